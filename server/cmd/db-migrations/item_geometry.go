@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ItemGeometryDocument struct {
+	ID     string                       `bson:"_id,omitempty"`
+	Fields []mongodoc.ItemFieldDocument `bson:"fields"`
+}
+
+func (d ItemGeometryDocument) GetID() primitive.ObjectID {
+	id, err := primitive.ObjectIDFromHex(d.ID)
+	if err != nil {
+		fmt.Printf("failed to parse id: %v\n", d.ID)
+		return primitive.NilObjectID
+	}
+	return id
+}
+
+// ItemGeometry backfills fields.g of the items saved before geometries were indexed for spatial queries.
+func ItemGeometry(ctx context.Context, dbURL, dbName string, wetRun bool) error {
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(dbURL))
+	if err != nil {
+		return fmt.Errorf("db: failed to init client err: %w", err)
+	}
+	defer func() {
+		if err := client.Disconnect(ctx); err != nil {
+			fmt.Printf("Warning: failed to disconnect client: %v\n", err)
+		}
+	}()
+
+	col := client.Database(dbName).Collection("item")
+	filter := bson.M{"fields.v.t": bson.M{"$in": bson.A{string(value.TypeGeometryObject), string(value.TypeGeometryEditor)}}}
+
+	if !wetRun {
+		fmt.Printf("dry run\n")
+		count, err := col.CountDocuments(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("failed to count docs: %w", err)
+		}
+		fmt.Printf("%d docs will be checked for geometries\n", count)
+		return nil
+	}
+
+	processed, err := BatchUpdate(ctx, col, filter, 1000, updateItemGeometry)
+	if err != nil {
+		return fmt.Errorf("failed to apply batches: %w", err)
+	}
+
+	fmt.Printf("Migration completed. Processed %d documents\n", processed)
+	return nil
+}
+
+func updateItemGeometry(item ItemGeometryDocument) (*ItemGeometryDocument, error) {
+	for i, f := range item.Fields {
+		item.Fields[i].G = mongodoc.NewGeometries(f.V.MultipleValue())
+	}
+	return &ItemGeometryDocument{Fields: item.Fields}, nil
+}
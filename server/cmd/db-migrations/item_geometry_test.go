@@ -0,0 +1,30 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_updateItemGeometry(t *testing.T) {
+	got, err := updateItemGeometry(ItemGeometryDocument{
+		Fields: []mongodoc.ItemFieldDocument{
+			{F: "id1", V: mongodoc.ValueDocument{T: "text", V: []any{"a"}}},
+			{F: "id2", V: mongodoc.ValueDocument{T: "geometryObject", V: []any{`{"type":"Point","coordinates":[139,35]}`}}},
+			{F: "id3", V: mongodoc.ValueDocument{T: "geometryEditor", V: []any{`{"type":"Point","coordinates":[200,35]}`}}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &ItemGeometryDocument{
+		Fields: []mongodoc.ItemFieldDocument{
+			{F: "id1", V: mongodoc.ValueDocument{T: "text", V: []any{"a"}}},
+			{
+				F: "id2",
+				V: mongodoc.ValueDocument{T: "geometryObject", V: []any{`{"type":"Point","coordinates":[139,35]}`}},
+				G: []any{map[string]any{"type": "Point", "coordinates": []any{139.0, 35.0}}},
+			},
+			{F: "id3", V: mongodoc.ValueDocument{T: "geometryEditor", V: []any{`{"type":"Point","coordinates":[200,35]}`}}},
+		},
+	}, got)
+}
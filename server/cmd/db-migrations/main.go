@@ -16,6 +16,7 @@ var commands = map[string]command{
 	"item-migration":     ItemMigration,
 	"project-visibility": ProjectVisibility,
 	"text-normalization": TextNormalizationMigration,
+	"item-geometry":      ItemGeometry,
 }
 
 func main() {
@@ -4,7 +4,9 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearthx/usecasex"
 	"github.com/samber/lo"
 )
@@ -42,9 +44,63 @@ func ToAsset(a *asset.Asset) *Asset {
 		Size:                    int64(a.Size()),
 		Public:                  ai.Public,
 		ContentType:             detectContentTypeByFilename(a.FileName()),
+		FolderID:                IDFromRef(a.Folder()),
+		Metadata:                ToAssetMetadata(a.Metadata()),
+		Versions:                ToAssetVersions(a.Versions()),
 	}
 }
 
+func ToAssetVersions(versions []*asset.Version) []*AssetVersion {
+	return lo.Map(versions, func(v *asset.Version, _ int) *AssetVersion {
+		var uploadedBy *ID
+		var uploadedByType *OperatorType
+		if v.UploadedByUser() != nil {
+			uploadedBy = IDFromRef(v.UploadedByUser())
+			uploadedByType = new(OperatorTypeUser)
+		} else if v.UploadedByIntegration() != nil {
+			uploadedBy = IDFromRef(v.UploadedByIntegration())
+			uploadedByType = new(OperatorTypeIntegration)
+		}
+
+		return &AssetVersion{
+			UUID:           v.UUID(),
+			FileName:       v.FileName(),
+			Size:           int64(v.Size()),
+			ContentType:    lo.EmptyableToPtr(v.ContentType()),
+			PreviewType:    ToPreviewType(v.PreviewType()),
+			UploadedAt:     v.UploadedAt(),
+			UploadedByID:   uploadedBy,
+			UploadedByType: uploadedByType,
+		}
+	})
+}
+
+func ToAssetMetadata(m asset.Metadata) []*AssetMetadataField {
+	return lo.Map(m, func(f *asset.MetadataField, _ int) *AssetMetadataField {
+		return &AssetMetadataField{
+			SchemaFieldID: IDFrom(f.FieldID()),
+			Type:          ToValueType(f.Type()),
+			Values:        f.Value().Interface(),
+		}
+	})
+}
+
+func FromAssetMetadataInput(fields []*AssetMetadataFieldInput) []interfaces.AssetMetadataFieldParam {
+	if fields == nil {
+		return nil
+	}
+	return lo.FilterMap(fields, func(f *AssetMetadataFieldInput, _ int) (interfaces.AssetMetadataFieldParam, bool) {
+		if f == nil {
+			return interfaces.AssetMetadataFieldParam{}, false
+		}
+		fid, err := ToID[id.Field](f.SchemaFieldID)
+		if err != nil {
+			return interfaces.AssetMetadataFieldParam{}, false
+		}
+		return interfaces.AssetMetadataFieldParam{Field: &fid, Value: f.Value}, true
+	})
+}
+
 func FromPreviewType(p *PreviewType) *asset.PreviewType {
 	if p == nil {
 		return nil
@@ -0,0 +1,69 @@
+package gqlmodel
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/samber/lo"
+)
+
+// ToAssetFolder converts the folder. The folders of the project are required to build the path and the effective permission.
+func ToAssetFolder(f *asset.Folder, folders asset.FolderList) *AssetFolder {
+	if f == nil {
+		return nil
+	}
+
+	return &AssetFolder{
+		ID:                  IDFrom(f.ID()),
+		ProjectID:           IDFrom(f.Project()),
+		ParentID:            IDFromRef(f.Parent()),
+		Name:                f.Name(),
+		Path:                folders.Path(f.ID()),
+		Permission:          ToAssetFolderPermission(f.Permission()),
+		EffectivePermission: *ToAssetFolderPermission(new(folders.Permission(f.ID()))),
+		CreatedAt:           f.CreatedAt(),
+		UpdatedAt:           f.UpdatedAt(),
+	}
+}
+
+func ToAssetFolders(folders asset.FolderList) []*AssetFolder {
+	return lo.Map(folders, func(f *asset.Folder, _ int) *AssetFolder {
+		return ToAssetFolder(f, folders)
+	})
+}
+
+func ToAssetFolderPermission(p *asset.FolderPermission) *AssetFolderPermission {
+	if p == nil {
+		return nil
+	}
+
+	var res AssetFolderPermission
+	switch *p {
+	case asset.FolderPermissionWriter:
+		res = AssetFolderPermissionWriter
+	case asset.FolderPermissionMaintainer:
+		res = AssetFolderPermissionMaintainer
+	case asset.FolderPermissionOwner:
+		res = AssetFolderPermissionOwner
+	default:
+		return nil
+	}
+	return &res
+}
+
+func FromAssetFolderPermission(p *AssetFolderPermission) *asset.FolderPermission {
+	if p == nil {
+		return nil
+	}
+
+	var res asset.FolderPermission
+	switch *p {
+	case AssetFolderPermissionWriter:
+		res = asset.FolderPermissionWriter
+	case AssetFolderPermissionMaintainer:
+		res = asset.FolderPermissionMaintainer
+	case AssetFolderPermissionOwner:
+		res = asset.FolderPermissionOwner
+	default:
+		return nil
+	}
+	return &res
+}
@@ -0,0 +1,41 @@
+package gqlmodel
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToAssetFolder(t *testing.T) {
+	pid := id.NewProjectID()
+	uid := accountdomain.NewUserID()
+	maintainer := asset.FolderPermissionMaintainer
+	a := asset.NewFolder().NewID().Project(pid).Name("a").Permission(&maintainer).CreatedByUser(uid).MustBuild()
+	b := asset.NewFolder().NewID().Project(pid).Name("b").Parent(a.ID().Ref()).CreatedByUser(uid).MustBuild()
+	folders := asset.FolderList{a, b}
+
+	assert.Nil(t, ToAssetFolder(nil, folders))
+	assert.Equal(t, &AssetFolder{
+		ID:                  IDFrom(b.ID()),
+		ProjectID:           IDFrom(pid),
+		ParentID:            IDFromRef(a.ID().Ref()),
+		Name:                "b",
+		Path:                "a/b",
+		Permission:          nil,
+		EffectivePermission: AssetFolderPermissionMaintainer,
+		CreatedAt:           b.CreatedAt(),
+		UpdatedAt:           b.UpdatedAt(),
+	}, ToAssetFolder(b, folders))
+	assert.Len(t, ToAssetFolders(folders), 2)
+}
+
+func TestAssetFolderPermission(t *testing.T) {
+	for _, p := range AllAssetFolderPermission {
+		assert.Equal(t, &p, ToAssetFolderPermission(FromAssetFolderPermission(&p)))
+	}
+	assert.Nil(t, ToAssetFolderPermission(nil))
+	assert.Nil(t, FromAssetFolderPermission(nil))
+}
@@ -2,10 +2,13 @@ package gqlmodel
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/usecasex"
 	"github.com/stretchr/testify/assert"
@@ -39,6 +42,8 @@ func TestToAsset(t *testing.T) {
 		ThreadID:      new(ID(thid.String())),
 		Size:          1000,
 		Public:        false,
+		Metadata:      []*AssetMetadataField{},
+		Versions:      []*AssetVersion{},
 	}
 
 	var a2 *asset.Asset = nil
@@ -404,3 +409,40 @@ func TestConvertAsset_detectContentTypeByFilename(t *testing.T) {
 		})
 	}
 }
+
+func TestToAssetMetadata(t *testing.T) {
+	fid := id.NewFieldID()
+	m := asset.Metadata{asset.NewMetadataField(fid, value.NewMultiple(value.TypeText, []any{"a", "b"}))}
+
+	assert.Equal(t, []*AssetMetadataField{
+		{SchemaFieldID: IDFrom(fid), Type: SchemaFieldTypeText, Values: []any{"a", "b"}},
+	}, ToAssetMetadata(m))
+}
+
+func TestToAssetVersions(t *testing.T) {
+	uid := accountdomain.NewUserID()
+	iid := id.NewIntegrationID()
+	now := time.Now()
+	pti := asset.PreviewTypeImage
+
+	assert.Equal(t, []*AssetVersion{
+		{UUID: "a", FileName: "a.png", Size: 1, ContentType: new("image/png"), PreviewType: new(PreviewTypeImage), UploadedAt: now, UploadedByID: new(IDFrom(uid)), UploadedByType: new(OperatorTypeUser)},
+		{UUID: "b", FileName: "b.png", Size: 2, UploadedAt: now, UploadedByID: new(IDFrom(iid)), UploadedByType: new(OperatorTypeIntegration)},
+	}, ToAssetVersions([]*asset.Version{
+		asset.NewVersion().UUID("a").FileName("a.png").Size(1).ContentType("image/png").PreviewType(&pti).UploadedAt(now).UploadedByUser(&uid).Build(),
+		asset.NewVersion().UUID("b").FileName("b.png").Size(2).UploadedAt(now).UploadedByIntegration(&iid).Build(),
+	}))
+}
+
+func TestFromAssetMetadataInput(t *testing.T) {
+	fid := id.NewFieldID()
+
+	assert.Nil(t, FromAssetMetadataInput(nil))
+	assert.Equal(t, []interfaces.AssetMetadataFieldParam{
+		{Field: &fid, Value: "x"},
+	}, FromAssetMetadataInput([]*AssetMetadataFieldInput{
+		{SchemaFieldID: IDFrom(fid), Value: "x"},
+		{SchemaFieldID: "invalid", Value: "y"},
+		nil,
+	}))
+}
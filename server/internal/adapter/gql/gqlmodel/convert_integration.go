@@ -1,10 +1,13 @@
 package gqlmodel
 
 import (
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/integration"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
 )
 
 func ToIntegration(i *integration.Integration, uId *accountdomain.UserID) *Integration {
@@ -60,15 +63,65 @@ func ToWebhook(w *integration.Webhook) *Webhook {
 			OnItemPublish:     new(w.Trigger()[event.ItemPublish]),
 			OnItemUnPublish:   new(w.Trigger()[event.ItemUnpublish]),
 			OnAssetUpload:     new(w.Trigger()[event.AssetCreate]),
+			OnAssetUpdate:     new(w.Trigger()[event.AssetUpdate]),
 			OnAssetDecompress: new(w.Trigger()[event.AssetDecompress]),
 			OnAssetDelete:     new(w.Trigger()[event.AssetDelete]),
+			OnModelCreate:     new(w.Trigger()[event.ModelCreate]),
+			OnModelUpdate:     new(w.Trigger()[event.ModelUpdate]),
+			OnModelDelete:     new(w.Trigger()[event.ModelDelete]),
+			OnFieldCreate:     new(w.Trigger()[event.FieldCreate]),
+			OnFieldUpdate:     new(w.Trigger()[event.FieldUpdate]),
+			OnFieldDelete:     new(w.Trigger()[event.FieldDelete]),
+			OnRequestCreate:   new(w.Trigger()[event.RequestCreate]),
+			OnRequestUpdate:   new(w.Trigger()[event.RequestUpdate]),
+			OnRequestApprove:  new(w.Trigger()[event.RequestApprove]),
+			OnRequestClose:    new(w.Trigger()[event.RequestClose]),
+			OnCommentCreate:   new(w.Trigger()[event.CommentCreate]),
+			OnCommentUpdate:   new(w.Trigger()[event.CommentUpdate]),
+			OnCommentDelete:   new(w.Trigger()[event.CommentDelete]),
 		},
+		Filter:    ToWebhookFilter(w.Filter()),
+		Payload:   ToWebhookPayloadShape(w.Payload()),
 		Secret:    w.Secret(),
 		CreatedAt: w.CreatedAt(),
 		UpdatedAt: w.UpdatedAt(),
 	}
 }
 
+func ToWebhookFilter(f integration.WebhookFilter) *WebhookFilter {
+	return &WebhookFilter{
+		ModelIds: lo.Map(f.Models(), func(m id.ModelID, _ int) ID { return IDFrom(m) }),
+		GroupIds: lo.Map(f.Groups(), func(g id.GroupID, _ int) ID { return IDFrom(g) }),
+	}
+}
+
+func ToWebhookPayloadShape(p integration.WebhookPayloadShape) *WebhookPayloadShape {
+	return &WebhookPayloadShape{
+		Type:   ToWebhookPayloadType(p.Type()),
+		Fields: p.Fields(),
+	}
+}
+
+func ToWebhookPayloadType(t integration.WebhookPayloadType) WebhookPayloadType {
+	switch t {
+	case integration.WebhookPayloadTypeMinimal:
+		return WebhookPayloadTypeMinimal
+	case integration.WebhookPayloadTypeProjection:
+		return WebhookPayloadTypeProjection
+	}
+	return WebhookPayloadTypeFull
+}
+
+func FromWebhookPayloadType(t WebhookPayloadType) integration.WebhookPayloadType {
+	switch t {
+	case WebhookPayloadTypeMinimal:
+		return integration.WebhookPayloadTypeMinimal
+	case WebhookPayloadTypeProjection:
+		return integration.WebhookPayloadTypeProjection
+	}
+	return integration.WebhookPayloadTypeFull
+}
+
 func ToWebhooks(ws []*integration.Webhook) []*Webhook {
 	if len(ws) == 0 {
 		return []*Webhook{}
@@ -77,3 +130,72 @@ func ToWebhooks(ws []*integration.Webhook) []*Webhook {
 		return ToWebhook(w)
 	})
 }
+
+func ToWebhookDelivery(d *integration.WebhookDelivery) *WebhookDelivery {
+	if d == nil {
+		return nil
+	}
+
+	return &WebhookDelivery{
+		ID:            IDFrom(d.ID()),
+		IntegrationID: IDFrom(d.Integration()),
+		WebhookID:     IDFrom(d.Webhook()),
+		EventID:       IDFrom(d.Event()),
+		EventType:     string(d.EventType()),
+		URL:           d.URL(),
+		Payload:       string(d.Payload()),
+		Status:        ToWebhookDeliveryStatus(d.Status()),
+		Attempts: util.Map(d.Attempts(), func(a *integration.WebhookDeliveryAttempt) *WebhookDeliveryAttempt {
+			return &WebhookDeliveryAttempt{
+				AttemptedAt:  a.AttemptedAt,
+				StatusCode:   lo.EmptyableToPtr(a.StatusCode),
+				Latency:      int(a.Latency.Milliseconds()),
+				ResponseBody: lo.EmptyableToPtr(a.ResponseBody),
+				Error:        lo.EmptyableToPtr(a.Error),
+			}
+		}),
+		NextAttemptAt: d.NextAttemptAt(),
+		CreatedAt:     d.CreatedAt(),
+		UpdatedAt:     d.UpdatedAt(),
+	}
+}
+
+func ToWebhookDeliveryStatus(s integration.WebhookDeliveryStatus) WebhookDeliveryStatus {
+	switch s {
+	case integration.WebhookDeliveryStatusSucceeded:
+		return WebhookDeliveryStatusSucceeded
+	case integration.WebhookDeliveryStatusRetrying:
+		return WebhookDeliveryStatusRetrying
+	case integration.WebhookDeliveryStatusDead:
+		return WebhookDeliveryStatusDead
+	}
+	return WebhookDeliveryStatusPending
+}
+
+func ToWebhookFilterParam(input *WebhookFilterInput) (*interfaces.WebhookFilterParam, error) {
+	if input == nil {
+		return nil, nil
+	}
+	models, err := ToIDs[id.Model](input.ModelIds)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := ToIDs[id.Group](input.GroupIds)
+	if err != nil {
+		return nil, err
+	}
+	return &interfaces.WebhookFilterParam{
+		Models: models,
+		Groups: groups,
+	}, nil
+}
+
+func ToWebhookPayloadParam(input *WebhookPayloadShapeInput) *interfaces.WebhookPayloadParam {
+	if input == nil {
+		return nil
+	}
+	return &interfaces.WebhookPayloadParam{
+		Type:   FromWebhookPayloadType(input.Type),
+		Fields: input.Fields,
+	}
+}
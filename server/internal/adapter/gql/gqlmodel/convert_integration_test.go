@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/integration"
@@ -140,9 +141,25 @@ func TestToWebhook(t *testing.T) {
 					OnItemPublish:     new(false),
 					OnItemUnPublish:   new(false),
 					OnAssetUpload:     new(false),
+					OnAssetUpdate:     new(false),
 					OnAssetDecompress: new(false),
 					OnAssetDelete:     new(false),
+					OnModelCreate:     new(false),
+					OnModelUpdate:     new(false),
+					OnModelDelete:     new(false),
+					OnFieldCreate:     new(false),
+					OnFieldUpdate:     new(false),
+					OnFieldDelete:     new(false),
+					OnRequestCreate:   new(false),
+					OnRequestUpdate:   new(false),
+					OnRequestApprove:  new(false),
+					OnRequestClose:    new(false),
+					OnCommentCreate:   new(false),
+					OnCommentUpdate:   new(false),
+					OnCommentDelete:   new(false),
 				},
+				Filter:    &WebhookFilter{ModelIds: []ID{}, GroupIds: []ID{}},
+				Payload:   &WebhookPayloadShape{Type: WebhookPayloadTypeFull},
 				CreatedAt: wId.Timestamp(),
 				UpdatedAt: now,
 			},
@@ -158,8 +175,22 @@ func TestToWebhook(t *testing.T) {
 					event.ItemPublish:     true,
 					event.ItemUnpublish:   true,
 					event.AssetCreate:     true,
+					event.AssetUpdate:     true,
 					event.AssetDecompress: true,
 					event.AssetDelete:     true,
+					event.ModelCreate:     true,
+					event.ModelUpdate:     true,
+					event.ModelDelete:     true,
+					event.FieldCreate:     true,
+					event.FieldUpdate:     true,
+					event.FieldDelete:     true,
+					event.RequestCreate:   true,
+					event.RequestUpdate:   true,
+					event.RequestApprove:  true,
+					event.RequestClose:    true,
+					event.CommentCreate:   true,
+					event.CommentUpdate:   true,
+					event.CommentDelete:   true,
 				}).
 				MustBuild(),
 			want: &Webhook{
@@ -174,9 +205,25 @@ func TestToWebhook(t *testing.T) {
 					OnItemPublish:     new(true),
 					OnItemUnPublish:   new(true),
 					OnAssetUpload:     new(true),
+					OnAssetUpdate:     new(true),
 					OnAssetDecompress: new(true),
 					OnAssetDelete:     new(true),
+					OnModelCreate:     new(true),
+					OnModelUpdate:     new(true),
+					OnModelDelete:     new(true),
+					OnFieldCreate:     new(true),
+					OnFieldUpdate:     new(true),
+					OnFieldDelete:     new(true),
+					OnRequestCreate:   new(true),
+					OnRequestUpdate:   new(true),
+					OnRequestApprove:  new(true),
+					OnRequestClose:    new(true),
+					OnCommentCreate:   new(true),
+					OnCommentUpdate:   new(true),
+					OnCommentDelete:   new(true),
 				},
+				Filter:    &WebhookFilter{ModelIds: []ID{}, GroupIds: []ID{}},
+				Payload:   &WebhookPayloadShape{Type: WebhookPayloadTypeFull},
 				CreatedAt: wId.Timestamp(),
 				UpdatedAt: now,
 			},
@@ -224,8 +271,22 @@ func TestToWebhooks(t *testing.T) {
 						event.ItemPublish:     true,
 						event.ItemUnpublish:   true,
 						event.AssetCreate:     true,
+						event.AssetUpdate:     true,
 						event.AssetDecompress: true,
 						event.AssetDelete:     true,
+						event.ModelCreate:     true,
+						event.ModelUpdate:     true,
+						event.ModelDelete:     true,
+						event.FieldCreate:     true,
+						event.FieldUpdate:     true,
+						event.FieldDelete:     true,
+						event.RequestCreate:   true,
+						event.RequestUpdate:   true,
+						event.RequestApprove:  true,
+						event.RequestClose:    true,
+						event.CommentCreate:   true,
+						event.CommentUpdate:   true,
+						event.CommentDelete:   true,
 					}).
 					MustBuild(),
 			},
@@ -242,9 +303,25 @@ func TestToWebhooks(t *testing.T) {
 						OnItemPublish:     new(false),
 						OnItemUnPublish:   new(false),
 						OnAssetUpload:     new(false),
+						OnAssetUpdate:     new(false),
 						OnAssetDecompress: new(false),
 						OnAssetDelete:     new(false),
+						OnModelCreate:     new(false),
+						OnModelUpdate:     new(false),
+						OnModelDelete:     new(false),
+						OnFieldCreate:     new(false),
+						OnFieldUpdate:     new(false),
+						OnFieldDelete:     new(false),
+						OnRequestCreate:   new(false),
+						OnRequestUpdate:   new(false),
+						OnRequestApprove:  new(false),
+						OnRequestClose:    new(false),
+						OnCommentCreate:   new(false),
+						OnCommentUpdate:   new(false),
+						OnCommentDelete:   new(false),
 					},
+					Filter:    &WebhookFilter{ModelIds: []ID{}, GroupIds: []ID{}},
+					Payload:   &WebhookPayloadShape{Type: WebhookPayloadTypeFull},
 					CreatedAt: wId.Timestamp(),
 					UpdatedAt: now,
 				},
@@ -260,9 +337,25 @@ func TestToWebhooks(t *testing.T) {
 						OnItemPublish:     new(true),
 						OnItemUnPublish:   new(true),
 						OnAssetUpload:     new(true),
+						OnAssetUpdate:     new(true),
 						OnAssetDecompress: new(true),
 						OnAssetDelete:     new(true),
+						OnModelCreate:     new(true),
+						OnModelUpdate:     new(true),
+						OnModelDelete:     new(true),
+						OnFieldCreate:     new(true),
+						OnFieldUpdate:     new(true),
+						OnFieldDelete:     new(true),
+						OnRequestCreate:   new(true),
+						OnRequestUpdate:   new(true),
+						OnRequestApprove:  new(true),
+						OnRequestClose:    new(true),
+						OnCommentCreate:   new(true),
+						OnCommentUpdate:   new(true),
+						OnCommentDelete:   new(true),
 					},
+					Filter:    &WebhookFilter{ModelIds: []ID{}, GroupIds: []ID{}},
+					Payload:   &WebhookPayloadShape{Type: WebhookPayloadTypeFull},
 					CreatedAt: wId.Timestamp(),
 					UpdatedAt: now,
 				},
@@ -277,3 +370,68 @@ func TestToWebhooks(t *testing.T) {
 		})
 	}
 }
+
+func TestToWebhookFilterAndPayload(t *testing.T) {
+	mId := id.NewModelID()
+	gId := id.NewGroupID()
+
+	assert.Equal(t, &WebhookFilter{
+		ModelIds: []ID{IDFrom(mId)},
+		GroupIds: []ID{IDFrom(gId)},
+	}, ToWebhookFilter(integration.NewWebhookFilter(id.ModelIDList{mId}, id.GroupIDList{gId})))
+
+	assert.Equal(t, &WebhookPayloadShape{
+		Type:   WebhookPayloadTypeProjection,
+		Fields: []string{"title"},
+	}, ToWebhookPayloadShape(integration.NewWebhookPayloadShape(integration.WebhookPayloadTypeProjection, []string{"title"})))
+
+	assert.Equal(t, integration.WebhookPayloadTypeMinimal, FromWebhookPayloadType(WebhookPayloadTypeMinimal))
+	assert.Equal(t, integration.WebhookPayloadTypeFull, FromWebhookPayloadType(""))
+}
+
+func TestToWebhookFilterParam(t *testing.T) {
+	mId := id.NewModelID()
+
+	got, err := ToWebhookFilterParam(&WebhookFilterInput{ModelIds: []ID{IDFrom(mId)}})
+	assert.NoError(t, err)
+	assert.Equal(t, id.ModelIDList{mId}, got.Models)
+	assert.Empty(t, got.Groups)
+
+	_, err = ToWebhookFilterParam(&WebhookFilterInput{GroupIds: []ID{"x"}})
+	assert.Error(t, err)
+
+	got, err = ToWebhookFilterParam(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	assert.Equal(t, &interfaces.WebhookPayloadParam{
+		Type:   integration.WebhookPayloadTypeProjection,
+		Fields: []string{"title"},
+	}, ToWebhookPayloadParam(&WebhookPayloadShapeInput{Type: WebhookPayloadTypeProjection, Fields: []string{"title"}}))
+	assert.Nil(t, ToWebhookPayloadParam(nil))
+}
+
+func TestToWebhookDelivery(t *testing.T) {
+	now := time.Now()
+	w := integration.NewWebhookBuilder().NewID().Url(lo.Must(url.Parse("https://example.com"))).MustBuild()
+	d := integration.NewWebhookDelivery(id.NewIntegrationID(), w, id.NewEventID(), event.ItemCreate, []byte(`{}`))
+	d.RecordAttempt(integration.WebhookDeliveryAttempt{AttemptedAt: now, StatusCode: 500, Latency: 30 * time.Millisecond, ResponseBody: "error"})
+
+	assert.Nil(t, ToWebhookDelivery(nil))
+	assert.Equal(t, &WebhookDelivery{
+		ID:            IDFrom(d.ID()),
+		IntegrationID: IDFrom(d.Integration()),
+		WebhookID:     IDFrom(w.ID()),
+		EventID:       IDFrom(d.Event()),
+		EventType:     "item.create",
+		URL:           "https://example.com",
+		Payload:       "{}",
+		Status:        WebhookDeliveryStatusRetrying,
+		Attempts: []*WebhookDeliveryAttempt{
+			{AttemptedAt: now, StatusCode: new(500), Latency: 30, ResponseBody: new("error")},
+		},
+		NextAttemptAt: new(now.Add(time.Minute)),
+		CreatedAt:     d.CreatedAt(),
+		UpdatedAt:     now,
+	}, ToWebhookDelivery(d))
+}
@@ -61,12 +61,25 @@ func toItemFields(fields item.Fields, s *schema.Schema, isGroupSchema bool) []*I
 				SchemaFieldID: IDFrom(sf.ID()),
 				Type:          ToValueType(sf.Type()),
 				Value:         ToValue(field.Value(), sf.Multiple()),
+				Locales:       toLocalizedValues(field, sf.Multiple()),
 			})
 		}
 	}
 	return res
 }
 
+func toLocalizedValues(f *item.Field, multiple bool) []*LocalizedValue {
+	if len(f.Locales()) == 0 {
+		return nil
+	}
+	return lo.Map(f.Locales(), func(l string, _ int) *LocalizedValue {
+		return &LocalizedValue{
+			Locale: l,
+			Value:  ToValue(f.LocalizedValue(l), multiple),
+		}
+	})
+}
+
 func ToVersionedItem(v *version.Value[*item.Item], s *schema.Schema, gsList schema.List) *VersionedItem {
 	if v == nil {
 		return nil
@@ -96,11 +109,22 @@ func ToItemParam(field *ItemFieldInput) *interfaces.ItemFieldParam {
 		return nil
 	}
 
+	var locales map[string]any
+	if field.Locales != nil {
+		locales = make(map[string]any, len(field.Locales))
+		for _, l := range field.Locales {
+			if l != nil {
+				locales[l.Locale] = l.Value
+			}
+		}
+	}
+
 	return &interfaces.ItemFieldParam{
 		Group: ToIDRef[id.ItemGroup](field.ItemGroupID),
 		Field: &fid,
 		// Type:  FromValueType(field.Type),
-		Value: field.Value,
+		Value:   field.Value,
+		Locales: locales,
 	}
 }
 
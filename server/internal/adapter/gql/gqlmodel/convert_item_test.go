@@ -83,6 +83,38 @@ func TestToItem(t *testing.T) {
 	}
 }
 
+func TestToItem_Locales(t *testing.T) {
+	sid := id.NewSchemaID()
+	pid := id.NewProjectID()
+	sf1 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).Localizable(true).MustBuild()
+	s := schema.New().ID(sid).Fields([]*schema.Field{sf1}).Workspace(accountdomain.NewWorkspaceID()).Project(pid).MustBuild()
+	f := item.NewField(sf1.ID(), value.TypeText.Value("東京").AsMultiple(), nil)
+	f.SetLocalizedValue("fr", value.TypeText.Value("Tokyo").AsMultiple())
+	f.SetLocalizedValue("en", value.TypeText.Value("Tokyo").AsMultiple())
+	i := item.New().
+		NewID().
+		Schema(sid).
+		Project(pid).
+		Fields([]*item.Field{f}).
+		Model(id.NewModelID()).
+		Thread(id.NewThreadID().Ref()).
+		Anonymous(true).
+		MustBuild()
+
+	got := ToItem(version.MustBeValue(version.New(), nil, version.NewRefs(version.Latest), util.Now(), i), s, nil)
+	assert.Equal(t, []*ItemField{
+		{
+			SchemaFieldID: IDFrom(sf1.ID()),
+			Type:          SchemaFieldTypeText,
+			Value:         "東京",
+			Locales: []*LocalizedValue{
+				{Locale: "en", Value: "Tokyo"},
+				{Locale: "fr", Value: "Tokyo"},
+			},
+		},
+	}, got.Fields)
+}
+
 func TestToItem_GroupFields(t *testing.T) {
 	pid := id.NewProjectID()
 	iid := id.NewItemID()
@@ -182,6 +214,37 @@ func TestToItemParam(t *testing.T) {
 				Value: "foo",
 			},
 		},
+		{
+			name: "should return ItemFieldParam with locales",
+			input: &ItemFieldInput{
+				SchemaFieldID: IDFrom(sfid),
+				Type:          SchemaFieldTypeText,
+				Value:         "foo",
+				Locales: []*LocalizedValueInput{
+					{Locale: "en", Value: "bar"},
+					nil,
+				},
+			},
+			want: &interfaces.ItemFieldParam{
+				Field:   &sfid,
+				Value:   "foo",
+				Locales: map[string]any{"en": "bar"},
+			},
+		},
+		{
+			name: "should return ItemFieldParam with cleared locales",
+			input: &ItemFieldInput{
+				SchemaFieldID: IDFrom(sfid),
+				Type:          SchemaFieldTypeText,
+				Value:         "foo",
+				Locales:       []*LocalizedValueInput{},
+			},
+			want: &interfaces.ItemFieldParam{
+				Field:   &sfid,
+				Value:   "foo",
+				Locales: map[string]any{},
+			},
+		},
 		{
 			name: "nil input",
 		},
@@ -0,0 +1,55 @@
+package gqlmodel
+
+import (
+	"strings"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+)
+
+func ToItemVersionDiff(d *interfaces.ItemDiff, s *schema.Schema, gsList schema.List) *ItemVersionDiff {
+	if d == nil {
+		return nil
+	}
+
+	fields := append(gsList, s).Fields()
+	return &ItemVersionDiff{
+		From: ToVersionedItem(d.From, s, gsList),
+		To:   ToVersionedItem(d.To, s, gsList),
+		Changes: lo.Map(d.Changes, func(c item.FieldChange, _ int) *ItemFieldChange {
+			return ToItemFieldChange(c, fields.Find(c.ID))
+		}),
+	}
+}
+
+// ToItemFieldChange converts the change of the field. The values of a field which is no longer in the schema are returned as lists.
+func ToItemFieldChange(c item.FieldChange, sf *schema.Field) *ItemFieldChange {
+	multiple := sf == nil || sf.Multiple()
+	added, removed := c.ValueChanges()
+
+	res := &ItemFieldChange{
+		FieldID:       IDFrom(c.ID),
+		ItemGroupID:   IDFromRef(c.ItemGroup),
+		Type:          ItemFieldChangeType(strings.ToUpper(string(c.Type))),
+		AddedValues:   lo.Map(added, func(v *value.Value, _ int) any { return v.Interface() }),
+		RemovedValues: lo.Map(removed, func(v *value.Value, _ int) any { return v.Interface() }),
+	}
+	if c.PreviousValue != nil {
+		res.PreviousValue = ToValue(c.PreviousValue, multiple)
+	}
+	if c.CurrentValue != nil {
+		res.CurrentValue = ToValue(c.CurrentValue, multiple)
+	}
+	return res
+}
+
+func ToDroppedField(d item.DroppedField) *DroppedField {
+	return &DroppedField{
+		FieldID:     IDFrom(d.Field),
+		ItemGroupID: IDFromRef(d.ItemGroup),
+		Reason:      DroppedFieldReason(strings.ToUpper(string(d.Reason))),
+	}
+}
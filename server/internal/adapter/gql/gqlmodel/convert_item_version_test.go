@@ -0,0 +1,77 @@
+package gqlmodel
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToItemFieldChange(t *testing.T) {
+	t.Parallel()
+
+	sf := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	sfm := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).Multiple(true).MustBuild()
+	gid := id.NewItemGroupID()
+
+	assert.Equal(t, &ItemFieldChange{
+		FieldID:       IDFrom(sf.ID()),
+		Type:          ItemFieldChangeTypeUpdate,
+		PreviousValue: "a",
+		CurrentValue:  "b",
+		AddedValues:   []any{"b"},
+		RemovedValues: []any{"a"},
+	}, ToItemFieldChange(item.FieldChange{
+		ID:            sf.ID(),
+		Type:          item.FieldChangeTypeUpdate,
+		PreviousValue: value.TypeText.Value("a").AsMultiple(),
+		CurrentValue:  value.TypeText.Value("b").AsMultiple(),
+	}, sf))
+
+	assert.Equal(t, &ItemFieldChange{
+		FieldID:       IDFrom(sfm.ID()),
+		ItemGroupID:   IDFromRef(&gid),
+		Type:          ItemFieldChangeTypeAdd,
+		CurrentValue:  []any{"a", "b"},
+		AddedValues:   []any{"a", "b"},
+		RemovedValues: []any{},
+	}, ToItemFieldChange(item.FieldChange{
+		ID:           sfm.ID(),
+		ItemGroup:    &gid,
+		Type:         item.FieldChangeTypeAdd,
+		CurrentValue: value.NewMultiple(value.TypeText, []any{"a", "b"}),
+	}, sfm))
+
+	// the field removed from the schema
+	assert.Equal(t, &ItemFieldChange{
+		FieldID:       IDFrom(sf.ID()),
+		Type:          ItemFieldChangeTypeDelete,
+		PreviousValue: []any{"a"},
+		AddedValues:   []any{},
+		RemovedValues: []any{"a"},
+	}, ToItemFieldChange(item.FieldChange{
+		ID:            sf.ID(),
+		Type:          item.FieldChangeTypeDelete,
+		PreviousValue: value.TypeText.Value("a").AsMultiple(),
+	}, nil))
+}
+
+func TestToDroppedField(t *testing.T) {
+	t.Parallel()
+
+	fid := id.NewFieldID()
+	gid := id.NewItemGroupID()
+
+	assert.Equal(t, &DroppedField{
+		FieldID: IDFrom(fid),
+		Reason:  DroppedFieldReasonRemoved,
+	}, ToDroppedField(item.DroppedField{Field: fid, Reason: item.DroppedFieldReasonRemoved}))
+	assert.Equal(t, &DroppedField{
+		FieldID:     IDFrom(fid),
+		ItemGroupID: IDFromRef(&gid),
+		Reason:      DroppedFieldReasonReferenceNotFound,
+	}, ToDroppedField(item.DroppedField{Field: fid, ItemGroup: &gid, Reason: item.DroppedFieldReasonReferenceNotFound}))
+}
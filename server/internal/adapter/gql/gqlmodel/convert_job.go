@@ -1,6 +1,8 @@
 package gqlmodel
 
 import (
+	"net/url"
+
 	"github.com/reearth/reearth-cms/server/pkg/job"
 )
 
@@ -15,16 +17,53 @@ func ToJob(j *job.Job) *Job {
 	}
 
 	return &Job{
-		ID:          IDFrom(j.ID()),
-		Type:        ToJobType(j.Type()),
-		ProjectID:   IDFrom(j.ProjectID()),
-		Status:      ToJobStatus(j.Status()),
-		Progress:    ToJobProgress(j.Progress()),
-		Error:       errStr,
-		CreatedAt:   j.CreatedAt(),
-		UpdatedAt:   j.UpdatedAt(),
-		StartedAt:   j.StartedAt(),
-		CompletedAt: j.CompletedAt(),
+		ID:               IDFrom(j.ID()),
+		Type:             ToJobType(j.Type()),
+		ProjectID:        IDFrom(j.ProjectID()),
+		Status:           ToJobStatus(j.Status()),
+		Progress:         ToJobProgress(j.Progress()),
+		Error:            errStr,
+		CreatedAt:        j.CreatedAt(),
+		UpdatedAt:        j.UpdatedAt(),
+		StartedAt:        j.StartedAt(),
+		CompletedAt:      j.CompletedAt(),
+		ExportResult:     ToExportJobResult(j),
+		BulkUpdateResult: ToBulkUpdateJobResult(j),
+	}
+}
+
+func ToBulkUpdateJobResult(j *job.Job) *BulkUpdateJobResult {
+	if j == nil || j.Status() != job.StatusCompleted {
+		return nil
+	}
+	r, err := j.BulkUpdateResult()
+	if err != nil || r == nil {
+		return nil
+	}
+	return &BulkUpdateJobResult{
+		TotalCount:   r.Total,
+		UpdatedCount: r.Updated,
+		SkippedCount: r.Skipped,
+		FailedCount:  r.Failed,
+	}
+}
+
+func ToExportJobResult(j *job.Job) *ExportJobResult {
+	if j == nil || j.Status() != job.StatusCompleted {
+		return nil
+	}
+	r, err := j.ExportResult()
+	if err != nil || r == nil {
+		return nil
+	}
+	u, err := url.Parse(r.URL)
+	if err != nil {
+		return nil
+	}
+	return &ExportJobResult{
+		URL:        *u,
+		TotalCount: r.Total,
+		ExpiresAt:  r.ExpiresAt,
 	}
 }
 
@@ -32,6 +71,10 @@ func ToJobType(t job.Type) JobType {
 	switch t {
 	case job.TypeImport:
 		return JobTypeImport
+	case job.TypeExport:
+		return JobTypeExport
+	case job.TypeBulkUpdate:
+		return JobTypeBulkUpdate
 	default:
 		return JobTypeImport
 	}
@@ -88,6 +131,10 @@ func FromJobType(t *JobType) *job.Type {
 	switch *t {
 	case JobTypeImport:
 		jt = job.TypeImport
+	case JobTypeExport:
+		jt = job.TypeExport
+	case JobTypeBulkUpdate:
+		jt = job.TypeBulkUpdate
 	default:
 		jt = job.TypeImport
 	}
@@ -1,9 +1,14 @@
 package gqlmodel
 
 import (
+	"net/url"
 	"testing"
+	"time"
 
+	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/job"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -191,6 +196,8 @@ func TestToJobType(t *testing.T) {
 		want    JobType
 	}{
 		{name: "import", jobType: job.TypeImport, want: JobTypeImport},
+		{name: "export", jobType: job.TypeExport, want: JobTypeExport},
+		{name: "bulk update", jobType: job.TypeBulkUpdate, want: JobTypeBulkUpdate},
 	}
 
 	for _, tt := range tests {
@@ -218,6 +225,44 @@ func TestFromJobType(t *testing.T) {
 		assert.NotNil(t, result)
 		assert.Equal(t, job.TypeImport, *result)
 	})
+
+	t.Run("export type", func(t *testing.T) {
+		t.Parallel()
+		jt := JobTypeExport
+		result := FromJobType(&jt)
+		assert.NotNil(t, result)
+		assert.Equal(t, job.TypeExport, *result)
+	})
+
+	t.Run("bulk update type", func(t *testing.T) {
+		t.Parallel()
+		jt := JobTypeBulkUpdate
+		result := FromJobType(&jt)
+		assert.NotNil(t, result)
+		assert.Equal(t, job.TypeBulkUpdate, *result)
+	})
+}
+
+func TestToExportJobResult(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	res := lo.Must((&job.ExportResult{Total: 10, Path: "exports/a.csv", URL: "https://example.com/exports/a.csv", ExpiresAt: &expiresAt}).ToJSON())
+
+	j := job.New().NewID().Type(job.TypeExport).Project(id.NewProjectID()).User(accountdomain.NewUserID()).Result(res).MustBuild()
+	assert.Nil(t, ToExportJobResult(j))
+
+	j = job.New().NewID().Type(job.TypeExport).Project(id.NewProjectID()).User(accountdomain.NewUserID()).Result(res).Status(job.StatusCompleted).MustBuild()
+	assert.Equal(t, &ExportJobResult{
+		URL:        lo.FromPtr(lo.Must(url.Parse("https://example.com/exports/a.csv"))),
+		TotalCount: 10,
+		ExpiresAt:  &expiresAt,
+	}, ToExportJobResult(j))
+	assert.Equal(t, ToExportJobResult(j), ToJob(j).ExportResult)
+
+	j = job.New().NewID().Type(job.TypeImport).Project(id.NewProjectID()).User(accountdomain.NewUserID()).Result(res).Status(job.StatusCompleted).MustBuild()
+	assert.Nil(t, ToExportJobResult(j))
+	assert.Nil(t, ToExportJobResult(nil))
 }
 
 func TestFromJobStatus(t *testing.T) {
@@ -251,3 +296,18 @@ func TestFromJobStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestToBulkUpdateJobResult(t *testing.T) {
+	t.Parallel()
+
+	res := lo.Must((&job.BulkUpdateResult{Total: 10, Updated: 7, Skipped: 2, Failed: 1}).ToJSON())
+
+	j := job.New().NewID().Type(job.TypeBulkUpdate).Project(id.NewProjectID()).User(accountdomain.NewUserID()).Result(res).MustBuild()
+	assert.Nil(t, ToBulkUpdateJobResult(j))
+
+	j = job.New().NewID().Type(job.TypeBulkUpdate).Project(id.NewProjectID()).User(accountdomain.NewUserID()).Result(res).Status(job.StatusCompleted).MustBuild()
+	assert.Equal(t, &BulkUpdateJobResult{TotalCount: 10, UpdatedCount: 7, SkippedCount: 2, FailedCount: 1}, ToBulkUpdateJobResult(j))
+	assert.Equal(t, ToBulkUpdateJobResult(j), ToJob(j).BulkUpdateResult)
+	assert.Nil(t, ToJob(j).ExportResult)
+	assert.Nil(t, ToBulkUpdateJobResult(nil))
+}
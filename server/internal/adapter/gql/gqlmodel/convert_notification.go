@@ -0,0 +1,57 @@
+package gqlmodel
+
+import (
+	"strings"
+
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/samber/lo"
+)
+
+func ToNotification(n *notification.Notification) *Notification {
+	if n == nil {
+		return nil
+	}
+
+	return &Notification{
+		ID:          IDFrom(n.ID()),
+		WorkspaceID: IDFrom(n.Workspace()),
+		Type:        ToNotificationType(n.Type()),
+		ActorID:     IDFromRef(n.Actor()),
+		RequestID:   IDFromRef(n.Request()),
+		ThreadID:    IDFromRef(n.Thread()),
+		CommentID:   IDFromRef(n.Comment()),
+		Title:       n.Title(),
+		Content:     n.Content(),
+		Read:        n.IsRead(),
+		ReadAt:      n.ReadAt(),
+		CreatedAt:   n.CreatedAt(),
+	}
+}
+
+func ToNotificationType(t notification.Type) NotificationType {
+	return NotificationType(strings.ToUpper(t.String()))
+}
+
+func FromNotificationType(t NotificationType) notification.Type {
+	res, _ := notification.TypeFrom(t.String())
+	return res
+}
+
+func ToNotificationPreference(p *notification.Preference) *NotificationPreference {
+	if p == nil {
+		return nil
+	}
+
+	return &NotificationPreference{
+		EmailFrequency: NotificationEmailFrequency(strings.ToUpper(p.EmailFrequency().String())),
+		MutedTypes:     lo.Map(p.MutedTypes(), func(t notification.Type, _ int) NotificationType { return ToNotificationType(t) }),
+	}
+}
+
+func FromNotificationEmailFrequency(f *NotificationEmailFrequency) *notification.EmailFrequency {
+	if f == nil {
+		return nil
+	}
+	res, _ := notification.EmailFrequencyFrom(f.String())
+	return &res
+}
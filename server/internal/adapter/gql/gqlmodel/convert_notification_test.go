@@ -0,0 +1,53 @@
+package gqlmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToNotification(t *testing.T) {
+	assert.Nil(t, ToNotification(nil))
+
+	uid, actor, rid := accountdomain.NewUserID(), accountdomain.NewUserID(), id.NewRequestID()
+	readAt := time.Now()
+	n := notification.New().NewID().Workspace(accountdomain.NewWorkspaceID()).User(uid).
+		Type(notification.TypeRequestChangesRequested).Actor(&actor).Request(&rid).Title("foo").ReadAt(&readAt).MustBuild()
+
+	assert.Equal(t, &Notification{
+		ID:          IDFrom(n.ID()),
+		WorkspaceID: IDFrom(n.Workspace()),
+		Type:        NotificationTypeRequestChangesRequested,
+		ActorID:     IDFromRef(&actor),
+		RequestID:   IDFromRef(&rid),
+		Title:       "foo",
+		Read:        true,
+		ReadAt:      &readAt,
+		CreatedAt:   n.CreatedAt(),
+	}, ToNotification(n))
+}
+
+func TestNotificationType(t *testing.T) {
+	for _, tt := range AllNotificationType {
+		assert.Equal(t, tt, ToNotificationType(FromNotificationType(tt)))
+	}
+}
+
+func TestToNotificationPreference(t *testing.T) {
+	assert.Nil(t, ToNotificationPreference(nil))
+
+	p := notification.NewPreference(accountdomain.NewUserID(), notification.EmailFrequencyDigest, []notification.Type{notification.TypeComment})
+	assert.Equal(t, &NotificationPreference{
+		EmailFrequency: NotificationEmailFrequencyDigest,
+		MutedTypes:     []NotificationType{NotificationTypeComment},
+	}, ToNotificationPreference(p))
+}
+
+func TestFromNotificationEmailFrequency(t *testing.T) {
+	assert.Nil(t, FromNotificationEmailFrequency(nil))
+	assert.Equal(t, new(notification.EmailFrequencyNever), FromNotificationEmailFrequency(new(NotificationEmailFrequencyNever)))
+}
@@ -1,6 +1,8 @@
 package gqlmodel
 
 import (
+	"slices"
+
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/project"
@@ -15,17 +17,59 @@ func ToProject(p *project.Project) *Project {
 	}
 
 	return &Project{
-		ID:            IDFrom(p.ID()),
-		WorkspaceID:   IDFrom(p.Workspace()),
-		CreatedAt:     p.CreatedAt(),
-		Alias:         p.Alias(),
-		Name:          p.Name(),
-		Description:   p.Description(),
-		License:       p.License(),
-		Readme:        p.Readme(),
-		UpdatedAt:     p.UpdatedAt(),
-		Accessibility: ToProjectAccessibility(p.Accessibility()),
-		RequestRoles:  lo.Map(p.RequestRoles(), func(r workspace.Role, _ int) Role { return ToRole(r) }),
+		ID:                    IDFrom(p.ID()),
+		WorkspaceID:           IDFrom(p.Workspace()),
+		CreatedAt:             p.CreatedAt(),
+		Alias:                 p.Alias(),
+		Name:                  p.Name(),
+		Description:           p.Description(),
+		License:               p.License(),
+		Readme:                p.Readme(),
+		UpdatedAt:             p.UpdatedAt(),
+		Accessibility:         ToProjectAccessibility(p.Accessibility()),
+		RequestRoles:          lo.Map(p.RequestRoles(), func(r workspace.Role, _ int) Role { return ToRole(r) }),
+		Localization:          ToProjectLocalization(p.Localization()),
+		AssetMetadataSchemaID: IDFromRef(p.AssetMetadataSchema()),
+	}
+}
+
+func ToProjectLocalization(l *project.Localization) *ProjectLocalization {
+	if l == nil {
+		return nil
+	}
+
+	fb := l.Fallbacks()
+	locales := lo.Keys(fb)
+	slices.Sort(locales)
+
+	return &ProjectLocalization{
+		DefaultLocale: l.DefaultLocale(),
+		Locales:       l.Locales(),
+		Fallbacks: lo.Map(locales, func(k string, _ int) *LocaleFallback {
+			return &LocaleFallback{Locale: k, Fallbacks: fb[k]}
+		}),
+	}
+}
+
+func FromProjectLocalization(l *ProjectLocalizationInput) *interfaces.LocalizationParam {
+	if l == nil {
+		return nil
+	}
+
+	var fb map[string][]string
+	if len(l.Fallbacks) > 0 {
+		fb = make(map[string][]string, len(l.Fallbacks))
+		for _, f := range l.Fallbacks {
+			if f != nil {
+				fb[f.Locale] = f.Fallbacks
+			}
+		}
+	}
+
+	return &interfaces.LocalizationParam{
+		DefaultLocale: l.DefaultLocale,
+		Locales:       l.Locales,
+		Fallbacks:     fb,
 	}
 }
 
@@ -146,4 +190,3 @@ func FromPostingSettings(p *UpdatePostingSettingsInput) *interfaces.PostingSetti
 	}
 	return &interfaces.PostingSettingsParam{AllowedOrigins: origins}
 }
-
@@ -48,6 +48,43 @@ func TestConvertProject_ToProject(t *testing.T) {
 	assert.Nil(t, ToProject(p2))
 }
 
+func TestToProjectLocalization(t *testing.T) {
+	l, err := project.NewLocalization("ja", []string{"ja", "en", "en-US", "fr"}, map[string][]string{"fr": {"en"}, "en-US": {"en"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, &ProjectLocalization{
+		DefaultLocale: "ja",
+		Locales:       []string{"ja", "en", "en-US", "fr"},
+		Fallbacks: []*LocaleFallback{
+			{Locale: "en-US", Fallbacks: []string{"en"}},
+			{Locale: "fr", Fallbacks: []string{"en"}},
+		},
+	}, ToProjectLocalization(l))
+	assert.Nil(t, ToProjectLocalization(nil))
+}
+
+func TestFromProjectLocalization(t *testing.T) {
+	assert.Nil(t, FromProjectLocalization(nil))
+
+	assert.Equal(t, &interfaces.LocalizationParam{
+		DefaultLocale: "ja",
+		Locales:       []string{"ja", "en"},
+	}, FromProjectLocalization(&ProjectLocalizationInput{
+		DefaultLocale: "ja",
+		Locales:       []string{"ja", "en"},
+	}))
+
+	assert.Equal(t, &interfaces.LocalizationParam{
+		DefaultLocale: "ja",
+		Locales:       []string{"ja", "en"},
+		Fallbacks:     map[string][]string{"en": {"ja"}},
+	}, FromProjectLocalization(&ProjectLocalizationInput{
+		DefaultLocale: "ja",
+		Locales:       []string{"ja", "en"},
+		Fallbacks:     []*LocaleFallbackInput{{Locale: "en", Fallbacks: []string{"ja"}}, nil},
+	}))
+}
+
 // --- ToPublication ---
 
 func TestToPublication(t *testing.T) {
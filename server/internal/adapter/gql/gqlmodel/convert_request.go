@@ -31,20 +31,70 @@ func ToRequest(req *request.Request) *Request {
 	})
 
 	return &Request{
-		ID:          IDFrom(req.ID()),
-		Items:       items,
-		Title:       req.Title(),
-		Description: new(req.Description()),
-		CreatedByID: IDFrom(req.CreatedBy()),
-		WorkspaceID: IDFrom(req.Workspace()),
-		ProjectID:   IDFrom(req.Project()),
-		ThreadID:    IDFromRef(req.Thread()),
-		ReviewersID: lo.Map(req.Reviewers(), func(t accountdomain.UserID, _ int) ID { return IDFrom(t) }),
-		State:       ToRequestState(req.State()),
-		CreatedAt:   req.CreatedAt(),
-		UpdatedAt:   req.UpdatedAt(),
-		ApprovedAt:  req.ApprovedAt(),
-		ClosedAt:    req.ClosedAt(),
+		ID:           IDFrom(req.ID()),
+		Items:        items,
+		Title:        req.Title(),
+		Description:  new(req.Description()),
+		CreatedByID:  IDFrom(req.CreatedBy()),
+		WorkspaceID:  IDFrom(req.Workspace()),
+		ProjectID:    IDFrom(req.Project()),
+		ThreadID:     IDFromRef(req.Thread()),
+		ReviewersID:  lo.Map(req.Reviewers(), func(t accountdomain.UserID, _ int) ID { return IDFrom(t) }),
+		State:        ToRequestState(req.State()),
+		Stages:       ToRequestStages(req.Stages()),
+		CurrentStage: req.CurrentStageIndex(),
+		Approvals:    lo.Map(req.Approvals(), func(a *request.Approval, _ int) *RequestApproval { return ToRequestApproval(a) }),
+		CreatedAt:    req.CreatedAt(),
+		UpdatedAt:    req.UpdatedAt(),
+		ApprovedAt:   req.ApprovedAt(),
+		ClosedAt:     req.ClosedAt(),
+	}
+}
+
+func ToRequestStages(stages request.StageList) []*RequestStage {
+	return lo.Map(stages, func(s *request.Stage, _ int) *RequestStage {
+		return &RequestStage{
+			Name:              s.Name(),
+			ReviewersID:       lo.Map(s.Reviewers(), func(u accountdomain.UserID, _ int) ID { return IDFrom(u) }),
+			RequiredApprovals: s.RequiredApprovals(),
+		}
+	})
+}
+
+func ToRequestApproval(a *request.Approval) *RequestApproval {
+	if a == nil {
+		return nil
+	}
+	return &RequestApproval{
+		UserID:    IDFrom(a.User()),
+		Stage:     a.Stage(),
+		Decision:  ToRequestDecision(a.Decision()),
+		Comment:   lo.EmptyableToPtr(a.Comment()),
+		CreatedAt: a.CreatedAt(),
+	}
+}
+
+func ToRequestDecision(d request.Decision) RequestDecision {
+	switch d {
+	case request.DecisionApproved:
+		return RequestDecisionApproved
+	case request.DecisionChangesRequested:
+		return RequestDecisionChangesRequested
+	default:
+		return ""
+	}
+}
+
+func ToRequestWorkflow(w *request.Workflow) *RequestWorkflow {
+	if w == nil {
+		return nil
+	}
+	return &RequestWorkflow{
+		ID:        IDFrom(w.ID()),
+		ProjectID: IDFrom(w.Project()),
+		ModelID:   IDFromRef(w.Model()),
+		Stages:    ToRequestStages(w.Stages()),
+		UpdatedAt: w.UpdatedAt(),
 	}
 }
 func ToRequestState(s request.State) RequestState {
@@ -57,6 +107,8 @@ func ToRequestState(s request.State) RequestState {
 		return RequestStateDraft
 	case request.StateWaiting:
 		return RequestStateWaiting
+	case request.StateChangesRequested:
+		return RequestStateChangesRequested
 	default:
 		return ""
 	}
@@ -43,6 +43,8 @@ func TestToRequest(t *testing.T) {
 		ThreadID:    IDFromRef(req.Thread()),
 		ReviewersID: []ID{IDFrom(req.Reviewers()[0])},
 		State:       RequestStateClosed,
+		Stages:      []*RequestStage{},
+		Approvals:   []*RequestApproval{},
 		CreatedAt:   req.CreatedAt(),
 		UpdatedAt:   req.UpdatedAt(),
 		ApprovedAt:  req.ApprovedAt(),
@@ -55,5 +57,46 @@ func TestToRequestState(t *testing.T) {
 	assert.Equal(t, RequestStateWaiting, ToRequestState(request.StateWaiting))
 	assert.Equal(t, RequestStateApproved, ToRequestState(request.StateApproved))
 	assert.Equal(t, RequestStateDraft, ToRequestState(request.StateDraft))
+	assert.Equal(t, RequestStateChangesRequested, ToRequestState(request.StateChangesRequested))
 	assert.Equal(t, RequestState(""), ToRequestState("xxx"))
 }
+
+func TestToRequestApproval(t *testing.T) {
+	uid := accountdomain.NewUserID()
+	now := util.Now()
+
+	assert.Equal(t, &RequestApproval{
+		UserID:    IDFrom(uid),
+		Stage:     1,
+		Decision:  RequestDecisionChangesRequested,
+		Comment:   new("fix"),
+		CreatedAt: now,
+	}, ToRequestApproval(request.NewApproval(uid, 1, request.DecisionChangesRequested, "fix", now)))
+	assert.Equal(t, &RequestApproval{
+		UserID:    IDFrom(uid),
+		Decision:  RequestDecisionApproved,
+		CreatedAt: now,
+	}, ToRequestApproval(request.NewApproval(uid, 0, request.DecisionApproved, "", now)))
+	assert.Nil(t, ToRequestApproval(nil))
+}
+
+func TestToRequestWorkflow(t *testing.T) {
+	uid := accountdomain.NewUserID()
+	mid := id.NewModelID()
+	s, _ := request.NewStage("legal", accountdomain.UserIDList{uid}, 1)
+	w := request.NewWorkflow().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).
+		Model(&mid).Stages(request.StageList{s}).MustBuild()
+
+	assert.Equal(t, &RequestWorkflow{
+		ID:        IDFrom(w.ID()),
+		ProjectID: IDFrom(w.Project()),
+		ModelID:   IDFromRef(&mid),
+		Stages: []*RequestStage{{
+			Name:              "legal",
+			ReviewersID:       []ID{IDFrom(uid)},
+			RequiredApprovals: 1,
+		}},
+		UpdatedAt: w.UpdatedAt(),
+	}, ToRequestWorkflow(w))
+	assert.Nil(t, ToRequestWorkflow(nil))
+}
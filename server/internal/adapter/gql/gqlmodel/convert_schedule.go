@@ -0,0 +1,83 @@
+package gqlmodel
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/samber/lo"
+)
+
+func ToSchedule(s *schedule.Schedule) *Schedule {
+	if s == nil {
+		return nil
+	}
+
+	var errStr *string
+	if e := s.Error(); e != "" {
+		errStr = &e
+	}
+
+	return &Schedule{
+		ID:            IDFrom(s.ID()),
+		ProjectID:     IDFrom(s.Project()),
+		ModelID:       IDFrom(s.Model()),
+		ItemIds:       lo.Map(s.Items(), func(i id.ItemID, _ int) ID { return IDFrom(i) }),
+		Action:        ToScheduleAction(s.Action()),
+		Status:        ToScheduleStatus(s.Status()),
+		ScheduledAt:   s.ScheduledAt(),
+		UserID:        IDFromRef(s.User()),
+		IntegrationID: IDFromRef(s.Integration()),
+		Error:         errStr,
+		CreatedAt:     s.CreatedAt(),
+		UpdatedAt:     s.UpdatedAt(),
+		ExecutedAt:    s.ExecutedAt(),
+	}
+}
+
+func ToScheduleAction(a schedule.Action) ScheduleAction {
+	switch a {
+	case schedule.ActionUnpublish:
+		return ScheduleActionUnpublish
+	default:
+		return ScheduleActionPublish
+	}
+}
+
+func ToScheduleStatus(s schedule.Status) ScheduleStatus {
+	switch s {
+	case schedule.StatusCompleted:
+		return ScheduleStatusCompleted
+	case schedule.StatusFailed:
+		return ScheduleStatusFailed
+	case schedule.StatusCancelled:
+		return ScheduleStatusCancelled
+	default:
+		return ScheduleStatusPending
+	}
+}
+
+func FromScheduleAction(a ScheduleAction) schedule.Action {
+	switch a {
+	case ScheduleActionUnpublish:
+		return schedule.ActionUnpublish
+	default:
+		return schedule.ActionPublish
+	}
+}
+
+func FromScheduleStatus(s *ScheduleStatus) *schedule.Status {
+	if s == nil {
+		return nil
+	}
+	var ss schedule.Status
+	switch *s {
+	case ScheduleStatusCompleted:
+		ss = schedule.StatusCompleted
+	case ScheduleStatusFailed:
+		ss = schedule.StatusFailed
+	case ScheduleStatusCancelled:
+		ss = schedule.StatusCancelled
+	default:
+		ss = schedule.StatusPending
+	}
+	return &ss
+}
@@ -0,0 +1,75 @@
+package gqlmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToSchedule(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, ToSchedule(nil))
+
+	uid := accountdomain.NewUserID()
+	iid := id.NewItemID()
+	at := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+	s := schedule.New().
+		NewID().
+		Project(id.NewProjectID()).
+		Model(id.NewModelID()).
+		Items(id.ItemIDList{iid}).
+		Action(schedule.ActionUnpublish).
+		ScheduledAt(at).
+		User(uid).
+		MustBuild()
+	s.Fail("item not found")
+
+	assert.Equal(t, &Schedule{
+		ID:          IDFrom(s.ID()),
+		ProjectID:   IDFrom(s.Project()),
+		ModelID:     IDFrom(s.Model()),
+		ItemIds:     []ID{IDFrom(iid)},
+		Action:      ScheduleActionUnpublish,
+		Status:      ScheduleStatusFailed,
+		ScheduledAt: at,
+		UserID:      IDFromRef(&uid),
+		Error:       lo.ToPtr("item not found"),
+		CreatedAt:   s.CreatedAt(),
+		UpdatedAt:   s.UpdatedAt(),
+		ExecutedAt:  s.ExecutedAt(),
+	}, ToSchedule(s))
+}
+
+func TestToScheduleStatus(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, ScheduleStatusPending, ToScheduleStatus(schedule.StatusPending))
+	assert.Equal(t, ScheduleStatusCompleted, ToScheduleStatus(schedule.StatusCompleted))
+	assert.Equal(t, ScheduleStatusFailed, ToScheduleStatus(schedule.StatusFailed))
+	assert.Equal(t, ScheduleStatusCancelled, ToScheduleStatus(schedule.StatusCancelled))
+}
+
+func TestScheduleAction(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, ScheduleActionPublish, ToScheduleAction(schedule.ActionPublish))
+	assert.Equal(t, ScheduleActionUnpublish, ToScheduleAction(schedule.ActionUnpublish))
+	assert.Equal(t, schedule.ActionPublish, FromScheduleAction(ScheduleActionPublish))
+	assert.Equal(t, schedule.ActionUnpublish, FromScheduleAction(ScheduleActionUnpublish))
+}
+
+func TestFromScheduleStatus(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, FromScheduleStatus(nil))
+	assert.Equal(t, lo.ToPtr(schedule.StatusPending), FromScheduleStatus(lo.ToPtr(ScheduleStatusPending)))
+	assert.Equal(t, lo.ToPtr(schedule.StatusCompleted), FromScheduleStatus(lo.ToPtr(ScheduleStatusCompleted)))
+	assert.Equal(t, lo.ToPtr(schedule.StatusFailed), FromScheduleStatus(lo.ToPtr(ScheduleStatusFailed)))
+	assert.Equal(t, lo.ToPtr(schedule.StatusCancelled), FromScheduleStatus(lo.ToPtr(ScheduleStatusCancelled)))
+}
@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/value"
@@ -24,6 +25,7 @@ func ToSchema(s *schema.Schema) *Schema {
 			return ToSchemaField(sf, s.TitleField())
 		}),
 		TitleFieldID: IDFromRef(s.TitleField()),
+		Rules:        lo.Map(s.Rules(), func(r *schema.Rule, _ int) *SchemaRule { return ToSchemaRule(r) }),
 	}
 }
 
@@ -44,6 +46,7 @@ func ToSchemaField(sf *schema.Field, titleField *id.FieldID) *SchemaField {
 		Unique:       sf.Unique(),
 		Required:     sf.Required(),
 		IsTitle:      lo.FromPtr(titleField) == sf.ID(),
+		Localizable:  sf.Localizable(),
 		CreatedAt:    sf.CreatedAt(),
 		UpdatedAt:    sf.UpdatedAt(),
 	}
@@ -162,24 +165,28 @@ func ToSchemaFieldTypeProperty(tp *schema.TypeProperty, dv *value.Multiple, mult
 			res = &SchemaFieldText{
 				DefaultValue: valueString(dv, multiple),
 				MaxLength:    f.MaxLength(),
+				Validation:   ToSchemaFieldTextValidation(f.Validation()),
 			}
 		},
 		TextArea: func(f *schema.FieldTextArea) {
 			res = &SchemaFieldTextArea{
 				DefaultValue: valueString(dv, multiple),
 				MaxLength:    f.MaxLength(),
+				Validation:   ToSchemaFieldTextValidation(f.Validation()),
 			}
 		},
 		RichText: func(f *schema.FieldRichText) {
 			res = &SchemaFieldRichText{
 				DefaultValue: valueString(dv, multiple),
 				MaxLength:    f.MaxLength(),
+				Validation:   ToSchemaFieldTextValidation(f.Validation()),
 			}
 		},
 		Markdown: func(f *schema.FieldMarkdown) {
 			res = &SchemaFieldMarkdown{
 				DefaultValue: valueString(dv, multiple),
 				MaxLength:    f.MaxLength(),
+				Validation:   ToSchemaFieldTextValidation(f.Validation()),
 			}
 		},
 		Select: func(f *schema.FieldSelect) {
@@ -391,7 +398,11 @@ func FromSchemaTypeProperty(tp *SchemaFieldTypePropertyInput, t SchemaFieldType,
 		} else {
 			dv = FromValue(SchemaFieldTypeText, x.DefaultValue).AsMultiple()
 		}
-		tpRes = schema.NewText(x.MaxLength).TypeProperty()
+		tps := schema.NewText(x.MaxLength)
+		if err := setTextValidation(tps, x.Validation); err != nil {
+			return nil, nil, err
+		}
+		tpRes = tps.TypeProperty()
 	case SchemaFieldTypeTextArea:
 		x := tp.TextArea
 		if x == nil {
@@ -402,7 +413,11 @@ func FromSchemaTypeProperty(tp *SchemaFieldTypePropertyInput, t SchemaFieldType,
 		} else {
 			dv = FromValue(SchemaFieldTypeTextArea, x.DefaultValue).AsMultiple()
 		}
-		tpRes = schema.NewTextArea(x.MaxLength).TypeProperty()
+		tps := schema.NewTextArea(x.MaxLength)
+		if err := setTextValidation(tps, x.Validation); err != nil {
+			return nil, nil, err
+		}
+		tpRes = tps.TypeProperty()
 	case SchemaFieldTypeRichText:
 		x := tp.RichText
 		if x == nil {
@@ -413,7 +428,11 @@ func FromSchemaTypeProperty(tp *SchemaFieldTypePropertyInput, t SchemaFieldType,
 		} else {
 			dv = FromValue(SchemaFieldTypeRichText, x.DefaultValue).AsMultiple()
 		}
-		tpRes = schema.NewRichText(x.MaxLength).TypeProperty()
+		tps := schema.NewRichText(x.MaxLength)
+		if err := setTextValidation(tps, x.Validation); err != nil {
+			return nil, nil, err
+		}
+		tpRes = tps.TypeProperty()
 	case SchemaFieldTypeMarkdownText:
 		x := tp.MarkdownText
 		if x == nil {
@@ -424,7 +443,11 @@ func FromSchemaTypeProperty(tp *SchemaFieldTypePropertyInput, t SchemaFieldType,
 		} else {
 			dv = FromValue(SchemaFieldTypeMarkdownText, x.DefaultValue).AsMultiple()
 		}
-		tpRes = schema.NewMarkdown(x.MaxLength).TypeProperty()
+		tps := schema.NewMarkdown(x.MaxLength)
+		if err := setTextValidation(tps, x.Validation); err != nil {
+			return nil, nil, err
+		}
+		tpRes = tps.TypeProperty()
 	case SchemaFieldTypeAsset:
 		x := tp.Asset
 		if x == nil {
@@ -679,3 +702,148 @@ func intPtr(v *int64) *int {
 	}
 	return new((int)(*v))
 }
+
+func ToSchemaFieldTextValidation(v *schema.TextValidation) *SchemaFieldTextValidation {
+	if v.IsEmpty() {
+		return nil
+	}
+	return &SchemaFieldTextValidation{
+		MinLength:      v.MinLength(),
+		Pattern:        lo.EmptyableToPtr(v.Pattern()),
+		PatternMessage: lo.EmptyableToPtr(v.PatternMessage()),
+		ForbiddenWords: lo.CoalesceSliceOrEmpty(v.ForbiddenWords()),
+		Preset:         ToSchemaFieldTextPreset(v.Preset()),
+	}
+}
+
+func ToSchemaFieldTextPreset(p schema.TextPreset) *SchemaFieldTextPreset {
+	switch p {
+	case schema.TextPresetEmail:
+		return new(SchemaFieldTextPresetEmail)
+	case schema.TextPresetPhone:
+		return new(SchemaFieldTextPresetPhone)
+	case schema.TextPresetPostalCode:
+		return new(SchemaFieldTextPresetPostalCode)
+	}
+	return nil
+}
+
+func FromSchemaFieldTextPreset(p *SchemaFieldTextPreset) schema.TextPreset {
+	if p == nil {
+		return ""
+	}
+	switch *p {
+	case SchemaFieldTextPresetEmail:
+		return schema.TextPresetEmail
+	case SchemaFieldTextPresetPhone:
+		return schema.TextPresetPhone
+	case SchemaFieldTextPresetPostalCode:
+		return schema.TextPresetPostalCode
+	}
+	return ""
+}
+
+func FromSchemaFieldTextValidation(v *SchemaFieldTextValidationInput) (*schema.TextValidation, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return schema.NewTextValidation(
+		v.MinLength,
+		lo.FromPtr(v.Pattern),
+		lo.FromPtr(v.PatternMessage),
+		v.ForbiddenWords,
+		FromSchemaFieldTextPreset(v.Preset),
+	)
+}
+
+func setTextValidation(f interface {
+	SetValidation(*schema.TextValidation) error
+}, v *SchemaFieldTextValidationInput) error {
+	tv, err := FromSchemaFieldTextValidation(v)
+	if err != nil {
+		return err
+	}
+	return f.SetValidation(tv)
+}
+
+func ToSchemaRule(r *schema.Rule) *SchemaRule {
+	if r == nil {
+		return nil
+	}
+
+	res := &SchemaRule{
+		Type:         SchemaRuleType(strings.ToUpper(string(r.Type()))),
+		FieldID:      IDFrom(r.Field()),
+		OtherFieldID: IDFromRef(r.Other()),
+		Message:      lo.EmptyableToPtr(r.Message()),
+	}
+	if r.Operator() != "" {
+		res.Operator = new(SchemaRuleCompareOperator(strings.ToUpper(string(r.Operator()))))
+	}
+	if c := r.Condition(); c != nil {
+		res.Condition = &SchemaRuleCondition{
+			FieldID:  IDFrom(c.Field()),
+			Operator: SchemaRuleConditionOperator(strings.ToUpper(string(c.Operator()))),
+		}
+		if v := c.Value(); v != nil {
+			res.Condition.Value = v.Interface()
+		}
+	}
+	return res
+}
+
+// ToSchemaRuleParam converts the input whose enum values are the upper case of the ones of the schema package.
+func ToSchemaRuleParam(r *SchemaRuleInput) (interfaces.SchemaRuleParam, error) {
+	if r == nil {
+		return interfaces.SchemaRuleParam{}, schema.ErrInvalidRule
+	}
+
+	t, ok := schema.RuleTypeFrom(strings.ToLower(string(r.Type)))
+	if !ok {
+		return interfaces.SchemaRuleParam{}, schema.ErrInvalidRule
+	}
+	fid, err := ToID[id.Field](r.FieldID)
+	if err != nil {
+		return interfaces.SchemaRuleParam{}, err
+	}
+
+	res := interfaces.SchemaRuleParam{
+		Type:    t,
+		FieldID: fid,
+		Message: lo.FromPtr(r.Message),
+	}
+
+	if r.Condition != nil {
+		cfid, err := ToID[id.Field](r.Condition.FieldID)
+		if err != nil {
+			return interfaces.SchemaRuleParam{}, err
+		}
+		op, ok := schema.ConditionOperatorFrom(strings.ToLower(string(r.Condition.Operator)))
+		if !ok {
+			return interfaces.SchemaRuleParam{}, schema.ErrInvalidRule
+		}
+		res.Condition = &interfaces.SchemaRuleConditionParam{
+			FieldID:  cfid,
+			Operator: op,
+			Value:    r.Condition.Value,
+		}
+	}
+
+	if r.Operator != nil {
+		op, ok := schema.CompareOperatorFrom(strings.ToLower(string(*r.Operator)))
+		if !ok {
+			return interfaces.SchemaRuleParam{}, schema.ErrInvalidRule
+		}
+		res.Operator = op
+	}
+
+	if r.OtherFieldID != nil {
+		ofid, err := ToID[id.Field](*r.OtherFieldID)
+		if err != nil {
+			return interfaces.SchemaRuleParam{}, err
+		}
+		res.OtherID = &ofid
+	}
+
+	return res, nil
+}
@@ -3,6 +3,7 @@ package gqlmodel
 import (
 	"testing"
 
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/value"
@@ -34,6 +35,7 @@ func TestToSchema(t *testing.T) {
 				ID:        IDFrom(sId),
 				ProjectID: IDFrom(pId),
 				Fields:    []*SchemaField{},
+				Rules:     []*SchemaRule{},
 			},
 		},
 		{
@@ -57,6 +59,7 @@ func TestToSchema(t *testing.T) {
 					CreatedAt: fId.Timestamp(),
 					UpdatedAt: fId.Timestamp(),
 				}},
+				Rules: []*SchemaRule{},
 			},
 		},
 	}
@@ -93,6 +96,7 @@ func TestToSchemaField(t *testing.T) {
 				Unique(true).
 				Multiple(true).
 				Required(true).
+				Localizable(true).
 				MustBuild(),
 			want: &SchemaField{
 				ID:           IDFrom(fid),
@@ -108,6 +112,7 @@ func TestToSchemaField(t *testing.T) {
 				Order:        new(0),
 				Required:     true,
 				IsTitle:      true,
+				Localizable:  true,
 				CreatedAt:    fid.Timestamp(),
 				UpdatedAt:    fid.Timestamp(),
 			},
@@ -591,3 +596,110 @@ func TestFromGeometryEditorSupportedType(t *testing.T) {
 		})
 	}
 }
+
+func TestSchemaFieldTextValidation(t *testing.T) {
+	t.Parallel()
+
+	inp := &SchemaFieldTypePropertyInput{
+		Text: &SchemaFieldTextInput{
+			MaxLength: new(10),
+			Validation: &SchemaFieldTextValidationInput{
+				MinLength:      new(2),
+				Pattern:        new("^[a-z@.]+$"),
+				PatternMessage: new("lowercase only"),
+				ForbiddenWords: []string{"spam"},
+				Preset:         new(SchemaFieldTextPresetEmail),
+			},
+		},
+	}
+	tp, _, err := FromSchemaTypeProperty(inp, SchemaFieldTypeText, false)
+	assert.NoError(t, err)
+
+	got := ToSchemaFieldTypeProperty(tp, nil, false)
+	assert.Equal(t, &SchemaFieldText{
+		MaxLength: new(10),
+		Validation: &SchemaFieldTextValidation{
+			MinLength:      new(2),
+			Pattern:        new("^[a-z@.]+$"),
+			PatternMessage: new("lowercase only"),
+			ForbiddenWords: []string{"spam"},
+			Preset:         new(SchemaFieldTextPresetEmail),
+		},
+	}, got)
+
+	// min length larger than max length
+	inp.Text.Validation.MinLength = new(11)
+	_, _, err = FromSchemaTypeProperty(inp, SchemaFieldTypeText, false)
+	assert.Equal(t, schema.ErrInvalidMinMax, err)
+
+	inp.Text.Validation.MinLength = nil
+	inp.Text.Validation.Pattern = new("(")
+	_, _, err = FromSchemaTypeProperty(inp, SchemaFieldTypeText, false)
+	assert.Equal(t, schema.ErrInvalidPattern, err)
+
+	assert.Nil(t, ToSchemaFieldTextValidation(nil))
+}
+
+func TestToSchemaRule(t *testing.T) {
+	fid1, fid2 := id.NewFieldID(), id.NewFieldID()
+	c := lo.Must(schema.NewCondition(fid2, schema.ConditionOperatorEquals, value.TypeText.Value("a")))
+
+	assert.Nil(t, ToSchemaRule(nil))
+	assert.Equal(t, &SchemaRule{
+		Type:    SchemaRuleTypeRequiredIf,
+		FieldID: IDFrom(fid1),
+		Condition: &SchemaRuleCondition{
+			FieldID:  IDFrom(fid2),
+			Operator: SchemaRuleConditionOperatorEq,
+			Value:    "a",
+		},
+	}, ToSchemaRule(schema.NewRequiredIfRule(fid1, c, "")))
+	assert.Equal(t, &SchemaRule{
+		Type:         SchemaRuleTypeCompare,
+		FieldID:      IDFrom(fid1),
+		Operator:     new(SchemaRuleCompareOperatorLte),
+		OtherFieldID: new(IDFrom(fid2)),
+		Message:      new("too large"),
+	}, ToSchemaRule(schema.NewCompareRule(fid1, schema.CompareOperatorLessThanOrEqual, fid2, "too large")))
+}
+
+func TestToSchemaRuleParam(t *testing.T) {
+	fid1, fid2 := id.NewFieldID(), id.NewFieldID()
+
+	got, err := ToSchemaRuleParam(&SchemaRuleInput{
+		Type:    SchemaRuleTypeVisibleIf,
+		FieldID: IDFrom(fid1),
+		Condition: &SchemaRuleConditionInput{
+			FieldID:  IDFrom(fid2),
+			Operator: SchemaRuleConditionOperatorNotEmpty,
+		},
+		Message: new("msg"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, interfaces.SchemaRuleParam{
+		Type:    schema.RuleTypeVisibleIf,
+		FieldID: fid1,
+		Condition: &interfaces.SchemaRuleConditionParam{
+			FieldID:  fid2,
+			Operator: schema.ConditionOperatorNotEmpty,
+		},
+		Message: "msg",
+	}, got)
+
+	got, err = ToSchemaRuleParam(&SchemaRuleInput{
+		Type:         SchemaRuleTypeCompare,
+		FieldID:      IDFrom(fid1),
+		Operator:     new(SchemaRuleCompareOperatorGt),
+		OtherFieldID: new(IDFrom(fid2)),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, interfaces.SchemaRuleParam{
+		Type:     schema.RuleTypeCompare,
+		FieldID:  fid1,
+		Operator: schema.CompareOperatorGreaterThan,
+		OtherID:  &fid2,
+	}, got)
+
+	_, err = ToSchemaRuleParam(&SchemaRuleInput{Type: "UNIQUE", FieldID: IDFrom(fid1)})
+	assert.Equal(t, schema.ErrInvalidRule, err)
+}
@@ -2,6 +2,7 @@ package gqlmodel
 
 import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/operator"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
 	"github.com/samber/lo"
 )
@@ -11,11 +12,18 @@ func ToThread(th *thread.Thread) *Thread {
 		return nil
 	}
 
-	return &Thread{
+	res := &Thread{
 		ID:          IDFrom(th.ID()),
 		WorkspaceID: IDFrom(th.Workspace()),
 		Comments:    lo.Map(th.Comments(), func(c *thread.Comment, _ int) *Comment { return ToComment(c, th) }),
+		Resolved:    th.IsResolved(),
+		ResolvedAt:  th.ResolvedAt(),
 	}
+	if by := th.ResolvedBy(); by != nil {
+		byID, byType := toOperatorIDAndType(*by)
+		res.ResolvedByID, res.ResolvedByType = &byID, &byType
+	}
+	return res
 }
 
 func ToComment(c *thread.Comment, th *thread.Thread) *Comment {
@@ -23,16 +31,7 @@ func ToComment(c *thread.Comment, th *thread.Thread) *Comment {
 		return nil
 	}
 
-	var authorID ID
-	var authorType OperatorType
-	if c.Author().User() != nil {
-		authorID = IDFrom(*c.Author().User())
-		authorType = OperatorTypeUser
-	}
-	if c.Author().Integration() != nil {
-		authorID = IDFrom(*c.Author().Integration())
-		authorType = OperatorTypeIntegration
-	}
+	authorID, authorType := toOperatorIDAndType(c.Author())
 
 	return &Comment{
 		ID:          IDFrom(c.ID()),
@@ -42,7 +41,34 @@ func ToComment(c *thread.Comment, th *thread.Thread) *Comment {
 		AuthorType:  authorType,
 		Content:     c.Content(),
 		CreatedAt:   c.CreatedAt(),
+		ReplyToID:   IDFromRef(c.ReplyTo()),
+		Mentions: lo.Map(c.Mentions(), func(m *thread.Mention, _ int) *CommentMention {
+			targetID, targetType := toOperatorIDAndType(m.Target())
+			return &CommentMention{
+				Name:       m.Name(),
+				TargetType: targetType,
+				TargetID:   targetID,
+			}
+		}),
+		Reactions: lo.Map(c.Reactions(), func(r *thread.Reaction, _ int) *CommentReaction {
+			authorID, authorType := toOperatorIDAndType(r.Author())
+			return &CommentReaction{
+				Emoji:      r.Emoji(),
+				AuthorType: authorType,
+				AuthorID:   authorID,
+			}
+		}),
+	}
+}
+
+func toOperatorIDAndType(op operator.Operator) (ID, OperatorType) {
+	if op.User() != nil {
+		return IDFrom(*op.User()), OperatorTypeUser
+	}
+	if op.Integration() != nil {
+		return IDFrom(*op.Integration()), OperatorTypeIntegration
 	}
+	return "", ""
 }
 
 func FromResourceType(p ResourceType) (interfaces.ResourceType, bool) {
@@ -2,6 +2,7 @@ package gqlmodel
 
 import (
 	"testing"
+	"time"
 
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/id"
@@ -25,6 +26,14 @@ func TestConvertThread_ToThread(t *testing.T) {
 	got1 := ToThread(th1)
 	assert.Equal(t, &want1, got1)
 
+	now, by := time.Now(), operator.OperatorFromUser(accountdomain.NewUserID())
+	th3 := thread.New().ID(id1).Workspace(wid1).Resolved(&by, &now).MustBuild()
+	got3 := ToThread(th3)
+	assert.True(t, got3.Resolved)
+	assert.Equal(t, &now, got3.ResolvedAt)
+	assert.Equal(t, new(ID(by.User().String())), got3.ResolvedByID)
+	assert.Equal(t, new(OperatorTypeUser), got3.ResolvedByType)
+
 	var th2 *thread.Thread = nil
 	want2 := (*Thread)(nil)
 	got2 := ToThread(th2)
@@ -47,11 +56,25 @@ func TestConvertThread_ToComment(t *testing.T) {
 		AuthorType:  OperatorTypeUser,
 		Content:     c1,
 		CreatedAt:   cid1.Timestamp(),
+		Mentions:    []*CommentMention{},
+		Reactions:   []*CommentReaction{},
 	}
 
 	got1 := ToComment(comment1, th)
 	assert.Equal(t, &want1, got1)
 
+	iid := id.NewIntegrationID()
+	reply := thread.NewComment(id.NewCommentID(), operator.OperatorFromIntegration(iid), "@alice")
+	reply.SetReplyTo(&cid1)
+	reply.SetMentions(thread.MentionList{thread.NewMention("alice", operator.OperatorFromUser(uid1))})
+	reply.SetReactions(thread.ReactionList{lo.Must(thread.NewReaction("+1", operator.OperatorFromUser(uid1)))})
+	got3 := ToComment(reply, th)
+	assert.Equal(t, ID(iid.String()), got3.AuthorID)
+	assert.Equal(t, OperatorTypeIntegration, got3.AuthorType)
+	assert.Equal(t, new(ID(cid1.String())), got3.ReplyToID)
+	assert.Equal(t, []*CommentMention{{Name: "alice", TargetType: OperatorTypeUser, TargetID: ID(uid1.String())}}, got3.Mentions)
+	assert.Equal(t, []*CommentReaction{{Emoji: "+1", AuthorType: OperatorTypeUser, AuthorID: ID(uid1.String())}}, got3.Reactions)
+
 	var comment2 *thread.Comment = nil
 	want2 := (*Comment)(nil)
 	got2 := ToComment(comment2, th)
@@ -0,0 +1,20 @@
+package gqlmodel
+
+import (
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+)
+
+func ToTrashedItem(ti interfaces.TrashedItem, s *schema.Schema, gsList schema.List) *TrashedItem {
+	if ti.Entry == nil {
+		return nil
+	}
+
+	return &TrashedItem{
+		Item:          ToItem(ti.Item, s, gsList),
+		TrashedAt:     ti.Entry.TrashedAt(),
+		ExpiresAt:     ti.ExpiresAt,
+		UserID:        IDFromRef(ti.Entry.User()),
+		IntegrationID: IDFromRef(ti.Entry.Integration()),
+	}
+}
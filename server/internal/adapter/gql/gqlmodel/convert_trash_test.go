@@ -0,0 +1,36 @@
+package gqlmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToTrashedItem(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, ToTrashedItem(interfaces.TrashedItem{}, nil, nil))
+
+	uid := accountdomain.NewUserID()
+	s := schema.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).MustBuild()
+	i := item.New().NewID().Schema(s.ID()).Model(id.NewModelID()).Project(s.Project()).User(uid).Thread(id.NewThreadID().Ref()).MustBuild()
+	vi := version.MustBeValue(version.New(), nil, version.NewRefs(version.Trash), time.Now(), i)
+	trashedAt := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+	expiresAt := trashedAt.Add(24 * time.Hour)
+	e := trash.New().Item(i.ID()).Project(i.Project()).Model(i.Model()).User(uid).TrashedAt(trashedAt).MustBuild()
+
+	assert.Equal(t, &TrashedItem{
+		Item:      ToItem(vi, s, nil),
+		TrashedAt: trashedAt,
+		ExpiresAt: &expiresAt,
+		UserID:    IDFromRef(&uid),
+	}, ToTrashedItem(interfaces.TrashedItem{Item: vi, Entry: e, ExpiresAt: &expiresAt}, s, nil))
+}
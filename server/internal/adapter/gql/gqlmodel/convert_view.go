@@ -141,6 +141,19 @@ func ToFilter(i *view.Condition) Condition {
 			Value:    i.TimeCondition.Value,
 		}
 	}
+	if i.SpatialCondition != nil {
+		c := SpatialFieldCondition{
+			FieldID:  ToFieldSelector(i.SpatialCondition.Field),
+			Operator: SpatialOperator(i.SpatialCondition.Op),
+			Bbox:     i.SpatialCondition.BBox,
+			Polygon:  i.SpatialCondition.Polygon,
+			Point:    i.SpatialCondition.Point,
+		}
+		if i.SpatialCondition.Op == view.SpatialOperatorNear {
+			c.Radius = new(i.SpatialCondition.Radius)
+		}
+		return c
+	}
 
 	if i.AndCondition != nil {
 		return AndCondition{
@@ -267,6 +280,19 @@ func (i *ConditionInput) Into() *view.Condition {
 			},
 		}
 	}
+	if i.Spatial != nil {
+		return &view.Condition{
+			ConditionType: view.ConditionTypeSpatial,
+			SpatialCondition: &view.SpatialCondition{
+				Field:   i.Spatial.FieldID.Into(),
+				Op:      i.Spatial.Operator.Into(),
+				BBox:    i.Spatial.Bbox,
+				Polygon: i.Spatial.Polygon,
+				Point:   i.Spatial.Point,
+				Radius:  lo.FromPtr(i.Spatial.Radius),
+			},
+		}
+	}
 	if i.Nullable != nil {
 		return &view.Condition{
 			ConditionType: view.ConditionTypeNullable,
@@ -387,6 +413,19 @@ func (e TimeOperator) Into() view.TimeOperator {
 	}
 }
 
+func (e SpatialOperator) Into() view.SpatialOperator {
+	switch e {
+	case SpatialOperatorBbox:
+		return view.SpatialOperatorBBox
+	case SpatialOperatorIntersects:
+		return view.SpatialOperatorIntersects
+	case SpatialOperatorNear:
+		return view.SpatialOperatorNear
+	default:
+		return ""
+	}
+}
+
 func (e NullableOperator) Into() view.NullableOperator {
 	switch e {
 	case NullableOperatorEmpty:
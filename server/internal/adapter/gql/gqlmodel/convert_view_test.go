@@ -3,6 +3,7 @@ package gqlmodel
 import (
 	"testing"
 
+	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item/view"
 	"github.com/reearth/reearth-cms/server/pkg/model"
 	"github.com/reearth/reearth-cms/server/pkg/project"
@@ -44,3 +45,32 @@ func TestToView(t *testing.T) {
 		})
 	}
 }
+
+func TestConditionInput_Into_Spatial(t *testing.T) {
+	fId := id.NewFieldID()
+	in := &ConditionInput{
+		Spatial: &SpatialFieldConditionInput{
+			FieldID:  &FieldSelectorInput{ID: IDFromRef(fId.Ref()), Type: FieldTypeField},
+			Operator: SpatialOperatorNear,
+			Point:    []float64{139.767, 35.681},
+			Radius:   new(500.0),
+		},
+	}
+	want := &view.Condition{
+		ConditionType: view.ConditionTypeSpatial,
+		SpatialCondition: &view.SpatialCondition{
+			Field:  view.FieldSelector{Type: view.FieldTypeField, ID: fId.Ref()},
+			Op:     view.SpatialOperatorNear,
+			Point:  []float64{139.767, 35.681},
+			Radius: 500,
+		},
+	}
+	assert.Equal(t, want, in.Into())
+
+	assert.Equal(t, SpatialFieldCondition{
+		FieldID:  &FieldSelector{Type: FieldTypeField, ID: IDFromRef(fId.Ref())},
+		Operator: SpatialOperatorNear,
+		Point:    []float64{139.767, 35.681},
+		Radius:   new(500.0),
+	}, ToFilter(want))
+}
@@ -46,8 +46,15 @@ type APIKeyPayload struct {
 }
 
 type AddCommentInput struct {
-	ThreadID ID     `json:"threadId"`
-	Content  string `json:"content"`
+	ThreadID  ID     `json:"threadId"`
+	Content   string `json:"content"`
+	ReplyToID *ID    `json:"replyToId,omitempty"`
+}
+
+type AddCommentReactionInput struct {
+	ThreadID  ID     `json:"threadId"`
+	CommentID ID     `json:"commentId"`
+	Emoji     string `json:"emoji"`
 }
 
 type AddIntegrationToWorkspaceInput struct {
@@ -95,10 +102,15 @@ type Asset struct {
 	Thread                  *Thread                  `json:"thread,omitempty"`
 	ThreadID                *ID                      `json:"threadId,omitempty"`
 	URL                     string                   `json:"url"`
+	ThumbnailURL            *string                  `json:"thumbnailUrl,omitempty"`
 	FileName                string                   `json:"fileName"`
 	ArchiveExtractionStatus *ArchiveExtractionStatus `json:"archiveExtractionStatus,omitempty"`
 	Public                  bool                     `json:"public"`
 	ContentType             *string                  `json:"contentType,omitempty"`
+	FolderID                *ID                      `json:"folderId,omitempty"`
+	Folder                  *AssetFolder             `json:"folder,omitempty"`
+	Metadata                []*AssetMetadataField    `json:"metadata"`
+	Versions                []*AssetVersion          `json:"versions"`
 }
 
 func (Asset) IsNode()        {}
@@ -125,15 +137,49 @@ type AssetFile struct {
 	FilePaths       []string `json:"filePaths,omitempty"`
 }
 
+type AssetFolder struct {
+	ID                  ID                     `json:"id"`
+	ProjectID           ID                     `json:"projectId"`
+	ParentID            *ID                    `json:"parentId,omitempty"`
+	Name                string                 `json:"name"`
+	Path                string                 `json:"path"`
+	Permission          *AssetFolderPermission `json:"permission,omitempty"`
+	EffectivePermission AssetFolderPermission  `json:"effectivePermission"`
+	CreatedAt           time.Time              `json:"createdAt"`
+	UpdatedAt           time.Time              `json:"updatedAt"`
+}
+
+func (AssetFolder) IsNode()        {}
+func (this AssetFolder) GetID() ID { return this.ID }
+
+type AssetFolderPayload struct {
+	Folder *AssetFolder `json:"folder"`
+}
+
 type AssetItem struct {
 	ItemID  ID `json:"itemId"`
 	ModelID ID `json:"modelId"`
 }
 
+type AssetMetadataField struct {
+	SchemaFieldID ID              `json:"schemaFieldId"`
+	Type          SchemaFieldType `json:"type"`
+	Values        []any           `json:"values"`
+}
+
+type AssetMetadataFieldInput struct {
+	SchemaFieldID ID  `json:"schemaFieldId"`
+	Value         any `json:"value,omitempty"`
+}
+
 type AssetQueryInput struct {
-	Project      ID                 `json:"project"`
-	Keyword      *string            `json:"keyword,omitempty"`
-	ContentTypes []ContentTypesEnum `json:"contentTypes,omitempty"`
+	Project      ID                         `json:"project"`
+	Keyword      *string                    `json:"keyword,omitempty"`
+	ContentTypes []ContentTypesEnum         `json:"contentTypes,omitempty"`
+	FolderID     *ID                        `json:"folderId,omitempty"`
+	RootFolder   *bool                      `json:"rootFolder,omitempty"`
+	Recursive    *bool                      `json:"recursive,omitempty"`
+	Metadata     []*AssetMetadataFieldInput `json:"metadata,omitempty"`
 }
 
 type AssetSort struct {
@@ -141,6 +187,17 @@ type AssetSort struct {
 	Direction *SortDirection `json:"direction,omitempty"`
 }
 
+type AssetVersion struct {
+	UUID           string        `json:"uuid"`
+	FileName       string        `json:"fileName"`
+	Size           int64         `json:"size"`
+	ContentType    *string       `json:"contentType,omitempty"`
+	PreviewType    *PreviewType  `json:"previewType,omitempty"`
+	UploadedAt     time.Time     `json:"uploadedAt"`
+	UploadedByID   *ID           `json:"uploadedById,omitempty"`
+	UploadedByType *OperatorType `json:"uploadedByType,omitempty"`
+}
+
 type BasicFieldCondition struct {
 	FieldID  *FieldSelector `json:"fieldId"`
 	Operator BasicOperator  `json:"operator"`
@@ -169,6 +226,27 @@ type BoolFieldConditionInput struct {
 	Value    bool                `json:"value"`
 }
 
+type BulkUpdateItemsInput struct {
+	ModelID ID                `json:"modelId"`
+	Filter  *ConditionInput   `json:"filter,omitempty"`
+	Fields  []*ItemFieldInput `json:"fields"`
+}
+
+type BulkUpdateItemsPayload struct {
+	Job *Job `json:"job"`
+}
+
+type BulkUpdateJobResult struct {
+	TotalCount   int `json:"totalCount"`
+	UpdatedCount int `json:"updatedCount"`
+	SkippedCount int `json:"skippedCount"`
+	FailedCount  int `json:"failedCount"`
+}
+
+type CancelScheduleInput struct {
+	ScheduleID ID `json:"scheduleId"`
+}
+
 type CesiumResourceProps struct {
 	Name                 string `json:"name"`
 	URL                  string `json:"url"`
@@ -196,14 +274,23 @@ type ColumnSelectionInput struct {
 }
 
 type Comment struct {
-	ID          ID           `json:"id"`
-	ThreadID    ID           `json:"threadId"`
-	WorkspaceID ID           `json:"workspaceId"`
-	Author      Operator     `json:"author,omitempty"`
-	AuthorType  OperatorType `json:"authorType"`
-	AuthorID    ID           `json:"authorId"`
-	Content     string       `json:"content"`
-	CreatedAt   time.Time    `json:"createdAt"`
+	ID          ID                 `json:"id"`
+	ThreadID    ID                 `json:"threadId"`
+	WorkspaceID ID                 `json:"workspaceId"`
+	Author      Operator           `json:"author,omitempty"`
+	AuthorType  OperatorType       `json:"authorType"`
+	AuthorID    ID                 `json:"authorId"`
+	Content     string             `json:"content"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	ReplyToID   *ID                `json:"replyToId,omitempty"`
+	Mentions    []*CommentMention  `json:"mentions"`
+	Reactions   []*CommentReaction `json:"reactions"`
+}
+
+type CommentMention struct {
+	Name       string       `json:"name"`
+	TargetType OperatorType `json:"targetType"`
+	TargetID   ID           `json:"targetId"`
 }
 
 type CommentPayload struct {
@@ -211,6 +298,12 @@ type CommentPayload struct {
 	Comment *Comment `json:"comment"`
 }
 
+type CommentReaction struct {
+	Emoji      string       `json:"emoji"`
+	AuthorType OperatorType `json:"authorType"`
+	AuthorID   ID           `json:"authorId"`
+}
+
 type ConditionInput struct {
 	And      *AndConditionInput           `json:"and,omitempty"`
 	Or       *OrConditionInput            `json:"or,omitempty"`
@@ -221,6 +314,7 @@ type ConditionInput struct {
 	String   *StringFieldConditionInput   `json:"string,omitempty"`
 	Number   *NumberFieldConditionInput   `json:"number,omitempty"`
 	Time     *TimeFieldConditionInput     `json:"time,omitempty"`
+	Spatial  *SpatialFieldConditionInput  `json:"spatial,omitempty"`
 }
 
 type CorrespondingFieldInput struct {
@@ -238,12 +332,20 @@ type CreateAPIKeyInput struct {
 	Publication *UpdatePublicationSettingsInput `json:"publication"`
 }
 
+type CreateAssetFolderInput struct {
+	ProjectID  ID                     `json:"projectId"`
+	ParentID   *ID                    `json:"parentId,omitempty"`
+	Name       string                 `json:"name"`
+	Permission *AssetFolderPermission `json:"permission,omitempty"`
+}
+
 type CreateAssetInput struct {
 	ProjectID         ID              `json:"projectId"`
 	File              *graphql.Upload `json:"file,omitempty"`
 	URL               *string         `json:"url,omitempty"`
 	Token             *string         `json:"token,omitempty"`
 	SkipDecompression *bool           `json:"skipDecompression,omitempty"`
+	FolderID          *ID             `json:"folderId,omitempty"`
 	ContentEncoding   *string         `json:"contentEncoding,omitempty"`
 }
 
@@ -271,6 +373,7 @@ type CreateAssetUploadPayload struct {
 type CreateFieldInput struct {
 	ModelID      *ID                           `json:"modelId,omitempty"`
 	GroupID      *ID                           `json:"groupId,omitempty"`
+	ProjectID    *ID                           `json:"projectId,omitempty"`
 	Type         SchemaFieldType               `json:"type"`
 	Title        string                        `json:"title"`
 	Metadata     *bool                         `json:"metadata,omitempty"`
@@ -280,6 +383,7 @@ type CreateFieldInput struct {
 	Unique       bool                          `json:"unique"`
 	Required     bool                          `json:"required"`
 	IsTitle      bool                          `json:"isTitle"`
+	Localizable  *bool                         `json:"localizable,omitempty"`
 	TypeProperty *SchemaFieldTypePropertyInput `json:"typeProperty"`
 }
 
@@ -332,6 +436,12 @@ type CreateRequestInput struct {
 	Items       []*RequestItemInput `json:"items"`
 }
 
+type CreateScheduleInput struct {
+	ItemIds     []ID           `json:"itemIds"`
+	Action      ScheduleAction `json:"action"`
+	ScheduledAt time.Time      `json:"scheduledAt"`
+}
+
 type CreateThreadWithCommentInput struct {
 	WorkspaceID  ID           `json:"workspaceId"`
 	ResourceID   ID           `json:"resourceId"`
@@ -349,12 +459,14 @@ type CreateViewInput struct {
 }
 
 type CreateWebhookInput struct {
-	IntegrationID ID                   `json:"integrationId"`
-	Name          string               `json:"name"`
-	URL           url.URL              `json:"url"`
-	Active        bool                 `json:"active"`
-	Trigger       *WebhookTriggerInput `json:"trigger"`
-	Secret        string               `json:"secret"`
+	IntegrationID ID                        `json:"integrationId"`
+	Name          string                    `json:"name"`
+	URL           url.URL                   `json:"url"`
+	Active        bool                      `json:"active"`
+	Trigger       *WebhookTriggerInput      `json:"trigger"`
+	Filter        *WebhookFilterInput       `json:"filter,omitempty"`
+	Payload       *WebhookPayloadShapeInput `json:"payload,omitempty"`
+	Secret        string                    `json:"secret"`
 }
 
 type CreateWorkspaceInput struct {
@@ -382,6 +494,14 @@ type DeleteAPIKeyPayload struct {
 	APIKeyID ID `json:"apiKeyId"`
 }
 
+type DeleteAssetFolderInput struct {
+	FolderID ID `json:"folderId"`
+}
+
+type DeleteAssetFolderPayload struct {
+	FolderID ID `json:"folderId"`
+}
+
 type DeleteAssetInput struct {
 	AssetID ID `json:"assetId"`
 }
@@ -409,10 +529,11 @@ type DeleteCommentPayload struct {
 }
 
 type DeleteFieldInput struct {
-	ModelID  *ID   `json:"modelId,omitempty"`
-	GroupID  *ID   `json:"groupId,omitempty"`
-	FieldID  ID    `json:"fieldId"`
-	Metadata *bool `json:"metadata,omitempty"`
+	ModelID   *ID   `json:"modelId,omitempty"`
+	GroupID   *ID   `json:"groupId,omitempty"`
+	ProjectID *ID   `json:"projectId,omitempty"`
+	FieldID   ID    `json:"fieldId"`
+	Metadata  *bool `json:"metadata,omitempty"`
 }
 
 type DeleteFieldPayload struct {
@@ -492,6 +613,14 @@ type DeleteRequestPayload struct {
 	Requests []ID `json:"requests"`
 }
 
+type DeleteRequestWorkflowInput struct {
+	WorkflowID ID `json:"workflowId"`
+}
+
+type DeleteRequestWorkflowPayload struct {
+	WorkflowID ID `json:"workflowId"`
+}
+
 type DeleteViewInput struct {
 	ViewID ID `json:"viewId"`
 }
@@ -517,6 +646,28 @@ type DeleteWorkspacePayload struct {
 	WorkspaceID ID `json:"workspaceId"`
 }
 
+type DroppedField struct {
+	FieldID     ID                 `json:"fieldId"`
+	ItemGroupID *ID                `json:"itemGroupId,omitempty"`
+	Reason      DroppedFieldReason `json:"reason"`
+}
+
+type ExportJobResult struct {
+	URL        url.URL    `json:"url"`
+	TotalCount int        `json:"totalCount"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+}
+
+type ExportModelAsyncInput struct {
+	ModelID       ID           `json:"modelId"`
+	Format        ExportFormat `json:"format"`
+	IncludeAssets *bool        `json:"includeAssets,omitempty"`
+}
+
+type ExportModelAsyncPayload struct {
+	Job *Job `json:"job"`
+}
+
 type ExportModelInput struct {
 	ModelID ID           `json:"modelId"`
 	Format  ExportFormat `json:"format"`
@@ -687,17 +838,29 @@ type ItemEdge struct {
 }
 
 type ItemField struct {
-	SchemaFieldID ID              `json:"schemaFieldId"`
-	ItemGroupID   *ID             `json:"itemGroupId,omitempty"`
-	Type          SchemaFieldType `json:"type"`
-	Value         any             `json:"value,omitempty"`
+	SchemaFieldID ID                `json:"schemaFieldId"`
+	ItemGroupID   *ID               `json:"itemGroupId,omitempty"`
+	Type          SchemaFieldType   `json:"type"`
+	Value         any               `json:"value,omitempty"`
+	Locales       []*LocalizedValue `json:"locales,omitempty"`
+}
+
+type ItemFieldChange struct {
+	FieldID       ID                  `json:"fieldId"`
+	ItemGroupID   *ID                 `json:"itemGroupId,omitempty"`
+	Type          ItemFieldChangeType `json:"type"`
+	PreviousValue any                 `json:"previousValue,omitempty"`
+	CurrentValue  any                 `json:"currentValue,omitempty"`
+	AddedValues   []any               `json:"addedValues"`
+	RemovedValues []any               `json:"removedValues"`
 }
 
 type ItemFieldInput struct {
-	SchemaFieldID ID              `json:"schemaFieldId"`
-	ItemGroupID   *ID             `json:"itemGroupId,omitempty"`
-	Type          SchemaFieldType `json:"type"`
-	Value         any             `json:"value"`
+	SchemaFieldID ID                     `json:"schemaFieldId"`
+	ItemGroupID   *ID                    `json:"itemGroupId,omitempty"`
+	Type          SchemaFieldType        `json:"type"`
+	Value         any                    `json:"value"`
+	Locales       []*LocalizedValueInput `json:"locales,omitempty"`
 }
 
 type ItemPayload struct {
@@ -721,17 +884,25 @@ type ItemSortInput struct {
 	Direction *SortDirection      `json:"direction,omitempty"`
 }
 
+type ItemVersionDiff struct {
+	From    *VersionedItem     `json:"from,omitempty"`
+	To      *VersionedItem     `json:"to"`
+	Changes []*ItemFieldChange `json:"changes"`
+}
+
 type Job struct {
-	ID          ID           `json:"id"`
-	Type        JobType      `json:"type"`
-	ProjectID   ID           `json:"projectId"`
-	Status      JobStatus    `json:"status"`
-	Progress    *JobProgress `json:"progress"`
-	Error       *string      `json:"error,omitempty"`
-	CreatedAt   time.Time    `json:"createdAt"`
-	UpdatedAt   time.Time    `json:"updatedAt"`
-	StartedAt   *time.Time   `json:"startedAt,omitempty"`
-	CompletedAt *time.Time   `json:"completedAt,omitempty"`
+	ID               ID                   `json:"id"`
+	Type             JobType              `json:"type"`
+	ProjectID        ID                   `json:"projectId"`
+	Status           JobStatus            `json:"status"`
+	Progress         *JobProgress         `json:"progress"`
+	Error            *string              `json:"error,omitempty"`
+	CreatedAt        time.Time            `json:"createdAt"`
+	UpdatedAt        time.Time            `json:"updatedAt"`
+	StartedAt        *time.Time           `json:"startedAt,omitempty"`
+	CompletedAt      *time.Time           `json:"completedAt,omitempty"`
+	ExportResult     *ExportJobResult     `json:"exportResult,omitempty"`
+	BulkUpdateResult *BulkUpdateJobResult `json:"bulkUpdateResult,omitempty"`
 }
 
 func (Job) IsNode()        {}
@@ -754,6 +925,34 @@ type KeyAvailability struct {
 	Available bool   `json:"available"`
 }
 
+type LocaleFallback struct {
+	Locale    string   `json:"locale"`
+	Fallbacks []string `json:"fallbacks"`
+}
+
+type LocaleFallbackInput struct {
+	Locale    string   `json:"locale"`
+	Fallbacks []string `json:"fallbacks"`
+}
+
+type LocalizedValue struct {
+	Locale string `json:"locale"`
+	Value  any    `json:"value,omitempty"`
+}
+
+type LocalizedValueInput struct {
+	Locale string `json:"locale"`
+	Value  any    `json:"value,omitempty"`
+}
+
+type MarkNotificationsAsReadInput struct {
+	NotificationIds []ID `json:"notificationIds,omitempty"`
+}
+
+type MarkNotificationsAsReadPayload struct {
+	Notifications []*Notification `json:"notifications"`
+}
+
 type Me struct {
 	ID                ID             `json:"id"`
 	Name              string         `json:"name"`
@@ -818,6 +1017,20 @@ type ModelsPayload struct {
 	Models []*Model `json:"models"`
 }
 
+type MoveAssetFolderInput struct {
+	FolderID ID  `json:"folderId"`
+	ParentID *ID `json:"parentId,omitempty"`
+}
+
+type MoveAssetsInput struct {
+	AssetIds []ID `json:"assetIds"`
+	FolderID *ID  `json:"folderId,omitempty"`
+}
+
+type MoveAssetsPayload struct {
+	Assets []*Asset `json:"assets"`
+}
+
 type MultipleFieldCondition struct {
 	FieldID  *FieldSelector   `json:"fieldId"`
 	Operator MultipleOperator `json:"operator"`
@@ -835,6 +1048,46 @@ type MultipleFieldConditionInput struct {
 type Mutation struct {
 }
 
+type Notification struct {
+	ID          ID               `json:"id"`
+	WorkspaceID ID               `json:"workspaceId"`
+	Type        NotificationType `json:"type"`
+	ActorID     *ID              `json:"actorId,omitempty"`
+	RequestID   *ID              `json:"requestId,omitempty"`
+	ThreadID    *ID              `json:"threadId,omitempty"`
+	CommentID   *ID              `json:"commentId,omitempty"`
+	Title       string           `json:"title"`
+	Content     string           `json:"content"`
+	Read        bool             `json:"read"`
+	ReadAt      *time.Time       `json:"readAt,omitempty"`
+	CreatedAt   time.Time        `json:"createdAt"`
+	Actor       *User            `json:"actor,omitempty"`
+}
+
+func (Notification) IsNode()        {}
+func (this Notification) GetID() ID { return this.ID }
+
+type NotificationConnection struct {
+	Edges      []*NotificationEdge `json:"edges"`
+	Nodes      []*Notification     `json:"nodes"`
+	PageInfo   *PageInfo           `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
+}
+
+type NotificationEdge struct {
+	Cursor usecasex.Cursor `json:"cursor"`
+	Node   *Notification   `json:"node,omitempty"`
+}
+
+type NotificationPreference struct {
+	EmailFrequency NotificationEmailFrequency `json:"emailFrequency"`
+	MutedTypes     []NotificationType         `json:"mutedTypes"`
+}
+
+type NotificationPreferencePayload struct {
+	Preference *NotificationPreference `json:"preference"`
+}
+
 type NullableFieldCondition struct {
 	FieldID  *FieldSelector   `json:"fieldId"`
 	Operator NullableOperator `json:"operator"`
@@ -868,6 +1121,7 @@ type OperatorInput struct {
 	String   *StringOperator   `json:"string,omitempty"`
 	Number   *NumberOperator   `json:"number,omitempty"`
 	Time     *TimeOperator     `json:"time,omitempty"`
+	Spatial  *SpatialOperator  `json:"spatial,omitempty"`
 }
 
 type OrCondition struct {
@@ -900,18 +1154,21 @@ type PostingSettings struct {
 }
 
 type Project struct {
-	ID            ID                    `json:"id"`
-	Name          string                `json:"name"`
-	Description   string                `json:"description"`
-	License       string                `json:"license"`
-	Readme        string                `json:"readme"`
-	Alias         string                `json:"alias"`
-	WorkspaceID   ID                    `json:"workspaceId"`
-	Workspace     *Workspace            `json:"workspace,omitempty"`
-	CreatedAt     time.Time             `json:"createdAt"`
-	UpdatedAt     time.Time             `json:"updatedAt"`
-	Accessibility *ProjectAccessibility `json:"accessibility"`
-	RequestRoles  []Role                `json:"requestRoles,omitempty"`
+	ID                    ID                    `json:"id"`
+	Name                  string                `json:"name"`
+	Description           string                `json:"description"`
+	License               string                `json:"license"`
+	Readme                string                `json:"readme"`
+	Alias                 string                `json:"alias"`
+	WorkspaceID           ID                    `json:"workspaceId"`
+	Workspace             *Workspace            `json:"workspace,omitempty"`
+	CreatedAt             time.Time             `json:"createdAt"`
+	UpdatedAt             time.Time             `json:"updatedAt"`
+	Accessibility         *ProjectAccessibility `json:"accessibility"`
+	RequestRoles          []Role                `json:"requestRoles,omitempty"`
+	Localization          *ProjectLocalization  `json:"localization,omitempty"`
+	AssetMetadataSchemaID *ID                   `json:"assetMetadataSchemaId,omitempty"`
+	AssetMetadataSchema   *Schema               `json:"assetMetadataSchema,omitempty"`
 }
 
 func (Project) IsNode()        {}
@@ -949,6 +1206,18 @@ type ProjectEdge struct {
 	Node   *Project        `json:"node,omitempty"`
 }
 
+type ProjectLocalization struct {
+	DefaultLocale string            `json:"defaultLocale"`
+	Locales       []string          `json:"locales"`
+	Fallbacks     []*LocaleFallback `json:"fallbacks"`
+}
+
+type ProjectLocalizationInput struct {
+	DefaultLocale string                 `json:"defaultLocale"`
+	Locales       []string               `json:"locales"`
+	Fallbacks     []*LocaleFallbackInput `json:"fallbacks,omitempty"`
+}
+
 type ProjectPayload struct {
 	Project *Project `json:"project"`
 }
@@ -966,9 +1235,26 @@ type PublishItemPayload struct {
 	Items []*Item `json:"items"`
 }
 
+type PurgeItemsInput struct {
+	ItemIds []ID `json:"itemIds"`
+}
+
+type PurgeItemsPayload struct {
+	ItemIds []ID `json:"itemIds"`
+}
+
 type Query struct {
 }
 
+type RedeliverWebhookInput struct {
+	WebhookID  ID `json:"webhookId"`
+	DeliveryID ID `json:"deliveryId"`
+}
+
+type RedeliverWebhookPayload struct {
+	Delivery *WebhookDelivery `json:"delivery"`
+}
+
 type RegenerateAPIKeyInput struct {
 	ProjectID ID `json:"projectId"`
 	ID        ID `json:"id"`
@@ -978,6 +1264,12 @@ type RegenerateIntegrationTokenInput struct {
 	IntegrationID ID `json:"integrationId"`
 }
 
+type RemoveCommentReactionInput struct {
+	ThreadID  ID     `json:"threadId"`
+	CommentID ID     `json:"commentId"`
+	Emoji     string `json:"emoji"`
+}
+
 type RemoveIntegrationFromWorkspaceInput struct {
 	WorkspaceID   ID `json:"workspaceId"`
 	IntegrationID ID `json:"integrationId"`
@@ -1009,31 +1301,64 @@ type RemoveMyAuthInput struct {
 	Auth string `json:"auth"`
 }
 
+type ReopenThreadInput struct {
+	ThreadID ID `json:"threadId"`
+}
+
+type ReplaceAssetFileInput struct {
+	AssetID           ID              `json:"assetId"`
+	File              *graphql.Upload `json:"file,omitempty"`
+	URL               *string         `json:"url,omitempty"`
+	Token             *string         `json:"token,omitempty"`
+	SkipDecompression *bool           `json:"skipDecompression,omitempty"`
+}
+
+type ReplaceAssetFilePayload struct {
+	Asset *Asset `json:"asset"`
+}
+
 type Request struct {
-	ID          ID             `json:"id"`
-	Items       []*RequestItem `json:"items"`
-	Title       string         `json:"title"`
-	Description *string        `json:"description,omitempty"`
-	CreatedByID ID             `json:"createdById"`
-	WorkspaceID ID             `json:"workspaceId"`
-	ProjectID   ID             `json:"projectId"`
-	ThreadID    *ID            `json:"threadId,omitempty"`
-	ReviewersID []ID           `json:"reviewersId"`
-	State       RequestState   `json:"state"`
-	CreatedAt   time.Time      `json:"createdAt"`
-	UpdatedAt   time.Time      `json:"updatedAt"`
-	ApprovedAt  *time.Time     `json:"approvedAt,omitempty"`
-	ClosedAt    *time.Time     `json:"closedAt,omitempty"`
-	Thread      *Thread        `json:"thread,omitempty"`
-	CreatedBy   *User          `json:"createdBy,omitempty"`
-	Workspace   *Workspace     `json:"workspace,omitempty"`
-	Project     *Project       `json:"project,omitempty"`
-	Reviewers   []*User        `json:"reviewers"`
+	ID           ID                 `json:"id"`
+	Items        []*RequestItem     `json:"items"`
+	Title        string             `json:"title"`
+	Description  *string            `json:"description,omitempty"`
+	CreatedByID  ID                 `json:"createdById"`
+	WorkspaceID  ID                 `json:"workspaceId"`
+	ProjectID    ID                 `json:"projectId"`
+	ThreadID     *ID                `json:"threadId,omitempty"`
+	ReviewersID  []ID               `json:"reviewersId"`
+	State        RequestState       `json:"state"`
+	Stages       []*RequestStage    `json:"stages"`
+	CurrentStage int                `json:"currentStage"`
+	Approvals    []*RequestApproval `json:"approvals"`
+	CreatedAt    time.Time          `json:"createdAt"`
+	UpdatedAt    time.Time          `json:"updatedAt"`
+	ApprovedAt   *time.Time         `json:"approvedAt,omitempty"`
+	ClosedAt     *time.Time         `json:"closedAt,omitempty"`
+	Thread       *Thread            `json:"thread,omitempty"`
+	CreatedBy    *User              `json:"createdBy,omitempty"`
+	Workspace    *Workspace         `json:"workspace,omitempty"`
+	Project      *Project           `json:"project,omitempty"`
+	Reviewers    []*User            `json:"reviewers"`
 }
 
 func (Request) IsNode()        {}
 func (this Request) GetID() ID { return this.ID }
 
+type RequestApproval struct {
+	UserID    ID              `json:"userId"`
+	Stage     int             `json:"stage"`
+	Decision  RequestDecision `json:"decision"`
+	Comment   *string         `json:"comment,omitempty"`
+	CreatedAt time.Time       `json:"createdAt"`
+	User      *User           `json:"user,omitempty"`
+}
+
+type RequestChangesInput struct {
+	RequestID ID      `json:"requestId"`
+	Comment   *string `json:"comment,omitempty"`
+}
+
 type RequestConnection struct {
 	Edges      []*RequestEdge `json:"edges"`
 	Nodes      []*Request     `json:"nodes"`
@@ -1047,10 +1372,11 @@ type RequestEdge struct {
 }
 
 type RequestItem struct {
-	ItemID  ID             `json:"itemId"`
-	Version *string        `json:"version,omitempty"`
-	Ref     *string        `json:"ref,omitempty"`
-	Item    *VersionedItem `json:"item,omitempty"`
+	ItemID  ID               `json:"itemId"`
+	Version *string          `json:"version,omitempty"`
+	Ref     *string          `json:"ref,omitempty"`
+	Item    *VersionedItem   `json:"item,omitempty"`
+	Diff    *ItemVersionDiff `json:"diff,omitempty"`
 }
 
 type RequestItemInput struct {
@@ -1062,6 +1388,37 @@ type RequestPayload struct {
 	Request *Request `json:"request"`
 }
 
+type RequestStage struct {
+	Name              string `json:"name"`
+	ReviewersID       []ID   `json:"reviewersId"`
+	RequiredApprovals int    `json:"requiredApprovals"`
+}
+
+type RequestStageInput struct {
+	Name              string `json:"name"`
+	ReviewersID       []ID   `json:"reviewersId"`
+	RequiredApprovals int    `json:"requiredApprovals"`
+}
+
+type RequestWorkflow struct {
+	ID        ID              `json:"id"`
+	ProjectID ID              `json:"projectId"`
+	ModelID   *ID             `json:"modelId,omitempty"`
+	Stages    []*RequestStage `json:"stages"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+}
+
+func (RequestWorkflow) IsNode()        {}
+func (this RequestWorkflow) GetID() ID { return this.ID }
+
+type RequestWorkflowPayload struct {
+	Workflow *RequestWorkflow `json:"workflow"`
+}
+
+type ResolveThreadInput struct {
+	ThreadID ID `json:"threadId"`
+}
+
 type ResourceInput struct {
 	Tile    *TileResourceInput    `json:"tile,omitempty"`
 	Terrain *TerrainResourceInput `json:"terrain,omitempty"`
@@ -1079,12 +1436,69 @@ type ResourcesListInput struct {
 	Enabled          *bool            `json:"enabled,omitempty"`
 }
 
+type RestoreAssetVersionInput struct {
+	AssetID ID     `json:"assetId"`
+	UUID    string `json:"uuid"`
+}
+
+type RestoreAssetVersionPayload struct {
+	Asset *Asset `json:"asset"`
+}
+
+type RestoreItemVersionInput struct {
+	ItemID  ID     `json:"itemId"`
+	Version string `json:"version"`
+}
+
+type RestoreItemVersionPayload struct {
+	Item          *Item           `json:"item"`
+	DroppedFields []*DroppedField `json:"droppedFields"`
+}
+
+type RestoreItemsInput struct {
+	ItemIds []ID `json:"itemIds"`
+}
+
+type RestoreItemsPayload struct {
+	Items []*Item `json:"items"`
+}
+
+type SaveRequestWorkflowInput struct {
+	ProjectID ID                   `json:"projectId"`
+	ModelID   *ID                  `json:"modelId,omitempty"`
+	Stages    []*RequestStageInput `json:"stages"`
+}
+
+type Schedule struct {
+	ID            ID             `json:"id"`
+	ProjectID     ID             `json:"projectId"`
+	ModelID       ID             `json:"modelId"`
+	ItemIds       []ID           `json:"itemIds"`
+	Action        ScheduleAction `json:"action"`
+	Status        ScheduleStatus `json:"status"`
+	ScheduledAt   time.Time      `json:"scheduledAt"`
+	UserID        *ID            `json:"userId,omitempty"`
+	IntegrationID *ID            `json:"integrationId,omitempty"`
+	Error         *string        `json:"error,omitempty"`
+	CreatedAt     time.Time      `json:"createdAt"`
+	UpdatedAt     time.Time      `json:"updatedAt"`
+	ExecutedAt    *time.Time     `json:"executedAt,omitempty"`
+}
+
+func (Schedule) IsNode()        {}
+func (this Schedule) GetID() ID { return this.ID }
+
+type SchedulePayload struct {
+	Schedule *Schedule `json:"schedule"`
+}
+
 type Schema struct {
 	ID           ID             `json:"id"`
 	ProjectID    ID             `json:"projectId"`
 	Fields       []*SchemaField `json:"fields"`
 	TitleFieldID *ID            `json:"titleFieldId,omitempty"`
 	TitleField   *SchemaField   `json:"titleField,omitempty"`
+	Rules        []*SchemaRule  `json:"rules"`
 	Project      *Project       `json:"project"`
 }
 
@@ -1107,6 +1521,7 @@ type SchemaField struct {
 	Unique       bool                    `json:"unique"`
 	Required     bool                    `json:"required"`
 	IsTitle      bool                    `json:"isTitle"`
+	Localizable  bool                    `json:"localizable"`
 	CreatedAt    time.Time               `json:"createdAt"`
 	UpdatedAt    time.Time               `json:"updatedAt"`
 }
@@ -1204,8 +1619,9 @@ type SchemaFieldLineStringInput struct {
 }
 
 type SchemaFieldMarkdown struct {
-	DefaultValue any  `json:"defaultValue,omitempty"`
-	MaxLength    *int `json:"maxLength,omitempty"`
+	DefaultValue any                        `json:"defaultValue,omitempty"`
+	MaxLength    *int                       `json:"maxLength,omitempty"`
+	Validation   *SchemaFieldTextValidation `json:"validation,omitempty"`
 }
 
 func (SchemaFieldMarkdown) IsSchemaFieldTypeProperty() {}
@@ -1241,15 +1657,17 @@ type SchemaFieldReferenceInput struct {
 }
 
 type SchemaFieldRichText struct {
-	DefaultValue any  `json:"defaultValue,omitempty"`
-	MaxLength    *int `json:"maxLength,omitempty"`
+	DefaultValue any                        `json:"defaultValue,omitempty"`
+	MaxLength    *int                       `json:"maxLength,omitempty"`
+	Validation   *SchemaFieldTextValidation `json:"validation,omitempty"`
 }
 
 func (SchemaFieldRichText) IsSchemaFieldTypeProperty() {}
 
 type SchemaFieldRichTextInput struct {
-	DefaultValue any  `json:"defaultValue,omitempty"`
-	MaxLength    *int `json:"maxLength,omitempty"`
+	DefaultValue any                             `json:"defaultValue,omitempty"`
+	MaxLength    *int                            `json:"maxLength,omitempty"`
+	Validation   *SchemaFieldTextValidationInput `json:"validation,omitempty"`
 }
 
 type SchemaFieldSelect struct {
@@ -1289,27 +1707,47 @@ type SchemaFieldTagValueInput struct {
 }
 
 type SchemaFieldText struct {
-	DefaultValue any  `json:"defaultValue,omitempty"`
-	MaxLength    *int `json:"maxLength,omitempty"`
+	DefaultValue any                        `json:"defaultValue,omitempty"`
+	MaxLength    *int                       `json:"maxLength,omitempty"`
+	Validation   *SchemaFieldTextValidation `json:"validation,omitempty"`
 }
 
 func (SchemaFieldText) IsSchemaFieldTypeProperty() {}
 
 type SchemaFieldTextArea struct {
-	DefaultValue any  `json:"defaultValue,omitempty"`
-	MaxLength    *int `json:"maxLength,omitempty"`
+	DefaultValue any                        `json:"defaultValue,omitempty"`
+	MaxLength    *int                       `json:"maxLength,omitempty"`
+	Validation   *SchemaFieldTextValidation `json:"validation,omitempty"`
 }
 
 func (SchemaFieldTextArea) IsSchemaFieldTypeProperty() {}
 
 type SchemaFieldTextAreaInput struct {
-	DefaultValue any  `json:"defaultValue,omitempty"`
-	MaxLength    *int `json:"maxLength,omitempty"`
+	DefaultValue any                             `json:"defaultValue,omitempty"`
+	MaxLength    *int                            `json:"maxLength,omitempty"`
+	Validation   *SchemaFieldTextValidationInput `json:"validation,omitempty"`
 }
 
 type SchemaFieldTextInput struct {
-	DefaultValue any  `json:"defaultValue,omitempty"`
-	MaxLength    *int `json:"maxLength,omitempty"`
+	DefaultValue any                             `json:"defaultValue,omitempty"`
+	MaxLength    *int                            `json:"maxLength,omitempty"`
+	Validation   *SchemaFieldTextValidationInput `json:"validation,omitempty"`
+}
+
+type SchemaFieldTextValidation struct {
+	MinLength      *int                   `json:"minLength,omitempty"`
+	Pattern        *string                `json:"pattern,omitempty"`
+	PatternMessage *string                `json:"patternMessage,omitempty"`
+	ForbiddenWords []string               `json:"forbiddenWords"`
+	Preset         *SchemaFieldTextPreset `json:"preset,omitempty"`
+}
+
+type SchemaFieldTextValidationInput struct {
+	MinLength      *int                   `json:"minLength,omitempty"`
+	Pattern        *string                `json:"pattern,omitempty"`
+	PatternMessage *string                `json:"patternMessage,omitempty"`
+	ForbiddenWords []string               `json:"forbiddenWords,omitempty"`
+	Preset         *SchemaFieldTextPreset `json:"preset,omitempty"`
 }
 
 type SchemaFieldTypePropertyInput struct {
@@ -1343,8 +1781,43 @@ type SchemaFieldURLInput struct {
 }
 
 type SchemaMarkdownTextInput struct {
-	DefaultValue any  `json:"defaultValue,omitempty"`
-	MaxLength    *int `json:"maxLength,omitempty"`
+	DefaultValue any                             `json:"defaultValue,omitempty"`
+	MaxLength    *int                            `json:"maxLength,omitempty"`
+	Validation   *SchemaFieldTextValidationInput `json:"validation,omitempty"`
+}
+
+type SchemaPayload struct {
+	Schema *Schema `json:"schema"`
+}
+
+type SchemaRule struct {
+	Type         SchemaRuleType             `json:"type"`
+	FieldID      ID                         `json:"fieldId"`
+	Condition    *SchemaRuleCondition       `json:"condition,omitempty"`
+	Operator     *SchemaRuleCompareOperator `json:"operator,omitempty"`
+	OtherFieldID *ID                        `json:"otherFieldId,omitempty"`
+	Message      *string                    `json:"message,omitempty"`
+}
+
+type SchemaRuleCondition struct {
+	FieldID  ID                          `json:"fieldId"`
+	Operator SchemaRuleConditionOperator `json:"operator"`
+	Value    any                         `json:"value,omitempty"`
+}
+
+type SchemaRuleConditionInput struct {
+	FieldID  ID                          `json:"fieldId"`
+	Operator SchemaRuleConditionOperator `json:"operator"`
+	Value    any                         `json:"value,omitempty"`
+}
+
+type SchemaRuleInput struct {
+	Type         SchemaRuleType             `json:"type"`
+	FieldID      ID                         `json:"fieldId"`
+	Condition    *SchemaRuleConditionInput  `json:"condition,omitempty"`
+	Operator     *SchemaRuleCompareOperator `json:"operator,omitempty"`
+	OtherFieldID *ID                        `json:"otherFieldId,omitempty"`
+	Message      *string                    `json:"message,omitempty"`
 }
 
 type SearchAssetsInput struct {
@@ -1365,6 +1838,26 @@ type Sort struct {
 	Reverted *bool  `json:"reverted,omitempty"`
 }
 
+type SpatialFieldCondition struct {
+	FieldID  *FieldSelector  `json:"fieldId"`
+	Operator SpatialOperator `json:"operator"`
+	Bbox     []float64       `json:"bbox,omitempty"`
+	Polygon  [][]float64     `json:"polygon,omitempty"`
+	Point    []float64       `json:"point,omitempty"`
+	Radius   *float64        `json:"radius,omitempty"`
+}
+
+func (SpatialFieldCondition) IsCondition() {}
+
+type SpatialFieldConditionInput struct {
+	FieldID  *FieldSelectorInput `json:"fieldId"`
+	Operator SpatialOperator     `json:"operator"`
+	Bbox     []float64           `json:"bbox,omitempty"`
+	Polygon  [][]float64         `json:"polygon,omitempty"`
+	Point    []float64           `json:"point,omitempty"`
+	Radius   *float64            `json:"radius,omitempty"`
+}
+
 type StringFieldCondition struct {
 	FieldID  *FieldSelector `json:"fieldId"`
 	Operator StringOperator `json:"operator"`
@@ -1397,10 +1890,18 @@ type TerrainResourceInput struct {
 }
 
 type Thread struct {
-	ID          ID         `json:"id"`
-	Workspace   *Workspace `json:"workspace,omitempty"`
-	WorkspaceID ID         `json:"workspaceId"`
-	Comments    []*Comment `json:"comments"`
+	ID             ID            `json:"id"`
+	Workspace      *Workspace    `json:"workspace,omitempty"`
+	WorkspaceID    ID            `json:"workspaceId"`
+	Comments       []*Comment    `json:"comments"`
+	Resolved       bool          `json:"resolved"`
+	ResolvedAt     *time.Time    `json:"resolvedAt,omitempty"`
+	ResolvedByID   *ID           `json:"resolvedById,omitempty"`
+	ResolvedByType *OperatorType `json:"resolvedByType,omitempty"`
+}
+
+type ThreadPayload struct {
+	Thread *Thread `json:"thread"`
 }
 
 type TileResource struct {
@@ -1431,6 +1932,26 @@ type TimeFieldConditionInput struct {
 	Value    time.Time           `json:"value"`
 }
 
+type TrashedItem struct {
+	Item          *Item      `json:"item"`
+	TrashedAt     time.Time  `json:"trashedAt"`
+	ExpiresAt     *time.Time `json:"expiresAt,omitempty"`
+	UserID        *ID        `json:"userId,omitempty"`
+	IntegrationID *ID        `json:"integrationId,omitempty"`
+}
+
+type TrashedItemConnection struct {
+	Edges      []*TrashedItemEdge `json:"edges"`
+	Nodes      []*TrashedItem     `json:"nodes"`
+	PageInfo   *PageInfo          `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
+}
+
+type TrashedItemEdge struct {
+	Cursor usecasex.Cursor `json:"cursor"`
+	Node   *TrashedItem    `json:"node,omitempty"`
+}
+
 type UnpublishItemInput struct {
 	ItemIds []ID `json:"itemIds"`
 }
@@ -1447,9 +1968,17 @@ type UpdateAPIKeyInput struct {
 	Publication *UpdatePublicationSettingsInput `json:"publication,omitempty"`
 }
 
+type UpdateAssetFolderInput struct {
+	FolderID          ID                     `json:"folderId"`
+	Name              *string                `json:"name,omitempty"`
+	Permission        *AssetFolderPermission `json:"permission,omitempty"`
+	InheritPermission *bool                  `json:"inheritPermission,omitempty"`
+}
+
 type UpdateAssetInput struct {
-	ID          ID           `json:"id"`
-	PreviewType *PreviewType `json:"previewType,omitempty"`
+	ID          ID                         `json:"id"`
+	PreviewType *PreviewType               `json:"previewType,omitempty"`
+	Metadata    []*AssetMetadataFieldInput `json:"metadata,omitempty"`
 }
 
 type UpdateAssetPayload struct {
@@ -1465,6 +1994,7 @@ type UpdateCommentInput struct {
 type UpdateFieldInput struct {
 	ModelID      *ID                           `json:"modelId,omitempty"`
 	GroupID      *ID                           `json:"groupId,omitempty"`
+	ProjectID    *ID                           `json:"projectId,omitempty"`
 	FieldID      ID                            `json:"fieldId"`
 	Title        *string                       `json:"title,omitempty"`
 	Description  *string                       `json:"description,omitempty"`
@@ -1475,6 +2005,7 @@ type UpdateFieldInput struct {
 	Unique       *bool                         `json:"unique,omitempty"`
 	Multiple     *bool                         `json:"multiple,omitempty"`
 	IsTitle      *bool                         `json:"isTitle,omitempty"`
+	Localizable  *bool                         `json:"localizable,omitempty"`
 	TypeProperty *SchemaFieldTypePropertyInput `json:"typeProperty,omitempty"`
 }
 
@@ -1543,6 +2074,11 @@ type UpdateModelsOrderInput struct {
 	ModelIds []ID `json:"modelIds"`
 }
 
+type UpdateNotificationPreferenceInput struct {
+	EmailFrequency *NotificationEmailFrequency `json:"emailFrequency,omitempty"`
+	MutedTypes     []NotificationType          `json:"mutedTypes,omitempty"`
+}
+
 type UpdatePostingSettingsInput struct {
 	AllowedOrigins []string `json:"allowedOrigins"`
 }
@@ -1562,6 +2098,7 @@ type UpdateProjectInput struct {
 	Alias         *string                          `json:"alias,omitempty"`
 	Accessibility *UpdateProjectAccessibilityInput `json:"accessibility,omitempty"`
 	RequestRoles  []Role                           `json:"requestRoles,omitempty"`
+	Localization  *ProjectLocalizationInput        `json:"localization,omitempty"`
 }
 
 type UpdatePublicationSettingsInput struct {
@@ -1578,6 +2115,11 @@ type UpdateRequestInput struct {
 	Items       []*RequestItemInput `json:"items,omitempty"`
 }
 
+type UpdateSchemaRulesInput struct {
+	SchemaID ID                 `json:"schemaId"`
+	Rules    []*SchemaRuleInput `json:"rules"`
+}
+
 type UpdateUserOfWorkspaceInput struct {
 	WorkspaceID ID   `json:"workspaceId"`
 	UserID      ID   `json:"userId"`
@@ -1597,13 +2139,15 @@ type UpdateViewsOrderInput struct {
 }
 
 type UpdateWebhookInput struct {
-	IntegrationID ID                   `json:"integrationId"`
-	WebhookID     ID                   `json:"webhookId"`
-	Name          *string              `json:"name,omitempty"`
-	URL           *url.URL             `json:"url,omitempty"`
-	Active        *bool                `json:"active,omitempty"`
-	Trigger       *WebhookTriggerInput `json:"trigger,omitempty"`
-	Secret        *string              `json:"secret,omitempty"`
+	IntegrationID ID                        `json:"integrationId"`
+	WebhookID     ID                        `json:"webhookId"`
+	Name          *string                   `json:"name,omitempty"`
+	URL           *url.URL                  `json:"url,omitempty"`
+	Active        *bool                     `json:"active,omitempty"`
+	Trigger       *WebhookTriggerInput      `json:"trigger,omitempty"`
+	Filter        *WebhookFilterInput       `json:"filter,omitempty"`
+	Payload       *WebhookPayloadShapeInput `json:"payload,omitempty"`
+	Secret        *string                   `json:"secret,omitempty"`
 }
 
 type UpdateWorkspaceInput struct {
@@ -1679,20 +2223,77 @@ type ViewsPayload struct {
 }
 
 type Webhook struct {
-	ID        ID              `json:"id"`
-	Name      string          `json:"name"`
-	URL       url.URL         `json:"url"`
-	Active    bool            `json:"active"`
-	Trigger   *WebhookTrigger `json:"trigger"`
-	Secret    string          `json:"secret"`
-	CreatedAt time.Time       `json:"createdAt"`
-	UpdatedAt time.Time       `json:"updatedAt"`
+	ID        ID                   `json:"id"`
+	Name      string               `json:"name"`
+	URL       url.URL              `json:"url"`
+	Active    bool                 `json:"active"`
+	Trigger   *WebhookTrigger      `json:"trigger"`
+	Filter    *WebhookFilter       `json:"filter"`
+	Payload   *WebhookPayloadShape `json:"payload"`
+	Secret    string               `json:"secret"`
+	CreatedAt time.Time            `json:"createdAt"`
+	UpdatedAt time.Time            `json:"updatedAt"`
+}
+
+type WebhookDelivery struct {
+	ID            ID                        `json:"id"`
+	IntegrationID ID                        `json:"integrationId"`
+	WebhookID     ID                        `json:"webhookId"`
+	EventID       ID                        `json:"eventId"`
+	EventType     string                    `json:"eventType"`
+	URL           string                    `json:"url"`
+	Payload       string                    `json:"payload"`
+	Status        WebhookDeliveryStatus     `json:"status"`
+	Attempts      []*WebhookDeliveryAttempt `json:"attempts"`
+	NextAttemptAt *time.Time                `json:"nextAttemptAt,omitempty"`
+	CreatedAt     time.Time                 `json:"createdAt"`
+	UpdatedAt     time.Time                 `json:"updatedAt"`
+}
+
+type WebhookDeliveryAttempt struct {
+	AttemptedAt  time.Time `json:"attemptedAt"`
+	StatusCode   *int      `json:"statusCode,omitempty"`
+	Latency      int       `json:"latency"`
+	ResponseBody *string   `json:"responseBody,omitempty"`
+	Error        *string   `json:"error,omitempty"`
+}
+
+type WebhookDeliveryConnection struct {
+	Edges      []*WebhookDeliveryEdge `json:"edges"`
+	Nodes      []*WebhookDelivery     `json:"nodes"`
+	PageInfo   *PageInfo              `json:"pageInfo"`
+	TotalCount int                    `json:"totalCount"`
+}
+
+type WebhookDeliveryEdge struct {
+	Cursor usecasex.Cursor  `json:"cursor"`
+	Node   *WebhookDelivery `json:"node,omitempty"`
+}
+
+type WebhookFilter struct {
+	ModelIds []ID `json:"modelIds"`
+	GroupIds []ID `json:"groupIds"`
+}
+
+type WebhookFilterInput struct {
+	ModelIds []ID `json:"modelIds,omitempty"`
+	GroupIds []ID `json:"groupIds,omitempty"`
 }
 
 type WebhookPayload struct {
 	Webhook *Webhook `json:"webhook"`
 }
 
+type WebhookPayloadShape struct {
+	Type   WebhookPayloadType `json:"type"`
+	Fields []string           `json:"fields"`
+}
+
+type WebhookPayloadShapeInput struct {
+	Type   WebhookPayloadType `json:"type"`
+	Fields []string           `json:"fields,omitempty"`
+}
+
 type WebhookTrigger struct {
 	OnItemCreate      *bool `json:"onItemCreate,omitempty"`
 	OnItemUpdate      *bool `json:"onItemUpdate,omitempty"`
@@ -1700,8 +2301,22 @@ type WebhookTrigger struct {
 	OnItemPublish     *bool `json:"onItemPublish,omitempty"`
 	OnItemUnPublish   *bool `json:"onItemUnPublish,omitempty"`
 	OnAssetUpload     *bool `json:"onAssetUpload,omitempty"`
+	OnAssetUpdate     *bool `json:"onAssetUpdate,omitempty"`
 	OnAssetDecompress *bool `json:"onAssetDecompress,omitempty"`
 	OnAssetDelete     *bool `json:"onAssetDelete,omitempty"`
+	OnModelCreate     *bool `json:"onModelCreate,omitempty"`
+	OnModelUpdate     *bool `json:"onModelUpdate,omitempty"`
+	OnModelDelete     *bool `json:"onModelDelete,omitempty"`
+	OnFieldCreate     *bool `json:"onFieldCreate,omitempty"`
+	OnFieldUpdate     *bool `json:"onFieldUpdate,omitempty"`
+	OnFieldDelete     *bool `json:"onFieldDelete,omitempty"`
+	OnRequestCreate   *bool `json:"onRequestCreate,omitempty"`
+	OnRequestUpdate   *bool `json:"onRequestUpdate,omitempty"`
+	OnRequestApprove  *bool `json:"onRequestApprove,omitempty"`
+	OnRequestClose    *bool `json:"onRequestClose,omitempty"`
+	OnCommentCreate   *bool `json:"onCommentCreate,omitempty"`
+	OnCommentUpdate   *bool `json:"onCommentUpdate,omitempty"`
+	OnCommentDelete   *bool `json:"onCommentDelete,omitempty"`
 }
 
 type WebhookTriggerInput struct {
@@ -1711,8 +2326,22 @@ type WebhookTriggerInput struct {
 	OnItemPublish     *bool `json:"onItemPublish,omitempty"`
 	OnItemUnPublish   *bool `json:"onItemUnPublish,omitempty"`
 	OnAssetUpload     *bool `json:"onAssetUpload,omitempty"`
+	OnAssetUpdate     *bool `json:"onAssetUpdate,omitempty"`
 	OnAssetDecompress *bool `json:"onAssetDecompress,omitempty"`
 	OnAssetDelete     *bool `json:"onAssetDelete,omitempty"`
+	OnModelCreate     *bool `json:"onModelCreate,omitempty"`
+	OnModelUpdate     *bool `json:"onModelUpdate,omitempty"`
+	OnModelDelete     *bool `json:"onModelDelete,omitempty"`
+	OnFieldCreate     *bool `json:"onFieldCreate,omitempty"`
+	OnFieldUpdate     *bool `json:"onFieldUpdate,omitempty"`
+	OnFieldDelete     *bool `json:"onFieldDelete,omitempty"`
+	OnRequestCreate   *bool `json:"onRequestCreate,omitempty"`
+	OnRequestUpdate   *bool `json:"onRequestUpdate,omitempty"`
+	OnRequestApprove  *bool `json:"onRequestApprove,omitempty"`
+	OnRequestClose    *bool `json:"onRequestClose,omitempty"`
+	OnCommentCreate   *bool `json:"onCommentCreate,omitempty"`
+	OnCommentUpdate   *bool `json:"onCommentUpdate,omitempty"`
+	OnCommentDelete   *bool `json:"onCommentDelete,omitempty"`
 }
 
 type Workspace struct {
@@ -1821,50 +2450,50 @@ func (e ArchiveExtractionStatus) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-type AssetSortType string
+type AssetFolderPermission string
 
 const (
-	AssetSortTypeDate AssetSortType = "DATE"
-	AssetSortTypeSize AssetSortType = "SIZE"
-	AssetSortTypeName AssetSortType = "NAME"
+	AssetFolderPermissionWriter     AssetFolderPermission = "WRITER"
+	AssetFolderPermissionMaintainer AssetFolderPermission = "MAINTAINER"
+	AssetFolderPermissionOwner      AssetFolderPermission = "OWNER"
 )
 
-var AllAssetSortType = []AssetSortType{
-	AssetSortTypeDate,
-	AssetSortTypeSize,
-	AssetSortTypeName,
+var AllAssetFolderPermission = []AssetFolderPermission{
+	AssetFolderPermissionWriter,
+	AssetFolderPermissionMaintainer,
+	AssetFolderPermissionOwner,
 }
 
-func (e AssetSortType) IsValid() bool {
+func (e AssetFolderPermission) IsValid() bool {
 	switch e {
-	case AssetSortTypeDate, AssetSortTypeSize, AssetSortTypeName:
+	case AssetFolderPermissionWriter, AssetFolderPermissionMaintainer, AssetFolderPermissionOwner:
 		return true
 	}
 	return false
 }
 
-func (e AssetSortType) String() string {
+func (e AssetFolderPermission) String() string {
 	return string(e)
 }
 
-func (e *AssetSortType) UnmarshalGQL(v any) error {
+func (e *AssetFolderPermission) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = AssetSortType(str)
+	*e = AssetFolderPermission(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid AssetSortType", str)
+		return fmt.Errorf("%s is not a valid AssetFolderPermission", str)
 	}
 	return nil
 }
 
-func (e AssetSortType) MarshalGQL(w io.Writer) {
+func (e AssetFolderPermission) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *AssetSortType) UnmarshalJSON(b []byte) error {
+func (e *AssetFolderPermission) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1872,27 +2501,84 @@ func (e *AssetSortType) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e AssetSortType) MarshalJSON() ([]byte, error) {
+func (e AssetFolderPermission) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-type BasicOperator string
+type AssetSortType string
 
 const (
-	BasicOperatorEquals    BasicOperator = "EQUALS"
-	BasicOperatorNotEquals BasicOperator = "NOT_EQUALS"
+	AssetSortTypeDate AssetSortType = "DATE"
+	AssetSortTypeSize AssetSortType = "SIZE"
+	AssetSortTypeName AssetSortType = "NAME"
 )
 
-var AllBasicOperator = []BasicOperator{
-	BasicOperatorEquals,
-	BasicOperatorNotEquals,
+var AllAssetSortType = []AssetSortType{
+	AssetSortTypeDate,
+	AssetSortTypeSize,
+	AssetSortTypeName,
 }
 
-func (e BasicOperator) IsValid() bool {
+func (e AssetSortType) IsValid() bool {
 	switch e {
-	case BasicOperatorEquals, BasicOperatorNotEquals:
+	case AssetSortTypeDate, AssetSortTypeSize, AssetSortTypeName:
+		return true
+	}
+	return false
+}
+
+func (e AssetSortType) String() string {
+	return string(e)
+}
+
+func (e *AssetSortType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AssetSortType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AssetSortType", str)
+	}
+	return nil
+}
+
+func (e AssetSortType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AssetSortType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AssetSortType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type BasicOperator string
+
+const (
+	BasicOperatorEquals    BasicOperator = "EQUALS"
+	BasicOperatorNotEquals BasicOperator = "NOT_EQUALS"
+)
+
+var AllBasicOperator = []BasicOperator{
+	BasicOperatorEquals,
+	BasicOperatorNotEquals,
+}
+
+func (e BasicOperator) IsValid() bool {
+	switch e {
+	case BasicOperatorEquals, BasicOperatorNotEquals:
 		return true
 	}
 	return false
@@ -2053,6 +2739,65 @@ func (e ContentTypesEnum) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type DroppedFieldReason string
+
+const (
+	DroppedFieldReasonRemoved           DroppedFieldReason = "REMOVED"
+	DroppedFieldReasonTypeChanged       DroppedFieldReason = "TYPE_CHANGED"
+	DroppedFieldReasonInvalid           DroppedFieldReason = "INVALID"
+	DroppedFieldReasonReferenceNotFound DroppedFieldReason = "REFERENCE_NOT_FOUND"
+)
+
+var AllDroppedFieldReason = []DroppedFieldReason{
+	DroppedFieldReasonRemoved,
+	DroppedFieldReasonTypeChanged,
+	DroppedFieldReasonInvalid,
+	DroppedFieldReasonReferenceNotFound,
+}
+
+func (e DroppedFieldReason) IsValid() bool {
+	switch e {
+	case DroppedFieldReasonRemoved, DroppedFieldReasonTypeChanged, DroppedFieldReasonInvalid, DroppedFieldReasonReferenceNotFound:
+		return true
+	}
+	return false
+}
+
+func (e DroppedFieldReason) String() string {
+	return string(e)
+}
+
+func (e *DroppedFieldReason) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DroppedFieldReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DroppedFieldReason", str)
+	}
+	return nil
+}
+
+func (e DroppedFieldReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DroppedFieldReason) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DroppedFieldReason) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type ExportFormat string
 
 const (
@@ -2356,6 +3101,63 @@ func (e IntegrationType) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type ItemFieldChangeType string
+
+const (
+	ItemFieldChangeTypeAdd    ItemFieldChangeType = "ADD"
+	ItemFieldChangeTypeUpdate ItemFieldChangeType = "UPDATE"
+	ItemFieldChangeTypeDelete ItemFieldChangeType = "DELETE"
+)
+
+var AllItemFieldChangeType = []ItemFieldChangeType{
+	ItemFieldChangeTypeAdd,
+	ItemFieldChangeTypeUpdate,
+	ItemFieldChangeTypeDelete,
+}
+
+func (e ItemFieldChangeType) IsValid() bool {
+	switch e {
+	case ItemFieldChangeTypeAdd, ItemFieldChangeTypeUpdate, ItemFieldChangeTypeDelete:
+		return true
+	}
+	return false
+}
+
+func (e ItemFieldChangeType) String() string {
+	return string(e)
+}
+
+func (e *ItemFieldChangeType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ItemFieldChangeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ItemFieldChangeType", str)
+	}
+	return nil
+}
+
+func (e ItemFieldChangeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ItemFieldChangeType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ItemFieldChangeType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type ItemStatus string
 
 const (
@@ -2481,16 +3283,20 @@ func (e JobStatus) MarshalJSON() ([]byte, error) {
 type JobType string
 
 const (
-	JobTypeImport JobType = "IMPORT"
+	JobTypeImport     JobType = "IMPORT"
+	JobTypeExport     JobType = "EXPORT"
+	JobTypeBulkUpdate JobType = "BULK_UPDATE"
 )
 
 var AllJobType = []JobType{
 	JobTypeImport,
+	JobTypeExport,
+	JobTypeBulkUpdate,
 }
 
 func (e JobType) IsValid() bool {
 	switch e {
-	case JobTypeImport:
+	case JobTypeImport, JobTypeExport, JobTypeBulkUpdate:
 		return true
 	}
 	return false
@@ -2665,6 +3471,126 @@ func (e NodeType) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type NotificationEmailFrequency string
+
+const (
+	NotificationEmailFrequencyInstant NotificationEmailFrequency = "INSTANT"
+	NotificationEmailFrequencyDigest  NotificationEmailFrequency = "DIGEST"
+	NotificationEmailFrequencyNever   NotificationEmailFrequency = "NEVER"
+)
+
+var AllNotificationEmailFrequency = []NotificationEmailFrequency{
+	NotificationEmailFrequencyInstant,
+	NotificationEmailFrequencyDigest,
+	NotificationEmailFrequencyNever,
+}
+
+func (e NotificationEmailFrequency) IsValid() bool {
+	switch e {
+	case NotificationEmailFrequencyInstant, NotificationEmailFrequencyDigest, NotificationEmailFrequencyNever:
+		return true
+	}
+	return false
+}
+
+func (e NotificationEmailFrequency) String() string {
+	return string(e)
+}
+
+func (e *NotificationEmailFrequency) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationEmailFrequency(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationEmailFrequency", str)
+	}
+	return nil
+}
+
+func (e NotificationEmailFrequency) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *NotificationEmailFrequency) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e NotificationEmailFrequency) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type NotificationType string
+
+const (
+	NotificationTypeRequestAssigned         NotificationType = "REQUEST_ASSIGNED"
+	NotificationTypeRequestApproved         NotificationType = "REQUEST_APPROVED"
+	NotificationTypeRequestChangesRequested NotificationType = "REQUEST_CHANGES_REQUESTED"
+	NotificationTypeRequestClosed           NotificationType = "REQUEST_CLOSED"
+	NotificationTypeComment                 NotificationType = "COMMENT"
+	NotificationTypeMention                 NotificationType = "MENTION"
+)
+
+var AllNotificationType = []NotificationType{
+	NotificationTypeRequestAssigned,
+	NotificationTypeRequestApproved,
+	NotificationTypeRequestChangesRequested,
+	NotificationTypeRequestClosed,
+	NotificationTypeComment,
+	NotificationTypeMention,
+}
+
+func (e NotificationType) IsValid() bool {
+	switch e {
+	case NotificationTypeRequestAssigned, NotificationTypeRequestApproved, NotificationTypeRequestChangesRequested, NotificationTypeRequestClosed, NotificationTypeComment, NotificationTypeMention:
+		return true
+	}
+	return false
+}
+
+func (e NotificationType) String() string {
+	return string(e)
+}
+
+func (e *NotificationType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationType", str)
+	}
+	return nil
+}
+
+func (e NotificationType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *NotificationType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e NotificationType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type NullableOperator string
 
 const (
@@ -2956,13 +3882,69 @@ func (e ProjectVisibility) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type RequestDecision string
+
+const (
+	RequestDecisionApproved         RequestDecision = "APPROVED"
+	RequestDecisionChangesRequested RequestDecision = "CHANGES_REQUESTED"
+)
+
+var AllRequestDecision = []RequestDecision{
+	RequestDecisionApproved,
+	RequestDecisionChangesRequested,
+}
+
+func (e RequestDecision) IsValid() bool {
+	switch e {
+	case RequestDecisionApproved, RequestDecisionChangesRequested:
+		return true
+	}
+	return false
+}
+
+func (e RequestDecision) String() string {
+	return string(e)
+}
+
+func (e *RequestDecision) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RequestDecision(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RequestDecision", str)
+	}
+	return nil
+}
+
+func (e RequestDecision) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RequestDecision) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RequestDecision) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type RequestState string
 
 const (
-	RequestStateDraft    RequestState = "DRAFT"
-	RequestStateWaiting  RequestState = "WAITING"
-	RequestStateClosed   RequestState = "CLOSED"
-	RequestStateApproved RequestState = "APPROVED"
+	RequestStateDraft            RequestState = "DRAFT"
+	RequestStateWaiting          RequestState = "WAITING"
+	RequestStateClosed           RequestState = "CLOSED"
+	RequestStateApproved         RequestState = "APPROVED"
+	RequestStateChangesRequested RequestState = "CHANGES_REQUESTED"
 )
 
 var AllRequestState = []RequestState{
@@ -2970,11 +3952,12 @@ var AllRequestState = []RequestState{
 	RequestStateWaiting,
 	RequestStateClosed,
 	RequestStateApproved,
+	RequestStateChangesRequested,
 }
 
 func (e RequestState) IsValid() bool {
 	switch e {
-	case RequestStateDraft, RequestStateWaiting, RequestStateClosed, RequestStateApproved:
+	case RequestStateDraft, RequestStateWaiting, RequestStateClosed, RequestStateApproved, RequestStateChangesRequested:
 		return true
 	}
 	return false
@@ -3131,6 +4114,120 @@ func (e Role) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type ScheduleAction string
+
+const (
+	ScheduleActionPublish   ScheduleAction = "PUBLISH"
+	ScheduleActionUnpublish ScheduleAction = "UNPUBLISH"
+)
+
+var AllScheduleAction = []ScheduleAction{
+	ScheduleActionPublish,
+	ScheduleActionUnpublish,
+}
+
+func (e ScheduleAction) IsValid() bool {
+	switch e {
+	case ScheduleActionPublish, ScheduleActionUnpublish:
+		return true
+	}
+	return false
+}
+
+func (e ScheduleAction) String() string {
+	return string(e)
+}
+
+func (e *ScheduleAction) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ScheduleAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ScheduleAction", str)
+	}
+	return nil
+}
+
+func (e ScheduleAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ScheduleAction) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ScheduleAction) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ScheduleStatus string
+
+const (
+	ScheduleStatusPending   ScheduleStatus = "PENDING"
+	ScheduleStatusCompleted ScheduleStatus = "COMPLETED"
+	ScheduleStatusFailed    ScheduleStatus = "FAILED"
+	ScheduleStatusCancelled ScheduleStatus = "CANCELLED"
+)
+
+var AllScheduleStatus = []ScheduleStatus{
+	ScheduleStatusPending,
+	ScheduleStatusCompleted,
+	ScheduleStatusFailed,
+	ScheduleStatusCancelled,
+}
+
+func (e ScheduleStatus) IsValid() bool {
+	switch e {
+	case ScheduleStatusPending, ScheduleStatusCompleted, ScheduleStatusFailed, ScheduleStatusCancelled:
+		return true
+	}
+	return false
+}
+
+func (e ScheduleStatus) String() string {
+	return string(e)
+}
+
+func (e *ScheduleStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ScheduleStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ScheduleStatus", str)
+	}
+	return nil
+}
+
+func (e ScheduleStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ScheduleStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ScheduleStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type SchemaFieldTagColor string
 
 const (
@@ -3204,6 +4301,63 @@ func (e SchemaFieldTagColor) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type SchemaFieldTextPreset string
+
+const (
+	SchemaFieldTextPresetEmail      SchemaFieldTextPreset = "EMAIL"
+	SchemaFieldTextPresetPhone      SchemaFieldTextPreset = "PHONE"
+	SchemaFieldTextPresetPostalCode SchemaFieldTextPreset = "POSTAL_CODE"
+)
+
+var AllSchemaFieldTextPreset = []SchemaFieldTextPreset{
+	SchemaFieldTextPresetEmail,
+	SchemaFieldTextPresetPhone,
+	SchemaFieldTextPresetPostalCode,
+}
+
+func (e SchemaFieldTextPreset) IsValid() bool {
+	switch e {
+	case SchemaFieldTextPresetEmail, SchemaFieldTextPresetPhone, SchemaFieldTextPresetPostalCode:
+		return true
+	}
+	return false
+}
+
+func (e SchemaFieldTextPreset) String() string {
+	return string(e)
+}
+
+func (e *SchemaFieldTextPreset) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SchemaFieldTextPreset(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SchemaFieldTextPreset", str)
+	}
+	return nil
+}
+
+func (e SchemaFieldTextPreset) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SchemaFieldTextPreset) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SchemaFieldTextPreset) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type SchemaFieldType string
 
 const (
@@ -3289,6 +4443,185 @@ func (e SchemaFieldType) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type SchemaRuleCompareOperator string
+
+const (
+	SchemaRuleCompareOperatorLt  SchemaRuleCompareOperator = "LT"
+	SchemaRuleCompareOperatorLte SchemaRuleCompareOperator = "LTE"
+	SchemaRuleCompareOperatorGt  SchemaRuleCompareOperator = "GT"
+	SchemaRuleCompareOperatorGte SchemaRuleCompareOperator = "GTE"
+	SchemaRuleCompareOperatorEq  SchemaRuleCompareOperator = "EQ"
+	SchemaRuleCompareOperatorNeq SchemaRuleCompareOperator = "NEQ"
+)
+
+var AllSchemaRuleCompareOperator = []SchemaRuleCompareOperator{
+	SchemaRuleCompareOperatorLt,
+	SchemaRuleCompareOperatorLte,
+	SchemaRuleCompareOperatorGt,
+	SchemaRuleCompareOperatorGte,
+	SchemaRuleCompareOperatorEq,
+	SchemaRuleCompareOperatorNeq,
+}
+
+func (e SchemaRuleCompareOperator) IsValid() bool {
+	switch e {
+	case SchemaRuleCompareOperatorLt, SchemaRuleCompareOperatorLte, SchemaRuleCompareOperatorGt, SchemaRuleCompareOperatorGte, SchemaRuleCompareOperatorEq, SchemaRuleCompareOperatorNeq:
+		return true
+	}
+	return false
+}
+
+func (e SchemaRuleCompareOperator) String() string {
+	return string(e)
+}
+
+func (e *SchemaRuleCompareOperator) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SchemaRuleCompareOperator(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SchemaRuleCompareOperator", str)
+	}
+	return nil
+}
+
+func (e SchemaRuleCompareOperator) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SchemaRuleCompareOperator) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SchemaRuleCompareOperator) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SchemaRuleConditionOperator string
+
+const (
+	SchemaRuleConditionOperatorEq       SchemaRuleConditionOperator = "EQ"
+	SchemaRuleConditionOperatorNeq      SchemaRuleConditionOperator = "NEQ"
+	SchemaRuleConditionOperatorEmpty    SchemaRuleConditionOperator = "EMPTY"
+	SchemaRuleConditionOperatorNotEmpty SchemaRuleConditionOperator = "NOT_EMPTY"
+)
+
+var AllSchemaRuleConditionOperator = []SchemaRuleConditionOperator{
+	SchemaRuleConditionOperatorEq,
+	SchemaRuleConditionOperatorNeq,
+	SchemaRuleConditionOperatorEmpty,
+	SchemaRuleConditionOperatorNotEmpty,
+}
+
+func (e SchemaRuleConditionOperator) IsValid() bool {
+	switch e {
+	case SchemaRuleConditionOperatorEq, SchemaRuleConditionOperatorNeq, SchemaRuleConditionOperatorEmpty, SchemaRuleConditionOperatorNotEmpty:
+		return true
+	}
+	return false
+}
+
+func (e SchemaRuleConditionOperator) String() string {
+	return string(e)
+}
+
+func (e *SchemaRuleConditionOperator) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SchemaRuleConditionOperator(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SchemaRuleConditionOperator", str)
+	}
+	return nil
+}
+
+func (e SchemaRuleConditionOperator) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SchemaRuleConditionOperator) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SchemaRuleConditionOperator) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SchemaRuleType string
+
+const (
+	SchemaRuleTypeRequiredIf SchemaRuleType = "REQUIRED_IF"
+	SchemaRuleTypeVisibleIf  SchemaRuleType = "VISIBLE_IF"
+	SchemaRuleTypeCompare    SchemaRuleType = "COMPARE"
+)
+
+var AllSchemaRuleType = []SchemaRuleType{
+	SchemaRuleTypeRequiredIf,
+	SchemaRuleTypeVisibleIf,
+	SchemaRuleTypeCompare,
+}
+
+func (e SchemaRuleType) IsValid() bool {
+	switch e {
+	case SchemaRuleTypeRequiredIf, SchemaRuleTypeVisibleIf, SchemaRuleTypeCompare:
+		return true
+	}
+	return false
+}
+
+func (e SchemaRuleType) String() string {
+	return string(e)
+}
+
+func (e *SchemaRuleType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SchemaRuleType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SchemaRuleType", str)
+	}
+	return nil
+}
+
+func (e SchemaRuleType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SchemaRuleType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SchemaRuleType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type SortDirection string
 
 const (
@@ -3344,6 +4677,63 @@ func (e SortDirection) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type SpatialOperator string
+
+const (
+	SpatialOperatorBbox       SpatialOperator = "BBOX"
+	SpatialOperatorIntersects SpatialOperator = "INTERSECTS"
+	SpatialOperatorNear       SpatialOperator = "NEAR"
+)
+
+var AllSpatialOperator = []SpatialOperator{
+	SpatialOperatorBbox,
+	SpatialOperatorIntersects,
+	SpatialOperatorNear,
+}
+
+func (e SpatialOperator) IsValid() bool {
+	switch e {
+	case SpatialOperatorBbox, SpatialOperatorIntersects, SpatialOperatorNear:
+		return true
+	}
+	return false
+}
+
+func (e SpatialOperator) String() string {
+	return string(e)
+}
+
+func (e *SpatialOperator) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SpatialOperator(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SpatialOperator", str)
+	}
+	return nil
+}
+
+func (e SpatialOperator) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SpatialOperator) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SpatialOperator) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type StringOperator string
 
 const (
@@ -3646,3 +5036,119 @@ func (e TimeOperator) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryStatusRetrying  WebhookDeliveryStatus = "RETRYING"
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "DEAD"
+)
+
+var AllWebhookDeliveryStatus = []WebhookDeliveryStatus{
+	WebhookDeliveryStatusPending,
+	WebhookDeliveryStatusSucceeded,
+	WebhookDeliveryStatusRetrying,
+	WebhookDeliveryStatusDead,
+}
+
+func (e WebhookDeliveryStatus) IsValid() bool {
+	switch e {
+	case WebhookDeliveryStatusPending, WebhookDeliveryStatusSucceeded, WebhookDeliveryStatusRetrying, WebhookDeliveryStatusDead:
+		return true
+	}
+	return false
+}
+
+func (e WebhookDeliveryStatus) String() string {
+	return string(e)
+}
+
+func (e *WebhookDeliveryStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookDeliveryStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookDeliveryStatus", str)
+	}
+	return nil
+}
+
+func (e WebhookDeliveryStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WebhookDeliveryStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WebhookDeliveryStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type WebhookPayloadType string
+
+const (
+	WebhookPayloadTypeFull       WebhookPayloadType = "FULL"
+	WebhookPayloadTypeMinimal    WebhookPayloadType = "MINIMAL"
+	WebhookPayloadTypeProjection WebhookPayloadType = "PROJECTION"
+)
+
+var AllWebhookPayloadType = []WebhookPayloadType{
+	WebhookPayloadTypeFull,
+	WebhookPayloadTypeMinimal,
+	WebhookPayloadTypeProjection,
+}
+
+func (e WebhookPayloadType) IsValid() bool {
+	switch e {
+	case WebhookPayloadTypeFull, WebhookPayloadTypeMinimal, WebhookPayloadTypeProjection:
+		return true
+	}
+	return false
+}
+
+func (e WebhookPayloadType) String() string {
+	return string(e)
+}
+
+func (e *WebhookPayloadType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookPayloadType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookPayloadType", str)
+	}
+	return nil
+}
+
+func (e WebhookPayloadType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WebhookPayloadType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WebhookPayloadType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
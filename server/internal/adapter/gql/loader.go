@@ -17,6 +17,7 @@ const (
 type Loaders struct {
 	usecases          interfaces.Container
 	Asset             *AssetLoader
+	AssetFolder       *AssetFolderLoader
 	Workspace         *WorkspaceLoader
 	Item              *ItemLoader
 	View              *ViewLoader
@@ -40,6 +41,7 @@ func NewLoaders(usecases *interfaces.Container) *Loaders {
 	return &Loaders{
 		usecases:          *usecases,
 		Asset:             NewAssetLoader(usecases.Asset),
+		AssetFolder:       NewAssetFolderLoader(usecases.AssetFolder),
 		Workspace:         NewWorkspaceLoader(usecases.Workspace),
 		User:              NewUserLoader(usecases.User),
 		Project:           NewProjectLoader(usecases.Project),
@@ -72,6 +72,10 @@ func (c *AssetLoader) Search(ctx context.Context, query gqlmodel.AssetQueryInput
 		Sort:         sort.Into(),
 		Pagination:   pagination.Into(),
 		ContentTypes: ct,
+		Folder:       gqlmodel.ToIDRef[id.AssetFolder](query.FolderID),
+		RootFolder:   lo.FromPtr(query.RootFolder),
+		Recursive:    lo.FromPtr(query.Recursive),
+		Metadata:     gqlmodel.FromAssetMetadataInput(query.Metadata),
 	}
 
 	assets, pi, err := c.usecase.Search(ctx, pID, filter, getOperator(ctx))
@@ -0,0 +1,26 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+)
+
+type AssetFolderLoader struct {
+	usecase interfaces.AssetFolder
+}
+
+func NewAssetFolderLoader(usecase interfaces.AssetFolder) *AssetFolderLoader {
+	return &AssetFolderLoader{usecase: usecase}
+}
+
+// Payload loads the folders of the project to resolve the path and the effective permission of the folder.
+func (c *AssetFolderLoader) Payload(ctx context.Context, f *asset.Folder) (*gqlmodel.AssetFolderPayload, error) {
+	folders, err := c.usecase.FindByProject(ctx, f.Project(), getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodel.AssetFolderPayload{Folder: gqlmodel.ToAssetFolder(f, folders)}, nil
+}
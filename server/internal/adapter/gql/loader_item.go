@@ -89,6 +89,9 @@ func (c *ItemLoader) FindVersionedItem(ctx context.Context, itemID gqlmodel.ID,
 	if err != nil {
 		return nil, err
 	}
+	if len(ss) == 0 {
+		return nil, rerror.ErrNotFound
+	}
 
 	return gqlmodel.ToVersionedItem(itm, ss[0], gs), nil
 }
@@ -104,11 +107,17 @@ func (c *ItemLoader) FindVersionedItems(ctx context.Context, itemID gqlmodel.ID)
 	if err != nil {
 		return nil, err
 	}
+	if len(res) == 0 {
+		return nil, rerror.ErrNotFound
+	}
 
 	ss, gs, err := c.schemaUsecase.GetSchemasAndGroupSchemasByIDs(ctx, id.SchemaIDList{res[0].Value().Schema()}, op)
 	if err != nil {
 		return nil, err
 	}
+	if len(ss) == 0 {
+		return nil, rerror.ErrNotFound
+	}
 	vis := make([]*gqlmodel.VersionedItem, 0, len(res))
 	for _, t := range res {
 		vis = append(vis, gqlmodel.ToVersionedItem(t, ss[0], gs))
@@ -116,6 +125,29 @@ func (c *ItemLoader) FindVersionedItems(ctx context.Context, itemID gqlmodel.ID)
 	return vis, nil
 }
 
+func (c *ItemLoader) FindVersionDiff(ctx context.Context, itemID gqlmodel.ID, from, to version.VersionOrRef) (*gqlmodel.ItemVersionDiff, error) {
+	op := getOperator(ctx)
+	iId, err := gqlmodel.ToID[id.Item](itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.usecase.Diff(ctx, iId, from, to, op)
+	if err != nil {
+		return nil, err
+	}
+
+	ss, gs, err := c.schemaUsecase.GetSchemasAndGroupSchemasByIDs(ctx, id.SchemaIDList{res.To.Value().Schema()}, op)
+	if err != nil {
+		return nil, err
+	}
+	if len(ss) == 0 {
+		return nil, rerror.ErrNotFound
+	}
+
+	return gqlmodel.ToItemVersionDiff(res, ss[0], gs), nil
+}
+
 func (c *ItemLoader) Search(ctx context.Context, query gqlmodel.SearchItemInput) (*gqlmodel.ItemConnection, error) {
 	_, span := trace.StartSpan(ctx, "loader/item/search")
 	t := time.Now()
@@ -164,6 +196,46 @@ func (c *ItemLoader) Search(ctx context.Context, query gqlmodel.SearchItemInput)
 	}, nil
 }
 
+func (c *ItemLoader) FindTrashed(ctx context.Context, modelID gqlmodel.ID, p *gqlmodel.Pagination) (*gqlmodel.TrashedItemConnection, error) {
+	op := getOperator(ctx)
+	mid, err := gqlmodel.ToID[id.Model](modelID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, pi, err := c.usecase.FindTrashed(ctx, mid, p.Into(), op)
+	if err != nil {
+		return nil, err
+	}
+
+	sIDs := lo.Uniq(lo.Map(res, func(ti interfaces.TrashedItem, _ int) id.SchemaID { return ti.Item.Value().Schema() }))
+	ss, gs, err := c.schemaUsecase.GetSchemasAndGroupSchemasByIDs(ctx, sIDs, op)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*gqlmodel.TrashedItemEdge, 0, len(res))
+	nodes := make([]*gqlmodel.TrashedItem, 0, len(res))
+	for _, ti := range res {
+		s, _ := lo.Find(ss, func(s *schema.Schema) bool {
+			return s.ID() == ti.Item.Value().Schema()
+		})
+		itm := gqlmodel.ToTrashedItem(ti, s, gs)
+		edges = append(edges, &gqlmodel.TrashedItemEdge{
+			Node:   itm,
+			Cursor: usecasex.Cursor(itm.Item.ID),
+		})
+		nodes = append(nodes, itm)
+	}
+
+	return &gqlmodel.TrashedItemConnection{
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo:   gqlmodel.ToPageInfo(pi),
+		TotalCount: int(pi.TotalCount),
+	}, nil
+}
+
 func (c *ItemLoader) IsItemReferenced(ctx context.Context, itemID gqlmodel.ID, correspondingFieldID gqlmodel.ID) (bool, error) {
 	op := getOperator(ctx)
 	iid, err := gqlmodel.ToID[id.Item](itemID)
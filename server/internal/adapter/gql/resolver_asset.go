@@ -50,6 +50,35 @@ func (r *assetResolver) Thread(ctx context.Context, obj *gqlmodel.Asset) (*gqlmo
 	return dataloaders(ctx).Thread.Load(*obj.ThreadID)
 }
 
+// ThumbnailURL is the resolver for the thumbnailUrl field.
+func (r *assetResolver) ThumbnailURL(ctx context.Context, obj *gqlmodel.Asset) (*string, error) {
+	t := gateways(ctx).ImageTransformer
+	if t == nil || obj.PreviewType == nil || *obj.PreviewType != gqlmodel.PreviewTypeImage {
+		return nil, nil
+	}
+	return new(t.ThumbnailURL(obj.UUID, obj.FileName)), nil
+}
+
+// Folder is the resolver for the folder field.
+func (r *assetResolver) Folder(ctx context.Context, obj *gqlmodel.Asset) (*gqlmodel.AssetFolder, error) {
+	if obj.FolderID == nil {
+		return nil, nil
+	}
+	pid, err := gqlmodel.ToID[id.Project](obj.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	folders, err := usecases(ctx).AssetFolder.FindByProject(ctx, pid, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+	fid, err := gqlmodel.ToID[id.AssetFolder](*obj.FolderID)
+	if err != nil {
+		return nil, err
+	}
+	return gqlmodel.ToAssetFolder(folders.Folder(fid), folders), nil
+}
+
 // CreateAsset is the resolver for the createAsset field.
 func (r *mutationResolver) CreateAsset(ctx context.Context, input gqlmodel.CreateAssetInput) (*gqlmodel.CreateAssetPayload, error) {
 	uc := usecases(ctx).Asset
@@ -71,6 +100,7 @@ func (r *mutationResolver) CreateAsset(ctx context.Context, input gqlmodel.Creat
 	}
 	params.Token = lo.FromPtr(input.Token)
 	params.SkipDecompression = lo.FromPtr(input.SkipDecompression)
+	params.Folder = gqlmodel.ToIDRef[id.AssetFolder](input.FolderID)
 
 	res, _, err := uc.Create(ctx, params, getOperator(ctx))
 	if err != nil {
@@ -93,6 +123,7 @@ func (r *mutationResolver) UpdateAsset(ctx context.Context, input gqlmodel.Updat
 	res, err2 := uc.Update(ctx, interfaces.UpdateAssetParam{
 		AssetID:     aid,
 		PreviewType: gqlmodel.FromPreviewType(input.PreviewType),
+		Metadata:    gqlmodel.FromAssetMetadataInput(input.Metadata),
 	}, getOperator(ctx))
 	if err2 != nil {
 		return nil, err2
@@ -103,6 +134,53 @@ func (r *mutationResolver) UpdateAsset(ctx context.Context, input gqlmodel.Updat
 	}, nil
 }
 
+// ReplaceAssetFile is the resolver for the replaceAssetFile field.
+func (r *mutationResolver) ReplaceAssetFile(ctx context.Context, input gqlmodel.ReplaceAssetFileInput) (*gqlmodel.ReplaceAssetFilePayload, error) {
+	aid, err := gqlmodel.ToID[id.Asset](input.AssetID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := interfaces.ReplaceAssetFileParam{
+		AssetID: aid,
+		File:    gqlmodel.FromFile(input.File),
+	}
+	if input.URL != nil {
+		params.File, err = file.FromURL(ctx, *input.URL)
+		if err != nil {
+			return nil, err
+		}
+	}
+	params.Token = lo.FromPtr(input.Token)
+	params.SkipDecompression = lo.FromPtr(input.SkipDecompression)
+
+	res, _, err := usecases(ctx).Asset.ReplaceFile(ctx, params, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.ReplaceAssetFilePayload{
+		Asset: gqlmodel.ToAsset(res),
+	}, nil
+}
+
+// RestoreAssetVersion is the resolver for the restoreAssetVersion field.
+func (r *mutationResolver) RestoreAssetVersion(ctx context.Context, input gqlmodel.RestoreAssetVersionInput) (*gqlmodel.RestoreAssetVersionPayload, error) {
+	aid, err := gqlmodel.ToID[id.Asset](input.AssetID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, _, err := usecases(ctx).Asset.RestoreVersion(ctx, aid, input.UUID, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.RestoreAssetVersionPayload{
+		Asset: gqlmodel.ToAsset(res),
+	}, nil
+}
+
 // DeleteAsset is the resolver for the deleteAsset field.
 func (r *mutationResolver) DeleteAsset(ctx context.Context, input gqlmodel.DeleteAssetInput) (*gqlmodel.DeleteAssetPayload, error) {
 	aid, err := gqlmodel.ToID[id.Asset](input.AssetID)
@@ -204,6 +282,9 @@ func (r *queryResolver) Assets(ctx context.Context, input gqlmodel.SearchAssetsI
 		Project:      input.Query.Project,
 		Keyword:      input.Query.Keyword,
 		ContentTypes: input.Query.ContentTypes,
+		FolderID:     input.Query.FolderID,
+		RootFolder:   input.Query.RootFolder,
+		Recursive:    input.Query.Recursive,
 	}, input.Sort, input.Pagination)
 }
 
@@ -0,0 +1,121 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/samber/lo"
+)
+
+// CreateAssetFolder is the resolver for the createAssetFolder field.
+func (r *mutationResolver) CreateAssetFolder(ctx context.Context, input gqlmodel.CreateAssetFolderInput) (*gqlmodel.AssetFolderPayload, error) {
+	pid, err := gqlmodel.ToID[id.Project](input.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).AssetFolder.Create(ctx, interfaces.CreateAssetFolderParam{
+		ProjectID:  pid,
+		Parent:     gqlmodel.ToIDRef[id.AssetFolder](input.ParentID),
+		Name:       input.Name,
+		Permission: gqlmodel.FromAssetFolderPermission(input.Permission),
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return loaders(ctx).AssetFolder.Payload(ctx, res)
+}
+
+// UpdateAssetFolder is the resolver for the updateAssetFolder field.
+func (r *mutationResolver) UpdateAssetFolder(ctx context.Context, input gqlmodel.UpdateAssetFolderInput) (*gqlmodel.AssetFolderPayload, error) {
+	fid, err := gqlmodel.ToID[id.AssetFolder](input.FolderID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).AssetFolder.Update(ctx, interfaces.UpdateAssetFolderParam{
+		FolderID:          fid,
+		Name:              input.Name,
+		Permission:        gqlmodel.FromAssetFolderPermission(input.Permission),
+		InheritPermission: lo.FromPtr(input.InheritPermission),
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return loaders(ctx).AssetFolder.Payload(ctx, res)
+}
+
+// MoveAssetFolder is the resolver for the moveAssetFolder field.
+func (r *mutationResolver) MoveAssetFolder(ctx context.Context, input gqlmodel.MoveAssetFolderInput) (*gqlmodel.AssetFolderPayload, error) {
+	fid, err := gqlmodel.ToID[id.AssetFolder](input.FolderID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).AssetFolder.Move(ctx, fid, gqlmodel.ToIDRef[id.AssetFolder](input.ParentID), getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return loaders(ctx).AssetFolder.Payload(ctx, res)
+}
+
+// DeleteAssetFolder is the resolver for the deleteAssetFolder field.
+func (r *mutationResolver) DeleteAssetFolder(ctx context.Context, input gqlmodel.DeleteAssetFolderInput) (*gqlmodel.DeleteAssetFolderPayload, error) {
+	fid, err := gqlmodel.ToID[id.AssetFolder](input.FolderID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).AssetFolder.Delete(ctx, fid, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.DeleteAssetFolderPayload{FolderID: gqlmodel.IDFrom(res)}, nil
+}
+
+// MoveAssets is the resolver for the moveAssets field.
+func (r *mutationResolver) MoveAssets(ctx context.Context, input gqlmodel.MoveAssetsInput) (*gqlmodel.MoveAssetsPayload, error) {
+	aids, err := gqlmodel.ToIDs[id.Asset](input.AssetIds)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Asset.Move(ctx, interfaces.MoveAssetsParam{
+		AssetIDs: aids,
+		Folder:   gqlmodel.ToIDRef[id.AssetFolder](input.FolderID),
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.MoveAssetsPayload{
+		Assets: lo.Map(res, func(a *asset.Asset, _ int) *gqlmodel.Asset { return gqlmodel.ToAsset(a) }),
+	}, nil
+}
+
+// AssetFolders is the resolver for the assetFolders field.
+func (r *queryResolver) AssetFolders(ctx context.Context, projectID gqlmodel.ID) ([]*gqlmodel.AssetFolder, error) {
+	pid, err := gqlmodel.ToID[id.Project](projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).AssetFolder.FindByProject(ctx, pid, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return gqlmodel.ToAssetFolders(res), nil
+}
@@ -21,11 +21,13 @@ import (
 func (r *mutationResolver) CreateField(ctx context.Context, input gqlmodel.CreateFieldInput) (*gqlmodel.FieldPayload, error) {
 	mid := gqlmodel.ToIDRef[id.Model](input.ModelID)
 	gid := gqlmodel.ToIDRef[id.Group](input.GroupID)
+	pid := gqlmodel.ToIDRef[id.Project](input.ProjectID)
 	param := interfaces.FindOrCreateSchemaParam{
-		ModelID:  mid,
-		GroupID:  gid,
-		Metadata: input.Metadata,
-		Create:   true,
+		ModelID:   mid,
+		GroupID:   gid,
+		ProjectID: pid,
+		Metadata:  input.Metadata,
+		Create:    true,
 	}
 	s, err := usecases(ctx).Model.FindOrCreateSchema(ctx, param, getOperator(ctx))
 	if err != nil {
@@ -48,6 +50,7 @@ func (r *mutationResolver) CreateField(ctx context.Context, input gqlmodel.Creat
 		Unique:       input.Unique,
 		Required:     input.Required,
 		IsTitle:      input.IsTitle,
+		Localizable:  lo.FromPtr(input.Localizable),
 		DefaultValue: dv,
 		TypeProperty: tp,
 	}, getOperator(ctx))
@@ -64,20 +67,22 @@ func (r *mutationResolver) CreateField(ctx context.Context, input gqlmodel.Creat
 func (r *mutationResolver) CreateFields(ctx context.Context, input []*gqlmodel.CreateFieldInput) (*gqlmodel.FieldsPayload, error) {
 	mid := gqlmodel.ToIDRef[id.Model](input[0].ModelID)
 	gid := gqlmodel.ToIDRef[id.Group](input[0].GroupID)
-	if mid == nil && gid == nil {
+	pid := gqlmodel.ToIDRef[id.Project](input[0].ProjectID)
+	if mid == nil && gid == nil && pid == nil {
 		return nil, interfaces.ErrEitherModelOrGroup
 	}
 	for _, ipt := range input {
-		if !utils.IsPtrEqual(ipt.ModelID, input[0].ModelID) || !utils.IsPtrEqual(ipt.GroupID, input[0].GroupID) {
+		if !utils.IsPtrEqual(ipt.ModelID, input[0].ModelID) || !utils.IsPtrEqual(ipt.GroupID, input[0].GroupID) || !utils.IsPtrEqual(ipt.ProjectID, input[0].ProjectID) {
 			return nil, interfaces.ErrEitherModelOrGroup
 		}
 	}
 
 	param := interfaces.FindOrCreateSchemaParam{
-		ModelID:  mid,
-		GroupID:  gid,
-		Metadata: input[0].Metadata,
-		Create:   true,
+		ModelID:   mid,
+		GroupID:   gid,
+		ProjectID: pid,
+		Metadata:  input[0].Metadata,
+		Create:    true,
 	}
 	s, err := usecases(ctx).Model.FindOrCreateSchema(ctx, param, getOperator(ctx))
 	if err != nil {
@@ -99,6 +104,7 @@ func (r *mutationResolver) CreateFields(ctx context.Context, input []*gqlmodel.C
 			Unique:       ipt.Unique,
 			IsTitle:      ipt.IsTitle,
 			Required:     ipt.Required,
+			Localizable:  lo.FromPtr(ipt.Localizable),
 			DefaultValue: dv,
 			TypeProperty: tp,
 		}, nil
@@ -128,11 +134,13 @@ func (r *mutationResolver) UpdateField(ctx context.Context, input gqlmodel.Updat
 
 	mid := gqlmodel.ToIDRef[id.Model](input.ModelID)
 	gid := gqlmodel.ToIDRef[id.Group](input.GroupID)
+	pid := gqlmodel.ToIDRef[id.Project](input.ProjectID)
 	param := interfaces.FindOrCreateSchemaParam{
-		ModelID:  mid,
-		GroupID:  gid,
-		Metadata: input.Metadata,
-		Create:   true,
+		ModelID:   mid,
+		GroupID:   gid,
+		ProjectID: pid,
+		Metadata:  input.Metadata,
+		Create:    true,
 	}
 	s, err := usecases(ctx).Model.FindOrCreateSchema(ctx, param, getOperator(ctx))
 	if err != nil {
@@ -158,6 +166,7 @@ func (r *mutationResolver) UpdateField(ctx context.Context, input gqlmodel.Updat
 		Unique:       input.Unique,
 		Required:     input.Required,
 		IsTitle:      input.IsTitle,
+		Localizable:  input.Localizable,
 		DefaultValue: dv,
 		TypeProperty: tp,
 	}, getOperator(ctx))
@@ -174,11 +183,13 @@ func (r *mutationResolver) UpdateField(ctx context.Context, input gqlmodel.Updat
 func (r *mutationResolver) UpdateFields(ctx context.Context, input []*gqlmodel.UpdateFieldInput) (*gqlmodel.FieldsPayload, error) {
 	mid := gqlmodel.ToIDRef[id.Model](input[0].ModelID)
 	gid := gqlmodel.ToIDRef[id.Group](input[0].GroupID)
+	pid := gqlmodel.ToIDRef[id.Project](input[0].ProjectID)
 	param := interfaces.FindOrCreateSchemaParam{
-		ModelID:  mid,
-		GroupID:  gid,
-		Metadata: input[0].Metadata,
-		Create:   true,
+		ModelID:   mid,
+		GroupID:   gid,
+		ProjectID: pid,
+		Metadata:  input[0].Metadata,
+		Create:    true,
 	}
 	s, err := usecases(ctx).Model.FindOrCreateSchema(ctx, param, getOperator(ctx))
 	if err != nil {
@@ -207,6 +218,7 @@ func (r *mutationResolver) UpdateFields(ctx context.Context, input []*gqlmodel.U
 			Unique:       ipt.Unique,
 			IsTitle:      ipt.IsTitle,
 			Required:     ipt.Required,
+			Localizable:  ipt.Localizable,
 			DefaultValue: dv,
 			TypeProperty: tp,
 		}, nil
@@ -236,11 +248,13 @@ func (r *mutationResolver) DeleteField(ctx context.Context, input gqlmodel.Delet
 
 	mid := gqlmodel.ToIDRef[id.Model](input.ModelID)
 	gid := gqlmodel.ToIDRef[id.Group](input.GroupID)
+	pid := gqlmodel.ToIDRef[id.Project](input.ProjectID)
 	param := interfaces.FindOrCreateSchemaParam{
-		ModelID:  mid,
-		GroupID:  gid,
-		Metadata: input.Metadata,
-		Create:   true,
+		ModelID:   mid,
+		GroupID:   gid,
+		ProjectID: pid,
+		Metadata:  input.Metadata,
+		Create:    true,
 	}
 	s, err := usecases(ctx).Model.FindOrCreateSchema(ctx, param, getOperator(ctx))
 	if err != nil {
@@ -12,6 +12,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/usecasex"
 	"github.com/samber/lo"
 )
 
@@ -22,6 +23,11 @@ func (r *mutationResolver) CreateWebhook(ctx context.Context, input gqlmodel.Cre
 		return nil, err
 	}
 
+	filter, err := gqlmodel.ToWebhookFilterParam(input.Filter)
+	if err != nil {
+		return nil, err
+	}
+
 	res, err := usecases(ctx).Integration.CreateWebhook(ctx, iId, interfaces.CreateWebhookParam{
 		Name:   input.Name,
 		URL:    input.URL,
@@ -33,10 +39,26 @@ func (r *mutationResolver) CreateWebhook(ctx context.Context, input gqlmodel.Cre
 			event.ItemPublish:     lo.FromPtrOr(input.Trigger.OnItemPublish, false),
 			event.ItemUnpublish:   lo.FromPtrOr(input.Trigger.OnItemUnPublish, false),
 			event.AssetCreate:     lo.FromPtrOr(input.Trigger.OnAssetUpload, false),
+			event.AssetUpdate:     lo.FromPtrOr(input.Trigger.OnAssetUpdate, false),
 			event.AssetDecompress: lo.FromPtrOr(input.Trigger.OnAssetDecompress, false),
 			event.AssetDelete:     lo.FromPtrOr(input.Trigger.OnAssetDelete, false),
+			event.ModelCreate:     lo.FromPtrOr(input.Trigger.OnModelCreate, false),
+			event.ModelUpdate:     lo.FromPtrOr(input.Trigger.OnModelUpdate, false),
+			event.ModelDelete:     lo.FromPtrOr(input.Trigger.OnModelDelete, false),
+			event.FieldCreate:     lo.FromPtrOr(input.Trigger.OnFieldCreate, false),
+			event.FieldUpdate:     lo.FromPtrOr(input.Trigger.OnFieldUpdate, false),
+			event.FieldDelete:     lo.FromPtrOr(input.Trigger.OnFieldDelete, false),
+			event.RequestCreate:   lo.FromPtrOr(input.Trigger.OnRequestCreate, false),
+			event.RequestUpdate:   lo.FromPtrOr(input.Trigger.OnRequestUpdate, false),
+			event.RequestApprove:  lo.FromPtrOr(input.Trigger.OnRequestApprove, false),
+			event.RequestClose:    lo.FromPtrOr(input.Trigger.OnRequestClose, false),
+			event.CommentCreate:   lo.FromPtrOr(input.Trigger.OnCommentCreate, false),
+			event.CommentUpdate:   lo.FromPtrOr(input.Trigger.OnCommentUpdate, false),
+			event.CommentDelete:   lo.FromPtrOr(input.Trigger.OnCommentDelete, false),
 		},
-		Secret: input.Secret,
+		Filter:  filter,
+		Payload: gqlmodel.ToWebhookPayloadParam(input.Payload),
+		Secret:  input.Secret,
 	}, getOperator(ctx))
 	if err != nil {
 		return nil, err
@@ -54,6 +76,11 @@ func (r *mutationResolver) UpdateWebhook(ctx context.Context, input gqlmodel.Upd
 		return nil, err
 	}
 
+	filter, err := gqlmodel.ToWebhookFilterParam(input.Filter)
+	if err != nil {
+		return nil, err
+	}
+
 	res, err := usecases(ctx).Integration.UpdateWebhook(ctx, iId, wId, interfaces.UpdateWebhookParam{
 		Name:   input.Name,
 		URL:    input.URL,
@@ -65,10 +92,26 @@ func (r *mutationResolver) UpdateWebhook(ctx context.Context, input gqlmodel.Upd
 			event.ItemPublish:     lo.FromPtrOr(input.Trigger.OnItemPublish, false),
 			event.ItemUnpublish:   lo.FromPtrOr(input.Trigger.OnItemUnPublish, false),
 			event.AssetCreate:     lo.FromPtrOr(input.Trigger.OnAssetUpload, false),
+			event.AssetUpdate:     lo.FromPtrOr(input.Trigger.OnAssetUpdate, false),
 			event.AssetDecompress: lo.FromPtrOr(input.Trigger.OnAssetDecompress, false),
 			event.AssetDelete:     lo.FromPtrOr(input.Trigger.OnAssetDelete, false),
+			event.ModelCreate:     lo.FromPtrOr(input.Trigger.OnModelCreate, false),
+			event.ModelUpdate:     lo.FromPtrOr(input.Trigger.OnModelUpdate, false),
+			event.ModelDelete:     lo.FromPtrOr(input.Trigger.OnModelDelete, false),
+			event.FieldCreate:     lo.FromPtrOr(input.Trigger.OnFieldCreate, false),
+			event.FieldUpdate:     lo.FromPtrOr(input.Trigger.OnFieldUpdate, false),
+			event.FieldDelete:     lo.FromPtrOr(input.Trigger.OnFieldDelete, false),
+			event.RequestCreate:   lo.FromPtrOr(input.Trigger.OnRequestCreate, false),
+			event.RequestUpdate:   lo.FromPtrOr(input.Trigger.OnRequestUpdate, false),
+			event.RequestApprove:  lo.FromPtrOr(input.Trigger.OnRequestApprove, false),
+			event.RequestClose:    lo.FromPtrOr(input.Trigger.OnRequestClose, false),
+			event.CommentCreate:   lo.FromPtrOr(input.Trigger.OnCommentCreate, false),
+			event.CommentUpdate:   lo.FromPtrOr(input.Trigger.OnCommentUpdate, false),
+			event.CommentDelete:   lo.FromPtrOr(input.Trigger.OnCommentDelete, false),
 		},
-		Secret: input.Secret,
+		Filter:  filter,
+		Payload: gqlmodel.ToWebhookPayloadParam(input.Payload),
+		Secret:  input.Secret,
 	}, getOperator(ctx))
 	if err != nil {
 		return nil, err
@@ -95,3 +138,51 @@ func (r *mutationResolver) DeleteWebhook(ctx context.Context, input gqlmodel.Del
 		WebhookID: input.WebhookID,
 	}, nil
 }
+
+// RedeliverWebhook is the resolver for the redeliverWebhook field.
+func (r *mutationResolver) RedeliverWebhook(ctx context.Context, input gqlmodel.RedeliverWebhookInput) (*gqlmodel.RedeliverWebhookPayload, error) {
+	wId, dId, err := gqlmodel.ToID2[id.Webhook, id.WebhookDelivery](input.WebhookID, input.DeliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Integration.RedeliverWebhook(ctx, wId, dId, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.RedeliverWebhookPayload{
+		Delivery: gqlmodel.ToWebhookDelivery(res),
+	}, nil
+}
+
+// WebhookDeliveries is the resolver for the webhookDeliveries field.
+func (r *queryResolver) WebhookDeliveries(ctx context.Context, integrationID gqlmodel.ID, webhookID gqlmodel.ID, pagination *gqlmodel.Pagination) (*gqlmodel.WebhookDeliveryConnection, error) {
+	iId, wId, err := gqlmodel.ToID2[id.Integration, id.Webhook](integrationID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, pi, err := usecases(ctx).Integration.FindWebhookDeliveries(ctx, iId, wId, pagination.Into(), getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*gqlmodel.WebhookDeliveryEdge, 0, len(res))
+	nodes := make([]*gqlmodel.WebhookDelivery, 0, len(res))
+	for _, d := range res {
+		gd := gqlmodel.ToWebhookDelivery(d)
+		edges = append(edges, &gqlmodel.WebhookDeliveryEdge{
+			Node:   gd,
+			Cursor: usecasex.Cursor(gd.ID),
+		})
+		nodes = append(nodes, gd)
+	}
+
+	return &gqlmodel.WebhookDeliveryConnection{
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo:   gqlmodel.ToPageInfo(pi),
+		TotalCount: int(pi.TotalCount),
+	}, nil
+}
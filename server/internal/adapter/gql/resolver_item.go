@@ -438,6 +438,40 @@ func (r *mutationResolver) ImportItemsAsync(ctx context.Context, input gqlmodel.
 	}, nil
 }
 
+// BulkUpdateItems is the resolver for the bulkUpdateItems field.
+func (r *mutationResolver) BulkUpdateItems(ctx context.Context, input gqlmodel.BulkUpdateItemsInput) (*gqlmodel.BulkUpdateItemsPayload, error) {
+	op := getOperator(ctx)
+
+	mid, err := gqlmodel.ToID[id.Model](input.ModelID)
+	if err != nil {
+		return nil, err
+	}
+
+	sp, err := usecases(ctx).Schema.FindByModel(ctx, mid, op)
+	if err != nil {
+		return nil, err
+	}
+
+	jobID, err := usecases(ctx).Item.BulkUpdateAsync(ctx, interfaces.BulkUpdateItemsParam{
+		ModelID:       mid,
+		SchemaPackage: *sp,
+		Filter:        input.Filter.Into(),
+		Fields:        util.DerefSlice(util.Map(input.Fields, gqlmodel.ToItemParam)),
+	}, op)
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := usecases(ctx).Job.FindByID(ctx, jobID, op)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.BulkUpdateItemsPayload{
+		Job: gqlmodel.ToJob(j),
+	}, nil
+}
+
 // VersionsByItem is the resolver for the versionsByItem field.
 func (r *queryResolver) VersionsByItem(ctx context.Context, itemID gqlmodel.ID) ([]*gqlmodel.VersionedItem, error) {
 	return loaders(ctx).Item.FindVersionedItems(ctx, itemID)
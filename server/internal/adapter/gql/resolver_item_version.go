@@ -0,0 +1,53 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/samber/lo"
+)
+
+// RestoreItemVersion is the resolver for the restoreItemVersion field.
+func (r *mutationResolver) RestoreItemVersion(ctx context.Context, input gqlmodel.RestoreItemVersionInput) (*gqlmodel.RestoreItemVersionPayload, error) {
+	op := getOperator(ctx)
+	iid, err := gqlmodel.ToID[id.Item](input.ItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, dropped, err := usecases(ctx).Item.Restore(ctx, iid, version.ParseVersionOrRef(input.Version), op)
+	if err != nil {
+		return nil, err
+	}
+
+	ss, gs, err := usecases(ctx).Schema.GetSchemasAndGroupSchemasByIDs(ctx, id.SchemaIDList{res.Value().Schema()}, op)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.RestoreItemVersionPayload{
+		Item: gqlmodel.ToItem(res, ss[0], gs),
+		DroppedFields: lo.Map(dropped, func(d item.DroppedField, _ int) *gqlmodel.DroppedField {
+			return gqlmodel.ToDroppedField(d)
+		}),
+	}, nil
+}
+
+// ItemVersionDiff is the resolver for the itemVersionDiff field.
+func (r *queryResolver) ItemVersionDiff(ctx context.Context, itemID gqlmodel.ID, from string, to string) (*gqlmodel.ItemVersionDiff, error) {
+	return loaders(ctx).Item.FindVersionDiff(ctx, itemID, version.ParseVersionOrRef(from), version.ParseVersionOrRef(to))
+}
+
+// Diff is the resolver for the diff field.
+func (r *requestItemResolver) Diff(ctx context.Context, obj *gqlmodel.RequestItem) (*gqlmodel.ItemVersionDiff, error) {
+	// reviewers see what is changed from the public version when the request is approved
+	return loaders(ctx).Item.FindVersionDiff(ctx, obj.ItemID, version.Public.OrVersion(), version.ToVersionOrLatestRef(obj.Version))
+}
@@ -212,6 +212,41 @@ func (r *mutationResolver) ExportModel(ctx context.Context, input gqlmodel.Expor
 	}, nil
 }
 
+// ExportModelAsync is the resolver for the exportModelAsync field.
+func (r *mutationResolver) ExportModelAsync(ctx context.Context, input gqlmodel.ExportModelAsyncInput) (*gqlmodel.ExportModelAsyncPayload, error) {
+	op, uc := adapter.Operator(ctx), adapter.Usecases(ctx)
+
+	mId, err := gqlmodel.ToID[id.Model](input.ModelID)
+	if err != nil {
+		return nil, err
+	}
+
+	sp, err := uc.Schema.FindByModel(ctx, mId, op)
+	if err != nil {
+		return nil, err
+	}
+
+	jobID, err := uc.Item.ExportAsync(ctx, interfaces.ExportItemsAsyncParam{
+		ModelID:       mId,
+		SchemaPackage: *sp,
+		Format:        gqlmodel.ToExportFormat(input.Format),
+		PublicOnly:    true,
+		IncludeAssets: lo.FromPtr(input.IncludeAssets),
+	}, op)
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := uc.Job.FindByID(ctx, jobID, op)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.ExportModelAsyncPayload{
+		Job: gqlmodel.ToJob(j),
+	}, nil
+}
+
 // ExportModelSchema is the resolver for the exportModelSchema field.
 func (r *mutationResolver) ExportModelSchema(ctx context.Context, input gqlmodel.ExportModelSchemaInput) (*gqlmodel.ExportModelSchemaPayload, error) {
 	op, uc, g := adapter.Operator(ctx), adapter.Usecases(ctx), adapter.Gateways(ctx)
@@ -0,0 +1,111 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+)
+
+// MarkNotificationsAsRead is the resolver for the markNotificationsAsRead field.
+func (r *mutationResolver) MarkNotificationsAsRead(ctx context.Context, input gqlmodel.MarkNotificationsAsReadInput) (*gqlmodel.MarkNotificationsAsReadPayload, error) {
+	ids, err := gqlmodel.ToIDs[id.Notification](input.NotificationIds)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Notification.MarkAsRead(ctx, ids, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.MarkNotificationsAsReadPayload{
+		Notifications: lo.Map(res, func(n *notification.Notification, _ int) *gqlmodel.Notification {
+			return gqlmodel.ToNotification(n)
+		}),
+	}, nil
+}
+
+// UpdateNotificationPreference is the resolver for the updateNotificationPreference field.
+func (r *mutationResolver) UpdateNotificationPreference(ctx context.Context, input gqlmodel.UpdateNotificationPreferenceInput) (*gqlmodel.NotificationPreferencePayload, error) {
+	param := interfaces.UpdateNotificationPreferenceParam{
+		EmailFrequency: gqlmodel.FromNotificationEmailFrequency(input.EmailFrequency),
+	}
+	if input.MutedTypes != nil {
+		param.MutedTypes = lo.Map(input.MutedTypes, func(t gqlmodel.NotificationType, _ int) notification.Type {
+			return gqlmodel.FromNotificationType(t)
+		})
+	}
+
+	p, err := usecases(ctx).Notification.UpdatePreference(ctx, param, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.NotificationPreferencePayload{
+		Preference: gqlmodel.ToNotificationPreference(p),
+	}, nil
+}
+
+// Actor is the resolver for the actor field.
+func (r *notificationResolver) Actor(ctx context.Context, obj *gqlmodel.Notification) (*gqlmodel.User, error) {
+	if obj.ActorID == nil {
+		return nil, nil
+	}
+	return dataloaders(ctx).User.Load(*obj.ActorID)
+}
+
+// Notifications is the resolver for the notifications field.
+func (r *queryResolver) Notifications(ctx context.Context, unreadOnly *bool, pagination *gqlmodel.Pagination) (*gqlmodel.NotificationConnection, error) {
+	res, pi, err := usecases(ctx).Notification.FindByUser(ctx, lo.FromPtr(unreadOnly), pagination.Into(), getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*gqlmodel.NotificationEdge, 0, len(res))
+	nodes := make([]*gqlmodel.Notification, 0, len(res))
+	for _, n := range res {
+		gn := gqlmodel.ToNotification(n)
+		edges = append(edges, &gqlmodel.NotificationEdge{
+			Node:   gn,
+			Cursor: usecasex.Cursor(gn.ID),
+		})
+		nodes = append(nodes, gn)
+	}
+
+	return &gqlmodel.NotificationConnection{
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo:   gqlmodel.ToPageInfo(pi),
+		TotalCount: int(pi.TotalCount),
+	}, nil
+}
+
+// UnreadNotificationCount is the resolver for the unreadNotificationCount field.
+func (r *queryResolver) UnreadNotificationCount(ctx context.Context) (int, error) {
+	count, err := usecases(ctx).Notification.CountUnread(ctx, getOperator(ctx))
+	return int(count), err
+}
+
+// NotificationPreference is the resolver for the notificationPreference field.
+func (r *queryResolver) NotificationPreference(ctx context.Context) (*gqlmodel.NotificationPreference, error) {
+	p, err := usecases(ctx).Notification.FindPreference(ctx, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return gqlmodel.ToNotificationPreference(p), nil
+}
+
+// Notification returns NotificationResolver implementation.
+func (r *Resolver) Notification() NotificationResolver { return &notificationResolver{r} }
+
+type notificationResolver struct{ *Resolver }
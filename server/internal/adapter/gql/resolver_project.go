@@ -70,6 +70,7 @@ func (r *mutationResolver) UpdateProject(ctx context.Context, input gqlmodel.Upd
 		Alias:         input.Alias,
 		Accessibility: pub,
 		RequestRoles:  lo.Map(input.RequestRoles, func(r gqlmodel.Role, _ int) workspace.Role { return gqlmodel.FromRole(r) }),
+		Localization:  gqlmodel.FromProjectLocalization(input.Localization),
 	}, getOperator(ctx))
 	if err != nil {
 		return nil, err
@@ -196,6 +197,14 @@ func (r *projectResolver) Workspace(ctx context.Context, obj *gqlmodel.Project)
 	return dataloaders(ctx).Workspace.Load(obj.WorkspaceID)
 }
 
+// AssetMetadataSchema is the resolver for the assetMetadataSchema field.
+func (r *projectResolver) AssetMetadataSchema(ctx context.Context, obj *gqlmodel.Project) (*gqlmodel.Schema, error) {
+	if obj.AssetMetadataSchemaID == nil {
+		return nil, nil
+	}
+	return dataloaders(ctx).Schema.Load(*obj.AssetMetadataSchemaID)
+}
+
 // Projects is the resolver for the projects field.
 func (r *queryResolver) Projects(ctx context.Context, workspaceID gqlmodel.ID, keyword *string, sort *gqlmodel.Sort, pagination *gqlmodel.Pagination) (*gqlmodel.ProjectConnection, error) {
 	return loaders(ctx).Project.FindByWorkspace(ctx, workspaceID, keyword, sort, pagination)
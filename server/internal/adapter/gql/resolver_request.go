@@ -14,6 +14,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/request"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
 )
 
 // CreateRequest is the resolver for the createRequest field.
@@ -114,6 +115,22 @@ func (r *mutationResolver) ApproveRequest(ctx context.Context, input gqlmodel.Ap
 	}, nil
 }
 
+// RequestChanges is the resolver for the requestChanges field.
+func (r *mutationResolver) RequestChanges(ctx context.Context, input gqlmodel.RequestChangesInput) (*gqlmodel.RequestPayload, error) {
+	rid, err := gqlmodel.ToID[id.Request](input.RequestID)
+	if err != nil {
+		return nil, err
+	}
+	res, err := usecases(ctx).Request.RequestChanges(ctx, rid, lo.FromPtr(input.Comment), getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.RequestPayload{
+		Request: gqlmodel.ToRequest(res),
+	}, nil
+}
+
 // DeleteRequest is the resolver for the deleteRequest field.
 func (r *mutationResolver) DeleteRequest(ctx context.Context, input gqlmodel.DeleteRequestInput) (*gqlmodel.DeleteRequestPayload, error) {
 	rids, err := gqlmodel.ToIDs[id.Request](input.RequestsID)
@@ -135,11 +152,79 @@ func (r *mutationResolver) DeleteRequest(ctx context.Context, input gqlmodel.Del
 	}, nil
 }
 
+// SaveRequestWorkflow is the resolver for the saveRequestWorkflow field.
+func (r *mutationResolver) SaveRequestWorkflow(ctx context.Context, input gqlmodel.SaveRequestWorkflowInput) (*gqlmodel.RequestWorkflowPayload, error) {
+	pid, err := gqlmodel.ToID[id.Project](input.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	stages, err := util.TryMap(input.Stages, func(s *gqlmodel.RequestStageInput) (interfaces.RequestStageParam, error) {
+		reviewers, err := gqlmodel.ToIDs[accountdomain.User](s.ReviewersID)
+		if err != nil {
+			return interfaces.RequestStageParam{}, err
+		}
+		return interfaces.RequestStageParam{
+			Name:              s.Name,
+			Reviewers:         reviewers,
+			RequiredApprovals: s.RequiredApprovals,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Request.SaveWorkflow(ctx, interfaces.SaveRequestWorkflowParam{
+		ProjectID: pid,
+		ModelID:   gqlmodel.ToIDRef[id.Model](input.ModelID),
+		Stages:    stages,
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.RequestWorkflowPayload{
+		Workflow: gqlmodel.ToRequestWorkflow(res),
+	}, nil
+}
+
+// DeleteRequestWorkflow is the resolver for the deleteRequestWorkflow field.
+func (r *mutationResolver) DeleteRequestWorkflow(ctx context.Context, input gqlmodel.DeleteRequestWorkflowInput) (*gqlmodel.DeleteRequestWorkflowPayload, error) {
+	wid, err := gqlmodel.ToID[id.Workflow](input.WorkflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := usecases(ctx).Request.DeleteWorkflow(ctx, wid, getOperator(ctx)); err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.DeleteRequestWorkflowPayload{
+		WorkflowID: input.WorkflowID,
+	}, nil
+}
+
 // Requests is the resolver for the requests field.
 func (r *queryResolver) Requests(ctx context.Context, projectID gqlmodel.ID, key *string, state []gqlmodel.RequestState, createdBy *gqlmodel.ID, reviewer *gqlmodel.ID, pagination *gqlmodel.Pagination, sort *gqlmodel.Sort) (*gqlmodel.RequestConnection, error) {
 	return loaders(ctx).Request.FindByProject(ctx, projectID, key, state, createdBy, reviewer, pagination, sort)
 }
 
+// RequestWorkflows is the resolver for the requestWorkflows field.
+func (r *queryResolver) RequestWorkflows(ctx context.Context, projectID gqlmodel.ID) ([]*gqlmodel.RequestWorkflow, error) {
+	pid, err := gqlmodel.ToID[id.Project](projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Request.FindWorkflows(ctx, pid, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(res, func(w *request.Workflow, _ int) *gqlmodel.RequestWorkflow {
+		return gqlmodel.ToRequestWorkflow(w)
+	}), nil
+}
+
 // Thread is the resolver for the thread field.
 func (r *requestResolver) Thread(ctx context.Context, obj *gqlmodel.Request) (*gqlmodel.Thread, error) {
 	if obj.ThreadID == nil {
@@ -172,6 +257,11 @@ func (r *requestResolver) Reviewers(ctx context.Context, obj *gqlmodel.Request)
 	return res, nil
 }
 
+// User is the resolver for the user field.
+func (r *requestApprovalResolver) User(ctx context.Context, obj *gqlmodel.RequestApproval) (*gqlmodel.User, error) {
+	return dataloaders(ctx).User.Load(obj.UserID)
+}
+
 // Item is the resolver for the item field.
 func (r *requestItemResolver) Item(ctx context.Context, obj *gqlmodel.RequestItem) (*gqlmodel.VersionedItem, error) {
 	return loaders(ctx).Item.FindVersionedItem(ctx, obj.ItemID, obj.Version)
@@ -180,10 +270,14 @@ func (r *requestItemResolver) Item(ctx context.Context, obj *gqlmodel.RequestIte
 // Request returns RequestResolver implementation.
 func (r *Resolver) Request() RequestResolver { return &requestResolver{r} }
 
+// RequestApproval returns RequestApprovalResolver implementation.
+func (r *Resolver) RequestApproval() RequestApprovalResolver { return &requestApprovalResolver{r} }
+
 // RequestItem returns RequestItemResolver implementation.
 func (r *Resolver) RequestItem() RequestItemResolver { return &requestItemResolver{r} }
 
 type (
-	requestResolver     struct{ *Resolver }
-	requestItemResolver struct{ *Resolver }
+	requestResolver         struct{ *Resolver }
+	requestApprovalResolver struct{ *Resolver }
+	requestItemResolver     struct{ *Resolver }
 )
@@ -0,0 +1,86 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/samber/lo"
+)
+
+// CreateSchedule is the resolver for the createSchedule field.
+func (r *mutationResolver) CreateSchedule(ctx context.Context, input gqlmodel.CreateScheduleInput) (*gqlmodel.SchedulePayload, error) {
+	iids, err := gqlmodel.ToIDs[id.Item](input.ItemIds)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := usecases(ctx).Schedule.Create(ctx, interfaces.CreateScheduleParam{
+		ItemIDs:     iids,
+		Action:      gqlmodel.FromScheduleAction(input.Action),
+		ScheduledAt: input.ScheduledAt,
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.SchedulePayload{
+		Schedule: gqlmodel.ToSchedule(s),
+	}, nil
+}
+
+// CancelSchedule is the resolver for the cancelSchedule field.
+func (r *mutationResolver) CancelSchedule(ctx context.Context, input gqlmodel.CancelScheduleInput) (*gqlmodel.SchedulePayload, error) {
+	sid, err := gqlmodel.ToID[id.Schedule](input.ScheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := usecases(ctx).Schedule.Cancel(ctx, sid, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.SchedulePayload{
+		Schedule: gqlmodel.ToSchedule(s),
+	}, nil
+}
+
+// Schedule is the resolver for the schedule field.
+func (r *queryResolver) Schedule(ctx context.Context, scheduleID gqlmodel.ID) (*gqlmodel.Schedule, error) {
+	sid, err := gqlmodel.ToID[id.Schedule](scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := usecases(ctx).Schedule.FindByID(ctx, sid, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return gqlmodel.ToSchedule(s), nil
+}
+
+// Schedules is the resolver for the schedules field.
+func (r *queryResolver) Schedules(ctx context.Context, projectID gqlmodel.ID, status *gqlmodel.ScheduleStatus) ([]*gqlmodel.Schedule, error) {
+	pid, err := gqlmodel.ToID[id.Project](projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Schedule.FindByProject(ctx, pid, gqlmodel.FromScheduleStatus(status), getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(res, func(s *schedule.Schedule, _ int) *gqlmodel.Schedule {
+		return gqlmodel.ToSchedule(s)
+	}), nil
+}
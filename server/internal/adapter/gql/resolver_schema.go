@@ -9,9 +9,36 @@ import (
 	"context"
 
 	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 )
 
+// UpdateSchemaRules is the resolver for the updateSchemaRules field.
+func (r *mutationResolver) UpdateSchemaRules(ctx context.Context, input gqlmodel.UpdateSchemaRulesInput) (*gqlmodel.SchemaPayload, error) {
+	sid, err := gqlmodel.ToID[id.Schema](input.SchemaID)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := util.TryMap(input.Rules, func(r *gqlmodel.SchemaRuleInput) (interfaces.SchemaRuleParam, error) {
+		return gqlmodel.ToSchemaRuleParam(r)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := usecases(ctx).Schema.UpdateRules(ctx, sid, params, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.SchemaPayload{
+		Schema: gqlmodel.ToSchema(s),
+	}, nil
+}
+
 // TitleField is the resolver for the titleField field.
 func (r *schemaResolver) TitleField(ctx context.Context, obj *gqlmodel.Schema) (*gqlmodel.SchemaField, error) {
 	if obj.TitleFieldID == nil {
@@ -12,6 +12,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/thread"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/samber/lo"
 )
@@ -71,9 +72,23 @@ func (r *mutationResolver) AddComment(ctx context.Context, input gqlmodel.AddCom
 	thid := lo.Must(gqlmodel.ToID[id.Thread](input.ThreadID))
 
 	uc := usecases(ctx).Thread
-	th, c, err := uc.AddComment(ctx, thid, input.Content, getOperator(ctx))
-	if err != nil {
-		return nil, err
+	var th *thread.Thread
+	var c *thread.Comment
+	if input.ReplyToID != nil {
+		replyTo, err := gqlmodel.ToID[id.Comment](*input.ReplyToID)
+		if err != nil {
+			return nil, err
+		}
+		th, c, err = uc.ReplyComment(ctx, thid, replyTo, input.Content, getOperator(ctx))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		th, c, err = uc.AddComment(ctx, thid, input.Content, getOperator(ctx))
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &gqlmodel.CommentPayload{
@@ -130,6 +145,86 @@ func (r *mutationResolver) DeleteComment(ctx context.Context, input gqlmodel.Del
 	}, nil
 }
 
+// AddCommentReaction is the resolver for the addCommentReaction field.
+func (r *mutationResolver) AddCommentReaction(ctx context.Context, input gqlmodel.AddCommentReactionInput) (*gqlmodel.CommentPayload, error) {
+	thid, err := gqlmodel.ToID[id.Thread](input.ThreadID)
+	if err != nil {
+		return nil, err
+	}
+
+	cid, err := gqlmodel.ToID[id.Comment](input.CommentID)
+	if err != nil {
+		return nil, err
+	}
+
+	th, c, err := usecases(ctx).Thread.AddReaction(ctx, thid, cid, input.Emoji, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.CommentPayload{
+		Thread:  gqlmodel.ToThread(th),
+		Comment: gqlmodel.ToComment(c, th),
+	}, nil
+}
+
+// RemoveCommentReaction is the resolver for the removeCommentReaction field.
+func (r *mutationResolver) RemoveCommentReaction(ctx context.Context, input gqlmodel.RemoveCommentReactionInput) (*gqlmodel.CommentPayload, error) {
+	thid, err := gqlmodel.ToID[id.Thread](input.ThreadID)
+	if err != nil {
+		return nil, err
+	}
+
+	cid, err := gqlmodel.ToID[id.Comment](input.CommentID)
+	if err != nil {
+		return nil, err
+	}
+
+	th, c, err := usecases(ctx).Thread.RemoveReaction(ctx, thid, cid, input.Emoji, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.CommentPayload{
+		Thread:  gqlmodel.ToThread(th),
+		Comment: gqlmodel.ToComment(c, th),
+	}, nil
+}
+
+// ResolveThread is the resolver for the resolveThread field.
+func (r *mutationResolver) ResolveThread(ctx context.Context, input gqlmodel.ResolveThreadInput) (*gqlmodel.ThreadPayload, error) {
+	thid, err := gqlmodel.ToID[id.Thread](input.ThreadID)
+	if err != nil {
+		return nil, err
+	}
+
+	th, err := usecases(ctx).Thread.Resolve(ctx, thid, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.ThreadPayload{
+		Thread: gqlmodel.ToThread(th),
+	}, nil
+}
+
+// ReopenThread is the resolver for the reopenThread field.
+func (r *mutationResolver) ReopenThread(ctx context.Context, input gqlmodel.ReopenThreadInput) (*gqlmodel.ThreadPayload, error) {
+	thid, err := gqlmodel.ToID[id.Thread](input.ThreadID)
+	if err != nil {
+		return nil, err
+	}
+
+	th, err := usecases(ctx).Thread.Reopen(ctx, thid, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.ThreadPayload{
+		Thread: gqlmodel.ToThread(th),
+	}, nil
+}
+
 // Workspace is the resolver for the workspace field.
 func (r *threadResolver) Workspace(ctx context.Context, obj *gqlmodel.Thread) (*gqlmodel.Workspace, error) {
 	return dataloaders(ctx).Workspace.Load(obj.WorkspaceID)
@@ -0,0 +1,64 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/samber/lo"
+)
+
+// RestoreItems is the resolver for the restoreItems field.
+func (r *mutationResolver) RestoreItems(ctx context.Context, input gqlmodel.RestoreItemsInput) (*gqlmodel.RestoreItemsPayload, error) {
+	op := getOperator(ctx)
+	iids, err := gqlmodel.ToIDs[id.Item](input.ItemIds)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Item.RestoreTrashed(ctx, iids, op)
+	if err != nil {
+		return nil, err
+	}
+
+	ss, gs, err := usecases(ctx).Schema.GetSchemasAndGroupSchemasByIDs(ctx, lo.Uniq(lo.Map(res, func(i item.Versioned, _ int) id.SchemaID {
+		return i.Value().Schema()
+	})), op)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.RestoreItemsPayload{
+		Items: lo.Map(res, func(i item.Versioned, _ int) *gqlmodel.Item {
+			return gqlmodel.ToItem(i, ss.Schema(i.Value().Schema().Ref()), gs)
+		}),
+	}, nil
+}
+
+// PurgeItems is the resolver for the purgeItems field.
+func (r *mutationResolver) PurgeItems(ctx context.Context, input gqlmodel.PurgeItemsInput) (*gqlmodel.PurgeItemsPayload, error) {
+	iids, err := gqlmodel.ToIDs[id.Item](input.ItemIds)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Item.PurgeTrashed(ctx, iids, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.PurgeItemsPayload{
+		ItemIds: lo.Map(res, func(i id.ItemID, _ int) gqlmodel.ID { return gqlmodel.IDFrom(i) }),
+	}, nil
+}
+
+// TrashedItems is the resolver for the trashedItems field.
+func (r *queryResolver) TrashedItems(ctx context.Context, modelID gqlmodel.ID, pagination *gqlmodel.Pagination) (*gqlmodel.TrashedItemConnection, error) {
+	return loaders(ctx).Item.FindTrashed(ctx, modelID, pagination)
+}
@@ -3,13 +3,17 @@ package integration
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"github.com/oapi-codegen/runtime"
 	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/file"
+	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
@@ -45,6 +49,9 @@ func (s *Server) AssetFilter(ctx context.Context, req AssetFilterRequestObject)
 		Keyword:    req.Params.Keyword,
 		Sort:       sort,
 		Pagination: p,
+		Folder:     req.Params.FolderId,
+		Recursive:  lo.FromPtr(req.Params.Recursive),
+		Metadata:   fromAssetMetadataFilter(req.Params.Metadata),
 	}
 
 	assets, pi, err := uc.Asset.Search(ctx, wp.Project.ID(), f, op)
@@ -55,8 +62,20 @@ func (s *Server) AssetFilter(ctx context.Context, req AssetFilterRequestObject)
 		return AssetFilter400Response{}, err
 	}
 
+	folders, err := uc.AssetFolder.FindByProject(ctx, wp.Project.ID(), op)
+	if err != nil {
+		return AssetFilter400Response{}, err
+	}
+	ms, err := s.assetMetadataSchema(ctx, wp.Project.ID())
+	if err != nil {
+		return AssetFilter400Response{}, err
+	}
+
 	itemList, err := util.TryMap(assets, func(a *asset.Asset) (integrationapi.Asset, error) {
 		aa := integrationapi.NewAsset(a, nil, true)
+		aa.SetThumbnailURL(gateway.ThumbnailURL(adapter.Gateways(ctx).ImageTransformer, a))
+		aa.SetFolderPath(folders)
+		aa.SetMetadata(a.Metadata(), ms)
 		return *aa, nil
 	})
 	if err != nil {
@@ -87,6 +106,7 @@ func (s *Server) AssetCreate(ctx context.Context, req AssetCreateRequestObject)
 	var token string
 
 	var skipDecompression bool
+	var folder *asset.FolderID
 
 	if req.MultipartBody != nil {
 		var inp integrationapi.AssetCreateMultipartBody
@@ -108,6 +128,7 @@ func (s *Server) AssetCreate(ctx context.Context, req AssetCreateRequestObject)
 			ContentEncoding: lo.FromPtr(inp.ContentEncoding), // TODO: check HTTP header also
 		}
 		skipDecompression = lo.FromPtrOr(inp.SkipDecompression, false)
+		folder = inp.FolderId
 	}
 
 	if req.JSONBody != nil {
@@ -122,6 +143,7 @@ func (s *Server) AssetCreate(ctx context.Context, req AssetCreateRequestObject)
 			}
 		}
 		skipDecompression = lo.FromPtr(req.JSONBody.SkipDecompression)
+		folder = req.JSONBody.FolderId
 	}
 
 	cp := interfaces.CreateAssetParam{
@@ -129,6 +151,7 @@ func (s *Server) AssetCreate(ctx context.Context, req AssetCreateRequestObject)
 		File:              f,
 		SkipDecompression: skipDecompression,
 		Token:             token,
+		Folder:            folder,
 	}
 
 	a, af, err := uc.Asset.Create(ctx, cp, op)
@@ -140,6 +163,10 @@ func (s *Server) AssetCreate(ctx context.Context, req AssetCreateRequestObject)
 	}
 
 	aa := integrationapi.NewAsset(a, af, true)
+	aa.SetThumbnailURL(gateway.ThumbnailURL(adapter.Gateways(ctx).ImageTransformer, a))
+	if err := s.setAssetFolderPath(ctx, aa); err != nil {
+		return AssetCreate400Response{}, err
+	}
 	return AssetCreate200JSONResponse(*aa), nil
 }
 
@@ -223,6 +250,15 @@ func (s *Server) AssetGet(ctx context.Context, req AssetGetRequestObject) (Asset
 	}
 
 	aa := integrationapi.NewAsset(a, f, true)
+	aa.SetThumbnailURL(gateway.ThumbnailURL(adapter.Gateways(ctx).ImageTransformer, a))
+	if err := s.setAssetFolderPath(ctx, aa); err != nil {
+		return AssetGet400Response{}, err
+	}
+	ms, err := s.assetMetadataSchema(ctx, a.Project())
+	if err != nil {
+		return AssetGet400Response{}, err
+	}
+	aa.SetMetadata(a.Metadata(), ms)
 	return AssetGet200JSONResponse(*aa), nil
 }
 
@@ -327,6 +363,7 @@ func (s *Server) AssetPublish(ctx context.Context, req AssetPublishRequestObject
 	}
 
 	aa := integrationapi.NewAsset(a, f, true)
+	aa.SetThumbnailURL(gateway.ThumbnailURL(adapter.Gateways(ctx).ImageTransformer, a))
 	return AssetPublish200JSONResponse(*aa), nil
 }
 
@@ -356,5 +393,32 @@ func (s *Server) AssetUnpublish(ctx context.Context, req AssetUnpublishRequestOb
 	}
 
 	aa := integrationapi.NewAsset(a, f, true)
+	aa.SetThumbnailURL(gateway.ThumbnailURL(adapter.Gateways(ctx).ImageTransformer, a))
 	return AssetUnpublish200JSONResponse(*aa), nil
 }
+
+// assetMetadataSchema returns the asset metadata schema of the project, or nil if the project does not define it.
+func (s *Server) assetMetadataSchema(ctx context.Context, pid id.ProjectID) (*schema.Schema, error) {
+	sc, err := adapter.Usecases(ctx).Asset.FindMetadataSchema(ctx, pid, adapter.Operator(ctx))
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return sc, nil
+}
+
+func fromAssetMetadataFilter(m *map[string]string) []interfaces.AssetMetadataFieldParam {
+	if m == nil || len(*m) == 0 {
+		return nil
+	}
+	keys := lo.Keys(*m)
+	slices.Sort(keys)
+	return lo.Map(keys, func(k string, _ int) interfaces.AssetMetadataFieldParam {
+		return interfaces.AssetMetadataFieldParam{
+			Key:   id.NewKey(k).Ref(),
+			Value: (*m)[k],
+		}
+	})
+}
@@ -75,6 +75,8 @@ func (s *Server) AssetCommentCreate(ctx context.Context, req AssetCommentCreateR
 	var comment *thread.Comment
 	if a.Thread() == nil {
 		comment, err = s.createThreadForAsset(ctx, uc, a, *req.Body.Content, op)
+	} else if req.Body.ReplyTo != nil {
+		_, comment, err = uc.Thread.ReplyComment(ctx, *a.Thread(), *req.Body.ReplyTo, *req.Body.Content, op)
 	} else {
 		_, comment, err = uc.Thread.AddComment(ctx, *a.Thread(), *req.Body.Content, op)
 	}
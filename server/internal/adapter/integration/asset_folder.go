@@ -0,0 +1,87 @@
+package integration
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+func (s *Server) AssetFolderList(ctx context.Context, req AssetFolderListRequestObject) (AssetFolderListResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	wp, err := s.loadWPContext(ctx, req.WorkspaceIdOrAlias, req.ProjectIdOrAlias, nil)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return AssetFolderList404Response{}, err
+		}
+		return AssetFolderList400Response{}, err
+	}
+
+	folders, err := uc.AssetFolder.FindByProject(ctx, wp.Project.ID(), op)
+	if err != nil {
+		return AssetFolderList400Response{}, err
+	}
+
+	res := lo.Map(folders, func(f *asset.Folder, _ int) integrationapi.AssetFolder {
+		return *integrationapi.NewAssetFolder(f, folders)
+	})
+
+	return AssetFolderList200JSONResponse{Folders: &res}, nil
+}
+
+func (s *Server) AssetFolderCreate(ctx context.Context, req AssetFolderCreateRequestObject) (AssetFolderCreateResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	wp, err := s.loadWPContext(ctx, req.WorkspaceIdOrAlias, req.ProjectIdOrAlias, nil)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return AssetFolderCreate404Response{}, err
+		}
+		return AssetFolderCreate400Response{}, err
+	}
+
+	if req.Body == nil {
+		return AssetFolderCreate400Response{}, rerror.ErrInvalidParams
+	}
+
+	f, err := uc.AssetFolder.Create(ctx, interfaces.CreateAssetFolderParam{
+		ProjectID: wp.Project.ID(),
+		Parent:    req.Body.ParentId,
+		Name:      req.Body.Name,
+	}, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return AssetFolderCreate404Response{}, err
+		}
+		return AssetFolderCreate400Response{}, err
+	}
+
+	folders, err := uc.AssetFolder.FindByProject(ctx, wp.Project.ID(), op)
+	if err != nil {
+		return AssetFolderCreate400Response{}, err
+	}
+
+	return AssetFolderCreate200JSONResponse(*integrationapi.NewAssetFolder(f, folders)), nil
+}
+
+// setAssetFolderPath resolves the path of the folder which the asset belongs to.
+func (s *Server) setAssetFolderPath(ctx context.Context, a *integrationapi.Asset) error {
+	if a == nil || a.FolderId == nil {
+		return nil
+	}
+
+	folders, err := adapter.Usecases(ctx).AssetFolder.FindByProject(ctx, a.ProjectId, adapter.Operator(ctx))
+	if err != nil {
+		return err
+	}
+	a.SetFolderPath(folders)
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
@@ -504,6 +505,103 @@ func (s *Server) ItemPublish(ctx context.Context, request ItemPublishRequestObje
 	return ItemPublish200JSONResponse(integrationapi.NewVersionedItem(i, schm, ac, getReferencedItems(ctx, i.Value().RefItemsIDs(*sp), sp, ac), ms, mi, sp.GroupSchemas())), nil
 }
 
+func (s *Server) ItemDiff(ctx context.Context, request ItemDiffRequestObject) (ItemDiffResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	wp, err := s.loadWPContext(ctx, request.WorkspaceIdOrAlias, request.ProjectIdOrAlias, &request.ModelIdOrKey)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemDiff404Response{}, err
+		}
+		return ItemDiff400Response{}, err
+	}
+
+	to := version.Latest.OrVersion()
+	if request.Params.To != nil {
+		to = version.ParseVersionOrRef(*request.Params.To)
+	}
+
+	d, err := uc.Item.Diff(ctx, request.ItemId, version.ParseVersionOrRef(request.Params.From), to, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemDiff404Response{}, err
+		}
+		return ItemDiff400Response{}, err
+	}
+
+	if d.To.Value().Model() != wp.Model.ID() {
+		return ItemDiff404Response{}, rerror.ErrNotFound
+	}
+
+	sp, err := uc.Schema.FindByModel(ctx, d.To.Value().Model(), op)
+	if err != nil {
+		return ItemDiff400Response{}, err
+	}
+
+	var from *integrationapi.VersionedItem
+	if d.From != nil {
+		from = new(integrationapi.NewVersionedItem(d.From, sp.Schema(), nil, nil, nil, nil, sp.GroupSchemas()))
+	}
+	toItem := integrationapi.NewVersionedItem(d.To, sp.Schema(), nil, nil, nil, nil, sp.GroupSchemas())
+
+	return ItemDiff200JSONResponse(integrationapi.NewItemDiff(from, &toItem, d.Changes)), nil
+}
+
+func (s *Server) ItemRestore(ctx context.Context, request ItemRestoreRequestObject) (ItemRestoreResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	wp, err := s.loadWPContext(ctx, request.WorkspaceIdOrAlias, request.ProjectIdOrAlias, &request.ModelIdOrKey)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemRestore404Response{}, err
+		}
+		return ItemRestore400Response{}, err
+	}
+
+	i, err := uc.Item.FindByID(ctx, request.ItemId, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemRestore404Response{}, err
+		}
+		return ItemRestore400Response{}, err
+	}
+
+	if i.Value().Model() != wp.Model.ID() {
+		return ItemRestore404Response{}, rerror.ErrNotFound
+	}
+
+	i, dropped, err := uc.Item.Restore(ctx, request.ItemId, request.Body.Into(), op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemRestore404Response{}, err
+		}
+		return ItemRestore400Response{}, err
+	}
+
+	sp, err := uc.Schema.FindByModel(ctx, i.Value().Model(), op)
+	if err != nil {
+		return ItemRestore400Response{}, err
+	}
+
+	msList, miList := getMetaSchemasAndItems(ctx, item.VersionedList{i})
+
+	var mi item.Versioned
+	var ms *schema.Schema
+	if len(miList) > 0 {
+		mi = miList[0]
+	}
+	if len(msList) > 0 {
+		ms = msList[0]
+	}
+
+	return ItemRestore200JSONResponse{
+		Item:          new(integrationapi.NewVersionedItem(i, sp.Schema(), nil, getReferencedItems(ctx, i.Value().RefItemsIDs(*sp), sp, nil), ms, mi, sp.GroupSchemas())),
+		DroppedFields: new(integrationapi.NewDroppedFields(dropped)),
+	}, nil
+}
+
 func createItem(ctx context.Context, uc *interfaces.Container, m *model.Model, fields, metaFields *[]integrationapi.Field, op *usecase.Operator) (*integrationapi.VersionedItem, error) {
 	sp, err := uc.Schema.FindByModel(ctx, m.ID(), op)
 	if err != nil {
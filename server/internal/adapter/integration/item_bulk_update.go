@@ -0,0 +1,54 @@
+package integration
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
+	"github.com/reearth/reearthx/rerror"
+)
+
+func (s *Server) ItemBulkUpdate(ctx context.Context, request ItemBulkUpdateRequestObject) (ItemBulkUpdateResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	wp, err := s.loadWPContext(ctx, request.WorkspaceIdOrAlias, request.ProjectIdOrAlias, &request.ModelIdOrKey)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemBulkUpdate404Response{}, err
+		}
+		return ItemBulkUpdate400Response{}, err
+	}
+
+	if request.Body == nil {
+		return ItemBulkUpdate400Response{}, rerror.ErrInvalidParams
+	}
+
+	sp, err := uc.Schema.FindByModel(ctx, wp.Model.ID(), op)
+	if err != nil {
+		return ItemBulkUpdate400Response{}, err
+	}
+
+	param := interfaces.BulkUpdateItemsParam{
+		ModelID:       wp.Model.ID(),
+		SchemaPackage: *sp,
+		Fields:        convertFields(&request.Body.Fields, sp, false, false),
+	}
+	if request.Body.Filter != nil {
+		param.Filter = fromCondition(*sp, *request.Body.Filter)
+	}
+
+	jobID, err := uc.Item.BulkUpdateAsync(ctx, param, op)
+	if err != nil {
+		return ItemBulkUpdate400Response{}, err
+	}
+
+	j, err := uc.Job.FindByID(ctx, jobID, op)
+	if err != nil {
+		return ItemBulkUpdate400Response{}, err
+	}
+
+	return ItemBulkUpdate202JSONResponse(*integrationapi.NewJob(j)), nil
+}
@@ -76,6 +76,8 @@ func (s *Server) ItemCommentCreate(ctx context.Context, request ItemCommentCreat
 			ResourceType: interfaces.ResourceTypeItem,
 			Content:      *request.Body.Content,
 		}, op)
+	} else if request.Body.ReplyTo != nil {
+		_, comment, err = uc.Thread.ReplyComment(ctx, *i.Value().Thread(), *request.Body.ReplyTo, *request.Body.Content, op)
 	} else {
 		_, comment, err = uc.Thread.AddComment(ctx, *i.Value().Thread(), *request.Body.Content, op)
 	}
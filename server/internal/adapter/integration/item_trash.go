@@ -0,0 +1,157 @@
+package integration
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+func (s *Server) ItemTrashList(ctx context.Context, request ItemTrashListRequestObject) (ItemTrashListResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	wp, err := s.loadWPContext(ctx, request.WorkspaceIdOrAlias, request.ProjectIdOrAlias, &request.ModelIdOrKey)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemTrashList404Response{}, err
+		}
+		return ItemTrashList400Response{}, err
+	}
+
+	sp, err := uc.Schema.FindByModel(ctx, wp.Model.ID(), op)
+	if err != nil {
+		return ItemTrashList400Response{}, err
+	}
+
+	p := fromPagination(request.Params.Page, request.Params.PerPage)
+	res, pi, err := uc.Item.FindTrashed(ctx, wp.Model.ID(), p, op)
+	if err != nil {
+		return ItemTrashList400Response{}, err
+	}
+
+	items := lo.Map(res, func(ti interfaces.TrashedItem, _ int) integrationapi.TrashedItem {
+		// the metadata item is in the trash together with the item
+		vi := integrationapi.NewVersionedItem(ti.Item, sp.Schema(), nil, nil, nil, nil, sp.GroupSchemas())
+		return *integrationapi.NewTrashedItem(ti.Entry, ti.ExpiresAt, vi)
+	})
+
+	return ItemTrashList200JSONResponse{
+		Items:      &items,
+		Page:       new(Page(*p.Offset)),
+		PerPage:    new(int(p.Offset.Limit)),
+		TotalCount: new(int(pi.TotalCount)),
+	}, nil
+}
+
+func (s *Server) ItemTrashRestore(ctx context.Context, request ItemTrashRestoreRequestObject) (ItemTrashRestoreResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	wp, err := s.loadWPContext(ctx, request.WorkspaceIdOrAlias, request.ProjectIdOrAlias, &request.ModelIdOrKey)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemTrashRestore404Response{}, err
+		}
+		return ItemTrashRestore400Response{}, err
+	}
+
+	if request.Body == nil {
+		return ItemTrashRestore400Response{}, rerror.ErrInvalidParams
+	}
+
+	if err := checkTrashedItemsInModel(ctx, request.Body.ItemIds, wp.Model.ID()); err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemTrashRestore404Response{}, err
+		}
+		return ItemTrashRestore400Response{}, err
+	}
+
+	res, err := uc.Item.RestoreTrashed(ctx, request.Body.ItemIds, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) || errors.Is(err, interfaces.ErrPartialNotFound) {
+			return ItemTrashRestore404Response{}, err
+		}
+		return ItemTrashRestore400Response{}, err
+	}
+
+	sp, err := uc.Schema.FindByModel(ctx, wp.Model.ID(), op)
+	if err != nil {
+		return ItemTrashRestore400Response{}, err
+	}
+
+	metaSchemas, metaItems := getMetaSchemasAndItems(ctx, res)
+	items := lo.Map(res, func(i item.Versioned, _ int) integrationapi.VersionedItem {
+		metaItem, _ := lo.Find(metaItems, func(itm item.Versioned) bool {
+			return itm.Value().ID() == lo.FromPtr(i.Value().MetadataItem())
+		})
+		var metaSchema *schema.Schema
+		if metaItem != nil {
+			metaSchema, _ = lo.Find(metaSchemas, func(s *schema.Schema) bool {
+				return metaItem.Value().Schema() == s.ID()
+			})
+		}
+		return integrationapi.NewVersionedItem(i, sp.Schema(), nil, nil, metaSchema, metaItem, sp.GroupSchemas())
+	})
+
+	return ItemTrashRestore200JSONResponse{Items: &items}, nil
+}
+
+func (s *Server) ItemTrashPurge(ctx context.Context, request ItemTrashPurgeRequestObject) (ItemTrashPurgeResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	wp, err := s.loadWPContext(ctx, request.WorkspaceIdOrAlias, request.ProjectIdOrAlias, &request.ModelIdOrKey)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemTrashPurge404Response{}, err
+		}
+		return ItemTrashPurge400Response{}, err
+	}
+
+	if request.Body == nil {
+		return ItemTrashPurge400Response{}, rerror.ErrInvalidParams
+	}
+
+	if err := checkTrashedItemsInModel(ctx, request.Body.ItemIds, wp.Model.ID()); err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemTrashPurge404Response{}, err
+		}
+		return ItemTrashPurge400Response{}, err
+	}
+
+	res, err := uc.Item.PurgeTrashed(ctx, request.Body.ItemIds, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) || errors.Is(err, interfaces.ErrPartialNotFound) {
+			return ItemTrashPurge404Response{}, err
+		}
+		return ItemTrashPurge400Response{}, err
+	}
+
+	return ItemTrashPurge200JSONResponse{ItemIds: new([]id.ItemID(res))}, nil
+}
+
+// checkTrashedItemsInModel checks all the items are in the trash of the model.
+func checkTrashedItemsInModel(ctx context.Context, itemIDs id.ItemIDList, modelID id.ModelID) error {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	for _, iid := range itemIDs {
+		itm, err := uc.Item.FindVersionByID(ctx, iid, version.Trash.OrVersion(), op)
+		if err != nil {
+			return err
+		}
+		if itm.Value().Model() != modelID {
+			return rerror.ErrNotFound
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package integration
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
+	"github.com/reearth/reearthx/rerror"
+)
+
+func (s *Server) JobGet(ctx context.Context, request JobGetRequestObject) (JobGetResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	wp, err := s.loadWPContext(ctx, request.WorkspaceIdOrAlias, request.ProjectIdOrAlias, nil)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return JobGet404Response{}, err
+		}
+		return JobGet400Response{}, err
+	}
+
+	res, err := uc.Job.FindByID(ctx, request.JobId, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return JobGet404Response{}, err
+		}
+		return JobGet400Response{}, err
+	}
+
+	if res.ProjectID() != wp.Project.ID() {
+		return JobGet404Response{}, rerror.ErrNotFound
+	}
+
+	return JobGet200JSONResponse(*integrationapi.NewJob(res)), nil
+}
+
+func (s *Server) JobCancel(ctx context.Context, request JobCancelRequestObject) (JobCancelResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	wp, err := s.loadWPContext(ctx, request.WorkspaceIdOrAlias, request.ProjectIdOrAlias, nil)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return JobCancel404Response{}, err
+		}
+		return JobCancel400Response{}, err
+	}
+
+	j, err := uc.Job.FindByID(ctx, request.JobId, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return JobCancel404Response{}, err
+		}
+		return JobCancel400Response{}, err
+	}
+
+	if j.ProjectID() != wp.Project.ID() {
+		return JobCancel404Response{}, rerror.ErrNotFound
+	}
+
+	res, err := uc.Job.Cancel(ctx, j.ID(), op)
+	if err != nil {
+		return JobCancel400Response{}, err
+	}
+
+	return JobCancel200JSONResponse(*integrationapi.NewJob(res)), nil
+}
@@ -0,0 +1,146 @@
+package integration
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+func (s *Server) ScheduleList(ctx context.Context, request ScheduleListRequestObject) (ScheduleListResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	wp, err := s.loadWPContext(ctx, request.WorkspaceIdOrAlias, request.ProjectIdOrAlias, nil)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ScheduleList404Response{}, err
+		}
+		return ScheduleList400Response{}, err
+	}
+
+	res, err := uc.Schedule.FindByProject(ctx, wp.Project.ID(), integrationapi.ToScheduleStatus(request.Params.Status), op)
+	if err != nil {
+		return ScheduleList400Response{}, err
+	}
+
+	schedules := lo.Map(res, func(s *schedule.Schedule, _ int) integrationapi.Schedule {
+		return *integrationapi.NewSchedule(s)
+	})
+
+	return ScheduleList200JSONResponse{Schedules: &schedules}, nil
+}
+
+func (s *Server) ScheduleCreate(ctx context.Context, request ScheduleCreateRequestObject) (ScheduleCreateResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	wp, err := s.loadWPContext(ctx, request.WorkspaceIdOrAlias, request.ProjectIdOrAlias, nil)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ScheduleCreate404Response{}, err
+		}
+		return ScheduleCreate400Response{}, err
+	}
+
+	if request.Body == nil {
+		return ScheduleCreate400Response{}, rerror.ErrInvalidParams
+	}
+
+	action, ok := schedule.ActionFrom(string(request.Body.Action))
+	if !ok {
+		return ScheduleCreate400Response{}, rerror.ErrInvalidParams
+	}
+
+	// the usecase checks that all items belong to the same model
+	if len(request.Body.ItemIds) > 0 {
+		itm, err := uc.Item.FindByID(ctx, request.Body.ItemIds[0], op)
+		if err != nil {
+			if errors.Is(err, rerror.ErrNotFound) {
+				return ScheduleCreate404Response{}, err
+			}
+			return ScheduleCreate400Response{}, err
+		}
+		if itm.Value().Project() != wp.Project.ID() {
+			return ScheduleCreate404Response{}, rerror.ErrNotFound
+		}
+	}
+
+	res, err := uc.Schedule.Create(ctx, interfaces.CreateScheduleParam{
+		ItemIDs:     request.Body.ItemIds,
+		Action:      action,
+		ScheduledAt: request.Body.ScheduledAt,
+	}, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ScheduleCreate404Response{}, err
+		}
+		return ScheduleCreate400Response{}, err
+	}
+
+	return ScheduleCreate200JSONResponse(*integrationapi.NewSchedule(res)), nil
+}
+
+func (s *Server) ScheduleGet(ctx context.Context, request ScheduleGetRequestObject) (ScheduleGetResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	wp, err := s.loadWPContext(ctx, request.WorkspaceIdOrAlias, request.ProjectIdOrAlias, nil)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ScheduleGet404Response{}, err
+		}
+		return ScheduleGet400Response{}, err
+	}
+
+	res, err := uc.Schedule.FindByID(ctx, request.ScheduleId, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ScheduleGet404Response{}, err
+		}
+		return ScheduleGet400Response{}, err
+	}
+
+	if res.Project() != wp.Project.ID() {
+		return ScheduleGet404Response{}, rerror.ErrNotFound
+	}
+
+	return ScheduleGet200JSONResponse(*integrationapi.NewSchedule(res)), nil
+}
+
+func (s *Server) ScheduleCancel(ctx context.Context, request ScheduleCancelRequestObject) (ScheduleCancelResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	wp, err := s.loadWPContext(ctx, request.WorkspaceIdOrAlias, request.ProjectIdOrAlias, nil)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ScheduleCancel404Response{}, err
+		}
+		return ScheduleCancel400Response{}, err
+	}
+
+	sch, err := uc.Schedule.FindByID(ctx, request.ScheduleId, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ScheduleCancel404Response{}, err
+		}
+		return ScheduleCancel400Response{}, err
+	}
+
+	if sch.Project() != wp.Project.ID() {
+		return ScheduleCancel404Response{}, rerror.ErrNotFound
+	}
+
+	res, err := uc.Schedule.Cancel(ctx, sch.ID(), op)
+	if err != nil {
+		return ScheduleCancel400Response{}, err
+	}
+
+	return ScheduleCancel200JSONResponse(*integrationapi.NewSchedule(res)), nil
+}
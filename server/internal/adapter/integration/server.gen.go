@@ -27,6 +27,12 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Returns a list of deliveries of a webhook
+	// (GET /webhooks/{webhookId}/deliveries)
+	WebhookDeliveryList(ctx *echo.Context, webhookId WebhookIdParam, params WebhookDeliveryListParams) error
+	// Send the payload of a delivery to the webhook again
+	// (POST /webhooks/{webhookId}/deliveries/{deliveryId}/redeliver)
+	WebhookRedeliver(ctx *echo.Context, webhookId WebhookIdParam, deliveryId WebhookDeliveryIdParam) error
 	// Returns a list of projects.
 	// (GET /{workspaceIdOrAlias}/projects)
 	ProjectFilter(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, params ProjectFilterParams) error
@@ -42,6 +48,12 @@ type ServerInterface interface {
 	// Update a project.
 	// (PATCH /{workspaceIdOrAlias}/projects/{projectIdOrAlias})
 	ProjectUpdate(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam) error
+	// Returns a list of asset folders.
+	// (GET /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/asset-folders)
+	AssetFolderList(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam) error
+	// Create an asset folder.
+	// (POST /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/asset-folders)
+	AssetFolderCreate(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam) error
 	// delete assets in batch
 	// (DELETE /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/assets)
 	AssetBatchDelete(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam) error
@@ -96,6 +108,12 @@ type ServerInterface interface {
 	// Update a group's details within a project.
 	// (PATCH /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/groups/{groupIdOrKey})
 	GroupUpdate(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, groupIdOrKey GroupIdOrKeyParam) error
+	// Cancel a running job
+	// (DELETE /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/jobs/{jobId})
+	JobCancel(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, jobId JobIdParam) error
+	// Returns a job
+	// (GET /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/jobs/{jobId})
+	JobGet(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, jobId JobIdParam) error
 	// Returns a list of models.
 	// (GET /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models)
 	ModelFilter(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, params ModelFilterParams) error
@@ -129,9 +147,21 @@ type ServerInterface interface {
 	// Returns a GeoJSON that has a list of items as features.
 	// (GET /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items.geojson)
 	ItemsAsGeoJSON(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam, params ItemsAsGeoJSONParams) error
+	// Update a set of fields of the items in the background
+	// (POST /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items/bulk-update)
+	ItemBulkUpdate(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam) error
 	// Returns a list of items with complex filtering.
 	// (POST /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items/filter)
 	ItemFilterPost(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam, params ItemFilterPostParams) error
+	// Returns a list of items in the trash.
+	// (GET /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items/trash)
+	ItemTrashList(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam, params ItemTrashListParams) error
+	// purge items from the trash
+	// (POST /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items/trash/purge)
+	ItemTrashPurge(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam) error
+	// restore items from the trash
+	// (POST /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items/trash/restore)
+	ItemTrashRestore(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam) error
 	// delete an item
 	// (DELETE /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items/{itemId})
 	ItemDelete(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam, itemId ItemIdParam) error
@@ -153,15 +183,33 @@ type ServerInterface interface {
 	// Update Item Comment
 	// (PATCH /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items/{itemId}/comments/{commentId})
 	ItemCommentUpdate(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam, itemId ItemIdParam, commentId CommentIdParam) error
+	// compare two versions of an item
+	// (GET /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items/{itemId}/diff)
+	ItemDiff(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam, itemId ItemIdParam, params ItemDiffParams) error
 	// publish item
 	// (POST /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items/{itemId}/publish)
 	ItemPublish(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam, itemId ItemIdParam, params ItemPublishParams) error
+	// restore item to a past version
+	// (POST /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items/{itemId}/restore)
+	ItemRestore(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam, itemId ItemIdParam) error
 	// Returns a metadata schema as json by project and model ID
 	// (GET /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/metadata_schema.json)
 	MetadataSchemaByModelAsJSON(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam) error
 	// Returns a schema as json by project and model ID
 	// (GET /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/schema.json)
 	SchemaByModelAsJSON(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam) error
+	// Returns a list of scheduled publications
+	// (GET /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/schedules)
+	ScheduleList(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, params ScheduleListParams) error
+	// Schedule items to be published or unpublished
+	// (POST /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/schedules)
+	ScheduleCreate(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam) error
+	// Cancel a pending schedule
+	// (DELETE /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/schedules/{scheduleId})
+	ScheduleCancel(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, scheduleId ScheduleIdParam) error
+	// Returns a schedule
+	// (GET /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/schedules/{scheduleId})
+	ScheduleGet(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, scheduleId ScheduleIdParam) error
 	// create a field
 	// (POST /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/schemata/{schemaId}/fields)
 	FieldCreate(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, schemaId SchemaIdParam) error
@@ -178,6 +226,66 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// WebhookDeliveryList converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookDeliveryList(ctx *echo.Context) error {
+	var err error
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId WebhookIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", ctx.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WebhookDeliveryListParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "perPage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "perPage", ctx.QueryParams(), &params.PerPage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perPage: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookDeliveryList(ctx, webhookId, params)
+	return err
+}
+
+// WebhookRedeliver converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookRedeliver(ctx *echo.Context) error {
+	var err error
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId WebhookIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", ctx.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
+	}
+
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryId WebhookDeliveryIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryId", ctx.Param("deliveryId"), &deliveryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter deliveryId: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookRedeliver(ctx, webhookId, deliveryId)
+	return err
+}
+
 // ProjectFilter converts echo context to params.
 func (w *ServerInterfaceWrapper) ProjectFilter(ctx *echo.Context) error {
 	var err error
@@ -366,6 +474,58 @@ func (w *ServerInterfaceWrapper) ProjectUpdate(ctx *echo.Context) error {
 	return err
 }
 
+// AssetFolderList converts echo context to params.
+func (w *ServerInterfaceWrapper) AssetFolderList(ctx *echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceIdOrAlias" -------------
+	var workspaceIdOrAlias WorkspaceIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceIdOrAlias", ctx.Param("workspaceIdOrAlias"), &workspaceIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AssetFolderList(ctx, workspaceIdOrAlias, projectIdOrAlias)
+	return err
+}
+
+// AssetFolderCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) AssetFolderCreate(ctx *echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceIdOrAlias" -------------
+	var workspaceIdOrAlias WorkspaceIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceIdOrAlias", ctx.Param("workspaceIdOrAlias"), &workspaceIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AssetFolderCreate(ctx, workspaceIdOrAlias, projectIdOrAlias)
+	return err
+}
+
 // AssetBatchDelete converts echo context to params.
 func (w *ServerInterfaceWrapper) AssetBatchDelete(ctx *echo.Context) error {
 	var err error
@@ -450,6 +610,27 @@ func (w *ServerInterfaceWrapper) AssetFilter(ctx *echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keyword: %s", err))
 	}
 
+	// ------------- Optional query parameter "folderId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "folderId", ctx.QueryParams(), &params.FolderId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter folderId: %s", err))
+	}
+
+	// ------------- Optional query parameter "recursive" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "recursive", ctx.QueryParams(), &params.Recursive)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter recursive: %s", err))
+	}
+
+	// ------------- Optional query parameter "metadata" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "metadata", ctx.QueryParams(), &params.Metadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter metadata: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.AssetFilter(ctx, workspaceIdOrAlias, projectIdOrAlias, params)
 	return err
@@ -1053,6 +1234,74 @@ func (w *ServerInterfaceWrapper) GroupUpdate(ctx *echo.Context) error {
 	return err
 }
 
+// JobCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) JobCancel(ctx *echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceIdOrAlias" -------------
+	var workspaceIdOrAlias WorkspaceIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceIdOrAlias", ctx.Param("workspaceIdOrAlias"), &workspaceIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "jobId" -------------
+	var jobId JobIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "jobId", ctx.Param("jobId"), &jobId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter jobId: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.JobCancel(ctx, workspaceIdOrAlias, projectIdOrAlias, jobId)
+	return err
+}
+
+// JobGet converts echo context to params.
+func (w *ServerInterfaceWrapper) JobGet(ctx *echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceIdOrAlias" -------------
+	var workspaceIdOrAlias WorkspaceIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceIdOrAlias", ctx.Param("workspaceIdOrAlias"), &workspaceIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "jobId" -------------
+	var jobId JobIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "jobId", ctx.Param("jobId"), &jobId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter jobId: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.JobGet(ctx, workspaceIdOrAlias, projectIdOrAlias, jobId)
+	return err
+}
+
 // ModelFilter converts echo context to params.
 func (w *ServerInterfaceWrapper) ModelFilter(ctx *echo.Context) error {
 	var err error
@@ -1582,6 +1831,40 @@ func (w *ServerInterfaceWrapper) ItemsAsGeoJSON(ctx *echo.Context) error {
 	return err
 }
 
+// ItemBulkUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemBulkUpdate(ctx *echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceIdOrAlias" -------------
+	var workspaceIdOrAlias WorkspaceIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceIdOrAlias", ctx.Param("workspaceIdOrAlias"), &workspaceIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "modelIdOrKey" -------------
+	var modelIdOrKey ModelIdOrKeyParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "modelIdOrKey", ctx.Param("modelIdOrKey"), &modelIdOrKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter modelIdOrKey: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ItemBulkUpdate(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey)
+	return err
+}
+
 // ItemFilterPost converts echo context to params.
 func (w *ServerInterfaceWrapper) ItemFilterPost(ctx *echo.Context) error {
 	var err error
@@ -1667,8 +1950,8 @@ func (w *ServerInterfaceWrapper) ItemFilterPost(ctx *echo.Context) error {
 	return err
 }
 
-// ItemDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) ItemDelete(ctx *echo.Context) error {
+// ItemTrashList converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemTrashList(ctx *echo.Context) error {
 	var err error
 	// ------------- Path parameter "workspaceIdOrAlias" -------------
 	var workspaceIdOrAlias WorkspaceIdOrAliasParam
@@ -1694,23 +1977,31 @@ func (w *ServerInterfaceWrapper) ItemDelete(ctx *echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter modelIdOrKey: %s", err))
 	}
 
-	// ------------- Path parameter "itemId" -------------
-	var itemId ItemIdParam
+	ctx.Set(string(BearerAuthScopes), []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "itemId", ctx.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ItemTrashListParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter itemId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
 	}
 
-	ctx.Set(string(BearerAuthScopes), []string{})
+	// ------------- Optional query parameter "perPage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "perPage", ctx.QueryParams(), &params.PerPage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perPage: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ItemDelete(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey, itemId)
+	err = w.Handler.ItemTrashList(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey, params)
 	return err
 }
 
-// ItemGet converts echo context to params.
-func (w *ServerInterfaceWrapper) ItemGet(ctx *echo.Context) error {
+// ItemTrashPurge converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemTrashPurge(ctx *echo.Context) error {
 	var err error
 	// ------------- Path parameter "workspaceIdOrAlias" -------------
 	var workspaceIdOrAlias WorkspaceIdOrAliasParam
@@ -1736,39 +2027,15 @@ func (w *ServerInterfaceWrapper) ItemGet(ctx *echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter modelIdOrKey: %s", err))
 	}
 
-	// ------------- Path parameter "itemId" -------------
-	var itemId ItemIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "itemId", ctx.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter itemId: %s", err))
-	}
-
 	ctx.Set(string(BearerAuthScopes), []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ItemGetParams
-	// ------------- Optional query parameter "ref" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "ref", ctx.QueryParams(), &params.Ref)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ref: %s", err))
-	}
-
-	// ------------- Optional query parameter "asset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "asset", ctx.QueryParams(), &params.Asset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ItemGet(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey, itemId, params)
+	err = w.Handler.ItemTrashPurge(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey)
 	return err
 }
 
-// ItemUpdate converts echo context to params.
-func (w *ServerInterfaceWrapper) ItemUpdate(ctx *echo.Context) error {
+// ItemTrashRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemTrashRestore(ctx *echo.Context) error {
 	var err error
 	// ------------- Path parameter "workspaceIdOrAlias" -------------
 	var workspaceIdOrAlias WorkspaceIdOrAliasParam
@@ -1794,23 +2061,15 @@ func (w *ServerInterfaceWrapper) ItemUpdate(ctx *echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter modelIdOrKey: %s", err))
 	}
 
-	// ------------- Path parameter "itemId" -------------
-	var itemId ItemIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "itemId", ctx.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter itemId: %s", err))
-	}
-
 	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ItemUpdate(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey, itemId)
+	err = w.Handler.ItemTrashRestore(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey)
 	return err
 }
 
-// ItemCommentList converts echo context to params.
-func (w *ServerInterfaceWrapper) ItemCommentList(ctx *echo.Context) error {
+// ItemDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemDelete(ctx *echo.Context) error {
 	var err error
 	// ------------- Path parameter "workspaceIdOrAlias" -------------
 	var workspaceIdOrAlias WorkspaceIdOrAliasParam
@@ -1847,12 +2106,12 @@ func (w *ServerInterfaceWrapper) ItemCommentList(ctx *echo.Context) error {
 	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ItemCommentList(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey, itemId)
+	err = w.Handler.ItemDelete(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey, itemId)
 	return err
 }
 
-// ItemCommentCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) ItemCommentCreate(ctx *echo.Context) error {
+// ItemGet converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemGet(ctx *echo.Context) error {
 	var err error
 	// ------------- Path parameter "workspaceIdOrAlias" -------------
 	var workspaceIdOrAlias WorkspaceIdOrAliasParam
@@ -1888,10 +2147,152 @@ func (w *ServerInterfaceWrapper) ItemCommentCreate(ctx *echo.Context) error {
 
 	ctx.Set(string(BearerAuthScopes), []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ItemCommentCreate(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey, itemId)
-	return err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ItemGetParams
+	// ------------- Optional query parameter "ref" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ref", ctx.QueryParams(), &params.Ref)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ref: %s", err))
+	}
+
+	// ------------- Optional query parameter "asset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "asset", ctx.QueryParams(), &params.Asset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ItemGet(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey, itemId, params)
+	return err
+}
+
+// ItemUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemUpdate(ctx *echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceIdOrAlias" -------------
+	var workspaceIdOrAlias WorkspaceIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceIdOrAlias", ctx.Param("workspaceIdOrAlias"), &workspaceIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "modelIdOrKey" -------------
+	var modelIdOrKey ModelIdOrKeyParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "modelIdOrKey", ctx.Param("modelIdOrKey"), &modelIdOrKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter modelIdOrKey: %s", err))
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId ItemIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", ctx.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter itemId: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ItemUpdate(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey, itemId)
+	return err
+}
+
+// ItemCommentList converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemCommentList(ctx *echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceIdOrAlias" -------------
+	var workspaceIdOrAlias WorkspaceIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceIdOrAlias", ctx.Param("workspaceIdOrAlias"), &workspaceIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "modelIdOrKey" -------------
+	var modelIdOrKey ModelIdOrKeyParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "modelIdOrKey", ctx.Param("modelIdOrKey"), &modelIdOrKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter modelIdOrKey: %s", err))
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId ItemIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", ctx.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter itemId: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ItemCommentList(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey, itemId)
+	return err
+}
+
+// ItemCommentCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemCommentCreate(ctx *echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceIdOrAlias" -------------
+	var workspaceIdOrAlias WorkspaceIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceIdOrAlias", ctx.Param("workspaceIdOrAlias"), &workspaceIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "modelIdOrKey" -------------
+	var modelIdOrKey ModelIdOrKeyParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "modelIdOrKey", ctx.Param("modelIdOrKey"), &modelIdOrKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter modelIdOrKey: %s", err))
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId ItemIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", ctx.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter itemId: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ItemCommentCreate(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey, itemId)
+	return err
+}
 
 // ItemCommentDelete converts echo context to params.
 func (w *ServerInterfaceWrapper) ItemCommentDelete(ctx *echo.Context) error {
@@ -1993,8 +2394,8 @@ func (w *ServerInterfaceWrapper) ItemCommentUpdate(ctx *echo.Context) error {
 	return err
 }
 
-// ItemPublish converts echo context to params.
-func (w *ServerInterfaceWrapper) ItemPublish(ctx *echo.Context) error {
+// ItemDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemDiff(ctx *echo.Context) error {
 	var err error
 	// ------------- Path parameter "workspaceIdOrAlias" -------------
 	var workspaceIdOrAlias WorkspaceIdOrAliasParam
@@ -2031,21 +2432,28 @@ func (w *ServerInterfaceWrapper) ItemPublish(ctx *echo.Context) error {
 	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ItemPublishParams
-	// ------------- Optional query parameter "asset" -------------
+	var params ItemDiffParams
+	// ------------- Required query parameter "from" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "asset", ctx.QueryParams(), &params.Asset)
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ItemPublish(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey, itemId, params)
+	err = w.Handler.ItemDiff(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey, itemId, params)
 	return err
 }
 
-// MetadataSchemaByModelAsJSON converts echo context to params.
-func (w *ServerInterfaceWrapper) MetadataSchemaByModelAsJSON(ctx *echo.Context) error {
+// ItemPublish converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemPublish(ctx *echo.Context) error {
 	var err error
 	// ------------- Path parameter "workspaceIdOrAlias" -------------
 	var workspaceIdOrAlias WorkspaceIdOrAliasParam
@@ -2071,15 +2479,32 @@ func (w *ServerInterfaceWrapper) MetadataSchemaByModelAsJSON(ctx *echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter modelIdOrKey: %s", err))
 	}
 
+	// ------------- Path parameter "itemId" -------------
+	var itemId ItemIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", ctx.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter itemId: %s", err))
+	}
+
 	ctx.Set(string(BearerAuthScopes), []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ItemPublishParams
+	// ------------- Optional query parameter "asset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "asset", ctx.QueryParams(), &params.Asset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.MetadataSchemaByModelAsJSON(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey)
+	err = w.Handler.ItemPublish(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey, itemId, params)
 	return err
 }
 
-// SchemaByModelAsJSON converts echo context to params.
-func (w *ServerInterfaceWrapper) SchemaByModelAsJSON(ctx *echo.Context) error {
+// ItemRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemRestore(ctx *echo.Context) error {
 	var err error
 	// ------------- Path parameter "workspaceIdOrAlias" -------------
 	var workspaceIdOrAlias WorkspaceIdOrAliasParam
@@ -2105,15 +2530,23 @@ func (w *ServerInterfaceWrapper) SchemaByModelAsJSON(ctx *echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter modelIdOrKey: %s", err))
 	}
 
+	// ------------- Path parameter "itemId" -------------
+	var itemId ItemIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", ctx.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter itemId: %s", err))
+	}
+
 	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SchemaByModelAsJSON(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey)
+	err = w.Handler.ItemRestore(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey, itemId)
 	return err
 }
 
-// FieldCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) FieldCreate(ctx *echo.Context) error {
+// MetadataSchemaByModelAsJSON converts echo context to params.
+func (w *ServerInterfaceWrapper) MetadataSchemaByModelAsJSON(ctx *echo.Context) error {
 	var err error
 	// ------------- Path parameter "workspaceIdOrAlias" -------------
 	var workspaceIdOrAlias WorkspaceIdOrAliasParam
@@ -2131,23 +2564,23 @@ func (w *ServerInterfaceWrapper) FieldCreate(ctx *echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
 	}
 
-	// ------------- Path parameter "schemaId" -------------
-	var schemaId SchemaIdParam
+	// ------------- Path parameter "modelIdOrKey" -------------
+	var modelIdOrKey ModelIdOrKeyParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "schemaId", ctx.Param("schemaId"), &schemaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "modelIdOrKey", ctx.Param("modelIdOrKey"), &modelIdOrKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter schemaId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter modelIdOrKey: %s", err))
 	}
 
 	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.FieldCreate(ctx, workspaceIdOrAlias, projectIdOrAlias, schemaId)
+	err = w.Handler.MetadataSchemaByModelAsJSON(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey)
 	return err
 }
 
-// FieldDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) FieldDelete(ctx *echo.Context) error {
+// SchemaByModelAsJSON converts echo context to params.
+func (w *ServerInterfaceWrapper) SchemaByModelAsJSON(ctx *echo.Context) error {
 	var err error
 	// ------------- Path parameter "workspaceIdOrAlias" -------------
 	var workspaceIdOrAlias WorkspaceIdOrAliasParam
@@ -2165,31 +2598,23 @@ func (w *ServerInterfaceWrapper) FieldDelete(ctx *echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
 	}
 
-	// ------------- Path parameter "schemaId" -------------
-	var schemaId SchemaIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "schemaId", ctx.Param("schemaId"), &schemaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter schemaId: %s", err))
-	}
-
-	// ------------- Path parameter "fieldIdOrKey" -------------
-	var fieldIdOrKey FieldIdOrKeyParam
+	// ------------- Path parameter "modelIdOrKey" -------------
+	var modelIdOrKey ModelIdOrKeyParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "fieldIdOrKey", ctx.Param("fieldIdOrKey"), &fieldIdOrKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "modelIdOrKey", ctx.Param("modelIdOrKey"), &modelIdOrKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldIdOrKey: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter modelIdOrKey: %s", err))
 	}
 
 	ctx.Set(string(BearerAuthScopes), []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.FieldDelete(ctx, workspaceIdOrAlias, projectIdOrAlias, schemaId, fieldIdOrKey)
+	err = w.Handler.SchemaByModelAsJSON(ctx, workspaceIdOrAlias, projectIdOrAlias, modelIdOrKey)
 	return err
 }
 
-// FieldUpdate converts echo context to params.
-func (w *ServerInterfaceWrapper) FieldUpdate(ctx *echo.Context) error {
+// ScheduleList converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleList(ctx *echo.Context) error {
 	var err error
 	// ------------- Path parameter "workspaceIdOrAlias" -------------
 	var workspaceIdOrAlias WorkspaceIdOrAliasParam
@@ -2207,40 +2632,245 @@ func (w *ServerInterfaceWrapper) FieldUpdate(ctx *echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
 	}
 
-	// ------------- Path parameter "schemaId" -------------
-	var schemaId SchemaIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "schemaId", ctx.Param("schemaId"), &schemaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter schemaId: %s", err))
-	}
+	ctx.Set(string(BearerAuthScopes), []string{})
 
-	// ------------- Path parameter "fieldIdOrKey" -------------
-	var fieldIdOrKey FieldIdOrKeyParam
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ScheduleListParams
+	// ------------- Optional query parameter "status" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "fieldIdOrKey", ctx.Param("fieldIdOrKey"), &fieldIdOrKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldIdOrKey: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
 	}
 
-	ctx.Set(string(BearerAuthScopes), []string{})
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.FieldUpdate(ctx, workspaceIdOrAlias, projectIdOrAlias, schemaId, fieldIdOrKey)
+	err = w.Handler.ScheduleList(ctx, workspaceIdOrAlias, projectIdOrAlias, params)
 	return err
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) echo.RouteInfo
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) echo.RouteInfo
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) echo.RouteInfo
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) echo.RouteInfo
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) echo.RouteInfo
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) echo.RouteInfo
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) echo.RouteInfo
+// ScheduleCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleCreate(ctx *echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceIdOrAlias" -------------
+	var workspaceIdOrAlias WorkspaceIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceIdOrAlias", ctx.Param("workspaceIdOrAlias"), &workspaceIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleCreate(ctx, workspaceIdOrAlias, projectIdOrAlias)
+	return err
+}
+
+// ScheduleCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleCancel(ctx *echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceIdOrAlias" -------------
+	var workspaceIdOrAlias WorkspaceIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceIdOrAlias", ctx.Param("workspaceIdOrAlias"), &workspaceIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId ScheduleIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", ctx.Param("scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scheduleId: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleCancel(ctx, workspaceIdOrAlias, projectIdOrAlias, scheduleId)
+	return err
+}
+
+// ScheduleGet converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleGet(ctx *echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceIdOrAlias" -------------
+	var workspaceIdOrAlias WorkspaceIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceIdOrAlias", ctx.Param("workspaceIdOrAlias"), &workspaceIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId ScheduleIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", ctx.Param("scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scheduleId: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleGet(ctx, workspaceIdOrAlias, projectIdOrAlias, scheduleId)
+	return err
+}
+
+// FieldCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) FieldCreate(ctx *echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceIdOrAlias" -------------
+	var workspaceIdOrAlias WorkspaceIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceIdOrAlias", ctx.Param("workspaceIdOrAlias"), &workspaceIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "schemaId" -------------
+	var schemaId SchemaIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schemaId", ctx.Param("schemaId"), &schemaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter schemaId: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.FieldCreate(ctx, workspaceIdOrAlias, projectIdOrAlias, schemaId)
+	return err
+}
+
+// FieldDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) FieldDelete(ctx *echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceIdOrAlias" -------------
+	var workspaceIdOrAlias WorkspaceIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceIdOrAlias", ctx.Param("workspaceIdOrAlias"), &workspaceIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "schemaId" -------------
+	var schemaId SchemaIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schemaId", ctx.Param("schemaId"), &schemaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter schemaId: %s", err))
+	}
+
+	// ------------- Path parameter "fieldIdOrKey" -------------
+	var fieldIdOrKey FieldIdOrKeyParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fieldIdOrKey", ctx.Param("fieldIdOrKey"), &fieldIdOrKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldIdOrKey: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.FieldDelete(ctx, workspaceIdOrAlias, projectIdOrAlias, schemaId, fieldIdOrKey)
+	return err
+}
+
+// FieldUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) FieldUpdate(ctx *echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceIdOrAlias" -------------
+	var workspaceIdOrAlias WorkspaceIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceIdOrAlias", ctx.Param("workspaceIdOrAlias"), &workspaceIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "schemaId" -------------
+	var schemaId SchemaIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schemaId", ctx.Param("schemaId"), &schemaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter schemaId: %s", err))
+	}
+
+	// ------------- Path parameter "fieldIdOrKey" -------------
+	var fieldIdOrKey FieldIdOrKeyParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fieldIdOrKey", ctx.Param("fieldIdOrKey"), &fieldIdOrKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fieldIdOrKey: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.FieldUpdate(ctx, workspaceIdOrAlias, projectIdOrAlias, schemaId, fieldIdOrKey)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) echo.RouteInfo
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) echo.RouteInfo
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) echo.RouteInfo
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) echo.RouteInfo
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) echo.RouteInfo
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) echo.RouteInfo
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) echo.RouteInfo
 	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) echo.RouteInfo
 	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) echo.RouteInfo
 }
@@ -2258,11 +2888,15 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/webhooks/:webhookId/deliveries", wrapper.WebhookDeliveryList)
+	router.POST(baseURL+"/webhooks/:webhookId/deliveries/:deliveryId/redeliver", wrapper.WebhookRedeliver)
 	router.GET(baseURL+"/:workspaceIdOrAlias/projects", wrapper.ProjectFilter)
 	router.POST(baseURL+"/:workspaceIdOrAlias/projects", wrapper.ProjectCreate)
 	router.DELETE(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias", wrapper.ProjectDelete)
 	router.GET(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias", wrapper.ProjectGet)
 	router.PATCH(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias", wrapper.ProjectUpdate)
+	router.GET(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/asset-folders", wrapper.AssetFolderList)
+	router.POST(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/asset-folders", wrapper.AssetFolderCreate)
 	router.DELETE(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/assets", wrapper.AssetBatchDelete)
 	router.GET(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/assets", wrapper.AssetFilter)
 	router.POST(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/assets", wrapper.AssetCreate)
@@ -2281,6 +2915,8 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/groups/:groupIdOrKey", wrapper.GroupDelete)
 	router.GET(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/groups/:groupIdOrKey", wrapper.GroupGet)
 	router.PATCH(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/groups/:groupIdOrKey", wrapper.GroupUpdate)
+	router.DELETE(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/jobs/:jobId", wrapper.JobCancel)
+	router.GET(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/jobs/:jobId", wrapper.JobGet)
 	router.GET(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models", wrapper.ModelFilter)
 	router.POST(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models", wrapper.ModelCreate)
 	router.DELETE(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models/:modelIdOrKey", wrapper.ModelDelete)
@@ -2292,7 +2928,11 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models/:modelIdOrKey/items", wrapper.ItemCreate)
 	router.GET(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models/:modelIdOrKey/items.csv", wrapper.ItemsAsCSV)
 	router.GET(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models/:modelIdOrKey/items.geojson", wrapper.ItemsAsGeoJSON)
+	router.POST(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models/:modelIdOrKey/items/bulk-update", wrapper.ItemBulkUpdate)
 	router.POST(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models/:modelIdOrKey/items/filter", wrapper.ItemFilterPost)
+	router.GET(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models/:modelIdOrKey/items/trash", wrapper.ItemTrashList)
+	router.POST(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models/:modelIdOrKey/items/trash/purge", wrapper.ItemTrashPurge)
+	router.POST(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models/:modelIdOrKey/items/trash/restore", wrapper.ItemTrashRestore)
 	router.DELETE(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models/:modelIdOrKey/items/:itemId", wrapper.ItemDelete)
 	router.GET(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models/:modelIdOrKey/items/:itemId", wrapper.ItemGet)
 	router.PATCH(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models/:modelIdOrKey/items/:itemId", wrapper.ItemUpdate)
@@ -2300,9 +2940,15 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models/:modelIdOrKey/items/:itemId/comments", wrapper.ItemCommentCreate)
 	router.DELETE(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models/:modelIdOrKey/items/:itemId/comments/:commentId", wrapper.ItemCommentDelete)
 	router.PATCH(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models/:modelIdOrKey/items/:itemId/comments/:commentId", wrapper.ItemCommentUpdate)
+	router.GET(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models/:modelIdOrKey/items/:itemId/diff", wrapper.ItemDiff)
 	router.POST(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models/:modelIdOrKey/items/:itemId/publish", wrapper.ItemPublish)
+	router.POST(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models/:modelIdOrKey/items/:itemId/restore", wrapper.ItemRestore)
 	router.GET(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models/:modelIdOrKey/metadata_schema.json", wrapper.MetadataSchemaByModelAsJSON)
 	router.GET(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/models/:modelIdOrKey/schema.json", wrapper.SchemaByModelAsJSON)
+	router.GET(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/schedules", wrapper.ScheduleList)
+	router.POST(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/schedules", wrapper.ScheduleCreate)
+	router.DELETE(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/schedules/:scheduleId", wrapper.ScheduleCancel)
+	router.GET(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/schedules/:scheduleId", wrapper.ScheduleGet)
 	router.POST(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/schemata/:schemaId/fields", wrapper.FieldCreate)
 	router.DELETE(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/schemata/:schemaId/fields/:fieldIdOrKey", wrapper.FieldDelete)
 	router.PATCH(baseURL+"/:workspaceIdOrAlias/projects/:projectIdOrAlias/schemata/:schemaId/fields/:fieldIdOrKey", wrapper.FieldUpdate)
@@ -2315,23 +2961,23 @@ type NotFoundErrorResponse struct {
 type UnauthorizedErrorResponse struct {
 }
 
-type ProjectFilterRequestObject struct {
-	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
-	Params             ProjectFilterParams
+type WebhookDeliveryListRequestObject struct {
+	WebhookId WebhookIdParam `json:"webhookId"`
+	Params    WebhookDeliveryListParams
 }
 
-type ProjectFilterResponseObject interface {
-	VisitProjectFilterResponse(w http.ResponseWriter) error
+type WebhookDeliveryListResponseObject interface {
+	VisitWebhookDeliveryListResponse(w http.ResponseWriter) error
 }
 
-type ProjectFilter200JSONResponse struct {
-	Page       *int       `json:"page,omitempty"`
-	PerPage    *int       `json:"perPage,omitempty"`
-	Projects   *[]Project `json:"projects,omitempty"`
-	TotalCount *int       `json:"totalCount,omitempty"`
+type WebhookDeliveryList200JSONResponse struct {
+	Deliveries *[]WebhookDelivery `json:"deliveries,omitempty"`
+	Page       *int               `json:"page,omitempty"`
+	PerPage    *int               `json:"perPage,omitempty"`
+	TotalCount *int               `json:"totalCount,omitempty"`
 }
 
-func (response ProjectFilter200JSONResponse) VisitProjectFilterResponse(w http.ResponseWriter) error {
+func (response WebhookDeliveryList200JSONResponse) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -2343,105 +2989,909 @@ func (response ProjectFilter200JSONResponse) VisitProjectFilterResponse(w http.R
 	return err
 }
 
-type ProjectFilter400Response struct {
+type WebhookDeliveryList400Response struct {
 }
 
-func (response ProjectFilter400Response) VisitProjectFilterResponse(w http.ResponseWriter) error {
+func (response WebhookDeliveryList400Response) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ProjectFilter401Response = UnauthorizedErrorResponse
+type WebhookDeliveryList401Response = UnauthorizedErrorResponse
 
-func (response ProjectFilter401Response) VisitProjectFilterResponse(w http.ResponseWriter) error {
+func (response WebhookDeliveryList401Response) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ProjectFilter404Response = NotFoundErrorResponse
+type WebhookDeliveryList404Response struct {
+}
 
-func (response ProjectFilter404Response) VisitProjectFilterResponse(w http.ResponseWriter) error {
+func (response WebhookDeliveryList404Response) VisitWebhookDeliveryListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ProjectFilter500Response struct {
+type WebhookRedeliverRequestObject struct {
+	WebhookId  WebhookIdParam         `json:"webhookId"`
+	DeliveryId WebhookDeliveryIdParam `json:"deliveryId"`
 }
 
-func (response ProjectFilter500Response) VisitProjectFilterResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
+type WebhookRedeliverResponseObject interface {
+	VisitWebhookRedeliverResponse(w http.ResponseWriter) error
+}
+
+type WebhookRedeliver200JSONResponse WebhookDelivery
+
+func (response WebhookRedeliver200JSONResponse) VisitWebhookRedeliverResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type WebhookRedeliver400Response struct {
+}
+
+func (response WebhookRedeliver400Response) VisitWebhookRedeliverResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
 	return nil
 }
 
-type ProjectCreateRequestObject struct {
-	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
-	Params             ProjectCreateParams
-	Body               *ProjectCreateJSONRequestBody
+type WebhookRedeliver401Response = UnauthorizedErrorResponse
+
+func (response WebhookRedeliver401Response) VisitWebhookRedeliverResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type WebhookRedeliver404Response struct {
+}
+
+func (response WebhookRedeliver404Response) VisitWebhookRedeliverResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ProjectFilterRequestObject struct {
+	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
+	Params             ProjectFilterParams
+}
+
+type ProjectFilterResponseObject interface {
+	VisitProjectFilterResponse(w http.ResponseWriter) error
+}
+
+type ProjectFilter200JSONResponse struct {
+	Page       *int       `json:"page,omitempty"`
+	PerPage    *int       `json:"perPage,omitempty"`
+	Projects   *[]Project `json:"projects,omitempty"`
+	TotalCount *int       `json:"totalCount,omitempty"`
+}
+
+func (response ProjectFilter200JSONResponse) VisitProjectFilterResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ProjectFilter400Response struct {
+}
+
+func (response ProjectFilter400Response) VisitProjectFilterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ProjectFilter401Response = UnauthorizedErrorResponse
+
+func (response ProjectFilter401Response) VisitProjectFilterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ProjectFilter404Response = NotFoundErrorResponse
+
+func (response ProjectFilter404Response) VisitProjectFilterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ProjectFilter500Response struct {
+}
+
+func (response ProjectFilter500Response) VisitProjectFilterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ProjectCreateRequestObject struct {
+	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
+	Params             ProjectCreateParams
+	Body               *ProjectCreateJSONRequestBody
+}
+
+type ProjectCreateResponseObject interface {
+	VisitProjectCreateResponse(w http.ResponseWriter) error
+}
+
+type ProjectCreate201JSONResponse Project
+
+func (response ProjectCreate201JSONResponse) VisitProjectCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ProjectCreate400Response struct {
+}
+
+func (response ProjectCreate400Response) VisitProjectCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ProjectCreate401Response = UnauthorizedErrorResponse
+
+func (response ProjectCreate401Response) VisitProjectCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ProjectCreate404Response struct {
+}
+
+func (response ProjectCreate404Response) VisitProjectCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ProjectCreate500Response struct {
+}
+
+func (response ProjectCreate500Response) VisitProjectCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ProjectDeleteRequestObject struct {
+	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
+	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
+}
+
+type ProjectDeleteResponseObject interface {
+	VisitProjectDeleteResponse(w http.ResponseWriter) error
+}
+
+type ProjectDelete200JSONResponse struct {
+	Id id.ProjectID `json:"id"`
+}
+
+func (response ProjectDelete200JSONResponse) VisitProjectDeleteResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ProjectDelete400Response struct {
+}
+
+func (response ProjectDelete400Response) VisitProjectDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ProjectDelete401Response = UnauthorizedErrorResponse
+
+func (response ProjectDelete401Response) VisitProjectDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ProjectDelete404Response struct {
+}
+
+func (response ProjectDelete404Response) VisitProjectDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ProjectDelete500Response struct {
+}
+
+func (response ProjectDelete500Response) VisitProjectDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ProjectGetRequestObject struct {
+	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
+	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
+}
+
+type ProjectGetResponseObject interface {
+	VisitProjectGetResponse(w http.ResponseWriter) error
+}
+
+type ProjectGet200JSONResponse Project
+
+func (response ProjectGet200JSONResponse) VisitProjectGetResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ProjectGet400Response struct {
+}
+
+func (response ProjectGet400Response) VisitProjectGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ProjectGet401Response = UnauthorizedErrorResponse
+
+func (response ProjectGet401Response) VisitProjectGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ProjectGet404Response struct {
+}
+
+func (response ProjectGet404Response) VisitProjectGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ProjectGet500Response struct {
+}
+
+func (response ProjectGet500Response) VisitProjectGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ProjectUpdateRequestObject struct {
+	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
+	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
+	Body               *ProjectUpdateJSONRequestBody
+}
+
+type ProjectUpdateResponseObject interface {
+	VisitProjectUpdateResponse(w http.ResponseWriter) error
+}
+
+type ProjectUpdate200JSONResponse Project
+
+func (response ProjectUpdate200JSONResponse) VisitProjectUpdateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ProjectUpdate400Response struct {
+}
+
+func (response ProjectUpdate400Response) VisitProjectUpdateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ProjectUpdate401Response = UnauthorizedErrorResponse
+
+func (response ProjectUpdate401Response) VisitProjectUpdateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ProjectUpdate404Response struct {
+}
+
+func (response ProjectUpdate404Response) VisitProjectUpdateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ProjectUpdate500Response struct {
+}
+
+func (response ProjectUpdate500Response) VisitProjectUpdateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type AssetFolderListRequestObject struct {
+	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
+	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
+}
+
+type AssetFolderListResponseObject interface {
+	VisitAssetFolderListResponse(w http.ResponseWriter) error
+}
+
+type AssetFolderList200JSONResponse struct {
+	Folders *[]AssetFolder `json:"folders,omitempty"`
+}
+
+func (response AssetFolderList200JSONResponse) VisitAssetFolderListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type AssetFolderList400Response struct {
+}
+
+func (response AssetFolderList400Response) VisitAssetFolderListResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AssetFolderList401Response = UnauthorizedErrorResponse
+
+func (response AssetFolderList401Response) VisitAssetFolderListResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AssetFolderList404Response struct {
+}
+
+func (response AssetFolderList404Response) VisitAssetFolderListResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AssetFolderCreateRequestObject struct {
+	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
+	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
+	Body               *AssetFolderCreateJSONRequestBody
+}
+
+type AssetFolderCreateResponseObject interface {
+	VisitAssetFolderCreateResponse(w http.ResponseWriter) error
+}
+
+type AssetFolderCreate200JSONResponse AssetFolder
+
+func (response AssetFolderCreate200JSONResponse) VisitAssetFolderCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type AssetFolderCreate400Response struct {
+}
+
+func (response AssetFolderCreate400Response) VisitAssetFolderCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AssetFolderCreate401Response = UnauthorizedErrorResponse
+
+func (response AssetFolderCreate401Response) VisitAssetFolderCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AssetFolderCreate404Response struct {
+}
+
+func (response AssetFolderCreate404Response) VisitAssetFolderCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AssetBatchDeleteRequestObject struct {
+	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
+	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
+	Body               *AssetBatchDeleteJSONRequestBody
+}
+
+type AssetBatchDeleteResponseObject interface {
+	VisitAssetBatchDeleteResponse(w http.ResponseWriter) error
+}
+
+type AssetBatchDelete200JSONResponse struct {
+	Ids *[]id.AssetID `json:"ids,omitempty"`
+}
+
+func (response AssetBatchDelete200JSONResponse) VisitAssetBatchDeleteResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type AssetBatchDelete400Response struct {
+}
+
+func (response AssetBatchDelete400Response) VisitAssetBatchDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AssetBatchDelete401Response = UnauthorizedErrorResponse
+
+func (response AssetBatchDelete401Response) VisitAssetBatchDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AssetBatchDelete404Response struct {
+}
+
+func (response AssetBatchDelete404Response) VisitAssetBatchDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AssetFilterRequestObject struct {
+	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
+	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
+	Params             AssetFilterParams
+}
+
+type AssetFilterResponseObject interface {
+	VisitAssetFilterResponse(w http.ResponseWriter) error
+}
+
+type AssetFilter200JSONResponse struct {
+	Items      *[]Asset `json:"items,omitempty"`
+	Page       *int     `json:"page,omitempty"`
+	PerPage    *int     `json:"perPage,omitempty"`
+	TotalCount *int     `json:"totalCount,omitempty"`
+}
+
+func (response AssetFilter200JSONResponse) VisitAssetFilterResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type AssetFilter400Response struct {
+}
+
+func (response AssetFilter400Response) VisitAssetFilterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AssetFilter401Response = UnauthorizedErrorResponse
+
+func (response AssetFilter401Response) VisitAssetFilterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AssetFilter404Response struct {
+}
+
+func (response AssetFilter404Response) VisitAssetFilterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AssetCreateRequestObject struct {
+	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
+	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
+	JSONBody           *AssetCreateJSONRequestBody
+	MultipartBody      *multipart.Reader
+}
+
+type AssetCreateResponseObject interface {
+	VisitAssetCreateResponse(w http.ResponseWriter) error
+}
+
+type AssetCreate200JSONResponse Asset
+
+func (response AssetCreate200JSONResponse) VisitAssetCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type AssetCreate400Response struct {
+}
+
+func (response AssetCreate400Response) VisitAssetCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AssetCreate401Response = UnauthorizedErrorResponse
+
+func (response AssetCreate401Response) VisitAssetCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AssetCreate404Response struct {
+}
+
+func (response AssetCreate404Response) VisitAssetCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AssetUploadCreateRequestObject struct {
+	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
+	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
+	Body               *AssetUploadCreateJSONRequestBody
+}
+
+type AssetUploadCreateResponseObject interface {
+	VisitAssetUploadCreateResponse(w http.ResponseWriter) error
+}
+
+type AssetUploadCreate200JSONResponse struct {
+	ContentEncoding *string `json:"contentEncoding,omitempty"`
+	ContentLength   *int    `json:"contentLength,omitempty"`
+	ContentType     *string `json:"contentType,omitempty"`
+	Next            *string `json:"next,omitempty"`
+	Token           *string `json:"token,omitempty"`
+	Url             *string `json:"url,omitempty"`
+}
+
+func (response AssetUploadCreate200JSONResponse) VisitAssetUploadCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type AssetUploadCreate400Response struct {
+}
+
+func (response AssetUploadCreate400Response) VisitAssetUploadCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AssetUploadCreate401Response = UnauthorizedErrorResponse
+
+func (response AssetUploadCreate401Response) VisitAssetUploadCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AssetUploadCreate404Response struct {
+}
+
+func (response AssetUploadCreate404Response) VisitAssetUploadCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AssetDeleteRequestObject struct {
+	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
+	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
+	AssetId            AssetIdParam            `json:"assetId"`
+}
+
+type AssetDeleteResponseObject interface {
+	VisitAssetDeleteResponse(w http.ResponseWriter) error
+}
+
+type AssetDelete200JSONResponse struct {
+	Id *id.AssetID `json:"id,omitempty"`
+}
+
+func (response AssetDelete200JSONResponse) VisitAssetDeleteResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type AssetDelete400Response struct {
+}
+
+func (response AssetDelete400Response) VisitAssetDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AssetDelete401Response = UnauthorizedErrorResponse
+
+func (response AssetDelete401Response) VisitAssetDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AssetDelete404Response struct {
+}
+
+func (response AssetDelete404Response) VisitAssetDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AssetGetRequestObject struct {
+	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
+	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
+	AssetId            AssetIdParam            `json:"assetId"`
+}
+
+type AssetGetResponseObject interface {
+	VisitAssetGetResponse(w http.ResponseWriter) error
+}
+
+type AssetGet200JSONResponse Asset
+
+func (response AssetGet200JSONResponse) VisitAssetGetResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type AssetGet400Response struct {
+}
+
+func (response AssetGet400Response) VisitAssetGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AssetGet401Response = UnauthorizedErrorResponse
+
+func (response AssetGet401Response) VisitAssetGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AssetGet404Response struct {
+}
+
+func (response AssetGet404Response) VisitAssetGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AssetCommentListRequestObject struct {
+	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
+	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
+	AssetId            AssetIdParam            `json:"assetId"`
+}
+
+type AssetCommentListResponseObject interface {
+	VisitAssetCommentListResponse(w http.ResponseWriter) error
+}
+
+type AssetCommentList200JSONResponse struct {
+	Comments *[]Comment `json:"comments,omitempty"`
+}
+
+func (response AssetCommentList200JSONResponse) VisitAssetCommentListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type AssetCommentList400Response struct {
+}
+
+func (response AssetCommentList400Response) VisitAssetCommentListResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AssetCommentList401Response = UnauthorizedErrorResponse
+
+func (response AssetCommentList401Response) VisitAssetCommentListResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AssetCommentList404Response struct {
+}
+
+func (response AssetCommentList404Response) VisitAssetCommentListResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AssetCommentCreateRequestObject struct {
+	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
+	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
+	AssetId            AssetIdParam            `json:"assetId"`
+	Body               *AssetCommentCreateJSONRequestBody
+}
+
+type AssetCommentCreateResponseObject interface {
+	VisitAssetCommentCreateResponse(w http.ResponseWriter) error
+}
+
+type AssetCommentCreate200JSONResponse Comment
+
+func (response AssetCommentCreate200JSONResponse) VisitAssetCommentCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type AssetCommentCreate400Response struct {
+}
+
+func (response AssetCommentCreate400Response) VisitAssetCommentCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AssetCommentCreate401Response = UnauthorizedErrorResponse
+
+func (response AssetCommentCreate401Response) VisitAssetCommentCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AssetCommentCreate404Response struct {
+}
+
+func (response AssetCommentCreate404Response) VisitAssetCommentCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AssetCommentDeleteRequestObject struct {
+	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
+	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
+	AssetId            AssetIdParam            `json:"assetId"`
+	CommentId          CommentIdParam          `json:"commentId"`
 }
 
-type ProjectCreateResponseObject interface {
-	VisitProjectCreateResponse(w http.ResponseWriter) error
+type AssetCommentDeleteResponseObject interface {
+	VisitAssetCommentDeleteResponse(w http.ResponseWriter) error
 }
 
-type ProjectCreate201JSONResponse Project
+type AssetCommentDelete200JSONResponse struct {
+	Id *id.CommentID `json:"id,omitempty"`
+}
 
-func (response ProjectCreate201JSONResponse) VisitProjectCreateResponse(w http.ResponseWriter) error {
+func (response AssetCommentDelete200JSONResponse) VisitAssetCommentDeleteResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type ProjectCreate400Response struct {
+type AssetCommentDelete400Response struct {
 }
 
-func (response ProjectCreate400Response) VisitProjectCreateResponse(w http.ResponseWriter) error {
+func (response AssetCommentDelete400Response) VisitAssetCommentDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ProjectCreate401Response = UnauthorizedErrorResponse
+type AssetCommentDelete401Response = UnauthorizedErrorResponse
 
-func (response ProjectCreate401Response) VisitProjectCreateResponse(w http.ResponseWriter) error {
+func (response AssetCommentDelete401Response) VisitAssetCommentDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ProjectCreate404Response struct {
+type AssetCommentDelete404Response struct {
 }
 
-func (response ProjectCreate404Response) VisitProjectCreateResponse(w http.ResponseWriter) error {
+func (response AssetCommentDelete404Response) VisitAssetCommentDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ProjectCreate500Response struct {
-}
-
-func (response ProjectCreate500Response) VisitProjectCreateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
-
-type ProjectDeleteRequestObject struct {
+type AssetCommentUpdateRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
+	AssetId            AssetIdParam            `json:"assetId"`
+	CommentId          CommentIdParam          `json:"commentId"`
+	Body               *AssetCommentUpdateJSONRequestBody
 }
 
-type ProjectDeleteResponseObject interface {
-	VisitProjectDeleteResponse(w http.ResponseWriter) error
+type AssetCommentUpdateResponseObject interface {
+	VisitAssetCommentUpdateResponse(w http.ResponseWriter) error
 }
 
-type ProjectDelete200JSONResponse struct {
-	Id id.ProjectID `json:"id"`
-}
+type AssetCommentUpdate200JSONResponse Comment
 
-func (response ProjectDelete200JSONResponse) VisitProjectDeleteResponse(w http.ResponseWriter) error {
+func (response AssetCommentUpdate200JSONResponse) VisitAssetCommentUpdateResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -2453,49 +3903,42 @@ func (response ProjectDelete200JSONResponse) VisitProjectDeleteResponse(w http.R
 	return err
 }
 
-type ProjectDelete400Response struct {
+type AssetCommentUpdate400Response struct {
 }
 
-func (response ProjectDelete400Response) VisitProjectDeleteResponse(w http.ResponseWriter) error {
+func (response AssetCommentUpdate400Response) VisitAssetCommentUpdateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ProjectDelete401Response = UnauthorizedErrorResponse
+type AssetCommentUpdate401Response = UnauthorizedErrorResponse
 
-func (response ProjectDelete401Response) VisitProjectDeleteResponse(w http.ResponseWriter) error {
+func (response AssetCommentUpdate401Response) VisitAssetCommentUpdateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ProjectDelete404Response struct {
+type AssetCommentUpdate404Response struct {
 }
 
-func (response ProjectDelete404Response) VisitProjectDeleteResponse(w http.ResponseWriter) error {
+func (response AssetCommentUpdate404Response) VisitAssetCommentUpdateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ProjectDelete500Response struct {
-}
-
-func (response ProjectDelete500Response) VisitProjectDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
-
-type ProjectGetRequestObject struct {
+type AssetPublishRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
+	AssetId            AssetIdParam            `json:"assetId"`
 }
 
-type ProjectGetResponseObject interface {
-	VisitProjectGetResponse(w http.ResponseWriter) error
+type AssetPublishResponseObject interface {
+	VisitAssetPublishResponse(w http.ResponseWriter) error
 }
 
-type ProjectGet200JSONResponse Project
+type AssetPublish200JSONResponse Asset
 
-func (response ProjectGet200JSONResponse) VisitProjectGetResponse(w http.ResponseWriter) error {
+func (response AssetPublish200JSONResponse) VisitAssetPublishResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -2507,50 +3950,42 @@ func (response ProjectGet200JSONResponse) VisitProjectGetResponse(w http.Respons
 	return err
 }
 
-type ProjectGet400Response struct {
+type AssetPublish400Response struct {
 }
 
-func (response ProjectGet400Response) VisitProjectGetResponse(w http.ResponseWriter) error {
+func (response AssetPublish400Response) VisitAssetPublishResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ProjectGet401Response = UnauthorizedErrorResponse
+type AssetPublish401Response = UnauthorizedErrorResponse
 
-func (response ProjectGet401Response) VisitProjectGetResponse(w http.ResponseWriter) error {
+func (response AssetPublish401Response) VisitAssetPublishResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ProjectGet404Response struct {
+type AssetPublish404Response struct {
 }
 
-func (response ProjectGet404Response) VisitProjectGetResponse(w http.ResponseWriter) error {
+func (response AssetPublish404Response) VisitAssetPublishResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ProjectGet500Response struct {
-}
-
-func (response ProjectGet500Response) VisitProjectGetResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
-
-type ProjectUpdateRequestObject struct {
+type AssetUnpublishRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	Body               *ProjectUpdateJSONRequestBody
+	AssetId            AssetIdParam            `json:"assetId"`
 }
 
-type ProjectUpdateResponseObject interface {
-	VisitProjectUpdateResponse(w http.ResponseWriter) error
+type AssetUnpublishResponseObject interface {
+	VisitAssetUnpublishResponse(w http.ResponseWriter) error
 }
 
-type ProjectUpdate200JSONResponse Project
+type AssetUnpublish200JSONResponse Asset
 
-func (response ProjectUpdate200JSONResponse) VisitProjectUpdateResponse(w http.ResponseWriter) error {
+func (response AssetUnpublish200JSONResponse) VisitAssetUnpublishResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -2562,104 +3997,102 @@ func (response ProjectUpdate200JSONResponse) VisitProjectUpdateResponse(w http.R
 	return err
 }
 
-type ProjectUpdate400Response struct {
+type AssetUnpublish400Response struct {
 }
 
-func (response ProjectUpdate400Response) VisitProjectUpdateResponse(w http.ResponseWriter) error {
+func (response AssetUnpublish400Response) VisitAssetUnpublishResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ProjectUpdate401Response = UnauthorizedErrorResponse
+type AssetUnpublish401Response = UnauthorizedErrorResponse
 
-func (response ProjectUpdate401Response) VisitProjectUpdateResponse(w http.ResponseWriter) error {
+func (response AssetUnpublish401Response) VisitAssetUnpublishResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ProjectUpdate404Response struct {
+type AssetUnpublish404Response struct {
 }
 
-func (response ProjectUpdate404Response) VisitProjectUpdateResponse(w http.ResponseWriter) error {
+func (response AssetUnpublish404Response) VisitAssetUnpublishResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ProjectUpdate500Response struct {
-}
-
-func (response ProjectUpdate500Response) VisitProjectUpdateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
-
-type AssetBatchDeleteRequestObject struct {
+type AssetContentGetRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	Body               *AssetBatchDeleteJSONRequestBody
+	Uuid1              string                  `json:"uuid1"`
+	Uuid2              string                  `json:"uuid2"`
+	Filename           string                  `json:"filename"`
 }
 
-type AssetBatchDeleteResponseObject interface {
-	VisitAssetBatchDeleteResponse(w http.ResponseWriter) error
+type AssetContentGetResponseObject interface {
+	VisitAssetContentGetResponse(w http.ResponseWriter) error
 }
 
-type AssetBatchDelete200JSONResponse struct {
-	Ids *[]id.AssetID `json:"ids,omitempty"`
+type AssetContentGet200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
 }
 
-func (response AssetBatchDelete200JSONResponse) VisitAssetBatchDeleteResponse(w http.ResponseWriter) error {
+func (response AssetContentGet200ApplicationoctetStreamResponse) VisitAssetContentGetResponse(w http.ResponseWriter) error {
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
-	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
 	return err
 }
 
-type AssetBatchDelete400Response struct {
+type AssetContentGet400Response struct {
 }
 
-func (response AssetBatchDelete400Response) VisitAssetBatchDeleteResponse(w http.ResponseWriter) error {
+func (response AssetContentGet400Response) VisitAssetContentGetResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type AssetBatchDelete401Response = UnauthorizedErrorResponse
+type AssetContentGet401Response = UnauthorizedErrorResponse
 
-func (response AssetBatchDelete401Response) VisitAssetBatchDeleteResponse(w http.ResponseWriter) error {
+func (response AssetContentGet401Response) VisitAssetContentGetResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AssetBatchDelete404Response struct {
+type AssetContentGet404Response struct {
 }
 
-func (response AssetBatchDelete404Response) VisitAssetBatchDeleteResponse(w http.ResponseWriter) error {
+func (response AssetContentGet404Response) VisitAssetContentGetResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type AssetFilterRequestObject struct {
+type GroupFilterRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	Params             AssetFilterParams
+	Params             GroupFilterParams
 }
 
-type AssetFilterResponseObject interface {
-	VisitAssetFilterResponse(w http.ResponseWriter) error
+type GroupFilterResponseObject interface {
+	VisitGroupFilterResponse(w http.ResponseWriter) error
 }
 
-type AssetFilter200JSONResponse struct {
-	Items      *[]Asset `json:"items,omitempty"`
+type GroupFilter200JSONResponse struct {
+	Groups     *[]Group `json:"groups,omitempty"`
 	Page       *int     `json:"page,omitempty"`
 	PerPage    *int     `json:"perPage,omitempty"`
 	TotalCount *int     `json:"totalCount,omitempty"`
 }
 
-func (response AssetFilter200JSONResponse) VisitAssetFilterResponse(w http.ResponseWriter) error {
+func (response GroupFilter200JSONResponse) VisitGroupFilterResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -2671,97 +4104,100 @@ func (response AssetFilter200JSONResponse) VisitAssetFilterResponse(w http.Respo
 	return err
 }
 
-type AssetFilter400Response struct {
+type GroupFilter400Response struct {
 }
 
-func (response AssetFilter400Response) VisitAssetFilterResponse(w http.ResponseWriter) error {
+func (response GroupFilter400Response) VisitGroupFilterResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type AssetFilter401Response = UnauthorizedErrorResponse
+type GroupFilter401Response = UnauthorizedErrorResponse
 
-func (response AssetFilter401Response) VisitAssetFilterResponse(w http.ResponseWriter) error {
+func (response GroupFilter401Response) VisitGroupFilterResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AssetFilter404Response struct {
+type GroupFilter404Response struct {
 }
 
-func (response AssetFilter404Response) VisitAssetFilterResponse(w http.ResponseWriter) error {
+func (response GroupFilter404Response) VisitGroupFilterResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type AssetCreateRequestObject struct {
+type GroupFilter500Response struct {
+}
+
+func (response GroupFilter500Response) VisitGroupFilterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GroupCreateRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	JSONBody           *AssetCreateJSONRequestBody
-	MultipartBody      *multipart.Reader
+	Params             GroupCreateParams
+	Body               *GroupCreateJSONRequestBody
 }
 
-type AssetCreateResponseObject interface {
-	VisitAssetCreateResponse(w http.ResponseWriter) error
+type GroupCreateResponseObject interface {
+	VisitGroupCreateResponse(w http.ResponseWriter) error
 }
 
-type AssetCreate200JSONResponse Asset
+type GroupCreate201JSONResponse Group
 
-func (response AssetCreate200JSONResponse) VisitAssetCreateResponse(w http.ResponseWriter) error {
+func (response GroupCreate201JSONResponse) VisitGroupCreateResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type AssetCreate400Response struct {
+type GroupCreate400Response struct {
 }
 
-func (response AssetCreate400Response) VisitAssetCreateResponse(w http.ResponseWriter) error {
+func (response GroupCreate400Response) VisitGroupCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type AssetCreate401Response = UnauthorizedErrorResponse
+type GroupCreate401Response = UnauthorizedErrorResponse
 
-func (response AssetCreate401Response) VisitAssetCreateResponse(w http.ResponseWriter) error {
+func (response GroupCreate401Response) VisitGroupCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AssetCreate404Response struct {
+type GroupCreate500Response struct {
 }
 
-func (response AssetCreate404Response) VisitAssetCreateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
+func (response GroupCreate500Response) VisitGroupCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
 	return nil
 }
 
-type AssetUploadCreateRequestObject struct {
+type GroupDeleteRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	Body               *AssetUploadCreateJSONRequestBody
-}
-
-type AssetUploadCreateResponseObject interface {
-	VisitAssetUploadCreateResponse(w http.ResponseWriter) error
-}
-
-type AssetUploadCreate200JSONResponse struct {
-	ContentEncoding *string `json:"contentEncoding,omitempty"`
-	ContentLength   *int    `json:"contentLength,omitempty"`
-	ContentType     *string `json:"contentType,omitempty"`
-	Next            *string `json:"next,omitempty"`
-	Token           *string `json:"token,omitempty"`
-	Url             *string `json:"url,omitempty"`
+	GroupIdOrKey       GroupIdOrKeyParam       `json:"groupIdOrKey"`
 }
 
-func (response AssetUploadCreate200JSONResponse) VisitAssetUploadCreateResponse(w http.ResponseWriter) error {
+type GroupDeleteResponseObject interface {
+	VisitGroupDeleteResponse(w http.ResponseWriter) error
+}
+
+type GroupDelete200JSONResponse struct {
+	Id *id.GroupID `json:"id,omitempty"`
+}
+
+func (response GroupDelete200JSONResponse) VisitGroupDeleteResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -2773,44 +4209,50 @@ func (response AssetUploadCreate200JSONResponse) VisitAssetUploadCreateResponse(
 	return err
 }
 
-type AssetUploadCreate400Response struct {
+type GroupDelete400Response struct {
 }
 
-func (response AssetUploadCreate400Response) VisitAssetUploadCreateResponse(w http.ResponseWriter) error {
+func (response GroupDelete400Response) VisitGroupDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type AssetUploadCreate401Response = UnauthorizedErrorResponse
+type GroupDelete401Response = UnauthorizedErrorResponse
 
-func (response AssetUploadCreate401Response) VisitAssetUploadCreateResponse(w http.ResponseWriter) error {
+func (response GroupDelete401Response) VisitGroupDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AssetUploadCreate404Response struct {
+type GroupDelete404Response struct {
 }
 
-func (response AssetUploadCreate404Response) VisitAssetUploadCreateResponse(w http.ResponseWriter) error {
+func (response GroupDelete404Response) VisitGroupDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type AssetDeleteRequestObject struct {
+type GroupDelete500Response struct {
+}
+
+func (response GroupDelete500Response) VisitGroupDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GroupGetRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	AssetId            AssetIdParam            `json:"assetId"`
+	GroupIdOrKey       GroupIdOrKeyParam       `json:"groupIdOrKey"`
 }
 
-type AssetDeleteResponseObject interface {
-	VisitAssetDeleteResponse(w http.ResponseWriter) error
+type GroupGetResponseObject interface {
+	VisitGroupGetResponse(w http.ResponseWriter) error
 }
 
-type AssetDelete200JSONResponse struct {
-	Id *id.AssetID `json:"id,omitempty"`
-}
+type GroupGet200JSONResponse Group
 
-func (response AssetDelete200JSONResponse) VisitAssetDeleteResponse(w http.ResponseWriter) error {
+func (response GroupGet200JSONResponse) VisitGroupGetResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -2822,42 +4264,51 @@ func (response AssetDelete200JSONResponse) VisitAssetDeleteResponse(w http.Respo
 	return err
 }
 
-type AssetDelete400Response struct {
+type GroupGet400Response struct {
 }
 
-func (response AssetDelete400Response) VisitAssetDeleteResponse(w http.ResponseWriter) error {
+func (response GroupGet400Response) VisitGroupGetResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type AssetDelete401Response = UnauthorizedErrorResponse
+type GroupGet401Response = UnauthorizedErrorResponse
 
-func (response AssetDelete401Response) VisitAssetDeleteResponse(w http.ResponseWriter) error {
+func (response GroupGet401Response) VisitGroupGetResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AssetDelete404Response struct {
+type GroupGet404Response struct {
 }
 
-func (response AssetDelete404Response) VisitAssetDeleteResponse(w http.ResponseWriter) error {
+func (response GroupGet404Response) VisitGroupGetResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type AssetGetRequestObject struct {
+type GroupGet500Response struct {
+}
+
+func (response GroupGet500Response) VisitGroupGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GroupUpdateRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	AssetId            AssetIdParam            `json:"assetId"`
+	GroupIdOrKey       GroupIdOrKeyParam       `json:"groupIdOrKey"`
+	Body               *GroupUpdateJSONRequestBody
 }
 
-type AssetGetResponseObject interface {
-	VisitAssetGetResponse(w http.ResponseWriter) error
+type GroupUpdateResponseObject interface {
+	VisitGroupUpdateResponse(w http.ResponseWriter) error
 }
 
-type AssetGet200JSONResponse Asset
+type GroupUpdate200JSONResponse Group
 
-func (response AssetGet200JSONResponse) VisitAssetGetResponse(w http.ResponseWriter) error {
+func (response GroupUpdate200JSONResponse) VisitGroupUpdateResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -2869,44 +4320,50 @@ func (response AssetGet200JSONResponse) VisitAssetGetResponse(w http.ResponseWri
 	return err
 }
 
-type AssetGet400Response struct {
+type GroupUpdate400Response struct {
 }
 
-func (response AssetGet400Response) VisitAssetGetResponse(w http.ResponseWriter) error {
+func (response GroupUpdate400Response) VisitGroupUpdateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type AssetGet401Response = UnauthorizedErrorResponse
+type GroupUpdate401Response = UnauthorizedErrorResponse
 
-func (response AssetGet401Response) VisitAssetGetResponse(w http.ResponseWriter) error {
+func (response GroupUpdate401Response) VisitGroupUpdateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AssetGet404Response struct {
+type GroupUpdate404Response struct {
 }
 
-func (response AssetGet404Response) VisitAssetGetResponse(w http.ResponseWriter) error {
+func (response GroupUpdate404Response) VisitGroupUpdateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type AssetCommentListRequestObject struct {
+type GroupUpdate500Response struct {
+}
+
+func (response GroupUpdate500Response) VisitGroupUpdateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type JobCancelRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	AssetId            AssetIdParam            `json:"assetId"`
+	JobId              JobIdParam              `json:"jobId"`
 }
 
-type AssetCommentListResponseObject interface {
-	VisitAssetCommentListResponse(w http.ResponseWriter) error
+type JobCancelResponseObject interface {
+	VisitJobCancelResponse(w http.ResponseWriter) error
 }
 
-type AssetCommentList200JSONResponse struct {
-	Comments *[]Comment `json:"comments,omitempty"`
-}
+type JobCancel200JSONResponse Job
 
-func (response AssetCommentList200JSONResponse) VisitAssetCommentListResponse(w http.ResponseWriter) error {
+func (response JobCancel200JSONResponse) VisitJobCancelResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -2918,43 +4375,42 @@ func (response AssetCommentList200JSONResponse) VisitAssetCommentListResponse(w
 	return err
 }
 
-type AssetCommentList400Response struct {
+type JobCancel400Response struct {
 }
 
-func (response AssetCommentList400Response) VisitAssetCommentListResponse(w http.ResponseWriter) error {
+func (response JobCancel400Response) VisitJobCancelResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type AssetCommentList401Response = UnauthorizedErrorResponse
+type JobCancel401Response = UnauthorizedErrorResponse
 
-func (response AssetCommentList401Response) VisitAssetCommentListResponse(w http.ResponseWriter) error {
+func (response JobCancel401Response) VisitJobCancelResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AssetCommentList404Response struct {
+type JobCancel404Response struct {
 }
 
-func (response AssetCommentList404Response) VisitAssetCommentListResponse(w http.ResponseWriter) error {
+func (response JobCancel404Response) VisitJobCancelResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type AssetCommentCreateRequestObject struct {
+type JobGetRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	AssetId            AssetIdParam            `json:"assetId"`
-	Body               *AssetCommentCreateJSONRequestBody
+	JobId              JobIdParam              `json:"jobId"`
 }
 
-type AssetCommentCreateResponseObject interface {
-	VisitAssetCommentCreateResponse(w http.ResponseWriter) error
+type JobGetResponseObject interface {
+	VisitJobGetResponse(w http.ResponseWriter) error
 }
 
-type AssetCommentCreate200JSONResponse Comment
+type JobGet200JSONResponse Job
 
-func (response AssetCommentCreate200JSONResponse) VisitAssetCommentCreateResponse(w http.ResponseWriter) error {
+func (response JobGet200JSONResponse) VisitJobGetResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -2966,45 +4422,47 @@ func (response AssetCommentCreate200JSONResponse) VisitAssetCommentCreateRespons
 	return err
 }
 
-type AssetCommentCreate400Response struct {
+type JobGet400Response struct {
 }
 
-func (response AssetCommentCreate400Response) VisitAssetCommentCreateResponse(w http.ResponseWriter) error {
+func (response JobGet400Response) VisitJobGetResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type AssetCommentCreate401Response = UnauthorizedErrorResponse
+type JobGet401Response = UnauthorizedErrorResponse
 
-func (response AssetCommentCreate401Response) VisitAssetCommentCreateResponse(w http.ResponseWriter) error {
+func (response JobGet401Response) VisitJobGetResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AssetCommentCreate404Response struct {
+type JobGet404Response struct {
 }
 
-func (response AssetCommentCreate404Response) VisitAssetCommentCreateResponse(w http.ResponseWriter) error {
+func (response JobGet404Response) VisitJobGetResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type AssetCommentDeleteRequestObject struct {
+type ModelFilterRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	AssetId            AssetIdParam            `json:"assetId"`
-	CommentId          CommentIdParam          `json:"commentId"`
+	Params             ModelFilterParams
 }
 
-type AssetCommentDeleteResponseObject interface {
-	VisitAssetCommentDeleteResponse(w http.ResponseWriter) error
+type ModelFilterResponseObject interface {
+	VisitModelFilterResponse(w http.ResponseWriter) error
 }
 
-type AssetCommentDelete200JSONResponse struct {
-	Id *id.CommentID `json:"id,omitempty"`
+type ModelFilter200JSONResponse struct {
+	Models     *[]Model `json:"models,omitempty"`
+	Page       *int     `json:"page,omitempty"`
+	PerPage    *int     `json:"perPage,omitempty"`
+	TotalCount *int     `json:"totalCount,omitempty"`
 }
 
-func (response AssetCommentDelete200JSONResponse) VisitAssetCommentDeleteResponse(w http.ResponseWriter) error {
+func (response ModelFilter200JSONResponse) VisitModelFilterResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3016,44 +4474,51 @@ func (response AssetCommentDelete200JSONResponse) VisitAssetCommentDeleteRespons
 	return err
 }
 
-type AssetCommentDelete400Response struct {
+type ModelFilter400Response struct {
 }
 
-func (response AssetCommentDelete400Response) VisitAssetCommentDeleteResponse(w http.ResponseWriter) error {
+func (response ModelFilter400Response) VisitModelFilterResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type AssetCommentDelete401Response = UnauthorizedErrorResponse
+type ModelFilter401Response = UnauthorizedErrorResponse
 
-func (response AssetCommentDelete401Response) VisitAssetCommentDeleteResponse(w http.ResponseWriter) error {
+func (response ModelFilter401Response) VisitModelFilterResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AssetCommentDelete404Response struct {
+type ModelFilter404Response struct {
 }
 
-func (response AssetCommentDelete404Response) VisitAssetCommentDeleteResponse(w http.ResponseWriter) error {
+func (response ModelFilter404Response) VisitModelFilterResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type AssetCommentUpdateRequestObject struct {
+type ModelFilter500Response struct {
+}
+
+func (response ModelFilter500Response) VisitModelFilterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ModelCreateRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	AssetId            AssetIdParam            `json:"assetId"`
-	CommentId          CommentIdParam          `json:"commentId"`
-	Body               *AssetCommentUpdateJSONRequestBody
+	Params             ModelCreateParams
+	Body               *ModelCreateJSONRequestBody
 }
 
-type AssetCommentUpdateResponseObject interface {
-	VisitAssetCommentUpdateResponse(w http.ResponseWriter) error
+type ModelCreateResponseObject interface {
+	VisitModelCreateResponse(w http.ResponseWriter) error
 }
 
-type AssetCommentUpdate200JSONResponse Comment
+type ModelCreate200JSONResponse Model
 
-func (response AssetCommentUpdate200JSONResponse) VisitAssetCommentUpdateResponse(w http.ResponseWriter) error {
+func (response ModelCreate200JSONResponse) VisitModelCreateResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3065,42 +4530,36 @@ func (response AssetCommentUpdate200JSONResponse) VisitAssetCommentUpdateRespons
 	return err
 }
 
-type AssetCommentUpdate400Response struct {
+type ModelCreate400Response struct {
 }
 
-func (response AssetCommentUpdate400Response) VisitAssetCommentUpdateResponse(w http.ResponseWriter) error {
+func (response ModelCreate400Response) VisitModelCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type AssetCommentUpdate401Response = UnauthorizedErrorResponse
+type ModelCreate401Response = UnauthorizedErrorResponse
 
-func (response AssetCommentUpdate401Response) VisitAssetCommentUpdateResponse(w http.ResponseWriter) error {
+func (response ModelCreate401Response) VisitModelCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AssetCommentUpdate404Response struct {
-}
-
-func (response AssetCommentUpdate404Response) VisitAssetCommentUpdateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
-
-type AssetPublishRequestObject struct {
+type ModelDeleteRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	AssetId            AssetIdParam            `json:"assetId"`
+	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
 }
 
-type AssetPublishResponseObject interface {
-	VisitAssetPublishResponse(w http.ResponseWriter) error
+type ModelDeleteResponseObject interface {
+	VisitModelDeleteResponse(w http.ResponseWriter) error
 }
 
-type AssetPublish200JSONResponse Asset
+type ModelDelete200JSONResponse struct {
+	Id id.ModelID `json:"id"`
+}
 
-func (response AssetPublish200JSONResponse) VisitAssetPublishResponse(w http.ResponseWriter) error {
+func (response ModelDelete200JSONResponse) VisitModelDeleteResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3112,42 +4571,50 @@ func (response AssetPublish200JSONResponse) VisitAssetPublishResponse(w http.Res
 	return err
 }
 
-type AssetPublish400Response struct {
+type ModelDelete400Response struct {
 }
 
-func (response AssetPublish400Response) VisitAssetPublishResponse(w http.ResponseWriter) error {
+func (response ModelDelete400Response) VisitModelDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type AssetPublish401Response = UnauthorizedErrorResponse
+type ModelDelete401Response = UnauthorizedErrorResponse
 
-func (response AssetPublish401Response) VisitAssetPublishResponse(w http.ResponseWriter) error {
+func (response ModelDelete401Response) VisitModelDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AssetPublish404Response struct {
+type ModelDelete404Response struct {
 }
 
-func (response AssetPublish404Response) VisitAssetPublishResponse(w http.ResponseWriter) error {
+func (response ModelDelete404Response) VisitModelDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type AssetUnpublishRequestObject struct {
+type ModelDelete500Response struct {
+}
+
+func (response ModelDelete500Response) VisitModelDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ModelGetRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	AssetId            AssetIdParam            `json:"assetId"`
+	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
 }
 
-type AssetUnpublishResponseObject interface {
-	VisitAssetUnpublishResponse(w http.ResponseWriter) error
+type ModelGetResponseObject interface {
+	VisitModelGetResponse(w http.ResponseWriter) error
 }
 
-type AssetUnpublish200JSONResponse Asset
+type ModelGet200JSONResponse Model
 
-func (response AssetUnpublish200JSONResponse) VisitAssetUnpublishResponse(w http.ResponseWriter) error {
+func (response ModelGet200JSONResponse) VisitModelGetResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3159,102 +4626,107 @@ func (response AssetUnpublish200JSONResponse) VisitAssetUnpublishResponse(w http
 	return err
 }
 
-type AssetUnpublish400Response struct {
+type ModelGet400Response struct {
 }
 
-func (response AssetUnpublish400Response) VisitAssetUnpublishResponse(w http.ResponseWriter) error {
+func (response ModelGet400Response) VisitModelGetResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type AssetUnpublish401Response = UnauthorizedErrorResponse
+type ModelGet401Response = UnauthorizedErrorResponse
 
-func (response AssetUnpublish401Response) VisitAssetUnpublishResponse(w http.ResponseWriter) error {
+func (response ModelGet401Response) VisitModelGetResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AssetUnpublish404Response struct {
+type ModelGet404Response struct {
+}
+
+func (response ModelGet404Response) VisitModelGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ModelGet500Response struct {
 }
 
-func (response AssetUnpublish404Response) VisitAssetUnpublishResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
+func (response ModelGet500Response) VisitModelGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
 	return nil
 }
 
-type AssetContentGetRequestObject struct {
+type ModelUpdateRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	Uuid1              string                  `json:"uuid1"`
-	Uuid2              string                  `json:"uuid2"`
-	Filename           string                  `json:"filename"`
+	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
+	Body               *ModelUpdateJSONRequestBody
 }
 
-type AssetContentGetResponseObject interface {
-	VisitAssetContentGetResponse(w http.ResponseWriter) error
+type ModelUpdateResponseObject interface {
+	VisitModelUpdateResponse(w http.ResponseWriter) error
 }
 
-type AssetContentGet200ApplicationoctetStreamResponse struct {
-	Body          io.Reader
-	ContentLength int64
-}
+type ModelUpdate200JSONResponse Model
 
-func (response AssetContentGet200ApplicationoctetStreamResponse) VisitAssetContentGetResponse(w http.ResponseWriter) error {
+func (response ModelUpdate200JSONResponse) VisitModelUpdateResponse(w http.ResponseWriter) error {
 
-	w.Header().Set("Content-Type", "application/octet-stream")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
-	}
-	_, err := io.Copy(w, response.Body)
+	_, err := buf.WriteTo(w)
 	return err
 }
 
-type AssetContentGet400Response struct {
+type ModelUpdate400Response struct {
 }
 
-func (response AssetContentGet400Response) VisitAssetContentGetResponse(w http.ResponseWriter) error {
+func (response ModelUpdate400Response) VisitModelUpdateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type AssetContentGet401Response = UnauthorizedErrorResponse
+type ModelUpdate401Response = UnauthorizedErrorResponse
 
-func (response AssetContentGet401Response) VisitAssetContentGetResponse(w http.ResponseWriter) error {
+func (response ModelUpdate401Response) VisitModelUpdateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type AssetContentGet404Response struct {
+type ModelUpdate404Response struct {
 }
 
-func (response AssetContentGet404Response) VisitAssetContentGetResponse(w http.ResponseWriter) error {
+func (response ModelUpdate404Response) VisitModelUpdateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type GroupFilterRequestObject struct {
+type ModelUpdate500Response struct {
+}
+
+func (response ModelUpdate500Response) VisitModelUpdateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ModelCopyRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	Params             GroupFilterParams
+	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
+	Body               *ModelCopyJSONRequestBody
 }
 
-type GroupFilterResponseObject interface {
-	VisitGroupFilterResponse(w http.ResponseWriter) error
+type ModelCopyResponseObject interface {
+	VisitModelCopyResponse(w http.ResponseWriter) error
 }
 
-type GroupFilter200JSONResponse struct {
-	Groups     *[]Group `json:"groups,omitempty"`
-	Page       *int     `json:"page,omitempty"`
-	PerPage    *int     `json:"perPage,omitempty"`
-	TotalCount *int     `json:"totalCount,omitempty"`
-}
+type ModelCopy200JSONResponse Model
 
-func (response GroupFilter200JSONResponse) VisitGroupFilterResponse(w http.ResponseWriter) error {
+func (response ModelCopy200JSONResponse) VisitModelCopyResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3266,100 +4738,119 @@ func (response GroupFilter200JSONResponse) VisitGroupFilterResponse(w http.Respo
 	return err
 }
 
-type GroupFilter400Response struct {
+type ModelCopy400Response struct {
 }
 
-func (response GroupFilter400Response) VisitGroupFilterResponse(w http.ResponseWriter) error {
+func (response ModelCopy400Response) VisitModelCopyResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type GroupFilter401Response = UnauthorizedErrorResponse
+type ModelCopy401Response = UnauthorizedErrorResponse
 
-func (response GroupFilter401Response) VisitGroupFilterResponse(w http.ResponseWriter) error {
+func (response ModelCopy401Response) VisitModelCopyResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GroupFilter404Response struct {
-}
+type ModelCopy404Response = NotFoundErrorResponse
 
-func (response GroupFilter404Response) VisitGroupFilterResponse(w http.ResponseWriter) error {
+func (response ModelCopy404Response) VisitModelCopyResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type GroupFilter500Response struct {
+type ModelCopy500Response struct {
 }
 
-func (response GroupFilter500Response) VisitGroupFilterResponse(w http.ResponseWriter) error {
+func (response ModelCopy500Response) VisitModelCopyResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type GroupCreateRequestObject struct {
+type ModelImportRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	Params             GroupCreateParams
-	Body               *GroupCreateJSONRequestBody
+	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
+	JSONBody           *ModelImportJSONRequestBody
+	MultipartBody      *multipart.Reader
 }
 
-type GroupCreateResponseObject interface {
-	VisitGroupCreateResponse(w http.ResponseWriter) error
+type ModelImportResponseObject interface {
+	VisitModelImportResponse(w http.ResponseWriter) error
 }
 
-type GroupCreate201JSONResponse Group
+type ModelImport200JSONResponse struct {
+	IgnoredCount  *int           `json:"ignoredCount,omitempty"`
+	InsertedCount *int           `json:"insertedCount,omitempty"`
+	ItemsCount    *int           `json:"itemsCount,omitempty"`
+	ModelId       *id.ModelID    `json:"modelId,omitempty"`
+	NewFields     *[]SchemaField `json:"newFields,omitempty"`
+	UpdatedCount  *int           `json:"updatedCount,omitempty"`
+}
 
-func (response GroupCreate201JSONResponse) VisitGroupCreateResponse(w http.ResponseWriter) error {
+func (response ModelImport200JSONResponse) VisitModelImportResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type GroupCreate400Response struct {
+type ModelImport400Response struct {
 }
 
-func (response GroupCreate400Response) VisitGroupCreateResponse(w http.ResponseWriter) error {
+func (response ModelImport400Response) VisitModelImportResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type GroupCreate401Response = UnauthorizedErrorResponse
+type ModelImport401Response = UnauthorizedErrorResponse
 
-func (response GroupCreate401Response) VisitGroupCreateResponse(w http.ResponseWriter) error {
+func (response ModelImport401Response) VisitModelImportResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GroupCreate500Response struct {
+type ModelImport404Response struct {
 }
 
-func (response GroupCreate500Response) VisitGroupCreateResponse(w http.ResponseWriter) error {
+func (response ModelImport404Response) VisitModelImportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ModelImport500Response struct {
+}
+
+func (response ModelImport500Response) VisitModelImportResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type GroupDeleteRequestObject struct {
+type ItemFilterRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	GroupIdOrKey       GroupIdOrKeyParam       `json:"groupIdOrKey"`
+	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
+	Params             ItemFilterParams
 }
 
-type GroupDeleteResponseObject interface {
-	VisitGroupDeleteResponse(w http.ResponseWriter) error
+type ItemFilterResponseObject interface {
+	VisitItemFilterResponse(w http.ResponseWriter) error
 }
 
-type GroupDelete200JSONResponse struct {
-	Id *id.GroupID `json:"id,omitempty"`
+type ItemFilter200JSONResponse struct {
+	Items      *[]VersionedItem `json:"items,omitempty"`
+	Page       *int             `json:"page,omitempty"`
+	PerPage    *int             `json:"perPage,omitempty"`
+	TotalCount *int             `json:"totalCount,omitempty"`
 }
 
-func (response GroupDelete200JSONResponse) VisitGroupDeleteResponse(w http.ResponseWriter) error {
+func (response ItemFilter200JSONResponse) VisitItemFilterResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3371,50 +4862,51 @@ func (response GroupDelete200JSONResponse) VisitGroupDeleteResponse(w http.Respo
 	return err
 }
 
-type GroupDelete400Response struct {
+type ItemFilter400Response struct {
 }
 
-func (response GroupDelete400Response) VisitGroupDeleteResponse(w http.ResponseWriter) error {
+func (response ItemFilter400Response) VisitItemFilterResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type GroupDelete401Response = UnauthorizedErrorResponse
+type ItemFilter401Response = UnauthorizedErrorResponse
 
-func (response GroupDelete401Response) VisitGroupDeleteResponse(w http.ResponseWriter) error {
+func (response ItemFilter401Response) VisitItemFilterResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GroupDelete404Response struct {
+type ItemFilter404Response struct {
 }
 
-func (response GroupDelete404Response) VisitGroupDeleteResponse(w http.ResponseWriter) error {
+func (response ItemFilter404Response) VisitItemFilterResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type GroupDelete500Response struct {
+type ItemFilter500Response struct {
 }
 
-func (response GroupDelete500Response) VisitGroupDeleteResponse(w http.ResponseWriter) error {
+func (response ItemFilter500Response) VisitItemFilterResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type GroupGetRequestObject struct {
+type ItemCreateRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	GroupIdOrKey       GroupIdOrKeyParam       `json:"groupIdOrKey"`
+	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
+	Body               *ItemCreateJSONRequestBody
 }
 
-type GroupGetResponseObject interface {
-	VisitGroupGetResponse(w http.ResponseWriter) error
+type ItemCreateResponseObject interface {
+	VisitItemCreateResponse(w http.ResponseWriter) error
 }
 
-type GroupGet200JSONResponse Group
+type ItemCreate200JSONResponse VersionedItem
 
-func (response GroupGet200JSONResponse) VisitGroupGetResponse(w http.ResponseWriter) error {
+func (response ItemCreate200JSONResponse) VisitItemCreateResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3426,208 +4918,221 @@ func (response GroupGet200JSONResponse) VisitGroupGetResponse(w http.ResponseWri
 	return err
 }
 
-type GroupGet400Response struct {
+type ItemCreate400Response struct {
 }
 
-func (response GroupGet400Response) VisitGroupGetResponse(w http.ResponseWriter) error {
+func (response ItemCreate400Response) VisitItemCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type GroupGet401Response = UnauthorizedErrorResponse
+type ItemCreate401Response = UnauthorizedErrorResponse
 
-func (response GroupGet401Response) VisitGroupGetResponse(w http.ResponseWriter) error {
+func (response ItemCreate401Response) VisitItemCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GroupGet404Response struct {
+type ItemCreate404Response struct {
 }
 
-func (response GroupGet404Response) VisitGroupGetResponse(w http.ResponseWriter) error {
+func (response ItemCreate404Response) VisitItemCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type GroupGet500Response struct {
-}
-
-func (response GroupGet500Response) VisitGroupGetResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
-
-type GroupUpdateRequestObject struct {
+type ItemsAsCSVRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	GroupIdOrKey       GroupIdOrKeyParam       `json:"groupIdOrKey"`
-	Body               *GroupUpdateJSONRequestBody
+	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
+	Params             ItemsAsCSVParams
 }
 
-type GroupUpdateResponseObject interface {
-	VisitGroupUpdateResponse(w http.ResponseWriter) error
+type ItemsAsCSVResponseObject interface {
+	VisitItemsAsCSVResponse(w http.ResponseWriter) error
 }
 
-type GroupUpdate200JSONResponse Group
+type ItemsAsCSV200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
 
-func (response GroupUpdate200JSONResponse) VisitGroupUpdateResponse(w http.ResponseWriter) error {
+func (response ItemsAsCSV200TextcsvResponse) VisitItemsAsCSVResponse(w http.ResponseWriter) error {
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
-	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
 	return err
 }
 
-type GroupUpdate400Response struct {
+type ItemsAsCSV400Response struct {
 }
 
-func (response GroupUpdate400Response) VisitGroupUpdateResponse(w http.ResponseWriter) error {
+func (response ItemsAsCSV400Response) VisitItemsAsCSVResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type GroupUpdate401Response = UnauthorizedErrorResponse
+type ItemsAsCSV401Response = UnauthorizedErrorResponse
 
-func (response GroupUpdate401Response) VisitGroupUpdateResponse(w http.ResponseWriter) error {
+func (response ItemsAsCSV401Response) VisitItemsAsCSVResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GroupUpdate404Response struct {
+type ItemsAsCSV404Response struct {
 }
 
-func (response GroupUpdate404Response) VisitGroupUpdateResponse(w http.ResponseWriter) error {
+func (response ItemsAsCSV404Response) VisitItemsAsCSVResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type GroupUpdate500Response struct {
+type ItemsAsCSV500Response struct {
 }
 
-func (response GroupUpdate500Response) VisitGroupUpdateResponse(w http.ResponseWriter) error {
+func (response ItemsAsCSV500Response) VisitItemsAsCSVResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type ModelFilterRequestObject struct {
+type ItemsAsGeoJSONRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	Params             ModelFilterParams
+	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
+	Params             ItemsAsGeoJSONParams
 }
 
-type ModelFilterResponseObject interface {
-	VisitModelFilterResponse(w http.ResponseWriter) error
+type ItemsAsGeoJSONResponseObject interface {
+	VisitItemsAsGeoJSONResponse(w http.ResponseWriter) error
 }
 
-type ModelFilter200JSONResponse struct {
-	Models     *[]Model `json:"models,omitempty"`
-	Page       *int     `json:"page,omitempty"`
-	PerPage    *int     `json:"perPage,omitempty"`
-	TotalCount *int     `json:"totalCount,omitempty"`
+type ItemsAsGeoJSON200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
 }
 
-func (response ModelFilter200JSONResponse) VisitModelFilterResponse(w http.ResponseWriter) error {
+func (response ItemsAsGeoJSON200ApplicationoctetStreamResponse) VisitItemsAsGeoJSONResponse(w http.ResponseWriter) error {
 
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(response); err != nil {
-		return err
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
-	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	_, err := buf.WriteTo(w)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
 	return err
 }
 
-type ModelFilter400Response struct {
+type ItemsAsGeoJSON400Response struct {
 }
 
-func (response ModelFilter400Response) VisitModelFilterResponse(w http.ResponseWriter) error {
+func (response ItemsAsGeoJSON400Response) VisitItemsAsGeoJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ModelFilter401Response = UnauthorizedErrorResponse
+type ItemsAsGeoJSON401Response = UnauthorizedErrorResponse
 
-func (response ModelFilter401Response) VisitModelFilterResponse(w http.ResponseWriter) error {
+func (response ItemsAsGeoJSON401Response) VisitItemsAsGeoJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ModelFilter404Response struct {
+type ItemsAsGeoJSON404Response struct {
 }
 
-func (response ModelFilter404Response) VisitModelFilterResponse(w http.ResponseWriter) error {
+func (response ItemsAsGeoJSON404Response) VisitItemsAsGeoJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ModelFilter500Response struct {
+type ItemsAsGeoJSON500Response struct {
 }
 
-func (response ModelFilter500Response) VisitModelFilterResponse(w http.ResponseWriter) error {
+func (response ItemsAsGeoJSON500Response) VisitItemsAsGeoJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type ModelCreateRequestObject struct {
+type ItemBulkUpdateRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	Params             ModelCreateParams
-	Body               *ModelCreateJSONRequestBody
+	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
+	Body               *ItemBulkUpdateJSONRequestBody
 }
 
-type ModelCreateResponseObject interface {
-	VisitModelCreateResponse(w http.ResponseWriter) error
+type ItemBulkUpdateResponseObject interface {
+	VisitItemBulkUpdateResponse(w http.ResponseWriter) error
 }
 
-type ModelCreate200JSONResponse Model
+type ItemBulkUpdate202JSONResponse Job
 
-func (response ModelCreate200JSONResponse) VisitModelCreateResponse(w http.ResponseWriter) error {
+func (response ItemBulkUpdate202JSONResponse) VisitItemBulkUpdateResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
 		return err
 	}
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(202)
 	_, err := buf.WriteTo(w)
 	return err
 }
 
-type ModelCreate400Response struct {
+type ItemBulkUpdate400Response struct {
 }
 
-func (response ModelCreate400Response) VisitModelCreateResponse(w http.ResponseWriter) error {
+func (response ItemBulkUpdate400Response) VisitItemBulkUpdateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ModelCreate401Response = UnauthorizedErrorResponse
+type ItemBulkUpdate401Response = UnauthorizedErrorResponse
 
-func (response ModelCreate401Response) VisitModelCreateResponse(w http.ResponseWriter) error {
+func (response ItemBulkUpdate401Response) VisitItemBulkUpdateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ModelDeleteRequestObject struct {
+type ItemBulkUpdate404Response struct {
+}
+
+func (response ItemBulkUpdate404Response) VisitItemBulkUpdateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ItemFilterPostRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
 	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
+	Params             ItemFilterPostParams
+	Body               *ItemFilterPostJSONRequestBody
 }
 
-type ModelDeleteResponseObject interface {
-	VisitModelDeleteResponse(w http.ResponseWriter) error
+type ItemFilterPostResponseObject interface {
+	VisitItemFilterPostResponse(w http.ResponseWriter) error
 }
 
-type ModelDelete200JSONResponse struct {
-	Id id.ModelID `json:"id"`
+type ItemFilterPost200JSONResponse struct {
+	Items      *[]VersionedItem `json:"items,omitempty"`
+	Page       *int             `json:"page,omitempty"`
+	PerPage    *int             `json:"perPage,omitempty"`
+	TotalCount *int             `json:"totalCount,omitempty"`
 }
 
-func (response ModelDelete200JSONResponse) VisitModelDeleteResponse(w http.ResponseWriter) error {
+func (response ItemFilterPost200JSONResponse) VisitItemFilterPostResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3639,50 +5144,56 @@ func (response ModelDelete200JSONResponse) VisitModelDeleteResponse(w http.Respo
 	return err
 }
 
-type ModelDelete400Response struct {
+type ItemFilterPost400Response struct {
 }
 
-func (response ModelDelete400Response) VisitModelDeleteResponse(w http.ResponseWriter) error {
+func (response ItemFilterPost400Response) VisitItemFilterPostResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ModelDelete401Response = UnauthorizedErrorResponse
+type ItemFilterPost401Response = UnauthorizedErrorResponse
 
-func (response ModelDelete401Response) VisitModelDeleteResponse(w http.ResponseWriter) error {
+func (response ItemFilterPost401Response) VisitItemFilterPostResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ModelDelete404Response struct {
+type ItemFilterPost404Response struct {
 }
 
-func (response ModelDelete404Response) VisitModelDeleteResponse(w http.ResponseWriter) error {
+func (response ItemFilterPost404Response) VisitItemFilterPostResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ModelDelete500Response struct {
+type ItemFilterPost500Response struct {
 }
 
-func (response ModelDelete500Response) VisitModelDeleteResponse(w http.ResponseWriter) error {
+func (response ItemFilterPost500Response) VisitItemFilterPostResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type ModelGetRequestObject struct {
+type ItemTrashListRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
 	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
+	Params             ItemTrashListParams
 }
 
-type ModelGetResponseObject interface {
-	VisitModelGetResponse(w http.ResponseWriter) error
+type ItemTrashListResponseObject interface {
+	VisitItemTrashListResponse(w http.ResponseWriter) error
 }
 
-type ModelGet200JSONResponse Model
+type ItemTrashList200JSONResponse struct {
+	Items      *[]TrashedItem `json:"items,omitempty"`
+	Page       *int           `json:"page,omitempty"`
+	PerPage    *int           `json:"perPage,omitempty"`
+	TotalCount *int           `json:"totalCount,omitempty"`
+}
 
-func (response ModelGet200JSONResponse) VisitModelGetResponse(w http.ResponseWriter) error {
+func (response ItemTrashList200JSONResponse) VisitItemTrashListResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3694,51 +5205,45 @@ func (response ModelGet200JSONResponse) VisitModelGetResponse(w http.ResponseWri
 	return err
 }
 
-type ModelGet400Response struct {
+type ItemTrashList400Response struct {
 }
 
-func (response ModelGet400Response) VisitModelGetResponse(w http.ResponseWriter) error {
+func (response ItemTrashList400Response) VisitItemTrashListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ModelGet401Response = UnauthorizedErrorResponse
+type ItemTrashList401Response = UnauthorizedErrorResponse
 
-func (response ModelGet401Response) VisitModelGetResponse(w http.ResponseWriter) error {
+func (response ItemTrashList401Response) VisitItemTrashListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ModelGet404Response struct {
+type ItemTrashList404Response struct {
 }
 
-func (response ModelGet404Response) VisitModelGetResponse(w http.ResponseWriter) error {
+func (response ItemTrashList404Response) VisitItemTrashListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ModelGet500Response struct {
-}
-
-func (response ModelGet500Response) VisitModelGetResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
-
-type ModelUpdateRequestObject struct {
+type ItemTrashPurgeRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
 	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
-	Body               *ModelUpdateJSONRequestBody
+	Body               *ItemTrashPurgeJSONRequestBody
 }
 
-type ModelUpdateResponseObject interface {
-	VisitModelUpdateResponse(w http.ResponseWriter) error
+type ItemTrashPurgeResponseObject interface {
+	VisitItemTrashPurgeResponse(w http.ResponseWriter) error
 }
 
-type ModelUpdate200JSONResponse Model
+type ItemTrashPurge200JSONResponse struct {
+	ItemIds *[]id.ItemID `json:"itemIds,omitempty"`
+}
 
-func (response ModelUpdate200JSONResponse) VisitModelUpdateResponse(w http.ResponseWriter) error {
+func (response ItemTrashPurge200JSONResponse) VisitItemTrashPurgeResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3750,51 +5255,45 @@ func (response ModelUpdate200JSONResponse) VisitModelUpdateResponse(w http.Respo
 	return err
 }
 
-type ModelUpdate400Response struct {
+type ItemTrashPurge400Response struct {
 }
 
-func (response ModelUpdate400Response) VisitModelUpdateResponse(w http.ResponseWriter) error {
+func (response ItemTrashPurge400Response) VisitItemTrashPurgeResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ModelUpdate401Response = UnauthorizedErrorResponse
+type ItemTrashPurge401Response = UnauthorizedErrorResponse
 
-func (response ModelUpdate401Response) VisitModelUpdateResponse(w http.ResponseWriter) error {
+func (response ItemTrashPurge401Response) VisitItemTrashPurgeResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ModelUpdate404Response struct {
+type ItemTrashPurge404Response struct {
 }
 
-func (response ModelUpdate404Response) VisitModelUpdateResponse(w http.ResponseWriter) error {
+func (response ItemTrashPurge404Response) VisitItemTrashPurgeResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ModelUpdate500Response struct {
-}
-
-func (response ModelUpdate500Response) VisitModelUpdateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
-
-type ModelCopyRequestObject struct {
+type ItemTrashRestoreRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
 	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
-	Body               *ModelCopyJSONRequestBody
+	Body               *ItemTrashRestoreJSONRequestBody
 }
 
-type ModelCopyResponseObject interface {
-	VisitModelCopyResponse(w http.ResponseWriter) error
+type ItemTrashRestoreResponseObject interface {
+	VisitItemTrashRestoreResponse(w http.ResponseWriter) error
 }
 
-type ModelCopy200JSONResponse Model
+type ItemTrashRestore200JSONResponse struct {
+	Items *[]VersionedItem `json:"items,omitempty"`
+}
 
-func (response ModelCopy200JSONResponse) VisitModelCopyResponse(w http.ResponseWriter) error {
+func (response ItemTrashRestore200JSONResponse) VisitItemTrashRestoreResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3806,58 +5305,45 @@ func (response ModelCopy200JSONResponse) VisitModelCopyResponse(w http.ResponseW
 	return err
 }
 
-type ModelCopy400Response struct {
+type ItemTrashRestore400Response struct {
 }
 
-func (response ModelCopy400Response) VisitModelCopyResponse(w http.ResponseWriter) error {
+func (response ItemTrashRestore400Response) VisitItemTrashRestoreResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ModelCopy401Response = UnauthorizedErrorResponse
+type ItemTrashRestore401Response = UnauthorizedErrorResponse
 
-func (response ModelCopy401Response) VisitModelCopyResponse(w http.ResponseWriter) error {
+func (response ItemTrashRestore401Response) VisitItemTrashRestoreResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ModelCopy404Response = NotFoundErrorResponse
-
-func (response ModelCopy404Response) VisitModelCopyResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
-
-type ModelCopy500Response struct {
+type ItemTrashRestore404Response struct {
 }
 
-func (response ModelCopy500Response) VisitModelCopyResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
+func (response ItemTrashRestore404Response) VisitItemTrashRestoreResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
 	return nil
 }
 
-type ModelImportRequestObject struct {
+type ItemDeleteRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
 	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
-	JSONBody           *ModelImportJSONRequestBody
-	MultipartBody      *multipart.Reader
+	ItemId             ItemIdParam             `json:"itemId"`
 }
 
-type ModelImportResponseObject interface {
-	VisitModelImportResponse(w http.ResponseWriter) error
+type ItemDeleteResponseObject interface {
+	VisitItemDeleteResponse(w http.ResponseWriter) error
 }
 
-type ModelImport200JSONResponse struct {
-	IgnoredCount  *int           `json:"ignoredCount,omitempty"`
-	InsertedCount *int           `json:"insertedCount,omitempty"`
-	ItemsCount    *int           `json:"itemsCount,omitempty"`
-	ModelId       *id.ModelID    `json:"modelId,omitempty"`
-	NewFields     *[]SchemaField `json:"newFields,omitempty"`
-	UpdatedCount  *int           `json:"updatedCount,omitempty"`
+type ItemDelete200JSONResponse struct {
+	Id *id.ItemID `json:"id,omitempty"`
 }
 
-func (response ModelImport200JSONResponse) VisitModelImportResponse(w http.ResponseWriter) error {
+func (response ItemDelete200JSONResponse) VisitItemDeleteResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3869,56 +5355,44 @@ func (response ModelImport200JSONResponse) VisitModelImportResponse(w http.Respo
 	return err
 }
 
-type ModelImport400Response struct {
+type ItemDelete400Response struct {
 }
 
-func (response ModelImport400Response) VisitModelImportResponse(w http.ResponseWriter) error {
+func (response ItemDelete400Response) VisitItemDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ModelImport401Response = UnauthorizedErrorResponse
+type ItemDelete401Response = UnauthorizedErrorResponse
 
-func (response ModelImport401Response) VisitModelImportResponse(w http.ResponseWriter) error {
+func (response ItemDelete401Response) VisitItemDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ModelImport404Response struct {
+type ItemDelete404Response struct {
 }
 
-func (response ModelImport404Response) VisitModelImportResponse(w http.ResponseWriter) error {
+func (response ItemDelete404Response) VisitItemDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ModelImport500Response struct {
-}
-
-func (response ModelImport500Response) VisitModelImportResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
-
-type ItemFilterRequestObject struct {
+type ItemGetRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
 	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
-	Params             ItemFilterParams
+	ItemId             ItemIdParam             `json:"itemId"`
+	Params             ItemGetParams
 }
 
-type ItemFilterResponseObject interface {
-	VisitItemFilterResponse(w http.ResponseWriter) error
+type ItemGetResponseObject interface {
+	VisitItemGetResponse(w http.ResponseWriter) error
 }
 
-type ItemFilter200JSONResponse struct {
-	Items      *[]VersionedItem `json:"items,omitempty"`
-	Page       *int             `json:"page,omitempty"`
-	PerPage    *int             `json:"perPage,omitempty"`
-	TotalCount *int             `json:"totalCount,omitempty"`
-}
+type ItemGet200JSONResponse VersionedItem
 
-func (response ItemFilter200JSONResponse) VisitItemFilterResponse(w http.ResponseWriter) error {
+func (response ItemGet200JSONResponse) VisitItemGetResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3930,51 +5404,52 @@ func (response ItemFilter200JSONResponse) VisitItemFilterResponse(w http.Respons
 	return err
 }
 
-type ItemFilter400Response struct {
+type ItemGet400Response struct {
 }
 
-func (response ItemFilter400Response) VisitItemFilterResponse(w http.ResponseWriter) error {
+func (response ItemGet400Response) VisitItemGetResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ItemFilter401Response = UnauthorizedErrorResponse
+type ItemGet401Response = UnauthorizedErrorResponse
 
-func (response ItemFilter401Response) VisitItemFilterResponse(w http.ResponseWriter) error {
+func (response ItemGet401Response) VisitItemGetResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ItemFilter404Response struct {
+type ItemGet404Response struct {
 }
 
-func (response ItemFilter404Response) VisitItemFilterResponse(w http.ResponseWriter) error {
+func (response ItemGet404Response) VisitItemGetResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ItemFilter500Response struct {
+type ItemGet500Response struct {
 }
 
-func (response ItemFilter500Response) VisitItemFilterResponse(w http.ResponseWriter) error {
+func (response ItemGet500Response) VisitItemGetResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type ItemCreateRequestObject struct {
+type ItemUpdateRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
 	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
-	Body               *ItemCreateJSONRequestBody
+	ItemId             ItemIdParam             `json:"itemId"`
+	Body               *ItemUpdateJSONRequestBody
 }
 
-type ItemCreateResponseObject interface {
-	VisitItemCreateResponse(w http.ResponseWriter) error
+type ItemUpdateResponseObject interface {
+	VisitItemUpdateResponse(w http.ResponseWriter) error
 }
 
-type ItemCreate200JSONResponse VersionedItem
+type ItemUpdate200JSONResponse VersionedItem
 
-func (response ItemCreate200JSONResponse) VisitItemCreateResponse(w http.ResponseWriter) error {
+func (response ItemUpdate200JSONResponse) VisitItemUpdateResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -3986,173 +5461,153 @@ func (response ItemCreate200JSONResponse) VisitItemCreateResponse(w http.Respons
 	return err
 }
 
-type ItemCreate400Response struct {
+type ItemUpdate400Response struct {
 }
 
-func (response ItemCreate400Response) VisitItemCreateResponse(w http.ResponseWriter) error {
+func (response ItemUpdate400Response) VisitItemUpdateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ItemCreate401Response = UnauthorizedErrorResponse
+type ItemUpdate401Response = UnauthorizedErrorResponse
 
-func (response ItemCreate401Response) VisitItemCreateResponse(w http.ResponseWriter) error {
+func (response ItemUpdate401Response) VisitItemUpdateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ItemCreate404Response struct {
+type ItemUpdate404Response struct {
+}
+
+func (response ItemUpdate404Response) VisitItemUpdateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ItemUpdate500Response struct {
 }
 
-func (response ItemCreate404Response) VisitItemCreateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
+func (response ItemUpdate500Response) VisitItemUpdateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
 	return nil
 }
 
-type ItemsAsCSVRequestObject struct {
+type ItemCommentListRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
 	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
-	Params             ItemsAsCSVParams
+	ItemId             ItemIdParam             `json:"itemId"`
 }
 
-type ItemsAsCSVResponseObject interface {
-	VisitItemsAsCSVResponse(w http.ResponseWriter) error
+type ItemCommentListResponseObject interface {
+	VisitItemCommentListResponse(w http.ResponseWriter) error
 }
 
-type ItemsAsCSV200TextcsvResponse struct {
-	Body          io.Reader
-	ContentLength int64
+type ItemCommentList200JSONResponse struct {
+	Comments *[]Comment `json:"comments,omitempty"`
 }
 
-func (response ItemsAsCSV200TextcsvResponse) VisitItemsAsCSVResponse(w http.ResponseWriter) error {
+func (response ItemCommentList200JSONResponse) VisitItemCommentListResponse(w http.ResponseWriter) error {
 
-	w.Header().Set("Content-Type", "text/csv")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
-	}
-	_, err := io.Copy(w, response.Body)
+	_, err := buf.WriteTo(w)
 	return err
 }
 
-type ItemsAsCSV400Response struct {
+type ItemCommentList400Response struct {
 }
 
-func (response ItemsAsCSV400Response) VisitItemsAsCSVResponse(w http.ResponseWriter) error {
+func (response ItemCommentList400Response) VisitItemCommentListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ItemsAsCSV401Response = UnauthorizedErrorResponse
+type ItemCommentList401Response = UnauthorizedErrorResponse
 
-func (response ItemsAsCSV401Response) VisitItemsAsCSVResponse(w http.ResponseWriter) error {
+func (response ItemCommentList401Response) VisitItemCommentListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ItemsAsCSV404Response struct {
+type ItemCommentList404Response struct {
 }
 
-func (response ItemsAsCSV404Response) VisitItemsAsCSVResponse(w http.ResponseWriter) error {
+func (response ItemCommentList404Response) VisitItemCommentListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ItemsAsCSV500Response struct {
-}
-
-func (response ItemsAsCSV500Response) VisitItemsAsCSVResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
-
-type ItemsAsGeoJSONRequestObject struct {
+type ItemCommentCreateRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
 	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
-	Params             ItemsAsGeoJSONParams
+	ItemId             ItemIdParam             `json:"itemId"`
+	Body               *ItemCommentCreateJSONRequestBody
 }
 
-type ItemsAsGeoJSONResponseObject interface {
-	VisitItemsAsGeoJSONResponse(w http.ResponseWriter) error
+type ItemCommentCreateResponseObject interface {
+	VisitItemCommentCreateResponse(w http.ResponseWriter) error
 }
 
-type ItemsAsGeoJSON200ApplicationoctetStreamResponse struct {
-	Body          io.Reader
-	ContentLength int64
-}
+type ItemCommentCreate200JSONResponse Comment
 
-func (response ItemsAsGeoJSON200ApplicationoctetStreamResponse) VisitItemsAsGeoJSONResponse(w http.ResponseWriter) error {
+func (response ItemCommentCreate200JSONResponse) VisitItemCommentCreateResponse(w http.ResponseWriter) error {
 
-	w.Header().Set("Content-Type", "application/octet-stream")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
 	}
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
-	}
-	_, err := io.Copy(w, response.Body)
+	_, err := buf.WriteTo(w)
 	return err
 }
 
-type ItemsAsGeoJSON400Response struct {
+type ItemCommentCreate400Response struct {
 }
 
-func (response ItemsAsGeoJSON400Response) VisitItemsAsGeoJSONResponse(w http.ResponseWriter) error {
+func (response ItemCommentCreate400Response) VisitItemCommentCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ItemsAsGeoJSON401Response = UnauthorizedErrorResponse
+type ItemCommentCreate401Response = UnauthorizedErrorResponse
 
-func (response ItemsAsGeoJSON401Response) VisitItemsAsGeoJSONResponse(w http.ResponseWriter) error {
+func (response ItemCommentCreate401Response) VisitItemCommentCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ItemsAsGeoJSON404Response struct {
+type ItemCommentCreate404Response struct {
 }
 
-func (response ItemsAsGeoJSON404Response) VisitItemsAsGeoJSONResponse(w http.ResponseWriter) error {
+func (response ItemCommentCreate404Response) VisitItemCommentCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ItemsAsGeoJSON500Response struct {
-}
-
-func (response ItemsAsGeoJSON500Response) VisitItemsAsGeoJSONResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
-
-type ItemFilterPostRequestObject struct {
+type ItemCommentDeleteRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
 	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
-	Params             ItemFilterPostParams
-	Body               *ItemFilterPostJSONRequestBody
+	ItemId             ItemIdParam             `json:"itemId"`
+	CommentId          CommentIdParam          `json:"commentId"`
 }
 
-type ItemFilterPostResponseObject interface {
-	VisitItemFilterPostResponse(w http.ResponseWriter) error
+type ItemCommentDeleteResponseObject interface {
+	VisitItemCommentDeleteResponse(w http.ResponseWriter) error
 }
 
-type ItemFilterPost200JSONResponse struct {
-	Items      *[]VersionedItem `json:"items,omitempty"`
-	Page       *int             `json:"page,omitempty"`
-	PerPage    *int             `json:"perPage,omitempty"`
-	TotalCount *int             `json:"totalCount,omitempty"`
+type ItemCommentDelete200JSONResponse struct {
+	Id *id.CommentID `json:"id,omitempty"`
 }
 
-func (response ItemFilterPost200JSONResponse) VisitItemFilterPostResponse(w http.ResponseWriter) error {
+func (response ItemCommentDelete200JSONResponse) VisitItemCommentDeleteResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4164,53 +5619,45 @@ func (response ItemFilterPost200JSONResponse) VisitItemFilterPostResponse(w http
 	return err
 }
 
-type ItemFilterPost400Response struct {
+type ItemCommentDelete400Response struct {
 }
 
-func (response ItemFilterPost400Response) VisitItemFilterPostResponse(w http.ResponseWriter) error {
+func (response ItemCommentDelete400Response) VisitItemCommentDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ItemFilterPost401Response = UnauthorizedErrorResponse
+type ItemCommentDelete401Response = UnauthorizedErrorResponse
 
-func (response ItemFilterPost401Response) VisitItemFilterPostResponse(w http.ResponseWriter) error {
+func (response ItemCommentDelete401Response) VisitItemCommentDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ItemFilterPost404Response struct {
+type ItemCommentDelete404Response struct {
 }
 
-func (response ItemFilterPost404Response) VisitItemFilterPostResponse(w http.ResponseWriter) error {
+func (response ItemCommentDelete404Response) VisitItemCommentDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ItemFilterPost500Response struct {
-}
-
-func (response ItemFilterPost500Response) VisitItemFilterPostResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
-
-type ItemDeleteRequestObject struct {
+type ItemCommentUpdateRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
 	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
 	ItemId             ItemIdParam             `json:"itemId"`
+	CommentId          CommentIdParam          `json:"commentId"`
+	Body               *ItemCommentUpdateJSONRequestBody
 }
 
-type ItemDeleteResponseObject interface {
-	VisitItemDeleteResponse(w http.ResponseWriter) error
+type ItemCommentUpdateResponseObject interface {
+	VisitItemCommentUpdateResponse(w http.ResponseWriter) error
 }
 
-type ItemDelete200JSONResponse struct {
-	Id *id.ItemID `json:"id,omitempty"`
-}
+type ItemCommentUpdate200JSONResponse Comment
 
-func (response ItemDelete200JSONResponse) VisitItemDeleteResponse(w http.ResponseWriter) error {
+func (response ItemCommentUpdate200JSONResponse) VisitItemCommentUpdateResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4222,44 +5669,44 @@ func (response ItemDelete200JSONResponse) VisitItemDeleteResponse(w http.Respons
 	return err
 }
 
-type ItemDelete400Response struct {
+type ItemCommentUpdate400Response struct {
 }
 
-func (response ItemDelete400Response) VisitItemDeleteResponse(w http.ResponseWriter) error {
+func (response ItemCommentUpdate400Response) VisitItemCommentUpdateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ItemDelete401Response = UnauthorizedErrorResponse
+type ItemCommentUpdate401Response = UnauthorizedErrorResponse
 
-func (response ItemDelete401Response) VisitItemDeleteResponse(w http.ResponseWriter) error {
+func (response ItemCommentUpdate401Response) VisitItemCommentUpdateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ItemDelete404Response struct {
+type ItemCommentUpdate404Response struct {
 }
 
-func (response ItemDelete404Response) VisitItemDeleteResponse(w http.ResponseWriter) error {
+func (response ItemCommentUpdate404Response) VisitItemCommentUpdateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ItemGetRequestObject struct {
+type ItemDiffRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
 	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
 	ItemId             ItemIdParam             `json:"itemId"`
-	Params             ItemGetParams
+	Params             ItemDiffParams
 }
 
-type ItemGetResponseObject interface {
-	VisitItemGetResponse(w http.ResponseWriter) error
+type ItemDiffResponseObject interface {
+	VisitItemDiffResponse(w http.ResponseWriter) error
 }
 
-type ItemGet200JSONResponse VersionedItem
+type ItemDiff200JSONResponse ItemDiff
 
-func (response ItemGet200JSONResponse) VisitItemGetResponse(w http.ResponseWriter) error {
+func (response ItemDiff200JSONResponse) VisitItemDiffResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4271,52 +5718,44 @@ func (response ItemGet200JSONResponse) VisitItemGetResponse(w http.ResponseWrite
 	return err
 }
 
-type ItemGet400Response struct {
+type ItemDiff400Response struct {
 }
 
-func (response ItemGet400Response) VisitItemGetResponse(w http.ResponseWriter) error {
+func (response ItemDiff400Response) VisitItemDiffResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ItemGet401Response = UnauthorizedErrorResponse
+type ItemDiff401Response = UnauthorizedErrorResponse
 
-func (response ItemGet401Response) VisitItemGetResponse(w http.ResponseWriter) error {
+func (response ItemDiff401Response) VisitItemDiffResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ItemGet404Response struct {
+type ItemDiff404Response struct {
 }
 
-func (response ItemGet404Response) VisitItemGetResponse(w http.ResponseWriter) error {
+func (response ItemDiff404Response) VisitItemDiffResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ItemGet500Response struct {
-}
-
-func (response ItemGet500Response) VisitItemGetResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
-
-type ItemUpdateRequestObject struct {
+type ItemPublishRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
 	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
 	ItemId             ItemIdParam             `json:"itemId"`
-	Body               *ItemUpdateJSONRequestBody
+	Params             ItemPublishParams
 }
 
-type ItemUpdateResponseObject interface {
-	VisitItemUpdateResponse(w http.ResponseWriter) error
+type ItemPublishResponseObject interface {
+	VisitItemPublishResponse(w http.ResponseWriter) error
 }
 
-type ItemUpdate200JSONResponse VersionedItem
+type ItemPublish200JSONResponse VersionedItem
 
-func (response ItemUpdate200JSONResponse) VisitItemUpdateResponse(w http.ResponseWriter) error {
+func (response ItemPublish200JSONResponse) VisitItemPublishResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4328,53 +5767,47 @@ func (response ItemUpdate200JSONResponse) VisitItemUpdateResponse(w http.Respons
 	return err
 }
 
-type ItemUpdate400Response struct {
+type ItemPublish400Response struct {
 }
 
-func (response ItemUpdate400Response) VisitItemUpdateResponse(w http.ResponseWriter) error {
+func (response ItemPublish400Response) VisitItemPublishResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ItemUpdate401Response = UnauthorizedErrorResponse
+type ItemPublish401Response = UnauthorizedErrorResponse
 
-func (response ItemUpdate401Response) VisitItemUpdateResponse(w http.ResponseWriter) error {
+func (response ItemPublish401Response) VisitItemPublishResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ItemUpdate404Response struct {
+type ItemPublish404Response struct {
 }
 
-func (response ItemUpdate404Response) VisitItemUpdateResponse(w http.ResponseWriter) error {
+func (response ItemPublish404Response) VisitItemPublishResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ItemUpdate500Response struct {
-}
-
-func (response ItemUpdate500Response) VisitItemUpdateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
-
-type ItemCommentListRequestObject struct {
+type ItemRestoreRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
 	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
 	ItemId             ItemIdParam             `json:"itemId"`
+	Body               *ItemRestoreJSONRequestBody
 }
 
-type ItemCommentListResponseObject interface {
-	VisitItemCommentListResponse(w http.ResponseWriter) error
+type ItemRestoreResponseObject interface {
+	VisitItemRestoreResponse(w http.ResponseWriter) error
 }
 
-type ItemCommentList200JSONResponse struct {
-	Comments *[]Comment `json:"comments,omitempty"`
+type ItemRestore200JSONResponse struct {
+	DroppedFields *[]DroppedField `json:"droppedFields,omitempty"`
+	Item          *VersionedItem  `json:"item,omitempty"`
 }
 
-func (response ItemCommentList200JSONResponse) VisitItemCommentListResponse(w http.ResponseWriter) error {
+func (response ItemRestore200JSONResponse) VisitItemRestoreResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4386,44 +5819,42 @@ func (response ItemCommentList200JSONResponse) VisitItemCommentListResponse(w ht
 	return err
 }
 
-type ItemCommentList400Response struct {
+type ItemRestore400Response struct {
 }
 
-func (response ItemCommentList400Response) VisitItemCommentListResponse(w http.ResponseWriter) error {
+func (response ItemRestore400Response) VisitItemRestoreResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ItemCommentList401Response = UnauthorizedErrorResponse
+type ItemRestore401Response = UnauthorizedErrorResponse
 
-func (response ItemCommentList401Response) VisitItemCommentListResponse(w http.ResponseWriter) error {
+func (response ItemRestore401Response) VisitItemRestoreResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ItemCommentList404Response struct {
+type ItemRestore404Response struct {
 }
 
-func (response ItemCommentList404Response) VisitItemCommentListResponse(w http.ResponseWriter) error {
+func (response ItemRestore404Response) VisitItemRestoreResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ItemCommentCreateRequestObject struct {
+type MetadataSchemaByModelAsJSONRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
 	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
-	ItemId             ItemIdParam             `json:"itemId"`
-	Body               *ItemCommentCreateJSONRequestBody
 }
 
-type ItemCommentCreateResponseObject interface {
-	VisitItemCommentCreateResponse(w http.ResponseWriter) error
+type MetadataSchemaByModelAsJSONResponseObject interface {
+	VisitMetadataSchemaByModelAsJSONResponse(w http.ResponseWriter) error
 }
 
-type ItemCommentCreate200JSONResponse Comment
+type MetadataSchemaByModelAsJSON200JSONResponse JSONSchema
 
-func (response ItemCommentCreate200JSONResponse) VisitItemCommentCreateResponse(w http.ResponseWriter) error {
+func (response MetadataSchemaByModelAsJSON200JSONResponse) VisitMetadataSchemaByModelAsJSONResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4435,46 +5866,50 @@ func (response ItemCommentCreate200JSONResponse) VisitItemCommentCreateResponse(
 	return err
 }
 
-type ItemCommentCreate400Response struct {
+type MetadataSchemaByModelAsJSON400Response struct {
 }
 
-func (response ItemCommentCreate400Response) VisitItemCommentCreateResponse(w http.ResponseWriter) error {
+func (response MetadataSchemaByModelAsJSON400Response) VisitMetadataSchemaByModelAsJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ItemCommentCreate401Response = UnauthorizedErrorResponse
+type MetadataSchemaByModelAsJSON401Response = UnauthorizedErrorResponse
+
+func (response MetadataSchemaByModelAsJSON401Response) VisitMetadataSchemaByModelAsJSONResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type MetadataSchemaByModelAsJSON404Response struct {
+}
 
-func (response ItemCommentCreate401Response) VisitItemCommentCreateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
+func (response MetadataSchemaByModelAsJSON404Response) VisitMetadataSchemaByModelAsJSONResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
 	return nil
 }
 
-type ItemCommentCreate404Response struct {
+type MetadataSchemaByModelAsJSON500Response struct {
 }
 
-func (response ItemCommentCreate404Response) VisitItemCommentCreateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
+func (response MetadataSchemaByModelAsJSON500Response) VisitMetadataSchemaByModelAsJSONResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
 	return nil
 }
 
-type ItemCommentDeleteRequestObject struct {
+type SchemaByModelAsJSONRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
 	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
-	ItemId             ItemIdParam             `json:"itemId"`
-	CommentId          CommentIdParam          `json:"commentId"`
 }
 
-type ItemCommentDeleteResponseObject interface {
-	VisitItemCommentDeleteResponse(w http.ResponseWriter) error
+type SchemaByModelAsJSONResponseObject interface {
+	VisitSchemaByModelAsJSONResponse(w http.ResponseWriter) error
 }
 
-type ItemCommentDelete200JSONResponse struct {
-	Id *id.CommentID `json:"id,omitempty"`
-}
+type SchemaByModelAsJSON200JSONResponse JSONSchema
 
-func (response ItemCommentDelete200JSONResponse) VisitItemCommentDeleteResponse(w http.ResponseWriter) error {
+func (response SchemaByModelAsJSON200JSONResponse) VisitSchemaByModelAsJSONResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4486,45 +5921,52 @@ func (response ItemCommentDelete200JSONResponse) VisitItemCommentDeleteResponse(
 	return err
 }
 
-type ItemCommentDelete400Response struct {
+type SchemaByModelAsJSON400Response struct {
 }
 
-func (response ItemCommentDelete400Response) VisitItemCommentDeleteResponse(w http.ResponseWriter) error {
+func (response SchemaByModelAsJSON400Response) VisitSchemaByModelAsJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ItemCommentDelete401Response = UnauthorizedErrorResponse
+type SchemaByModelAsJSON401Response = UnauthorizedErrorResponse
 
-func (response ItemCommentDelete401Response) VisitItemCommentDeleteResponse(w http.ResponseWriter) error {
+func (response SchemaByModelAsJSON401Response) VisitSchemaByModelAsJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ItemCommentDelete404Response struct {
+type SchemaByModelAsJSON404Response struct {
 }
 
-func (response ItemCommentDelete404Response) VisitItemCommentDeleteResponse(w http.ResponseWriter) error {
+func (response SchemaByModelAsJSON404Response) VisitSchemaByModelAsJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ItemCommentUpdateRequestObject struct {
+type SchemaByModelAsJSON500Response struct {
+}
+
+func (response SchemaByModelAsJSON500Response) VisitSchemaByModelAsJSONResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ScheduleListRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
-	ItemId             ItemIdParam             `json:"itemId"`
-	CommentId          CommentIdParam          `json:"commentId"`
-	Body               *ItemCommentUpdateJSONRequestBody
+	Params             ScheduleListParams
 }
 
-type ItemCommentUpdateResponseObject interface {
-	VisitItemCommentUpdateResponse(w http.ResponseWriter) error
+type ScheduleListResponseObject interface {
+	VisitScheduleListResponse(w http.ResponseWriter) error
 }
 
-type ItemCommentUpdate200JSONResponse Comment
+type ScheduleList200JSONResponse struct {
+	Schedules *[]Schedule `json:"schedules,omitempty"`
+}
 
-func (response ItemCommentUpdate200JSONResponse) VisitItemCommentUpdateResponse(w http.ResponseWriter) error {
+func (response ScheduleList200JSONResponse) VisitScheduleListResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4536,44 +5978,42 @@ func (response ItemCommentUpdate200JSONResponse) VisitItemCommentUpdateResponse(
 	return err
 }
 
-type ItemCommentUpdate400Response struct {
+type ScheduleList400Response struct {
 }
 
-func (response ItemCommentUpdate400Response) VisitItemCommentUpdateResponse(w http.ResponseWriter) error {
+func (response ScheduleList400Response) VisitScheduleListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ItemCommentUpdate401Response = UnauthorizedErrorResponse
+type ScheduleList401Response = UnauthorizedErrorResponse
 
-func (response ItemCommentUpdate401Response) VisitItemCommentUpdateResponse(w http.ResponseWriter) error {
+func (response ScheduleList401Response) VisitScheduleListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ItemCommentUpdate404Response struct {
+type ScheduleList404Response struct {
 }
 
-func (response ItemCommentUpdate404Response) VisitItemCommentUpdateResponse(w http.ResponseWriter) error {
+func (response ScheduleList404Response) VisitScheduleListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ItemPublishRequestObject struct {
+type ScheduleCreateRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
-	ItemId             ItemIdParam             `json:"itemId"`
-	Params             ItemPublishParams
+	Body               *ScheduleCreateJSONRequestBody
 }
 
-type ItemPublishResponseObject interface {
-	VisitItemPublishResponse(w http.ResponseWriter) error
+type ScheduleCreateResponseObject interface {
+	VisitScheduleCreateResponse(w http.ResponseWriter) error
 }
 
-type ItemPublish200JSONResponse VersionedItem
+type ScheduleCreate200JSONResponse Schedule
 
-func (response ItemPublish200JSONResponse) VisitItemPublishResponse(w http.ResponseWriter) error {
+func (response ScheduleCreate200JSONResponse) VisitScheduleCreateResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4585,42 +6025,42 @@ func (response ItemPublish200JSONResponse) VisitItemPublishResponse(w http.Respo
 	return err
 }
 
-type ItemPublish400Response struct {
+type ScheduleCreate400Response struct {
 }
 
-func (response ItemPublish400Response) VisitItemPublishResponse(w http.ResponseWriter) error {
+func (response ScheduleCreate400Response) VisitScheduleCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ItemPublish401Response = UnauthorizedErrorResponse
+type ScheduleCreate401Response = UnauthorizedErrorResponse
 
-func (response ItemPublish401Response) VisitItemPublishResponse(w http.ResponseWriter) error {
+func (response ScheduleCreate401Response) VisitScheduleCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ItemPublish404Response struct {
+type ScheduleCreate404Response struct {
 }
 
-func (response ItemPublish404Response) VisitItemPublishResponse(w http.ResponseWriter) error {
+func (response ScheduleCreate404Response) VisitScheduleCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type MetadataSchemaByModelAsJSONRequestObject struct {
+type ScheduleCancelRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
+	ScheduleId         ScheduleIdParam         `json:"scheduleId"`
 }
 
-type MetadataSchemaByModelAsJSONResponseObject interface {
-	VisitMetadataSchemaByModelAsJSONResponse(w http.ResponseWriter) error
+type ScheduleCancelResponseObject interface {
+	VisitScheduleCancelResponse(w http.ResponseWriter) error
 }
 
-type MetadataSchemaByModelAsJSON200JSONResponse JSONSchema
+type ScheduleCancel200JSONResponse Schedule
 
-func (response MetadataSchemaByModelAsJSON200JSONResponse) VisitMetadataSchemaByModelAsJSONResponse(w http.ResponseWriter) error {
+func (response ScheduleCancel200JSONResponse) VisitScheduleCancelResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4632,50 +6072,42 @@ func (response MetadataSchemaByModelAsJSON200JSONResponse) VisitMetadataSchemaBy
 	return err
 }
 
-type MetadataSchemaByModelAsJSON400Response struct {
+type ScheduleCancel400Response struct {
 }
 
-func (response MetadataSchemaByModelAsJSON400Response) VisitMetadataSchemaByModelAsJSONResponse(w http.ResponseWriter) error {
+func (response ScheduleCancel400Response) VisitScheduleCancelResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type MetadataSchemaByModelAsJSON401Response = UnauthorizedErrorResponse
+type ScheduleCancel401Response = UnauthorizedErrorResponse
 
-func (response MetadataSchemaByModelAsJSON401Response) VisitMetadataSchemaByModelAsJSONResponse(w http.ResponseWriter) error {
+func (response ScheduleCancel401Response) VisitScheduleCancelResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type MetadataSchemaByModelAsJSON404Response struct {
+type ScheduleCancel404Response struct {
 }
 
-func (response MetadataSchemaByModelAsJSON404Response) VisitMetadataSchemaByModelAsJSONResponse(w http.ResponseWriter) error {
+func (response ScheduleCancel404Response) VisitScheduleCancelResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type MetadataSchemaByModelAsJSON500Response struct {
-}
-
-func (response MetadataSchemaByModelAsJSON500Response) VisitMetadataSchemaByModelAsJSONResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
-
-type SchemaByModelAsJSONRequestObject struct {
+type ScheduleGetRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
-	ModelIdOrKey       ModelIdOrKeyParam       `json:"modelIdOrKey"`
+	ScheduleId         ScheduleIdParam         `json:"scheduleId"`
 }
 
-type SchemaByModelAsJSONResponseObject interface {
-	VisitSchemaByModelAsJSONResponse(w http.ResponseWriter) error
+type ScheduleGetResponseObject interface {
+	VisitScheduleGetResponse(w http.ResponseWriter) error
 }
 
-type SchemaByModelAsJSON200JSONResponse JSONSchema
+type ScheduleGet200JSONResponse Schedule
 
-func (response SchemaByModelAsJSON200JSONResponse) VisitSchemaByModelAsJSONResponse(w http.ResponseWriter) error {
+func (response ScheduleGet200JSONResponse) VisitScheduleGetResponse(w http.ResponseWriter) error {
 
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(response); err != nil {
@@ -4687,37 +6119,29 @@ func (response SchemaByModelAsJSON200JSONResponse) VisitSchemaByModelAsJSONRespo
 	return err
 }
 
-type SchemaByModelAsJSON400Response struct {
+type ScheduleGet400Response struct {
 }
 
-func (response SchemaByModelAsJSON400Response) VisitSchemaByModelAsJSONResponse(w http.ResponseWriter) error {
+func (response ScheduleGet400Response) VisitScheduleGetResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type SchemaByModelAsJSON401Response = UnauthorizedErrorResponse
+type ScheduleGet401Response = UnauthorizedErrorResponse
 
-func (response SchemaByModelAsJSON401Response) VisitSchemaByModelAsJSONResponse(w http.ResponseWriter) error {
+func (response ScheduleGet401Response) VisitScheduleGetResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type SchemaByModelAsJSON404Response struct {
+type ScheduleGet404Response struct {
 }
 
-func (response SchemaByModelAsJSON404Response) VisitSchemaByModelAsJSONResponse(w http.ResponseWriter) error {
+func (response ScheduleGet404Response) VisitScheduleGetResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type SchemaByModelAsJSON500Response struct {
-}
-
-func (response SchemaByModelAsJSON500Response) VisitSchemaByModelAsJSONResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
-
 type FieldCreateRequestObject struct {
 	WorkspaceIdOrAlias WorkspaceIdOrAliasParam `json:"workspaceIdOrAlias"`
 	ProjectIdOrAlias   ProjectIdOrAliasParam   `json:"projectIdOrAlias"`
@@ -4867,6 +6291,12 @@ func (response FieldUpdate404Response) VisitFieldUpdateResponse(w http.ResponseW
 
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
+	// Returns a list of deliveries of a webhook
+	// (GET /webhooks/{webhookId}/deliveries)
+	WebhookDeliveryList(ctx context.Context, request WebhookDeliveryListRequestObject) (WebhookDeliveryListResponseObject, error)
+	// Send the payload of a delivery to the webhook again
+	// (POST /webhooks/{webhookId}/deliveries/{deliveryId}/redeliver)
+	WebhookRedeliver(ctx context.Context, request WebhookRedeliverRequestObject) (WebhookRedeliverResponseObject, error)
 	// Returns a list of projects.
 	// (GET /{workspaceIdOrAlias}/projects)
 	ProjectFilter(ctx context.Context, request ProjectFilterRequestObject) (ProjectFilterResponseObject, error)
@@ -4882,6 +6312,12 @@ type StrictServerInterface interface {
 	// Update a project.
 	// (PATCH /{workspaceIdOrAlias}/projects/{projectIdOrAlias})
 	ProjectUpdate(ctx context.Context, request ProjectUpdateRequestObject) (ProjectUpdateResponseObject, error)
+	// Returns a list of asset folders.
+	// (GET /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/asset-folders)
+	AssetFolderList(ctx context.Context, request AssetFolderListRequestObject) (AssetFolderListResponseObject, error)
+	// Create an asset folder.
+	// (POST /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/asset-folders)
+	AssetFolderCreate(ctx context.Context, request AssetFolderCreateRequestObject) (AssetFolderCreateResponseObject, error)
 	// delete assets in batch
 	// (DELETE /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/assets)
 	AssetBatchDelete(ctx context.Context, request AssetBatchDeleteRequestObject) (AssetBatchDeleteResponseObject, error)
@@ -4936,6 +6372,12 @@ type StrictServerInterface interface {
 	// Update a group's details within a project.
 	// (PATCH /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/groups/{groupIdOrKey})
 	GroupUpdate(ctx context.Context, request GroupUpdateRequestObject) (GroupUpdateResponseObject, error)
+	// Cancel a running job
+	// (DELETE /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/jobs/{jobId})
+	JobCancel(ctx context.Context, request JobCancelRequestObject) (JobCancelResponseObject, error)
+	// Returns a job
+	// (GET /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/jobs/{jobId})
+	JobGet(ctx context.Context, request JobGetRequestObject) (JobGetResponseObject, error)
 	// Returns a list of models.
 	// (GET /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models)
 	ModelFilter(ctx context.Context, request ModelFilterRequestObject) (ModelFilterResponseObject, error)
@@ -4969,9 +6411,21 @@ type StrictServerInterface interface {
 	// Returns a GeoJSON that has a list of items as features.
 	// (GET /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items.geojson)
 	ItemsAsGeoJSON(ctx context.Context, request ItemsAsGeoJSONRequestObject) (ItemsAsGeoJSONResponseObject, error)
+	// Update a set of fields of the items in the background
+	// (POST /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items/bulk-update)
+	ItemBulkUpdate(ctx context.Context, request ItemBulkUpdateRequestObject) (ItemBulkUpdateResponseObject, error)
 	// Returns a list of items with complex filtering.
 	// (POST /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items/filter)
 	ItemFilterPost(ctx context.Context, request ItemFilterPostRequestObject) (ItemFilterPostResponseObject, error)
+	// Returns a list of items in the trash.
+	// (GET /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items/trash)
+	ItemTrashList(ctx context.Context, request ItemTrashListRequestObject) (ItemTrashListResponseObject, error)
+	// purge items from the trash
+	// (POST /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items/trash/purge)
+	ItemTrashPurge(ctx context.Context, request ItemTrashPurgeRequestObject) (ItemTrashPurgeResponseObject, error)
+	// restore items from the trash
+	// (POST /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items/trash/restore)
+	ItemTrashRestore(ctx context.Context, request ItemTrashRestoreRequestObject) (ItemTrashRestoreResponseObject, error)
 	// delete an item
 	// (DELETE /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items/{itemId})
 	ItemDelete(ctx context.Context, request ItemDeleteRequestObject) (ItemDeleteResponseObject, error)
@@ -4993,15 +6447,33 @@ type StrictServerInterface interface {
 	// Update Item Comment
 	// (PATCH /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items/{itemId}/comments/{commentId})
 	ItemCommentUpdate(ctx context.Context, request ItemCommentUpdateRequestObject) (ItemCommentUpdateResponseObject, error)
+	// compare two versions of an item
+	// (GET /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items/{itemId}/diff)
+	ItemDiff(ctx context.Context, request ItemDiffRequestObject) (ItemDiffResponseObject, error)
 	// publish item
 	// (POST /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items/{itemId}/publish)
 	ItemPublish(ctx context.Context, request ItemPublishRequestObject) (ItemPublishResponseObject, error)
+	// restore item to a past version
+	// (POST /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/items/{itemId}/restore)
+	ItemRestore(ctx context.Context, request ItemRestoreRequestObject) (ItemRestoreResponseObject, error)
 	// Returns a metadata schema as json by project and model ID
 	// (GET /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/metadata_schema.json)
 	MetadataSchemaByModelAsJSON(ctx context.Context, request MetadataSchemaByModelAsJSONRequestObject) (MetadataSchemaByModelAsJSONResponseObject, error)
 	// Returns a schema as json by project and model ID
 	// (GET /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/models/{modelIdOrKey}/schema.json)
 	SchemaByModelAsJSON(ctx context.Context, request SchemaByModelAsJSONRequestObject) (SchemaByModelAsJSONResponseObject, error)
+	// Returns a list of scheduled publications
+	// (GET /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/schedules)
+	ScheduleList(ctx context.Context, request ScheduleListRequestObject) (ScheduleListResponseObject, error)
+	// Schedule items to be published or unpublished
+	// (POST /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/schedules)
+	ScheduleCreate(ctx context.Context, request ScheduleCreateRequestObject) (ScheduleCreateResponseObject, error)
+	// Cancel a pending schedule
+	// (DELETE /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/schedules/{scheduleId})
+	ScheduleCancel(ctx context.Context, request ScheduleCancelRequestObject) (ScheduleCancelResponseObject, error)
+	// Returns a schedule
+	// (GET /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/schedules/{scheduleId})
+	ScheduleGet(ctx context.Context, request ScheduleGetRequestObject) (ScheduleGetResponseObject, error)
 	// create a field
 	// (POST /{workspaceIdOrAlias}/projects/{projectIdOrAlias}/schemata/{schemaId}/fields)
 	FieldCreate(ctx context.Context, request FieldCreateRequestObject) (FieldCreateResponseObject, error)
@@ -5025,6 +6497,58 @@ type strictHandler struct {
 	middlewares []StrictMiddlewareFunc
 }
 
+// WebhookDeliveryList operation middleware
+func (sh *strictHandler) WebhookDeliveryList(ctx *echo.Context, webhookId WebhookIdParam, params WebhookDeliveryListParams) error {
+	var request WebhookDeliveryListRequestObject
+
+	request.WebhookId = webhookId
+	request.Params = params
+
+	handler := func(ctx *echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhookDeliveryList(ctx.Request().Context(), request.(WebhookDeliveryListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhookDeliveryList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WebhookDeliveryListResponseObject); ok {
+		return validResponse.VisitWebhookDeliveryListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WebhookRedeliver operation middleware
+func (sh *strictHandler) WebhookRedeliver(ctx *echo.Context, webhookId WebhookIdParam, deliveryId WebhookDeliveryIdParam) error {
+	var request WebhookRedeliverRequestObject
+
+	request.WebhookId = webhookId
+	request.DeliveryId = deliveryId
+
+	handler := func(ctx *echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WebhookRedeliver(ctx.Request().Context(), request.(WebhookRedeliverRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WebhookRedeliver")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WebhookRedeliverResponseObject); ok {
+		return validResponse.VisitWebhookRedeliverResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ProjectFilter operation middleware
 func (sh *strictHandler) ProjectFilter(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, params ProjectFilterParams) error {
 	var request ProjectFilterRequestObject
@@ -5167,6 +6691,64 @@ func (sh *strictHandler) ProjectUpdate(ctx *echo.Context, workspaceIdOrAlias Wor
 	return nil
 }
 
+// AssetFolderList operation middleware
+func (sh *strictHandler) AssetFolderList(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam) error {
+	var request AssetFolderListRequestObject
+
+	request.WorkspaceIdOrAlias = workspaceIdOrAlias
+	request.ProjectIdOrAlias = projectIdOrAlias
+
+	handler := func(ctx *echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AssetFolderList(ctx.Request().Context(), request.(AssetFolderListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AssetFolderList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AssetFolderListResponseObject); ok {
+		return validResponse.VisitAssetFolderListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AssetFolderCreate operation middleware
+func (sh *strictHandler) AssetFolderCreate(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam) error {
+	var request AssetFolderCreateRequestObject
+
+	request.WorkspaceIdOrAlias = workspaceIdOrAlias
+	request.ProjectIdOrAlias = projectIdOrAlias
+
+	var body AssetFolderCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx *echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AssetFolderCreate(ctx.Request().Context(), request.(AssetFolderCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AssetFolderCreate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AssetFolderCreateResponseObject); ok {
+		return validResponse.VisitAssetFolderCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // AssetBatchDelete operation middleware
 func (sh *strictHandler) AssetBatchDelete(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam) error {
 	var request AssetBatchDeleteRequestObject
@@ -5626,78 +7208,132 @@ func (sh *strictHandler) GroupDelete(ctx *echo.Context, workspaceIdOrAlias Works
 	request.GroupIdOrKey = groupIdOrKey
 
 	handler := func(ctx *echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GroupDelete(ctx.Request().Context(), request.(GroupDeleteRequestObject))
+		return sh.ssi.GroupDelete(ctx.Request().Context(), request.(GroupDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GroupDelete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GroupDeleteResponseObject); ok {
+		return validResponse.VisitGroupDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GroupGet operation middleware
+func (sh *strictHandler) GroupGet(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, groupIdOrKey GroupIdOrKeyParam) error {
+	var request GroupGetRequestObject
+
+	request.WorkspaceIdOrAlias = workspaceIdOrAlias
+	request.ProjectIdOrAlias = projectIdOrAlias
+	request.GroupIdOrKey = groupIdOrKey
+
+	handler := func(ctx *echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GroupGet(ctx.Request().Context(), request.(GroupGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GroupGet")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GroupGetResponseObject); ok {
+		return validResponse.VisitGroupGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GroupUpdate operation middleware
+func (sh *strictHandler) GroupUpdate(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, groupIdOrKey GroupIdOrKeyParam) error {
+	var request GroupUpdateRequestObject
+
+	request.WorkspaceIdOrAlias = workspaceIdOrAlias
+	request.ProjectIdOrAlias = projectIdOrAlias
+	request.GroupIdOrKey = groupIdOrKey
+
+	var body GroupUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx *echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GroupUpdate(ctx.Request().Context(), request.(GroupUpdateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GroupDelete")
+		handler = middleware(handler, "GroupUpdate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GroupDeleteResponseObject); ok {
-		return validResponse.VisitGroupDeleteResponse(ctx.Response())
+	} else if validResponse, ok := response.(GroupUpdateResponseObject); ok {
+		return validResponse.VisitGroupUpdateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GroupGet operation middleware
-func (sh *strictHandler) GroupGet(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, groupIdOrKey GroupIdOrKeyParam) error {
-	var request GroupGetRequestObject
+// JobCancel operation middleware
+func (sh *strictHandler) JobCancel(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, jobId JobIdParam) error {
+	var request JobCancelRequestObject
 
 	request.WorkspaceIdOrAlias = workspaceIdOrAlias
 	request.ProjectIdOrAlias = projectIdOrAlias
-	request.GroupIdOrKey = groupIdOrKey
+	request.JobId = jobId
 
 	handler := func(ctx *echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GroupGet(ctx.Request().Context(), request.(GroupGetRequestObject))
+		return sh.ssi.JobCancel(ctx.Request().Context(), request.(JobCancelRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GroupGet")
+		handler = middleware(handler, "JobCancel")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GroupGetResponseObject); ok {
-		return validResponse.VisitGroupGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(JobCancelResponseObject); ok {
+		return validResponse.VisitJobCancelResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GroupUpdate operation middleware
-func (sh *strictHandler) GroupUpdate(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, groupIdOrKey GroupIdOrKeyParam) error {
-	var request GroupUpdateRequestObject
+// JobGet operation middleware
+func (sh *strictHandler) JobGet(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, jobId JobIdParam) error {
+	var request JobGetRequestObject
 
 	request.WorkspaceIdOrAlias = workspaceIdOrAlias
 	request.ProjectIdOrAlias = projectIdOrAlias
-	request.GroupIdOrKey = groupIdOrKey
-
-	var body GroupUpdateJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.JobId = jobId
 
 	handler := func(ctx *echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GroupUpdate(ctx.Request().Context(), request.(GroupUpdateRequestObject))
+		return sh.ssi.JobGet(ctx.Request().Context(), request.(JobGetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GroupUpdate")
+		handler = middleware(handler, "JobGet")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GroupUpdateResponseObject); ok {
-		return validResponse.VisitGroupUpdateResponse(ctx.Response())
+	} else if validResponse, ok := response.(JobGetResponseObject); ok {
+		return validResponse.VisitJobGetResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -6042,6 +7678,39 @@ func (sh *strictHandler) ItemsAsGeoJSON(ctx *echo.Context, workspaceIdOrAlias Wo
 	return nil
 }
 
+// ItemBulkUpdate operation middleware
+func (sh *strictHandler) ItemBulkUpdate(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam) error {
+	var request ItemBulkUpdateRequestObject
+
+	request.WorkspaceIdOrAlias = workspaceIdOrAlias
+	request.ProjectIdOrAlias = projectIdOrAlias
+	request.ModelIdOrKey = modelIdOrKey
+
+	var body ItemBulkUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx *echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemBulkUpdate(ctx.Request().Context(), request.(ItemBulkUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemBulkUpdate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemBulkUpdateResponseObject); ok {
+		return validResponse.VisitItemBulkUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ItemFilterPost operation middleware
 func (sh *strictHandler) ItemFilterPost(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam, params ItemFilterPostParams) error {
 	var request ItemFilterPostRequestObject
@@ -6076,6 +7745,100 @@ func (sh *strictHandler) ItemFilterPost(ctx *echo.Context, workspaceIdOrAlias Wo
 	return nil
 }
 
+// ItemTrashList operation middleware
+func (sh *strictHandler) ItemTrashList(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam, params ItemTrashListParams) error {
+	var request ItemTrashListRequestObject
+
+	request.WorkspaceIdOrAlias = workspaceIdOrAlias
+	request.ProjectIdOrAlias = projectIdOrAlias
+	request.ModelIdOrKey = modelIdOrKey
+	request.Params = params
+
+	handler := func(ctx *echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemTrashList(ctx.Request().Context(), request.(ItemTrashListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemTrashList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemTrashListResponseObject); ok {
+		return validResponse.VisitItemTrashListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ItemTrashPurge operation middleware
+func (sh *strictHandler) ItemTrashPurge(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam) error {
+	var request ItemTrashPurgeRequestObject
+
+	request.WorkspaceIdOrAlias = workspaceIdOrAlias
+	request.ProjectIdOrAlias = projectIdOrAlias
+	request.ModelIdOrKey = modelIdOrKey
+
+	var body ItemTrashPurgeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx *echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemTrashPurge(ctx.Request().Context(), request.(ItemTrashPurgeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemTrashPurge")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemTrashPurgeResponseObject); ok {
+		return validResponse.VisitItemTrashPurgeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ItemTrashRestore operation middleware
+func (sh *strictHandler) ItemTrashRestore(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam) error {
+	var request ItemTrashRestoreRequestObject
+
+	request.WorkspaceIdOrAlias = workspaceIdOrAlias
+	request.ProjectIdOrAlias = projectIdOrAlias
+	request.ModelIdOrKey = modelIdOrKey
+
+	var body ItemTrashRestoreJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx *echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemTrashRestore(ctx.Request().Context(), request.(ItemTrashRestoreRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemTrashRestore")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemTrashRestoreResponseObject); ok {
+		return validResponse.VisitItemTrashRestoreResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ItemDelete operation middleware
 func (sh *strictHandler) ItemDelete(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam, itemId ItemIdParam) error {
 	var request ItemDeleteRequestObject
@@ -6293,6 +8056,35 @@ func (sh *strictHandler) ItemCommentUpdate(ctx *echo.Context, workspaceIdOrAlias
 	return nil
 }
 
+// ItemDiff operation middleware
+func (sh *strictHandler) ItemDiff(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam, itemId ItemIdParam, params ItemDiffParams) error {
+	var request ItemDiffRequestObject
+
+	request.WorkspaceIdOrAlias = workspaceIdOrAlias
+	request.ProjectIdOrAlias = projectIdOrAlias
+	request.ModelIdOrKey = modelIdOrKey
+	request.ItemId = itemId
+	request.Params = params
+
+	handler := func(ctx *echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemDiff(ctx.Request().Context(), request.(ItemDiffRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemDiff")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemDiffResponseObject); ok {
+		return validResponse.VisitItemDiffResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ItemPublish operation middleware
 func (sh *strictHandler) ItemPublish(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam, itemId ItemIdParam, params ItemPublishParams) error {
 	var request ItemPublishRequestObject
@@ -6322,6 +8114,40 @@ func (sh *strictHandler) ItemPublish(ctx *echo.Context, workspaceIdOrAlias Works
 	return nil
 }
 
+// ItemRestore operation middleware
+func (sh *strictHandler) ItemRestore(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam, itemId ItemIdParam) error {
+	var request ItemRestoreRequestObject
+
+	request.WorkspaceIdOrAlias = workspaceIdOrAlias
+	request.ProjectIdOrAlias = projectIdOrAlias
+	request.ModelIdOrKey = modelIdOrKey
+	request.ItemId = itemId
+
+	var body ItemRestoreJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx *echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemRestore(ctx.Request().Context(), request.(ItemRestoreRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemRestore")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemRestoreResponseObject); ok {
+		return validResponse.VisitItemRestoreResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // MetadataSchemaByModelAsJSON operation middleware
 func (sh *strictHandler) MetadataSchemaByModelAsJSON(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam) error {
 	var request MetadataSchemaByModelAsJSONRequestObject
@@ -6376,6 +8202,119 @@ func (sh *strictHandler) SchemaByModelAsJSON(ctx *echo.Context, workspaceIdOrAli
 	return nil
 }
 
+// ScheduleList operation middleware
+func (sh *strictHandler) ScheduleList(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, params ScheduleListParams) error {
+	var request ScheduleListRequestObject
+
+	request.WorkspaceIdOrAlias = workspaceIdOrAlias
+	request.ProjectIdOrAlias = projectIdOrAlias
+	request.Params = params
+
+	handler := func(ctx *echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ScheduleList(ctx.Request().Context(), request.(ScheduleListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ScheduleList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ScheduleListResponseObject); ok {
+		return validResponse.VisitScheduleListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ScheduleCreate operation middleware
+func (sh *strictHandler) ScheduleCreate(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam) error {
+	var request ScheduleCreateRequestObject
+
+	request.WorkspaceIdOrAlias = workspaceIdOrAlias
+	request.ProjectIdOrAlias = projectIdOrAlias
+
+	var body ScheduleCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx *echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ScheduleCreate(ctx.Request().Context(), request.(ScheduleCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ScheduleCreate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ScheduleCreateResponseObject); ok {
+		return validResponse.VisitScheduleCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ScheduleCancel operation middleware
+func (sh *strictHandler) ScheduleCancel(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, scheduleId ScheduleIdParam) error {
+	var request ScheduleCancelRequestObject
+
+	request.WorkspaceIdOrAlias = workspaceIdOrAlias
+	request.ProjectIdOrAlias = projectIdOrAlias
+	request.ScheduleId = scheduleId
+
+	handler := func(ctx *echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ScheduleCancel(ctx.Request().Context(), request.(ScheduleCancelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ScheduleCancel")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ScheduleCancelResponseObject); ok {
+		return validResponse.VisitScheduleCancelResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ScheduleGet operation middleware
+func (sh *strictHandler) ScheduleGet(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, scheduleId ScheduleIdParam) error {
+	var request ScheduleGetRequestObject
+
+	request.WorkspaceIdOrAlias = workspaceIdOrAlias
+	request.ProjectIdOrAlias = projectIdOrAlias
+	request.ScheduleId = scheduleId
+
+	handler := func(ctx *echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ScheduleGet(ctx.Request().Context(), request.(ScheduleGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ScheduleGet")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ScheduleGetResponseObject); ok {
+		return validResponse.VisitScheduleGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // FieldCreate operation middleware
 func (sh *strictHandler) FieldCreate(ctx *echo.Context, workspaceIdOrAlias WorkspaceIdOrAliasParam, projectIdOrAlias ProjectIdOrAliasParam, schemaId SchemaIdParam) error {
 	var request FieldCreateRequestObject
@@ -6474,87 +8413,130 @@ func (sh *strictHandler) FieldUpdate(ctx *echo.Context, workspaceIdOrAlias Works
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w9XW/buLJ/RdC9wH1x43b3nJe8pU1aeHfbBk2yxcUiKBhrbPNEprwkldQb+L8fcEhK",
-	"lEV92XIcJ3lJbIukhsP55nD4EI6T+SJhwKQIjx/CBeFkDhI4fiNCgBxF5+pH9T0CMeZ0IWnCwuNwdBok",
-	"k0DOIBAQw1hCFGCHcBBS9XxB5CwchIzMITy2Y4WDkMPfKeUQhceSpzAIxXgGc6LGl8uFaiokp2waDsKf",
-	"b6bJG/MjjY5OcIjTcLUa6OEqALtYwJhOKIjgfgZyBlzDFUREkoBwCGB+A1EEUUAZws9BpLEUFvC/U+DL",
-	"NchDF87/5TAJj8P/GebIG+qnYoitz/AFahIK1nEynwPrhEjTxY/KbLxtkPnBDKLROaEQR6PoK/8dljVQ",
-	"8uAWlhZY7GNROE8iiEVgXu8F233HxpDrVkcfcaxTPZaawJQn6aLjBLCPncCCJ/+BcQXG3dE3Bh0HOXKB",
-	"phLmXahCtfcDqEfahh5GagRNDLewvE94FVzmaZAN5OMZ0yisBkC9CImm46Jhn1aL5o6+MWZwkMKiLcgU",
-	"KoC9EhAFMjELpiEkU6jAkXmUwxHBhKSxDI/fDcI5ZXSezvGzXSQmYQpcAwH8vDc49Fh+UP79dhDOyU8D",
-	"y9u3zZDpJVF4P4kpEbXrSlQLu7K1i7k+7MYLagbCJdUjKag5TNrhkgQcJgr0O+AV+FTKwYvLMCYShJog",
-	"MIXAv/IfFulNTMfh9cDDJXqkNnICGxYksh+ZdsRt5MWFHkNLDJFweUp5AwojmFAGCFzCI+BBRDmMVSM7",
-	"Aw5ikTABQUyFHAT3NI6DGwjolCVcyb+J05mKgCUyWHAQwCREFasRUV6xGgpIZy0IfsMf/cuQcNl1gr5p",
-	"VcCphq8AdMyBSIhOXMpxf0sXkfnsBfw+4bdiQcawAU9mff2EVB56Y5Ii43GSMhklc0LZ0fdsYIdNkU81",
-	"JtE8/ZLIj0nKojPOE16eziVi/u8UhNKdHESS8jEE90QTzkR1DVeD8IqRVM4STv+BqqFOxmMQIpDJLTBF",
-	"eHMqBGVThS7K7khMI4dTEbZPkPx28fWLM+vkxkg3d9bqnziyjVeDUP2/WEdYTVenvbKKEU56Q2Mql2jP",
-	"82QBXFKNMLKgv8MSPyqDQTTastheDWwXiXOC37W8Iho99YM4TS9ASsqmQo1wR104LVmfX73/Y/QhHITn",
-	"30Z/nlye+Sk6p7C/3HEG2QTzXgZ1K/usjJTCSpeslEFIozauyfnod1gqaYjmk3cgzTOeB9sicw0jNLIM",
-	"qmEpvsCLGvRwyuTCxzN6B2c/JScopi8kkalw12sBLLJW4I8FT6YchJIBUcLU6yeExhB5FlE5RUwCk5f4",
-	"uwcpuYA7fggnCZ8TlNlEwhtJcW6lLhMaQxMCsU3rVbUOZ93icbijcG/nYRFD58bgUv9/iDs1+hQS/ffH",
-	"r9GPSxqDMF/nd4q5UWH/+FWt3ljcKbnObllyz7zoy+yhFtM4N21Pc2Jzet0kSQyEIZcnksQX9B93piyd",
-	"3yjTzlUzrVck5bHf8i/Raz4f3WtQoeE8ptIaHee+t7McJFZDKn2EVBkLqCBK7XaXWQE1RDO2CUPxqJuv",
-	"00QqjM0oYcolKXJjiTP64opWlF6MBpQQO05YRK18WsMMi1rrk3wYj0q5IULTZXF8EzVo5muIowu00ROk",
-	"VjUGkVqZ2wWAv1MSK6ZjiTzTn30LcEfiVC2cFxWKXZ4UlCU+XmMvC5rzMtvZx0PzNJZ0oQXp7uZI2ThO",
-	"IxAnbKknOir8kD1GtnUfx3E9MiwdlghsO6ywNI7Jza6xAvOFNPg4w49e68cDHIronYI2RcHDL2eEhYMw",
-	"BiHMR+fBV47kepk4LfLf2tCwVTbbLZaGfHuJZODcKV6VsCeUGXb/kH8TknApvlN0t4BF9iNL5IX7SNGK",
-	"fdoGxRVKuCOKUdnsFDE3MEm4UmhkIlFt6h++8q/M/mg+J5PLGRXfAW6zL58ThsjR3/4fCK/HTRtNug3C",
-	"fFyLA5RxiFHilvHaTxiWPm2t5U3UvM5JkcZmqVs/nCUaN03KsrjSdfTSHnK5bmlH1likCTslEpyvV9ri",
-	"micRnRgHyLRwfzKthPZu7MoMwjlIgi9uKYet/1Gc5HhG44gDay2TrIuyLo6aPKZqF4XImfeB8Jv6vrll",
-	"ZNnNeUZUtg846P8a5x4MtCJyhym6e+KbelXt9gZFFqjJIrAdA60lv8kJ5boulHH/3eWxwYBsi86sjU+8",
-	"40ZTmZI3cck7rf9kq5W3W1iDkIrPIElEJPE7unPz9GNP4NnxRnobrguoZpfKS44Jp1PKSLzBsBwmwIGN",
-	"IRrZmbWaIq68Z4adnX+fENFbEn3QVS9Bu8+I+lpJERMhP6OqgKg9dJYcLjpKhWK/jtLhYMVaT8Rltw/L",
-	"EYr1oHhtyLvQeDUIcTukr1hML2RbWKGYjoGJjgYBBxJVPsINk29JDO2FhkH9t7xvLyKksHW18f5RbXy8",
-	"qCAtMjMMFSGwxLC+zzJo3I0rUamLKseW/XZ2cnr2LRyE37+NLvHD55PRl8uT0Rf88vW7+u8NB3v2BUqM",
-	"oBthUFv49aJugXKxuPrtJWlt1KUw/qAIkA9bufx5bEukD0vUFXEbi2BJZQwfra/Y1k1aVSLzo9/t7Mt/",
-	"dMOHZfqqkUiWSLz7Et2cUh+/4xDWBM6eOvAa4HxUmA/tsKqEn4rb1b8TDsqe5nQ8u9S/zgm/jZJ7JVPG",
-	"Mxjf3iQ/w0GWSBhpFxQjyYNQJ5bYfQH0RI0j5lhx2a6I9sMGoSRmR2kOki+/2g1i+8NZRJXH7Q16ABfe",
-	"SP6CcJsGmrFIxltpmqOxHC/IGYPDpE5yeHo48DS8zUfUprc2c1Hbx/HXSXj8Vyszt4GkDGSqXRFVm1rX",
-	"RWh90nJtgteYVgDjlFO5RHmiAbgBwoGfpNqxx+GRZ/DnHHMzKRc6cYKySVLOa/gGZ4TL2ZsPny+CEW5L",
-	"oQoJTs5HoRE9ja2y9QvfHb09emvieYwsaHgc/nr09ujXUIcgEPDhQzlnZGUNCB0A01vR64DKlDMREEyh",
-	"CZJJkHWxATiaMCVdQyM9P9JYGh5yUkZ+efsW9Ue+xUYWC6s5h/8Rmg6r1A4m0R0/1KbAZbl5LRo6s+5i",
-	"Z/m4CLduPygLqPDet94cvfXo5LplGp4Ev118/RLg6AVkrwbhvzQK11KHdBaMzbcJsjzyQMcHsd+7qvll",
-	"SzQs5+Jgz3819ywmA60G4b/9cErgjMSBAH4HPADd2mEyFB0ue/11vboehCKdzwlf1pLikZbLAhNZLMau",
-	"MQCXZ9VXCKa8ybAqX6tKXDld89zUNo3dHNIW7fMEuJaNs4zAFu0LOccK4YtEICl7mfsD2n9h5q68T6Ll",
-	"Fnxd7eM1OWwH4375ub6YqrcqCct3nZDaSnRVChsNl008NBZ+4Ii8vQme4hu/uLmDuxIymsAD4iRFeyTL",
-	"atCgT4cP63nTKw1uDBIquetUP+5VdXaNbJRt+OsWeusyTyPHPE890SgQKTrskzSOly+NlPRqNpHSoMnu",
-	"snnzVfbWJ3RttqKYPuXHC5Ub3vXas03iPRCCKp7I8cyTTY8BtBYkpxv2ZwXsKE78wm2I/ckAE4l9grLA",
-	"EHDwKDLBx089GRP6tKuosykwuvtecbpjVvTCrjo5u1Oc2knofnzSXjeIdgl5kYj0KunTR09SIXYh58jY",
-	"M3pOlAU3qEZymrb7Cc02jXXgzVj3VM6CCcaNKJsGhEX6GBdl07IOwrdkQaZu2nSnnvROYwAlN71PjugU",
-	"StXBdN/RpH6DdP0G1p4VJ1Yx0lGJGTMV/FQsTxNcKqIhc7oZ3JuSEUoGcJxmISqBDytkQs+xKdPtjI0T",
-	"e6ilnMd3SxenoJDAlVGqB8zOj+JxFzeFXR/L9B3/uQW/iVp5jqec56o31AiXw0nC529sDtY202tKfLR5",
-	"l9n20Q1lBM/WboYpz4GKEmPv0s41oq1Kehy+4LCMxnJOq5UZW5imw3QRJyQqFbd5UoJnJESK6Lj69geK",
-	"HGKOGssk0PCrh3VC5wpb7UH0mDZ/AJsWkowdDdp40jPlQmeHt3V/d8GQe5k6g5/+c349iGK/9DD0dPgy",
-	"5KrMFzuRHw+mcNWq0cndW9jcrYv1PCxQr7NX6+J51mPHEekGLX0oGJ4qK7cavfvWmc0dC0XqVte98PrQ",
-	"HAR3E1J8pr5u9Yeu6tKr+slf3/Icoz64vnl4yJacey7OaUbX2cTKGiJ7cpCUXpUj4ZLmbizC1gbI4+0N",
-	"ZAxQJu/Dp+Wx8Zfak3O/UnD4kJWcbDaDDOXtzRqqLWVRpg5rYbAiep9XnPy5ysDm9mu1VwvbztXE2/PO",
-	"8kGKTbNtePLsGMNOzFnvR5CleNZFzJ5CHKpfS+PcTOzV1dqSLg2FVHtj/RBiyp4tKV5lU3slxidNjGlK",
-	"o3cr/f+X1fBhQmNgZA6rJn8b169zYCcZS5BvhOSgy57mC924ZVReZjmDQLcOzOtsvlG2JX0oIR87gScb",
-	"+lmzSK7yWsc1Nw0gcXWoQ7va6k2/bPemj4byW7zNMkmnF24WD8NThl1OY+kOwQ3ECZtSNg1koktSm6sY",
-	"oupkTqzXsovTWvkkWoXQ9MnKQ0soOVlfgmJl/KMnmPTIHjcRuowfbybkJ00th+EFP9VzXpXmGWK352Bk",
-	"U151x+pPaydOnALK13s+O2Ukk/+4i01J0reL7Ifdd34KisF9fltLPftuoe+GD+49L2uRzuLkdHRTiRcN",
-	"1j2VMwStrcLbW3g0K4/WRrtg48LpKXuk6ikpFg0le9TDVGvLXq9Qao2oCCShMd64kJHPuDh+F0tqx7ve",
-	"lbJIL4KZzAuljm8gOYW7zvTx9A2O8vVajQe4BJKtUqOD4BaWgyDhgdNO07tfeo4baLzn8Hz/hsReY/q1",
-	"5oI9E7VPc2HfbJodiEIk/F8uglux60YGxjwrqdXSodYdyvSPtbN24S3Py0W/6ohMl3I8PG+5WNckFcBR",
-	"RImXe1C4THGW5E2dtleX+EmUPsHVePIu9F41nxFKLY4HZ+LrsXl+k7Sf7Cq/EmNuoYuGD+79mC2cXQtH",
-	"hU7am0Ob1Z7cvGSIuVM0erGlQbKF9Yj+BoOljiZ27Is2sfurmvet00Fq9/JNwW0KiNTR5qsPeeCadJ9s",
-	"VaawHarm4ThZLA8jWaeCTWvsWTW1vniwBx57GjyV3TPx0mttKvKw11oTpi/At5Fyeyn+IxjHQzpfJFwe",
-	"NA+mVSw40nPrryzPezK+nXIUwd5q3iajr1vRG5uo5dxIB8lvAsv2I0i+Ute2GjbWLf+9smK5JBJKdy47",
-	"AAvJiYTpsniBoQCel/vHD/hL44XFdvrZnJwXXPdQUaFDNYQXi9Rd13bS99VnYctyWFPPtL6JknU1zysv",
-	"Dqq7nYHB/cdeL0Iw+wmVcHaIyub6T4vbwFzI9sJsSy2PA8XmQcoi4Hr791HVnSWMlpsVWi13Kp81kjB/",
-	"cdWzOEy65b0fYF2uhisOXjeIDnaDCJff9XX13RcHHUCqrQPGAjVDv+zqeSNm0vNFhP1cbLjvKNSaLDl8",
-	"dt1k94ch43nYrm+FfzQWdy2U/oeLPwM5IzKYkbINQEQwASJTDsLPN+JEfLj4s7POfyS13Kw4JfyUQ4Oo",
-	"rY4RnQT6WUAZotQM8WK1TBeyKnCCU9deUdbhqqPe+XkKiZW3DTz9CRIUpFvxtRnkcHm73ZHBOoVlUeDl",
-	"d4vk/PKyl8npXYmtqPcGoUXyK6dbTh9qr/s57BF1CDWo4WP46YQcKMu45iaJlnVRh/MEa3a9Rh52EHno",
-	"xyGyFF1fvoRFFGllHxX8X1Bc5JxMKcPE8QIjvgZF6uTR0SM4bcMH9a+hLpd6+d7y89TLuxfjMhe0PpMa",
-	"XJU+fFN6ne7oV2Q6u66bBttUD1zvNcrzlMnhEYRNTgTPJPLa3EkLtdaZfnVs0vctYVigo02NoLP5DUTR",
-	"ZtfPvwZ4XzTrl+n60eyI5tLHuP3xPCofqzc8y8LHmnCymo3rIdMDK/rZjwrxZqE6xPxaK/k51UpuzwG7",
-	"F6Vt6yc7xHh45ZML6H4unturFHWlaJ/llh1af6227FZbfl58ZOalVjv4sE9ZfFBFbx+DVdfCO9Xm0SMU",
-	"eG70A7GkoYYDomcSHLQldnef3mODBj80vo9aZgXYbtkJHBGonsHN0uZcYJKvPj09Oi2fszT99TGA90tM",
-	"VT4RJklgZ8SkxjcnD5qSzV/wceDOa5slnBdWNRyEr5vxls+6sdeGXPXKTU+Pmzoz0YtmHo0tSYYP+pMy",
-	"z/L4+NNHhIW6yXjCGH3PUaXbygN3saSLGPyH7XIXx/dUmutJay00xah4j+nevajCibSDOE3da2DLzCeX",
-	"JWYraEOLsZoZhw/4318hyEPpewtZ4dvbBqyeK3nYQFw1eQwOT7Q2d3BJtDHmhKjoOdr0KpBfqkBObRGY",
-	"aoG8Wv03AAD//3QgftFhxQAA",
+	"H4sIAAAAAAAC/+09bW/bOJN/RfAdcHeAG3f77AMcFrgPbpMW2d1ugiZtcSiCQpZoWxtZ8qOXJL4g//1m",
+	"hi+iJOrNlu3YyYfdxhJJDYfzxpnh8HHghItlGLAgiQe/PQ6WdmQvWMIi+mXHMUvO3Ut8iL9dFjuRt0y8",
+	"MBj8Njg/tcKplcyZFTOfOQlzLeowGA48fL+0kzn8HcCA8EuMBQ8i9q/Ui5g7+C2JUjYcxM6cLWwcP1kt",
+	"sWmcRF4wg5YPb2bhG/HQc0/GNMTp4OlpyIerAOxqyRxv6rHYup8zgC/icFmundiWHTGLLSbMdQFeLyD4",
+	"IxanPiBAAP6vlEWrAuQDHc5/j9gUXvzbKEPeiL+NR9T6jD6Ak0BYodUC2nRBpOhiRqUabxNkfhCDcHQC",
+	"tnz33L2I/mCrGigj65atJLDUR6JwEbrMjy3xeSPY+jfWhpy3OvlIY53ysXACsyhMlx0nQH3kBJZR+Dfg",
+	"3gy6PvraoNMgJzrQXsIWXagC25sB5CNtQg/nOAInhr/DSReooLkZKBpnE5h+hwE4SLBq92FUBZR4a6lx",
+	"TGwsGg2qv48fIjruSEfUpxUd6aOvjRgaJEdHS3vGKoD9GsMKJaFYLQ4htK7AkXiVweGyqQ2icfDbL4Aa",
+	"L/AW6YL+lmsUJGzGIg4Eiy57g4OPZQbln28BFvtBwPL2bTNkfEkQ72Pfs+PadbWxhVzZ2sUsDrv2goqB",
+	"aEn5SAg16Jh2uLRBf00R9DuYrxmfqK+MuBz4dsJinCALEIE/sgfLdOJ7zuBmaOASHMlNfdZFTMg+ZmRm",
+	"I24iMK7kKKcKzIXdBkhqmNNl1WDiiJsCCWMIEMMoOfWihpWG9fICRsCBCAODxoUvO9hIzgBMGLBEYmb5",
+	"XpwMrXvP960Js7xZEEaoOaZaZy+2gjAB4mYxWADMrSAa+EYF0SCQGsnY9IsemqkF5th1gqZpVcCJw1cA",
+	"6kQMyNkd6wSuP0uXrvjbCPg9m8zD8PaU+R7w1qoLtYuuMDHe10xOrhp5E4L6XgDzVAd+DaDNsKrxegBV",
+	"ghhGt/HSdtgaoln1rYC2NPTaYNuOE6ZB4oYL2wtOvquBNWlN4ppTKm2c/gqTj9DHPYuiMCpP55ooG8g4",
+	"RqxDzzCNHJiSzRlzil0HMObXwE6TeRh5/8eqhho7DotjYKFbFiBjL7w4hikgurzgDjDmapKQYPvEwt+v",
+	"Lv7SZh1OhJLTZ43/xCeyMQyB/14VEVbTVWuP+zWC05t4vpesaKcZhaDlE48jzF56YMzQn2jKxo27LGqP",
+	"A8tFiiKbfnO1ZXP01A+iNb1iSQJoi3GEO0+HU4qNy6/v/zz/ADO9/HL+bXx9ZpYYGYX90McZqglmvQTq",
+	"nuS7MlJyK10yVoHs3Tab5stzGBwZjqxo40CcZwwvNkVmASOeKxmUw5L/gBE1tPcuk0vkzEHWnT0kkU1q",
+	"8CqxkzTW12vJAlduBn5C7xmwGcoAF+CGf6a25wNQ5UXE7TooxSC5pucGpGQKBN5Ow2hhk06EZ28Sj+ZW",
+	"6jKFjzUhkNpg29AHBXjutnWIfOTtT7O+lygJ1ycY6WUBu5olNjpNCOWu6yGmbf9SWwouR0vLVk1QEbvz",
+	"2L3ErVwsbyH2Avjvz/gOwZqxkP//5z/cn9eAnFj8XNyhwCEjDV7Bn058h7o8uA3C+8C4pMpUbzH/S9H2",
+	"NGMArdckDH1mByR55uliEgAdfY18s4z/+uVPqa5UY/mApsodU2CuzT1njuIb6DmIkaZAL4TcGp36qxMT",
+	"TSVhYvtXoBo06ACdE9z06JZNayJN+TTqhRqxcIZO3mtYYVQZNhEF1s4cZRo12D4OiaRFjOrHzLiodkb9",
+	"ZQmxBpN6a7FcNanbEXfSrTHosoqDYYak4bk8lgi7j0BporGOFkoC/9EPYAb414S5zsRRI8eF2dVkVWfr",
+	"Ll2bZaFOtk4zwuyAFD1vXpQkaSw2wbD9jwAVYVAn4/uS761IR/O4omgNUFC0t3gE2j7zfibLB8B21hrz",
+	"i+hoHnTpr67Dzg7lqmWX8JdWv5KPEjuasaQ1WfDmFcq7BjCFhH7psjQdtgj/9lqDFnClawAqcDsssxzG",
+	"sMATO+YKLj++cNY3Gy3Q7Ir2jyHpHRzDTvhORfIkSA4Q4igywuSM/23iSditpIgzIypQ7z4rKEsGQUFG",
+	"StC0j8nOJqm4SP3EW3IrcXtz9ALHT8FOGQcrPtHz3AP1mhSw/hoe1CJD0mGJwDbDSpD6vj3ZNlbYYpkI",
+	"fJzRn8atnQE4Mra2CtqMdFF0PQcKGw7ABo7Fn9qLi4jI9TrUWmTP2tCwNBs3WywO+eYSKQaTwrMNJrUT",
+	"hhHYiuigpjDuDz8MZl4CBDq0fOiDf92gE/f7p6v//pXbqdq6TCbhQ3nQH/cMHaZxCGJ7aDEbfwRhlMxv",
+	"hlaK3snJyqKew2xqmVEQphNfswgy+3thP5zz5r9SfCL7UZxun+QiAQ0wgg8tSZwx22wFLkOPW0AFhJiw",
+	"muGChlsXF+90XLwzOG9CfzXj6i4PFHuAGXlhZEXk3BLRGd46gy03bwXhdkAtGUq266Vlt414jlTJ8ypK",
+	"mGyEqpkrTdwo1nmr0gmtaNhtCKX5IfsVgxWWxN892hqwwJV/Qqsr/RVKXPm2jaCq2I90FFRkxW8VMRMG",
+	"q4pLaU/5xow/uIguAvlQ/B1Or+de/J2xW/XjM+Bxrn79bxX7KtyssYHrhDCT7nMBdUvmUhpGGZWU6dAy",
+	"5+ATpVactt5FicwPseGJ8xvhCKzrOwpoYYefDmjCmYhvcV84hVUZbMwd9hOI7yd3tbfT99O9TrbKd5uI",
+	"jUYdzdLK0o6kyczOU3cdj7SHPCk6+1zpLwAJeQo0q/38yrfvi9D1psIvLFroj0SrmDt95cpwfyUnybYr",
+	"ajItnbnnu0Aira0Z6bktqoQmR3KN66jC/ROb3X2muSmy7BZTIFS29yDwfznODRhoReQaU3QPUKzr2G2X",
+	"zBer+JUK/HeM75fcZloGge5GFd40fXlkjETl1Im1Mak0ygzrwwnacf2nG628zDmD5vFnLdZQ9rXLSMTH",
+	"nsCT453zvLkuoIocLiM5hpE3gw2Kv8awSi+55yXDtW6KtPKGGa7j4zWS1ak3nZqEJKrWbmDS4n2gjiaI",
+	"p1G4aNRkYEEDb3Ak8ShIxy5V09SBK3vbMFv4GyrOuNbbATI/jdDt/00q2eGu7AOKrIVprH1ZWEOt4C4q",
+	"aZix8uSTXPJh91JWqwgRdnlzZ0cowmLse55HJ+q/MQ1nePFVfsHw7lR81LhmmHVapkpYfuzTSeStISWZ",
+	"TMZYLxIg8lpJA/C4dGkm8AsTJpg+nMppFME/0ysTqtZWkjwvnhMMbem6YSlWQfk6/oSFFNH7ltYsdJC2",
+	"bC9CLgOgRfqAorAshwAe2iC3fWM+gZFBfpdfvFRfUY/Og8vsa+rpB+2z6uFH+f2sWQYIn1g50L7k2XLs",
+	"QfwxSf3bn4LPW0OPw57LocTvs4f87/cwsODuG6k2ezFRekmL+UxavNbo9O04+Uy7Ds6F7aCTlsVVRwMz",
+	"36+joXmwFnJPLCzztMuKu5h2VptUlmuMoTxK7+spRtwL2eZWyPccFsQd95YAuFv5ilISv4R+B8NOoP5L",
+	"1rcXazSXHLp2hmZt5kJ+ryWRqTCUh0ASQzGTsUvmgwFVmmj+cjY+PfsCg3z/cn5Nf3wen/91Df/Rj4vv",
+	"+K/Rj2/IvCvbE9SIEk1i8xaLtyC5mF/99pK0NvSXG3+YB8iELaC5i+gbN97L8yGKfOxwamE4uMvGUhSY",
+	"pp7bTsSo4wsGGdMmP1L2H6tUi17tTvbAnHQbWSv6kQp5Yq0TfWRb3NImvGonXUdjG+kznMgWTFg5dGbH",
+	"9qLVCiSjW6dI5fGcsh7l3+2Mt6vcmJdqnPzzr9moGhx1VnIvdvFV7kOZcZx/rtvC+TfKIC50yFnFmV2z",
+	"a2dZH85S3XRamxUSL/GZit209TFUkaicUgmjfYU49NyYst6qsXSk8jFm73aLm5jsCBpCemnVWw1eAZwx",
+	"BhnZ8Vz4pEqIg80ZDBV3kuVioG7OMw7EZlIqQ5ImGBL2gNoY/xkDXyF+PGd+zZ8u7OjWxVRtkBBz5tzy",
+	"pAV5zF74gyjhCwiMYlEyo5PCPiLqoblMVRoy97VhEqDIIIddVbS6kIdU5IMz18uHzY1GQsGAonTeioQC",
+	"sxlhyqac1ulOQ4/NjJb8iqOt4vsXQB8/WvmUW1ETtSvZZmu5ssv0WbQnCxO8KZ/RM5hnSYIJXu3BKIw4",
+	"5v2NAb41LLi7iqRw+a4yWthKmhqOAmIGEHAhn0UXUJf2yg9tV7n/WhhCBdRtYA9VHUfQzjV2Pm5YYo+K",
+	"la4iob5sddysBM6qnCv0J39ByUKeD/YXw0S5eDA0eIDlecP3obsynz5hDw6LlknpKO0Ee1Tauh/A1G7r",
+	"WDYvt9E+jFPYNjOX8RQMkMH8sQt77ZbG4XfTxzIb0fj6SvuqscGXDBTj+1OCj05Qwv4qgh0/GWAioZCB",
+	"TojGKQ/WEw+QkUGPMwzPk2TJz4h6wTQsr9UXdmZHyfzNh89X1jmdW6C9vDW+PB8IW62xlVITg19O3p68",
+	"FXlJgb304NE/4NE/xFENAlzyaTx6VNz0NBKHkWVaC0tMoCZpFMRET/LssuWHlJFnqzPP8oRTBuXQChjm",
+	"WVpTL4qTE5V3BK+Qj4u4/5Mf9taLEVVorKzJKCuCUaW59MZ6sYqnm8Lx3Xdv3/JokjokYi+X0scy+lsk",
+	"HVVtJPJ4XEflGM+12jwwWVfrQhXhaG5IAaQP6DXLtX07bMH4T0Vv5mBs4Wlfi4DF5S+cfkdUQJ9fOVoL",
+	"R7t5ZpY8D22pRbJ4ohL1+6UKgWrZRuWz0tTz1/IX/8qOWGt8TSSmc/SPG6SLOF2AubrSaN+mWgQ4y2x2",
+	"Ofrn9qcus2LaeHYj58LxfbJ1mvh29JgVFHgC3IhfxcJe3T8+bNujWCoBUbgMYyIyI8t/UUBuyIOd2KtM",
+	"wagwQUZl9RoOnl6vQDWKOjtkx3EaVVI7CXkZBcGp9sz2AjPlIuE9luspPEnXf7O6yFhGdSlqAOGf+Oj5",
+	"yea0UNy59So59Vl3iZCYhPp2pbCCdJ+0XN8zXygDev3TDCfAFNi+FbMIiNfipvWG0lti50Sj+kuJsTXk",
+	"dUUtkzbGyPqWS3P7rPhOy8aqGlGL9rmybNWyXqD1A22XByrQKLcua/J1dXS2KdR6MIFTM9fny9g8lYTl",
+	"L70pTiW6KoUNh0ua/MIhYmki7/kp0e0JGU7gIGOyunEGydKoT0ePxdJyT2JfgelwVdwlsuV6VZ1dcxLK",
+	"XvKbFnrrOqu0RzWQ+ERdi3wGcTxNfX/10kiJr2YTKQ2b7C5ZWrDK3vpELvetGd5d5ccLlRvG9dqzTWKs",
+	"mUkq3k6cuaGSH3l4W5CcyvjtyQrYUobXC7ch9icDRKjgGcoCQcDWTmSCiZ96MiZ4jfI3vFhYi92774sC",
+	"6qJHQVpb914yxwdeZJGLucz6WoEj4dvt0UjR5tGudqBWN6olt+QRk0cGVSY9Qu9mbpY69fGcPcWfz0Ut",
+	"iZ1nHhvKIkffnj4jyw5wVXDSuZ0Lf1tLwT1vY7dQQKxghlckoZTLlvYp9nNcVs9Dh889ksyCHI3VMs36",
+	"gjuu2wzSd96jiabtB3uxs3hZyE6pn1opyd3bJMWd7DYhN9D2sSgGV2xE+Zy8wJqQ/V+k7Dab0ZxmibnZ",
+	"MCWHPxZ1QYFMtb/hR5X8ldGBbvpmqy7QrTpv8/7VYSlfxFt4CY/GC5yKqA6MFMtq9kLGmmunq2KzOvdR",
+	"yc2u9/Lo2qdM7LyMmA6pgC5OJ0WzshbgCOk29u5YK4i14mzdcHc/D2NmyTNZ1gJpnsVDi53MTtTjH7A8",
+	"N//D+ZVO1PmUO8Nr4pqAV/V0a2E3V9qtzhXUa/6sfF5Wni0vZI5cv2K0U0IfT+k8tGSGoxLfVdL3iAz6",
+	"CkueXlYokp5NeNHtLHBCWcu4fFZgg7Le8a23PGWIQDwX7MkCaeKWCyFAslqJuaLceso7XhJgBK6y9HO5",
+	"FBRPbrejZIRpkG9kyY5NUNNUJ0eW6VF5lxMvsCNjDuPWsWyo+rn7bVW11DqmrZTi8G3to0bpEhNj4jXy",
+	"o3Yn8M7jOCV0YDF5FHW2uOwDzBUOv9p2Vgi7r9RqDyJPtPmTBbNcPStNczfetQDGXkUWdYXfZBsMuZep",
+	"Y9a+2ezbXIxXuGM4PR2+DPla5outyI9HcanpU6NHZm/Bef3O1OOwfI2eiVp/hGE9thz3btDSh4LhGVrX",
+	"1ejdt85s7pi7wJinUG/M6/LWBD1wZtpi8Fb9h7v0z3e55WGTWJf85pFsihVdq4mVNYR6c5CUXpWJqZPm",
+	"dizCiuSGXm4R2W3iguKbMlccPgs4YpvVngv6FZ6jR3WLebP1JIhib0ZUI1Hml0QaJkEevccVCzpW0dnc",
+	"XhGuISeumnh7TnurlrbPWGyKnKbx0TGGnJi23juQpbJGzzNwX/VroGRliV53aBvRpaCQ6k1cP4SYFaE6",
+	"OlLMKmG9EuOzJkYsivPLE//3HfyLQSN0ET81bdNp/Tr7g0InYckb0LmM31eeLXRjlKq8zBi25K0t8TmZ",
+	"C6Ei6IfiKZITeLYeo4JF8hWvltdxbbxNnoirwwXyTxt96d1mX/ooKL/F1ySTdPrgem40Ks3V5ag472BN",
+	"GN1HFsxkfk68ZA7VZK4+aUJl9LdxlDybRCvPGy9HdnjFPApLIFK1FLqf34mMYLentMr4MR7T+MSp5TB2",
+	"wc/1EHqleUbY7dmH2XToq+P9RKY8fD7IzZ4PdgvJZD6LKzOoqNGe2H3rR7Qxu4Qm2My+G+i70SP9i8//",
+	"YKuCpzM/Oe7dRPHCwcL0aAKtrcLbm3tU3VrTRrtQ49zRbnne+zkpFg5lsNOT3oVlr1cotUaUyxLb80VV",
+	"KkE+Tn78LpbUloPllbKIL4KYzAulDixQ6LG7zvTx/A0OXTC2PF3O0/VRjQ4t0KJDK4wsrR2nd7P0dBpo",
+	"vGf3fP+GxF59+rXmgjywvU9zYd9sqk5rExL+IxPBrdh1LQPj73ACz+H/pQhqeYlot2rZPtYfWFnqbjG8",
+	"hRo5CgahC9URVVGIlfKtie3cllnl93DCi+lvUx/gzW5Vlqms5G9Ro4PPdabpAHFEaRCgiwNnldEHYLsm",
+	"jw3eblkxVyzE+DiQn23nK7D+/FUoMf9GyW0Ldd1PS68c71CWDHRDyzZcbovyhUR1NMuvmTv0+rkpaCKL",
+	"V75+saWQyhQnOVTcIfXqV3sWxR1pNZ69H26v5rMQSi0KICnxtWueXyd3UEBrYMwNdNHoUVwL1tZjJuGo",
+	"0El784qpO8vWL4pI87I898UWP1QLaxD9DQZLHU1s2W5uYvdXNW9ap4PU7rqsal8isY42Xx1RB65J98lW",
+	"ZQrbomoGjCxXh5HxV8GmNfYsTq0vHuyBx54HT03l1ZUv/TYBJA+L44/O5HP/Kg+30YWFzN2FcTzyAAlR",
+	"ctA8mFax4DmfW3/1697bzi2654OKG0FFWnC36nAy2zO782zGwt9julKcQDJdMinvoaS7T/+ovPU0AVl+",
+	"VVnSCss9Ac7YbKV/3QNGiLKryOkPenIzbMhOkdNXc9I+cNNDJZhOVVxeKFK3XQRxFoQwtHJblt2afKb1",
+	"TVDW1bxf61bvgN1/7PUyZRGUrISzg1c2039c3FqxutbyJdmWXB5bVA8PPgWDUA7JTtWdJIyWwQquljvV",
+	"mcSrZ19cmUl42+3wzPo+5L3VImy4XPg1QHSwASJafn2vy2+dPmgHUm3tw8DCGZplV8+BmGk3pTytUsey",
+	"8unHXsbbtxeqIEsOn13Xif4ExHgGtutb4Z848V0Lpf/h6htYJHZize2yDQCPpgB2Cngy8008jmGAXV46",
+	"3EUtNyvOhD0kI4Gojc4iji3+DtPiEaViiBerZbqQVY4TtJu7kLIOVx31zs+wU5fytoGnP8G2HwXpRnwt",
+	"Bjlc3m537rhOYUkUGPldIjmrI/4yOb0rseX13nAgkfzK6ZLTR5PUv30jnGZHECjKE99VYkcJTx+17uee",
+	"Q5UNvFnATykQU6hLBbgdTREC36cnnKToYgE63EyN0O9wYp3ZMJbMqsd21gyrQPAza8L2PLHErZkzLFZt",
+	"efD6zvaoALg1jcKFzOs2S8X3sC49B5r72ilwJDQXGQr4JQklj6uAYz3n6rtt506Tywzp5lhS2FXEGWs/",
+	"yBChusqDE7k4bjbJ4i/b3zSMMjo6LqlT6+XE4X32oHk7AfeShibA4HUOz8uQSpW+Oj234PTsR8J2l4y7",
+	"DjC9IJcsfBM21SjIc4z46o+tk0cnuxD9SWTzUmQtw0TyvnEBOJlyjh1YEwaLFScYMrWAaDwy3FZ0Um6Z",
+	"RjPmDtEiw7WcelGcmGXrNQIjqkDvbP+5z0ALYf9IwixiLs+Zs/vgVWGg0WyPKZCyHckyIuY/PsvykkUL",
+	"G0dQJTkqyKNCyF0SWvqydvDT590u7URIKu7szB3AECPf7MU86m9OjdKMinaRouJeBLwD9QiqSMKEBGEq",
+	"RwfX+LsyLUbCKjjGzSVNzIhedH+qQq9SJw6tJAQraw50Im+BBxKasogFjvJ+LYRNdQ+PLcfHxXWtMOBC",
+	"Bn1Z1TJFAPQqVXa16WorV5RhfCSmUVRD+bsQLI+cfmrvQsCP7+04o2SDbhcgeILIjuPeg8qUh6bTiLyj",
+	"Wc7xw4jd9Mi6vqubvSbFPGdy2IGDJCOCI9lfNXfiQq31wcg6Nuk5XMWLIrepy362mDDXVVfRvubDvbJ+",
+	"1+BYNedvzY5ovqWOskWP45I62t0e4x11nHDUPTnFDLMDu2ipHxViPLSrEfPrtXav19p1YJztS+C2V91p",
+	"NHx4N93l0H0sG75X4asL3z5vxtNo/fViPP1ivOPiIzEvXG3rwz5lsetNp40ZAVqipu26GN6P2CK8Yy6d",
+	"GXXmdoChFPIN2jIPE0tQA471hDf0ettBiE7xE+v7nAXZG8wqDkLV1074N3FEGGSokkMlFBE6WhE4hEFA",
+	"Zd4en+IES3IoP0/5Wc+VUP+nj1W1k6FFbn3nvxB0pBT8MEIlbw0CMqPTI/LaIP5qgzuKuoGShEOLv8aK",
+	"xS6b2qmfVMCWhIPay4u2yOCeXIcKnzmnIJUcKXwJh29zyVW6V5TNM51r3KUvYrOyPXF2UNct7sLyKDi5",
+	"qzeJO7hatNEbxuPyehz1eC533NGZ0IwVDj0Wv74HxHw4XD8kkrNK7udhrCwLICfbL5xMyZXzyB0zEW3E",
+	"IRdxUQAnzGnI64AAmUR4b6UsigWNXLDLl8J4UlaMF8jn3PVttmY2D/vXMSi8uoi+8QluPzyfm25rf6je",
+	"yxRAID7rKIjWCe0fV2SfTHMAFeAVyNmurJJhnp98SU5aHnuV3RQ3xRb2RONXfJmYipcHPj8tFxIV/Xmd",
+	"q/crqsUzjsUp2K0pPhxflNZqqqb0guvddl5bVVEpt6rw4vW0qeSzbuy1Jle9ctPz46bOTPSimQex5aY+",
+	"qw4KX4kW5gMrJn+LKIY3bEnVEoQrUUOv56MquRm2riOIPdaLPBcPjGTfP8LDInJyrvDR0ZLEBebis38O",
+	"jFXpB5Bg9hwpth1ZZb0NvY15a2HK95STPFQM4I6TXAEg9MO/STy6gLi+ZKkEZygnlB/0Zs9RlIxf6y8v",
+	"1vn6sBlRkqvITYYtzET3H8EGXEvLr+DGzdTF6FH+2RDCVpy19UsCG8lA3RR4PISgrgtcsgATErOpVYng",
+	"Wh2/5VtQ6lZofESrkjdGa5fj+ZuaGZ9vbGiC3rG54FjY6KjNUmcPAw0IdZMlQe65ns2I28rS5Vhu3Wfm",
+	"suWZAja95U8avIXIVtfYcO+ZErna3gdxL0WvyWtiPpkUEb7jDdS4mRlHj/Sv+a41A6XvLS2Nvt42Ke1Y",
+	"yUMm21WTx/DwRGtzB51EG/PKCBU9Z5S9CuSXKpBTWdysWiA/Pf0/ExxNHkMZAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,59 @@
+package integration
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+func (s *Server) WebhookDeliveryList(ctx context.Context, request WebhookDeliveryListRequestObject) (WebhookDeliveryListResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+	if op.Integration == nil {
+		return WebhookDeliveryList401Response{}, interfaces.ErrInvalidOperator
+	}
+
+	p := fromPagination(request.Params.Page, request.Params.PerPage)
+	res, pi, err := uc.Integration.FindWebhookDeliveries(ctx, *op.Integration, request.WebhookId, p, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return WebhookDeliveryList404Response{}, err
+		}
+		return WebhookDeliveryList400Response{}, err
+	}
+
+	deliveries := lo.Map(res, func(d *integration.WebhookDelivery, _ int) integrationapi.WebhookDelivery {
+		return *integrationapi.NewWebhookDelivery(d)
+	})
+
+	return WebhookDeliveryList200JSONResponse{
+		Deliveries: &deliveries,
+		Page:       new(Page(*p.Offset)),
+		PerPage:    new(int(p.Offset.Limit)),
+		TotalCount: new(int(pi.TotalCount)),
+	}, nil
+}
+
+func (s *Server) WebhookRedeliver(ctx context.Context, request WebhookRedeliverRequestObject) (WebhookRedeliverResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+	if op.Integration == nil {
+		return WebhookRedeliver401Response{}, interfaces.ErrInvalidOperator
+	}
+
+	res, err := uc.Integration.RedeliverWebhook(ctx, request.WebhookId, request.DeliveryId, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return WebhookRedeliver404Response{}, err
+		}
+		return WebhookRedeliver400Response{}, err
+	}
+
+	return WebhookRedeliver200JSONResponse(*integrationapi.NewWebhookDelivery(res)), nil
+}
@@ -5,6 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"hash/fnv"
 	"io"
 	"net/http"
 	"path"
@@ -13,8 +15,10 @@ import (
 	"time"
 
 	"github.com/labstack/echo/v5"
+	"github.com/reearth/reearth-cms/server/pkg/mvt"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
 )
 
 type contextKey string
@@ -28,6 +32,11 @@ var controllerCK = contextKey("controller")
 const defaultLimit = 50
 const maxLimit = 100
 
+// tileCacheControl lets clients and CDNs reuse vector tiles for a while since they are requested in large numbers.
+const tileCacheControl = "public, max-age=300"
+
+const mvtContentType = "application/vnd.mapbox-vector-tile"
+
 // maxPayloadBytes caps the raw posting request body before parsing (256 KB).
 const maxPayloadBytes = 256 * 1024
 
@@ -61,9 +70,11 @@ func Echo(e *echo.Group, rl RateLimitConfig) {
 	// /:ws/:p/:m.metadata_schema.json
 	// /:ws/:p/:m.zip
 	// /:ws/:p/:m/:i
+	// /:ws/:p/:m/tiles/:z/:x/:y.mvt
 
 	e.GET("/:workspace/:project/:sub-route", SubRoute())
 	e.GET("/:workspace/:project/:model/:item", ItemOrAsset())
+	e.GET("/:workspace/:project/:model/tiles/:z/:x/:y", Tile())
 	e.GET("/:workspace/:project", OpenAPISchema())
 	e.POST("/:workspace/:project/:model/items", PostItem(), RateLimitMiddleware(rl))
 	e.OPTIONS("/:workspace/:project/:model/items", PreflightItem())
@@ -164,11 +175,14 @@ func Items(c *echo.Context, wsAlias, pAlias, mKey, ext string) error {
 
 	w := bytes.NewBuffer(nil)
 
-	err := ctrl.GetPublicItems(ctx, wsAlias, pAlias, mKey, ext, p, w)
+	err := ctrl.GetPublicItems(ctx, wsAlias, pAlias, mKey, ext, c.QueryParam("locale"), itemQueryFrom(c), p, w)
 	if err != nil {
 		if errors.Is(err, rerror.ErrNotFound) {
 			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
 		}
+		if isInvalidQuery(err) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
 		return err
 	}
 
@@ -196,7 +210,7 @@ func ItemOrAsset() echo.HandlerFunc {
 		if m == "assets" {
 			res, err = ctrl.GetAsset(ctx, ws, p, i)
 		} else {
-			res, err = ctrl.GetItem(ctx, ws, p, m, i)
+			res, err = ctrl.GetItem(ctx, ws, p, m, i, c.QueryParam("locale"))
 		}
 
 		if err != nil {
@@ -210,6 +224,57 @@ func ItemOrAsset() echo.HandlerFunc {
 	}
 }
 
+// Tile handles GET /:workspace/:project/:model/tiles/:z/:x/:y.mvt to get a vector tile of the public items.
+func Tile() echo.HandlerFunc {
+	return func(c *echo.Context) error {
+		ctx := c.Request().Context()
+		ctrl := GetController(ctx)
+
+		tile, ok := parseTilePath(c.Param("z"), c.Param("x"), c.Param("y"))
+		if !ok {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+		}
+
+		w := bytes.NewBuffer(nil)
+		err := ctrl.GetTile(ctx, c.Param("workspace"), c.Param("project"), c.Param("model"), c.QueryParam("locale"), tile, c.QueryParam("geometryField"), itemQueryFrom(c), w)
+		if err != nil {
+			if errors.Is(err, rerror.ErrNotFound) {
+				return c.JSON(http.StatusNotFound, map[string]string{"error": "not found"})
+			}
+			if errors.Is(err, mvt.ErrInvalidTile) || isInvalidQuery(err) {
+				return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+			}
+			return err
+		}
+
+		h := fnv.New64a()
+		_, _ = h.Write(w.Bytes())
+		etag := fmt.Sprintf(`"%x"`, h.Sum64())
+		c.Response().Header().Set("Cache-Control", tileCacheControl)
+		c.Response().Header().Set("ETag", etag)
+		if c.Request().Header.Get("If-None-Match") == etag {
+			return c.NoContent(http.StatusNotModified)
+		}
+
+		return c.Blob(http.StatusOK, mvtContentType, w.Bytes())
+	}
+}
+
+// parseTilePath parses the tile coordinates of the path, where y has the ".mvt" extension.
+func parseTilePath(z, x, y string) (mvt.TileID, bool) {
+	y, ok := strings.CutSuffix(strings.ToLower(y), ".mvt")
+	if !ok {
+		return mvt.TileID{}, false
+	}
+	zz, err1 := strconv.ParseUint(z, 10, 32)
+	xx, err2 := strconv.ParseUint(x, 10, 32)
+	yy, err3 := strconv.ParseUint(y, 10, 32)
+	if err1 != nil || err2 != nil || err3 != nil {
+		return mvt.TileID{}, false
+	}
+	return mvt.TileID{Z: uint32(zz), X: uint32(xx), Y: uint32(yy)}, true
+}
+
 func paginationFrom(c *echo.Context) *usecasex.Pagination {
 	limit, _ := intParams(c, "limit", "perPage", "per_page", "page_size", "pageSize")
 	if limit <= 0 {
@@ -245,6 +310,22 @@ func paginationFrom(c *echo.Context) *usecasex.Pagination {
 	return nil
 }
 
+func itemQueryFrom(c *echo.Context) ItemQuery {
+	var fields []string
+	if f := c.QueryParam("fields"); f != "" {
+		fields = lo.Compact(lo.Map(strings.Split(f, ","), func(s string, _ int) string {
+			return strings.TrimSpace(s)
+		}))
+	}
+
+	return ItemQuery{
+		Filters: c.QueryParams()["filter"],
+		Sort:    c.QueryParam("sort"),
+		Fields:  fields,
+		BBox:    c.QueryParam("bbox"),
+	}
+}
+
 func intParams(c *echo.Context, params ...string) (int64, bool) {
 	for _, p := range params {
 		if q := c.QueryParam(p); q != "" {
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/labstack/echo/v5"
+	"github.com/reearth/reearth-cms/server/pkg/mvt"
 	"github.com/reearth/reearthx/usecasex"
 	"github.com/stretchr/testify/assert"
 )
@@ -84,3 +85,30 @@ func TestPaginationFrom(t *testing.T) {
 		assert.Nil(t, p)
 	})
 }
+
+func TestParseTilePath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		z, x, y string
+		want    mvt.TileID
+		wantOK  bool
+	}{
+		{name: "valid", z: "14", x: "14552", y: "6451.mvt", want: mvt.TileID{Z: 14, X: 14552, Y: 6451}, wantOK: true},
+		{name: "uppercase extension", z: "0", x: "0", y: "0.MVT", want: mvt.TileID{}, wantOK: true},
+		{name: "without extension", z: "0", x: "0", y: "0"},
+		{name: "other extension", z: "0", x: "0", y: "0.pbf"},
+		{name: "negative", z: "1", x: "-1", y: "0.mvt"},
+		{name: "not a number", z: "a", x: "0", y: "0.mvt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := parseTilePath(tt.z, tt.x, tt.y)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
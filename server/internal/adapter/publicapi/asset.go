@@ -5,6 +5,8 @@ import (
 	"errors"
 	"io"
 
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearthx/rerror"
@@ -39,7 +41,16 @@ func (c *Controller) GetAsset(ctx context.Context, wsAlias, pAlias, i string) (A
 		return Asset{}, err
 	}
 
-	return NewAsset(a, f), nil
+	ms, err := c.usecases.Asset.FindMetadataSchema(ctx, a.Project(), nil)
+	if err != nil && !errors.Is(err, rerror.ErrNotFound) {
+		return Asset{}, err
+	}
+
+	res := NewAsset(a, f, ms)
+	if g := adapter.Gateways(ctx); g != nil {
+		res.ThumbnailURL = gateway.ThumbnailURL(g.ImageTransformer, a)
+	}
+	return res, nil
 }
 
 func (c *Controller) GetAssets(ctx context.Context, wsAlias, pAlias string, p *usecasex.Pagination, w io.Writer) error {
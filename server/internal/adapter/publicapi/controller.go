@@ -34,6 +34,14 @@ type WPMContext struct {
 	PublicModels  id.ModelIDList
 }
 
+// locales returns the fallback chain for the requested locale, or nil when no locale is requested.
+func (wpm *WPMContext) locales(locale string) []string {
+	if locale == "" {
+		return nil
+	}
+	return wpm.Project.Localization().FallbackChain(locale)
+}
+
 func NewController(workspace accountrepo.Workspace, project repo.Project, usecases *interfaces.Container) *Controller {
 	return &Controller{
 		workspace: workspace,
@@ -15,6 +15,9 @@ var ErrInvalidProject = rerror.NewE(i18n.T("invalid project"))
 var ErrProjectPostingDisabled = rerror.NewE(i18n.T("posting is disabled for this project"))
 var ErrModelPostingDisabled = rerror.NewE(i18n.T("posting is disabled for this model"))
 var ErrUnsupportedFieldType = rerror.NewE(i18n.T("unsupported field type required in schema"))
+var ErrInvalidFilter = rerror.NewE(i18n.T("invalid filter"))
+var ErrInvalidSort = rerror.NewE(i18n.T("invalid sort"))
+var ErrUnknownField = rerror.NewE(i18n.T("unknown field"))
 
 // apiErrorResponse is the uniform error body returned by the posting endpoint.
 type apiErrorResponse struct {
@@ -92,6 +95,11 @@ func postingAccessErrorResponse(c *echo.Context, err error) error {
 	}
 }
 
+// isInvalidQuery reports whether the error was caused by the filter, sort or fields query parameters.
+func isInvalidQuery(err error) bool {
+	return errors.Is(err, ErrInvalidFilter) || errors.Is(err, ErrInvalidSort) || errors.Is(err, ErrUnknownField)
+}
+
 func postItemErrorResponse(c *echo.Context, err error) error {
 	switch {
 	case errors.Is(err, rerror.ErrNotFound):
@@ -28,7 +28,7 @@ var unsupportedTypes = []value.Type{
 	value.TypeGeometryObject,
 }
 
-func (c *Controller) GetItem(ctx context.Context, wsAlias, pAlias, mKey, i string) (Item, error) {
+func (c *Controller) GetItem(ctx context.Context, wsAlias, pAlias, mKey, i, locale string) (Item, error) {
 	wpm, err := c.loadWPMContext(ctx, wsAlias, pAlias, mKey)
 	if err != nil {
 		return Item{}, err
@@ -51,7 +51,8 @@ func (c *Controller) GetItem(ctx context.Context, wsAlias, pAlias, mKey, i strin
 		return Item{}, err
 	}
 
-	itv := it.Value()
+	locales := wpm.locales(locale)
+	itv := it.Value().Localize(locales)
 
 	sp, err := c.usecases.Schema.FindByModel(ctx, wpm.Model.ID(), nil)
 	if err != nil {
@@ -66,10 +67,10 @@ func (c *Controller) GetItem(ctx context.Context, wsAlias, pAlias, mKey, i strin
 		}
 	}
 
-	return NewItem(itv, sp, assets, getReferencedItems(ctx, itv, sp, wpm.PublicAssets)), nil
+	return NewItem(itv, sp, assets, getReferencedItems(ctx, itv, sp, wpm.PublicAssets, locales)), nil
 }
 
-func (c *Controller) GetPublicItems(ctx context.Context, wsAlias, pAlias, mKey, ext string, p *usecasex.Pagination, w io.Writer) error {
+func (c *Controller) GetPublicItems(ctx context.Context, wsAlias, pAlias, mKey, ext, locale string, q ItemQuery, p *usecasex.Pagination, w io.Writer) error {
 	wpm, err := c.loadWPMContext(ctx, wsAlias, pAlias, mKey)
 	if err != nil {
 		return err
@@ -80,6 +81,19 @@ func (c *Controller) GetPublicItems(ctx context.Context, wsAlias, pAlias, mKey,
 		return err
 	}
 
+	filter, err := q.Condition(sp.Schema())
+	if err != nil {
+		return err
+	}
+	sort, err := q.SortBy(sp.Schema())
+	if err != nil {
+		return err
+	}
+	fields, err := q.FieldIDs(sp.Schema())
+	if err != nil {
+		return err
+	}
+
 	format := exporters.FormatJSON
 	switch ext {
 	case "json":
@@ -101,7 +115,11 @@ func (c *Controller) GetPublicItems(ctx context.Context, wsAlias, pAlias, mKey,
 			GeometryField:    nil,
 			Pagination:       p,
 			IncludeRefModels: wpm.PublicModels,
+			Locales:          wpm.locales(locale),
+			Fields:           fields,
 		},
+		Filter: filter,
+		Sort:   sort,
 	}, w, nil)
 	if err != nil {
 		return err
@@ -110,7 +128,7 @@ func (c *Controller) GetPublicItems(ctx context.Context, wsAlias, pAlias, mKey,
 	return nil
 }
 
-func getReferencedItems(ctx context.Context, i *item.Item, sp *schema.Package, prp bool) []Item {
+func getReferencedItems(ctx context.Context, i *item.Item, sp *schema.Package, prp bool, locales []string) []Item {
 	op := adapter.Operator(ctx)
 	uc := adapter.Usecases(ctx)
 
@@ -171,7 +189,7 @@ func getReferencedItems(ctx context.Context, i *item.Item, sp *schema.Package, p
 			}
 		}
 
-		vi = append(vi, NewItem(ii.Value(), refSchemaPackage, itemAssets, nil))
+		vi = append(vi, NewItem(ii.Value().Localize(locales), refSchemaPackage, itemAssets, nil))
 	}
 
 	return vi
@@ -558,3 +558,17 @@ func TestFieldsFromBody(t *testing.T) {
 		})
 	}
 }
+
+func TestWPMContext_Locales(t *testing.T) {
+	l, err := project.NewLocalization("ja", []string{"ja", "en", "en-US"}, map[string][]string{"en-US": {"en"}})
+	require.NoError(t, err)
+	p := project.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Localization(l).MustBuild()
+	wpm := &WPMContext{Project: *p}
+
+	assert.Nil(t, wpm.locales(""))
+	assert.Equal(t, []string{"en-US", "en", "ja"}, wpm.locales("en-us"))
+	assert.Equal(t, []string{"fr", "ja"}, wpm.locales("fr"))
+
+	wpm = &WPMContext{Project: *project.New().NewID().Workspace(accountdomain.NewWorkspaceID()).MustBuild()}
+	assert.Equal(t, []string{"en"}, wpm.locales("en"))
+}
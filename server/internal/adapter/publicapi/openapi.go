@@ -183,6 +183,39 @@ func (c *Controller) GetOpenAPISchema(ctx context.Context, wsAlias, pAlias strin
 							"maximum": 100,
 						},
 					},
+					{
+						"name":        "filter",
+						"in":          "query",
+						"description": "Filter in the form key:operator[:value]. Repeated filters are combined with AND. Operators: eq, ne, contains, ncontains, startswith, nstartswith, endswith, nendswith, gt, gte, lt, lte, empty, nempty",
+						"schema": map[string]any{
+							"type":  "array",
+							"items": map[string]any{"type": "string"},
+						},
+					},
+					{
+						"name":        "sort",
+						"in":          "query",
+						"description": "Field key, createdAt or updatedAt to sort by. Prefix with - for descending order",
+						"schema": map[string]any{
+							"type": "string",
+						},
+					},
+					{
+						"name":        "fields",
+						"in":          "query",
+						"description": "Comma-separated list of field keys to include in the response",
+						"schema": map[string]any{
+							"type": "string",
+						},
+					},
+					{
+						"name":        "bbox",
+						"in":          "query",
+						"description": "Bounding box as west,south,east,north to filter items by the first geometry field, optionally prefixed with a field key as key:west,south,east,north",
+						"schema": map[string]any{
+							"type": "string",
+						},
+					},
 				},
 				"responses": map[string]any{
 					"200": map[string]any{
@@ -302,6 +335,66 @@ func (c *Controller) GetOpenAPISchema(ctx context.Context, wsAlias, pAlias strin
 			},
 		}
 
+		// Add path for getting vector tiles
+		spec.Paths[fmt.Sprintf("/%s/tiles/{z}/{x}/{y}.mvt", modelKey)] = map[string]any{
+			"get": map[string]any{
+				"summary":     fmt.Sprintf("Get a vector tile of %s items", m.Name()),
+				"description": fmt.Sprintf("Retrieve the items of the %s model whose geometry intersects the tile as a Mapbox Vector Tile with a single layer named %s", m.Name(), modelKey),
+				"parameters": []map[string]any{
+					{"name": "z", "in": "path", "required": true, "description": "Zoom level", "schema": map[string]any{"type": "integer"}},
+					{"name": "x", "in": "path", "required": true, "description": "Tile column", "schema": map[string]any{"type": "integer"}},
+					{"name": "y", "in": "path", "required": true, "description": "Tile row", "schema": map[string]any{"type": "integer"}},
+					{
+						"name":        "geometryField",
+						"in":          "query",
+						"description": "Key of the geometry field to encode. Defaults to the first geometry field of the model",
+						"schema": map[string]any{
+							"type": "string",
+						},
+					},
+					{
+						"name":        "filter",
+						"in":          "query",
+						"description": "Filter in the form key:operator[:value], as in the items endpoint",
+						"schema": map[string]any{
+							"type":  "array",
+							"items": map[string]any{"type": "string"},
+						},
+					},
+					{
+						"name":        "fields",
+						"in":          "query",
+						"description": "Comma-separated list of field keys to include in the feature properties",
+						"schema": map[string]any{
+							"type": "string",
+						},
+					},
+				},
+				"responses": map[string]any{
+					"200": map[string]any{
+						"description": "Successful response",
+						"content": map[string]any{
+							"application/vnd.mapbox-vector-tile": map[string]any{
+								"schema": map[string]any{
+									"type":   "string",
+									"format": "binary",
+								},
+							},
+						},
+					},
+					"304": map[string]any{
+						"description": "Tile not modified since the ETag given in If-None-Match",
+					},
+					"400": map[string]any{
+						"description": "Invalid tile or query",
+					},
+					"404": map[string]any{
+						"description": "Model not found or without geometry fields",
+					},
+				},
+			},
+		}
+
 		// Add path for getting schema
 		spec.Paths[fmt.Sprintf("/%s.schema.json", modelKey)] = map[string]any{
 			"get": map[string]any{
@@ -0,0 +1,309 @@
+package publicapi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item/view"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+)
+
+// ItemQuery holds the filter, sort and field selection requested by a public API caller.
+//
+// Filters are written as "key:operator[:value]" and combined with AND, e.g. "population:gte:1000".
+// Sort is a field key, or "createdAt" / "updatedAt", prefixed with "-" for descending order.
+// Fields is the list of field keys to include in the response.
+// BBox is written as "west,south,east,north" and matches items whose geometry intersects the box.
+// It applies to the first geometry field of the model unless prefixed with a field key, e.g. "location:139,35,140,36".
+type ItemQuery struct {
+	Filters []string
+	Sort    string
+	Fields  []string
+	BBox    string
+}
+
+const (
+	sortKeyCreatedAt = "createdAt"
+	sortKeyUpdatedAt = "updatedAt"
+)
+
+var stringTypes = []value.Type{
+	value.TypeText,
+	value.TypeTextArea,
+	value.TypeRichText,
+	value.TypeMarkdown,
+	value.TypeSelect,
+	value.TypeURL,
+}
+
+// Condition converts the filters into a condition validated against the schema.
+func (q ItemQuery) Condition(s *schema.Schema) (*view.Condition, error) {
+	if len(q.Filters) == 0 && q.BBox == "" {
+		return nil, nil
+	}
+
+	conds := make([]view.Condition, 0, len(q.Filters)+1)
+	for _, f := range q.Filters {
+		c, err := conditionFrom(f, s)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, *c)
+	}
+
+	if q.BBox != "" {
+		c, err := bboxConditionFrom(q.BBox, s)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, *c)
+	}
+
+	if len(conds) == 1 {
+		return &conds[0], nil
+	}
+	return &view.Condition{
+		ConditionType: view.ConditionTypeAnd,
+		AndCondition:  &view.AndCondition{Conditions: conds},
+	}, nil
+}
+
+// SortBy converts the sort key into a sort validated against the schema.
+func (q ItemQuery) SortBy(s *schema.Schema) (*view.Sort, error) {
+	if q.Sort == "" {
+		return nil, nil
+	}
+
+	key, desc := strings.CutPrefix(q.Sort, "-")
+	dir := view.DirectionAsc
+	if desc {
+		dir = view.DirectionDesc
+	}
+
+	switch key {
+	case sortKeyCreatedAt:
+		return &view.Sort{Field: view.FieldSelector{Type: view.FieldTypeCreationDate}, Direction: dir}, nil
+	case sortKeyUpdatedAt:
+		return &view.Sort{Field: view.FieldSelector{Type: view.FieldTypeModificationDate}, Direction: dir}, nil
+	}
+
+	sf := fieldByKey(s, key)
+	if sf == nil || sf.Multiple() || !isComparable(sf.Type()) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSort, q.Sort)
+	}
+	return &view.Sort{Field: fieldSelector(sf), Direction: dir}, nil
+}
+
+// FieldIDs converts the selected field keys into field IDs of the schema.
+func (q ItemQuery) FieldIDs(s *schema.Schema) (id.FieldIDList, error) {
+	if len(q.Fields) == 0 {
+		return nil, nil
+	}
+
+	res := make(id.FieldIDList, 0, len(q.Fields))
+	for _, k := range q.Fields {
+		sf := fieldByKey(s, k)
+		if sf == nil {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownField, k)
+		}
+		res = res.AddUniq(sf.ID())
+	}
+	return res, nil
+}
+
+func conditionFrom(filter string, s *schema.Schema) (*view.Condition, error) {
+	parts := strings.SplitN(filter, ":", 3)
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidFilter, filter)
+	}
+
+	key, op := parts[0], strings.ToLower(parts[1])
+	sf := fieldByKey(s, key)
+	if sf == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownField, key)
+	}
+	fs := fieldSelector(sf)
+
+	if op == "empty" || op == "nempty" {
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidFilter, filter)
+		}
+		o := view.NullableOperatorEmpty
+		if op == "nempty" {
+			o = view.NullableOperatorNotEmpty
+		}
+		return &view.Condition{
+			ConditionType:     view.ConditionTypeNullable,
+			NullableCondition: &view.NullableCondition{Field: fs, Op: o},
+		}, nil
+	}
+
+	if len(parts) != 3 || !isComparable(sf.Type()) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidFilter, filter)
+	}
+	v := parts[2]
+	t := sf.Type()
+
+	switch {
+	case (op == "eq" || op == "ne") && (t == value.TypeBool || t == value.TypeCheckbox):
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidFilter, filter)
+		}
+		o := view.BoolOperatorEquals
+		if op == "ne" {
+			o = view.BoolOperatorNotEquals
+		}
+		return &view.Condition{
+			ConditionType: view.ConditionTypeBool,
+			BoolCondition: &view.BoolCondition{Field: fs, Op: o, Value: b},
+		}, nil
+
+	case op == "eq" || op == "ne":
+		bv, ok := basicValue(t, v)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidFilter, filter)
+		}
+		o := view.BasicOperatorEquals
+		if op == "ne" {
+			o = view.BasicOperatorNotEquals
+		}
+		return &view.Condition{
+			ConditionType:  view.ConditionTypeBasic,
+			BasicCondition: &view.BasicCondition{Field: fs, Op: o, Value: bv},
+		}, nil
+
+	case lo.Contains(stringTypes, t):
+		o, ok := map[string]view.StringOperator{
+			"contains":    view.StringOperatorContains,
+			"ncontains":   view.StringOperatorNotContains,
+			"startswith":  view.StringOperatorStartsWith,
+			"nstartswith": view.StringOperatorNotStartsWith,
+			"endswith":    view.StringOperatorEndsWith,
+			"nendswith":   view.StringOperatorNotEndsWith,
+		}[op]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidFilter, filter)
+		}
+		return &view.Condition{
+			ConditionType:   view.ConditionTypeString,
+			StringCondition: &view.StringCondition{Field: fs, Op: o, Value: v},
+		}, nil
+
+	case t == value.TypeInteger || t == value.TypeNumber:
+		o, ok := map[string]view.NumberOperator{
+			"gt":  view.NumberOperatorGreaterThan,
+			"gte": view.NumberOperatorGreaterThanOrEqualTo,
+			"lt":  view.NumberOperatorLessThan,
+			"lte": view.NumberOperatorLessThanOrEqualTo,
+		}[op]
+		n, err := strconv.ParseFloat(v, 64)
+		if !ok || err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidFilter, filter)
+		}
+		return &view.Condition{
+			ConditionType:   view.ConditionTypeNumber,
+			NumberCondition: &view.NumberCondition{Field: fs, Op: o, Value: n},
+		}, nil
+
+	case t == value.TypeDateTime:
+		o, ok := map[string]view.TimeOperator{
+			"gt":  view.TimeOperatorAfter,
+			"gte": view.TimeOperatorAfterOrOn,
+			"lt":  view.TimeOperatorBefore,
+			"lte": view.TimeOperatorBeforeOrOn,
+		}[op]
+		tv, err := time.Parse(time.RFC3339, v)
+		if !ok || err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidFilter, filter)
+		}
+		return &view.Condition{
+			ConditionType: view.ConditionTypeTime,
+			TimeCondition: &view.TimeCondition{Field: fs, Op: o, Value: tv},
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrInvalidFilter, filter)
+}
+
+func bboxConditionFrom(bbox string, s *schema.Schema) (*view.Condition, error) {
+	var sf *schema.Field
+	key, coords, ok := strings.Cut(bbox, ":")
+	if ok {
+		sf = fieldByKey(s, key)
+		if sf == nil {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownField, key)
+		}
+	} else {
+		coords = key
+		sf = s.FirstGeometryField()
+	}
+	if sf == nil || !sf.Type().IsGeometryFieldType() {
+		return nil, fmt.Errorf("%w: bbox requires a geometry field", ErrInvalidFilter)
+	}
+
+	parts := strings.Split(coords, ",")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("%w: bbox=%s", ErrInvalidFilter, bbox)
+	}
+	box := make([]float64, 0, len(parts))
+	for _, p := range parts {
+		v, err := strconv.ParseFloat(strings.TrimSpace(p), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: bbox=%s", ErrInvalidFilter, bbox)
+		}
+		box = append(box, v)
+	}
+
+	sc := &view.SpatialCondition{Field: fieldSelector(sf), Op: view.SpatialOperatorBBox, BBox: box}
+	if err := sc.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: bbox=%s", ErrInvalidFilter, bbox)
+	}
+	return &view.Condition{
+		ConditionType:    view.ConditionTypeSpatial,
+		SpatialCondition: sc,
+	}, nil
+}
+
+// basicValue converts the raw query value into the value stored for the field type.
+func basicValue(t value.Type, v string) (any, bool) {
+	switch t {
+	case value.TypeDateTime:
+		// date times are compared as RFC3339 strings by the repository
+		if _, err := time.Parse(time.RFC3339, v); err != nil {
+			return nil, false
+		}
+		return v, true
+	case value.TypeInteger, value.TypeNumber:
+		vv := t.Value(v)
+		if vv == nil {
+			return nil, false
+		}
+		return vv.Interface(), true
+	}
+	return v, true
+}
+
+func isComparable(t value.Type) bool {
+	switch t {
+	case value.TypeGroup, value.TypeAsset, value.TypeGeometryObject, value.TypeGeometryEditor:
+		return false
+	}
+	return true
+}
+
+func fieldByKey(s *schema.Schema, key string) *schema.Field {
+	if s == nil || key == "" {
+		return nil
+	}
+	return s.FieldByIDOrKey(nil, new(id.NewKey(key)))
+}
+
+func fieldSelector(sf *schema.Field) view.FieldSelector {
+	return view.FieldSelector{Type: view.FieldTypeField, ID: sf.ID().Ref()}
+}
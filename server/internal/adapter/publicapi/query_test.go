@@ -0,0 +1,177 @@
+package publicapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item/view"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func testQuerySchema() (*schema.Schema, *schema.Field, *schema.Field, *schema.Field, *schema.Field, *schema.Field) {
+	name := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("name")).MustBuild()
+	pop := schema.NewField(schema.MustNewInteger(nil, nil).TypeProperty()).NewID().Key(id.NewKey("population")).MustBuild()
+	capital := schema.NewField(schema.NewBool().TypeProperty()).NewID().Key(id.NewKey("capital")).MustBuild()
+	founded := schema.NewField(schema.NewDateTime().TypeProperty()).NewID().Key(id.NewKey("founded")).MustBuild()
+	geo := schema.NewField(schema.NewGeometryObject(schema.GeometryObjectSupportedTypeList{schema.GeometryObjectSupportedTypePoint}).TypeProperty()).NewID().Key(id.NewKey("location")).MustBuild()
+	s := schema.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).Fields(schema.FieldList{name, pop, capital, founded, geo}).MustBuild()
+	return s, name, pop, capital, founded, geo
+}
+
+func TestItemQuery_Condition(t *testing.T) {
+	s, name, pop, capital, founded, geo := testQuerySchema()
+
+	c, err := ItemQuery{}.Condition(s)
+	assert.NoError(t, err)
+	assert.Nil(t, c)
+
+	c, err = ItemQuery{Filters: []string{"name:contains:To:kyo"}}.Condition(s)
+	assert.NoError(t, err)
+	assert.Equal(t, &view.Condition{
+		ConditionType:   view.ConditionTypeString,
+		StringCondition: &view.StringCondition{Field: view.FieldSelector{Type: view.FieldTypeField, ID: name.ID().Ref()}, Op: view.StringOperatorContains, Value: "To:kyo"},
+	}, c)
+
+	c, err = ItemQuery{Filters: []string{"population:gte:1000", "capital:eq:true", "founded:lt:2000-01-01T00:00:00Z", "location:nempty", "population:eq:5"}}.Condition(s)
+	assert.NoError(t, err)
+	assert.Equal(t, &view.Condition{
+		ConditionType: view.ConditionTypeAnd,
+		AndCondition: &view.AndCondition{Conditions: []view.Condition{
+			{
+				ConditionType:   view.ConditionTypeNumber,
+				NumberCondition: &view.NumberCondition{Field: view.FieldSelector{Type: view.FieldTypeField, ID: pop.ID().Ref()}, Op: view.NumberOperatorGreaterThanOrEqualTo, Value: 1000},
+			},
+			{
+				ConditionType: view.ConditionTypeBool,
+				BoolCondition: &view.BoolCondition{Field: view.FieldSelector{Type: view.FieldTypeField, ID: capital.ID().Ref()}, Op: view.BoolOperatorEquals, Value: true},
+			},
+			{
+				ConditionType: view.ConditionTypeTime,
+				TimeCondition: &view.TimeCondition{Field: view.FieldSelector{Type: view.FieldTypeField, ID: founded.ID().Ref()}, Op: view.TimeOperatorBefore, Value: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)},
+			},
+			{
+				ConditionType:     view.ConditionTypeNullable,
+				NullableCondition: &view.NullableCondition{Field: view.FieldSelector{Type: view.FieldTypeField, ID: geo.ID().Ref()}, Op: view.NullableOperatorNotEmpty},
+			},
+			{
+				ConditionType:  view.ConditionTypeBasic,
+				BasicCondition: &view.BasicCondition{Field: view.FieldSelector{Type: view.FieldTypeField, ID: pop.ID().Ref()}, Op: view.BasicOperatorEquals, Value: int64(5)},
+			},
+		}},
+	}, c)
+
+	tests := []struct {
+		filter  string
+		wantErr error
+	}{
+		{filter: "name", wantErr: ErrInvalidFilter},
+		{filter: "unknown:eq:a", wantErr: ErrUnknownField},
+		{filter: "name:gt:a", wantErr: ErrInvalidFilter},
+		{filter: "name:empty:a", wantErr: ErrInvalidFilter},
+		{filter: "name:eq", wantErr: ErrInvalidFilter},
+		{filter: "population:gt:abc", wantErr: ErrInvalidFilter},
+		{filter: "population:eq:abc", wantErr: ErrInvalidFilter},
+		{filter: "population:contains:1", wantErr: ErrInvalidFilter},
+		{filter: "capital:eq:maybe", wantErr: ErrInvalidFilter},
+		{filter: "founded:gt:yesterday", wantErr: ErrInvalidFilter},
+		{filter: "location:eq:x", wantErr: ErrInvalidFilter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			t.Parallel()
+			_, err := ItemQuery{Filters: []string{tt.filter}}.Condition(s)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestItemQuery_Condition_BBox(t *testing.T) {
+	s, name, _, _, _, geo := testQuerySchema()
+	bbox := view.Condition{
+		ConditionType:    view.ConditionTypeSpatial,
+		SpatialCondition: &view.SpatialCondition{Field: view.FieldSelector{Type: view.FieldTypeField, ID: geo.ID().Ref()}, Op: view.SpatialOperatorBBox, BBox: []float64{139, 35, 140.5, 36}},
+	}
+
+	c, err := ItemQuery{BBox: "139,35,140.5,36"}.Condition(s)
+	assert.NoError(t, err)
+	assert.Equal(t, &bbox, c)
+
+	c, err = ItemQuery{BBox: "location:139,35,140.5,36"}.Condition(s)
+	assert.NoError(t, err)
+	assert.Equal(t, &bbox, c)
+
+	c, err = ItemQuery{Filters: []string{"name:eq:Tokyo"}, BBox: "139,35,140.5,36"}.Condition(s)
+	assert.NoError(t, err)
+	assert.Equal(t, &view.Condition{
+		ConditionType: view.ConditionTypeAnd,
+		AndCondition: &view.AndCondition{Conditions: []view.Condition{
+			{
+				ConditionType:  view.ConditionTypeBasic,
+				BasicCondition: &view.BasicCondition{Field: view.FieldSelector{Type: view.FieldTypeField, ID: name.ID().Ref()}, Op: view.BasicOperatorEquals, Value: "Tokyo"},
+			},
+			bbox,
+		}},
+	}, c)
+
+	tests := []struct {
+		bbox    string
+		wantErr error
+	}{
+		{bbox: "139,35,140", wantErr: ErrInvalidFilter},
+		{bbox: "139,35,140,north", wantErr: ErrInvalidFilter},
+		{bbox: "140,35,139,36", wantErr: ErrInvalidFilter},
+		{bbox: "name:139,35,140,36", wantErr: ErrInvalidFilter},
+		{bbox: "unknown:139,35,140,36", wantErr: ErrUnknownField},
+	}
+	for _, tt := range tests {
+		t.Run(tt.bbox, func(t *testing.T) {
+			t.Parallel()
+			_, err := ItemQuery{BBox: tt.bbox}.Condition(s)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	s2 := schema.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).Fields(schema.FieldList{name}).MustBuild()
+	_, err = ItemQuery{BBox: "139,35,140,36"}.Condition(s2)
+	assert.ErrorIs(t, err, ErrInvalidFilter)
+}
+
+func TestItemQuery_SortBy(t *testing.T) {
+	s, name, _, _, _, _ := testQuerySchema()
+
+	got, err := ItemQuery{}.SortBy(s)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	got, err = ItemQuery{Sort: "name"}.SortBy(s)
+	assert.NoError(t, err)
+	assert.Equal(t, &view.Sort{Field: view.FieldSelector{Type: view.FieldTypeField, ID: name.ID().Ref()}, Direction: view.DirectionAsc}, got)
+
+	got, err = ItemQuery{Sort: "-createdAt"}.SortBy(s)
+	assert.NoError(t, err)
+	assert.Equal(t, &view.Sort{Field: view.FieldSelector{Type: view.FieldTypeCreationDate}, Direction: view.DirectionDesc}, got)
+
+	_, err = ItemQuery{Sort: "unknown"}.SortBy(s)
+	assert.ErrorIs(t, err, ErrInvalidSort)
+
+	_, err = ItemQuery{Sort: "-location"}.SortBy(s)
+	assert.ErrorIs(t, err, ErrInvalidSort)
+}
+
+func TestItemQuery_FieldIDs(t *testing.T) {
+	s, name, pop, _, _, _ := testQuerySchema()
+
+	got, err := ItemQuery{}.FieldIDs(s)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	got, err = ItemQuery{Fields: []string{"population", "name", "population"}}.FieldIDs(s)
+	assert.NoError(t, err)
+	assert.Equal(t, id.FieldIDList{pop.ID(), name.ID()}, got)
+
+	_, err = ItemQuery{Fields: []string{"name", "unknown"}}.FieldIDs(s)
+	assert.ErrorIs(t, err, ErrUnknownField)
+}
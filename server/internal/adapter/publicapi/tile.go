@@ -0,0 +1,110 @@
+package publicapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/exporters"
+	"github.com/reearth/reearth-cms/server/pkg/item/view"
+	"github.com/reearth/reearth-cms/server/pkg/mvt"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearthx/rerror"
+)
+
+// GetTile writes a vector tile of the public items of the model whose geometry intersects the tile.
+// The geometry field is the first one of the model unless geometryField is given as a field key.
+// The tile has a single layer named after the model key.
+func (c *Controller) GetTile(ctx context.Context, wsAlias, pAlias, mKey, locale string, tile mvt.TileID, geometryField string, q ItemQuery, w io.Writer) error {
+	if err := tile.Validate(); err != nil {
+		return err
+	}
+
+	wpm, err := c.loadWPMContext(ctx, wsAlias, pAlias, mKey)
+	if err != nil {
+		return err
+	}
+
+	sp, err := c.usecases.Schema.FindByModel(ctx, wpm.Model.ID(), nil)
+	if err != nil {
+		return err
+	}
+
+	gf, err := tileGeometryField(sp.Schema(), geometryField)
+	if err != nil {
+		return err
+	}
+
+	filter, err := q.Condition(sp.Schema())
+	if err != nil {
+		return err
+	}
+	fields, err := q.FieldIDs(sp.Schema())
+	if err != nil {
+		return err
+	}
+
+	return c.usecases.Item.Export(ctx, interfaces.ExportItemParams{
+		ModelID:       wpm.Model.ID(),
+		SchemaPackage: *sp,
+		Format:        exporters.FormatMVT,
+		Options: exporters.ExportOptions{
+			PublicOnly:       true,
+			IncludeAssets:    wpm.PublicAssets,
+			IncludeGeometry:  true,
+			GeometryField:    gf.ID().Ref(),
+			IncludeRefModels: wpm.PublicModels,
+			Locales:          wpm.locales(locale),
+			Fields:           fields,
+			Tile: &exporters.TileOptions{
+				ID:    tile,
+				Layer: wpm.Model.Key().String(),
+			},
+		},
+		Filter: tileCondition(gf, tile, filter),
+	}, w, nil)
+}
+
+func tileGeometryField(s *schema.Schema, key string) (*schema.Field, error) {
+	if key == "" {
+		if gf := s.FirstGeometryField(); gf != nil {
+			return gf, nil
+		}
+		return nil, rerror.ErrNotFound
+	}
+	gf := fieldByKey(s, key)
+	if gf == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownField, key)
+	}
+	if !gf.Type().IsGeometryFieldType() {
+		return nil, fmt.Errorf("%w: %s is not a geometry field", ErrInvalidFilter, key)
+	}
+	return gf, nil
+}
+
+// minSpatialFilterZoom is the lowest zoom level whose tiles are narrowed by a spatial condition.
+// Tiles of lower levels cover a hemisphere or more, which can not be queried as a polygon, and are clipped by the encoder instead.
+const minSpatialFilterZoom = 2
+
+// tileCondition narrows the condition to the items whose geometry intersects the tile and its buffer.
+func tileCondition(gf *schema.Field, tile mvt.TileID, filter *view.Condition) *view.Condition {
+	if tile.Z < minSpatialFilterZoom {
+		return filter
+	}
+	c := view.Condition{
+		ConditionType: view.ConditionTypeSpatial,
+		SpatialCondition: &view.SpatialCondition{
+			Field: fieldSelector(gf),
+			Op:    view.SpatialOperatorBBox,
+			BBox:  tile.BufferedBounds(),
+		},
+	}
+	if filter == nil {
+		return &c
+	}
+	return &view.Condition{
+		ConditionType: view.ConditionTypeAnd,
+		AndCondition:  &view.AndCondition{Conditions: []view.Condition{c, *filter}},
+	}
+}
@@ -0,0 +1,143 @@
+package publicapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v5"
+	geojson "github.com/paulmach/go.geojson"
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interactor"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/mvt"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountdomain/workspace"
+	"github.com/reearth/reearthx/account/accountinfrastructure/accountmemory"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/reearth/reearthx/account/accountusecase/accountrepo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Tile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	wid := accountdomain.NewWorkspaceID()
+	pid := id.NewProjectID()
+	ws := workspace.New().ID(wid).Name("ws").Members(map[accountdomain.UserID]workspace.Member{}).MustBuild()
+	p := project.New().ID(pid).Workspace(wid).Alias("test-project").Name("test-project").
+		Accessibility(project.NewAccessibility(project.VisibilityPublic, nil, nil, nil)).
+		MustBuild()
+
+	gst := schema.GeometryObjectSupportedTypeList{schema.GeometryObjectSupportedTypePoint}
+	sf1 := schema.NewField(schema.NewGeometryObject(gst).TypeProperty()).NewID().Key(id.NewKey("location")).MustBuild()
+	sf2 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("name")).MustBuild()
+	s1 := schema.New().NewID().Workspace(wid).Project(pid).Fields(schema.FieldList{sf1, sf2}).MustBuild()
+	m1 := model.New().NewID().Schema(s1.ID()).Key(id.NewKey("places")).Project(pid).MustBuild()
+	s2 := schema.New().NewID().Workspace(wid).Project(pid).Fields(schema.FieldList{sf2}).MustBuild()
+	m2 := model.New().NewID().Schema(s2.ID()).Key(id.NewKey("names")).Project(pid).MustBuild()
+
+	newItem := func(geo, name string) *item.Item {
+		return item.New().NewID().Schema(s1.ID()).Model(m1.ID()).Project(pid).Thread(id.NewThreadID().Ref()).Anonymous(true).
+			Fields([]*item.Field{
+				item.NewField(sf1.ID(), value.TypeGeometryObject.Value(geo).AsMultiple(), nil),
+				item.NewField(sf2.ID(), value.TypeText.Value(name).AsMultiple(), nil),
+			}).MustBuild()
+	}
+	i1 := newItem(`{"type":"Point","coordinates":[139.767,35.681]}`, "Tokyo")
+	i2 := newItem(`{"type":"Point","coordinates":[-74.006,40.713]}`, "New York")
+
+	db := memory.New()
+	wsRepo := accountmemory.NewWorkspace()
+	require.NoError(t, wsRepo.Save(ctx, ws))
+	require.NoError(t, db.Project.Save(ctx, p))
+	require.NoError(t, db.Schema.Save(ctx, s1))
+	require.NoError(t, db.Schema.Save(ctx, s2))
+	require.NoError(t, db.Model.Save(ctx, m1))
+	require.NoError(t, db.Model.Save(ctx, m2))
+	for _, i := range []*item.Item{i1, i2} {
+		require.NoError(t, db.Item.Save(ctx, i))
+		require.NoError(t, db.Item.UpdateRef(ctx, i.ID(), version.Public, version.Latest.OrVersion().Ref()))
+	}
+
+	uc := interactor.New(db, nil, &accountrepo.Container{Workspace: wsRepo}, nil, interactor.ContainerConfig{})
+	ctx = adapter.AttachOperator(ctx, &usecase.Operator{AcOperator: &accountusecase.Operator{}, Anonymous: true})
+	ctx = adapter.AttachUsecases(ctx, &uc)
+	ctx = AttachController(ctx, NewController(wsRepo, db.Project, &uc))
+
+	tokyo := mvt.NewLayer("places")
+	tokyo.Add(geojson.NewPointGeometry([]float64{139.767, 35.681}), map[string]any{"id": i1.ID().String(), "name": "Tokyo"})
+
+	tests := []struct {
+		name        string
+		model       string
+		z, x, y     string
+		query       string
+		ifNoneMatch bool
+		wantStatus  int
+		wantTile    *mvt.Layer
+		wantTileID  mvt.TileID
+	}{
+		{name: "low zoom tile", model: "places", z: "1", x: "1", y: "0.mvt", wantStatus: http.StatusOK, wantTile: tokyo, wantTileID: mvt.TileID{Z: 1, X: 1, Y: 0}},
+		{name: "tile narrowed by the spatial condition", model: "places", z: "14", x: "14552", y: "6451.mvt", wantStatus: http.StatusOK, wantTile: tokyo, wantTileID: mvt.TileID{Z: 14, X: 14552, Y: 6451}},
+		{name: "tile narrowed by the query", model: "places", z: "1", x: "1", y: "0.mvt", query: "bbox=-80,40,-70,41", wantStatus: http.StatusOK, wantTile: mvt.NewLayer("places"), wantTileID: mvt.TileID{Z: 1, X: 1, Y: 0}},
+		{name: "geometry field", model: "places", z: "1", x: "1", y: "0.mvt", query: "geometryField=location", wantStatus: http.StatusOK, wantTile: tokyo, wantTileID: mvt.TileID{Z: 1, X: 1, Y: 0}},
+		{name: "not modified", model: "places", z: "1", x: "1", y: "0.mvt", ifNoneMatch: true, wantStatus: http.StatusNotModified},
+		{name: "non geometry field", model: "places", z: "1", x: "1", y: "0.mvt", query: "geometryField=name", wantStatus: http.StatusBadRequest},
+		{name: "tile out of range", model: "places", z: "1", x: "2", y: "0.mvt", wantStatus: http.StatusBadRequest},
+		{name: "without extension", model: "places", z: "1", x: "1", y: "0", wantStatus: http.StatusNotFound},
+		{name: "model without geometry fields", model: "names", z: "1", x: "1", y: "0.mvt", wantStatus: http.StatusNotFound},
+		{name: "unknown model", model: "unknown", z: "1", x: "1", y: "0.mvt", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			serve := func(etag string) *httptest.ResponseRecorder {
+				req := httptest.NewRequest(http.MethodGet, "/?"+tt.query, nil).WithContext(ctx)
+				if etag != "" {
+					req.Header.Set("If-None-Match", etag)
+				}
+				rec := httptest.NewRecorder()
+				c := echo.New().NewContext(req, rec)
+				c.SetPathValues(echo.PathValues{
+					{Name: "workspace", Value: ws.ID().String()},
+					{Name: "project", Value: "test-project"},
+					{Name: "model", Value: tt.model},
+					{Name: "z", Value: tt.z},
+					{Name: "x", Value: tt.x},
+					{Name: "y", Value: tt.y},
+				})
+				require.NoError(t, Tile()(c))
+				return rec
+			}
+
+			rec := serve("")
+			if tt.ifNoneMatch {
+				rec = serve(rec.Header().Get("ETag"))
+			}
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantTile != nil {
+				want, err := mvt.Marshal(tt.wantTileID, tt.wantTile)
+				require.NoError(t, err)
+				assert.Equal(t, want, rec.Body.Bytes())
+				assert.Equal(t, "application/vnd.mapbox-vector-tile", rec.Header().Get("Content-Type"))
+				assert.Equal(t, "public, max-age=300", rec.Header().Get("Cache-Control"))
+				assert.NotEmpty(t, rec.Header().Get("ETag"))
+			}
+		})
+	}
+}
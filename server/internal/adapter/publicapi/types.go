@@ -188,18 +188,20 @@ func NewItemFields(fields item.Fields, sfields schema.FieldList, groupFields sch
 }
 
 type Asset struct {
-	Type        string    `json:"type"`
-	ID          string    `json:"id,omitempty"`
-	URL         string    `json:"url,omitempty"`
-	ContentType string    `json:"contentType,omitempty"`
-	Files       []string  `json:"files,omitempty"`
-	CreatedAt   time.Time `json:"createdAt"`
-	UpdatedAt   time.Time `json:"updatedAt"`
-	CreatedBy   string    `json:"createdBy,omitempty"`
-	UpdatedBy   string    `json:"updatedBy,omitempty"`
+	Type         string         `json:"type"`
+	ID           string         `json:"id,omitempty"`
+	URL          string         `json:"url,omitempty"`
+	ThumbnailURL string         `json:"thumbnailUrl,omitempty"`
+	ContentType  string         `json:"contentType,omitempty"`
+	Files        []string       `json:"files,omitempty"`
+	Metadata     map[string]any `json:"metadata,omitempty"`
+	CreatedAt    time.Time      `json:"createdAt"`
+	UpdatedAt    time.Time      `json:"updatedAt"`
+	CreatedBy    string         `json:"createdBy,omitempty"`
+	UpdatedBy    string         `json:"updatedBy,omitempty"`
 }
 
-func NewAsset(a *asset.Asset, f *asset.File) Asset {
+func NewAsset(a *asset.Asset, f *asset.File, ms *schema.Schema) Asset {
 	// TODO: how to handle public api with asset url management
 	ai := a.AccessInfo()
 
@@ -237,6 +239,7 @@ func NewAsset(a *asset.Asset, f *asset.File) Asset {
 		URL:         ai.Url,
 		ContentType: f.ContentType(),
 		Files:       files,
+		Metadata:    a.Metadata().Map(ms),
 		CreatedAt:   a.CreatedAt(),
 		UpdatedAt:   a.UpdatedAt(),
 		CreatedBy:   createdBy,
@@ -12,6 +12,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/adapter"
 	"github.com/reearth/reearth-cms/server/internal/adapter/integration"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interactor"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearthx/appx"
 	"github.com/reearth/reearthx/log"
 	"github.com/reearth/reearthx/rerror"
@@ -42,6 +43,7 @@ func initEcho(appCtx *ApplicationContext) *echo.Echo {
 	usecaseMiddleware := UsecaseMiddleware(appCtx.Repos, appCtx.Gateways, appCtx.AcRepos, appCtx.AcGateways, interactor.ContainerConfig{
 		SignupSecret:    appCtx.Config.SignupSecret,
 		AuthSrvUIDomain: appCtx.Config.Host_Web,
+		TrashRetention:  appCtx.Config.Trash.Retention,
 	})
 
 	// apis
@@ -196,6 +198,14 @@ func errorMessage(err error, log func(string, ...any)) (int, string) {
 		return http.StatusBadRequest, rErr.Error()
 	}
 
+	if fErrs, ok := errors.AsType[schema.FieldValidationErrors](err); ok {
+		return http.StatusBadRequest, fErrs.Error()
+	}
+
+	if fErr, ok := errors.AsType[*schema.FieldValidationError](err); ok {
+		return http.StatusBadRequest, fErr.Error()
+	}
+
 	if gqlErr, ok := errors.AsType[*gqlerror.Error](err); ok {
 		return http.StatusBadRequest, gqlErr.Error()
 	}
@@ -214,9 +224,17 @@ func errorHandler(next echo.HTTPErrorHandler) echo.HTTPErrorHandler {
 		code, msg := errorMessage(err, func(f string, args ...any) {
 			c.Logger().Error(fmt.Sprintf(f, args...))
 		})
-		if err := c.JSON(code, map[string]string{
+		body := map[string]any{
 			"error": msg,
-		}); err != nil {
+		}
+		// tells the clients which fields are invalid
+		if fErrs, ok := errors.AsType[schema.FieldValidationErrors](err); ok {
+			body["errors"] = fErrs
+		} else if fErr, ok := errors.AsType[*schema.FieldValidationError](err); ok {
+			body["field"] = fErr.Field
+			body["code"] = string(fErr.Code)
+		}
+		if err := c.JSON(code, body); err != nil {
 			next(c, err)
 		}
 	}
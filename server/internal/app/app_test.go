@@ -2,10 +2,12 @@ package app
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"testing"
 
 	"github.com/labstack/echo/v5"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/stretchr/testify/assert"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -52,6 +54,21 @@ func TestErrorMessage(t *testing.T) {
 			wantCode: http.StatusBadRequest,
 			wantMsg:  "input: graphql error",
 		},
+		{
+			name:     "field validation error",
+			err:      fmt.Errorf("wrapped: %w", &schema.FieldValidationError{Field: "email", Code: schema.FieldValidationCodeInvalidFormat, Detail: "value is not a valid email"}),
+			wantCode: http.StatusBadRequest,
+			wantMsg:  "email: value is not a valid email",
+		},
+		{
+			name: "field validation errors",
+			err: schema.FieldValidationErrors{
+				{Field: "startAt", Code: schema.FieldValidationCodeRequired, Detail: "value is required"},
+				{Field: "endAt", Code: schema.FieldValidationCodeComparisonFailed, Detail: "value must be greater than startAt"},
+			},
+			wantCode: http.StatusBadRequest,
+			wantMsg:  "startAt: value is required; endAt: value must be greater than startAt",
+		},
 		{
 			name:     "unknown error defaults to 500",
 			err:      errors.New("unexpected"),
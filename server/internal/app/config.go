@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"net/url"
 	"os"
 	"strings"
 	"time"
@@ -12,6 +13,9 @@ import (
 	"github.com/k0kubun/pp/v3"
 	"github.com/kelseyhightower/envconfig"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/gcp"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/s3"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/taskqueue"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearthx/appx"
 	"github.com/reearth/reearthx/log"
 	"github.com/samber/lo"
@@ -40,7 +44,9 @@ type Config struct {
 	SendGrid            SendGridConfig    `pp:",omitempty"`
 	SignupSecret        string            `pp:",omitempty"`
 	GCS                 GCSConfig         `pp:",omitempty"`
+	S3                  s3.Config         `pp:",omitempty"`
 	Task                gcp.TaskConfig    `pp:",omitempty"`
+	TaskQueue           taskqueue.Config  `pp:",omitempty"`
 	Web                 map[string]string `pp:",omitempty"`
 	Web_Config          JSON              `pp:",omitempty"`
 	Web_Disabled        bool              `pp:",omitempty"`
@@ -72,6 +78,27 @@ type Config struct {
 	// server
 	Server ServerConfig `pp:",omitempty"`
 
+	// scheduled publication
+	Scheduler SchedulerConfig `pp:",omitempty"`
+
+	// item trash
+	Trash TrashConfig `pp:",omitempty"`
+
+	// exported files
+	Export ExportConfig `pp:",omitempty"`
+
+	// notification digest emails
+	Notification NotificationConfig `pp:",omitempty"`
+
+	// webhook delivery retries
+	Webhook WebhookConfig `pp:",omitempty"`
+
+	// on-the-fly image transformations
+	ImageTransform ImageTransformConfig `pp:",omitempty"`
+
+	// job progress notifications
+	JobPubSub JobPubSubConfig `pp:",omitempty"`
+
 	// Health Check Configuration
 	HealthCheck HealthCheckConfig `pp:",omitempty"`
 
@@ -130,6 +157,70 @@ type ServerConfig struct {
 	Active bool `default:"true" pp:",omitempty"`
 }
 
+type SchedulerConfig struct {
+	Active   bool          `default:"true" pp:",omitempty"`
+	Interval time.Duration `default:"1m" pp:",omitempty"`
+}
+
+type TrashConfig struct {
+	// Retention is how long trashed items are kept before they are purged. Zero keeps them forever.
+	Retention     time.Duration `default:"720h" pp:",omitempty"`
+	SweepInterval time.Duration `default:"1h" pp:",omitempty"`
+}
+
+type ExportConfig struct {
+	// SweepInterval is how often the exported files whose download links expired are deleted. Zero keeps them forever.
+	SweepInterval time.Duration `default:"1h" pp:",omitempty"`
+}
+
+type JobPubSubConfig struct {
+	// Type is "memory" for a single server or "mongo" to share the job progress among the servers.
+	Type string `default:"memory" pp:",omitempty"`
+	// Retention is how long the job states are kept in MongoDB.
+	Retention    time.Duration `default:"1h" pp:",omitempty"`
+	PollInterval time.Duration `default:"1s" pp:",omitempty"`
+}
+
+type NotificationConfig struct {
+	// DigestInterval is how often the notifications of the users who prefer digests are emailed.
+	DigestInterval time.Duration `default:"24h" pp:",omitempty"`
+}
+
+type WebhookConfig struct {
+	// RetryInterval is how often the failed deliveries which are due are sent again.
+	RetryInterval time.Duration `default:"1m" pp:",omitempty"`
+}
+
+type ImageTransformConfig struct {
+	Enabled bool `default:"true" pp:",omitempty"`
+	// Secret signs the URLs of the transformations which are not in the whitelists. Unsigned transformations are allowed only when they are in the whitelists.
+	Secret    string `pp:",omitempty"`
+	Sizes     []int  `default:"64,128,256,512,1024,2048" pp:",omitempty"`
+	Qualities []int  `default:"60,80,90" pp:",omitempty"`
+	// Thumbnail is the query of the transformation of the thumbnails of image assets.
+	Thumbnail string `default:"w=256" pp:",omitempty"`
+}
+
+func (c ImageTransformConfig) Policy() asset.ImageTransformationPolicy {
+	return asset.ImageTransformationPolicy{
+		Secret:    c.Secret,
+		Sizes:     c.Sizes,
+		Qualities: c.Qualities,
+	}
+}
+
+func (c ImageTransformConfig) ThumbnailTransformation() (asset.ImageTransformation, error) {
+	q, err := url.ParseQuery(c.Thumbnail)
+	if err != nil {
+		return asset.ImageTransformation{}, err
+	}
+	t, err := asset.ParseImageTransformation(q)
+	if err != nil || t == nil {
+		return asset.ImageTransformation{}, asset.ErrInvalidImageTransformation
+	}
+	return *t, nil
+}
+
 type InternalApiConfig struct {
 	Active bool   `default:"false" pp:",omitempty"`
 	Port   string `default:"50051" pp:",omitempty"`
@@ -1,6 +1,8 @@
 package app
 
 import (
+	"bytes"
+	"errors"
 	"io"
 	"mime"
 	"net/http"
@@ -9,6 +11,10 @@ import (
 
 	"github.com/labstack/echo/v5"
 	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/file"
+	"github.com/reearth/reearthx/log"
 	"github.com/reearth/reearthx/rerror"
 )
 
@@ -49,11 +55,19 @@ func handleAssetByUUID(appCtx *ApplicationContext) echo.HandlerFunc {
 	return func(ctx *echo.Context) error {
 		filename := ctx.Param("filename")
 		uuid := ctx.Param("uuid1") + ctx.Param("uuid2")
-		if !appCtx.Config.Asset_Public {
-			a, err := appCtx.Repos.Asset.FindByUUID(ctx.Request().Context(), uuid)
+		t, err := asset.ParseImageTransformation(ctx.QueryParams())
+		if err != nil {
+			return err
+		}
+
+		var a *asset.Asset
+		if !appCtx.Config.Asset_Public || t != nil {
+			a, err = appCtx.Repos.Asset.FindByUUID(ctx.Request().Context(), uuid)
 			if err != nil {
 				return err
 			}
+		}
+		if !appCtx.Config.Asset_Public {
 			if a != nil && !a.Public() {
 				op := adapter.Operator(ctx.Request().Context())
 				if op == nil || !op.IsReadableProject(a.Project()) {
@@ -61,6 +75,11 @@ func handleAssetByUUID(appCtx *ApplicationContext) echo.HandlerFunc {
 				}
 			}
 		}
+
+		if t != nil {
+			return serveTransformedImage(ctx, appCtx, a, uuid, filename, *t)
+		}
+
 		r, h, err := appCtx.Gateways.File.ReadAsset(
 			ctx.Request().Context(), uuid, filename, assetHeaders(ctx.Request().Header),
 		)
@@ -71,6 +90,57 @@ func handleAssetByUUID(appCtx *ApplicationContext) echo.HandlerFunc {
 	}
 }
 
+// serveTransformedImage serves the image asset transformed, which is cached in the storage once it is generated.
+func serveTransformedImage(ctx *echo.Context, appCtx *ApplicationContext, a *asset.Asset, uuid, filename string, t asset.ImageTransformation) error {
+	transformer := appCtx.Gateways.ImageTransformer
+	if transformer == nil || a == nil || a.PreviewType() == nil || *a.PreviewType() != asset.PreviewTypeImage {
+		return rerror.ErrNotFound
+	}
+	if !transformer.Allow(t, uuid, ctx.QueryParam(asset.ImageTransformationSignatureKey)) {
+		return echo.NewHTTPError(http.StatusForbidden, asset.ErrInvalidImageTransformation.Error())
+	}
+
+	c := ctx.Request().Context()
+	t = t.WithDefaults(filename)
+	headers := map[string]string{
+		"Content-Type":  t.Format.ContentType(),
+		"Cache-Control": "public, max-age=31536000, immutable",
+	}
+	cachePath := t.CachePath(uuid, filename)
+
+	if r, _, err := appCtx.Gateways.File.Read(c, cachePath, nil); err == nil {
+		defer func() { _ = r.Close() }()
+		return streamFile(ctx, filename, r, headers)
+	} else if !errors.Is(err, rerror.ErrNotFound) {
+		return err
+	}
+
+	r, _, err := appCtx.Gateways.File.ReadAsset(c, uuid, filename, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = r.Close() }()
+
+	b, err := transformer.Transform(c, r, t)
+	if errors.Is(err, gateway.ErrUnsupportedImageFormat) || errors.Is(err, gateway.ErrImageTooLarge) {
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error())
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err := appCtx.Gateways.File.Upload(c, &file.File{
+		Content:     io.NopCloser(bytes.NewReader(b)),
+		Name:        path.Base(cachePath),
+		Size:        int64(len(b)),
+		ContentType: t.Format.ContentType(),
+	}, cachePath); err != nil {
+		log.Errorfc(c, "file: failed to cache transformed image %s: %v", cachePath, err)
+	}
+
+	return streamFile(ctx, filename, bytes.NewReader(b), headers)
+}
+
 func handleAssetByFileName(appCtx *ApplicationContext) echo.HandlerFunc {
 	return func(ctx *echo.Context) error {
 		filename := ctx.Param("filename")
@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/99designs/gqlgen/graphql"
@@ -14,6 +15,7 @@ import (
 	"github.com/ravilushqa/otelgqlgen"
 	"github.com/reearth/reearth-cms/server/internal/adapter"
 	"github.com/reearth/reearth-cms/server/internal/adapter/gql"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearthx/log"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/vektah/gqlparser/v2/ast"
@@ -103,6 +105,24 @@ func GraphqlAPI(conf GraphQLConfig, dev bool) echo.HandlerFunc {
 
 func gqlErrorPresenter(dev bool) graphql.ErrorPresenterFunc {
 	return func(ctx context.Context, e error) *gqlerror.Error {
+		if fes, ok := errors.AsType[schema.FieldValidationErrors](e); ok {
+			ge := graphql.DefaultErrorPresenter(ctx, fes)
+			ge.Extensions = map[string]any{
+				"code":   "VALIDATION_FAILED",
+				"errors": fes,
+			}
+			return ge
+		}
+
+		if fe, ok := errors.AsType[*schema.FieldValidationError](e); ok {
+			ge := graphql.DefaultErrorPresenter(ctx, fe)
+			ge.Extensions = map[string]any{
+				"code":  string(fe.Code),
+				"field": fe.Field,
+			}
+			return ge
+		}
+
 		if dev {
 			return gqlerror.WrapPath(graphql.GetFieldContext(ctx).Path(), e)
 		}
@@ -49,8 +49,37 @@ func Start(debug bool, version string) {
 		log.Infof("health check: initial health check disabled")
 	}
 
-	// Start web server
-	NewServer(ctx, &ApplicationContext{
+	// Start item schedule runner
+	if conf.Scheduler.Active {
+		go runScheduler(ctx, repos, gateways, conf.Scheduler.Interval)
+		log.Infof("scheduler: started with interval %s", conf.Scheduler.Interval)
+	}
+
+	// Start item trash sweeper
+	if conf.Trash.Retention > 0 {
+		go runTrashSweeper(ctx, repos, gateways, conf.Trash.Retention, conf.Trash.SweepInterval)
+		log.Infof("trash: items are purged %s after deletion", conf.Trash.Retention)
+	}
+
+	// Start exported file sweeper
+	if conf.Export.SweepInterval > 0 {
+		go runExportSweeper(ctx, repos, gateways, conf.Export.SweepInterval)
+		log.Infof("export: expired files are checked every %s", conf.Export.SweepInterval)
+	}
+
+	// Start notification digest sender
+	if conf.Notification.DigestInterval > 0 {
+		go runNotificationDigest(ctx, repos, gateways, conf.Notification.DigestInterval)
+		log.Infof("notification: digests are sent every %s", conf.Notification.DigestInterval)
+	}
+
+	// Start webhook delivery retrier
+	if conf.Webhook.RetryInterval > 0 {
+		go runWebhookRetrier(ctx, repos, gateways, conf.Webhook.RetryInterval)
+		log.Infof("webhook: failed deliveries are checked every %s", conf.Webhook.RetryInterval)
+	}
+
+	appCtx := &ApplicationContext{
 		Config:        conf,
 		Debug:         debug,
 		Version:       version,
@@ -59,7 +88,16 @@ func Start(debug bool, version string) {
 		AcRepos:       acRepos,
 		AcGateways:    acGateways,
 		HealthChecker: healthChecker,
-	}).Run(ctx)
+	}
+
+	// Start built-in task worker
+	if conf.Task.GCPProject == "" && conf.TaskQueue.Enabled && conf.TaskQueue.Worker {
+		go runTaskWorker(ctx, appCtx)
+		log.Infof("task queue: worker started with concurrency %d", conf.TaskQueue.Concurrency)
+	}
+
+	// Start web server
+	NewServer(ctx, appCtx).Run(ctx)
 }
 
 type WebServer struct {
@@ -8,9 +8,12 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/auth0"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/fs"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/gcp"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/imageproc"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
 	mongorepo "github.com/reearth/reearth-cms/server/internal/infrastructure/mongo"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/policy"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/s3"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/taskqueue"
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearthx/account/accountinfrastructure/accountmongo"
@@ -94,6 +97,16 @@ func InitReposAndGateways(ctx context.Context, conf *Config) (*repo.Container, *
 		if err != nil {
 			log.Fatalf("file: failed to init GCS storage: %s\n", err.Error())
 		}
+	} else if conf.S3.BucketName != "" {
+		log.Infof("file: S3 storage is used: %s", conf.S3.BucketName)
+		if conf.Asset_Public {
+			fileRepo, err = s3.NewFile(conf.S3, conf.AssetBaseURL)
+		} else {
+			fileRepo, err = s3.NewFileWithACL(conf.S3, conf.AssetBaseURL, privateBase)
+		}
+		if err != nil {
+			log.Fatalf("file: failed to init S3 storage: %s\n", err.Error())
+		}
 	} else {
 		log.Infof("file: local storage is used")
 		datafs := afero.NewBasePathFs(afero.NewOsFs(), "data")
@@ -108,6 +121,20 @@ func InitReposAndGateways(ctx context.Context, conf *Config) (*repo.Container, *
 	}
 	gateways.File = fileRepo
 
+	// Image Transformer
+	if conf.ImageTransform.Enabled {
+		thumbnail, err := conf.ImageTransform.ThumbnailTransformation()
+		if err != nil {
+			log.Fatalf("image transformer: invalid thumbnail: %s", conf.ImageTransform.Thumbnail)
+		}
+		imageTransformer, err := imageproc.NewTransformer(conf.Host+"/assets", conf.ImageTransform.Policy(), thumbnail)
+		if err != nil {
+			log.Fatalf("image transformer: init error: %+v", err)
+		}
+		gateways.ImageTransformer = imageTransformer
+		log.Infof("image transformer: enabled")
+	}
+
 	// Auth0
 	auth := auth0.New(conf.Auth0.Domain, conf.Auth0.ClientID, conf.Auth0.ClientSecret)
 	gateways.Authenticator = auth
@@ -124,6 +151,9 @@ func InitReposAndGateways(ctx context.Context, conf *Config) (*repo.Container, *
 		}
 		gateways.TaskRunner = taskRunner
 		log.Infof("task runner: GCP is used")
+	} else if conf.TaskQueue.Enabled {
+		gateways.TaskRunner = taskqueue.NewTaskRunner(cmsRepos.Task, conf.TaskQueue)
+		log.Infof("task runner: built-in task queue is used")
 	} else {
 		log.Infof("task runner: not used")
 	}
@@ -165,9 +195,21 @@ func InitReposAndGateways(ctx context.Context, conf *Config) (*repo.Container, *
 		log.Infof("accounts api: not configured or disabled")
 	}
 
-	// Job PubSub - In-memory pub/sub for job progress notifications
-	gateways.JobPubSub = memory.NewJobPubSub()
-	log.Infof("job pubsub: in-memory pub/sub initialized")
+	// Job PubSub
+	if conf.JobPubSub.Type == "mongo" {
+		gateways.JobPubSub, err = mongorepo.NewJobPubSub(mongox.NewClient(conf.DB_CMS, client), mongorepo.JobPubSubConfig{
+			Retention:    conf.JobPubSub.Retention,
+			PollInterval: conf.JobPubSub.PollInterval,
+			ChangeStream: txAvailable,
+		})
+		if err != nil {
+			log.Fatalf("job pubsub: failed to init MongoDB pub/sub: %s\n", err.Error())
+		}
+		log.Infof("job pubsub: MongoDB pub/sub initialized (change streams: %v)", txAvailable)
+	} else {
+		gateways.JobPubSub = memory.NewJobPubSub()
+		log.Infof("job pubsub: in-memory pub/sub initialized")
+	}
 
 	return cmsRepos, gateways, acRepos, acGateways
 }
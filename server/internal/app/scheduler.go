@@ -0,0 +1,140 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interactor"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/util"
+)
+
+const (
+	defaultSchedulerInterval    = time.Minute
+	defaultTrashSweeperInterval = time.Hour
+)
+
+// runScheduler periodically fires the scheduled publications that are due until ctx is done.
+func runScheduler(ctx context.Context, repos *repo.Container, gateways *gateway.Container, interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultSchedulerInterval
+	}
+
+	uc := interactor.NewSchedule(repos, gateways)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			res, err := uc.RunDue(ctx, util.Now())
+			if err != nil {
+				log.Errorf("scheduler: failed to run due schedules: %v", err)
+				continue
+			}
+			if len(res) > 0 {
+				log.Infof("scheduler: %d schedule(s) fired", len(res))
+			}
+		}
+	}
+}
+
+// runTrashSweeper periodically purges the items kept in the trash longer than the retention period until ctx is done.
+func runTrashSweeper(ctx context.Context, repos *repo.Container, gateways *gateway.Container, retention, interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultTrashSweeperInterval
+	}
+
+	uc := interactor.NewItem(repos, gateways)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			res, err := uc.PurgeTrashedBefore(ctx, util.Now().Add(-retention))
+			if err != nil {
+				log.Errorf("trash: failed to purge expired items: %v", err)
+				continue
+			}
+			if len(res) > 0 {
+				log.Infof("trash: %d item(s) purged", len(res))
+			}
+		}
+	}
+}
+
+// runExportSweeper periodically deletes the exported files whose download links expired until ctx is done.
+func runExportSweeper(ctx context.Context, repos *repo.Container, gateways *gateway.Container, interval time.Duration) {
+	uc := interactor.NewItem(repos, gateways)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			res, err := uc.PurgeExpiredExports(ctx, util.Now())
+			if err != nil {
+				log.Errorf("export: failed to delete expired files: %v", err)
+				continue
+			}
+			if len(res) > 0 {
+				log.Infof("export: %d file(s) deleted", len(res))
+			}
+		}
+	}
+}
+
+// runNotificationDigest periodically emails the pending notifications of the users who prefer digests until ctx is done.
+func runNotificationDigest(ctx context.Context, repos *repo.Container, gateways *gateway.Container, interval time.Duration) {
+	uc := interactor.NewNotification(repos, gateways)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			res, err := uc.SendDigests(ctx)
+			if err != nil {
+				log.Errorf("notification: failed to send digests: %v", err)
+				continue
+			}
+			if len(res) > 0 {
+				log.Infof("notification: %d notification(s) sent in digests", len(res))
+			}
+		}
+	}
+}
+
+// runWebhookRetrier periodically resends the failed webhook deliveries which are due until ctx is done.
+func runWebhookRetrier(ctx context.Context, repos *repo.Container, gateways *gateway.Container, interval time.Duration) {
+	uc := interactor.NewIntegration(repos, gateways)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			res, err := uc.RetryWebhookDeliveries(ctx, util.Now())
+			if err != nil {
+				log.Errorf("webhook: failed to retry deliveries: %v", err)
+				continue
+			}
+			if len(res) > 0 {
+				log.Infof("webhook: %d delivery(s) retried", len(res))
+			}
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package app
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/taskqueue"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interactor"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/file"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearthx/account/accountdomain/user"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/util"
+	"golang.org/x/text/language"
+)
+
+const taskAssetBasePath = "assets"
+
+var (
+	errUnsupportedArchive = errors.New("unsupported archive")
+	errCopierNotAvailable = errors.New("copier is not available")
+)
+
+var webhookClient = &http.Client{Timeout: 30 * time.Second}
+
+// runTaskWorker runs the tasks queued to the built-in task runner until ctx is done.
+func runTaskWorker(ctx context.Context, appCtx *ApplicationContext) {
+	h := newTaskHandler(appCtx)
+	taskqueue.NewWorker(appCtx.Repos.Task, h.Handle, appCtx.Config.TaskQueue).Run(ctx)
+}
+
+// taskHandler runs the tasks in the server in the same way as the worker and Cloud Build do.
+type taskHandler struct {
+	appCtx   *ApplicationContext
+	usecases interfaces.Container
+}
+
+func newTaskHandler(appCtx *ApplicationContext) *taskHandler {
+	return &taskHandler{
+		appCtx: appCtx,
+		usecases: interactor.New(appCtx.Repos, appCtx.Gateways, appCtx.AcRepos, appCtx.AcGateways, interactor.ContainerConfig{
+			SignupSecret:    appCtx.Config.SignupSecret,
+			AuthSrvUIDomain: appCtx.Config.Host_Web,
+			TrashRetention:  appCtx.Config.Trash.Retention,
+		}),
+	}
+}
+
+func (h *taskHandler) Handle(ctx context.Context, m task.Message) error {
+	switch {
+	case m.DecompressAsset != nil:
+		return h.decompressAsset(ctx, m.DecompressAsset)
+	case m.Webhook != nil:
+		return h.sendWebhook(ctx, m.Webhook)
+	case m.Copy != nil:
+		if h.appCtx.Repos.Copier == nil {
+			return errCopierNotAvailable
+		}
+		return h.appCtx.Repos.Copier.Copy(ctx, *m.Copy)
+	case m.Import != nil:
+		return h.importItems(ctx, m.Import)
+	}
+	// compression is not run by Cloud Build either
+	log.Warnfc(ctx, "task: %s is not supported", m.Kind())
+	return nil
+}
+
+func (h *taskHandler) decompressAsset(ctx context.Context, p *task.DecompressAssetPayload) error {
+	aid, err := id.AssetIDFrom(p.AssetID)
+	if err != nil {
+		return fmt.Errorf("invalid asset id: %w", err)
+	}
+	op, err := generateMachineOperator()
+	if err != nil {
+		return err
+	}
+
+	status := asset.ArchiveExtractionStatusDone
+	err = h.extract(ctx, p.Path)
+	if errors.Is(err, errUnsupportedArchive) {
+		// retrying does not help
+		log.Warnfc(ctx, "task: unsupported archive: assetID=%s path=%s", p.AssetID, p.Path)
+		status, err = asset.ArchiveExtractionStatusFailed, nil
+	} else if err != nil {
+		status = asset.ArchiveExtractionStatusFailed
+	}
+
+	if _, err2 := h.usecases.Asset.UpdateFiles(ctx, aid, &status, op); err2 != nil {
+		return errors.Join(err, err2)
+	}
+	return err
+}
+
+// extract uploads the files in the archive next to it in the same layout as the worker.
+func (h *taskHandler) extract(ctx context.Context, p string) error {
+	if path.Ext(p) != ".zip" {
+		return errUnsupportedArchive
+	}
+	base := strings.TrimPrefix(strings.TrimSuffix(p, path.Ext(p)), "/")
+	fileGateway := h.appCtx.Gateways.File
+
+	r, _, err := fileGateway.Read(ctx, path.Join(taskAssetBasePath, p), nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = r.Close() }()
+
+	// zip needs random access to the archive
+	tmp, err := os.CreateTemp("", "reearth-cms-archive-*.zip")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+	size, err := io.Copy(tmp, r)
+	if err != nil {
+		return err
+	}
+
+	zr, err := zip.NewReader(tmp, size)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errUnsupportedArchive, err)
+	}
+
+	for _, f := range zr.File {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		name := path.Clean(f.Name)
+		if strings.HasSuffix(f.Name, "/") || strings.HasPrefix(f.Name, "/") || name == ".." || strings.HasPrefix(name, "../") {
+			continue
+		}
+
+		fr, err := f.Open()
+		if err != nil {
+			return fmt.Errorf("failed to open %s: %w", f.Name, err)
+		}
+		_, err = fileGateway.Upload(ctx, &file.File{
+			Content: fr,
+			Name:    path.Base(name),
+			Size:    int64(f.UncompressedSize64),
+		}, path.Join(taskAssetBasePath, joinArchivePath(base, name)))
+		_ = fr.Close()
+		if err != nil {
+			return fmt.Errorf("failed to upload %s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+// joinArchivePath does not repeat the directory when the archive contains a directory of the same name as itself.
+func joinArchivePath(base, name string) string {
+	if path.Base(base) == strings.Split(name, "/")[0] {
+		return path.Join(path.Dir(base), name)
+	}
+	return path.Join(base, name)
+}
+
+func (h *taskHandler) sendWebhook(ctx context.Context, m *task.WebhookMessage) error {
+	now := util.Now()
+	a, err := postWebhook(ctx, m, now)
+	if err != nil {
+		log.Errorfc(ctx, "task: webhook failed: webhook=%s event=%s err=%v", m.WebhookID, m.EventID, err)
+	}
+
+	if m.DeliveryID == "" {
+		return err
+	}
+	did, err2 := id.WebhookDeliveryIDFrom(m.DeliveryID)
+	if err2 != nil {
+		return err
+	}
+
+	// the failed delivery is retried by the webhook retrier according to the recorded attempt
+	d, err2 := h.appCtx.Repos.WebhookDelivery.FindByID(ctx, did)
+	if err2 != nil {
+		return errors.Join(err, err2)
+	}
+	d.RecordAttempt(a)
+	return h.appCtx.Repos.WebhookDelivery.Save(ctx, d)
+}
+
+func postWebhook(ctx context.Context, m *task.WebhookMessage, now time.Time) (integration.WebhookDeliveryAttempt, error) {
+	a := integration.WebhookDeliveryAttempt{AttemptedAt: now}
+
+	b, err := m.Body()
+	if err != nil {
+		a.Error = err.Error()
+		return a, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.URL, bytes.NewReader(b))
+	if err != nil {
+		a.Error = err.Error()
+		return a, err
+	}
+	req.Header.Set("Reearth-Signature", m.Signature(b, now))
+
+	start := time.Now()
+	res, err := webhookClient.Do(req)
+	a.Latency = time.Since(start)
+	if err != nil {
+		a.Error = err.Error()
+		return a, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	body, _ := io.ReadAll(io.LimitReader(res.Body, integration.MaxWebhookResponseBodyLength))
+	a.StatusCode = res.StatusCode
+	a.ResponseBody = string(body)
+	if !a.Succeeded() {
+		return a, fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+	return a, nil
+}
+
+func (h *taskHandler) importItems(ctx context.Context, p *task.ImportPayload) error {
+	if !p.Validate() {
+		return errors.New("invalid import payload")
+	}
+	mid, err := id.ModelIDFrom(p.ModelId)
+	if err != nil {
+		return err
+	}
+	aid, err := id.AssetIDFrom(p.AssetId)
+	if err != nil {
+		return err
+	}
+	strategy := interfaces.ImportStrategyTypeFromString(p.Strategy)
+	if strategy == "" {
+		return errors.New("invalid strategy")
+	}
+	format := interfaces.ImportFormatTypeFromString(p.Format)
+	if format == "" {
+		return errors.New("invalid format")
+	}
+
+	op, err := h.operator(ctx, p)
+	if err != nil {
+		return fmt.Errorf("failed to generate operator: %w", err)
+	}
+
+	sp, err := h.usecases.Schema.FindByModel(ctx, mid, op)
+	if err != nil {
+		return err
+	}
+	r, _, err := h.usecases.Asset.DownloadByID(ctx, aid, nil, op)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = r.Close() }()
+
+	_, err = h.usecases.Item.Import(ctx, interfaces.ImportItemsParam{
+		SP:           *sp,
+		ModelID:      mid,
+		Format:       format,
+		Strategy:     strategy,
+		MutateSchema: p.MutateSchema,
+		GeoField:     new(p.GeometryFieldKey),
+		Reader:       r,
+	}, op)
+	return err
+}
+
+func (h *taskHandler) operator(ctx context.Context, p *task.ImportPayload) (*usecase.Operator, error) {
+	lang := language.English.String()
+	if p.UserId != "" {
+		uid, err := user.IDFrom(p.UserId)
+		if err != nil {
+			return nil, err
+		}
+		u, err := h.appCtx.Repos.User.FindByID(ctx, uid)
+		if err != nil {
+			return nil, err
+		}
+		return generateUserOperator(ctx, h.appCtx, u, lang)
+	}
+
+	iid, err := id.IntegrationIDFrom(p.IntegrationId)
+	if err != nil {
+		return nil, err
+	}
+	in, err := h.appCtx.Repos.Integration.FindByID(ctx, iid)
+	if err != nil {
+		return nil, err
+	}
+	return generateIntegrationOperator(ctx, h.appCtx, in, lang)
+}
@@ -0,0 +1,48 @@
+package app
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJoinArchivePath(t *testing.T) {
+	assert.Equal(t, "aa/bbb/data/a.txt", joinArchivePath("aa/bbb/data", "a.txt"))
+	assert.Equal(t, "aa/bbb/data/b/a.txt", joinArchivePath("aa/bbb/data", "b/a.txt"))
+	assert.Equal(t, "aa/bbb/data/a.txt", joinArchivePath("aa/bbb/data", "data/a.txt"))
+}
+
+func TestPostWebhook(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	m := &task.WebhookMessage{Secret: "secret", EventID: "e", EventType: "item.create", Timestamp: now}
+	body, _ := m.Body()
+
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		assert.Equal(t, body, b)
+		assert.Equal(t, m.Signature(b, now), r.Header.Get("Reearth-Signature"))
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+	m.URL = srv.URL
+
+	a, err := postWebhook(context.Background(), m, now)
+	assert.NoError(t, err)
+	assert.True(t, a.Succeeded())
+	assert.Equal(t, "ok", a.ResponseBody)
+	assert.Equal(t, now, a.AttemptedAt)
+
+	status = http.StatusInternalServerError
+	a, err = postWebhook(context.Background(), m, now)
+	assert.Error(t, err)
+	assert.False(t, a.Succeeded())
+	assert.Equal(t, http.StatusInternalServerError, a.StatusCode)
+}
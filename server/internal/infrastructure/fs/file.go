@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
@@ -184,6 +185,18 @@ func (f *fileRepo) IssueUploadAssetLink(_ context.Context, _ gateway.IssueUpload
 	return nil, gateway.ErrUnsupportedOperation
 }
 
+// IssueDownloadLink returns the public URL of the file. The local storage cannot sign URLs, so the link does not expire
+// and anyone who knows it can download the file. It is not issued when the assets are private, as the file would be public.
+func (f *fileRepo) IssueDownloadLink(_ context.Context, filename string, _ time.Time) (string, error) {
+	if filename == "" {
+		return "", gateway.ErrInvalidFile
+	}
+	if !f.public {
+		return "", gateway.ErrUnsupportedOperation
+	}
+	return f.publicBase.JoinPath(filename).String(), nil
+}
+
 func (f *fileRepo) UploadedAsset(_ context.Context, _ *asset.Upload) (*file.File, error) {
 	return nil, gateway.ErrUnsupportedOperation
 }
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/pkg/file"
@@ -285,6 +286,22 @@ func TestFile_ListByPrefix(t *testing.T) {
 	}
 }
 
+func TestFile_IssueDownloadLink(t *testing.T) {
+	f, _ := NewFile(mockFs(), "https://example.com/assets", false)
+
+	u, err := f.IssueDownloadLink(context.Background(), "model-export.json", time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/assets/model-export.json", u)
+
+	_, err = f.IssueDownloadLink(context.Background(), "", time.Now())
+	assert.Equal(t, gateway.ErrInvalidFile, err)
+
+	// the link cannot be signed, so it is not issued for private assets
+	f, _ = NewFileWithACL(mockFs(), "https://example.com/assets", "https://example.com/private", false)
+	_, err = f.IssueDownloadLink(context.Background(), "model-export.json", time.Now().Add(time.Hour))
+	assert.Equal(t, gateway.ErrUnsupportedOperation, err)
+}
+
 func mockFs() afero.Fs {
 	files := map[string]string{
 		filepath.Join("assets", "51", "30c89f-8f67-4766-b127-49ee6796d464", "xxx.txt"):          "hello",
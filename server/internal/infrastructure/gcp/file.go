@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"cloud.google.com/go/storage"
 	"github.com/google/uuid"
@@ -276,6 +277,28 @@ func (f *fileRepo) IssueUploadAssetLink(ctx context.Context, param gateway.Issue
 	}, nil
 }
 
+func (f *fileRepo) IssueDownloadLink(ctx context.Context, objectName string, expiresAt time.Time) (string, error) {
+	if objectName == "" {
+		return "", gateway.ErrInvalidFile
+	}
+
+	bucket, err := f.bucket(ctx)
+	if err != nil {
+		return "", err
+	}
+	downloadURL, err := bucket.SignedURL(objectName, &storage.SignedURLOptions{
+		Scheme:  storage.SigningSchemeV4,
+		Method:  http.MethodGet,
+		Expires: expiresAt,
+	})
+	if err != nil {
+		log.Errorf("gcs: failed to issue signed url: %v", err)
+		return "", gateway.ErrUnsupportedOperation
+	}
+
+	return downloadURL, nil
+}
+
 func (f *fileRepo) UploadedAsset(ctx context.Context, u *asset.Upload) (*file.File, error) {
 	p := getGCSObjectPath(u.UUID(), u.FileName())
 	bucket, err := f.bucket(ctx)
@@ -0,0 +1,166 @@
+package imageproc
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/url"
+	"path"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearthx/rerror"
+)
+
+// maxSourcePixels limits the size of the images to be decoded to protect the server from decompression bombs.
+const maxSourcePixels = 50_000_000
+
+type transformer struct {
+	baseURL   *url.URL
+	policy    asset.ImageTransformationPolicy
+	thumbnail asset.ImageTransformation
+}
+
+// NewTransformer returns an image transformer built on the standard library, which can encode images in PNG and JPEG.
+// baseURL is the URL which the assets are served from by the server.
+func NewTransformer(baseURL string, policy asset.ImageTransformationPolicy, thumbnail asset.ImageTransformation) (gateway.ImageTransformer, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, rerror.ErrInternalBy(err)
+	}
+	return &transformer{
+		baseURL:   u,
+		policy:    policy,
+		thumbnail: thumbnail,
+	}, nil
+}
+
+func (t *transformer) Transform(ctx context.Context, r io.Reader, tr asset.ImageTransformation) ([]byte, error) {
+	if tr.Format == asset.ImageFormatWebP {
+		return nil, gateway.ErrUnsupportedImageFormat
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, rerror.ErrInternalBy(err)
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, gateway.ErrUnsupportedImageFormat
+	}
+	if cfg.Width*cfg.Height > maxSourcePixels {
+		return nil, gateway.ErrImageTooLarge
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, gateway.ErrUnsupportedImageFormat
+	}
+
+	dst := transform(src, tr)
+
+	buf := &bytes.Buffer{}
+	switch tr.Format {
+	case asset.ImageFormatJPEG:
+		q := tr.Quality
+		if q == 0 {
+			q = jpeg.DefaultQuality
+		}
+		err = jpeg.Encode(buf, dst, &jpeg.Options{Quality: q})
+	case asset.ImageFormatPNG:
+		err = png.Encode(buf, dst)
+	default:
+		return nil, gateway.ErrUnsupportedImageFormat
+	}
+	if err != nil {
+		return nil, rerror.ErrInternalBy(err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (t *transformer) Allow(tr asset.ImageTransformation, uuid, signature string) bool {
+	return t.policy.Allow(tr, uuid, signature)
+}
+
+func (t *transformer) URL(uuid, fileName string, tr asset.ImageTransformation) string {
+	if len(uuid) < 3 {
+		return ""
+	}
+	return t.policy.URL(t.baseURL.JoinPath(uuid[:2], uuid[2:], path.Base(fileName)).String(), uuid, tr)
+}
+
+func (t *transformer) ThumbnailURL(uuid, fileName string) string {
+	return t.URL(uuid, fileName, t.thumbnail)
+}
+
+// transform resizes the image as specified by the transformation.
+func transform(src image.Image, tr asset.ImageTransformation) image.Image {
+	b := src.Bounds()
+	sw, sh := b.Dx(), b.Dy()
+	if sw == 0 || sh == 0 || (tr.Width == 0 && tr.Height == 0) {
+		return src
+	}
+
+	if tr.Fit == asset.ImageFitCover && tr.Width > 0 && tr.Height > 0 {
+		// crop the center of the image which has the same aspect ratio as the box
+		cw, ch := sw, sh
+		if sw*tr.Height > sh*tr.Width {
+			cw = max(sh*tr.Width/tr.Height, 1)
+		} else {
+			ch = max(sw*tr.Height/tr.Width, 1)
+		}
+		x, y := b.Min.X+(sw-cw)/2, b.Min.Y+(sh-ch)/2
+		return resize(src, image.Rect(x, y, x+cw, y+ch), tr.Width, tr.Height)
+	}
+
+	w, h := tr.Width, tr.Height
+	switch {
+	case w == 0:
+		w = sw * h / sh
+	case h == 0:
+		h = sh * w / sw
+	case sw*h > sh*w:
+		h = sh * w / sw
+	default:
+		w = sw * h / sh
+	}
+	return resize(src, b, max(w, 1), max(h, 1))
+}
+
+// resize scales the area of the image to the size by averaging the pixels which each pixel of the result covers.
+func resize(src image.Image, area image.Rectangle, w, h int) *image.RGBA {
+	s := image.NewRGBA(image.Rect(0, 0, area.Dx(), area.Dy()))
+	draw.Draw(s, s.Bounds(), src, area.Min, draw.Src)
+	sw, sh := area.Dx(), area.Dy()
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := range h {
+		y0 := y * sh / h
+		y1 := max((y+1)*sh/h, y0+1)
+		for x := range w {
+			x0 := x * sw / w
+			x1 := max((x+1)*sw/w, x0+1)
+
+			var sum [4]uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					i := s.PixOffset(sx, sy)
+					for c := range 4 {
+						sum[c] += uint64(s.Pix[i+c])
+					}
+				}
+			}
+
+			n := uint64((x1 - x0) * (y1 - y0))
+			i := dst.PixOffset(x, y)
+			for c := range 4 {
+				dst.Pix[i+c] = uint8(sum[c] / n)
+			}
+		}
+	}
+	return dst
+}
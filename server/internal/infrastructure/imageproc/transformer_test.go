@@ -0,0 +1,63 @@
+package imageproc
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransformer_Transform(t *testing.T) {
+	tr, err := NewTransformer("https://example.com/assets", asset.ImageTransformationPolicy{}, asset.ImageTransformation{Width: 256})
+	assert.NoError(t, err)
+
+	src := image.NewRGBA(image.Rect(0, 0, 200, 100))
+	for x := range 200 {
+		for y := range 100 {
+			src.Set(x, y, color.RGBA{R: uint8(x), A: 255})
+		}
+	}
+	buf := &bytes.Buffer{}
+	assert.NoError(t, png.Encode(buf, src))
+	data := buf.Bytes()
+
+	// contain
+	res, err := tr.Transform(context.Background(), bytes.NewReader(data), asset.ImageTransformation{Width: 50, Height: 50, Format: asset.ImageFormatPNG})
+	assert.NoError(t, err)
+	img, err := png.Decode(bytes.NewReader(res))
+	assert.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 50, 25), img.Bounds())
+
+	// cover
+	res, err = tr.Transform(context.Background(), bytes.NewReader(data), asset.ImageTransformation{Width: 50, Height: 50, Fit: asset.ImageFitCover, Format: asset.ImageFormatJPEG, Quality: 80})
+	assert.NoError(t, err)
+	img, err = jpeg.Decode(bytes.NewReader(res))
+	assert.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 50, 50), img.Bounds())
+	r, _, _, _ := img.At(0, 25).RGBA()
+	assert.InDelta(t, 50, r>>8, 10)
+
+	// webp
+	_, err = tr.Transform(context.Background(), bytes.NewReader(data), asset.ImageTransformation{Width: 50, Format: asset.ImageFormatWebP})
+	assert.ErrorIs(t, err, gateway.ErrUnsupportedImageFormat)
+
+	// not an image
+	_, err = tr.Transform(context.Background(), bytes.NewReader([]byte("hello")), asset.ImageTransformation{Width: 50, Format: asset.ImageFormatPNG})
+	assert.ErrorIs(t, err, gateway.ErrUnsupportedImageFormat)
+}
+
+func TestTransformer_URL(t *testing.T) {
+	tr, err := NewTransformer("https://example.com/assets", asset.ImageTransformationPolicy{}, asset.ImageTransformation{Width: 256})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "https://example.com/assets/01/23456789/a.png?f=png&w=10", tr.URL("0123456789", "a.png", asset.ImageTransformation{Width: 10, Format: asset.ImageFormatPNG}))
+	assert.Equal(t, "https://example.com/assets/01/23456789/a.png?w=256", tr.ThumbnailURL("0123456789", "a.png"))
+	assert.Equal(t, "", tr.URL("", "a.png", asset.ImageTransformation{Width: 10}))
+}
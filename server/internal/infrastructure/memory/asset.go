@@ -11,6 +11,7 @@ import (
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
 	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
 )
 
 type Asset struct {
@@ -88,6 +89,25 @@ func (r *Asset) Search(_ context.Context, id id.ProjectID, filter repo.AssetFilt
 		}
 		// Content type filter can't be performed as it's not stored in memory
 
+		// Folder filter
+		if filter.HasFolderFilter() {
+			if f := v.Folder(); f == nil {
+				if !filter.RootFolder {
+					return false
+				}
+			} else if !filter.Folders.Has(*f) {
+				return false
+			}
+		}
+
+		// Metadata filter
+		for _, mf := range filter.Metadata {
+			f := v.Metadata().Field(mf.Field)
+			if f == nil || !lo.ContainsBy(f.Value().Values(), mf.Value.Equal) {
+				return false
+			}
+		}
+
 		return true
 	})).SortByID()
 
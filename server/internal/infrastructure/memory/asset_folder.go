@@ -0,0 +1,94 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+)
+
+type AssetFolder struct {
+	data *util.SyncMap[id.AssetFolderID, *asset.Folder]
+	err  error
+	f    repo.ProjectFilter
+}
+
+func NewAssetFolder() repo.AssetFolder {
+	return &AssetFolder{
+		data: &util.SyncMap[id.AssetFolderID, *asset.Folder]{},
+	}
+}
+
+func (r *AssetFolder) Filtered(f repo.ProjectFilter) repo.AssetFolder {
+	return &AssetFolder{
+		data: r.data,
+		f:    r.f.Merge(f),
+		err:  r.err,
+	}
+}
+
+func (r *AssetFolder) FindByID(_ context.Context, fid id.AssetFolderID) (*asset.Folder, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return rerror.ErrIfNil(r.data.Find(func(k id.AssetFolderID, v *asset.Folder) bool {
+		return k == fid && r.f.CanRead(v.Project())
+	}), rerror.ErrNotFound)
+}
+
+func (r *AssetFolder) FindByIDs(_ context.Context, ids id.AssetFolderIDList) (asset.FolderList, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	res := r.data.FindAll(func(k id.AssetFolderID, v *asset.Folder) bool {
+		return ids.Has(k) && r.f.CanRead(v.Project())
+	})
+	return asset.FolderList(res).SortByID(), nil
+}
+
+func (r *AssetFolder) FindByProject(_ context.Context, pid id.ProjectID) (asset.FolderList, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if !r.f.CanRead(pid) {
+		return nil, nil
+	}
+
+	res := r.data.FindAll(func(_ id.AssetFolderID, v *asset.Folder) bool {
+		return v.Project() == pid
+	})
+	return asset.FolderList(res).SortByID(), nil
+}
+
+func (r *AssetFolder) Save(_ context.Context, f *asset.Folder) error {
+	if r.err != nil {
+		return r.err
+	}
+	if !r.f.CanWrite(f.Project()) {
+		return repo.ErrOperationDenied
+	}
+
+	r.data.Store(f.ID(), f)
+	return nil
+}
+
+func (r *AssetFolder) Remove(_ context.Context, fid id.AssetFolderID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if f, ok := r.data.Load(fid); ok && r.f.CanWrite(f.Project()) {
+		r.data.Delete(fid)
+		return nil
+	}
+	return rerror.ErrNotFound
+}
+
+func SetAssetFolderError(r repo.AssetFolder, err error) {
+	r.(*AssetFolder).err = err
+}
@@ -0,0 +1,56 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssetFolder(t *testing.T) {
+	ctx := context.Background()
+	pid1, pid2 := id.NewProjectID(), id.NewProjectID()
+	uid := accountdomain.NewUserID()
+	f1 := asset.NewFolder().NewID().Project(pid1).Name("a").CreatedByUser(uid).MustBuild()
+	f2 := asset.NewFolder().NewID().Project(pid1).Name("b").Parent(f1.ID().Ref()).CreatedByUser(uid).MustBuild()
+	f3 := asset.NewFolder().NewID().Project(pid2).Name("c").CreatedByUser(uid).MustBuild()
+
+	r := NewAssetFolder()
+	for _, f := range []*asset.Folder{f1, f2, f3} {
+		assert.NoError(t, r.Save(ctx, f))
+	}
+
+	got, err := r.FindByID(ctx, f2.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, f2, got)
+
+	gotList, err := r.FindByProject(ctx, pid1)
+	assert.NoError(t, err)
+	assert.Equal(t, asset.FolderList{f1, f2}, gotList)
+
+	gotList, err = r.FindByIDs(ctx, id.AssetFolderIDList{f1.ID(), f3.ID()})
+	assert.NoError(t, err)
+	assert.Equal(t, asset.FolderList{f1, f3}, gotList)
+
+	// filtered
+	fr := r.Filtered(repo.ProjectFilter{Readable: id.ProjectIDList{pid1}, Writable: id.ProjectIDList{pid1}})
+	_, err = fr.FindByID(ctx, f3.ID())
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+	assert.ErrorIs(t, fr.Save(ctx, f3), repo.ErrOperationDenied)
+	assert.ErrorIs(t, fr.Remove(ctx, f3.ID()), rerror.ErrNotFound)
+
+	assert.NoError(t, r.Remove(ctx, f2.ID()))
+	_, err = r.FindByID(ctx, f2.ID())
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+
+	wantErr := errors.New("test")
+	SetAssetFolderError(r, wantErr)
+	_, err = r.FindByProject(ctx, pid1)
+	assert.Same(t, wantErr, err)
+}
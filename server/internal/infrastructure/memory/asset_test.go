@@ -7,6 +7,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
@@ -392,6 +393,34 @@ func TestAssetRepo_Search(t *testing.T) {
 	}
 }
 
+func TestAssetRepo_Search_Metadata(t *testing.T) {
+	ctx := context.Background()
+	pid := id.NewProjectID()
+	fid := id.NewFieldID()
+	a1 := asset.New().NewID().Project(pid).NewUUID().CreatedByUser(accountdomain.NewUserID()).Size(1).Thread(id.NewThreadID().Ref()).
+		Metadata(asset.Metadata{asset.NewMetadataField(fid, value.NewMultiple(value.TypeTag, []any{"a", "b"}))}).MustBuild()
+	a2 := asset.New().NewID().Project(pid).NewUUID().CreatedByUser(accountdomain.NewUserID()).Size(1).Thread(id.NewThreadID().Ref()).
+		Metadata(asset.Metadata{asset.NewMetadataField(fid, value.NewMultiple(value.TypeTag, []any{"c"}))}).MustBuild()
+	a3 := asset.New().NewID().Project(pid).NewUUID().CreatedByUser(accountdomain.NewUserID()).Size(1).Thread(id.NewThreadID().Ref()).MustBuild()
+
+	r := NewAsset()
+	assert.NoError(t, r.Save(ctx, a1))
+	assert.NoError(t, r.Save(ctx, a2))
+	assert.NoError(t, r.Save(ctx, a3))
+
+	got, _, err := r.Search(ctx, pid, repo.AssetFilter{
+		Metadata: []repo.AssetMetadataFilter{{Field: fid, Value: value.TypeTag.Value("b")}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, asset.List{a1}, got)
+
+	got, _, err = r.Search(ctx, pid, repo.AssetFilter{
+		Metadata: []repo.AssetMetadataFilter{{Field: id.NewFieldID(), Value: value.TypeTag.Value("b")}},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
+
 func TestAssetRepo_Delete(t *testing.T) {
 	pid1 := id.NewProjectID()
 	id1 := id.NewAssetID()
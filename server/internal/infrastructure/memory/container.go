@@ -8,23 +8,31 @@ import (
 
 func New() *repo.Container {
 	return &repo.Container{
-		Asset:             NewAsset(),
-		AssetFile:         NewAssetFile(),
-		Lock:              NewLock(),
-		Request:           NewRequest(),
-		User:              accountmemory.NewUser(),
-		Workspace:         accountmemory.NewWorkspace(),
-		Project:           NewProject(),
-		Model:             NewModel(),
-		Item:              NewItem(),
-		View:              NewView(),
-		Schema:            NewSchema(),
-		Integration:       NewIntegration(),
-		Thread:            NewThread(),
-		Event:             NewEvent(),
-		Group:             NewGroup(),
-		WorkspaceSettings: NewWorkspaceSettings(),
-		Job:               NewJob(),
-		Transaction:       &usecasex.NopTransaction{},
+		Asset:                  NewAsset(),
+		AssetFile:              NewAssetFile(),
+		AssetFolder:            NewAssetFolder(),
+		Lock:                   NewLock(),
+		Request:                NewRequest(),
+		RequestWorkflow:        NewRequestWorkflow(),
+		User:                   accountmemory.NewUser(),
+		Workspace:              accountmemory.NewWorkspace(),
+		Project:                NewProject(),
+		Model:                  NewModel(),
+		Item:                   NewItem(),
+		View:                   NewView(),
+		Schema:                 NewSchema(),
+		Integration:            NewIntegration(),
+		Thread:                 NewThread(),
+		Event:                  NewEvent(),
+		Group:                  NewGroup(),
+		WorkspaceSettings:      NewWorkspaceSettings(),
+		Job:                    NewJob(),
+		Notification:           NewNotification(),
+		NotificationPreference: NewNotificationPreference(),
+		Schedule:               NewSchedule(),
+		Trash:                  NewTrash(),
+		WebhookDelivery:        NewWebhookDelivery(),
+		Task:                   NewTask(),
+		Transaction:            &usecasex.NopTransaction{},
 	}
 }
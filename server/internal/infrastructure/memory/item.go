@@ -11,6 +11,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/item/view"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/task"
 	"github.com/reearth/reearth-cms/server/pkg/value"
@@ -40,6 +41,9 @@ func (r *Item) FindByAssets(_ context.Context, list id.AssetIDList, ref *version
 	var res item.VersionedList
 	r.data.Range(func(k item.ID, v *version.Values[*item.Item]) bool {
 		itv := v.Get(ref.OrLatest().OrVersion())
+		if itv == nil {
+			return true
+		}
 		it := itv.Value()
 		if it.AssetIDs().Has(list...) {
 			res = append(res, itv)
@@ -76,6 +80,9 @@ func (r *Item) FindBySchema(_ context.Context, schemaID id.SchemaID, ref *versio
 	var res item.VersionedList
 	r.data.Range(func(k item.ID, v *version.Values[*item.Item]) bool {
 		itv := v.Get(ref.OrLatest().OrVersion())
+		if itv == nil {
+			return true
+		}
 		it := itv.Value()
 		if it.Schema() == schemaID && r.f.CanRead(it.Project()) {
 			res = append(res, itv)
@@ -93,6 +100,9 @@ func (r *Item) FindByModel(_ context.Context, modelID id.ModelID, ref *version.R
 	var res item.VersionedList
 	r.data.Range(func(k item.ID, v *version.Values[*item.Item]) bool {
 		itv := v.Get(ref.OrLatest().OrVersion())
+		if itv == nil {
+			return true
+		}
 		it := itv.Value()
 		if it.Model() == modelID && r.f.CanRead(it.Project()) {
 			res = append(res, itv)
@@ -156,6 +166,9 @@ func (r *Item) LastModifiedByModel(_ context.Context, modelID id.ModelID) (time.
 	var latest time.Time
 	r.data.Range(func(k item.ID, v *version.Values[*item.Item]) bool {
 		itv := v.Get(version.Latest.OrVersion())
+		if itv == nil {
+			return true
+		}
 		it := itv.Value()
 		if it.Model() != modelID {
 			return true
@@ -253,6 +266,9 @@ func (r *Item) RemoveByModel(_ context.Context, modelID id.ModelID) error {
 
 	r.data.Range(func(k item.ID, v *version.Values[*item.Item]) bool {
 		itv := v.Get(version.Latest.OrVersion())
+		if itv == nil {
+			itv = v.Get(version.Trash.OrVersion())
+		}
 		if itv != nil && itv.Value().Model() == modelID && r.f.CanWrite(itv.Value().Project()) {
 			r.data.Delete(k)
 		}
@@ -274,6 +290,10 @@ func (r *Item) BatchRemove(_ context.Context, itemIDs id.ItemIDList) error {
 	itemsToDelete := make([]id.ItemID, 0, len(itemIDs))
 	for _, itemID := range itemIDs {
 		item, _ := r.data.Load(itemID, version.Latest.OrVersion())
+		if item == nil {
+			// trashed items are purged as well
+			item, _ = r.data.Load(itemID, version.Trash.OrVersion())
+		}
 		if item != nil {
 			if !r.f.CanWrite(item.Value().Project()) {
 				return repo.ErrOperationDenied
@@ -296,6 +316,9 @@ func (r *Item) IsArchived(_ context.Context, itemID id.ItemID) (bool, error) {
 	}
 
 	i, _ := r.data.Load(itemID, version.Latest.OrVersion())
+	if i == nil {
+		i, _ = r.data.Load(itemID, version.Trash.OrVersion())
+	}
 	if i == nil || !r.f.CanRead(i.Value().Project()) {
 		return false, nil
 	}
@@ -321,6 +344,38 @@ func (r *Item) Archive(_ context.Context, itemID id.ItemID, projectID id.Project
 	return nil
 }
 
+func (r *Item) Trash(_ context.Context, itemIDs id.ItemIDList, projectID id.ProjectID, trashed bool) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if !r.f.CanWrite(projectID) {
+		return repo.ErrOperationDenied
+	}
+
+	from, to := version.Latest, version.Trash
+	if !trashed {
+		from, to = to, from
+	}
+
+	for _, itemID := range itemIDs {
+		iv, _ := r.data.Load(itemID, from.OrVersion())
+		if iv == nil || iv.Value().Project() != projectID {
+			continue
+		}
+
+		if trashed {
+			r.data.UpdateRef(itemID, version.Public, nil)
+			r.data.MoveRef(itemID, from, to)
+			r.data.Archive(itemID, true)
+		} else {
+			r.data.Archive(itemID, false)
+			r.data.MoveRef(itemID, from, to)
+		}
+	}
+	return nil
+}
+
 func SetItemError(r repo.Item, err error) {
 	r.(*Item).err = err
 }
@@ -336,7 +391,7 @@ func sortItems(items []*version.Value[*item.Item]) {
 }
 
 func (r *Item) Search(_ context.Context, sp schema.Package, q *item.Query, pagination *usecasex.Pagination) (item.VersionedList, *usecasex.PageInfo, error) {
-	// TODO: support filters, sort, and pagination
+	// TODO: support non-spatial filters, sort, and pagination
 	if r.err != nil {
 		return nil, nil, r.err
 	}
@@ -346,6 +401,9 @@ func (r *Item) Search(_ context.Context, sp schema.Package, q *item.Query, pagin
 
 	r.data.Range(func(k item.ID, v *version.Values[*item.Item]) bool {
 		it := v.Get(version.Latest.OrVersion())
+		if it == nil {
+			return true
+		}
 		itv := it.Value()
 		searchMatched := qq == ""
 		if !searchMatched {
@@ -362,7 +420,8 @@ func (r *Item) Search(_ context.Context, sp schema.Package, q *item.Query, pagin
 		}
 		schemaMatched := q.Schema() == nil || itv.Schema() == *q.Schema()
 		modelMatched := itv.Model() == q.Model()
-		if searchMatched && schemaMatched && modelMatched && r.f.CanRead(itv.Project()) {
+		spatialMatched := matchSpatial(itv, q.Filter())
+		if searchMatched && schemaMatched && modelMatched && spatialMatched && r.f.CanRead(itv.Project()) {
 			res = append(res, it)
 		}
 		return true
@@ -370,6 +429,25 @@ func (r *Item) Search(_ context.Context, sp schema.Package, q *item.Query, pagin
 	return res, nil, nil
 }
 
+// matchSpatial reports whether the item satisfies the spatial conditions required by the filter.
+// Areas are evaluated on a plane unlike MongoDB, see view.SpatialCondition.Match.
+func matchSpatial(i *item.Item, c *view.Condition) bool {
+	if c == nil {
+		return true
+	}
+	return lo.EveryBy(c.RequiredSpatialConditions(), func(sc view.SpatialCondition) bool {
+		if sc.Field.Type != view.FieldTypeField || sc.Field.ID == nil {
+			return true
+		}
+		f := i.Field(*sc.Field.ID)
+		if f == nil {
+			return false
+		}
+		geometries, _ := f.Value().ValuesJson()
+		return sc.Match(geometries)
+	})
+}
+
 func (r *Item) FindByModelAndValue(_ context.Context, modelID id.ModelID, fields []repo.FieldAndValue, ref *version.Ref) (item.VersionedList, error) {
 	if r.err != nil {
 		return nil, r.err
@@ -378,6 +456,9 @@ func (r *Item) FindByModelAndValue(_ context.Context, modelID id.ModelID, fields
 	var res item.VersionedList
 	r.data.Range(func(k item.ID, v *version.Values[*item.Item]) bool {
 		itv := v.Get(ref.OrLatest().OrVersion())
+		if itv == nil {
+			return true
+		}
 		it := itv.Value()
 		if it.Model() == modelID {
 			for _, f := range fields {
@@ -401,6 +482,9 @@ func (r *Item) CountByModel(_ context.Context, modelID id.ModelID) (int, error)
 	count := 0
 	r.data.Range(func(k item.ID, v *version.Values[*item.Item]) bool {
 		itv := v.Get(version.Latest.OrVersion())
+		if itv == nil {
+			return true
+		}
 		it := itv.Value()
 		if it.Model() == modelID && r.f.CanRead(it.Project()) {
 			count++
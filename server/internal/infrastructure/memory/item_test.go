@@ -89,6 +89,7 @@ func TestItem_MemorySpecific_Errors(t *testing.T) {
 	assert.Same(t, wantErr, r.Remove(ctx, i.ID()))
 	assert.Same(t, wantErr, r.BatchRemove(ctx, id.ItemIDList{i.ID()}))
 	assert.Same(t, wantErr, r.UpdateRef(ctx, i.ID(), version.Ref("xxx"), nil))
+	assert.Same(t, wantErr, r.Trash(ctx, id.ItemIDList{i.ID()}, i.Project(), true))
 	_, _, err = r.Search(ctx, schema.Package{}, item.NewQuery(i.Project(), i.Model(), nil, "", nil), nil)
 	assert.Same(t, wantErr, err)
 	_, err = r.FindByModelAndValue(ctx, i.Model(), nil, nil)
@@ -101,6 +101,16 @@ func (m *VersionedSyncMap[K, V]) UpdateRef(key K, ref version.Ref, vr *version.V
 	})
 }
 
+func (m *VersionedSyncMap[K, V]) MoveRef(key K, from, to version.Ref) {
+	m.Range(func(k K, v *version.Values[V]) bool {
+		if k == key {
+			v.MoveRef(from, to)
+			return false
+		}
+		return true
+	})
+}
+
 func (m *VersionedSyncMap[K, V]) IsArchived(key K) bool {
 	v, _ := m.m.Load(key)
 	return v.IsArchived()
@@ -320,3 +320,25 @@ func TestVersionedSyncMap_UpdateRef(t *testing.T) {
 		})
 	}
 }
+
+func TestVersionedSyncMap_MoveRef(t *testing.T) {
+	vx := version.New()
+	target := &VersionedSyncMap[string, string]{
+		m: util.SyncMapFrom(
+			map[string]*version.Values[string]{
+				"1": version.MustBeValues(version.NewValue(vx, nil, version.NewRefs(version.Latest), time.Time{}, "a")),
+				"2": version.MustBeValues(version.NewValue(vx, nil, version.NewRefs(version.Latest), time.Time{}, "a")),
+			},
+		),
+	}
+
+	target.MoveRef("1", version.Latest, version.Trash)
+
+	_, ok := target.Load("1", version.Latest.OrVersion())
+	assert.False(t, ok)
+	got, ok := target.Load("1", version.Trash.OrVersion())
+	assert.True(t, ok)
+	assert.Equal(t, "a", got.Value())
+	_, ok = target.Load("2", version.Latest.OrVersion())
+	assert.True(t, ok)
+}
@@ -0,0 +1,157 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/reearth/reearthx/util"
+)
+
+type Notification struct {
+	data *util.SyncMap[id.NotificationID, *notification.Notification]
+	err  error
+}
+
+func NewNotification() repo.Notification {
+	return &Notification{
+		data: &util.SyncMap[id.NotificationID, *notification.Notification]{},
+	}
+}
+
+func (r *Notification) FindByID(_ context.Context, nid id.NotificationID) (*notification.Notification, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	n, ok := r.data.Load(nid)
+	if !ok {
+		return nil, rerror.ErrNotFound
+	}
+	return n.Clone(), nil
+}
+
+func (r *Notification) FindByUser(_ context.Context, uid accountdomain.UserID, unreadOnly bool, _ *usecasex.Pagination) (notification.List, *usecasex.PageInfo, error) {
+	if r.err != nil {
+		return nil, nil, r.err
+	}
+
+	result := r.filter(func(n *notification.Notification) bool {
+		return n.User() == uid && (!unreadOnly || !n.IsRead())
+	})
+
+	var startCursor, endCursor *usecasex.Cursor
+	if len(result) > 0 {
+		startCursor = new(usecasex.Cursor(result[0].ID().String()))
+		endCursor = new(usecasex.Cursor(result[len(result)-1].ID().String()))
+	}
+
+	return result, usecasex.NewPageInfo(
+		int64(len(result)),
+		startCursor,
+		endCursor,
+		false,
+		false,
+	), nil
+}
+
+func (r *Notification) FindUnreadByUser(_ context.Context, uid accountdomain.UserID) (notification.List, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return r.filter(func(n *notification.Notification) bool {
+		return n.User() == uid && !n.IsRead()
+	}), nil
+}
+
+func (r *Notification) CountUnreadByUser(ctx context.Context, uid accountdomain.UserID) (int64, error) {
+	res, err := r.FindUnreadByUser(ctx, uid)
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(res)), nil
+}
+
+func (r *Notification) FindEmailPending(_ context.Context) (notification.List, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return r.filter(func(n *notification.Notification) bool {
+		return n.EmailPending()
+	}), nil
+}
+
+func (r *Notification) SaveAll(_ context.Context, l notification.List) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	for _, n := range l {
+		r.data.Store(n.ID(), n.Clone())
+	}
+	return nil
+}
+
+// filter returns the matched notifications from the newest one.
+func (r *Notification) filter(f func(*notification.Notification) bool) notification.List {
+	result := notification.List{}
+	r.data.Range(func(_ id.NotificationID, n *notification.Notification) bool {
+		if f(n) {
+			result = append(result, n.Clone())
+		}
+		return true
+	})
+	slices.SortFunc(result, func(a, b *notification.Notification) int {
+		return b.ID().Compare(a.ID())
+	})
+	return result
+}
+
+func SetNotificationError(r repo.Notification, err error) {
+	r.(*Notification).err = err
+}
+
+type NotificationPreference struct {
+	data *util.SyncMap[accountdomain.UserID, *notification.Preference]
+	err  error
+}
+
+func NewNotificationPreference() repo.NotificationPreference {
+	return &NotificationPreference{
+		data: &util.SyncMap[accountdomain.UserID, *notification.Preference]{},
+	}
+}
+
+func (r *NotificationPreference) FindByUsers(_ context.Context, ids accountdomain.UserIDList) (notification.PreferenceList, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	result := notification.PreferenceList{}
+	for _, uid := range ids {
+		if p, ok := r.data.Load(uid); ok {
+			result = append(result, p.Clone())
+		}
+	}
+	return result, nil
+}
+
+func (r *NotificationPreference) Save(_ context.Context, p *notification.Preference) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.data.Store(p.User(), p.Clone())
+	return nil
+}
+
+func SetNotificationPreferenceError(r repo.NotificationPreference, err error) {
+	r.(*NotificationPreference).err = err
+}
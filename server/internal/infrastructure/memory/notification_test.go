@@ -0,0 +1,87 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestNotification(uid accountdomain.UserID) *notification.Notification {
+	return notification.New().
+		NewID().
+		Workspace(accountdomain.NewWorkspaceID()).
+		User(uid).
+		Type(notification.TypeComment).
+		MustBuild()
+}
+
+func TestNotification_FindByUser(t *testing.T) {
+	ctx := context.Background()
+	u1, u2 := accountdomain.NewUserID(), accountdomain.NewUserID()
+	r := NewNotification()
+
+	n1 := newTestNotification(u1)
+	n2 := newTestNotification(u1)
+	n2.MarkAsRead()
+	n3 := newTestNotification(u2)
+	n3.SetEmailPending(true)
+	assert.NoError(t, r.SaveAll(ctx, notification.List{n1, n2, n3}))
+
+	got, err := r.FindByID(ctx, n1.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, n1, got)
+	_, err = r.FindByID(ctx, id.NewNotificationID())
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	// the newest first
+	res, pi, err := r.FindByUser(ctx, u1, false, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, notification.List{n2, n1}, res)
+	assert.Equal(t, int64(2), pi.TotalCount)
+
+	res, _, err = r.FindByUser(ctx, u1, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, notification.List{n1}, res)
+
+	res, err = r.FindUnreadByUser(ctx, u1)
+	assert.NoError(t, err)
+	assert.Equal(t, notification.List{n1}, res)
+
+	c, err := r.CountUnreadByUser(ctx, u1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), c)
+
+	res, err = r.FindEmailPending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, notification.List{n3}, res)
+
+	wantErr := errors.New("test")
+	SetNotificationError(r, wantErr)
+	_, _, err = r.FindByUser(ctx, u1, false, nil)
+	assert.Same(t, wantErr, err)
+	assert.Same(t, wantErr, r.SaveAll(ctx, nil))
+}
+
+func TestNotificationPreference(t *testing.T) {
+	ctx := context.Background()
+	u1, u2 := accountdomain.NewUserID(), accountdomain.NewUserID()
+	r := NewNotificationPreference()
+
+	p := notification.NewPreference(u1, notification.EmailFrequencyDigest, []notification.Type{notification.TypeComment})
+	assert.NoError(t, r.Save(ctx, p))
+
+	res, err := r.FindByUsers(ctx, accountdomain.UserIDList{u1, u2})
+	assert.NoError(t, err)
+	assert.Equal(t, notification.PreferenceList{p}, res)
+
+	wantErr := errors.New("test")
+	SetNotificationPreferenceError(r, wantErr)
+	_, err = r.FindByUsers(ctx, accountdomain.UserIDList{u1})
+	assert.Same(t, wantErr, err)
+}
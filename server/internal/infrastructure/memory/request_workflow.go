@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+)
+
+type RequestWorkflow struct {
+	data *util.SyncMap[id.WorkflowID, *request.Workflow]
+	err  error
+}
+
+func NewRequestWorkflow() repo.RequestWorkflow {
+	return &RequestWorkflow{
+		data: &util.SyncMap[id.WorkflowID, *request.Workflow]{},
+	}
+}
+
+func (r *RequestWorkflow) FindByID(_ context.Context, workflowID id.WorkflowID) (*request.Workflow, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	w, ok := r.data.Load(workflowID)
+	if !ok {
+		return nil, rerror.ErrNotFound
+	}
+	return w.Clone(), nil
+}
+
+func (r *RequestWorkflow) FindByProject(_ context.Context, projectID id.ProjectID) (request.WorkflowList, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	result := request.WorkflowList{}
+	r.data.Range(func(_ id.WorkflowID, w *request.Workflow) bool {
+		if w.Project() == projectID {
+			result = append(result, w.Clone())
+		}
+		return true
+	})
+	slices.SortFunc(result, func(a, b *request.Workflow) int {
+		return a.ID().Compare(b.ID())
+	})
+	return result, nil
+}
+
+func (r *RequestWorkflow) Save(_ context.Context, w *request.Workflow) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.data.Store(w.ID(), w.Clone())
+	return nil
+}
+
+func (r *RequestWorkflow) Remove(_ context.Context, workflowID id.WorkflowID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if _, ok := r.data.Load(workflowID); !ok {
+		return rerror.ErrNotFound
+	}
+	r.data.Delete(workflowID)
+	return nil
+}
+
+func SetRequestWorkflowError(r repo.RequestWorkflow, err error) {
+	r.(*RequestWorkflow).err = err
+}
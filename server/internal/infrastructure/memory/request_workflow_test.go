@@ -0,0 +1,82 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestWorkflow(pid id.ProjectID, mid *id.ModelID) *request.Workflow {
+	s, _ := request.NewStage("editor", accountdomain.UserIDList{accountdomain.NewUserID()}, 1)
+	return request.NewWorkflow().
+		NewID().
+		Workspace(accountdomain.NewWorkspaceID()).
+		Project(pid).
+		Model(mid).
+		Stages(request.StageList{s}).
+		MustBuild()
+}
+
+func TestRequestWorkflow_FindByID(t *testing.T) {
+	ctx := context.Background()
+	r := NewRequestWorkflow()
+	w := newTestWorkflow(id.NewProjectID(), nil)
+	assert.NoError(t, r.Save(ctx, w))
+
+	got, err := r.FindByID(ctx, w.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, w, got)
+
+	got, err = r.FindByID(ctx, id.NewWorkflowID())
+	assert.Nil(t, got)
+	assert.Equal(t, rerror.ErrNotFound, err)
+}
+
+func TestRequestWorkflow_FindByProject(t *testing.T) {
+	ctx := context.Background()
+	pid := id.NewProjectID()
+	r := NewRequestWorkflow()
+
+	w1 := newTestWorkflow(pid, nil)
+	w2 := newTestWorkflow(pid, id.NewModelID().Ref())
+	w3 := newTestWorkflow(id.NewProjectID(), nil)
+	for _, w := range []*request.Workflow{w1, w2, w3} {
+		assert.NoError(t, r.Save(ctx, w))
+	}
+
+	got, err := r.FindByProject(ctx, pid)
+	assert.NoError(t, err)
+	assert.Equal(t, request.WorkflowList{w1, w2}, got)
+}
+
+func TestRequestWorkflow_Remove(t *testing.T) {
+	ctx := context.Background()
+	r := NewRequestWorkflow()
+	w := newTestWorkflow(id.NewProjectID(), nil)
+	assert.NoError(t, r.Save(ctx, w))
+
+	assert.NoError(t, r.Remove(ctx, w.ID()))
+	_, err := r.FindByID(ctx, w.ID())
+	assert.Equal(t, rerror.ErrNotFound, err)
+	assert.Equal(t, rerror.ErrNotFound, r.Remove(ctx, w.ID()))
+}
+
+func TestRequestWorkflow_Error(t *testing.T) {
+	ctx := context.Background()
+	wantErr := errors.New("test")
+	r := NewRequestWorkflow()
+	SetRequestWorkflowError(r, wantErr)
+
+	_, err := r.FindByID(ctx, id.NewWorkflowID())
+	assert.Same(t, wantErr, err)
+	_, err = r.FindByProject(ctx, id.NewProjectID())
+	assert.Same(t, wantErr, err)
+	assert.Same(t, wantErr, r.Save(ctx, newTestWorkflow(id.NewProjectID(), nil)))
+	assert.Same(t, wantErr, r.Remove(ctx, id.NewWorkflowID()))
+}
@@ -0,0 +1,86 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+)
+
+type Schedule struct {
+	data *util.SyncMap[id.ScheduleID, *schedule.Schedule]
+	err  error
+}
+
+func NewSchedule() repo.Schedule {
+	return &Schedule{
+		data: &util.SyncMap[id.ScheduleID, *schedule.Schedule]{},
+	}
+}
+
+func (r *Schedule) FindByID(_ context.Context, scheduleID id.ScheduleID) (*schedule.Schedule, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	s := r.data.Find(func(k id.ScheduleID, v *schedule.Schedule) bool {
+		return k == scheduleID
+	})
+
+	if s != nil {
+		return s.Clone(), nil
+	}
+	return nil, rerror.ErrNotFound
+}
+
+func (r *Schedule) FindByProject(_ context.Context, projectID id.ProjectID, status *schedule.Status) (schedule.List, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return r.filter(func(s *schedule.Schedule) bool {
+		return s.Project() == projectID && (status == nil || s.Status() == *status)
+	}), nil
+}
+
+func (r *Schedule) FindDue(_ context.Context, now time.Time) (schedule.List, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return r.filter(func(s *schedule.Schedule) bool {
+		return s.IsDue(now)
+	}), nil
+}
+
+func (r *Schedule) Save(_ context.Context, s *schedule.Schedule) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.data.Store(s.ID(), s.Clone())
+	return nil
+}
+
+func (r *Schedule) filter(f func(*schedule.Schedule) bool) schedule.List {
+	result := schedule.List{}
+	r.data.Range(func(_ id.ScheduleID, s *schedule.Schedule) bool {
+		if f(s) {
+			result = append(result, s.Clone())
+		}
+		return true
+	})
+	slices.SortFunc(result, func(a, b *schedule.Schedule) int {
+		return a.ScheduledAt().Compare(b.ScheduledAt())
+	})
+	return result
+}
+
+func SetScheduleError(r repo.Schedule, err error) {
+	r.(*Schedule).err = err
+}
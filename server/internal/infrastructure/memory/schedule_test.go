@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestSchedule(pid id.ProjectID, at time.Time) *schedule.Schedule {
+	return schedule.New().
+		NewID().
+		Project(pid).
+		Model(id.NewModelID()).
+		Items(id.ItemIDList{id.NewItemID()}).
+		Action(schedule.ActionPublish).
+		ScheduledAt(at).
+		User(accountdomain.NewUserID()).
+		MustBuild()
+}
+
+func TestSchedule_FindByID(t *testing.T) {
+	ctx := context.Background()
+	r := NewSchedule()
+	s := newTestSchedule(id.NewProjectID(), time.Now())
+	assert.NoError(t, r.Save(ctx, s))
+
+	got, err := r.FindByID(ctx, s.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, s, got)
+
+	got, err = r.FindByID(ctx, id.NewScheduleID())
+	assert.Nil(t, got)
+	assert.Equal(t, rerror.ErrNotFound, err)
+}
+
+func TestSchedule_FindByProject(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	pid := id.NewProjectID()
+	r := NewSchedule()
+
+	s1 := newTestSchedule(pid, now.Add(time.Hour))
+	s2 := newTestSchedule(pid, now)
+	assert.NoError(t, s2.Cancel())
+	s3 := newTestSchedule(id.NewProjectID(), now)
+	for _, s := range []*schedule.Schedule{s1, s2, s3} {
+		assert.NoError(t, r.Save(ctx, s))
+	}
+
+	got, err := r.FindByProject(ctx, pid, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, schedule.List{s2, s1}, got)
+
+	pending := schedule.StatusPending
+	got, err = r.FindByProject(ctx, pid, &pending)
+	assert.NoError(t, err)
+	assert.Equal(t, schedule.List{s1}, got)
+}
+
+func TestSchedule_FindDue(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	pid := id.NewProjectID()
+	r := NewSchedule()
+
+	s1 := newTestSchedule(pid, now.Add(-time.Hour))
+	s2 := newTestSchedule(pid, now.Add(time.Hour))
+	s3 := newTestSchedule(pid, now.Add(-time.Minute))
+	s3.Complete()
+	s4 := newTestSchedule(id.NewProjectID(), now)
+	for _, s := range []*schedule.Schedule{s1, s2, s3, s4} {
+		assert.NoError(t, r.Save(ctx, s))
+	}
+
+	got, err := r.FindDue(ctx, now)
+	assert.NoError(t, err)
+	assert.Equal(t, schedule.List{s1, s4}, got)
+}
+
+func TestSchedule_Error(t *testing.T) {
+	ctx := context.Background()
+	wantErr := errors.New("test")
+	r := NewSchedule()
+	SetScheduleError(r, wantErr)
+
+	_, err := r.FindByID(ctx, id.NewScheduleID())
+	assert.Same(t, wantErr, err)
+	_, err = r.FindByProject(ctx, id.NewProjectID(), nil)
+	assert.Same(t, wantErr, err)
+	_, err = r.FindDue(ctx, time.Now())
+	assert.Same(t, wantErr, err)
+	assert.Same(t, wantErr, r.Save(ctx, newTestSchedule(id.NewProjectID(), time.Now())))
+}
@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+)
+
+type Task struct {
+	// lock serializes the acquisitions so that each task is started only once
+	lock sync.Mutex
+	data *util.SyncMap[id.TaskID, *task.Task]
+	err  error
+}
+
+func NewTask() repo.Task {
+	return &Task{
+		data: &util.SyncMap[id.TaskID, *task.Task]{},
+	}
+}
+
+func (r *Task) FindByID(_ context.Context, taskID id.TaskID) (*task.Task, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	t, ok := r.data.Load(taskID)
+	if !ok {
+		return nil, rerror.ErrNotFound
+	}
+	return t.Clone(), nil
+}
+
+func (r *Task) Acquire(_ context.Context, now time.Time, lease time.Duration) (*task.Task, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var res *task.Task
+	r.data.Range(func(_ id.TaskID, t *task.Task) bool {
+		if t.IsDue(now) && (res == nil || t.RunAt().Before(res.RunAt())) {
+			res = t
+		}
+		return true
+	})
+	if res == nil {
+		return nil, rerror.ErrNotFound
+	}
+
+	res = res.Clone()
+	res.Start(now, lease)
+	r.data.Store(res.ID(), res.Clone())
+	return res, nil
+}
+
+func (r *Task) Save(_ context.Context, t *task.Task) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.data.Store(t.ID(), t.Clone())
+	return nil
+}
+
+func SetTaskError(r repo.Task, err error) {
+	r.(*Task).err = err
+}
@@ -0,0 +1,73 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTask(at time.Time) *task.Task {
+	return task.New().
+		NewID().
+		Message(task.Message{Copy: &task.CopyPayload{Collection: "item"}}).
+		RunAt(at).
+		MustBuild()
+}
+
+func TestTask_FindByID(t *testing.T) {
+	ctx := context.Background()
+	r := NewTask()
+	tk := newTestTask(time.Now())
+	assert.NoError(t, r.Save(ctx, tk))
+
+	got, err := r.FindByID(ctx, tk.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, tk, got)
+
+	got, err = r.FindByID(ctx, id.NewTaskID())
+	assert.Nil(t, got)
+	assert.Equal(t, rerror.ErrNotFound, err)
+}
+
+func TestTask_Acquire(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	r := NewTask()
+
+	t1 := newTestTask(now.Add(-time.Minute))
+	t2 := newTestTask(now.Add(-time.Hour))
+	t3 := newTestTask(now.Add(time.Hour))
+	assert.NoError(t, r.Save(ctx, t1))
+	assert.NoError(t, r.Save(ctx, t2))
+	assert.NoError(t, r.Save(ctx, t3))
+
+	got, err := r.Acquire(ctx, now, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, t2.ID(), got.ID())
+	assert.Equal(t, task.StatusRunning, got.Status())
+	assert.Equal(t, 1, got.Attempts())
+
+	got, err = r.Acquire(ctx, now, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, t1.ID(), got.ID())
+
+	_, err = r.Acquire(ctx, now, time.Minute)
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	// the lease of the lost runner has expired
+	got, err = r.Acquire(ctx, now.Add(2*time.Minute), time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, t2.ID(), got.ID())
+	assert.Equal(t, 2, got.Attempts())
+
+	wantErr := errors.New("test")
+	SetTaskError(r, wantErr)
+	_, err = r.Acquire(ctx, now, time.Minute)
+	assert.Same(t, wantErr, err)
+}
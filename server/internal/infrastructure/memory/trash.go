@@ -0,0 +1,120 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/reearth/reearthx/util"
+)
+
+type Trash struct {
+	data *util.SyncMap[id.ItemID, *trash.Entry]
+	err  error
+}
+
+func NewTrash() repo.Trash {
+	return &Trash{
+		data: &util.SyncMap[id.ItemID, *trash.Entry]{},
+	}
+}
+
+func (r *Trash) FindByItems(_ context.Context, ids id.ItemIDList) (trash.List, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return r.filter(func(e *trash.Entry) bool {
+		return ids.Has(e.Item())
+	}), nil
+}
+
+func (r *Trash) FindByModel(_ context.Context, modelID id.ModelID, _ *usecasex.Pagination) (trash.List, *usecasex.PageInfo, error) {
+	if r.err != nil {
+		return nil, nil, r.err
+	}
+
+	// TODO: implement pagination
+
+	result := r.filter(func(e *trash.Entry) bool {
+		return e.Model() == modelID
+	})
+
+	var startCursor, endCursor *usecasex.Cursor
+	if len(result) > 0 {
+		startCursor = new(usecasex.Cursor(result[0].Item().String()))
+		endCursor = new(usecasex.Cursor(result[len(result)-1].Item().String()))
+	}
+
+	return result, usecasex.NewPageInfo(
+		int64(len(result)),
+		startCursor,
+		endCursor,
+		false,
+		false,
+	), nil
+}
+
+func (r *Trash) FindTrashedBefore(_ context.Context, t time.Time) (trash.List, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return r.filter(func(e *trash.Entry) bool {
+		return !e.TrashedAt().After(t)
+	}), nil
+}
+
+func (r *Trash) SaveAll(_ context.Context, l trash.List) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	for _, e := range l {
+		r.data.Store(e.Item(), e.Clone())
+	}
+	return nil
+}
+
+func (r *Trash) RemoveAll(_ context.Context, ids id.ItemIDList) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.data.DeleteAll(ids...)
+	return nil
+}
+
+func (r *Trash) RemoveByModel(_ context.Context, modelID id.ModelID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.data.DeleteAll(r.filter(func(e *trash.Entry) bool {
+		return e.Model() == modelID
+	}).Items()...)
+	return nil
+}
+
+// filter returns the matched entries in the order of the newest first
+func (r *Trash) filter(f func(*trash.Entry) bool) trash.List {
+	result := trash.List{}
+	r.data.Range(func(_ id.ItemID, e *trash.Entry) bool {
+		if f(e) {
+			result = append(result, e.Clone())
+		}
+		return true
+	})
+	slices.SortFunc(result, func(a, b *trash.Entry) int {
+		return b.TrashedAt().Compare(a.TrashedAt())
+	})
+	return result
+}
+
+func SetTrashError(r repo.Trash, err error) {
+	r.(*Trash).err = err
+}
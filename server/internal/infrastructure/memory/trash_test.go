@@ -0,0 +1,125 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTrash(mid id.ModelID, at time.Time) *trash.Entry {
+	return trash.New().
+		Item(id.NewItemID()).
+		Project(id.NewProjectID()).
+		Model(mid).
+		User(accountdomain.NewUserID()).
+		TrashedAt(at).
+		MustBuild()
+}
+
+func TestTrash_FindByItems(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	r := NewTrash()
+
+	e1 := newTestTrash(id.NewModelID(), now)
+	e2 := newTestTrash(id.NewModelID(), now.Add(time.Hour))
+	assert.NoError(t, r.SaveAll(ctx, trash.List{e1, e2}))
+
+	got, err := r.FindByItems(ctx, id.ItemIDList{e1.Item(), id.NewItemID()})
+	assert.NoError(t, err)
+	assert.Equal(t, trash.List{e1}, got)
+
+	got, err = r.FindByItems(ctx, id.ItemIDList{e1.Item(), e2.Item()})
+	assert.NoError(t, err)
+	assert.Equal(t, trash.List{e2, e1}, got)
+}
+
+func TestTrash_FindByModel(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	mid := id.NewModelID()
+	r := NewTrash()
+
+	e1 := newTestTrash(mid, now)
+	e2 := newTestTrash(mid, now.Add(time.Hour))
+	e3 := newTestTrash(id.NewModelID(), now)
+	assert.NoError(t, r.SaveAll(ctx, trash.List{e1, e2, e3}))
+
+	got, pi, err := r.FindByModel(ctx, mid, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, trash.List{e2, e1}, got)
+	assert.Equal(t, int64(2), pi.TotalCount)
+
+	got, _, err = r.FindByModel(ctx, id.NewModelID(), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestTrash_FindTrashedBefore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	r := NewTrash()
+
+	e1 := newTestTrash(id.NewModelID(), now.Add(-time.Hour))
+	e2 := newTestTrash(id.NewModelID(), now)
+	e3 := newTestTrash(id.NewModelID(), now.Add(time.Hour))
+	assert.NoError(t, r.SaveAll(ctx, trash.List{e1, e2, e3}))
+
+	got, err := r.FindTrashedBefore(ctx, now)
+	assert.NoError(t, err)
+	assert.Equal(t, trash.List{e2, e1}, got)
+}
+
+func TestTrash_RemoveAll(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	r := NewTrash()
+
+	e1 := newTestTrash(id.NewModelID(), now)
+	e2 := newTestTrash(id.NewModelID(), now)
+	assert.NoError(t, r.SaveAll(ctx, trash.List{e1, e2}))
+	assert.NoError(t, r.RemoveAll(ctx, id.ItemIDList{e1.Item()}))
+
+	got, err := r.FindByItems(ctx, id.ItemIDList{e1.Item(), e2.Item()})
+	assert.NoError(t, err)
+	assert.Equal(t, trash.List{e2}, got)
+}
+
+func TestTrash_RemoveByModel(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	mid := id.NewModelID()
+	r := NewTrash()
+
+	e1 := newTestTrash(mid, now)
+	e2 := newTestTrash(id.NewModelID(), now)
+	assert.NoError(t, r.SaveAll(ctx, trash.List{e1, e2}))
+	assert.NoError(t, r.RemoveByModel(ctx, mid))
+
+	got, err := r.FindByItems(ctx, id.ItemIDList{e1.Item(), e2.Item()})
+	assert.NoError(t, err)
+	assert.Equal(t, trash.List{e2}, got)
+}
+
+func TestTrash_Error(t *testing.T) {
+	ctx := context.Background()
+	wantErr := errors.New("test")
+	r := NewTrash()
+	SetTrashError(r, wantErr)
+
+	_, err := r.FindByItems(ctx, nil)
+	assert.Same(t, wantErr, err)
+	_, _, err = r.FindByModel(ctx, id.NewModelID(), nil)
+	assert.Same(t, wantErr, err)
+	_, err = r.FindTrashedBefore(ctx, time.Now())
+	assert.Same(t, wantErr, err)
+	assert.Same(t, wantErr, r.SaveAll(ctx, nil))
+	assert.Same(t, wantErr, r.RemoveAll(ctx, nil))
+	assert.Same(t, wantErr, r.RemoveByModel(ctx, id.NewModelID()))
+}
@@ -0,0 +1,123 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/reearth/reearthx/util"
+)
+
+type WebhookDelivery struct {
+	data *util.SyncMap[id.WebhookDeliveryID, *integration.WebhookDelivery]
+	err  error
+}
+
+func NewWebhookDelivery() repo.WebhookDelivery {
+	return &WebhookDelivery{
+		data: &util.SyncMap[id.WebhookDeliveryID, *integration.WebhookDelivery]{},
+	}
+}
+
+func (r *WebhookDelivery) FindByID(_ context.Context, did id.WebhookDeliveryID) (*integration.WebhookDelivery, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	d, ok := r.data.Load(did)
+	if !ok {
+		return nil, rerror.ErrNotFound
+	}
+	return d.Clone(), nil
+}
+
+func (r *WebhookDelivery) FindByWebhook(_ context.Context, wid id.WebhookID, _ *usecasex.Pagination) (integration.WebhookDeliveryList, *usecasex.PageInfo, error) {
+	if r.err != nil {
+		return nil, nil, r.err
+	}
+
+	result := r.filter(func(d *integration.WebhookDelivery) bool {
+		return d.Webhook() == wid
+	})
+
+	var startCursor, endCursor *usecasex.Cursor
+	if len(result) > 0 {
+		startCursor = new(usecasex.Cursor(result[0].ID().String()))
+		endCursor = new(usecasex.Cursor(result[len(result)-1].ID().String()))
+	}
+
+	return result, usecasex.NewPageInfo(
+		int64(len(result)),
+		startCursor,
+		endCursor,
+		false,
+		false,
+	), nil
+}
+
+func (r *WebhookDelivery) FindLatestByWebhook(_ context.Context, wid id.WebhookID, limit int) (integration.WebhookDeliveryList, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	result := r.filter(func(d *integration.WebhookDelivery) bool {
+		return d.Webhook() == wid
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (r *WebhookDelivery) FindDue(_ context.Context, now time.Time) (integration.WebhookDeliveryList, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return r.filter(func(d *integration.WebhookDelivery) bool {
+		return d.IsDue(now)
+	}), nil
+}
+
+func (r *WebhookDelivery) FindDeadSince(_ context.Context, since time.Time) (integration.WebhookDeliveryList, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return r.filter(func(d *integration.WebhookDelivery) bool {
+		return d.Status() == integration.WebhookDeliveryStatusDead && !d.UpdatedAt().Before(since)
+	}), nil
+}
+
+func (r *WebhookDelivery) Save(_ context.Context, d *integration.WebhookDelivery) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.data.Store(d.ID(), d.Clone())
+	return nil
+}
+
+// filter returns the matched deliveries from the newest one.
+func (r *WebhookDelivery) filter(f func(*integration.WebhookDelivery) bool) integration.WebhookDeliveryList {
+	result := integration.WebhookDeliveryList{}
+	r.data.Range(func(_ id.WebhookDeliveryID, d *integration.WebhookDelivery) bool {
+		if f(d) {
+			result = append(result, d.Clone())
+		}
+		return true
+	})
+	slices.SortFunc(result, func(a, b *integration.WebhookDelivery) int {
+		return b.ID().Compare(a.ID())
+	})
+	return result
+}
+
+func SetWebhookDeliveryError(r repo.WebhookDelivery, err error) {
+	r.(*WebhookDelivery).err = err
+}
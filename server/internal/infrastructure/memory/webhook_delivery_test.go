@@ -0,0 +1,67 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookDelivery(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	r := NewWebhookDelivery()
+
+	w1 := integration.NewWebhookBuilder().NewID().Url(lo.Must(url.Parse("https://example.com"))).MustBuild()
+	w2 := integration.NewWebhookBuilder().NewID().Url(lo.Must(url.Parse("https://example.com"))).MustBuild()
+	iid := id.NewIntegrationID()
+
+	d1 := integration.NewWebhookDelivery(iid, w1, id.NewEventID(), event.ItemCreate, []byte(`{}`))
+	d1.RecordAttempt(integration.WebhookDeliveryAttempt{AttemptedAt: now.Add(-time.Hour), StatusCode: 500})
+	d2 := integration.NewWebhookDelivery(iid, w1, id.NewEventID(), event.ItemUpdate, []byte(`{}`))
+	for range integration.MaxWebhookDeliveryAttempts {
+		d2.RecordAttempt(integration.WebhookDeliveryAttempt{AttemptedAt: now, StatusCode: 500})
+	}
+	d3 := integration.NewWebhookDelivery(iid, w2, id.NewEventID(), event.ItemCreate, []byte(`{}`))
+	for _, d := range []*integration.WebhookDelivery{d1, d2, d3} {
+		assert.NoError(t, r.Save(ctx, d))
+	}
+
+	got, err := r.FindByID(ctx, d1.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, d1, got)
+	_, err = r.FindByID(ctx, id.NewWebhookDeliveryID())
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	// the newest first
+	res, pi, err := r.FindByWebhook(ctx, w1.ID(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, integration.WebhookDeliveryList{d2, d1}, res)
+	assert.Equal(t, int64(2), pi.TotalCount)
+
+	res, err = r.FindLatestByWebhook(ctx, w1.ID(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, integration.WebhookDeliveryList{d2}, res)
+
+	res, err = r.FindDue(ctx, now)
+	assert.NoError(t, err)
+	assert.Equal(t, integration.WebhookDeliveryList{d1}, res)
+
+	res, err = r.FindDeadSince(ctx, now.Add(-time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, integration.WebhookDeliveryList{d2}, res)
+
+	wantErr := errors.New("test")
+	SetWebhookDeliveryError(r, wantErr)
+	assert.Equal(t, wantErr, r.Save(ctx, d1))
+	_, err = r.FindDue(ctx, now)
+	assert.Equal(t, wantErr, err)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/reearth/reearthx/mongox"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -24,6 +25,7 @@ var (
 		"project,createdat,id",
 		"project,!size,!id",
 		"project,size,id",
+		"project,folder",
 		"!createdat,!id",
 	}
 	assetUniqueIndexes = []string{"id", "uuid"}
@@ -110,6 +112,27 @@ func (r *Asset) Search(ctx context.Context, pID id.ProjectID, filter repo.AssetF
 		}
 	}
 
+	if filter.HasFolderFilter() {
+		folders := lo.Map(filter.Folders, func(f id.AssetFolderID, _ int) any { return f.String() })
+		if filter.RootFolder {
+			// null also matches the assets which were created before folders were introduced
+			folders = append(folders, nil)
+		}
+		filters["folder"] = bson.M{
+			"$in": folders,
+		}
+	}
+
+	if len(filter.Metadata) > 0 {
+		filters["$and"] = lo.Map(filter.Metadata, func(mf repo.AssetMetadataFilter, _ int) bson.M {
+			return bson.M{
+				"metadata": bson.M{
+					"$elemMatch": bson.M{"f": mf.Field.String(), "v.v": mf.Value.Interface()},
+				},
+			}
+		})
+	}
+
 	return r.paginate(ctx, filters, filter.Sort, filter.Pagination)
 }
 
@@ -0,0 +1,109 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/mongox"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	assetFolderIndexes       = []string{"project,parent"}
+	assetFolderUniqueIndexes = []string{"id"}
+)
+
+type AssetFolder struct {
+	client *mongox.Collection
+	f      repo.ProjectFilter
+}
+
+func NewAssetFolder(client *mongox.Client) repo.AssetFolder {
+	return &AssetFolder{client: client.WithCollection("asset_folder")}
+}
+
+func (r *AssetFolder) Init() error {
+	return createIndexes(context.Background(), r.client, assetFolderIndexes, assetFolderUniqueIndexes)
+}
+
+func (r *AssetFolder) Filtered(f repo.ProjectFilter) repo.AssetFolder {
+	return &AssetFolder{
+		client: r.client,
+		f:      r.f.Merge(f),
+	}
+}
+
+func (r *AssetFolder) FindByID(ctx context.Context, fid id.AssetFolderID) (*asset.Folder, error) {
+	return r.findOne(ctx, bson.M{
+		"id": fid.String(),
+	})
+}
+
+func (r *AssetFolder) FindByIDs(ctx context.Context, ids id.AssetFolderIDList) (asset.FolderList, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	res, err := r.find(ctx, bson.M{
+		"id": bson.M{
+			"$in": ids.Strings(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.SortByID(), nil
+}
+
+func (r *AssetFolder) FindByProject(ctx context.Context, pid id.ProjectID) (asset.FolderList, error) {
+	if !r.f.CanRead(pid) {
+		return nil, nil
+	}
+
+	res, err := r.find(ctx, bson.M{
+		"project": pid.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.SortByID(), nil
+}
+
+func (r *AssetFolder) Save(ctx context.Context, f *asset.Folder) error {
+	if !r.f.CanWrite(f.Project()) {
+		return repo.ErrOperationDenied
+	}
+	doc, fid := mongodoc.NewAssetFolder(f)
+	return r.client.SaveOne(ctx, fid, doc)
+}
+
+func (r *AssetFolder) Remove(ctx context.Context, fid id.AssetFolderID) error {
+	return r.client.RemoveOne(ctx, r.writeFilter(bson.M{"id": fid.String()}))
+}
+
+func (r *AssetFolder) findOne(ctx context.Context, filter any) (*asset.Folder, error) {
+	c := mongodoc.NewAssetFolderConsumer()
+	if err := r.client.FindOne(ctx, r.readFilter(filter), c); err != nil {
+		return nil, err
+	}
+	return c.Result[0], nil
+}
+
+func (r *AssetFolder) find(ctx context.Context, filter any) (asset.FolderList, error) {
+	c := mongodoc.NewAssetFolderConsumer()
+	if err := r.client.Find(ctx, r.readFilter(filter), c); err != nil {
+		return nil, err
+	}
+	return c.Result, nil
+}
+
+func (r *AssetFolder) readFilter(filter any) any {
+	return applyProjectFilter(filter, r.f.Readable)
+}
+
+func (r *AssetFolder) writeFilter(filter any) any {
+	return applyProjectFilter(filter, r.f.Writable)
+}
@@ -30,25 +30,34 @@ func New(ctx context.Context, mc *mongo.Client, databaseName string, useTransact
 	}
 
 	c := &repo.Container{
-		Asset:             NewAsset(client),
-		AssetFile:         NewAssetFile(client),
-		AssetUpload:       NewAssetUpload(client),
-		User:              acRepo.User,
-		Project:           NewProject(client),
-		Workspace:         acRepo.Workspace,
-		Transaction:       client.Transaction(),
-		Lock:              lock,
-		Request:           NewRequest(client),
-		Item:              NewItem(client),
-		View:              NewView(client),
-		Model:             NewModel(client),
-		Schema:            NewSchema(client),
-		Thread:            NewThread(client),
-		Integration:       NewIntegration(client),
-		Group:             NewGroup(client),
-		Event:             NewEvent(client),
-		WorkspaceSettings: NewWorkspaceSettings(client),
-		Job:               NewJob(client),
+		Asset:                  NewAsset(client),
+		AssetFile:              NewAssetFile(client),
+		AssetUpload:            NewAssetUpload(client),
+		AssetFolder:            NewAssetFolder(client),
+		User:                   acRepo.User,
+		Project:                NewProject(client),
+		Workspace:              acRepo.Workspace,
+		Transaction:            client.Transaction(),
+		Lock:                   lock,
+		Request:                NewRequest(client),
+		RequestWorkflow:        NewRequestWorkflow(client),
+		Item:                   NewItem(client),
+		View:                   NewView(client),
+		Model:                  NewModel(client),
+		Schema:                 NewSchema(client),
+		Thread:                 NewThread(client),
+		Integration:            NewIntegration(client),
+		Group:                  NewGroup(client),
+		Event:                  NewEvent(client),
+		WorkspaceSettings:      NewWorkspaceSettings(client),
+		Job:                    NewJob(client),
+		Notification:           NewNotification(client),
+		NotificationPreference: NewNotificationPreference(client),
+		Schedule:               NewSchedule(client),
+		Trash:                  NewTrash(client),
+		WebhookDelivery:        NewWebhookDelivery(client),
+		Task:                   NewTask(client),
+		Copier:                 NewCopier(client),
 	}
 
 	// init
@@ -72,9 +81,11 @@ func Init(r *repo.Container) error {
 		r.Asset.(*Asset).Init,
 		r.AssetFile.(*AssetFile).Init,
 		r.AssetUpload.(*AssetUpload).Init,
+		r.AssetFolder.(*AssetFolder).Init,
 		r.Model.(*Model).Init,
 		r.View.(*View).Init,
 		r.Request.(*Request).Init,
+		r.RequestWorkflow.(*RequestWorkflow).Init,
 		r.Project.(*ProjectRepo).Init,
 		r.Item.(*Item).Init,
 		r.Schema.(*Schema).Init,
@@ -83,6 +94,12 @@ func Init(r *repo.Container) error {
 		r.Event.(*Event).Init,
 		r.WorkspaceSettings.(*WorkspaceSettingsRepo).Init,
 		r.Job.(*Job).Init,
+		r.Notification.(*Notification).Init,
+		r.NotificationPreference.(*NotificationPreference).Init,
+		r.Schedule.(*Schedule).Init,
+		r.Trash.(*Trash).Init,
+		r.WebhookDelivery.(*WebhookDelivery).Init,
+		r.Task.(*Task).Init,
 	)
 }
 
@@ -0,0 +1,131 @@
+package mongo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/oklog/ulid"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/rerror"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const copierBatchSize = 1000
+
+var ErrInvalidCopyChange = errors.New("invalid copy change")
+
+// Copier copies documents in the same way as the copier of the worker.
+type Copier struct {
+	client *mongox.Client
+}
+
+func NewCopier(client *mongox.Client) repo.Copier {
+	return &Copier{client: client}
+}
+
+func (r *Copier) Copy(ctx context.Context, p task.CopyPayload) error {
+	var filter bson.M
+	if err := json.Unmarshal([]byte(p.Filter), &filter); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	var changes task.Changes
+	if err := json.Unmarshal([]byte(p.Changes), &changes); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+
+	c := r.client.WithCollection(p.Collection).Client()
+	cursor, err := c.Find(ctx, filter, options.Find().SetBatchSize(copierBatchSize))
+	if err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	var models []mongo.WriteModel
+	for cursor.Next(ctx) {
+		var doc bson.M
+		if err := cursor.Decode(&doc); err != nil {
+			return rerror.ErrInternalBy(err)
+		}
+		doc["_id"] = primitive.NewObjectID()
+
+		for k, change := range changes {
+			if err := applyCopyChange(doc, k, change); err != nil {
+				return err
+			}
+		}
+
+		models = append(models, mongo.NewInsertOneModel().SetDocument(doc))
+		if len(models) >= copierBatchSize {
+			if _, err := c.BulkWrite(ctx, models); err != nil {
+				return rerror.ErrInternalBy(err)
+			}
+			models = nil
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+
+	if len(models) > 0 {
+		if _, err := c.BulkWrite(ctx, models); err != nil {
+			return rerror.ErrInternalBy(err)
+		}
+	}
+	return nil
+}
+
+func applyCopyChange(doc bson.M, k string, change task.Change) error {
+	switch change.Type {
+	case task.ChangeTypeNew:
+		s, ok := change.Value.(string)
+		if !ok {
+			return ErrInvalidCopyChange
+		}
+		switch s {
+		case "version":
+			doc[k] = uuid.New()
+		case "item":
+			doc[k] = id.NewItemID().String()
+		case "schema":
+			doc[k] = id.NewSchemaID().String()
+		case "model":
+			doc[k] = id.NewModelID().String()
+		default:
+			return ErrInvalidCopyChange
+		}
+	case task.ChangeTypeULID:
+		if doc[k] == nil {
+			return nil
+		}
+		s, ok := doc[k].(string)
+		if !ok {
+			return ErrInvalidCopyChange
+		}
+		u, err := ulid.Parse(s)
+		if err != nil {
+			return rerror.ErrInternalBy(err)
+		}
+		ms, ok := change.Value.(float64)
+		if !ok || ms < 0 || ms != float64(uint64(ms)) {
+			return ErrInvalidCopyChange
+		}
+		if err := u.SetTime(uint64(ms)); err != nil {
+			return rerror.ErrInternalBy(err)
+		}
+		doc[k] = strings.ToLower(u.String())
+	case task.ChangeTypeSet:
+		doc[k] = change.Value
+	}
+	return nil
+}
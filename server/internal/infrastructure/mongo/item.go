@@ -37,6 +37,10 @@ var (
 		"__r,asset,project,__",
 		"schema,id,__r,project",
 	}
+	itemGeoIndex = mongox.Index{
+		Name: "re_fields.g_2dsphere",
+		Key:  bson.D{{Key: "fields.g", Value: "2dsphere"}},
+	}
 )
 
 type Item struct {
@@ -60,7 +64,7 @@ func (r *Item) Init() error {
 		context.Background(),
 		r.client.Client(),
 		append(
-			r.client.Indexes(),
+			append(r.client.Indexes(), itemGeoIndex),
 			mongox.IndexFromKeys(itemIndexes, false)...,
 		)...,
 	)
@@ -166,6 +170,9 @@ func (r *Item) FindVersionByID(ctx context.Context, itemID id.ItemID, ver versio
 	}), version.Eq(ver), c); err != nil {
 		return nil, err
 	}
+	if len(c.Result) == 0 {
+		return nil, rerror.ErrNotFound
+	}
 
 	return c.Result[0], nil
 }
@@ -267,6 +274,59 @@ func (r *Item) Archive(ctx context.Context, id id.ItemID, pid id.ProjectID, b bo
 	}, b)
 }
 
+// Trash moves the latest versions of the items to the trash ref and archives them, or brings them back when trashed is false.
+// Trashed items are unpublished and can be found only with the trash ref.
+func (r *Item) Trash(ctx context.Context, ids id.ItemIDList, pid id.ProjectID, trashed bool) error {
+	if !r.f.CanWrite(pid) {
+		return repo.ErrOperationDenied
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	from, to := version.Latest, version.Trash
+	if !trashed {
+		from, to = to, from
+	}
+
+	res, err := r.find(ctx, bson.M{
+		"id":      bson.M{"$in": ids.Strings()},
+		"project": pid.String(),
+	}, from.Ref())
+	if err != nil {
+		return err
+	}
+	targets := lo.Map(res, func(v item.Versioned, _ int) string { return v.Value().ID().String() })
+	if len(targets) == 0 {
+		return nil
+	}
+
+	if trashed {
+		if err := r.client.DeleteRef(ctx, targets, version.Public); err != nil {
+			return err
+		}
+	} else {
+		for _, iid := range targets {
+			if err := r.client.ArchiveOne(ctx, bson.M{"id": iid, "project": pid.String()}, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := r.client.MoveRef(ctx, bson.M{"id": bson.M{"$in": targets}}, from, to); err != nil {
+		return err
+	}
+
+	if trashed {
+		for _, iid := range targets {
+			if err := r.client.ArchiveOne(ctx, bson.M{"id": iid, "project": pid.String()}, true); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (r *Item) paginate(ctx context.Context, filter bson.M, ref *version.Ref, sort *usecasex.Sort, pagination *usecasex.Pagination) (item.VersionedList, *usecasex.PageInfo, error) {
 	c := mongodoc.NewVersionedItemConsumer()
 	pageInfo, err := r.client.Paginate(ctx, r.readFilter(filter), version.Eq(ref.OrLatest().OrVersion()), sort, pagination, c)
@@ -97,6 +97,18 @@ func basicFilterStage(query *item.Query) any {
 	if query.Schema() != nil {
 		filter["schema"] = query.Schema().String()
 	}
+	// spatial conditions on item fields are applied in the first stage to use the 2dsphere index
+	if query.Filter() != nil {
+		var spatial []any
+		for _, c := range query.Filter().RequiredSpatialConditions() {
+			if c.Field.Type == view.FieldTypeField {
+				spatial = append(spatial, spatialFilter(c))
+			}
+		}
+		if len(spatial) > 0 {
+			filter["$and"] = spatial
+		}
+	}
 	return bson.M{"$match": filter}
 }
 
@@ -311,6 +323,8 @@ func filter(c *view.Condition, sp schema.Package) any {
 		ff = lo.Assign(ff, filterDate(c, sp))
 	case view.ConditionTypeMultiple:
 		ff = lo.Assign(ff, filterMultiple(c, sp))
+	case view.ConditionTypeSpatial:
+		ff = lo.Assign(ff, spatialFilter(*c.SpatialCondition))
 	case view.ConditionTypeAnd:
 		ff["$and"] = lo.Map(c.AndCondition.Conditions, func(c view.Condition, _ int) any {
 			return filter(&c, sp)
@@ -325,6 +339,32 @@ func filter(c *view.Condition, sp schema.Package) any {
 	return ff
 }
 
+func spatialFilter(c view.SpatialCondition) bson.M {
+	if c.Field.ID == nil {
+		return bson.M{}
+	}
+	var g bson.M
+	switch c.Op {
+	case view.SpatialOperatorBBox, view.SpatialOperatorIntersects:
+		g = bson.M{"$geoIntersects": bson.M{"$geometry": bson.M{
+			"type":        "Polygon",
+			"coordinates": bson.A{c.Area()},
+		}}}
+	case view.SpatialOperatorNear:
+		g = bson.M{"$geoWithin": bson.M{
+			"$centerSphere": bson.A{c.Point, c.Radius / view.EarthRadius},
+		}}
+	default:
+		return bson.M{}
+	}
+
+	key := "fields"
+	if c.Field.Type == view.FieldTypeMetaField {
+		key = "__temp.meta.fields"
+	}
+	return bson.M{key: bson.M{"$elemMatch": bson.M{"f": c.Field.ID.String(), "g": g}}}
+}
+
 func filterMultiple(c *view.Condition, _ schema.Package) bson.M {
 	f := bson.M{}
 	switch c.MultipleCondition.Op {
@@ -0,0 +1,266 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/job"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	defaultJobStateRetention    = time.Hour
+	defaultJobStatePollInterval = time.Second
+	defaultJobStateReplaySize   = 10
+	jobStateTimeout             = 10 * time.Second
+)
+
+var jobStateIndexes = []string{"jobid,createdat"}
+
+type JobPubSubConfig struct {
+	// Retention is how long the published states are kept. The states are replayed to new subscribers within it.
+	Retention time.Duration
+	// PollInterval is how often the subscribers poll the states when change streams are not used.
+	PollInterval time.Duration
+	// ChangeStream watches the states with change streams, which require a replica set.
+	ChangeStream bool
+	// ReplaySize is the number of the recent states sent to new subscribers.
+	ReplaySize int
+}
+
+// JobPubSub shares the states of the jobs among the servers through the jobstate collection.
+type JobPubSub struct {
+	client *mongox.Collection
+	conf   JobPubSubConfig
+}
+
+func NewJobPubSub(client *mongox.Client, conf JobPubSubConfig) (gateway.JobPubSub, error) {
+	if conf.Retention <= 0 {
+		conf.Retention = defaultJobStateRetention
+	}
+	if conf.PollInterval <= 0 {
+		conf.PollInterval = defaultJobStatePollInterval
+	}
+	if conf.ReplaySize <= 0 {
+		conf.ReplaySize = defaultJobStateReplaySize
+	}
+
+	p := &JobPubSub{client: client.WithCollection("jobstate"), conf: conf}
+	if err := p.Init(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *JobPubSub) Init() error {
+	ctx := context.Background()
+	if err := createIndexes(ctx, p.client, jobStateIndexes, nil); err != nil {
+		return err
+	}
+
+	// the states are removed by MongoDB after the retention
+	_, err := p.client.Client().Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "createdat", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(int32(p.conf.Retention.Seconds())),
+	})
+	if err != nil {
+		// the index of the other retention is kept until it is dropped manually
+		log.Warnf("mongo: jobstate: failed to create TTL index: %v", err)
+	}
+	return nil
+}
+
+func (p *JobPubSub) Publish(ctx context.Context, jobID id.JobID, state job.State) error {
+	log.Debugf("pubsub: publishing state for job %s: status=%s", jobID, state.Status())
+
+	if _, err := p.client.Client().InsertOne(ctx, mongodoc.NewJobState(jobID, state, util.Now())); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	return nil
+}
+
+func (p *JobPubSub) Subscribe(ctx context.Context, jobID id.JobID) (<-chan job.State, error) {
+	log.Infof("pubsub: new subscriber for job %s", jobID)
+
+	// the stream is opened before the replay so that no states are missed between them
+	var stream *mongo.ChangeStream
+	if p.conf.ChangeStream {
+		s, err := p.client.Client().Watch(ctx, mongo.Pipeline{
+			{{Key: "$match", Value: bson.M{
+				"operationType":      "insert",
+				"fullDocument.jobid": jobID.String(),
+			}}},
+		})
+		if err != nil {
+			log.Warnf("pubsub: failed to watch job %s, falling back to polling: %v", jobID, err)
+		} else {
+			stream = s
+		}
+	}
+
+	recent, err := p.recent(ctx, jobID)
+	if err != nil {
+		if stream != nil {
+			_ = stream.Close(context.Background())
+		}
+		return nil, rerror.ErrInternalBy(err)
+	}
+
+	ch := make(chan job.State, p.conf.ReplaySize)
+	go p.deliver(ctx, jobID, ch, recent, stream)
+	return ch, nil
+}
+
+// Unsubscribe closes the subscriptions of the job on all the servers.
+func (p *JobPubSub) Unsubscribe(jobID id.JobID) {
+	log.Infof("pubsub: unsubscribing all subscribers for job %s", jobID)
+
+	ctx, cancel := context.WithTimeout(context.Background(), jobStateTimeout)
+	defer cancel()
+	if _, err := p.client.Client().InsertOne(ctx, mongodoc.NewJobStateClosed(jobID, util.Now())); err != nil {
+		log.Errorf("pubsub: failed to unsubscribe job %s: %v", jobID, err)
+	}
+}
+
+// HasPublisher returns true when the job has published its states recently and has not been unsubscribed.
+func (p *JobPubSub) HasPublisher(jobID id.JobID) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), jobStateTimeout)
+	defer cancel()
+
+	var doc mongodoc.JobStateDocument
+	err := p.client.Client().FindOne(ctx, bson.M{
+		"jobid":     jobID.String(),
+		"createdat": bson.M{"$gte": util.Now().Add(-p.conf.Retention)},
+	}, options.FindOne().SetSort(bson.D{{Key: "createdat", Value: -1}})).Decode(&doc)
+	if err != nil {
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			log.Errorf("pubsub: failed to find the states of job %s: %v", jobID, err)
+		}
+		return false
+	}
+	return !doc.Closed
+}
+
+// recent returns the recent states of the job in the order of publication.
+func (p *JobPubSub) recent(ctx context.Context, jobID id.JobID) ([]*mongodoc.JobStateDocument, error) {
+	cur, err := p.client.Client().Find(ctx, bson.M{
+		"jobid": jobID.String(),
+	}, options.Find().SetSort(bson.D{{Key: "createdat", Value: -1}}).SetLimit(int64(p.conf.ReplaySize)))
+	if err != nil {
+		return nil, err
+	}
+	var docs []*mongodoc.JobStateDocument
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	slices.Reverse(docs)
+
+	// the states before the last unsubscription belong to the previous run
+	for i := len(docs) - 2; i >= 0; i-- {
+		if docs[i].Closed {
+			docs = docs[i+1:]
+			break
+		}
+	}
+	return docs, nil
+}
+
+func (p *JobPubSub) deliver(ctx context.Context, jobID id.JobID, ch chan<- job.State, recent []*mongodoc.JobStateDocument, stream *mongo.ChangeStream) {
+	defer close(ch)
+	if stream != nil {
+		defer func() { _ = stream.Close(context.Background()) }()
+	}
+
+	seen := map[string]struct{}{}
+	var last time.Time
+	// send returns false when the subscription ends
+	send := func(d *mongodoc.JobStateDocument) bool {
+		if _, ok := seen[d.ID]; ok {
+			return true
+		}
+		seen[d.ID] = struct{}{}
+		if d.CreatedAt.After(last) {
+			last = d.CreatedAt
+		}
+		if d.Closed {
+			return false
+		}
+
+		s, err := d.Model()
+		if err != nil {
+			log.Errorf("pubsub: invalid state of job %s: %v", jobID, err)
+			return true
+		}
+		select {
+		case ch <- s:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	for _, d := range recent {
+		if !send(d) {
+			return
+		}
+	}
+
+	if stream != nil {
+		for stream.Next(ctx) {
+			var e struct {
+				FullDocument mongodoc.JobStateDocument `bson:"fullDocument"`
+			}
+			if err := stream.Decode(&e); err != nil {
+				log.Errorf("pubsub: failed to decode the state of job %s: %v", jobID, err)
+				continue
+			}
+			if !send(&e.FullDocument) {
+				return
+			}
+		}
+		if err := stream.Err(); err != nil && ctx.Err() == nil {
+			log.Errorf("pubsub: stopped watching job %s: %v", jobID, err)
+		}
+		return
+	}
+
+	ticker := time.NewTicker(p.conf.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		cur, err := p.client.Client().Find(ctx, bson.M{
+			"jobid":     jobID.String(),
+			"createdat": bson.M{"$gte": last},
+		}, options.Find().SetSort(bson.D{{Key: "createdat", Value: 1}}))
+		if err != nil {
+			log.Errorf("pubsub: failed to poll the states of job %s: %v", jobID, err)
+			continue
+		}
+		var docs []*mongodoc.JobStateDocument
+		if err := cur.All(ctx, &docs); err != nil {
+			log.Errorf("pubsub: failed to poll the states of job %s: %v", jobID, err)
+			continue
+		}
+		for _, d := range docs {
+			if !send(d) {
+				return
+			}
+		}
+	}
+}
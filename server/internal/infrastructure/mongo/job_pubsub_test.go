@@ -0,0 +1,91 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/job"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/mongox/mongotest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobPubSub_PublishSubscribe(t *testing.T) {
+	initDB := mongotest.Connect(t)
+	client := mongox.NewClientWithDatabase(initDB(t))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// two servers sharing the same database
+	p1, err := NewJobPubSub(client, JobPubSubConfig{PollInterval: 10 * time.Millisecond})
+	require.NoError(t, err)
+	p2, err := NewJobPubSub(client, JobPubSubConfig{PollInterval: 10 * time.Millisecond})
+	require.NoError(t, err)
+
+	jobID := id.NewJobID()
+	assert.False(t, p2.HasPublisher(jobID))
+
+	progress := job.NewProgress(1, 10)
+	require.NoError(t, p1.Publish(ctx, jobID, job.NewState(job.StatusInProgress, &progress, "")))
+	assert.True(t, p2.HasPublisher(jobID))
+
+	ch, err := p2.Subscribe(ctx, jobID)
+	require.NoError(t, err)
+
+	// the recent state is replayed
+	s := receiveJobState(t, ch)
+	assert.Equal(t, job.StatusInProgress, s.Status())
+	assert.Equal(t, 1, s.Progress().Processed())
+	assert.Equal(t, 10, s.Progress().Total())
+
+	progress = job.NewProgress(10, 10)
+	require.NoError(t, p1.Publish(ctx, jobID, job.NewState(job.StatusCompleted, &progress, "")))
+	s = receiveJobState(t, ch)
+	assert.Equal(t, job.StatusCompleted, s.Status())
+	assert.Equal(t, 10, s.Progress().Processed())
+
+	// unsubscribing on a server closes the subscriptions on the others
+	p1.Unsubscribe(jobID)
+	assert.False(t, p2.HasPublisher(jobID))
+	select {
+	case _, ok := <-ch:
+		assert.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for close")
+	}
+}
+
+func TestJobPubSub_Subscribe_ContextDone(t *testing.T) {
+	initDB := mongotest.Connect(t)
+	client := mongox.NewClientWithDatabase(initDB(t))
+
+	p, err := NewJobPubSub(client, JobPubSubConfig{PollInterval: 10 * time.Millisecond})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, err := p.Subscribe(ctx, id.NewJobID())
+	require.NoError(t, err)
+	cancel()
+
+	select {
+	case _, ok := <-ch:
+		assert.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for close")
+	}
+}
+
+func receiveJobState(t *testing.T, ch <-chan job.State) job.State {
+	t.Helper()
+	select {
+	case s, ok := <-ch:
+		require.True(t, ok)
+		return s
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for state")
+	}
+	return job.State{}
+}
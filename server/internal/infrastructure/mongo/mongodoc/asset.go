@@ -8,6 +8,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/utils"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 	"go.mongodb.org/mongo-driver/bson"
 )
@@ -30,6 +31,26 @@ type AssetDocument struct {
 	ArchiveExtractionStatus string
 	FlatFiles               bool
 	Public                  bool
+	Folder                  *string
+	Metadata                []AssetMetadataFieldDocument
+	Versions                []AssetVersionDocument
+}
+
+type AssetMetadataFieldDocument struct {
+	F string        `bson:"f"`
+	V ValueDocument `bson:"v"`
+}
+
+type AssetVersionDocument struct {
+	UUID            string
+	FileName        string
+	Size            uint64
+	ContentType     string
+	ContentEncoding string
+	PreviewType     string
+	UploadedAt      time.Time
+	User            *string
+	Integration     *string
 }
 
 type AssetAndFileDocument struct {
@@ -101,6 +122,9 @@ func NewAsset(a *asset.Asset) (*AssetDocument, string) {
 		ArchiveExtractionStatus: archiveExtractionStatus,
 		FlatFiles:               a.FlatFiles(),
 		Public:                  a.Public(),
+		Folder:                  a.Folder().StringRef(),
+		Metadata:                newAssetMetadata(a.Metadata()),
+		Versions:                newAssetVersions(a.Versions()),
 	}, aid
 }
 
@@ -126,7 +150,32 @@ func (d *AssetDocument) Model() (*asset.Asset, error) {
 		Thread(id.ThreadIDFromRef(d.Thread)).
 		ArchiveExtractionStatus(asset.ArchiveExtractionStatusFromRef(new(d.ArchiveExtractionStatus))).
 		FlatFiles(d.FlatFiles).
-		Public(d.Public)
+		Public(d.Public).
+		Folder(id.AssetFolderIDFromRef(d.Folder))
+
+	if len(d.Metadata) > 0 {
+		m, err := util.TryMap(d.Metadata, func(f AssetMetadataFieldDocument) (*asset.MetadataField, error) {
+			fid, err := id.FieldIDFrom(f.F)
+			if err != nil {
+				return nil, err
+			}
+			return asset.NewMetadataField(fid, f.V.MultipleValue()), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		ab = ab.Metadata(m)
+	}
+
+	if len(d.Versions) > 0 {
+		versions, err := util.TryMap(d.Versions, func(v AssetVersionDocument) (*asset.Version, error) {
+			return v.Model()
+		})
+		if err != nil {
+			return nil, err
+		}
+		ab = ab.Versions(versions)
+	}
 
 	if d.User != nil {
 		uid, err := accountdomain.UserIDFrom(*d.User)
@@ -161,6 +210,68 @@ func (d *AssetDocument) Model() (*asset.Asset, error) {
 	return ab.Build()
 }
 
+func newAssetMetadata(m asset.Metadata) []AssetMetadataFieldDocument {
+	if len(m) == 0 {
+		return nil
+	}
+	return lo.Map(m, func(f *asset.MetadataField, _ int) AssetMetadataFieldDocument {
+		return AssetMetadataFieldDocument{
+			F: f.FieldID().String(),
+			V: lo.FromPtr(NewMultipleValue(f.Value())),
+		}
+	})
+}
+
+func newAssetVersions(versions []*asset.Version) []AssetVersionDocument {
+	if len(versions) == 0 {
+		return nil
+	}
+	return lo.Map(versions, func(v *asset.Version, _ int) AssetVersionDocument {
+		previewType := ""
+		if pt := v.PreviewType(); pt != nil {
+			previewType = pt.String()
+		}
+		return AssetVersionDocument{
+			UUID:            v.UUID(),
+			FileName:        v.FileName(),
+			Size:            v.Size(),
+			ContentType:     v.ContentType(),
+			ContentEncoding: v.ContentEncoding(),
+			PreviewType:     previewType,
+			UploadedAt:      v.UploadedAt(),
+			User:            v.UploadedByUser().StringRef(),
+			Integration:     v.UploadedByIntegration().StringRef(),
+		}
+	})
+}
+
+func (d AssetVersionDocument) Model() (*asset.Version, error) {
+	b := asset.NewVersion().
+		UUID(d.UUID).
+		FileName(d.FileName).
+		Size(d.Size).
+		ContentType(d.ContentType).
+		ContentEncoding(d.ContentEncoding).
+		PreviewType(asset.PreviewTypeFromRef(new(d.PreviewType))).
+		UploadedAt(d.UploadedAt)
+
+	if d.User != nil {
+		uid, err := accountdomain.UserIDFrom(*d.User)
+		if err != nil {
+			return nil, err
+		}
+		b = b.UploadedByUser(&uid)
+	} else if d.Integration != nil {
+		iid, err := id.IntegrationIDFrom(*d.Integration)
+		if err != nil {
+			return nil, err
+		}
+		b = b.UploadedByIntegration(&iid)
+	}
+
+	return b.Build(), nil
+}
+
 func NewFile(f *asset.File) *AssetFileDocument {
 	if f == nil {
 		return nil
@@ -0,0 +1,87 @@
+package mongodoc
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/mongox"
+)
+
+type AssetFolderDocument struct {
+	ID          string
+	Project     string
+	Parent      *string
+	Name        string
+	Permission  *string
+	User        *string
+	Integration *string
+	UpdatedAt   time.Time
+}
+
+type AssetFolderConsumer = mongox.SliceFuncConsumer[*AssetFolderDocument, *asset.Folder]
+
+func NewAssetFolderConsumer() *AssetFolderConsumer {
+	return NewConsumer[*AssetFolderDocument, *asset.Folder]()
+}
+
+func NewAssetFolder(f *asset.Folder) (*AssetFolderDocument, string) {
+	fid := f.ID().String()
+
+	var permission *string
+	if p := f.Permission(); p != nil {
+		permission = new(p.String())
+	}
+
+	return &AssetFolderDocument{
+		ID:          fid,
+		Project:     f.Project().String(),
+		Parent:      f.Parent().StringRef(),
+		Name:        f.Name(),
+		Permission:  permission,
+		User:        f.User().StringRef(),
+		Integration: f.Integration().StringRef(),
+		UpdatedAt:   f.UpdatedAt(),
+	}, fid
+}
+
+func (d *AssetFolderDocument) Model() (*asset.Folder, error) {
+	fid, err := id.AssetFolderIDFrom(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	pid, err := id.ProjectIDFrom(d.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	b := asset.NewFolder().
+		ID(fid).
+		Project(pid).
+		Parent(id.AssetFolderIDFromRef(d.Parent)).
+		Name(d.Name).
+		UpdatedAt(d.UpdatedAt)
+
+	if d.Permission != nil {
+		if p, ok := asset.FolderPermissionFrom(*d.Permission); ok {
+			b = b.Permission(&p)
+		}
+	}
+
+	if d.User != nil {
+		uid, err := accountdomain.UserIDFrom(*d.User)
+		if err != nil {
+			return nil, err
+		}
+		b = b.CreatedByUser(uid)
+	} else if d.Integration != nil {
+		iid, err := id.IntegrationIDFrom(*d.Integration)
+		if err != nil {
+			return nil, err
+		}
+		b = b.CreatedByIntegration(iid)
+	}
+
+	return b.Build()
+}
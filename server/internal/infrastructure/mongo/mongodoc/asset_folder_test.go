@@ -0,0 +1,37 @@
+package mongodoc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssetFolderDocument(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond)
+	p := asset.FolderPermissionMaintainer
+	f := asset.NewFolder().
+		NewID().
+		Project(id.NewProjectID()).
+		Parent(id.NewAssetFolderID().Ref()).
+		Name("images").
+		Permission(&p).
+		CreatedByUser(accountdomain.NewUserID()).
+		UpdatedAt(now).
+		MustBuild()
+
+	doc, fid := NewAssetFolder(f)
+	assert.Equal(t, f.ID().String(), fid)
+	assert.Equal(t, new("maintainer"), doc.Permission)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, f, got)
+
+	doc.ID = "x"
+	_, err = doc.Model()
+	assert.Error(t, err)
+}
@@ -6,8 +6,10 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/account/accountdomain/user"
 	"github.com/stretchr/testify/assert"
 )
@@ -94,6 +96,35 @@ func TestNewAsset(t *testing.T) {
 	}
 }
 
+func TestAssetDocument_Metadata(t *testing.T) {
+	fid := id.NewFieldID()
+	a := asset.New().NewID().Project(project.NewID()).CreatedByUser(user.NewID()).NewUUID().Thread(thread.NewID().Ref()).Size(1).
+		Metadata(asset.Metadata{asset.NewMetadataField(fid, value.NewMultiple(value.TypeText, []any{"alt text"}))}).
+		MustBuild()
+
+	doc, _ := NewAsset(a)
+	assert.Equal(t, []AssetMetadataFieldDocument{{F: fid.String(), V: ValueDocument{T: "text", V: []any{"alt text"}}}}, doc.Metadata)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, a.Metadata(), got.Metadata())
+}
+
+func TestAssetDocument_Versions(t *testing.T) {
+	uid := user.NewID()
+	a := asset.New().NewID().Project(project.NewID()).CreatedByUser(uid).UUID("uuid1").FileName("a.pdf").Thread(thread.NewID().Ref()).Size(1).MustBuild()
+	a.ReplaceFile(asset.NewVersion().UUID("uuid2").FileName("b.pdf").Size(2).ContentType("application/pdf").
+		UploadedAt(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).UploadedByUser(&uid).Build())
+
+	doc, _ := NewAsset(a)
+	assert.Len(t, doc.Versions, 2)
+	assert.Equal(t, "uuid2", doc.UUID)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, a.Versions(), got.Versions())
+}
+
 func TestNewAssetConsumer(t *testing.T) {
 	c := NewAssetConsumer()
 	assert.NotNil(t, c)
@@ -7,6 +7,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/item"
 	"github.com/reearth/reearth-cms/server/pkg/model"
 	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/request"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
 	"github.com/reearth/reearth-cms/server/pkg/version"
@@ -57,6 +58,9 @@ func NewDocument(obj any) (doc Document, id string, err error) {
 	case *integration.Integration:
 		ty = "integration"
 		res, id = NewIntegration(m)
+	case *request.Request:
+		ty = "request"
+		res, id = NewRequest(m)
 	default:
 		err = ErrInvalidObject
 		return
@@ -106,6 +110,11 @@ func ModelFrom(obj Document) (res any, err error) {
 		if err = bson.Unmarshal(obj.Object, &d); err == nil {
 			res, err = d.Model()
 		}
+	case "request":
+		var d *RequestDocument
+		if err = bson.Unmarshal(obj.Object, &d); err == nil {
+			res, err = d.Model()
+		}
 	default:
 		err = ErrInvalidDoc
 	}
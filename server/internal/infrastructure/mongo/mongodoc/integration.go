@@ -32,6 +32,18 @@ type WebhookDocument struct {
 	Trigger   map[string]bool
 	UpdatedAt time.Time
 	Secret    string
+	Filter    *WebhookFilterDocument
+	Payload   *WebhookPayloadDocument
+}
+
+type WebhookFilterDocument struct {
+	Models []string
+	Groups []string
+}
+
+type WebhookPayloadDocument struct {
+	Type   string
+	Fields []string
 }
 
 func NewIntegration(i *integration.Integration) (*IntegrationDocument, string) {
@@ -40,6 +52,20 @@ func NewIntegration(i *integration.Integration) (*IntegrationDocument, string) {
 		trigger := lo.MapKeys(w.Trigger(), func(_ bool, t event.Type) string {
 			return string(t)
 		})
+		var filter *WebhookFilterDocument
+		if f := w.Filter(); !f.IsEmpty() {
+			filter = &WebhookFilterDocument{
+				Models: f.Models().Strings(),
+				Groups: f.Groups().Strings(),
+			}
+		}
+		var payload *WebhookPayloadDocument
+		if p := w.Payload(); p.Type() != integration.WebhookPayloadTypeFull {
+			payload = &WebhookPayloadDocument{
+				Type:   string(p.Type()),
+				Fields: p.Fields(),
+			}
+		}
 		return WebhookDocument{
 			ID:        w.ID().String(),
 			Name:      w.Name(),
@@ -48,6 +74,8 @@ func NewIntegration(i *integration.Integration) (*IntegrationDocument, string) {
 			Trigger:   trigger,
 			UpdatedAt: w.UpdatedAt(),
 			Secret:    w.Secret(),
+			Filter:    filter,
+			Payload:   payload,
 		}
 	})
 	return &IntegrationDocument{
@@ -91,15 +119,29 @@ func (d *IntegrationDocument) Model() (*integration.Integration, error) {
 		trigger := lo.MapKeys(d.Trigger, func(_ bool, t string) event.Type {
 			return event.Type(t)
 		})
-		m, err := integration.NewWebhookBuilder().
+		b := integration.NewWebhookBuilder().
 			ID(wId).
 			Name(d.Name).
 			Active(d.Active).
 			Url(u).
 			UpdatedAt(d.UpdatedAt).
 			Trigger(trigger).
-			Secret(d.Secret).
-			Build()
+			Secret(d.Secret)
+		if d.Filter != nil {
+			models, err := id.ModelIDListFrom(d.Filter.Models)
+			if err != nil {
+				return nil
+			}
+			groups, err := id.GroupIDListFrom(d.Filter.Groups)
+			if err != nil {
+				return nil
+			}
+			b = b.Filter(integration.NewWebhookFilter(models, groups))
+		}
+		if d.Payload != nil {
+			b = b.Payload(integration.NewWebhookPayloadShape(integration.WebhookPayloadTypeFrom(d.Payload.Type), d.Payload.Fields))
+		}
+		m, err := b.Build()
 		if err != nil {
 			return nil
 		}
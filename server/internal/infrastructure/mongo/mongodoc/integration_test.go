@@ -5,6 +5,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/integration"
 	"github.com/reearth/reearthx/account/accountdomain/user"
 	"github.com/samber/lo"
@@ -109,6 +111,43 @@ func TestNewIntegration(t *testing.T) {
 	}
 }
 
+func TestIntegrationDocument_WebhookFilterAndPayload(t *testing.T) {
+	mId := id.NewModelID()
+	gId := id.NewGroupID()
+	w := integration.NewWebhookBuilder().
+		NewID().
+		Name("w").
+		Url(lo.Must(url.Parse("https://example.com"))).
+		Active(true).
+		Trigger(integration.WebhookTrigger{event.ItemCreate: true}).
+		Filter(integration.NewWebhookFilter(id.ModelIDList{mId}, id.GroupIDList{gId})).
+		Payload(integration.NewWebhookPayloadShape(integration.WebhookPayloadTypeProjection, []string{"title"})).
+		MustBuild()
+	i := integration.New().
+		NewID().
+		Name("abc").
+		Type(integration.TypePrivate).
+		Developer(user.NewID()).
+		Webhook([]*integration.Webhook{w}).
+		LogoUrl(lo.Must(url.Parse("https://example.com/logo"))).
+		MustBuild()
+
+	doc, _ := NewIntegration(i)
+	assert.Equal(t, &WebhookFilterDocument{
+		Models: []string{mId.String()},
+		Groups: []string{gId.String()},
+	}, doc.Webhook[0].Filter)
+	assert.Equal(t, &WebhookPayloadDocument{
+		Type:   "projection",
+		Fields: []string{"title"},
+	}, doc.Webhook[0].Payload)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, w.Filter(), got.Webhooks()[0].Filter())
+	assert.Equal(t, w.Payload(), got.Webhooks()[0].Payload())
+}
+
 func TestNewIntegrationConsumer(t *testing.T) {
 	c := NewIntegrationConsumer()
 	assert.NotNil(t, c)
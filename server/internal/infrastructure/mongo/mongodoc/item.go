@@ -1,11 +1,15 @@
 package mongodoc
 
 import (
+	"encoding/json"
 	"time"
 
+	geojson "github.com/paulmach/go.geojson"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongogit"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/item/view"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/mongox"
@@ -33,8 +37,10 @@ type ItemDocument struct {
 }
 
 type ItemFieldDocument struct {
-	F         string        `bson:"f,omitempty"`
-	V         ValueDocument `bson:"v,omitempty"`
+	F         string                   `bson:"f,omitempty"`
+	V         ValueDocument            `bson:"v,omitempty"`
+	L         map[string]ValueDocument `bson:"l,omitempty"`
+	G         []any                    `bson:"g,omitempty"`
 	ItemGroup *string
 }
 
@@ -73,10 +79,24 @@ func NewItem(i *item.Item) (*ItemDocument, string) {
 				return ItemFieldDocument{}, false
 			}
 
+			var l map[string]ValueDocument
+			for _, locale := range f.Locales() {
+				lv := NewMultipleValue(f.LocalizedValue(locale))
+				if lv == nil {
+					continue
+				}
+				if l == nil {
+					l = map[string]ValueDocument{}
+				}
+				l[locale] = *lv
+			}
+
 			return ItemFieldDocument{
 				ItemGroup: f.ItemGroup().StringRef(),
 				F:         f.FieldID().String(),
 				V:         *v,
+				L:         l,
+				G:         NewGeometries(f.Value()),
 			}, true
 		}),
 		Timestamp:            i.Timestamp(),
@@ -118,7 +138,11 @@ func (d *ItemDocument) Model() (*item.Item, error) {
 			return nil, err
 		}
 		ig := id.ItemGroupIDFromRef(f.ItemGroup)
-		return item.NewField(sf, f.V.MultipleValue(), ig), nil
+		field := item.NewField(sf, f.V.MultipleValue(), ig)
+		for locale, lv := range f.L {
+			field.SetLocalizedValue(locale, lv.MultipleValue())
+		}
+		return field, nil
 	})
 	if err != nil {
 		return nil, err
@@ -163,3 +187,87 @@ func NewItems(items item.List) ([]*ItemDocument, []string) {
 	}
 	return res, ids
 }
+
+// NewGeometries parses geometry values into GeoJSON objects that can be queried with geospatial operators.
+// Values that are not valid GeoJSON geometries are skipped.
+func NewGeometries(v *value.Multiple) []any {
+	if v == nil || !v.Type().IsGeometryFieldType() {
+		return nil
+	}
+	values, _ := v.ValuesJson()
+	return lo.FilterMap(values, func(s string, _ int) (any, bool) {
+		if g, err := geojson.UnmarshalGeometry([]byte(s)); err != nil || !isIndexableGeometry(g) {
+			return nil, false
+		}
+		var g map[string]any
+		if err := json.Unmarshal([]byte(s), &g); err != nil {
+			return nil, false
+		}
+		return g, true
+	})
+}
+
+// maxIndexableRingSize is the number of the positions of a ring up to which the ring is checked for self-intersections.
+// Larger rings are not indexed since checking them would slow down every write of the item.
+const maxIndexableRingSize = 2000
+
+// isIndexableGeometry reports whether the geometry can be stored under the 2dsphere index.
+// MongoDB rejects the whole write when a geometry can not be indexed, so such geometries are left out of the index.
+func isIndexableGeometry(g *geojson.Geometry) bool {
+	if g == nil {
+		return false
+	}
+	switch g.Type {
+	case geojson.GeometryPoint:
+		return view.IsValidPosition(g.Point)
+	case geojson.GeometryMultiPoint:
+		return len(g.MultiPoint) > 0 && lo.EveryBy(g.MultiPoint, view.IsValidPosition)
+	case geojson.GeometryLineString:
+		return isValidLineString(g.LineString)
+	case geojson.GeometryMultiLineString:
+		return len(g.MultiLineString) > 0 && lo.EveryBy(g.MultiLineString, isValidLineString)
+	case geojson.GeometryPolygon:
+		return isValidPolygon(g.Polygon)
+	case geojson.GeometryMultiPolygon:
+		return len(g.MultiPolygon) > 0 && lo.EveryBy(g.MultiPolygon, isValidPolygon)
+	case geojson.GeometryCollection:
+		return len(g.Geometries) > 0 && lo.EveryBy(g.Geometries, isIndexableGeometry)
+	}
+	return false
+}
+
+func isValidLineString(l [][]float64) bool {
+	return len(l) >= 2 && lo.EveryBy(l, view.IsValidPosition)
+}
+
+func isValidPolygon(p [][][]float64) bool {
+	return len(p) > 0 && lo.EveryBy(p, isValidRing)
+}
+
+// isValidRing reports whether the ring is closed, has at least three distinct positions and does not intersect itself.
+func isValidRing(r [][]float64) bool {
+	if len(r) < 4 || len(r) > maxIndexableRingSize || !lo.EveryBy(r, view.IsValidPosition) {
+		return false
+	}
+	first, last := r[0], r[len(r)-1]
+	if first[0] != last[0] || first[1] != last[1] {
+		return false
+	}
+	distinct := lo.UniqBy(r, func(p []float64) [2]float64 { return [2]float64{p[0], p[1]} })
+	if len(distinct) < 3 {
+		return false
+	}
+	n := len(r) - 1
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			// adjacent edges share a position
+			if j == i+1 || (i == 0 && j == n-1) {
+				continue
+			}
+			if view.SegmentsIntersect(r[i], r[i+1], r[j], r[j+1]) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/account/accountdomain/user"
 	"github.com/stretchr/testify/assert"
 )
@@ -206,6 +207,69 @@ func TestNewItem(t *testing.T) {
 	}
 }
 
+func TestNewItem_Localized(t *testing.T) {
+	fId := schema.NewFieldID()
+	f := item.NewField(fId, value.TypeText.Value("こんにちは").AsMultiple(), nil)
+	f.SetLocalizedValue("en", value.TypeText.Value("hello").AsMultiple())
+	i := item.New().NewID().Project(project.NewID()).Schema(schema.NewID()).Thread(thread.NewID().Ref()).Model(model.NewID()).Anonymous(true).Fields([]*item.Field{f}).MustBuild()
+
+	doc, _ := NewItem(i)
+	assert.Equal(t, []ItemFieldDocument{
+		{
+			F: fId.String(),
+			V: *NewMultipleValue(value.TypeText.Value("こんにちは").AsMultiple()),
+			L: map[string]ValueDocument{
+				"en": *NewMultipleValue(value.TypeText.Value("hello").AsMultiple()),
+			},
+		},
+	}, doc.Fields)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"en"}, got.Field(fId).Locales())
+	assert.Equal(t, value.TypeText.Value("hello").AsMultiple(), got.Field(fId).LocalizedValue("en"))
+	assert.Equal(t, value.TypeText.Value("こんにちは").AsMultiple(), got.Field(fId).Value())
+}
+
+func TestNewGeometries(t *testing.T) {
+	assert.Nil(t, NewGeometries(nil))
+	assert.Nil(t, NewGeometries(value.TypeText.Value("text").AsMultiple()))
+	assert.Equal(t, []any{
+		map[string]any{"type": "Point", "coordinates": []any{139.0, 35.0}},
+	}, NewGeometries(value.NewMultiple(value.TypeGeometryObject, []any{
+		`{"type":"Point","coordinates":[139,35]}`,
+		`{"type":"Unknown"}`,
+	})))
+
+	// geometries which MongoDB can not index are left out so that they do not fail the write
+	assert.Nil(t, NewGeometries(value.NewMultiple(value.TypeGeometryObject, []any{
+		`{"type":"Point","coordinates":[200,35]}`,
+		`{"type":"Point","coordinates":[139,95]}`,
+		`{"type":"LineString","coordinates":[[139,35]]}`,
+		`{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1]]]}`,
+		`{"type":"Polygon","coordinates":[[[0,0],[1,1],[1,0],[0,1],[0,0]]]}`,
+		`{"type":"Polygon","coordinates":[[[0,0],[1,0],[0,0],[0,0]]]}`,
+		`{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[139,35]},{"type":"Point","coordinates":[139,95]}]}`,
+	})))
+	assert.Equal(t, []any{
+		map[string]any{"type": "Polygon", "coordinates": []any{[]any{
+			[]any{0.0, 0.0}, []any{1.0, 0.0}, []any{1.0, 1.0}, []any{0.0, 1.0}, []any{0.0, 0.0},
+		}}},
+	}, NewGeometries(value.NewMultiple(value.TypeGeometryObject, []any{
+		`{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1],[0,0]]]}`,
+	})))
+
+	fId := schema.NewFieldID()
+	v := value.TypeGeometryEditor.Value(`{"type":"Point","coordinates":[139,35]}`).AsMultiple()
+	i := item.New().NewID().Project(project.NewID()).Schema(schema.NewID()).Thread(thread.NewID().Ref()).Model(model.NewID()).Anonymous(true).Fields([]*item.Field{item.NewField(fId, v, nil)}).MustBuild()
+	doc, _ := NewItem(i)
+	assert.Equal(t, NewGeometries(v), doc.Fields[0].G)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, v, got.Field(fId).Value())
+}
+
 func TestNewItemConsumer(t *testing.T) {
 	c := NewItemConsumer()
 	assert.NotNil(t, c)
@@ -0,0 +1,65 @@
+package mongodoc
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/job"
+)
+
+// JobStateDocument is a state of a job published to the subscribers on all the servers.
+type JobStateDocument struct {
+	ID       string
+	JobID    string
+	Status   string
+	Progress *JobProgressDocument
+	Error    string
+	// Closed marks the end of the states of the job, which closes the subscriptions.
+	Closed    bool
+	CreatedAt time.Time
+}
+
+type JobProgressDocument struct {
+	Processed int
+	Total     int
+}
+
+func NewJobState(jobID id.JobID, s job.State, now time.Time) *JobStateDocument {
+	var p *JobProgressDocument
+	if s.Progress() != nil {
+		p = &JobProgressDocument{
+			Processed: s.Progress().Processed(),
+			Total:     s.Progress().Total(),
+		}
+	}
+	return &JobStateDocument{
+		ID:        uuid.NewString(),
+		JobID:     jobID.String(),
+		Status:    s.Status().String(),
+		Progress:  p,
+		Error:     s.Error(),
+		CreatedAt: now,
+	}
+}
+
+func NewJobStateClosed(jobID id.JobID, now time.Time) *JobStateDocument {
+	return &JobStateDocument{
+		ID:        uuid.NewString(),
+		JobID:     jobID.String(),
+		Closed:    true,
+		CreatedAt: now,
+	}
+}
+
+func (d *JobStateDocument) Model() (job.State, error) {
+	st, ok := job.StatusFrom(d.Status)
+	if !ok {
+		return job.State{}, job.ErrInvalidJobStatus
+	}
+	var p *job.Progress
+	if d.Progress != nil {
+		p = new(job.NewProgress(d.Progress.Processed, d.Progress.Total))
+	}
+	return job.NewState(st, p, d.Error), nil
+}
@@ -0,0 +1,134 @@
+package mongodoc
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/samber/lo"
+)
+
+type NotificationDocument struct {
+	ID           string
+	Workspace    string
+	User         string
+	Type         string
+	Actor        *string
+	Request      *string
+	Thread       *string
+	Comment      *string
+	Title        string
+	Content      string
+	ReadAt       *time.Time
+	Read         bool
+	EmailPending bool
+}
+
+type NotificationConsumer = mongox.SliceFuncConsumer[*NotificationDocument, *notification.Notification]
+
+func NewNotificationConsumer() *NotificationConsumer {
+	return NewConsumer[*NotificationDocument, *notification.Notification]()
+}
+
+func NewNotification(n *notification.Notification) (*NotificationDocument, string) {
+	nid := n.ID().String()
+	return &NotificationDocument{
+		ID:           nid,
+		Workspace:    n.Workspace().String(),
+		User:         n.User().String(),
+		Type:         n.Type().String(),
+		Actor:        n.Actor().StringRef(),
+		Request:      n.Request().StringRef(),
+		Thread:       n.Thread().StringRef(),
+		Comment:      n.Comment().StringRef(),
+		Title:        n.Title(),
+		Content:      n.Content(),
+		ReadAt:       n.ReadAt(),
+		Read:         n.IsRead(),
+		EmailPending: n.EmailPending(),
+	}, nid
+}
+
+func NewNotifications(l notification.List) ([]*NotificationDocument, []string) {
+	res := make([]*NotificationDocument, 0, len(l))
+	ids := make([]string, 0, len(l))
+	for _, n := range l {
+		if n == nil {
+			continue
+		}
+		d, nid := NewNotification(n)
+		res = append(res, d)
+		ids = append(ids, nid)
+	}
+	return res, ids
+}
+
+func (d *NotificationDocument) Model() (*notification.Notification, error) {
+	nid, err := id.NotificationIDFrom(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	wid, err := accountdomain.WorkspaceIDFrom(d.Workspace)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := accountdomain.UserIDFrom(d.User)
+	if err != nil {
+		return nil, err
+	}
+	t, ok := notification.TypeFrom(d.Type)
+	if !ok {
+		return nil, notification.ErrInvalidType
+	}
+
+	return notification.New().
+		ID(nid).
+		Workspace(wid).
+		User(uid).
+		Type(t).
+		Actor(accountdomain.UserIDFromRef(d.Actor)).
+		Request(id.RequestIDFromRef(d.Request)).
+		Thread(id.ThreadIDFromRef(d.Thread)).
+		Comment(id.CommentIDFromRef(d.Comment)).
+		Title(d.Title).
+		Content(d.Content).
+		ReadAt(d.ReadAt).
+		EmailPending(d.EmailPending).
+		Build()
+}
+
+// NotificationPreferenceDocument is identified by the id of its user.
+type NotificationPreferenceDocument struct {
+	ID             string
+	EmailFrequency string
+	MutedTypes     []string
+}
+
+type NotificationPreferenceConsumer = mongox.SliceFuncConsumer[*NotificationPreferenceDocument, *notification.Preference]
+
+func NewNotificationPreferenceConsumer() *NotificationPreferenceConsumer {
+	return NewConsumer[*NotificationPreferenceDocument, *notification.Preference]()
+}
+
+func NewNotificationPreference(p *notification.Preference) (*NotificationPreferenceDocument, string) {
+	uid := p.User().String()
+	return &NotificationPreferenceDocument{
+		ID:             uid,
+		EmailFrequency: p.EmailFrequency().String(),
+		MutedTypes:     lo.Map(p.MutedTypes(), func(t notification.Type, _ int) string { return t.String() }),
+	}, uid
+}
+
+func (d *NotificationPreferenceDocument) Model() (*notification.Preference, error) {
+	uid, err := accountdomain.UserIDFrom(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	f, _ := notification.EmailFrequencyFrom(d.EmailFrequency)
+	muted := lo.FilterMap(d.MutedTypes, func(s string, _ int) (notification.Type, bool) {
+		return notification.TypeFrom(s)
+	})
+	return notification.NewPreference(uid, f, muted), nil
+}
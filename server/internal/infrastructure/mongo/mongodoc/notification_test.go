@@ -0,0 +1,59 @@
+package mongodoc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNotification(t *testing.T) {
+	uid, actor, rid := accountdomain.NewUserID(), accountdomain.NewUserID(), id.NewRequestID()
+	readAt := time.Now().Truncate(time.Millisecond)
+	n := notification.New().NewID().Workspace(accountdomain.NewWorkspaceID()).User(uid).
+		Type(notification.TypeRequestApproved).Actor(&actor).Request(&rid).Title("foo").ReadAt(&readAt).EmailPending(true).
+		MustBuild()
+
+	doc, nid := NewNotification(n)
+	assert.Equal(t, n.ID().String(), nid)
+	assert.Equal(t, &NotificationDocument{
+		ID:           n.ID().String(),
+		Workspace:    n.Workspace().String(),
+		User:         uid.String(),
+		Type:         "request_approved",
+		Actor:        actor.StringRef(),
+		Request:      rid.StringRef(),
+		Title:        "foo",
+		ReadAt:       &readAt,
+		Read:         true,
+		EmailPending: true,
+	}, doc)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, n, got)
+
+	doc.Type = "xxx"
+	_, err = doc.Model()
+	assert.Equal(t, notification.ErrInvalidType, err)
+}
+
+func TestNewNotificationPreference(t *testing.T) {
+	uid := accountdomain.NewUserID()
+	p := notification.NewPreference(uid, notification.EmailFrequencyDigest, []notification.Type{notification.TypeComment})
+
+	doc, id := NewNotificationPreference(p)
+	assert.Equal(t, uid.String(), id)
+	assert.Equal(t, &NotificationPreferenceDocument{
+		ID:             uid.String(),
+		EmailFrequency: "digest",
+		MutedTypes:     []string{"comment"},
+	}, doc)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, p, got)
+}
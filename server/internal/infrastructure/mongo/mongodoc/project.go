@@ -27,6 +27,14 @@ type ProjectDocument struct {
 	Workspace     string
 	Accessibility *ProjectAccessibilityDocument
 	RequestRoles  []string
+	Localization  *LocalizationDocument
+	AssetMetadata *string
+}
+
+type LocalizationDocument struct {
+	DefaultLocale string
+	Locales       []string
+	Fallbacks     map[string][]string
 }
 
 type PublicationSettingsDocument struct {
@@ -78,9 +86,26 @@ func NewProject(project *project.Project) (*ProjectDocument, string) {
 		Workspace:     project.Workspace().String(),
 		Accessibility: NewProjectAccessibility(project.Accessibility()),
 		RequestRoles:  fromRequestRoles(project.RequestRoles()),
+		Localization:  NewLocalization(project.Localization()),
+		AssetMetadata: project.AssetMetadataSchema().StringRef(),
 	}, pid
 }
 
+func NewLocalization(l *project.Localization) *LocalizationDocument {
+	if l == nil {
+		return nil
+	}
+	var fallbacks map[string][]string
+	if fb := l.Fallbacks(); len(fb) > 0 {
+		fallbacks = fb
+	}
+	return &LocalizationDocument{
+		DefaultLocale: l.DefaultLocale(),
+		Locales:       l.Locales(),
+		Fallbacks:     fallbacks,
+	}
+}
+
 func NewProjectPublicationSettings(p *project.PublicationSettings) *PublicationSettingsDocument {
 	if p == nil {
 		return nil
@@ -155,6 +180,11 @@ func (d *ProjectDocument) Model() (*project.Project, error) {
 		return nil, err
 	}
 
+	localization, err := d.Localization.Model()
+	if err != nil {
+		return nil, err
+	}
+
 	return project.New().
 		ID(pid).
 		UpdatedAt(d.UpdatedAt).
@@ -170,9 +200,18 @@ func (d *ProjectDocument) Model() (*project.Project, error) {
 		Topics(d.Topics).
 		Accessibility(accessibility).
 		RequestRoles(toRequestRoles(d.RequestRoles)).
+		Localization(localization).
+		AssetMetadataSchema(id.SchemaIDFromRef(d.AssetMetadata)).
 		Build()
 }
 
+func (d *LocalizationDocument) Model() (*project.Localization, error) {
+	if d == nil {
+		return nil, nil
+	}
+	return project.NewLocalization(d.DefaultLocale, d.Locales, d.Fallbacks)
+}
+
 func (d *PublicationSettingsDocument) Model() *project.PublicationSettings {
 	if d == nil {
 		return nil
@@ -293,3 +293,28 @@ func TestPostingSettingsDocument_Model(t *testing.T) {
 		})
 	}
 }
+
+func TestLocalizationDocument_Model(t *testing.T) {
+	l, err := project.NewLocalization("ja", []string{"ja", "en", "en-US"}, map[string][]string{"en-US": {"en"}})
+	require.NoError(t, err)
+
+	doc := NewLocalization(l)
+	assert.Equal(t, &LocalizationDocument{
+		DefaultLocale: "ja",
+		Locales:       []string{"ja", "en", "en-US"},
+		Fallbacks:     map[string][]string{"en-US": {"en"}},
+	}, doc)
+
+	got, err := doc.Model()
+	require.NoError(t, err)
+	assert.Equal(t, l, got)
+
+	assert.Nil(t, NewLocalization(nil))
+
+	got, err = (*LocalizationDocument)(nil).Model()
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	_, err = (&LocalizationDocument{DefaultLocale: "fr", Locales: []string{"ja"}}).Model()
+	assert.Equal(t, project.ErrDefaultLocaleMissing, err)
+}
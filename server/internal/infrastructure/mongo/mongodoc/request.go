@@ -14,19 +14,22 @@ import (
 )
 
 type RequestDocument struct {
-	ID          string
-	Workspace   string
-	Project     string
-	Items       []RequestItem
-	Title       string
-	Description string
-	CreatedBy   string
-	Reviewers   []string
-	State       string
-	UpdatedAt   time.Time
-	ApprovedAt  *time.Time
-	ClosedAt    *time.Time
-	Thread      *string
+	ID           string
+	Workspace    string
+	Project      string
+	Items        []RequestItem
+	Title        string
+	Description  string
+	CreatedBy    string
+	Reviewers    []string
+	State        string
+	UpdatedAt    time.Time
+	ApprovedAt   *time.Time
+	ClosedAt     *time.Time
+	Thread       *string
+	Stages       []RequestStage
+	CurrentStage int
+	Approvals    []RequestApproval
 }
 
 type RequestItem struct {
@@ -35,6 +38,20 @@ type RequestItem struct {
 	Ref     *string
 }
 
+type RequestStage struct {
+	Name              string
+	Reviewers         []string
+	RequiredApprovals int
+}
+
+type RequestApproval struct {
+	User      string
+	Stage     int
+	Decision  string
+	Comment   string
+	CreatedAt time.Time
+}
+
 type RequestConsumer = mongox.SliceFuncConsumer[*RequestDocument, *request.Request]
 
 func NewRequestConsumer() *RequestConsumer {
@@ -72,11 +89,14 @@ func NewRequest(r *request.Request) (*RequestDocument, string) {
 		Reviewers: lo.Map(r.Reviewers(), func(u accountdomain.UserID, i int) string {
 			return u.String()
 		}),
-		State:      r.State().String(),
-		UpdatedAt:  r.UpdatedAt(),
-		ApprovedAt: r.ApprovedAt(),
-		ClosedAt:   r.ClosedAt(),
-		Thread:     r.Thread().StringRef(),
+		State:        r.State().String(),
+		UpdatedAt:    r.UpdatedAt(),
+		ApprovedAt:   r.ApprovedAt(),
+		ClosedAt:     r.ClosedAt(),
+		Thread:       r.Thread().StringRef(),
+		Stages:       NewRequestStages(r.Stages()),
+		CurrentStage: r.CurrentStageIndex(),
+		Approvals:    newRequestApprovals(r.Approvals()),
 	}, rid
 
 	return doc, id
@@ -138,6 +158,21 @@ func (d *RequestDocument) Model() (*request.Request, error) {
 		return nil, err
 	}
 
+	stages, err := RequestStagesModel(d.Stages)
+	if err != nil {
+		return nil, err
+	}
+	approvals, err := util.TryMap(d.Approvals, func(a RequestApproval) (*request.Approval, error) {
+		uid, err := accountdomain.UserIDFrom(a.User)
+		if err != nil {
+			return nil, err
+		}
+		return request.NewApproval(uid, a.Stage, request.DecisionFrom(a.Decision), a.Comment, a.CreatedAt), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	builder := request.New().
 		ID(rid).
 		Project(pid).
@@ -151,7 +186,48 @@ func (d *RequestDocument) Model() (*request.Request, error) {
 		ClosedAt(d.ClosedAt).
 		ApprovedAt(d.ApprovedAt).
 		Reviewers(reviewers).
-		Thread(id.ThreadIDFromRef(d.Thread))
+		Thread(id.ThreadIDFromRef(d.Thread)).
+		Stages(stages).
+		CurrentStage(d.CurrentStage).
+		Approvals(approvals)
 
 	return builder.Build()
 }
+
+func NewRequestStages(stages request.StageList) []RequestStage {
+	if len(stages) == 0 {
+		return nil
+	}
+	return lo.Map(stages, func(s *request.Stage, _ int) RequestStage {
+		return RequestStage{
+			Name:              s.Name(),
+			Reviewers:         s.Reviewers().Strings(),
+			RequiredApprovals: s.RequiredApprovals(),
+		}
+	})
+}
+
+func newRequestApprovals(approvals request.ApprovalList) []RequestApproval {
+	if len(approvals) == 0 {
+		return nil
+	}
+	return lo.Map(approvals, func(a *request.Approval, _ int) RequestApproval {
+		return RequestApproval{
+			User:      a.User().String(),
+			Stage:     a.Stage(),
+			Decision:  a.Decision().String(),
+			Comment:   a.Comment(),
+			CreatedAt: a.CreatedAt(),
+		}
+	})
+}
+
+func RequestStagesModel(stages []RequestStage) (request.StageList, error) {
+	return util.TryMap(stages, func(s RequestStage) (*request.Stage, error) {
+		reviewers, err := id.UserIDListFrom(s.Reviewers)
+		if err != nil {
+			return nil, err
+		}
+		return request.NewStage(s.Name, reviewers, s.RequiredApprovals)
+	})
+}
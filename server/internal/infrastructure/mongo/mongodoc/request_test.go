@@ -366,3 +366,37 @@ func TestRequestDocument_Model(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestDocument_Stages(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond)
+	u1, u2 := user.NewID(), user.NewID()
+	itm, _ := request.NewItem(item.NewID(), new(version.New().String()))
+	editor, _ := request.NewStage("editor", accountdomain.UserIDList{u1}, 1)
+	legal, _ := request.NewStage("legal", accountdomain.UserIDList{u1, u2}, 2)
+	approvals := request.ApprovalList{
+		request.NewApproval(u1, 0, request.DecisionApproved, "", now),
+		request.NewApproval(u2, 1, request.DecisionChangesRequested, "fix", now),
+	}
+	r := request.New().NewID().Project(project.NewID()).Workspace(user.NewWorkspaceID()).CreatedBy(user.NewID()).
+		Title("ab").Items(request.ItemList{itm}).UpdatedAt(now).State(request.StateChangesRequested).
+		Reviewers(accountdomain.UserIDList{u1, u2}).Stages(request.StageList{editor, legal}).CurrentStage(1).Approvals(approvals).
+		MustBuild()
+
+	doc, _ := NewRequest(r)
+	assert.Equal(t, []RequestStage{
+		{Name: "editor", Reviewers: []string{u1.String()}, RequiredApprovals: 1},
+		{Name: "legal", Reviewers: []string{u1.String(), u2.String()}, RequiredApprovals: 2},
+	}, doc.Stages)
+	assert.Equal(t, 1, doc.CurrentStage)
+	assert.Equal(t, []RequestApproval{
+		{User: u1.String(), Stage: 0, Decision: "approved", CreatedAt: now},
+		{User: u2.String(), Stage: 1, Decision: "changes_requested", Comment: "fix", CreatedAt: now},
+	}, doc.Approvals)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, r.Stages(), got.Stages())
+	assert.Equal(t, 1, got.CurrentStageIndex())
+	assert.Equal(t, r.Approvals(), got.Approvals())
+	assert.Equal(t, request.StateChangesRequested, got.State())
+}
@@ -0,0 +1,65 @@
+package mongodoc
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/mongox"
+)
+
+type RequestWorkflowDocument struct {
+	ID        string
+	Workspace string
+	Project   string
+	ModelID   *string
+	Stages    []RequestStage
+	UpdatedAt time.Time
+}
+
+type RequestWorkflowConsumer = mongox.SliceFuncConsumer[*RequestWorkflowDocument, *request.Workflow]
+
+func NewRequestWorkflowConsumer() *RequestWorkflowConsumer {
+	return NewConsumer[*RequestWorkflowDocument, *request.Workflow]()
+}
+
+func NewRequestWorkflow(w *request.Workflow) (*RequestWorkflowDocument, string) {
+	wid := w.ID().String()
+	return &RequestWorkflowDocument{
+		ID:        wid,
+		Workspace: w.Workspace().String(),
+		Project:   w.Project().String(),
+		ModelID:   w.Model().StringRef(),
+		Stages:    NewRequestStages(w.Stages()),
+		UpdatedAt: w.UpdatedAt(),
+	}, wid
+}
+
+func (d *RequestWorkflowDocument) Model() (*request.Workflow, error) {
+	wid, err := id.WorkflowIDFrom(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	wsid, err := accountdomain.WorkspaceIDFrom(d.Workspace)
+	if err != nil {
+		return nil, err
+	}
+	pid, err := id.ProjectIDFrom(d.Project)
+	if err != nil {
+		return nil, err
+	}
+	stages, err := RequestStagesModel(d.Stages)
+	if err != nil {
+		return nil, err
+	}
+
+	return request.NewWorkflow().
+		ID(wid).
+		Workspace(wsid).
+		Project(pid).
+		Model(id.ModelIDFromRef(d.ModelID)).
+		Stages(stages).
+		UpdatedAt(d.UpdatedAt).
+		Build()
+}
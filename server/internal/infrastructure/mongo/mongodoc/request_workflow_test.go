@@ -0,0 +1,39 @@
+package mongodoc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRequestWorkflow(t *testing.T) {
+	uid := accountdomain.NewUserID()
+	mid := id.NewModelID()
+	now := time.Now().Truncate(time.Millisecond)
+	s, _ := request.NewStage("legal", accountdomain.UserIDList{uid}, 1)
+	w := request.NewWorkflow().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).
+		Model(&mid).Stages(request.StageList{s}).UpdatedAt(now).MustBuild()
+
+	doc, wid := NewRequestWorkflow(w)
+	assert.Equal(t, w.ID().String(), wid)
+	assert.Equal(t, &RequestWorkflowDocument{
+		ID:        w.ID().String(),
+		Workspace: w.Workspace().String(),
+		Project:   w.Project().String(),
+		ModelID:   mid.StringRef(),
+		Stages:    []RequestStage{{Name: "legal", Reviewers: []string{uid.String()}, RequiredApprovals: 1}},
+		UpdatedAt: now,
+	}, doc)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, w, got)
+
+	doc.Stages = nil
+	_, err = doc.Model()
+	assert.Equal(t, request.ErrEmptyStages, err)
+}
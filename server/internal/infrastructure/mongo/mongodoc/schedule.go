@@ -0,0 +1,119 @@
+package mongodoc
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/mongox"
+)
+
+type ScheduleDocument struct {
+	ID          string
+	ProjectID   string
+	ModelID     string
+	Items       []string
+	Action      string
+	Status      string
+	ScheduledAt time.Time
+	User        *string
+	Integration *string
+	Error       string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	ExecutedAt  *time.Time
+}
+
+func NewSchedule(s *schedule.Schedule) (*ScheduleDocument, string, error) {
+	sID := s.ID().String()
+
+	var uid, iid *string
+	if s.User() != nil {
+		uid = s.User().StringRef()
+	}
+	if s.Integration() != nil {
+		iid = s.Integration().StringRef()
+	}
+
+	return &ScheduleDocument{
+		ID:          sID,
+		ProjectID:   s.Project().String(),
+		ModelID:     s.Model().String(),
+		Items:       s.Items().Strings(),
+		Action:      s.Action().String(),
+		Status:      s.Status().String(),
+		ScheduledAt: s.ScheduledAt(),
+		User:        uid,
+		Integration: iid,
+		Error:       s.Error(),
+		CreatedAt:   s.CreatedAt(),
+		UpdatedAt:   s.UpdatedAt(),
+		ExecutedAt:  s.ExecutedAt(),
+	}, sID, nil
+}
+
+func (d *ScheduleDocument) Model() (*schedule.Schedule, error) {
+	sID, err := id.ScheduleIDFrom(d.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	pID, err := id.ProjectIDFrom(d.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	mID, err := id.ModelIDFrom(d.ModelID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := id.ItemIDListFrom(d.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	a, ok := schedule.ActionFrom(d.Action)
+	if !ok {
+		return nil, schedule.ErrInvalidAction
+	}
+
+	st, ok := schedule.StatusFrom(d.Status)
+	if !ok {
+		return nil, schedule.ErrInvalidStatus
+	}
+
+	b := schedule.New().
+		ID(sID).
+		Project(pID).
+		Model(mID).
+		Items(items).
+		Action(a).
+		Status(st).
+		ScheduledAt(d.ScheduledAt).
+		Error(d.Error).
+		UpdatedAt(d.UpdatedAt).
+		ExecutedAt(d.ExecutedAt)
+
+	if d.User != nil {
+		uid := accountdomain.UserIDFromRef(d.User)
+		if uid != nil {
+			b = b.User(*uid)
+		}
+	}
+	if d.Integration != nil {
+		iid := id.IntegrationIDFromRef(d.Integration)
+		if iid != nil {
+			b = b.Integration(*iid)
+		}
+	}
+
+	return b.Build()
+}
+
+type ScheduleConsumer = mongox.SliceFuncConsumer[*ScheduleDocument, *schedule.Schedule]
+
+func NewScheduleConsumer() *ScheduleConsumer {
+	return NewConsumer[*ScheduleDocument, *schedule.Schedule]()
+}
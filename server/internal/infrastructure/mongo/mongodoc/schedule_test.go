@@ -0,0 +1,77 @@
+package mongodoc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSchedule(t *testing.T) {
+	uid := accountdomain.NewUserID()
+	iid := id.NewIntegrationID()
+	at := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+	items := id.ItemIDList{id.NewItemID(), id.NewItemID()}
+
+	s1 := schedule.New().NewID().Project(id.NewProjectID()).Model(id.NewModelID()).
+		Items(items).Action(schedule.ActionPublish).ScheduledAt(at).User(uid).MustBuild()
+	s2 := schedule.New().NewID().Project(id.NewProjectID()).Model(id.NewModelID()).
+		Items(items).Action(schedule.ActionUnpublish).ScheduledAt(at).Integration(iid).MustBuild()
+	s2.Fail("failed")
+
+	doc, sID, err := NewSchedule(s1)
+	assert.NoError(t, err)
+	assert.Equal(t, s1.ID().String(), sID)
+	assert.Equal(t, &ScheduleDocument{
+		ID:          s1.ID().String(),
+		ProjectID:   s1.Project().String(),
+		ModelID:     s1.Model().String(),
+		Items:       items.Strings(),
+		Action:      "publish",
+		Status:      "pending",
+		ScheduledAt: at,
+		User:        uid.StringRef(),
+		CreatedAt:   s1.CreatedAt(),
+		UpdatedAt:   s1.UpdatedAt(),
+	}, doc)
+
+	doc2, _, err := NewSchedule(s2)
+	assert.NoError(t, err)
+	assert.Nil(t, doc2.User)
+	assert.Equal(t, iid.StringRef(), doc2.Integration)
+	assert.Equal(t, "failed", doc2.Status)
+	assert.Equal(t, "failed", doc2.Error)
+	assert.Equal(t, s2.ExecutedAt(), doc2.ExecutedAt)
+}
+
+func TestScheduleDocument_Model(t *testing.T) {
+	uid := accountdomain.NewUserID()
+	at := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+	s := schedule.New().NewID().Project(id.NewProjectID()).Model(id.NewModelID()).
+		Items(id.ItemIDList{id.NewItemID()}).Action(schedule.ActionPublish).ScheduledAt(at).User(uid).MustBuild()
+
+	doc, _, err := NewSchedule(s)
+	assert.NoError(t, err)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, s, got)
+
+	invalid := *doc
+	invalid.Action = "delete"
+	_, err = invalid.Model()
+	assert.ErrorIs(t, err, schedule.ErrInvalidAction)
+
+	invalid = *doc
+	invalid.Status = "unknown"
+	_, err = invalid.Model()
+	assert.ErrorIs(t, err, schedule.ErrInvalidStatus)
+
+	invalid = *doc
+	invalid.ID = "x"
+	_, err = invalid.Model()
+	assert.Error(t, err)
+}
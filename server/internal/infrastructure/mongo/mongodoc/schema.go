@@ -18,6 +18,22 @@ type SchemaDocument struct {
 	Project    string
 	Fields     []FieldDocument
 	TitleField *string
+	Rules      []SchemaRuleDocument `bson:",omitempty"`
+}
+
+type SchemaRuleDocument struct {
+	Type      string
+	Field     string
+	Condition *SchemaRuleConditionDocument `bson:",omitempty"`
+	Operator  string                       `bson:",omitempty"`
+	Other     *string                      `bson:",omitempty"`
+	Message   string                       `bson:",omitempty"`
+}
+
+type SchemaRuleConditionDocument struct {
+	Field    string
+	Operator string
+	Value    *ValueDocument `bson:",omitempty"`
 }
 
 type FieldDocument struct {
@@ -29,6 +45,7 @@ type FieldDocument struct {
 	Unique       bool
 	Multiple     bool
 	Required     bool
+	Localizable  bool
 	UpdatedAt    time.Time
 	DefaultValue *ValueDocument
 	TypeProperty TypePropertyDocument
@@ -51,8 +68,38 @@ type TypePropertyDocument struct {
 }
 
 type FieldTextPropertyDocument struct {
-	MaxLength *int
+	MaxLength      *int
+	MinLength      *int     `bson:",omitempty"`
+	Pattern        string   `bson:",omitempty"`
+	PatternMessage string   `bson:",omitempty"`
+	ForbiddenWords []string `bson:",omitempty"`
+	Preset         string   `bson:",omitempty"`
+}
+
+func newFieldTextPropertyDocument(maxLength *int, v *schema.TextValidation) *FieldTextPropertyDocument {
+	return &FieldTextPropertyDocument{
+		MaxLength:      maxLength,
+		MinLength:      v.MinLength(),
+		Pattern:        v.Pattern(),
+		PatternMessage: v.PatternMessage(),
+		ForbiddenWords: v.ForbiddenWords(),
+		Preset:         v.Preset().String(),
+	}
 }
+
+func (d *FieldTextPropertyDocument) setValidation(f interface {
+	SetValidation(*schema.TextValidation) error
+}) error {
+	if d.MinLength == nil && d.Pattern == "" && len(d.ForbiddenWords) == 0 && d.Preset == "" {
+		return nil
+	}
+	v, err := schema.NewTextValidation(d.MinLength, d.Pattern, d.PatternMessage, d.ForbiddenWords, schema.TextPreset(d.Preset))
+	if err != nil {
+		return err
+	}
+	return f.SetValidation(v)
+}
+
 type FieldSelectPropertyDocument struct {
 	Values []string
 }
@@ -107,6 +154,7 @@ func NewSchema(s *schema.Schema) (*SchemaDocument, string) {
 			Unique:      f.Unique(),
 			Multiple:    f.Multiple(),
 			Required:    f.Required(),
+			Localizable: f.Localizable(),
 			UpdatedAt:   f.UpdatedAt(),
 			TypeProperty: TypePropertyDocument{
 				Type: string(f.Type()),
@@ -119,24 +167,16 @@ func NewSchema(s *schema.Schema) (*SchemaDocument, string) {
 
 		f.TypeProperty().Match(schema.TypePropertyMatch{
 			Text: func(fp *schema.FieldText) {
-				fd.TypeProperty.Text = &FieldTextPropertyDocument{
-					MaxLength: fp.MaxLength(),
-				}
+				fd.TypeProperty.Text = newFieldTextPropertyDocument(fp.MaxLength(), fp.Validation())
 			},
 			TextArea: func(fp *schema.FieldTextArea) {
-				fd.TypeProperty.TextArea = &FieldTextPropertyDocument{
-					MaxLength: fp.MaxLength(),
-				}
+				fd.TypeProperty.TextArea = newFieldTextPropertyDocument(fp.MaxLength(), fp.Validation())
 			},
 			RichText: func(fp *schema.FieldRichText) {
-				fd.TypeProperty.RichText = &FieldTextPropertyDocument{
-					MaxLength: fp.MaxLength(),
-				}
+				fd.TypeProperty.RichText = newFieldTextPropertyDocument(fp.MaxLength(), fp.Validation())
 			},
 			Markdown: func(fp *schema.FieldMarkdown) {
-				fd.TypeProperty.Markdown = &FieldTextPropertyDocument{
-					MaxLength: fp.MaxLength(),
-				}
+				fd.TypeProperty.Markdown = newFieldTextPropertyDocument(fp.MaxLength(), fp.Validation())
 			},
 			Asset:    func(fp *schema.FieldAsset) {},
 			DateTime: func(fp *schema.FieldDateTime) {},
@@ -207,9 +247,66 @@ func NewSchema(s *schema.Schema) (*SchemaDocument, string) {
 		Project:    s.Project().String(),
 		Fields:     fieldsDoc,
 		TitleField: s.TitleField().StringRef(),
+		Rules:      newSchemaRuleDocuments(s.Rules()),
 	}, sId
 }
 
+func newSchemaRuleDocuments(rules schema.Rules) []SchemaRuleDocument {
+	if len(rules) == 0 {
+		return nil
+	}
+	return lo.Map(rules, func(r *schema.Rule, _ int) SchemaRuleDocument {
+		rd := SchemaRuleDocument{
+			Type:     string(r.Type()),
+			Field:    r.Field().String(),
+			Operator: string(r.Operator()),
+			Other:    r.Other().StringRef(),
+			Message:  r.Message(),
+		}
+		if c := r.Condition(); c != nil {
+			rd.Condition = &SchemaRuleConditionDocument{
+				Field:    c.Field().String(),
+				Operator: string(c.Operator()),
+				Value:    NewMultipleValue(c.Value().AsMultiple()),
+			}
+		}
+		return rd
+	})
+}
+
+func (d SchemaRuleDocument) model() (*schema.Rule, error) {
+	fid, err := id.FieldIDFrom(d.Field)
+	if err != nil {
+		return nil, err
+	}
+
+	switch schema.RuleType(d.Type) {
+	case schema.RuleTypeRequiredIf, schema.RuleTypeVisibleIf:
+		if d.Condition == nil {
+			return nil, schema.ErrInvalidRule
+		}
+		cfid, err := id.FieldIDFrom(d.Condition.Field)
+		if err != nil {
+			return nil, err
+		}
+		c, err := schema.NewCondition(cfid, schema.ConditionOperator(d.Condition.Operator), d.Condition.Value.MultipleValue().First())
+		if err != nil {
+			return nil, err
+		}
+		if schema.RuleType(d.Type) == schema.RuleTypeRequiredIf {
+			return schema.NewRequiredIfRule(fid, c, d.Message), nil
+		}
+		return schema.NewVisibleIfRule(fid, c, d.Message), nil
+	case schema.RuleTypeCompare:
+		ofid := id.FieldIDFromRef(d.Other)
+		if ofid == nil {
+			return nil, schema.ErrInvalidRule
+		}
+		return schema.NewCompareRule(fid, schema.CompareOperator(d.Operator), *ofid, d.Message), nil
+	}
+	return nil, schema.ErrInvalidRule
+}
+
 func NewSchemas(list schema.List) ([]*SchemaDocument, []string) {
 	docs := make([]*SchemaDocument, 0, len(list))
 	ids := make([]string, 0, len(list))
@@ -265,13 +362,29 @@ func (d *SchemaDocument) Model() (*schema.Schema, error) {
 		var tp *schema.TypeProperty
 		switch value.Type(tpd.Type) {
 		case value.TypeText:
-			tp = schema.NewText(tpd.Text.MaxLength).TypeProperty()
+			tps := schema.NewText(tpd.Text.MaxLength)
+			if err := tpd.Text.setValidation(tps); err != nil {
+				return nil, err
+			}
+			tp = tps.TypeProperty()
 		case value.TypeTextArea:
-			tp = schema.NewTextArea(tpd.TextArea.MaxLength).TypeProperty()
+			tps := schema.NewTextArea(tpd.TextArea.MaxLength)
+			if err := tpd.TextArea.setValidation(tps); err != nil {
+				return nil, err
+			}
+			tp = tps.TypeProperty()
 		case value.TypeRichText:
-			tp = schema.NewRichText(tpd.RichText.MaxLength).TypeProperty()
+			tps := schema.NewRichText(tpd.RichText.MaxLength)
+			if err := tpd.RichText.setValidation(tps); err != nil {
+				return nil, err
+			}
+			tp = tps.TypeProperty()
 		case value.TypeMarkdown:
-			tp = schema.NewMarkdown(tpd.Markdown.MaxLength).TypeProperty()
+			tps := schema.NewMarkdown(tpd.Markdown.MaxLength)
+			if err := tpd.Markdown.setValidation(tps); err != nil {
+				return nil, err
+			}
+			tp = tps.TypeProperty()
 		case value.TypeAsset:
 			tp = schema.NewAsset().TypeProperty()
 		case value.TypeDateTime:
@@ -341,6 +454,7 @@ func (d *SchemaDocument) Model() (*schema.Schema, error) {
 			Multiple(fd.Multiple).
 			Order(fd.Order).
 			Required(fd.Required).
+			Localizable(fd.Localizable).
 			Description(fd.Description).
 			Key(id.NewKey(fd.Key)).
 			UpdatedAt(fd.UpdatedAt).
@@ -351,12 +465,18 @@ func (d *SchemaDocument) Model() (*schema.Schema, error) {
 		return nil, err
 	}
 
+	rules, err := util.TryMap(d.Rules, SchemaRuleDocument.model)
+	if err != nil {
+		return nil, err
+	}
+
 	return schema.New().
 		ID(sId).
 		Workspace(wId).
 		Project(pId).
 		Fields(f).
 		TitleField(fid).
+		Rules(rules).
 		Build()
 }
 
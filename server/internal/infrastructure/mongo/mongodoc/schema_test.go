@@ -6,6 +6,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/account/accountdomain/user"
 
 	"github.com/stretchr/testify/assert"
@@ -133,3 +134,72 @@ func TestSchemaDocument_Model(t *testing.T) {
 		})
 	}
 }
+
+func TestSchemaDocument_Model_TextValidation(t *testing.T) {
+	v, err := schema.NewTextValidation(new(2), "^[a-z@.]+$", "lowercase only", []string{"spam"}, schema.TextPresetEmail)
+	assert.NoError(t, err)
+	tp := schema.NewText(new(100))
+	assert.NoError(t, tp.SetValidation(v))
+	sf := schema.NewField(tp.TypeProperty()).NewID().Key(id.NewKey("email")).MustBuild()
+	s := schema.New().NewID().Workspace(user.NewWorkspaceID()).Project(project.NewID()).Fields(schema.FieldList{sf}).MustBuild()
+
+	doc, _ := NewSchema(s)
+	assert.Equal(t, &FieldTextPropertyDocument{
+		MaxLength:      new(100),
+		MinLength:      new(2),
+		Pattern:        "^[a-z@.]+$",
+		PatternMessage: "lowercase only",
+		ForbiddenWords: []string{"spam"},
+		Preset:         "email",
+	}, doc.Fields[0].TypeProperty.Text)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, s, got)
+
+	doc.Fields[0].TypeProperty.Text.Pattern = "("
+	_, err = doc.Model()
+	assert.Equal(t, schema.ErrInvalidPattern, err)
+}
+
+func TestSchemaDocument_Model_Rules(t *testing.T) {
+	status := schema.NewField(schema.NewSelect([]string{"draft", "published"}).TypeProperty()).NewID().Key(id.NewKey("status")).MustBuild()
+	publishedAt := schema.NewField(schema.NewDateTime().TypeProperty()).NewID().Key(id.NewKey("publishedAt")).MustBuild()
+	expiredAt := schema.NewField(schema.NewDateTime().TypeProperty()).NewID().Key(id.NewKey("expiredAt")).MustBuild()
+	c, err := schema.NewCondition(status.ID(), schema.ConditionOperatorEquals, value.TypeSelect.Value("published"))
+	assert.NoError(t, err)
+	rules := schema.Rules{
+		schema.NewRequiredIfRule(publishedAt.ID(), c, ""),
+		schema.NewCompareRule(expiredAt.ID(), schema.CompareOperatorGreaterThan, publishedAt.ID(), "must be after the publication"),
+	}
+	s := schema.New().NewID().Workspace(user.NewWorkspaceID()).Project(project.NewID()).
+		Fields(schema.FieldList{status, publishedAt, expiredAt}).Rules(rules).MustBuild()
+
+	doc, _ := NewSchema(s)
+	assert.Equal(t, []SchemaRuleDocument{
+		{
+			Type:  "required_if",
+			Field: publishedAt.ID().String(),
+			Condition: &SchemaRuleConditionDocument{
+				Field:    status.ID().String(),
+				Operator: "eq",
+				Value:    &ValueDocument{T: "select", V: []any{"published"}},
+			},
+		},
+		{
+			Type:     "compare",
+			Field:    expiredAt.ID().String(),
+			Operator: "gt",
+			Other:    publishedAt.ID().StringRef(),
+			Message:  "must be after the publication",
+		},
+	}, doc.Rules)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, s, got)
+
+	doc.Rules[1].Other = nil
+	_, err = doc.Model()
+	assert.Equal(t, schema.ErrInvalidRule, err)
+}
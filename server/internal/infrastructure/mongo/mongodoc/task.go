@@ -0,0 +1,83 @@
+package mongodoc
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearthx/mongox"
+)
+
+type TaskDocument struct {
+	ID          string
+	Kind        string
+	Payload     string
+	Status      string
+	Attempts    int
+	MaxAttempts int
+	RunAt       time.Time
+	LockedUntil *time.Time
+	Error       string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func NewTask(t *task.Task) (*TaskDocument, string, error) {
+	tID := t.ID().String()
+
+	// the payload is kept as JSON since the data of webhook events cannot be restored from BSON as they were
+	p, err := json.Marshal(t.Message())
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &TaskDocument{
+		ID:          tID,
+		Kind:        t.Message().Kind(),
+		Payload:     string(p),
+		Status:      t.Status().String(),
+		Attempts:    t.Attempts(),
+		MaxAttempts: t.MaxAttempts(),
+		RunAt:       t.RunAt(),
+		LockedUntil: t.LockedUntil(),
+		Error:       t.Error(),
+		CreatedAt:   t.CreatedAt(),
+		UpdatedAt:   t.UpdatedAt(),
+	}, tID, nil
+}
+
+func (d *TaskDocument) Model() (*task.Task, error) {
+	tID, err := id.TaskIDFrom(d.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var m task.Message
+	if err := json.Unmarshal([]byte(d.Payload), &m); err != nil {
+		return nil, err
+	}
+
+	st, ok := task.StatusFrom(d.Status)
+	if !ok {
+		return nil, task.ErrInvalidStatus
+	}
+
+	return task.New().
+		ID(tID).
+		Message(m).
+		Status(st).
+		Attempts(d.Attempts).
+		MaxAttempts(d.MaxAttempts).
+		RunAt(d.RunAt).
+		LockedUntil(d.LockedUntil).
+		Error(d.Error).
+		UpdatedAt(d.UpdatedAt).
+		Build()
+}
+
+type TaskConsumer = mongox.SliceFuncConsumer[*TaskDocument, *task.Task]
+
+func NewTaskConsumer() *TaskConsumer {
+	return NewConsumer[*TaskDocument, *task.Task]()
+}
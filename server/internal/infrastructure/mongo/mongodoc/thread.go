@@ -1,18 +1,24 @@
 package mongodoc
 
 import (
+	"time"
+
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/operator"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/mongox"
 	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
 )
 
 type ThreadDocument struct {
-	ID        string
-	Workspace string
-	Comments  []*CommentDocument
+	ID                    string
+	Workspace             string
+	Comments              []*CommentDocument
+	ResolvedAt            *time.Time
+	ResolvedByUser        *string
+	ResolvedByIntegration *string
 }
 
 type CommentDocument struct {
@@ -20,6 +26,21 @@ type CommentDocument struct {
 	User        *string
 	Integration *string
 	Content     string
+	ReplyTo     *string
+	Mentions    []*MentionDocument
+	Reactions   []*ReactionDocument
+}
+
+type MentionDocument struct {
+	Name        string
+	User        *string
+	Integration *string
+}
+
+type ReactionDocument struct {
+	Emoji       string
+	User        *string
+	Integration *string
 }
 
 type ThreadConsumer = mongox.SliceFuncConsumer[*ThreadDocument, *thread.Thread]
@@ -32,10 +53,15 @@ func NewThread(a *thread.Thread) (*ThreadDocument, string) {
 	thid := a.ID().String()
 	comments := util.Map(a.Comments(), func(c *thread.Comment) *CommentDocument { return NewComment(c) })
 	thd, id := &ThreadDocument{
-		ID:        thid,
-		Workspace: a.Workspace().String(),
-		Comments:  comments,
+		ID:         thid,
+		Workspace:  a.Workspace().String(),
+		Comments:   comments,
+		ResolvedAt: a.ResolvedAt(),
 	}, thid
+	if by := a.ResolvedBy(); by != nil {
+		thd.ResolvedByUser = by.User().StringRef()
+		thd.ResolvedByIntegration = by.Integration().StringRef()
+	}
 
 	return thd, id
 }
@@ -69,10 +95,16 @@ func (d *ThreadDocument) Model() (*thread.Thread, error) {
 		return c.Model()
 	})
 
+	var resolvedBy *operator.Operator
+	if d.ResolvedAt != nil {
+		resolvedBy = new(operatorFrom(d.ResolvedByUser, d.ResolvedByIntegration))
+	}
+
 	return thread.New().
 		ID(thid).
 		Workspace(wid).
 		Comments(comments).
+		Resolved(resolvedBy, d.ResolvedAt).
 		Build()
 }
 
@@ -86,6 +118,21 @@ func NewComment(c *thread.Comment) *CommentDocument {
 		User:        c.Author().User().StringRef(),
 		Integration: c.Author().Integration().StringRef(),
 		Content:     c.Content(),
+		ReplyTo:     c.ReplyTo().StringRef(),
+		Mentions: util.Map(c.Mentions(), func(m *thread.Mention) *MentionDocument {
+			return &MentionDocument{
+				Name:        m.Name(),
+				User:        m.Target().User().StringRef(),
+				Integration: m.Target().Integration().StringRef(),
+			}
+		}),
+		Reactions: util.Map(c.Reactions(), func(r *thread.Reaction) *ReactionDocument {
+			return &ReactionDocument{
+				Emoji:       r.Emoji(),
+				User:        r.Author().User().StringRef(),
+				Integration: r.Author().Integration().StringRef(),
+			}
+		}),
 	}
 }
 
@@ -99,16 +146,32 @@ func (c *CommentDocument) Model() *thread.Comment {
 		return nil
 	}
 
-	var author operator.Operator
-	if c.User != nil {
-		if uid := accountdomain.UserIDFromRef(c.User); uid != nil {
-			author = operator.OperatorFromUser(*uid)
+	res := thread.NewComment(cid, operatorFrom(c.User, c.Integration), c.Content)
+	res.SetReplyTo(id.CommentIDFromRef(c.ReplyTo))
+	if len(c.Mentions) > 0 {
+		res.SetMentions(util.Map(c.Mentions, func(m *MentionDocument) *thread.Mention {
+			return thread.NewMention(m.Name, operatorFrom(m.User, m.Integration))
+		}))
+	}
+	if len(c.Reactions) > 0 {
+		res.SetReactions(lo.FilterMap(c.Reactions, func(r *ReactionDocument, _ int) (*thread.Reaction, bool) {
+			reaction, err := thread.NewReaction(r.Emoji, operatorFrom(r.User, r.Integration))
+			return reaction, err == nil
+		}))
+	}
+	return res
+}
+
+func operatorFrom(user, integration *string) operator.Operator {
+	var res operator.Operator
+	if user != nil {
+		if uid := accountdomain.UserIDFromRef(user); uid != nil {
+			res = operator.OperatorFromUser(*uid)
 		}
-	} else if c.Integration != nil {
-		if iid := id.IntegrationIDFromRef(c.Integration); iid != nil {
-			author = operator.OperatorFromIntegration(*iid)
+	} else if integration != nil {
+		if iid := id.IntegrationIDFromRef(integration); iid != nil {
+			res = operator.OperatorFromIntegration(*iid)
 		}
 	}
-
-	return thread.NewComment(cid, author, c.Content)
+	return res
 }
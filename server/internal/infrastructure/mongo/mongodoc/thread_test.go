@@ -2,16 +2,18 @@ package mongodoc
 
 import (
 	"testing"
+	"time"
 
 	"github.com/reearth/reearth-cms/server/pkg/operator"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
 	"github.com/reearth/reearthx/account/accountdomain/user"
+	"github.com/samber/lo"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestComment_Model(t *testing.T) {
-	cId := thread.NewCommentID()
+	cId, replyTo := thread.NewCommentID(), thread.NewCommentID()
 	op := operator.OperatorFromUser(user.NewID())
 	tests := []struct {
 		name string
@@ -28,6 +30,24 @@ func TestComment_Model(t *testing.T) {
 			},
 			want: thread.NewComment(cId, op, "abc"),
 		},
+		{
+			name: "reply with mentions and reactions",
+			cDoc: &CommentDocument{
+				ID:        cId.String(),
+				User:      op.User().StringRef(),
+				Content:   "@abc",
+				ReplyTo:   replyTo.StringRef(),
+				Mentions:  []*MentionDocument{{Name: "abc", User: op.User().StringRef()}},
+				Reactions: []*ReactionDocument{{Emoji: "+1", User: op.User().StringRef()}},
+			},
+			want: func() *thread.Comment {
+				c := thread.NewComment(cId, op, "@abc")
+				c.SetReplyTo(&replyTo)
+				c.SetMentions(thread.MentionList{thread.NewMention("abc", op)})
+				c.SetReactions(thread.ReactionList{lo.Must(thread.NewReaction("+1", op))})
+				return c
+			}(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -39,6 +59,7 @@ func TestComment_Model(t *testing.T) {
 
 func TestThreadDocument_Model(t *testing.T) {
 	tId, wId := thread.NewID(), user.NewWorkspaceID()
+	now, resolvedBy := time.Now(), operator.OperatorFromUser(user.NewID())
 	tests := []struct {
 		name    string
 		tDoc    *ThreadDocument
@@ -55,6 +76,17 @@ func TestThreadDocument_Model(t *testing.T) {
 			want:    thread.New().ID(tId).Workspace(wId).MustBuild(),
 			wantErr: false,
 		},
+		{
+			name: "resolved",
+			tDoc: &ThreadDocument{
+				ID:             tId.String(),
+				Workspace:      wId.String(),
+				ResolvedAt:     &now,
+				ResolvedByUser: resolvedBy.User().StringRef(),
+			},
+			want:    thread.New().ID(tId).Workspace(wId).Resolved(&resolvedBy, &now).MustBuild(),
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
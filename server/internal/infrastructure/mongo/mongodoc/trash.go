@@ -0,0 +1,128 @@
+package mongodoc
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/samber/lo"
+)
+
+type TrashDocument struct {
+	ID           string
+	MetadataItem *string
+	ProjectID    string
+	ModelID      string
+	User         *string
+	Integration  *string
+	References   []TrashReferenceDocument
+	TrashedAt    time.Time
+}
+
+type TrashReferenceDocument struct {
+	Item  string
+	Field string
+}
+
+func NewTrash(e *trash.Entry) (*TrashDocument, string) {
+	iID := e.Item().String()
+
+	var uid, iid *string
+	if e.User() != nil {
+		uid = e.User().StringRef()
+	}
+	if e.Integration() != nil {
+		iid = e.Integration().StringRef()
+	}
+
+	return &TrashDocument{
+		ID:           iID,
+		MetadataItem: e.MetadataItem().StringRef(),
+		ProjectID:    e.Project().String(),
+		ModelID:      e.Model().String(),
+		User:         uid,
+		Integration:  iid,
+		References: lo.Map(e.References(), func(r trash.Reference, _ int) TrashReferenceDocument {
+			return TrashReferenceDocument{
+				Item:  r.Item().String(),
+				Field: r.Field().String(),
+			}
+		}),
+		TrashedAt: e.TrashedAt(),
+	}, iID
+}
+
+func NewTrashes(l trash.List) ([]*TrashDocument, []string) {
+	res := make([]*TrashDocument, 0, len(l))
+	ids := make([]string, 0, len(l))
+	for _, e := range l {
+		if e == nil {
+			continue
+		}
+		doc, iID := NewTrash(e)
+		res = append(res, doc)
+		ids = append(ids, iID)
+	}
+	return res, ids
+}
+
+func (d *TrashDocument) Model() (*trash.Entry, error) {
+	iID, err := id.ItemIDFrom(d.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	pID, err := id.ProjectIDFrom(d.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	mID, err := id.ModelIDFrom(d.ModelID)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make([]trash.Reference, 0, len(d.References))
+	for _, r := range d.References {
+		riID, err := id.ItemIDFrom(r.Item)
+		if err != nil {
+			return nil, err
+		}
+		fID, err := id.FieldIDFrom(r.Field)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, trash.NewReference(riID, fID))
+	}
+
+	b := trash.New().
+		Item(iID).
+		MetadataItem(id.ItemIDFromRef(d.MetadataItem)).
+		Project(pID).
+		Model(mID).
+		References(refs).
+		TrashedAt(d.TrashedAt)
+
+	if d.User != nil {
+		uid := accountdomain.UserIDFromRef(d.User)
+		if uid != nil {
+			b = b.User(*uid)
+		}
+	}
+	if d.Integration != nil {
+		iid := id.IntegrationIDFromRef(d.Integration)
+		if iid != nil {
+			b = b.Integration(*iid)
+		}
+	}
+
+	return b.Build()
+}
+
+type TrashConsumer = mongox.SliceFuncConsumer[*TrashDocument, *trash.Entry]
+
+func NewTrashConsumer() *TrashConsumer {
+	return NewConsumer[*TrashDocument, *trash.Entry]()
+}
@@ -0,0 +1,69 @@
+package mongodoc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTrash(t *testing.T) {
+	uid := accountdomain.NewUserID()
+	at := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+	mdid := id.NewItemID()
+	ref := trash.NewReference(id.NewItemID(), id.NewFieldID())
+
+	e := trash.New().Item(id.NewItemID()).MetadataItem(&mdid).Project(id.NewProjectID()).Model(id.NewModelID()).
+		User(uid).References([]trash.Reference{ref}).TrashedAt(at).MustBuild()
+
+	doc, iID := NewTrash(e)
+	assert.Equal(t, e.Item().String(), iID)
+	assert.Equal(t, &TrashDocument{
+		ID:           e.Item().String(),
+		MetadataItem: mdid.StringRef(),
+		ProjectID:    e.Project().String(),
+		ModelID:      e.Model().String(),
+		User:         uid.StringRef(),
+		References: []TrashReferenceDocument{
+			{Item: ref.Item().String(), Field: ref.Field().String()},
+		},
+		TrashedAt: at,
+	}, doc)
+
+	docs, ids := NewTrashes(trash.List{e, nil})
+	assert.Equal(t, []*TrashDocument{doc}, docs)
+	assert.Equal(t, []string{iID}, ids)
+}
+
+func TestTrashDocument_Model(t *testing.T) {
+	iid := id.NewIntegrationID()
+	at := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+	ref := trash.NewReference(id.NewItemID(), id.NewFieldID())
+
+	e1 := trash.New().Item(id.NewItemID()).Project(id.NewProjectID()).Model(id.NewModelID()).
+		Integration(iid).References([]trash.Reference{ref}).TrashedAt(at).MustBuild()
+	doc, _ := NewTrash(e1)
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, e1, got)
+
+	e2 := trash.New().Item(id.NewItemID()).Project(id.NewProjectID()).Model(id.NewModelID()).
+		User(accountdomain.NewUserID()).TrashedAt(at).MustBuild()
+	doc, _ = NewTrash(e2)
+	got, err = doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, e2.Item(), got.Item())
+	assert.Equal(t, e2.User(), got.User())
+	assert.Empty(t, got.References())
+
+	_, err = (&TrashDocument{ID: "x"}).Model()
+	assert.Error(t, err)
+
+	doc, _ = NewTrash(e1)
+	doc.References[0].Field = "x"
+	_, err = doc.Model()
+	assert.Error(t, err)
+}
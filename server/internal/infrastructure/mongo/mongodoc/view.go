@@ -114,6 +114,7 @@ type FilterDocument struct {
 	StringCondition   *StringConditionDocument
 	NumberCondition   *NumberConditionDocument
 	TimeCondition     *TimeConditionDocument
+	SpatialCondition  *SpatialConditionDocument
 }
 
 func NewFilter(i *view.Condition) *FilterDocument {
@@ -198,6 +199,18 @@ func NewFilter(i *view.Condition) *FilterDocument {
 				Value: i.TimeCondition.Value,
 			},
 		}
+	case i.SpatialCondition != nil:
+		return &FilterDocument{
+			ConditionType: "SPATIAL",
+			SpatialCondition: &SpatialConditionDocument{
+				Field:   NewFieldSelector(i.SpatialCondition.Field),
+				Op:      string(i.SpatialCondition.Op),
+				BBox:    i.SpatialCondition.BBox,
+				Polygon: i.SpatialCondition.Polygon,
+				Point:   i.SpatialCondition.Point,
+				Radius:  i.SpatialCondition.Radius,
+			},
+		}
 	default:
 		return nil
 	}
@@ -276,6 +289,17 @@ func (d *FilterDocument) Model() *view.Condition {
 				Value: d.TimeCondition.Value,
 			},
 		}
+	case "SPATIAL":
+		return &view.Condition{
+			SpatialCondition: &view.SpatialCondition{
+				Field:   d.SpatialCondition.Field.Model(),
+				Op:      view.SpatialOperator(d.SpatialCondition.Op),
+				BBox:    d.SpatialCondition.BBox,
+				Polygon: d.SpatialCondition.Polygon,
+				Point:   d.SpatialCondition.Point,
+				Radius:  d.SpatialCondition.Radius,
+			},
+		}
 	default:
 		return nil
 	}
@@ -330,6 +354,15 @@ type TimeConditionDocument struct {
 	Value time.Time
 }
 
+type SpatialConditionDocument struct {
+	Field   FieldSelectorDocument
+	Op      string
+	BBox    []float64   `bson:",omitempty"`
+	Polygon [][]float64 `bson:",omitempty"`
+	Point   []float64   `bson:",omitempty"`
+	Radius  float64     `bson:",omitempty"`
+}
+
 func NewView(i *view.View) (*ViewDocument, string) {
 	if i == nil {
 		return nil, ""
@@ -77,3 +77,18 @@ func TestNewView(t *testing.T) {
 	assert.Equal(t, want, got)
 	assert.Equal(t, want.ID, gotId)
 }
+
+func TestNewFilter_Spatial(t *testing.T) {
+	c := &view.Condition{
+		SpatialCondition: &view.SpatialCondition{
+			Field:  view.FieldSelector{Type: view.FieldTypeField, ID: schema.NewFieldID().Ref()},
+			Op:     view.SpatialOperatorNear,
+			Point:  []float64{139.767, 35.681},
+			Radius: 500,
+		},
+	}
+
+	d := NewFilter(c)
+	assert.Equal(t, "SPATIAL", d.ConditionType)
+	assert.Equal(t, c, d.Model())
+}
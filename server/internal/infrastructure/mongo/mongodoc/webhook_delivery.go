@@ -0,0 +1,106 @@
+package mongodoc
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/samber/lo"
+)
+
+type WebhookDeliveryDocument struct {
+	ID            string
+	Integration   string
+	Webhook       string
+	Event         string
+	EventType     string
+	URL           string
+	Payload       string
+	Status        string
+	Attempts      []WebhookDeliveryAttemptDocument
+	NextAttemptAt *time.Time
+	UpdatedAt     time.Time
+}
+
+// WebhookDeliveryAttemptDocument is also written by the worker which sends webhooks.
+type WebhookDeliveryAttemptDocument struct {
+	AttemptedAt  time.Time
+	StatusCode   int
+	LatencyMs    int64
+	ResponseBody string
+	Error        string
+}
+
+type WebhookDeliveryConsumer = mongox.SliceFuncConsumer[*WebhookDeliveryDocument, *integration.WebhookDelivery]
+
+func NewWebhookDeliveryConsumer() *WebhookDeliveryConsumer {
+	return NewConsumer[*WebhookDeliveryDocument, *integration.WebhookDelivery]()
+}
+
+func NewWebhookDelivery(d *integration.WebhookDelivery) (*WebhookDeliveryDocument, string) {
+	did := d.ID().String()
+	return &WebhookDeliveryDocument{
+		ID:          did,
+		Integration: d.Integration().String(),
+		Webhook:     d.Webhook().String(),
+		Event:       d.Event().String(),
+		EventType:   string(d.EventType()),
+		URL:         d.URL(),
+		Payload:     string(d.Payload()),
+		Status:      d.Status().String(),
+		Attempts: lo.Map(d.Attempts(), func(a *integration.WebhookDeliveryAttempt, _ int) WebhookDeliveryAttemptDocument {
+			return WebhookDeliveryAttemptDocument{
+				AttemptedAt:  a.AttemptedAt,
+				StatusCode:   a.StatusCode,
+				LatencyMs:    a.Latency.Milliseconds(),
+				ResponseBody: a.ResponseBody,
+				Error:        a.Error,
+			}
+		}),
+		NextAttemptAt: d.NextAttemptAt(),
+		UpdatedAt:     d.UpdatedAt(),
+	}, did
+}
+
+func (d *WebhookDeliveryDocument) Model() (*integration.WebhookDelivery, error) {
+	did, err := id.WebhookDeliveryIDFrom(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	iid, err := id.IntegrationIDFrom(d.Integration)
+	if err != nil {
+		return nil, err
+	}
+	wid, err := id.WebhookIDFrom(d.Webhook)
+	if err != nil {
+		return nil, err
+	}
+	eid, err := id.EventIDFrom(d.Event)
+	if err != nil {
+		return nil, err
+	}
+
+	return integration.NewWebhookDeliveryBuilder().
+		ID(did).
+		Integration(iid).
+		Webhook(wid).
+		Event(eid).
+		EventType(event.Type(d.EventType)).
+		URL(d.URL).
+		Payload([]byte(d.Payload)).
+		Status(integration.WebhookDeliveryStatus(d.Status)).
+		Attempts(lo.Map(d.Attempts, func(a WebhookDeliveryAttemptDocument, _ int) *integration.WebhookDeliveryAttempt {
+			return &integration.WebhookDeliveryAttempt{
+				AttemptedAt:  a.AttemptedAt,
+				StatusCode:   a.StatusCode,
+				Latency:      time.Duration(a.LatencyMs) * time.Millisecond,
+				ResponseBody: a.ResponseBody,
+				Error:        a.Error,
+			}
+		})).
+		NextAttemptAt(d.NextAttemptAt).
+		UpdatedAt(d.UpdatedAt).
+		Build()
+}
@@ -0,0 +1,42 @@
+package mongodoc
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWebhookDelivery(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond)
+	w := integration.NewWebhookBuilder().NewID().Url(lo.Must(url.Parse("https://example.com"))).MustBuild()
+	d := integration.NewWebhookDelivery(id.NewIntegrationID(), w, id.NewEventID(), event.ItemCreate, []byte(`{"a":1}`))
+	d.RecordAttempt(integration.WebhookDeliveryAttempt{AttemptedAt: now, StatusCode: 500, Latency: 120 * time.Millisecond, ResponseBody: "error"})
+
+	doc, did := NewWebhookDelivery(d)
+	assert.Equal(t, d.ID().String(), did)
+	assert.Equal(t, &WebhookDeliveryDocument{
+		ID:          d.ID().String(),
+		Integration: d.Integration().String(),
+		Webhook:     w.ID().String(),
+		Event:       d.Event().String(),
+		EventType:   "item.create",
+		URL:         "https://example.com",
+		Payload:     `{"a":1}`,
+		Status:      "retrying",
+		Attempts: []WebhookDeliveryAttemptDocument{
+			{AttemptedAt: now, StatusCode: 500, LatencyMs: 120, ResponseBody: "error"},
+		},
+		NextAttemptAt: new(now.Add(time.Minute)),
+		UpdatedAt:     now,
+	}, doc)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, d, got)
+}
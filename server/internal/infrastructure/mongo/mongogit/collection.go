@@ -246,6 +246,31 @@ func (c *Collection) BulkUpdateRef(ctx context.Context, ids []string, ref versio
 	return nil
 }
 
+// MoveRef renames the ref from to the ref to on the objects matching the filter. Unlike UpdateRef, special refs such as latest can be moved.
+func (c *Collection) MoveRef(ctx context.Context, filter bson.M, from, to version.Ref) error {
+	if from == to {
+		return nil
+	}
+
+	if _, err := c.client.Client().UpdateMany(ctx, mongox.And(filter, "", bson.M{
+		refsKey: bson.M{"$in": []string{to.String()}},
+	}), bson.M{
+		"$pull": bson.M{refsKey: to},
+	}); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+
+	if _, err := c.client.Client().UpdateMany(ctx, mongox.And(filter, "", bson.M{
+		refsKey: bson.M{"$in": []string{from.String()}},
+	}), bson.M{
+		"$set": bson.M{refsKey + ".$": to},
+	}); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+
+	return nil
+}
+
 func (c *Collection) IsArchived(ctx context.Context, filter any) (bool, error) {
 	cons := mongox.SliceConsumer[MetadataDocument]{}
 	q := mongox.And(filter, "", bson.M{
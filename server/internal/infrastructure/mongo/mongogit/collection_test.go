@@ -737,6 +737,41 @@ func TestCollection_BulkUpdateRef(t *testing.T) {
 	}
 }
 
+func TestCollection_MoveRef(t *testing.T) {
+	ctx := context.Background()
+	col := initCollection(t)
+	c := col.Client().Client()
+
+	v1, v2, v3 := version.New(), version.New(), version.New()
+	_, _ = c.InsertMany(ctx, []any{
+		bson.M{"id": "x", versionKey: v1, refsKey: []string{"public"}},
+		bson.M{"id": "x", versionKey: v2, refsKey: []string{"latest"}},
+		bson.M{"id": "y", versionKey: v3, refsKey: []string{"latest", "public"}},
+	})
+
+	var meta Meta
+
+	// move latest ref to public ref
+	assert.NoError(t, col.MoveRef(ctx, bson.M{"id": "x"}, version.Latest, version.Public))
+	got := c.FindOne(ctx, bson.M{"id": "x", versionKey: v1})
+	assert.NoError(t, got.Decode(&meta))
+	assert.Equal(t, Meta{ObjectID: meta.ObjectID, Version: v1, Refs: []version.Ref{}}, meta)
+	got = c.FindOne(ctx, bson.M{"id": "x", versionKey: v2})
+	assert.NoError(t, got.Decode(&meta))
+	assert.Equal(t, Meta{ObjectID: meta.ObjectID, Version: v2, Refs: []version.Ref{"public"}}, meta)
+
+	// other objects are not affected
+	got = c.FindOne(ctx, bson.M{"id": "y", versionKey: v3})
+	assert.NoError(t, got.Decode(&meta))
+	assert.Equal(t, Meta{ObjectID: meta.ObjectID, Version: v3, Refs: []version.Ref{"latest", "public"}}, meta)
+
+	// non-existent ref
+	assert.NoError(t, col.MoveRef(ctx, bson.M{"id": "y"}, version.Trash, version.Latest))
+	got = c.FindOne(ctx, bson.M{"id": "y", versionKey: v3})
+	assert.NoError(t, got.Decode(&meta))
+	assert.Equal(t, Meta{ObjectID: meta.ObjectID, Version: v3, Refs: []version.Ref{"latest", "public"}}, meta)
+}
+
 func TestCollection_IsArchived(t *testing.T) {
 	ctx := context.Background()
 	col := initCollection(t)
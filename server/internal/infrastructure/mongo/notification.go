@@ -0,0 +1,114 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	notificationIndexes       = []string{"user,read", "emailpending"}
+	notificationUniqueIndexes = []string{"id"}
+
+	notificationPreferenceUniqueIndexes = []string{"id"}
+)
+
+type Notification struct {
+	client *mongox.Collection
+}
+
+func NewNotification(client *mongox.Client) repo.Notification {
+	return &Notification{client: client.WithCollection("notification")}
+}
+
+func (r *Notification) Init() error {
+	return createIndexes(context.Background(), r.client, notificationIndexes, notificationUniqueIndexes)
+}
+
+func (r *Notification) FindByID(ctx context.Context, nid id.NotificationID) (*notification.Notification, error) {
+	c := mongodoc.NewNotificationConsumer()
+	if err := r.client.FindOne(ctx, bson.M{"id": nid.String()}, c); err != nil {
+		return nil, err
+	}
+	return c.Result[0], nil
+}
+
+func (r *Notification) FindByUser(ctx context.Context, uid accountdomain.UserID, unreadOnly bool, pagination *usecasex.Pagination) (notification.List, *usecasex.PageInfo, error) {
+	filter := bson.M{"user": uid.String()}
+	if unreadOnly {
+		filter["read"] = false
+	}
+
+	c := mongodoc.NewNotificationConsumer()
+	// the newest notifications come first
+	pageInfo, err := r.client.Paginate(ctx, filter, &usecasex.Sort{Key: "id", Reverted: true}, pagination, c)
+	if err != nil {
+		return nil, nil, rerror.ErrInternalBy(err)
+	}
+	return c.Result, pageInfo, nil
+}
+
+func (r *Notification) FindUnreadByUser(ctx context.Context, uid accountdomain.UserID) (notification.List, error) {
+	return r.find(ctx, bson.M{"user": uid.String(), "read": false})
+}
+
+func (r *Notification) CountUnreadByUser(ctx context.Context, uid accountdomain.UserID) (int64, error) {
+	return r.client.Count(ctx, bson.M{"user": uid.String(), "read": false})
+}
+
+func (r *Notification) FindEmailPending(ctx context.Context) (notification.List, error) {
+	return r.find(ctx, bson.M{"emailpending": true})
+}
+
+func (r *Notification) SaveAll(ctx context.Context, l notification.List) error {
+	if len(l) == 0 {
+		return nil
+	}
+	docs, ids := mongodoc.NewNotifications(l)
+	return r.client.SaveAll(ctx, ids, lo.ToAnySlice(docs))
+}
+
+func (r *Notification) find(ctx context.Context, filter any) (notification.List, error) {
+	c := mongodoc.NewNotificationConsumer()
+	if err := r.client.Find(ctx, filter, c); err != nil {
+		return nil, rerror.ErrInternalBy(err)
+	}
+	return c.Result, nil
+}
+
+type NotificationPreference struct {
+	client *mongox.Collection
+}
+
+func NewNotificationPreference(client *mongox.Client) repo.NotificationPreference {
+	return &NotificationPreference{client: client.WithCollection("notification_preference")}
+}
+
+func (r *NotificationPreference) Init() error {
+	return createIndexes(context.Background(), r.client, nil, notificationPreferenceUniqueIndexes)
+}
+
+func (r *NotificationPreference) FindByUsers(ctx context.Context, ids accountdomain.UserIDList) (notification.PreferenceList, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	c := mongodoc.NewNotificationPreferenceConsumer()
+	if err := r.client.Find(ctx, bson.M{"id": bson.M{"$in": ids.Strings()}}, c); err != nil {
+		return nil, rerror.ErrInternalBy(err)
+	}
+	return c.Result, nil
+}
+
+func (r *NotificationPreference) Save(ctx context.Context, p *notification.Preference) error {
+	doc, uid := mongodoc.NewNotificationPreference(p)
+	return r.client.SaveOne(ctx, uid, doc)
+}
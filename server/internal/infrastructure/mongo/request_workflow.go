@@ -0,0 +1,58 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearthx/mongox"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	requestWorkflowIndexes       = []string{"project"}
+	requestWorkflowUniqueIndexes = []string{"id"}
+)
+
+type RequestWorkflow struct {
+	client *mongox.Collection
+}
+
+func NewRequestWorkflow(client *mongox.Client) repo.RequestWorkflow {
+	return &RequestWorkflow{client: client.WithCollection("request_workflow")}
+}
+
+func (r *RequestWorkflow) Init() error {
+	return createIndexes(context.Background(), r.client, requestWorkflowIndexes, requestWorkflowUniqueIndexes)
+}
+
+func (r *RequestWorkflow) FindByID(ctx context.Context, workflowID id.WorkflowID) (*request.Workflow, error) {
+	c := mongodoc.NewRequestWorkflowConsumer()
+	if err := r.client.FindOne(ctx, bson.M{
+		"id": workflowID.String(),
+	}, c); err != nil {
+		return nil, err
+	}
+	return c.Result[0], nil
+}
+
+func (r *RequestWorkflow) FindByProject(ctx context.Context, projectID id.ProjectID) (request.WorkflowList, error) {
+	c := mongodoc.NewRequestWorkflowConsumer()
+	if err := r.client.Find(ctx, bson.M{
+		"project": projectID.String(),
+	}, c); err != nil {
+		return nil, err
+	}
+	return c.Result, nil
+}
+
+func (r *RequestWorkflow) Save(ctx context.Context, w *request.Workflow) error {
+	doc, wid := mongodoc.NewRequestWorkflow(w)
+	return r.client.SaveOne(ctx, wid, doc)
+}
+
+func (r *RequestWorkflow) Remove(ctx context.Context, workflowID id.WorkflowID) error {
+	return r.client.RemoveOne(ctx, bson.M{"id": workflowID.String()})
+}
@@ -0,0 +1,77 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/reearth/reearthx/mongox"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	scheduleIndexes       = []string{"projectid", "status,scheduledat"}
+	scheduleUniqueIndexes = []string{"id"}
+)
+
+type Schedule struct {
+	client *mongox.Collection
+}
+
+func NewSchedule(client *mongox.Client) repo.Schedule {
+	return &Schedule{client: client.WithCollection("schedule")}
+}
+
+func (r *Schedule) Init() error {
+	return createIndexes(context.Background(), r.client, scheduleIndexes, scheduleUniqueIndexes)
+}
+
+func (r *Schedule) FindByID(ctx context.Context, scheduleID id.ScheduleID) (*schedule.Schedule, error) {
+	return r.findOne(ctx, bson.M{
+		"id": scheduleID.String(),
+	})
+}
+
+func (r *Schedule) FindByProject(ctx context.Context, projectID id.ProjectID, status *schedule.Status) (schedule.List, error) {
+	filter := bson.M{
+		"projectid": projectID.String(),
+	}
+	if status != nil {
+		filter["status"] = status.String()
+	}
+	return r.find(ctx, filter)
+}
+
+func (r *Schedule) FindDue(ctx context.Context, now time.Time) (schedule.List, error) {
+	return r.find(ctx, bson.M{
+		"status":      schedule.StatusPending.String(),
+		"scheduledat": bson.M{"$lte": now},
+	})
+}
+
+func (r *Schedule) Save(ctx context.Context, s *schedule.Schedule) error {
+	doc, sID, err := mongodoc.NewSchedule(s)
+	if err != nil {
+		return err
+	}
+	return r.client.SaveOne(ctx, sID, doc)
+}
+
+func (r *Schedule) findOne(ctx context.Context, filter any) (*schedule.Schedule, error) {
+	c := mongodoc.NewScheduleConsumer()
+	if err := r.client.FindOne(ctx, filter, c); err != nil {
+		return nil, err
+	}
+	return c.Result[0], nil
+}
+
+func (r *Schedule) find(ctx context.Context, filter any) (schedule.List, error) {
+	c := mongodoc.NewScheduleConsumer()
+	if err := r.client.Find(ctx, filter, c); err != nil {
+		return nil, err
+	}
+	return c.Result, nil
+}
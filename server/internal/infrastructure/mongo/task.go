@@ -0,0 +1,76 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/rerror"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	taskIndexes       = []string{"status,runat", "status,lockeduntil"}
+	taskUniqueIndexes = []string{"id"}
+)
+
+type Task struct {
+	client *mongox.Collection
+}
+
+func NewTask(client *mongox.Client) repo.Task {
+	return &Task{client: client.WithCollection("task")}
+}
+
+func (r *Task) Init() error {
+	return createIndexes(context.Background(), r.client, taskIndexes, taskUniqueIndexes)
+}
+
+func (r *Task) FindByID(ctx context.Context, taskID id.TaskID) (*task.Task, error) {
+	c := mongodoc.NewTaskConsumer()
+	if err := r.client.FindOne(ctx, bson.M{"id": taskID.String()}, c); err != nil {
+		return nil, err
+	}
+	return c.Result[0], nil
+}
+
+func (r *Task) Acquire(ctx context.Context, now time.Time, lease time.Duration) (*task.Task, error) {
+	// the task is claimed atomically so that each task is run by only one of the runners
+	res := r.client.Client().FindOneAndUpdate(ctx, bson.M{
+		"$or": []bson.M{
+			{"status": task.StatusPending.String(), "runat": bson.M{"$lte": now}},
+			{"status": task.StatusRunning.String(), "lockeduntil": bson.M{"$lt": now}},
+		},
+	}, bson.M{
+		"$set": bson.M{
+			"status":      task.StatusRunning.String(),
+			"lockeduntil": now.Add(lease),
+			"updatedat":   now,
+		},
+		"$inc": bson.M{"attempts": 1},
+	}, options.FindOneAndUpdate().SetSort(bson.D{{Key: "runat", Value: 1}}).SetReturnDocument(options.After))
+
+	var doc mongodoc.TaskDocument
+	if err := res.Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, rerror.ErrNotFound
+		}
+		return nil, rerror.ErrInternalBy(err)
+	}
+	return doc.Model()
+}
+
+func (r *Task) Save(ctx context.Context, t *task.Task) error {
+	doc, tID, err := mongodoc.NewTask(t)
+	if err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	return r.client.SaveOne(ctx, tID, doc)
+}
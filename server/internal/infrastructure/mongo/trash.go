@@ -0,0 +1,90 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	trashIndexes       = []string{"modelid,!trashedat", "trashedat"}
+	trashUniqueIndexes = []string{"id"}
+)
+
+type Trash struct {
+	client *mongox.Collection
+}
+
+func NewTrash(client *mongox.Client) repo.Trash {
+	return &Trash{client: client.WithCollection("item_trash")}
+}
+
+func (r *Trash) Init() error {
+	return createIndexes(context.Background(), r.client, trashIndexes, trashUniqueIndexes)
+}
+
+func (r *Trash) FindByItems(ctx context.Context, ids id.ItemIDList) (trash.List, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return r.find(ctx, bson.M{
+		"id": bson.M{"$in": ids.Strings()},
+	})
+}
+
+func (r *Trash) FindByModel(ctx context.Context, modelID id.ModelID, pagination *usecasex.Pagination) (trash.List, *usecasex.PageInfo, error) {
+	c := mongodoc.NewTrashConsumer()
+	pageInfo, err := r.client.Paginate(ctx, bson.M{
+		"modelid": modelID.String(),
+	}, &usecasex.Sort{Key: "trashedat", Reverted: true}, pagination, c)
+	if err != nil {
+		return nil, nil, rerror.ErrInternalBy(err)
+	}
+	return c.Result, pageInfo, nil
+}
+
+func (r *Trash) FindTrashedBefore(ctx context.Context, t time.Time) (trash.List, error) {
+	return r.find(ctx, bson.M{
+		"trashedat": bson.M{"$lte": t},
+	})
+}
+
+func (r *Trash) SaveAll(ctx context.Context, l trash.List) error {
+	if len(l) == 0 {
+		return nil
+	}
+	docs, ids := mongodoc.NewTrashes(l)
+	return r.client.SaveAll(ctx, ids, lo.ToAnySlice(docs))
+}
+
+func (r *Trash) RemoveAll(ctx context.Context, ids id.ItemIDList) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.client.RemoveAll(ctx, bson.M{
+		"id": bson.M{"$in": ids.Strings()},
+	})
+}
+
+func (r *Trash) RemoveByModel(ctx context.Context, modelID id.ModelID) error {
+	return r.client.RemoveAll(ctx, bson.M{
+		"modelid": modelID.String(),
+	})
+}
+
+func (r *Trash) find(ctx context.Context, filter any) (trash.List, error) {
+	c := mongodoc.NewTrashConsumer()
+	if err := r.client.Find(ctx, filter, c); err != nil {
+		return nil, err
+	}
+	return c.Result, nil
+}
@@ -0,0 +1,83 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	webhookDeliveryIndexes       = []string{"webhook", "status,nextattemptat", "status,updatedat"}
+	webhookDeliveryUniqueIndexes = []string{"id"}
+)
+
+type WebhookDelivery struct {
+	client *mongox.Collection
+}
+
+func NewWebhookDelivery(client *mongox.Client) repo.WebhookDelivery {
+	return &WebhookDelivery{client: client.WithCollection("webhook_delivery")}
+}
+
+func (r *WebhookDelivery) Init() error {
+	return createIndexes(context.Background(), r.client, webhookDeliveryIndexes, webhookDeliveryUniqueIndexes)
+}
+
+func (r *WebhookDelivery) FindByID(ctx context.Context, did id.WebhookDeliveryID) (*integration.WebhookDelivery, error) {
+	c := mongodoc.NewWebhookDeliveryConsumer()
+	if err := r.client.FindOne(ctx, bson.M{"id": did.String()}, c); err != nil {
+		return nil, err
+	}
+	return c.Result[0], nil
+}
+
+func (r *WebhookDelivery) FindByWebhook(ctx context.Context, wid id.WebhookID, pagination *usecasex.Pagination) (integration.WebhookDeliveryList, *usecasex.PageInfo, error) {
+	c := mongodoc.NewWebhookDeliveryConsumer()
+	// the newest deliveries come first
+	pageInfo, err := r.client.Paginate(ctx, bson.M{"webhook": wid.String()}, &usecasex.Sort{Key: "id", Reverted: true}, pagination, c)
+	if err != nil {
+		return nil, nil, rerror.ErrInternalBy(err)
+	}
+	return c.Result, pageInfo, nil
+}
+
+func (r *WebhookDelivery) FindLatestByWebhook(ctx context.Context, wid id.WebhookID, limit int) (integration.WebhookDeliveryList, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "id", Value: -1}}).SetLimit(int64(limit))
+	return r.find(ctx, bson.M{"webhook": wid.String()}, opts)
+}
+
+func (r *WebhookDelivery) FindDue(ctx context.Context, now time.Time) (integration.WebhookDeliveryList, error) {
+	return r.find(ctx, bson.M{
+		"status":        integration.WebhookDeliveryStatusRetrying.String(),
+		"nextattemptat": bson.M{"$lte": now},
+	})
+}
+
+func (r *WebhookDelivery) FindDeadSince(ctx context.Context, since time.Time) (integration.WebhookDeliveryList, error) {
+	return r.find(ctx, bson.M{
+		"status":    integration.WebhookDeliveryStatusDead.String(),
+		"updatedat": bson.M{"$gte": since},
+	})
+}
+
+func (r *WebhookDelivery) Save(ctx context.Context, d *integration.WebhookDelivery) error {
+	doc, did := mongodoc.NewWebhookDelivery(d)
+	return r.client.SaveOne(ctx, did, doc)
+}
+
+func (r *WebhookDelivery) find(ctx context.Context, filter any, opts ...*options.FindOptions) (integration.WebhookDeliveryList, error) {
+	c := mongodoc.NewWebhookDeliveryConsumer()
+	if err := r.client.Find(ctx, filter, c, opts...); err != nil {
+		return nil, rerror.ErrInternalBy(err)
+	}
+	return c.Result, nil
+}
@@ -0,0 +1,194 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+const (
+	maxPresignExpiry = 7 * 24 * time.Hour
+	// maxDeleteObjects is the max number of the objects which can be deleted by a request.
+	maxDeleteObjects = 1000
+)
+
+// client wraps the S3 client of the AWS SDK with the bucket.
+type client struct {
+	api     *awss3.Client
+	presign *awss3.PresignClient
+	bucket  string
+}
+
+func newClient(ctx context.Context, conf Config) (*client, error) {
+	if _, err := endpointURL(conf); err != nil {
+		return nil, err
+	}
+
+	opts := []func(*config.LoadOptions) error{config.WithRegion(conf.Region)}
+	if conf.AccessKeyID != "" {
+		opts = append(opts, config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(conf.AccessKeyID, conf.SecretAccessKey, "")))
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	api := awss3.NewFromConfig(cfg, func(o *awss3.Options) {
+		if conf.Endpoint != "" {
+			o.BaseEndpoint = aws.String(conf.Endpoint)
+		}
+		o.UsePathStyle = conf.UsePathStyle
+		// S3 compatible services do not always support the checksums which the SDK sends by default
+		o.RequestChecksumCalculation = aws.RequestChecksumCalculationWhenRequired
+		o.ResponseChecksumValidation = aws.ResponseChecksumValidationWhenRequired
+	})
+
+	return &client{
+		api:     api,
+		presign: awss3.NewPresignClient(api),
+		bucket:  conf.BucketName,
+	}, nil
+}
+
+func isNotFound(err error) bool {
+	e, ok := errors.AsType[*awshttp.ResponseError](err)
+	return ok && e.HTTPStatusCode() == http.StatusNotFound
+}
+
+func presignExpires(expiresAt time.Time) func(*awss3.PresignOptions) {
+	return awss3.WithPresignExpires(min(max(time.Until(expiresAt), time.Second), maxPresignExpiry))
+}
+
+func (c *client) listObjects(ctx context.Context, prefix string) ([]types.Object, error) {
+	var objects []types.Object
+	p := awss3.NewListObjectsV2Paginator(c.api, &awss3.ListObjectsV2Input{
+		Bucket: aws.String(c.bucket),
+		Prefix: aws.String(prefix),
+	})
+	for p.HasMorePages() {
+		res, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, res.Contents...)
+	}
+	return objects, nil
+}
+
+func (c *client) deleteObjects(ctx context.Context, keys []string) error {
+	var errs []error
+	for len(keys) > 0 {
+		n := min(len(keys), maxDeleteObjects)
+		objects := make([]types.ObjectIdentifier, 0, n)
+		for _, k := range keys[:n] {
+			objects = append(objects, types.ObjectIdentifier{Key: aws.String(k)})
+		}
+		keys = keys[n:]
+
+		res, err := c.api.DeleteObjects(ctx, &awss3.DeleteObjectsInput{
+			Bucket: aws.String(c.bucket),
+			Delete: &types.Delete{Objects: objects, Quiet: aws.Bool(true)},
+		})
+		if err != nil {
+			return err
+		}
+		for _, e := range res.Errors {
+			errs = append(errs, fmt.Errorf("s3: failed to delete %s: %s %s", aws.ToString(e.Key), aws.ToString(e.Code), aws.ToString(e.Message)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// listMultipartUploads returns the IDs of the multipart uploads in progress of the object, oldest first.
+func (c *client) listMultipartUploads(ctx context.Context, key string) ([]string, error) {
+	res, err := c.api.ListMultipartUploads(ctx, &awss3.ListMultipartUploadsInput{
+		Bucket: aws.String(c.bucket),
+		Prefix: aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, u := range res.Uploads {
+		if aws.ToString(u.Key) == key {
+			ids = append(ids, aws.ToString(u.UploadId))
+		}
+	}
+	return ids, nil
+}
+
+func (c *client) listParts(ctx context.Context, key, uploadID string) ([]types.CompletedPart, error) {
+	var parts []types.CompletedPart
+	p := awss3.NewListPartsPaginator(c.api, &awss3.ListPartsInput{
+		Bucket:   aws.String(c.bucket),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	})
+	for p.HasMorePages() {
+		res, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, pt := range res.Parts {
+			parts = append(parts, types.CompletedPart{PartNumber: pt.PartNumber, ETag: pt.ETag})
+		}
+	}
+	return parts, nil
+}
+
+func (c *client) completeMultipartUpload(ctx context.Context, key, uploadID string, parts []types.CompletedPart) error {
+	_, err := c.api.CompleteMultipartUpload(ctx, &awss3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(c.bucket),
+		Key:             aws.String(key),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: parts},
+	})
+	return err
+}
+
+func (c *client) abortMultipartUpload(ctx context.Context, key, uploadID string) error {
+	_, err := c.api.AbortMultipartUpload(ctx, &awss3.AbortMultipartUploadInput{
+		Bucket:   aws.String(c.bucket),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	})
+	return err
+}
+
+func endpointURL(conf Config) (*url.URL, error) {
+	if conf.Endpoint == "" {
+		return url.Parse(fmt.Sprintf("https://s3.%s.amazonaws.com", conf.Region))
+	}
+	u, err := url.Parse(conf.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid endpoint: %s", conf.Endpoint)
+	}
+	return u, nil
+}
+
+// bucketURL returns the URL of the bucket on the endpoint.
+func bucketURL(endpoint *url.URL, bucket string, pathStyle bool) *url.URL {
+	u := *endpoint
+	if pathStyle {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/" + bucket
+	} else {
+		u.Host = bucket + "." + u.Host
+		u.Path = strings.TrimSuffix(u.Path, "/")
+	}
+	u.RawPath = ""
+	return &u
+}
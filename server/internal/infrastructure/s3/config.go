@@ -0,0 +1,14 @@
+package s3
+
+type Config struct {
+	BucketName string `pp:",omitempty"`
+	Region     string `default:"us-east-1" pp:",omitempty"`
+	// Endpoint is the URL of the S3-compatible service such as MinIO. AWS is used when it is empty.
+	Endpoint string `pp:",omitempty"`
+	// AccessKeyID and SecretAccessKey are static credentials. The default credential chain of AWS is used when they are empty.
+	AccessKeyID     string `pp:",omitempty"`
+	SecretAccessKey string `pp:",omitempty"`
+	// UsePathStyle addresses the bucket by the path of the URL instead of the subdomain, which most S3-compatible services require.
+	UsePathStyle bool   `pp:",omitempty"`
+	CacheControl string `pp:",omitempty"`
+}
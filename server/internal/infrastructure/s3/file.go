@@ -0,0 +1,772 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/uuid"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/file"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/rerror"
+)
+
+const (
+	s3AssetBasePath string = "assets"
+	fileSizeLimit   int64  = 10 * 1024 * 1024 * 1024 // 10GB
+	healthCheckDir  string = ".temp-health-check"
+	// uploadPartSize is the size of the parts of the files which the server uploads. Files larger than it are uploaded in multiple parts.
+	uploadPartSize int64 = 16 * 1024 * 1024 // 16MB
+	// multipartUploadPartSize is the size of the parts of the files which the clients upload with the issued links.
+	multipartUploadPartSize int64 = 100 * 1024 * 1024 // 100MB
+)
+
+type fileRepo struct {
+	conf        Config
+	publicBase  *url.URL
+	privateBase *url.URL
+	public      bool
+	client      *client
+	clientMu    sync.Mutex
+}
+
+func NewFile(conf Config, publicBase string) (gateway.File, error) {
+	if conf.BucketName == "" {
+		return nil, rerror.NewE(i18n.T("bucket name is empty"))
+	}
+
+	endpoint, err := endpointURL(conf)
+	if err != nil {
+		return nil, rerror.NewE(i18n.T("invalid base URL"))
+	}
+
+	if publicBase == "" {
+		publicBase = bucketURL(endpoint, conf.BucketName, conf.UsePathStyle).String()
+	}
+	u, err := url.Parse(publicBase)
+	if err != nil {
+		return nil, rerror.NewE(i18n.T("invalid base URL"))
+	}
+
+	return &fileRepo{
+		conf:       conf,
+		publicBase: u,
+		public:     true,
+	}, nil
+}
+
+func NewFileWithACL(conf Config, publicBase, privateBase string) (gateway.File, error) {
+	f, err := NewFile(conf, publicBase)
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(privateBase)
+	if err != nil {
+		return nil, rerror.NewE(i18n.T("invalid base URL"))
+	}
+	fr := f.(*fileRepo)
+	fr.privateBase = u
+	fr.public = false
+	return fr, nil
+}
+
+func (f *fileRepo) ReadAsset(ctx context.Context, u string, fn string, h map[string]string) (io.ReadCloser, map[string]string, error) {
+	p := getS3ObjectPath(u, fn)
+	if p == "" {
+		return nil, nil, rerror.ErrNotFound
+	}
+
+	return f.Read(ctx, p, h)
+}
+
+func (f *fileRepo) GetAssetFiles(ctx context.Context, u string) ([]gateway.FileEntry, error) {
+	p := getS3ObjectPathFolder(u)
+	if p == "" {
+		return nil, rerror.ErrNotFound
+	}
+
+	c, err := f.s3(ctx)
+	if err != nil {
+		return nil, rerror.ErrInternalBy(err)
+	}
+	objects, err := c.listObjects(ctx, p+"/")
+	if err != nil {
+		return nil, rerror.ErrInternalBy(err)
+	}
+
+	fileEntries := make([]gateway.FileEntry, 0, len(objects))
+	for _, o := range objects {
+		fileEntries = append(fileEntries, gateway.FileEntry{
+			// assets/22/2232222233333/hoge/tileset.json -> hoge/tileset.json
+			Name: strings.TrimPrefix(aws.ToString(o.Key), p+"/"),
+			Size: aws.ToInt64(o.Size),
+		})
+	}
+
+	if len(fileEntries) == 0 {
+		return nil, gateway.ErrFileNotFound
+	}
+
+	return fileEntries, nil
+}
+
+func (f *fileRepo) UploadAsset(ctx context.Context, file *file.File) (string, int64, error) {
+	if file == nil {
+		return "", 0, gateway.ErrInvalidFile
+	}
+	if file.Size >= fileSizeLimit {
+		return "", 0, gateway.ErrFileTooLarge
+	}
+
+	fileUUID := newUUID()
+
+	p := getS3ObjectPath(fileUUID, file.Name)
+	if p == "" {
+		return "", 0, gateway.ErrInvalidFile
+	}
+
+	size, err := f.Upload(ctx, file, p)
+	if err != nil {
+		return "", 0, err
+	}
+	return fileUUID, size, nil
+}
+
+func (f *fileRepo) DeleteAsset(ctx context.Context, u string, fn string) error {
+	p := getS3ObjectPath(u, fn)
+	if p == "" {
+		return gateway.ErrInvalidFile
+	}
+
+	return f.Delete(ctx, p)
+}
+
+func (f *fileRepo) DeleteAssets(ctx context.Context, UUIDs []string) error {
+	if len(UUIDs) == 0 {
+		return gateway.ErrInvalidInput
+	}
+
+	c, err := f.s3(ctx)
+	if err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+
+	var errs []error
+	for _, id := range UUIDs {
+		p := getS3ObjectPathFolder(id)
+		if p == "" {
+			return gateway.ErrInvalidFile
+		}
+		if err := f.deleteByPrefix(ctx, c, p+"/", nil); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete folder %s: %w", p, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		log.Errorf("s3: batch delete completed with %d errors.", len(errs))
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
+func (f *fileRepo) PublishAsset(ctx context.Context, u string, fn string) error {
+	if f.public {
+		return gateway.ErrUnsupportedOperation
+	}
+	p := getS3ObjectPath(u, fn)
+	if p == "" {
+		return gateway.ErrInvalidFile
+	}
+
+	return f.publish(ctx, p, true)
+}
+
+func (f *fileRepo) UnpublishAsset(ctx context.Context, u string, fn string) error {
+	if f.public {
+		return gateway.ErrUnsupportedOperation
+	}
+	p := getS3ObjectPath(u, fn)
+	if p == "" {
+		return gateway.ErrInvalidFile
+	}
+
+	return f.publish(ctx, p, false)
+}
+
+func (f *fileRepo) GetAccessInfoResolver() asset.AccessInfoResolver {
+	return func(a *asset.Asset) *asset.AccessInfo {
+		base := f.privateBase
+		publiclyAccessible := f.public || a.Public()
+		if publiclyAccessible {
+			base = f.publicBase
+		}
+		return &asset.AccessInfo{
+			Url:    getURL(base, a.UUID(), url.PathEscape(a.FileName())),
+			Public: publiclyAccessible,
+		}
+	}
+}
+
+func (f *fileRepo) GetAccessInfo(a *asset.Asset) *asset.AccessInfo {
+	if a == nil {
+		return nil
+	}
+	return f.GetAccessInfoResolver()(a)
+}
+
+func (f *fileRepo) GetBaseURL() string {
+	return f.publicBase.String()
+}
+
+// IssueUploadAssetLink issues a presigned URL to upload the file. Files larger than multipartUploadPartSize are uploaded in multiple parts:
+// the link of each part has the cursor to issue the link of the next part, and the parts are assembled by UploadedAsset.
+func (f *fileRepo) IssueUploadAssetLink(ctx context.Context, param gateway.IssueUploadAssetParam) (*gateway.UploadAssetLink, error) {
+	contentType := param.GetOrGuessContentType()
+	if err := validateContentEncoding(param.ContentEncoding); err != nil {
+		return nil, err
+	}
+
+	p := getS3ObjectPath(param.UUID, param.Filename)
+	if p == "" {
+		return nil, gateway.ErrInvalidFile
+	}
+
+	c, err := f.s3(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if param.Cursor == "" && param.ContentLength <= multipartUploadPartSize {
+		req, err := c.presign.PresignPutObject(ctx, &awss3.PutObjectInput{
+			Bucket:          aws.String(c.bucket),
+			Key:             aws.String(p),
+			ContentType:     nonEmpty(contentType),
+			ContentEncoding: nonEmpty(param.ContentEncoding),
+		}, presignExpires(param.ExpiresAt))
+		if err != nil {
+			log.Errorf("s3: failed to issue signed url: %v", err)
+			return nil, gateway.ErrUnsupportedOperation
+		}
+		return &gateway.UploadAssetLink{
+			URL:             req.URL,
+			ContentType:     contentType,
+			ContentLength:   param.ContentLength,
+			ContentEncoding: param.ContentEncoding,
+			Next:            "",
+		}, nil
+	}
+
+	var uploadID string
+	partNumber := 1
+	if param.Cursor == "" {
+		res, err := c.api.CreateMultipartUpload(ctx, &awss3.CreateMultipartUploadInput{
+			Bucket:          aws.String(c.bucket),
+			Key:             aws.String(p),
+			ContentType:     nonEmpty(contentType),
+			ContentEncoding: nonEmpty(param.ContentEncoding),
+			CacheControl:    nonEmpty(f.conf.CacheControl),
+		})
+		if err != nil {
+			log.Errorf("s3: failed to create multipart upload: %v", err)
+			return nil, gateway.ErrUnsupportedOperation
+		}
+		uploadID = aws.ToString(res.UploadId)
+	} else if uploadID, partNumber, err = parseMultipartCursor(param.Cursor); err != nil {
+		return nil, gateway.ErrInvalidInput
+	}
+
+	offset := int64(partNumber-1) * multipartUploadPartSize
+	if offset >= param.ContentLength {
+		return nil, gateway.ErrInvalidInput
+	}
+	length := min(multipartUploadPartSize, param.ContentLength-offset)
+
+	req, err := c.presign.PresignUploadPart(ctx, &awss3.UploadPartInput{
+		Bucket:     aws.String(c.bucket),
+		Key:        aws.String(p),
+		PartNumber: aws.Int32(int32(partNumber)),
+		UploadId:   aws.String(uploadID),
+	}, presignExpires(param.ExpiresAt))
+	if err != nil {
+		log.Errorf("s3: failed to issue signed url: %v", err)
+		return nil, gateway.ErrUnsupportedOperation
+	}
+
+	next := ""
+	if offset+length < param.ContentLength {
+		next = multipartCursor(uploadID, partNumber+1)
+	}
+
+	return &gateway.UploadAssetLink{
+		URL:             req.URL,
+		ContentType:     contentType,
+		ContentLength:   length,
+		ContentEncoding: param.ContentEncoding,
+		Next:            next,
+	}, nil
+}
+
+func (f *fileRepo) IssueDownloadLink(ctx context.Context, objectName string, expiresAt time.Time) (string, error) {
+	if objectName == "" {
+		return "", gateway.ErrInvalidFile
+	}
+
+	c, err := f.s3(ctx)
+	if err != nil {
+		return "", err
+	}
+	req, err := c.presign.PresignGetObject(ctx, &awss3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(objectName),
+	}, presignExpires(expiresAt))
+	if err != nil {
+		log.Errorf("s3: failed to issue signed url: %v", err)
+		return "", gateway.ErrUnsupportedOperation
+	}
+
+	return req.URL, nil
+}
+
+// UploadedAsset returns the file uploaded with the links issued by IssueUploadAssetLink, completing the multipart upload if it is in progress.
+func (f *fileRepo) UploadedAsset(ctx context.Context, u *asset.Upload) (*file.File, error) {
+	p := getS3ObjectPath(u.UUID(), u.FileName())
+	if p == "" {
+		return nil, gateway.ErrInvalidFile
+	}
+
+	c, err := f.s3(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uploadIDs, err := c.listMultipartUploads(ctx, p)
+	if err != nil {
+		return nil, fmt.Errorf("list multipart uploads(object=%s): %w", p, err)
+	}
+	if len(uploadIDs) > 0 {
+		uploadID := uploadIDs[len(uploadIDs)-1]
+		parts, err := c.listParts(ctx, p, uploadID)
+		if err != nil {
+			return nil, fmt.Errorf("list parts(object=%s): %w", p, err)
+		}
+		if err := c.completeMultipartUpload(ctx, p, uploadID, parts); err != nil {
+			return nil, fmt.Errorf("complete multipart upload(object=%s): %w", p, err)
+		}
+	}
+
+	res, err := c.api.HeadObject(ctx, &awss3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(p),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("head(object=%s): %w", p, err)
+	}
+	return &file.File{
+		Content:     nil,
+		Name:        u.FileName(),
+		Size:        aws.ToInt64(res.ContentLength),
+		ContentType: aws.ToString(res.ContentType),
+	}, nil
+}
+
+func (f *fileRepo) Read(ctx context.Context, filename string, headers map[string]string) (io.ReadCloser, map[string]string, error) {
+	if filename == "" {
+		return nil, nil, rerror.ErrNotFound
+	}
+
+	c, err := f.s3(ctx)
+	if err != nil {
+		return nil, nil, rerror.ErrInternalBy(err)
+	}
+
+	res, err := c.api.GetObject(ctx, &awss3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(filename),
+		Range:  nonEmpty(headers["Range"]),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil, rerror.ErrNotFound
+		}
+		log.Errorf("s3: read err: %+v\n", err)
+		return nil, nil, rerror.ErrInternalBy(err)
+	}
+
+	resheaders := map[string]string{}
+	if res.ContentLength != nil {
+		resheaders["Content-Length"] = strconv.FormatInt(*res.ContentLength, 10)
+	}
+	if res.LastModified != nil {
+		resheaders["Last-Modified"] = res.LastModified.UTC().Format(http.TimeFormat)
+	}
+	for k, v := range map[string]*string{
+		"Content-Range":    res.ContentRange,
+		"Content-Type":     res.ContentType,
+		"Content-Encoding": res.ContentEncoding,
+		"Cache-Control":    res.CacheControl,
+		"ETag":             res.ETag,
+	} {
+		if aws.ToString(v) != "" {
+			resheaders[k] = *v
+		}
+	}
+
+	return res.Body, resheaders, nil
+}
+
+func (f *fileRepo) Upload(ctx context.Context, file *file.File, objectName string) (int64, error) {
+	if file == nil || file.Name == "" || file.Content == nil {
+		return 0, gateway.ErrInvalidFile
+	}
+
+	if err := validateContentEncoding(file.ContentEncoding); err != nil {
+		return 0, err
+	}
+
+	if file.ContentEncoding == "identity" {
+		file.ContentEncoding = ""
+	}
+
+	c, err := f.s3(ctx)
+	if err != nil {
+		log.Errorf("s3: upload client err: %+v\n", err)
+		return 0, rerror.ErrInternalBy(err)
+	}
+
+	contentType := file.ContentType
+	if contentType == "" {
+		contentType = getContentType(file.Name)
+	}
+	if file.ContentEncoding == "gzip" && (contentType == "" || contentType == "application/gzip") {
+		contentType = "application/octet-stream"
+	}
+	in := &awss3.PutObjectInput{
+		Bucket:          aws.String(c.bucket),
+		Key:             aws.String(objectName),
+		ContentType:     nonEmpty(contentType),
+		ContentEncoding: nonEmpty(file.ContentEncoding),
+		CacheControl:    nonEmpty(f.conf.CacheControl),
+	}
+
+	size, err := upload(ctx, c, in, file.Content)
+	if err != nil {
+		log.Errorf("s3: upload err: %+v\n", err)
+		return 0, gateway.ErrFailedToUploadFile
+	}
+	return size, nil
+}
+
+// upload uploads the content in a request, or in multiple parts if it is larger than uploadPartSize so that the whole content is not loaded on memory.
+func upload(ctx context.Context, c *client, in *awss3.PutObjectInput, r io.Reader) (int64, error) {
+	buf := make([]byte, uploadPartSize)
+	n, err := io.ReadFull(r, buf)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		in.Body = bytes.NewReader(buf[:n])
+		_, err := c.api.PutObject(ctx, in)
+		return int64(n), err
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := c.api.CreateMultipartUpload(ctx, &awss3.CreateMultipartUploadInput{
+		Bucket:          in.Bucket,
+		Key:             in.Key,
+		ContentType:     in.ContentType,
+		ContentEncoding: in.ContentEncoding,
+		CacheControl:    in.CacheControl,
+	})
+	if err != nil {
+		return 0, err
+	}
+	key, uploadID := aws.ToString(in.Key), aws.ToString(res.UploadId)
+
+	var size int64
+	var parts []types.CompletedPart
+	for i := int32(1); n > 0; i++ {
+		pt, err := c.api.UploadPart(ctx, &awss3.UploadPartInput{
+			Bucket:     in.Bucket,
+			Key:        in.Key,
+			PartNumber: aws.Int32(i),
+			UploadId:   res.UploadId,
+			Body:       bytes.NewReader(buf[:n]),
+		})
+		if err != nil {
+			_ = c.abortMultipartUpload(ctx, key, uploadID)
+			return 0, err
+		}
+		parts = append(parts, types.CompletedPart{PartNumber: aws.Int32(i), ETag: pt.ETag})
+		size += int64(n)
+
+		n, err = io.ReadFull(r, buf)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			_ = c.abortMultipartUpload(ctx, key, uploadID)
+			return 0, err
+		}
+	}
+
+	if err := c.completeMultipartUpload(ctx, key, uploadID, parts); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+func (f *fileRepo) Delete(ctx context.Context, filename string) error {
+	if filename == "" {
+		return gateway.ErrInvalidFile
+	}
+
+	c, err := f.s3(ctx)
+	if err != nil {
+		log.Errorf("s3: delete client err: %+v\n", err)
+		return rerror.ErrInternalBy(err)
+	}
+
+	if _, err := c.api.DeleteObject(ctx, &awss3.DeleteObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(filename),
+	}); err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+
+		log.Errorf("s3: delete err: %+v\n", err)
+		return rerror.ErrInternalBy(err)
+	}
+	return nil
+}
+
+func (f *fileRepo) DeleteByPrefix(ctx context.Context, folderPrefix string, p gateway.Predicate) error {
+	if folderPrefix == "" {
+		return gateway.ErrInvalidInput
+	}
+	c, err := f.s3(ctx)
+	if err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	return f.deleteByPrefix(ctx, c, folderPrefix, p)
+}
+
+func (f *fileRepo) ListByPrefix(ctx context.Context, prefix string) ([]string, error) {
+	c, err := f.s3(ctx)
+	if err != nil {
+		return nil, rerror.ErrInternalBy(err)
+	}
+
+	objects, err := c.listObjects(ctx, prefix)
+	if err != nil {
+		return nil, rerror.ErrInternalBy(err)
+	}
+
+	var result []string
+	for _, o := range objects {
+		result = append(result, aws.ToString(o.Key))
+	}
+	return result, nil
+}
+
+func (f *fileRepo) Check(ctx context.Context) error {
+	c, err := f.s3(ctx)
+	if err != nil {
+		return fmt.Errorf("S3 client creation failed: %w", err)
+	}
+
+	testFileName := fmt.Sprintf("%s/%s", healthCheckDir, uuid.New().String())
+	testContent := []byte("ok")
+
+	deleteTestFile := func() error {
+		_, err := c.api.DeleteObject(ctx, &awss3.DeleteObjectInput{
+			Bucket: aws.String(c.bucket),
+			Key:    aws.String(testFileName),
+		})
+		return err
+	}
+
+	// upload
+	if _, err := c.api.PutObject(ctx, &awss3.PutObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(testFileName),
+		Body:   bytes.NewReader(testContent),
+	}); err != nil {
+		return fmt.Errorf("S3 upload failed: %w", err)
+	}
+
+	// read
+	res, err := c.api.GetObject(ctx, &awss3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(testFileName),
+	})
+	if err != nil {
+		_ = deleteTestFile()
+		return fmt.Errorf("S3 read failed: %w", err)
+	}
+	defer func() { _ = res.Body.Close() }()
+	readContent, err := io.ReadAll(res.Body)
+	if err != nil {
+		_ = deleteTestFile()
+		return fmt.Errorf("S3 read failed: %w", err)
+	}
+
+	if !bytes.Equal(readContent, testContent) {
+		_ = deleteTestFile()
+		return fmt.Errorf("S3 verification failed: content mismatch")
+	}
+
+	// delete
+	if err := deleteTestFile(); err != nil {
+		return fmt.Errorf("S3 delete failed: %w", err)
+	}
+
+	return nil
+}
+
+func (f *fileRepo) s3(ctx context.Context) (*client, error) {
+	f.clientMu.Lock()
+	defer f.clientMu.Unlock()
+	if f.client == nil {
+		c, err := newClient(ctx, f.conf)
+		if err != nil {
+			log.Errorf("s3: failed to initialize client: %v", err)
+			return nil, err
+		}
+		f.client = c
+	}
+	return f.client, nil
+}
+
+func (f *fileRepo) publish(ctx context.Context, filename string, public bool) error {
+	if filename == "" {
+		return gateway.ErrInvalidFile
+	}
+
+	c, err := f.s3(ctx)
+	if err != nil {
+		log.Errorf("s3: get client err: %+v\n", err)
+		return rerror.ErrInternalBy(err)
+	}
+
+	acl := types.ObjectCannedACLPrivate
+	if public {
+		acl = types.ObjectCannedACLPublicRead
+	}
+	if _, err := c.api.PutObjectAcl(ctx, &awss3.PutObjectAclInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(filename),
+		ACL:    acl,
+	}); err != nil {
+		if isNotFound(err) {
+			return gateway.ErrFileNotFound
+		}
+
+		log.Errorf("s3: acl err: %+v\n", err)
+		return rerror.ErrInternalBy(err)
+	}
+	return nil
+}
+
+func (f *fileRepo) deleteByPrefix(ctx context.Context, c *client, folderPrefix string, p gateway.Predicate) error {
+	objects, err := c.listObjects(ctx, folderPrefix)
+	if err != nil {
+		log.Errorf("s3: failed to list objects: %+v", err)
+		return err
+	}
+
+	var keys []string
+	for _, o := range objects {
+		key := aws.ToString(o.Key)
+		if p != nil && !p(gateway.FileEntry{Name: key, Size: aws.ToInt64(o.Size)}) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	if err := c.deleteObjects(ctx, keys); err != nil {
+		log.Errorf("s3: delete objects error: %+v\n", err)
+		return err
+	}
+	return nil
+}
+
+func multipartCursor(uploadID string, partNumber int) string {
+	return strconv.Itoa(partNumber) + ":" + uploadID
+}
+
+func parseMultipartCursor(c string) (string, int, error) {
+	n, uploadID, found := strings.Cut(c, ":")
+	if !found || uploadID == "" {
+		return "", 0, fmt.Errorf("invalid cursor: %s", c)
+	}
+	partNumber, err := strconv.Atoi(n)
+	if err != nil || partNumber < 1 {
+		return "", 0, fmt.Errorf("invalid cursor: %s", c)
+	}
+	return uploadID, partNumber, nil
+}
+
+func getS3ObjectPath(uuid, objectName string) string {
+	if uuid == "" || !IsValidUUID(uuid) {
+		return ""
+	}
+
+	return path.Join(s3AssetBasePath, uuid[:2], uuid[2:], objectName)
+}
+
+func getS3ObjectPathFolder(uuid string) string {
+	if uuid == "" || !IsValidUUID(uuid) {
+		return ""
+	}
+	return path.Join(s3AssetBasePath, uuid[:2], uuid[2:])
+}
+
+func getContentType(filename string) string {
+	ext := filepath.Ext(filename)
+	return mime.TypeByExtension(ext)
+}
+
+func newUUID() string {
+	return uuid.New().String()
+}
+
+func IsValidUUID(u string) bool {
+	_, err := uuid.Parse(u)
+	return err == nil
+}
+
+func getURL(host *url.URL, uuid, fName string) string {
+	return host.JoinPath(s3AssetBasePath, uuid[:2], uuid[2:], fName).String()
+}
+
+// nonEmpty returns nil for the empty string so that the header is not sent.
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func validateContentEncoding(ce string) error {
+	if ce != "" && ce != "identity" && ce != "gzip" {
+		return gateway.ErrUnsupportedContentEncoding
+	}
+	return nil
+}
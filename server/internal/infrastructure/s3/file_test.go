@@ -0,0 +1,593 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/file"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBucket = "test-bucket"
+
+func TestNewFile(t *testing.T) {
+	f, err := NewFile(Config{BucketName: "bucket", Region: "ap-northeast-1"}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://bucket.s3.ap-northeast-1.amazonaws.com", f.GetBaseURL())
+
+	f, err = NewFile(Config{BucketName: "bucket", Endpoint: "http://localhost:9000", UsePathStyle: true}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:9000/bucket", f.GetBaseURL())
+
+	f, err = NewFile(Config{BucketName: "bucket"}, "https://assets.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://assets.example.com", f.GetBaseURL())
+
+	_, err = NewFile(Config{}, "")
+	assert.Error(t, err)
+
+	_, err = NewFile(Config{BucketName: "bucket", Endpoint: "localhost"}, "")
+	assert.Error(t, err)
+}
+
+func TestFile_UploadAndRead(t *testing.T) {
+	ctx := context.Background()
+	s, f := newTestFile(t, false)
+
+	u, size, err := f.UploadAsset(ctx, &file.File{
+		Content: io.NopCloser(strings.NewReader("hello world")),
+		Name:    "a b(1).txt",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(11), size)
+	assert.Equal(t, "text/plain; charset=utf-8", s.object(getS3ObjectPath(u, "a b(1).txt")).header.Get("Content-Type"))
+	assert.Equal(t, "max-age=60", s.object(getS3ObjectPath(u, "a b(1).txt")).header.Get("Cache-Control"))
+
+	r, h, err := f.ReadAsset(ctx, u, "a b(1).txt", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", readAll(t, r))
+	assert.Equal(t, "text/plain; charset=utf-8", h["Content-Type"])
+	assert.Equal(t, "11", h["Content-Length"])
+
+	r, h, err = f.ReadAsset(ctx, u, "a b(1).txt", map[string]string{"Range": "bytes=6-10"})
+	assert.NoError(t, err)
+	assert.Equal(t, "world", readAll(t, r))
+	assert.Equal(t, "bytes 6-10/11", h["Content-Range"])
+
+	_, _, err = f.ReadAsset(ctx, u, "b.txt", nil)
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+
+	// gzip
+	n, err := f.Upload(ctx, &file.File{
+		Content:         io.NopCloser(strings.NewReader("gzipped")),
+		Name:            "c.json",
+		ContentEncoding: "gzip",
+	}, "c.json")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), n)
+	assert.Equal(t, "gzip", s.object("c.json").header.Get("Content-Encoding"))
+
+	_, err = f.Upload(ctx, &file.File{
+		Content:         io.NopCloser(strings.NewReader("x")),
+		Name:            "d.json",
+		ContentEncoding: "br",
+	}, "d.json")
+	assert.ErrorIs(t, err, gateway.ErrUnsupportedContentEncoding)
+}
+
+func TestFile_Upload_Multipart(t *testing.T) {
+	ctx := context.Background()
+	s, f := newTestFile(t, false)
+
+	content := bytes.Repeat([]byte("a"), int(uploadPartSize)+10)
+	n, err := f.Upload(ctx, &file.File{
+		Content: io.NopCloser(bytes.NewReader(content)),
+		Name:    "large.bin",
+	}, "large.bin")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(content)), n)
+	assert.Equal(t, content, s.object("large.bin").body)
+	assert.Empty(t, s.uploads)
+}
+
+func TestFile_ListAndDelete(t *testing.T) {
+	ctx := context.Background()
+	s, f := newTestFile(t, false)
+	s.pageSize = 2
+
+	u1, u2 := newUUID(), newUUID()
+	for _, k := range []string{
+		getS3ObjectPath(u1, "a/1.txt"),
+		getS3ObjectPath(u1, "a/2.txt"),
+		getS3ObjectPath(u1, "b.json"),
+		getS3ObjectPath(u2, "c.txt"),
+		"exports/x.csv",
+		"exports/y.json",
+	} {
+		s.put(k, []byte("x"))
+	}
+
+	files, err := f.GetAssetFiles(ctx, u1)
+	assert.NoError(t, err)
+	assert.Equal(t, []gateway.FileEntry{
+		{Name: "a/1.txt", Size: 1},
+		{Name: "a/2.txt", Size: 1},
+		{Name: "b.json", Size: 1},
+	}, files)
+
+	_, err = f.GetAssetFiles(ctx, newUUID())
+	assert.ErrorIs(t, err, gateway.ErrFileNotFound)
+
+	keys, err := f.ListByPrefix(ctx, "exports/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"exports/x.csv", "exports/y.json"}, keys)
+
+	assert.NoError(t, f.DeleteByPrefix(ctx, "exports/", func(fe gateway.FileEntry) bool {
+		return strings.HasSuffix(fe.Name, ".csv")
+	}))
+	assert.Equal(t, []string{"exports/y.json"}, listByPrefix(t, f, "exports/"))
+	assert.ErrorIs(t, f.DeleteByPrefix(ctx, "", nil), gateway.ErrInvalidInput)
+
+	assert.NoError(t, f.DeleteAsset(ctx, u1, "b.json"))
+	assert.NoError(t, f.DeleteAsset(ctx, u1, "b.json"))
+	assert.Nil(t, s.object(getS3ObjectPath(u1, "b.json")))
+
+	assert.NoError(t, f.DeleteAssets(ctx, []string{u1, u2}))
+	assert.Empty(t, listByPrefix(t, f, "assets/"))
+	assert.ErrorIs(t, f.DeleteAssets(ctx, []string{"invalid"}), gateway.ErrInvalidFile)
+}
+
+func TestFile_Publish(t *testing.T) {
+	ctx := context.Background()
+	s, f := newTestFile(t, true)
+	u := newUUID()
+	s.put(getS3ObjectPath(u, "a.txt"), []byte("x"))
+
+	assert.NoError(t, f.PublishAsset(ctx, u, "a.txt"))
+	assert.Equal(t, "public-read", s.object(getS3ObjectPath(u, "a.txt")).acl)
+	assert.NoError(t, f.UnpublishAsset(ctx, u, "a.txt"))
+	assert.Equal(t, "private", s.object(getS3ObjectPath(u, "a.txt")).acl)
+	assert.ErrorIs(t, f.PublishAsset(ctx, u, "b.txt"), gateway.ErrFileNotFound)
+
+	a := asset.New().NewID().Project(asset.NewProjectID()).CreatedByUser(asset.NewUserID()).Size(1).UUID(u).FileName("a.txt").Thread(asset.NewThreadID().Ref()).MustBuild()
+	assert.Equal(t, &asset.AccessInfo{Url: "http://localhost:8080/assets/" + u[:2] + "/" + u[2:] + "/a.txt", Public: false}, f.GetAccessInfo(a))
+
+	_, pf := newTestFile(t, false)
+	assert.ErrorIs(t, pf.PublishAsset(ctx, u, "a.txt"), gateway.ErrUnsupportedOperation)
+}
+
+func TestFile_IssueUploadAssetLink(t *testing.T) {
+	ctx := context.Background()
+	s, f := newTestFile(t, false)
+	u := newUUID()
+	expiresAt := time.Now().Add(time.Hour)
+
+	link, err := f.IssueUploadAssetLink(ctx, gateway.IssueUploadAssetParam{
+		UUID:          u,
+		Filename:      "a.json",
+		ContentLength: 5,
+		ExpiresAt:     expiresAt,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "application/json", link.ContentType)
+	assert.Equal(t, int64(5), link.ContentLength)
+	assert.Empty(t, link.Next)
+	put(t, link.URL, link.ContentType, "hello")
+
+	uploaded, err := f.UploadedAsset(ctx, asset.NewUpload().UUID(u).FileName("a.json").Build())
+	assert.NoError(t, err)
+	assert.Equal(t, &file.File{Name: "a.json", Size: 5, ContentType: "application/json"}, uploaded)
+	assert.Equal(t, "hello", string(s.object(getS3ObjectPath(u, "a.json")).body))
+
+	_, err = f.IssueUploadAssetLink(ctx, gateway.IssueUploadAssetParam{UUID: u, Filename: "a.json", ContentEncoding: "br"})
+	assert.ErrorIs(t, err, gateway.ErrUnsupportedContentEncoding)
+}
+
+func TestFile_IssueUploadAssetLink_Multipart(t *testing.T) {
+	ctx := context.Background()
+	s, f := newTestFile(t, false)
+	u := newUUID()
+	param := gateway.IssueUploadAssetParam{
+		UUID:          u,
+		Filename:      "large.bin",
+		ContentLength: multipartUploadPartSize + 5,
+		ExpiresAt:     time.Now().Add(time.Hour),
+	}
+
+	link, err := f.IssueUploadAssetLink(ctx, param)
+	assert.NoError(t, err)
+	assert.Equal(t, multipartUploadPartSize, link.ContentLength)
+	assert.NotEmpty(t, link.Next)
+	put(t, link.URL, "", "hello ")
+
+	param.Cursor = link.Next
+	link, err = f.IssueUploadAssetLink(ctx, param)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), link.ContentLength)
+	assert.Empty(t, link.Next)
+	put(t, link.URL, "", "world")
+
+	uploaded, err := f.UploadedAsset(ctx, asset.NewUpload().UUID(u).FileName("large.bin").Build())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(11), uploaded.Size)
+	assert.Equal(t, "hello world", string(s.object(getS3ObjectPath(u, "large.bin")).body))
+	assert.Empty(t, s.uploads)
+
+	param.Cursor = "invalid"
+	_, err = f.IssueUploadAssetLink(ctx, param)
+	assert.ErrorIs(t, err, gateway.ErrInvalidInput)
+}
+
+func TestFile_IssueDownloadLink(t *testing.T) {
+	ctx := context.Background()
+	s, f := newTestFile(t, false)
+	s.put("exports/a.csv", []byte("a,b"))
+
+	link, err := f.IssueDownloadLink(ctx, "exports/a.csv", time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	res, err := http.Get(link)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "a,b", readAll(t, res.Body))
+
+	_, err = f.IssueDownloadLink(ctx, "", time.Now())
+	assert.ErrorIs(t, err, gateway.ErrInvalidFile)
+}
+
+func TestFile_Check(t *testing.T) {
+	s, f := newTestFile(t, false)
+	assert.NoError(t, f.Check(context.Background()))
+	assert.Empty(t, s.objects)
+}
+
+func TestMultipartCursor(t *testing.T) {
+	id, n, err := parseMultipartCursor(multipartCursor("upload:id", 2))
+	assert.NoError(t, err)
+	assert.Equal(t, "upload:id", id)
+	assert.Equal(t, 2, n)
+
+	_, _, err = parseMultipartCursor("0:id")
+	assert.Error(t, err)
+	_, _, err = parseMultipartCursor("1:")
+	assert.Error(t, err)
+}
+
+func newTestFile(t *testing.T, acl bool) (*fakeS3, gateway.File) {
+	t.Helper()
+	s := &fakeS3{objects: map[string]*fakeObject{}, uploads: map[string]*fakeUpload{}}
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+
+	conf := Config{
+		BucketName:      testBucket,
+		Region:          "us-east-1",
+		Endpoint:        srv.URL,
+		AccessKeyID:     "key",
+		SecretAccessKey: "secret",
+		UsePathStyle:    true,
+		CacheControl:    "max-age=60",
+	}
+	var f gateway.File
+	var err error
+	if acl {
+		f, err = NewFileWithACL(conf, "", "http://localhost:8080")
+	} else {
+		f, err = NewFile(conf, "")
+	}
+	require.NoError(t, err)
+	return s, f
+}
+
+func put(t *testing.T, u, contentType, body string) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPut, u, strings.NewReader(body))
+	require.NoError(t, err)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	_ = res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func readAll(t *testing.T, r io.ReadCloser) string {
+	t.Helper()
+	defer func() { _ = r.Close() }()
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func listByPrefix(t *testing.T, f gateway.File, prefix string) []string {
+	t.Helper()
+	keys, err := f.ListByPrefix(context.Background(), prefix)
+	require.NoError(t, err)
+	return keys
+}
+
+// fakeS3 is an in-memory stand-in of an S3-compatible service which implements the operations used by fileRepo.
+type fakeS3 struct {
+	mu       sync.Mutex
+	objects  map[string]*fakeObject
+	uploads  map[string]*fakeUpload
+	pageSize int
+	seq      int
+}
+
+type fakeObject struct {
+	body   []byte
+	header http.Header
+	acl    string
+}
+
+type fakeUpload struct {
+	key    string
+	header http.Header
+	parts  map[int][]byte
+}
+
+type fakeListObject struct {
+	Key  string `xml:"Key"`
+	Size int64  `xml:"Size"`
+}
+
+type fakePart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+func (s *fakeS3) object(key string) *fakeObject {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.objects[key]
+}
+
+func (s *fakeS3) put(key string, body []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key] = &fakeObject{body: body, header: http.Header{}}
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ") && r.URL.Query().Get("X-Amz-Signature") == "" {
+		writeFakeError(w, http.StatusForbidden, "AccessDenied")
+		return
+	}
+	rest, ok := strings.CutPrefix(r.URL.Path, "/"+testBucket)
+	if !ok {
+		writeFakeError(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+	key := strings.TrimPrefix(rest, "/")
+	q := r.URL.Query()
+	body, _ := io.ReadAll(r.Body)
+
+	if key == "" {
+		switch {
+		case r.Method == http.MethodGet && q.Has("uploads"):
+			type upload struct {
+				Key      string `xml:"Key"`
+				UploadID string `xml:"UploadId"`
+			}
+			var res struct {
+				XMLName xml.Name `xml:"ListMultipartUploadsResult"`
+				Uploads []upload `xml:"Upload"`
+			}
+			for _, id := range sortedKeys(s.uploads) {
+				if strings.HasPrefix(s.uploads[id].key, q.Get("prefix")) {
+					res.Uploads = append(res.Uploads, upload{Key: s.uploads[id].key, UploadID: id})
+				}
+			}
+			writeFakeXML(w, res)
+		case r.Method == http.MethodGet && q.Get("list-type") == "2":
+			var keys []string
+			for _, k := range sortedKeys(s.objects) {
+				if strings.HasPrefix(k, q.Get("prefix")) {
+					keys = append(keys, k)
+				}
+			}
+			start, _ := strconv.Atoi(q.Get("continuation-token"))
+			end := len(keys)
+			if s.pageSize > 0 {
+				end = min(start+s.pageSize, len(keys))
+			}
+			var res struct {
+				XMLName               xml.Name         `xml:"ListBucketResult"`
+				Contents              []fakeListObject `xml:"Contents"`
+				IsTruncated           bool             `xml:"IsTruncated"`
+				NextContinuationToken string           `xml:"NextContinuationToken,omitempty"`
+			}
+			for _, k := range keys[start:end] {
+				res.Contents = append(res.Contents, fakeListObject{Key: k, Size: int64(len(s.objects[k].body))})
+			}
+			if end < len(keys) {
+				res.IsTruncated = true
+				res.NextContinuationToken = strconv.Itoa(end)
+			}
+			writeFakeXML(w, res)
+		case r.Method == http.MethodPost && q.Has("delete"):
+			var req struct {
+				Objects []struct {
+					Key string `xml:"Key"`
+				} `xml:"Object"`
+			}
+			if err := xml.Unmarshal(body, &req); err != nil || !hasChecksum(r.Header) {
+				writeFakeError(w, http.StatusBadRequest, "MalformedXML")
+				return
+			}
+			for _, o := range req.Objects {
+				delete(s.objects, o.Key)
+			}
+			writeFakeXML(w, struct {
+				XMLName xml.Name `xml:"DeleteResult"`
+			}{})
+		default:
+			writeFakeError(w, http.StatusNotImplemented, "NotImplemented")
+		}
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodPut && q.Has("acl"):
+		o, ok := s.objects[key]
+		if !ok {
+			writeFakeError(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		o.acl = r.Header.Get("X-Amz-Acl")
+	case r.Method == http.MethodPut && q.Has("uploadId"):
+		u, ok := s.uploads[q.Get("uploadId")]
+		if !ok || u.key != key {
+			writeFakeError(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		n, _ := strconv.Atoi(q.Get("partNumber"))
+		u.parts[n] = body
+		w.Header().Set("ETag", fmt.Sprintf(`"%d"`, n))
+	case r.Method == http.MethodPut:
+		s.objects[key] = &fakeObject{body: body, header: objectHeader(r.Header)}
+	case r.Method == http.MethodPost && q.Has("uploads"):
+		s.seq++
+		id := fmt.Sprintf("upload%03d", s.seq)
+		s.uploads[id] = &fakeUpload{key: key, header: objectHeader(r.Header), parts: map[int][]byte{}}
+		writeFakeXML(w, struct {
+			XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+			UploadID string   `xml:"UploadId"`
+		}{UploadID: id})
+	case r.Method == http.MethodPost && q.Has("uploadId"):
+		u, ok := s.uploads[q.Get("uploadId")]
+		if !ok || u.key != key {
+			writeFakeError(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		var req struct {
+			Parts []fakePart `xml:"Part"`
+		}
+		if err := xml.Unmarshal(body, &req); err != nil {
+			writeFakeError(w, http.StatusBadRequest, "MalformedXML")
+			return
+		}
+		var content []byte
+		for _, p := range req.Parts {
+			b, ok := u.parts[p.PartNumber]
+			if !ok || p.ETag != fmt.Sprintf(`"%d"`, p.PartNumber) {
+				writeFakeXML(w, struct {
+					XMLName xml.Name `xml:"Error"`
+					Code    string   `xml:"Code"`
+				}{Code: "InvalidPart"})
+				return
+			}
+			content = append(content, b...)
+		}
+		s.objects[key] = &fakeObject{body: content, header: u.header}
+		delete(s.uploads, q.Get("uploadId"))
+		writeFakeXML(w, struct {
+			XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+		}{})
+	case r.Method == http.MethodGet && q.Has("uploadId"):
+		u, ok := s.uploads[q.Get("uploadId")]
+		if !ok {
+			writeFakeError(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		var res struct {
+			XMLName xml.Name   `xml:"ListPartsResult"`
+			Parts   []fakePart `xml:"Part"`
+		}
+		for _, n := range sortedKeys(u.parts) {
+			res.Parts = append(res.Parts, fakePart{PartNumber: n, ETag: fmt.Sprintf(`"%d"`, n)})
+		}
+		writeFakeXML(w, res)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		o, ok := s.objects[key]
+		if !ok {
+			writeFakeError(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		for k, v := range o.header {
+			w.Header()[k] = v
+		}
+		b, status := o.body, http.StatusOK
+		var from, to int
+		if _, err := fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-%d", &from, &to); err == nil {
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", from, to, len(b)))
+			b, status = b[from:to+1], http.StatusPartialContent
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(b)))
+		w.WriteHeader(status)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(b)
+		}
+	case r.Method == http.MethodDelete && q.Has("uploadId"):
+		delete(s.uploads, q.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeFakeError(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func objectHeader(h http.Header) http.Header {
+	res := http.Header{}
+	for _, k := range []string{"Content-Type", "Content-Encoding", "Cache-Control"} {
+		if v := h.Get(k); v != "" {
+			res.Set(k, v)
+		}
+	}
+	return res
+}
+
+// hasChecksum reports whether the request has the checksum of the body, which is required by some operations such as DeleteObjects.
+func hasChecksum(h http.Header) bool {
+	if h.Get("Content-MD5") != "" {
+		return true
+	}
+	for k := range h {
+		if strings.HasPrefix(strings.ToLower(k), "x-amz-checksum-") {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[K string | int, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func writeFakeXML(w http.ResponseWriter, v any) {
+	b, _ := xml.Marshal(v)
+	w.Header().Set("Content-Type", "application/xml")
+	_, _ = w.Write(b)
+}
+
+func writeFakeError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, code)
+}
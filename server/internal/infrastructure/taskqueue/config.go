@@ -0,0 +1,15 @@
+package taskqueue
+
+import "time"
+
+type Config struct {
+	// Enabled runs the tasks with the built-in task runner when Cloud Tasks is not configured.
+	Enabled bool `default:"true" pp:",omitempty"`
+	// Worker runs the queued tasks in this process. Disable it when the tasks are run by dedicated worker processes.
+	Worker       bool          `default:"true" pp:",omitempty"`
+	Concurrency  int           `default:"4" pp:",omitempty"`
+	MaxAttempts  int           `default:"5" pp:",omitempty"`
+	PollInterval time.Duration `default:"1s" pp:",omitempty"`
+	// Timeout is how long a task can run. A task which is not finished by then is regarded as lost and run again.
+	Timeout time.Duration `default:"1h" pp:",omitempty"`
+}
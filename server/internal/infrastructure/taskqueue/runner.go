@@ -0,0 +1,66 @@
+package taskqueue
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+)
+
+// TaskRunner queues the tasks to the durable queue which the workers run.
+type TaskRunner struct {
+	repo repo.Task
+	conf Config
+}
+
+func NewTaskRunner(r repo.Task, conf Config) gateway.TaskRunner {
+	return &TaskRunner{
+		repo: r,
+		conf: conf,
+	}
+}
+
+// Run implements gateway.TaskRunner
+func (t *TaskRunner) Run(ctx context.Context, p task.Payload) error {
+	m, err := task.NewMessage(p)
+	if err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+
+	tk, err := task.New().
+		NewID().
+		Message(m).
+		MaxAttempts(t.conf.MaxAttempts).
+		RunAt(util.Now()).
+		Build()
+	if err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+
+	if err := t.repo.Save(ctx, tk); err != nil {
+		return err
+	}
+	log.Infofc(ctx, "task queue: queued: id=%s kind=%s", tk.ID(), m.Kind())
+	return nil
+}
+
+// Retry implements gateway.TaskRunner
+func (t *TaskRunner) Retry(ctx context.Context, id string) error {
+	tid, err := task.IDFrom(id)
+	if err != nil {
+		return rerror.ErrNotFound
+	}
+
+	tk, err := t.repo.FindByID(ctx, tid)
+	if err != nil {
+		return err
+	}
+	if err := tk.Retry(util.Now()); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	return t.repo.Save(ctx, tk)
+}
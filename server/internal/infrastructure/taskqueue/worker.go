@@ -0,0 +1,118 @@
+package taskqueue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+)
+
+const (
+	defaultPollInterval = time.Second
+	defaultTimeout      = time.Hour
+)
+
+var errTaskLost = errors.New("the runner of the task has been lost")
+
+// Handler runs a task. The task is retried when it returns an error.
+type Handler func(context.Context, task.Message) error
+
+// Worker runs the queued tasks with the handler concurrently.
+type Worker struct {
+	repo    repo.Task
+	handler Handler
+	conf    Config
+}
+
+func NewWorker(r repo.Task, h Handler, conf Config) *Worker {
+	if conf.PollInterval <= 0 {
+		conf.PollInterval = defaultPollInterval
+	}
+	if conf.Timeout <= 0 {
+		conf.Timeout = defaultTimeout
+	}
+	return &Worker{
+		repo:    r,
+		handler: h,
+		conf:    conf,
+	}
+}
+
+// Run runs the tasks until ctx is done.
+func (w *Worker) Run(ctx context.Context) {
+	wg := sync.WaitGroup{}
+	for range max(w.conf.Concurrency, 1) {
+		wg.Go(func() {
+			w.loop(ctx)
+		})
+	}
+	wg.Wait()
+}
+
+func (w *Worker) loop(ctx context.Context) {
+	for {
+		ok, err := w.RunNext(ctx)
+		if err != nil {
+			log.Errorf("task queue: failed to run a task: %v", err)
+		}
+		if ok && err == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.conf.PollInterval):
+		}
+	}
+}
+
+// RunNext runs the task which is due first and returns false when there are no due tasks.
+func (w *Worker) RunNext(ctx context.Context) (bool, error) {
+	if ctx.Err() != nil {
+		return false, nil
+	}
+
+	tk, err := w.repo.Acquire(ctx, util.Now(), w.conf.Timeout)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	m := tk.Message()
+	if tk.Attempts() > tk.MaxAttempts() {
+		// the last attempt was lost, so the task is not run any more
+		tk.Fail(util.Now(), errTaskLost)
+	} else if err := w.run(ctx, m); err != nil {
+		log.Errorf("task queue: failed: id=%s kind=%s attempt=%d err=%v", tk.ID(), m.Kind(), tk.Attempts(), err)
+		tk.Fail(util.Now(), err)
+	} else {
+		log.Infof("task queue: succeeded: id=%s kind=%s", tk.ID(), m.Kind())
+		tk.Succeed(util.Now())
+	}
+
+	// the result is recorded even when the worker is being stopped so that the task is retried soon
+	return true, w.repo.Save(context.WithoutCancel(ctx), tk)
+}
+
+func (w *Worker) run(ctx context.Context, m task.Message) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, w.conf.Timeout)
+	defer cancel()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return w.handler(ctx, m)
+}
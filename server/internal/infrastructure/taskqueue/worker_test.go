@@ -0,0 +1,106 @@
+package taskqueue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskRunner_Run(t *testing.T) {
+	ctx := context.Background()
+	r := memory.NewTask()
+	runner := NewTaskRunner(r, Config{MaxAttempts: 3})
+
+	assert.NoError(t, runner.Run(ctx, (&task.DecompressAssetPayload{AssetID: "a", Path: "b"}).Payload()))
+	assert.Error(t, runner.Run(ctx, task.Payload{}))
+
+	tk, err := r.Acquire(ctx, util.Now(), time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, task.Message{DecompressAsset: &task.DecompressAssetPayload{AssetID: "a", Path: "b"}}, tk.Message())
+	assert.Equal(t, 3, tk.MaxAttempts())
+
+	assert.Equal(t, rerror.ErrNotFound, runner.Retry(ctx, "x"))
+	assert.Equal(t, rerror.ErrNotFound, runner.Retry(ctx, task.NewID().String()))
+	// the running task cannot be retried
+	assert.Error(t, runner.Retry(ctx, tk.ID().String()))
+}
+
+func TestWorker_RunNext(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	defer util.MockNow(now)()
+
+	r := memory.NewTask()
+	conf := Config{MaxAttempts: 2, Timeout: time.Minute}
+	tk := task.New().NewID().Message(task.Message{Copy: &task.CopyPayload{Collection: "item"}}).MaxAttempts(2).RunAt(now).MustBuild()
+	assert.NoError(t, r.Save(ctx, tk))
+
+	var got []task.Message
+	fail := true
+	w := NewWorker(r, func(_ context.Context, m task.Message) error {
+		got = append(got, m)
+		if fail {
+			return errors.New("boom")
+		}
+		return nil
+	}, conf)
+
+	ok, err := w.RunNext(ctx)
+	assert.True(t, ok)
+	assert.NoError(t, err)
+	assert.Equal(t, []task.Message{tk.Message()}, got)
+
+	// the task is retried after the backoff
+	ok, err = w.RunNext(ctx)
+	assert.False(t, ok)
+	assert.NoError(t, err)
+
+	defer util.MockNow(now.Add(task.RetryInterval(1)))()
+	ok, err = w.RunNext(ctx)
+	assert.True(t, ok)
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+
+	res, err := r.FindByID(ctx, tk.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, task.StatusFailed, res.Status())
+	assert.Equal(t, "boom", res.Error())
+
+	// the failed task is run again from its first attempt
+	fail = false
+	assert.NoError(t, NewTaskRunner(r, conf).Retry(ctx, tk.ID().String()))
+	ok, err = w.RunNext(ctx)
+	assert.True(t, ok)
+	assert.NoError(t, err)
+
+	res, err = r.FindByID(ctx, tk.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, task.StatusSucceeded, res.Status())
+	assert.Equal(t, 1, res.Attempts())
+}
+
+func TestWorker_RunNext_Panic(t *testing.T) {
+	ctx := context.Background()
+	r := memory.NewTask()
+	tk := task.New().NewID().Message(task.Message{Copy: &task.CopyPayload{Collection: "item"}}).MaxAttempts(1).MustBuild()
+	assert.NoError(t, r.Save(ctx, tk))
+
+	w := NewWorker(r, func(context.Context, task.Message) error {
+		panic("boom")
+	}, Config{})
+	ok, err := w.RunNext(ctx)
+	assert.True(t, ok)
+	assert.NoError(t, err)
+
+	res, err := r.FindByID(ctx, tk.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, task.StatusFailed, res.Status())
+	assert.Equal(t, "panic: boom", res.Error())
+}
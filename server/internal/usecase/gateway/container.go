@@ -1,12 +1,13 @@
 package gateway
 
 type Container struct {
-	Authenticator Authenticator
-	File          File
-	Mailer        Mailer
-	PolicyChecker PolicyChecker
-	TaskRunner    TaskRunner
-	Accounts      Account
-	JobPubSub     JobPubSub
-	Authorization Authorization
+	Authenticator    Authenticator
+	File             File
+	Mailer           Mailer
+	PolicyChecker    PolicyChecker
+	TaskRunner       TaskRunner
+	Accounts         Account
+	JobPubSub        JobPubSub
+	Authorization    Authorization
+	ImageTransformer ImageTransformer
 }
@@ -84,6 +84,9 @@ type File interface {
 	GetAccessInfo(*asset.Asset) *asset.AccessInfo
 	GetBaseURL() string
 	IssueUploadAssetLink(context.Context, IssueUploadAssetParam) (*UploadAssetLink, error)
+	// IssueDownloadLink returns a URL to download the file uploaded by Upload, which is valid until the given time.
+	// Storages which cannot sign URLs may return a public URL which does not expire, or ErrUnsupportedOperation.
+	IssueDownloadLink(context.Context, string, time.Time) (string, error)
 	UploadedAsset(context.Context, *asset.Upload) (*file.File, error)
 	Check(context.Context) error
 }
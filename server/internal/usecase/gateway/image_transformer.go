@@ -0,0 +1,34 @@
+package gateway
+
+import (
+	"context"
+	"io"
+
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+)
+
+var (
+	ErrUnsupportedImageFormat error = rerror.NewE(i18n.T("unsupported image format"))
+	ErrImageTooLarge          error = rerror.NewE(i18n.T("image too large"))
+)
+
+type ImageTransformer interface {
+	// Transform decodes the image, transforms it and encodes it in the format of the transformation.
+	Transform(context.Context, io.Reader, asset.ImageTransformation) ([]byte, error)
+	// Allow reports whether the transformation of the file which has the UUID can be requested with the signature.
+	Allow(t asset.ImageTransformation, uuid, signature string) bool
+	// URL returns the URL which serves the file transformed.
+	URL(uuid, fileName string, t asset.ImageTransformation) string
+	// ThumbnailURL returns the URL which serves the thumbnail of the file.
+	ThumbnailURL(uuid, fileName string) string
+}
+
+// ThumbnailURL returns the URL of the thumbnail of the asset, or an empty string when the asset is not an image which can be transformed.
+func ThumbnailURL(t ImageTransformer, a *asset.Asset) string {
+	if t == nil || a == nil || a.PreviewType() == nil || *a.PreviewType() != asset.PreviewTypeImage {
+		return ""
+	}
+	return t.ThumbnailURL(a.UUID(), a.FileName())
+}
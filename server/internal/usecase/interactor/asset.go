@@ -22,12 +22,15 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/rbac"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/task"
 	"github.com/reearth/reearth-cms/server/pkg/types"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/log"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
+	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 )
 
@@ -113,12 +116,11 @@ func (i *Asset) Search(ctx context.Context, projectID id.ProjectID, filter inter
 	if err := i.checkPermissions(ctx, rbac.ActionList, id.ProjectIDList{projectID}); err != nil {
 		return nil, nil, err
 	}
-	al, pi, err := i.repos.Asset.Search(ctx, projectID, repo.AssetFilter{
-		Sort:         filter.Sort,
-		Keyword:      filter.Keyword,
-		Pagination:   filter.Pagination,
-		ContentTypes: filter.ContentTypes,
-	})
+	f, _, err := i.repoFilter(ctx, projectID, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	al, pi, err := i.repos.Asset.Search(ctx, projectID, f)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -128,6 +130,113 @@ func (i *Asset) Search(ctx context.Context, projectID id.ProjectID, filter inter
 	return al, pi, nil
 }
 
+// repoFilter converts the filter into the one of the repository, resolving the sub folders of the folder when it is recursive.
+// It also returns all folders of the project to build the folder paths of the assets.
+func (i *Asset) repoFilter(ctx context.Context, pid id.ProjectID, filter interfaces.AssetFilter) (repo.AssetFilter, asset.FolderList, error) {
+	res := repo.AssetFilter{
+		Sort:         filter.Sort,
+		Keyword:      filter.Keyword,
+		Pagination:   filter.Pagination,
+		ContentTypes: filter.ContentTypes,
+	}
+
+	folders, err := i.repos.AssetFolder.FindByProject(ctx, pid)
+	if err != nil {
+		return res, nil, err
+	}
+
+	switch {
+	case filter.Folder != nil:
+		if folders.Folder(*filter.Folder) == nil {
+			return res, nil, rerror.ErrNotFound
+		}
+		if filter.Recursive {
+			res.Folders = folders.Descendants(*filter.Folder)
+		} else {
+			res.Folders = id.AssetFolderIDList{*filter.Folder}
+		}
+	case filter.RootFolder && !filter.Recursive:
+		res.RootFolder = true
+	}
+
+	if len(filter.Metadata) > 0 {
+		s, err := i.metadataSchema(ctx, pid)
+		if err != nil {
+			return res, nil, err
+		}
+		if s == nil {
+			return res, nil, interfaces.ErrAssetMetadataSchemaNotFound
+		}
+		res.Metadata, err = assetMetadataFilterFromParams(filter.Metadata, s)
+		if err != nil {
+			return res, nil, err
+		}
+	}
+	return res, folders, nil
+}
+
+func (i *Asset) FindMetadataSchema(ctx context.Context, pid id.ProjectID, _ *usecase.Operator) (*schema.Schema, error) {
+	if err := i.checkPermissions(ctx, rbac.ActionRead, id.ProjectIDList{pid}); err != nil {
+		return nil, err
+	}
+	s, err := i.metadataSchema(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, rerror.ErrNotFound
+	}
+	return s, nil
+}
+
+// metadataSchema returns the asset metadata schema of the project, or nil when the project does not define it.
+func (i *Asset) metadataSchema(ctx context.Context, pid id.ProjectID) (*schema.Schema, error) {
+	p, err := i.repos.Project.FindByID(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	if p.AssetMetadataSchema() == nil {
+		return nil, nil
+	}
+	return i.repos.Schema.FindByID(ctx, *p.AssetMetadataSchema())
+}
+
+func assetMetadataFromParams(params []interfaces.AssetMetadataFieldParam, s *schema.Schema) (asset.Metadata, error) {
+	return util.TryMap(params, func(f interfaces.AssetMetadataFieldParam) (*asset.MetadataField, error) {
+		sf := s.FieldByIDOrKey(f.Field, f.Key)
+		if sf == nil {
+			return nil, fmt.Errorf("%w: id=%s key=%s", interfaces.ErrInvalidField, f.Field, f.Key)
+		}
+
+		if !sf.Multiple() {
+			f.Value = []any{f.Value}
+		}
+		as, ok := f.Value.([]any)
+		if !ok {
+			return nil, fmt.Errorf("%w: id=%s key=%s", interfaces.ErrInvalidValue, f.Field, f.Key)
+		}
+		m := value.NewMultiple(sf.Type(), as)
+		if err := sf.Validate(m); err != nil {
+			return nil, fmt.Errorf("%w: id=%s key=%s", err, sf.ID(), sf.Name())
+		}
+		return asset.NewMetadataField(sf.ID(), m), nil
+	})
+}
+
+func assetMetadataFilterFromParams(params []interfaces.AssetMetadataFieldParam, s *schema.Schema) ([]repo.AssetMetadataFilter, error) {
+	return util.TryMap(params, func(f interfaces.AssetMetadataFieldParam) (repo.AssetMetadataFilter, error) {
+		sf := s.FieldByIDOrKey(f.Field, f.Key)
+		if sf == nil {
+			return repo.AssetMetadataFilter{}, fmt.Errorf("%w: id=%s key=%s", interfaces.ErrInvalidField, f.Field, f.Key)
+		}
+		v := sf.Type().Value(f.Value)
+		if v == nil {
+			return repo.AssetMetadataFilter{}, fmt.Errorf("%w: id=%s key=%s", interfaces.ErrInvalidValue, sf.ID(), sf.Name())
+		}
+		return repo.AssetMetadataFilter{Field: sf.ID(), Value: v}, nil
+	})
+}
+
 func (i *Asset) Export(ctx context.Context, params interfaces.ExportAssetsParams, w io.Writer, _ *usecase.Operator) error {
 	if err := i.checkPermissions(ctx, rbac.ActionExport, id.ProjectIDList{params.ProjectID}); err != nil {
 		return err
@@ -147,15 +256,20 @@ func (i *Asset) Export(ctx context.Context, params interfaces.ExportAssetsParams
 		pagination = params.Filter.Pagination
 	}
 
+	filter, folders, err := i.repoFilter(ctx, params.ProjectID, params.Filter)
+	if err != nil {
+		return err
+	}
+	metadataSchema, err := i.metadataSchema(ctx, params.ProjectID)
+	if err != nil {
+		return err
+	}
+	filter.Pagination = pagination
+
 	totalProcessed := 0
 	pageInfo := &usecasex.PageInfo{}
 	for {
-		assets, pi, err := i.repos.Asset.Search(ctx, params.ProjectID, repo.AssetFilter{
-			Pagination:   pagination,
-			Sort:         params.Filter.Sort,
-			Keyword:      params.Filter.Keyword,
-			ContentTypes: params.Filter.ContentTypes,
-		})
+		assets, pi, err := i.repos.Asset.Search(ctx, params.ProjectID, filter)
 		if err != nil {
 			return rerror.ErrInternalBy(err)
 		}
@@ -211,12 +325,19 @@ func (i *Asset) Export(ctx context.Context, params interfaces.ExportAssetsParams
 				updatedBy = createdBy
 			}
 
+			folderPath := ""
+			if fid := a.Folder(); fid != nil {
+				folderPath = folders.Path(*fid)
+			}
+
 			return types.Asset{
 				Type:        "asset",
 				ID:          a.ID().String(),
 				URL:         a.AccessInfo().Url,
 				ContentType: f.ContentType(),
 				Files:       files,
+				FolderPath:  folderPath,
+				Metadata:    a.Metadata().Map(metadataSchema),
 				CreatedAt:   &createdAt,
 				UpdatedAt:   &updatedAt,
 				CreatedBy:   createdBy,
@@ -374,60 +495,26 @@ func (i *Asset) Create(ctx context.Context, inp interfaces.CreateAssetParam, op
 		return nil, nil, interfaces.ErrOperationDenied
 	}
 
-	var uuid string
-	var file *file.File
-	if inp.File != nil {
-		if inp.File.ContentEncoding == "gzip" {
-			inp.File.Name = strings.TrimSuffix(inp.File.Name, ".gz")
-		}
-
-		var size int64
-		file = inp.File
-
-		visibility := project.VisibilityPublic
-		if prj.Accessibility() != nil && prj.Accessibility().Visibility() != "" {
-			visibility = prj.Accessibility().Visibility()
+	if inp.Folder != nil {
+		folders, err := i.repos.AssetFolder.FindByProject(ctx, inp.ProjectID)
+		if err != nil {
+			return nil, nil, err
 		}
-
-		var checkType gateway.PolicyCheckType
-		if visibility == project.VisibilityPublic {
-			checkType = gateway.PolicyCheckPublicDataTransferUpload
-		} else {
-			checkType = gateway.PolicyCheckPrivateDataTransferUpload
+		if folders.Folder(*inp.Folder) == nil {
+			return nil, nil, rerror.ErrNotFound
 		}
-
-		if i.gateways != nil && i.gateways.PolicyChecker != nil {
-			policyReq := gateway.PolicyCheckRequest{
-				WorkspaceID: prj.Workspace(),
-				CheckType:   checkType,
-				Value:       file.Size,
-			}
-			policyResp, err := i.gateways.PolicyChecker.CheckPolicy(ctx, policyReq)
-			if err != nil {
-				return nil, nil, rerror.NewE(i18n.T("policy check failed"))
-			}
-			if !policyResp.Allowed {
-				return nil, nil, interfaces.ErrDataTransferUploadSizeLimitExceeded
-			}
-
-			policyReq.CheckType = gateway.PolicyCheckUploadAssetsSize
-
-			policyResp, err = i.gateways.PolicyChecker.CheckPolicy(ctx, policyReq)
-			if err != nil {
-				return nil, nil, rerror.NewE(i18n.T("policy check failed"))
-			}
-			if !policyResp.Allowed {
-				return nil, nil, interfaces.ErrAssetUploadSizeLimitExceeded
-			}
+		if err := checkFolderPermission(op, inp.ProjectID, folders, inp.Folder); err != nil {
+			return nil, nil, err
 		}
+	}
 
-		ctxWithWorkspace := context.WithValue(ctx, contextKey("workspace"), prj.Workspace().String())
-		uuid, size, err = i.gateways.File.UploadAsset(ctxWithWorkspace, inp.File)
+	var uuid string
+	file := inp.File
+	if file != nil {
+		uuid, err = i.uploadFile(ctx, prj, file)
 		if err != nil {
 			return nil, nil, err
 		}
-
-		file.Size = size
 	}
 
 	a, f, err := Run2(
@@ -438,32 +525,14 @@ func (i *Asset) Create(ctx context.Context, inp interfaces.CreateAssetParam, op
 		func(ctx context.Context) (*asset.Asset, *asset.File, error) {
 			if inp.Token != "" {
 				uuid = inp.Token
-				u, err := i.repos.AssetUpload.FindByID(ctx, uuid)
-				if err != nil {
-					return nil, nil, err
-				}
-				if u.Expired(time.Now()) {
-					return nil, nil, rerror.ErrInternalBy(fmt.Errorf("expired upload token: %s", uuid))
-				}
-				file, err = i.gateways.File.UploadedAsset(ctx, u)
+				file, err = i.uploadedFile(ctx, uuid)
 				if err != nil {
 					return nil, nil, err
 				}
 			}
 
-			needDecompress := false
-			if ext := strings.ToLower(path.Ext(file.Name)); ext == ".zip" || ext == ".7z" {
-				needDecompress = true
-			}
-
-			es := lo.ToPtr(asset.ArchiveExtractionStatusDone)
-			if needDecompress {
-				if inp.SkipDecompression {
-					es = lo.ToPtr(asset.ArchiveExtractionStatusSkipped)
-				} else {
-					es = lo.ToPtr(asset.ArchiveExtractionStatusPending)
-				}
-			}
+			needDecompress := needsDecompression(file.Name)
+			es := archiveExtractionStatus(needDecompress, inp.SkipDecompression)
 
 			ab := asset.New().
 				NewID().
@@ -472,7 +541,8 @@ func (i *Asset) Create(ctx context.Context, inp interfaces.CreateAssetParam, op
 				Size(uint64(file.Size)).
 				Type(asset.DetectPreviewType(file)).
 				UUID(uuid).
-				ArchiveExtractionStatus(es)
+				ArchiveExtractionStatus(es).
+				Folder(inp.Folder)
 
 			if op.AcOperator.User != nil {
 				ab.CreatedByUser(*op.AcOperator.User)
@@ -531,6 +601,272 @@ func (i *Asset) Create(ctx context.Context, inp interfaces.CreateAssetParam, op
 	return a, f, nil
 }
 
+// ReplaceFile uploads a new file for the asset while keeping its ID, and the previous file is kept as a version of the asset.
+func (i *Asset) ReplaceFile(ctx context.Context, inp interfaces.ReplaceAssetFileParam, op *usecase.Operator) (*asset.Asset, *asset.File, error) {
+	if op.AcOperator.User == nil && op.Integration == nil {
+		return nil, nil, interfaces.ErrInvalidOperator
+	}
+
+	if inp.File == nil && inp.Token == "" {
+		return nil, nil, interfaces.ErrFileNotIncluded
+	}
+
+	a, prj, err := i.findUpdatableAsset(ctx, inp.AssetID, op)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var uuid string
+	file := inp.File
+	if file != nil {
+		uuid, err = i.uploadFile(ctx, prj, file)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	a, f, err := Run2(
+		ctx, op, i.repos,
+		Usecase().
+			WithPermission(i.authz(), rbac.ResourceAsset, rbac.ActionUpdate, prj.Workspace()).
+			Transaction(),
+		func(ctx context.Context) (*asset.Asset, *asset.File, error) {
+			if inp.Token != "" {
+				uuid = inp.Token
+				file, err = i.uploadedFile(ctx, uuid)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+
+			v := uploadedVersion(asset.NewVersion().
+				UUID(uuid).
+				FileName(path.Base(file.Name)).
+				Size(uint64(file.Size)).
+				ContentType(file.ContentType).
+				ContentEncoding(file.ContentEncoding).
+				PreviewType(asset.DetectPreviewType(file)), op)
+
+			return i.replaceFile(ctx, a, v, inp.SkipDecompression, op)
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := i.assetUpdateEvent(ctx, prj, a, op); err != nil {
+		return nil, nil, err
+	}
+
+	return a, f, nil
+}
+
+// RestoreVersion makes the file of the version the current file of the asset again. The current file is kept as a version of the asset.
+func (i *Asset) RestoreVersion(ctx context.Context, aid id.AssetID, uuid string, op *usecase.Operator) (*asset.Asset, *asset.File, error) {
+	if op.AcOperator.User == nil && op.Integration == nil {
+		return nil, nil, interfaces.ErrInvalidOperator
+	}
+
+	a, prj, err := i.findUpdatableAsset(ctx, aid, op)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a, f, err := Run2(
+		ctx, op, i.repos,
+		Usecase().
+			WithPermission(i.authz(), rbac.ResourceAsset, rbac.ActionUpdate, prj.Workspace()).
+			Transaction(),
+		func(ctx context.Context) (*asset.Asset, *asset.File, error) {
+			if uuid == a.UUID() {
+				return nil, nil, asset.ErrCurrentVersion
+			}
+			old := a.Version(uuid)
+			if old == nil {
+				return nil, nil, rerror.ErrNotFound
+			}
+
+			v := uploadedVersion(asset.NewVersion().
+				UUID(old.UUID()).
+				FileName(old.FileName()).
+				Size(old.Size()).
+				ContentType(old.ContentType()).
+				ContentEncoding(old.ContentEncoding()).
+				PreviewType(old.PreviewType()), op)
+
+			return i.replaceFile(ctx, a, v, false, op)
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := i.assetUpdateEvent(ctx, prj, a, op); err != nil {
+		return nil, nil, err
+	}
+
+	return a, f, nil
+}
+
+func (i *Asset) findUpdatableAsset(ctx context.Context, aid id.AssetID, op *usecase.Operator) (*asset.Asset, *project.Project, error) {
+	a, err := i.repos.Asset.FindByID(ctx, aid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prj, err := i.repos.Project.FindByID(ctx, a.Project())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !op.CanUpdate(a) {
+		return nil, nil, interfaces.ErrOperationDenied
+	}
+
+	if err := i.checkFolderPermission(ctx, op, asset.List{a}); err != nil {
+		return nil, nil, err
+	}
+
+	return a, prj, nil
+}
+
+// replaceFile replaces the file of the asset with the version and re-runs the decompression of the new file if needed.
+func (i *Asset) replaceFile(ctx context.Context, a *asset.Asset, v *asset.Version, skipDecompression bool, op *usecase.Operator) (*asset.Asset, *asset.File, error) {
+	needDecompress := needsDecompression(v.FileName())
+
+	a.ReplaceFile(v)
+	a.UpdateArchiveExtractionStatus(archiveExtractionStatus(needDecompress, skipDecompression))
+	if op.AcOperator.User != nil {
+		a.SetUpdatedByUser(*op.AcOperator.User)
+	} else if op.Integration != nil {
+		a.SetUpdatedByIntegration(*op.Integration)
+	}
+	a.SetAccessInfoResolver(i.gateways.File.GetAccessInfoResolver())
+
+	f := asset.NewFile().
+		Name(v.FileName()).
+		Path(v.FileName()).
+		Size(v.Size()).
+		ContentType(v.ContentType()).
+		GuessContentTypeIfEmpty().
+		ContentEncoding(v.ContentEncoding()).
+		Build()
+
+	if err := i.repos.Asset.Save(ctx, a); err != nil {
+		return nil, nil, err
+	}
+
+	if err := i.repos.AssetFile.Save(ctx, a.ID(), f); err != nil {
+		return nil, nil, err
+	}
+
+	if needDecompress && !skipDecompression {
+		if err := i.triggerDecompressEvent(ctx, a, f); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return a, f, nil
+}
+
+func (i *Asset) assetUpdateEvent(ctx context.Context, prj *project.Project, a *asset.Asset, op *usecase.Operator) error {
+	return i.event(ctx, Event{
+		Project:   prj,
+		Workspace: prj.Workspace(),
+		Type:      event.AssetUpdate,
+		Object:    a,
+		Operator:  op.Operator(),
+	})
+}
+
+// uploadFile checks the policies of the workspace and uploads the file to the storage, and then returns the UUID of the file.
+func (i *Asset) uploadFile(ctx context.Context, prj *project.Project, file *file.File) (string, error) {
+	if file.ContentEncoding == "gzip" {
+		file.Name = strings.TrimSuffix(file.Name, ".gz")
+	}
+
+	visibility := project.VisibilityPublic
+	if prj.Accessibility() != nil && prj.Accessibility().Visibility() != "" {
+		visibility = prj.Accessibility().Visibility()
+	}
+
+	var checkType gateway.PolicyCheckType
+	if visibility == project.VisibilityPublic {
+		checkType = gateway.PolicyCheckPublicDataTransferUpload
+	} else {
+		checkType = gateway.PolicyCheckPrivateDataTransferUpload
+	}
+
+	if i.gateways != nil && i.gateways.PolicyChecker != nil {
+		policyReq := gateway.PolicyCheckRequest{
+			WorkspaceID: prj.Workspace(),
+			CheckType:   checkType,
+			Value:       file.Size,
+		}
+		policyResp, err := i.gateways.PolicyChecker.CheckPolicy(ctx, policyReq)
+		if err != nil {
+			return "", rerror.NewE(i18n.T("policy check failed"))
+		}
+		if !policyResp.Allowed {
+			return "", interfaces.ErrDataTransferUploadSizeLimitExceeded
+		}
+
+		policyReq.CheckType = gateway.PolicyCheckUploadAssetsSize
+
+		policyResp, err = i.gateways.PolicyChecker.CheckPolicy(ctx, policyReq)
+		if err != nil {
+			return "", rerror.NewE(i18n.T("policy check failed"))
+		}
+		if !policyResp.Allowed {
+			return "", interfaces.ErrAssetUploadSizeLimitExceeded
+		}
+	}
+
+	ctxWithWorkspace := context.WithValue(ctx, contextKey("workspace"), prj.Workspace().String())
+	uuid, size, err := i.gateways.File.UploadAsset(ctxWithWorkspace, file)
+	if err != nil {
+		return "", err
+	}
+
+	file.Size = size
+	return uuid, nil
+}
+
+// uploadedFile returns the file which has been uploaded with the upload token.
+func (i *Asset) uploadedFile(ctx context.Context, token string) (*file.File, error) {
+	u, err := i.repos.AssetUpload.FindByID(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if u.Expired(time.Now()) {
+		return nil, rerror.ErrInternalBy(fmt.Errorf("expired upload token: %s", token))
+	}
+	return i.gateways.File.UploadedAsset(ctx, u)
+}
+
+func uploadedVersion(b *asset.VersionBuilder, op *usecase.Operator) *asset.Version {
+	b = b.UploadedAt(util.Now())
+	if op.AcOperator.User != nil {
+		b = b.UploadedByUser(op.AcOperator.User.CloneRef())
+	} else if op.Integration != nil {
+		b = b.UploadedByIntegration(op.Integration.CloneRef())
+	}
+	return b.Build()
+}
+
+func needsDecompression(name string) bool {
+	ext := strings.ToLower(path.Ext(name))
+	return ext == ".zip" || ext == ".7z"
+}
+
+func archiveExtractionStatus(needDecompress, skipDecompression bool) *asset.ArchiveExtractionStatus {
+	if !needDecompress {
+		return lo.ToPtr(asset.ArchiveExtractionStatusDone)
+	}
+	if skipDecompression {
+		return lo.ToPtr(asset.ArchiveExtractionStatusSkipped)
+	}
+	return lo.ToPtr(asset.ArchiveExtractionStatusPending)
+}
+
 func (i *Asset) Decompress(ctx context.Context, aId id.AssetID, operator *usecase.Operator) (*asset.Asset, error) {
 	if operator.AcOperator.User == nil && operator.Integration == nil {
 		return nil, interfaces.ErrInvalidOperator
@@ -880,10 +1216,29 @@ func (i *Asset) Update(ctx context.Context, inp interfaces.UpdateAssetParam, ope
 				return nil, interfaces.ErrOperationDenied
 			}
 
+			if err := i.checkFolderPermission(ctx, operator, asset.List{a}); err != nil {
+				return nil, err
+			}
+
 			if inp.PreviewType != nil {
 				a.UpdatePreviewType(inp.PreviewType)
 			}
 
+			if inp.Metadata != nil {
+				s, err := i.metadataSchema(ctx, a.Project())
+				if err != nil {
+					return nil, err
+				}
+				if s == nil {
+					return nil, interfaces.ErrAssetMetadataSchemaNotFound
+				}
+				m, err := assetMetadataFromParams(inp.Metadata, s)
+				if err != nil {
+					return nil, err
+				}
+				a.SetMetadata(a.Metadata().Merge(m))
+			}
+
 			if operator.AcOperator.User != nil {
 				a.SetUpdatedByUser(*operator.AcOperator.User)
 			} else if operator.Integration != nil {
@@ -1072,11 +1427,17 @@ func (i *Asset) Delete(ctx context.Context, aId id.AssetID, operator *usecase.Op
 			return aId, interfaces.ErrOperationDenied
 		}
 
-		uuid := a.UUID()
-		filename := a.FileName()
-		if uuid != "" && filename != "" {
-			if err := i.gateways.File.DeleteAsset(ctx, uuid, filename); err != nil {
-				return aId, err
+		if err := i.checkFolderPermission(ctx, operator, asset.List{a}); err != nil {
+			return aId, err
+		}
+
+		// the files of the versions are deleted as well
+		for _, uuid := range a.UUIDs() {
+			filename := a.Version(uuid).FileName()
+			if uuid != "" && filename != "" {
+				if err := i.gateways.File.DeleteAsset(ctx, uuid, filename); err != nil {
+					return aId, err
+				}
 			}
 		}
 
@@ -1132,11 +1493,15 @@ func (i *Asset) BatchDelete(ctx context.Context, assetIDs id.AssetIDList, operat
 			return assetIDs, err
 		}
 
-		UUIDList := lo.FilterMap(assets, func(a *asset.Asset, _ int) (string, bool) {
+		if err := i.checkFolderPermission(ctx, operator, assets); err != nil {
+			return assetIDs, err
+		}
+
+		UUIDList := lo.FlatMap(assets, func(a *asset.Asset, _ int) []string {
 			if a == nil || a.UUID() == "" || a.FileName() == "" {
-				return "", false
+				return nil
 			}
-			return a.UUID(), true
+			return a.UUIDs()
 		})
 
 		if err := i.gateways.File.DeleteAssets(ctx, UUIDList); err != nil {
@@ -1151,6 +1516,100 @@ func (i *Asset) BatchDelete(ctx context.Context, assetIDs id.AssetIDList, operat
 	})
 }
 
+// Move moves the assets into the folder. All assets must be in the same project as the folder.
+func (i *Asset) Move(ctx context.Context, inp interfaces.MoveAssetsParam, operator *usecase.Operator) (asset.List, error) {
+	if !operator.IsUserOrIntegration() {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	if len(inp.AssetIDs) == 0 {
+		return nil, interfaces.ErrEmptyIDsList
+	}
+
+	return Run1(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) (asset.List, error) {
+		assets, err := i.repos.Asset.FindByIDs(ctx, inp.AssetIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(inp.AssetIDs) != len(assets) {
+			return nil, interfaces.ErrPartialNotFound
+		}
+
+		projects := assets.Projects()
+		if len(projects) != 1 {
+			return nil, interfaces.ErrOperationDenied
+		}
+		pid := projects[0]
+
+		if err := i.checkPermissions(ctx, rbac.ActionUpdate, projects); err != nil {
+			return nil, err
+		}
+
+		if !lo.EveryBy(assets, func(a *asset.Asset) bool { return operator.CanUpdate(a) }) {
+			return nil, interfaces.ErrOperationDenied
+		}
+
+		folders, err := i.repos.AssetFolder.FindByProject(ctx, pid)
+		if err != nil {
+			return nil, err
+		}
+
+		if inp.Folder != nil && folders.Folder(*inp.Folder) == nil {
+			return nil, rerror.ErrNotFound
+		}
+
+		// both of the source and destination folders must be writable by the operator
+		for _, a := range assets {
+			if err := checkFolderPermission(operator, pid, folders, a.Folder()); err != nil {
+				return nil, err
+			}
+		}
+		if err := checkFolderPermission(operator, pid, folders, inp.Folder); err != nil {
+			return nil, err
+		}
+
+		for _, a := range assets {
+			a.MoveToFolder(inp.Folder)
+			if operator.AcOperator.User != nil {
+				a.SetUpdatedByUser(*operator.AcOperator.User)
+			} else if operator.Integration != nil {
+				a.SetUpdatedByIntegration(*operator.Integration)
+			}
+
+			if err := i.repos.Asset.Save(ctx, a); err != nil {
+				return nil, err
+			}
+		}
+
+		assets.SetAccessInfoResolver(i.gateways.File.GetAccessInfoResolver())
+		return assets, nil
+	})
+}
+
+// checkFolderPermission checks the permissions of the folders which the assets are in.
+func (i *Asset) checkFolderPermission(ctx context.Context, operator *usecase.Operator, assets asset.List) error {
+	if !lo.SomeBy(assets, func(a *asset.Asset) bool { return a.Folder() != nil }) {
+		return nil
+	}
+
+	for _, pid := range assets.Projects() {
+		folders, err := i.repos.AssetFolder.FindByProject(ctx, pid)
+		if err != nil {
+			return err
+		}
+		for _, a := range assets {
+			if a.Project() != pid {
+				continue
+			}
+			if err := checkFolderPermission(operator, pid, folders, a.Folder()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (i *Asset) event(ctx context.Context, e Event) error {
 	if i.ignoreEvent {
 		return nil
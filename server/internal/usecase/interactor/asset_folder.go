@@ -0,0 +1,288 @@
+package interactor
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/rbac"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+)
+
+type AssetFolder struct {
+	repos    *repo.Container
+	gateways *gateway.Container
+}
+
+func NewAssetFolder(r *repo.Container, g *gateway.Container) interfaces.AssetFolder {
+	return &AssetFolder{
+		repos:    r,
+		gateways: g,
+	}
+}
+
+// checkPermissions enforces a Cerbos check on the asset resource for the workspaces
+// owning the given projects, as folders are a part of the assets of a project.
+func (i *AssetFolder) checkPermissions(ctx context.Context, action rbac.Action, projectIDs id.ProjectIDList) error {
+	if len(projectIDs) == 0 {
+		return nil
+	}
+	projects, err := i.repos.Project.FindByIDs(ctx, projectIDs)
+	if err != nil {
+		return err
+	}
+	return doCheckPermission(ctx, i.gateways, rbac.ResourceAsset, action, lo.Uniq(projects.Workspaces())...)
+}
+
+func (i *AssetFolder) FindByID(ctx context.Context, fid id.AssetFolderID, _ *usecase.Operator) (*asset.Folder, error) {
+	f, err := i.repos.AssetFolder.FindByID(ctx, fid)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.checkPermissions(ctx, rbac.ActionRead, id.ProjectIDList{f.Project()}); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (i *AssetFolder) FindByIDs(ctx context.Context, ids id.AssetFolderIDList, _ *usecase.Operator) (asset.FolderList, error) {
+	l, err := i.repos.AssetFolder.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	projects := lo.Uniq(lo.Map(l, func(f *asset.Folder, _ int) id.ProjectID { return f.Project() }))
+	if err := i.checkPermissions(ctx, rbac.ActionList, projects); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (i *AssetFolder) FindByProject(ctx context.Context, pid id.ProjectID, _ *usecase.Operator) (asset.FolderList, error) {
+	if err := i.checkPermissions(ctx, rbac.ActionList, id.ProjectIDList{pid}); err != nil {
+		return nil, err
+	}
+	return i.repos.AssetFolder.FindByProject(ctx, pid)
+}
+
+func (i *AssetFolder) Create(ctx context.Context, param interfaces.CreateAssetFolderParam, op *usecase.Operator) (*asset.Folder, error) {
+	if !op.IsUserOrIntegration() {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (*asset.Folder, error) {
+		if !op.IsWritableProject(param.ProjectID) {
+			return nil, interfaces.ErrOperationDenied
+		}
+		if err := i.checkPermissions(ctx, rbac.ActionCreate, id.ProjectIDList{param.ProjectID}); err != nil {
+			return nil, err
+		}
+
+		folders, err := i.repos.AssetFolder.FindByProject(ctx, param.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		if param.Parent != nil {
+			if folders.Folder(*param.Parent) == nil {
+				return nil, rerror.ErrNotFound
+			}
+			if err := checkFolderPermission(op, param.ProjectID, folders, param.Parent); err != nil {
+				return nil, err
+			}
+		}
+		if param.Permission != nil && !canChangeFolder(op, param.ProjectID, *param.Permission) {
+			return nil, interfaces.ErrFolderPermissionDenied
+		}
+		if folders.HasName(param.Parent, param.Name, nil) {
+			return nil, asset.ErrDuplicatedFolderName
+		}
+
+		b := asset.NewFolder().
+			NewID().
+			Project(param.ProjectID).
+			Parent(param.Parent).
+			Name(param.Name).
+			Permission(param.Permission)
+		if op.AcOperator.User != nil {
+			b = b.CreatedByUser(*op.AcOperator.User)
+		} else if op.Integration != nil {
+			b = b.CreatedByIntegration(*op.Integration)
+		}
+
+		f, err := b.Build()
+		if err != nil {
+			return nil, err
+		}
+		if err := i.repos.AssetFolder.Save(ctx, f); err != nil {
+			return nil, err
+		}
+		return f, nil
+	})
+}
+
+func (i *AssetFolder) Update(ctx context.Context, param interfaces.UpdateAssetFolderParam, op *usecase.Operator) (*asset.Folder, error) {
+	if !op.IsUserOrIntegration() {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (*asset.Folder, error) {
+		f, folders, err := i.findWithSiblings(ctx, param.FolderID, op)
+		if err != nil {
+			return nil, err
+		}
+
+		if param.Name != nil {
+			if folders.HasName(f.Parent(), *param.Name, f.ID().Ref()) {
+				return nil, asset.ErrDuplicatedFolderName
+			}
+			if err := f.Rename(*param.Name); err != nil {
+				return nil, err
+			}
+		}
+
+		if param.Permission != nil || param.InheritPermission {
+			// changing the permission of a folder is limited to maintainers so that writers can not lock the others out
+			if !op.IsMaintainingProject(f.Project()) {
+				return nil, interfaces.ErrFolderPermissionDenied
+			}
+			if param.Permission != nil && !canChangeFolder(op, f.Project(), *param.Permission) {
+				return nil, interfaces.ErrFolderPermissionDenied
+			}
+			if param.InheritPermission {
+				f.SetPermission(nil)
+			} else {
+				f.SetPermission(param.Permission)
+			}
+		}
+
+		if err := i.repos.AssetFolder.Save(ctx, f); err != nil {
+			return nil, err
+		}
+		return f, nil
+	})
+}
+
+func (i *AssetFolder) Move(ctx context.Context, fid id.AssetFolderID, parent *id.AssetFolderID, op *usecase.Operator) (*asset.Folder, error) {
+	if !op.IsUserOrIntegration() {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (*asset.Folder, error) {
+		f, folders, err := i.findWithSiblings(ctx, fid, op)
+		if err != nil {
+			return nil, err
+		}
+
+		if parent != nil {
+			if folders.Folder(*parent) == nil {
+				return nil, rerror.ErrNotFound
+			}
+			if err := checkFolderPermission(op, f.Project(), folders, parent); err != nil {
+				return nil, err
+			}
+		}
+		if !folders.CanMove(fid, parent) {
+			return nil, asset.ErrInvalidFolderParent
+		}
+		if folders.HasName(parent, f.Name(), f.ID().Ref()) {
+			return nil, asset.ErrDuplicatedFolderName
+		}
+
+		if err := f.Move(parent); err != nil {
+			return nil, err
+		}
+		if err := i.repos.AssetFolder.Save(ctx, f); err != nil {
+			return nil, err
+		}
+		return f, nil
+	})
+}
+
+func (i *AssetFolder) Delete(ctx context.Context, fid id.AssetFolderID, op *usecase.Operator) (id.AssetFolderID, error) {
+	if !op.IsUserOrIntegration() {
+		return fid, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (id.AssetFolderID, error) {
+		f, folders, err := i.findWithSiblings(ctx, fid, op)
+		if err != nil {
+			return fid, err
+		}
+		if err := i.checkPermissions(ctx, rbac.ActionDelete, id.ProjectIDList{f.Project()}); err != nil {
+			return fid, err
+		}
+
+		if len(folders.Children(f.ID().Ref())) > 0 {
+			return fid, asset.ErrFolderNotEmpty
+		}
+		assets, _, err := i.repos.Asset.Search(ctx, f.Project(), repo.AssetFilter{
+			Folders:    id.AssetFolderIDList{fid},
+			Pagination: usecasex.CursorPagination{First: lo.ToPtr(int64(1))}.Wrap(),
+		})
+		if err != nil {
+			return fid, err
+		}
+		if len(assets) > 0 {
+			return fid, asset.ErrFolderNotEmpty
+		}
+
+		if err := i.repos.AssetFolder.Remove(ctx, fid); err != nil {
+			return fid, err
+		}
+		return fid, nil
+	})
+}
+
+// findWithSiblings returns the folder and all folders of its project after checking that the operator can change the folder.
+func (i *AssetFolder) findWithSiblings(ctx context.Context, fid id.AssetFolderID, op *usecase.Operator) (*asset.Folder, asset.FolderList, error) {
+	f, err := i.repos.AssetFolder.FindByID(ctx, fid)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !op.IsWritableProject(f.Project()) {
+		return nil, nil, interfaces.ErrOperationDenied
+	}
+	if err := i.checkPermissions(ctx, rbac.ActionUpdate, id.ProjectIDList{f.Project()}); err != nil {
+		return nil, nil, err
+	}
+
+	folders, err := i.repos.AssetFolder.FindByProject(ctx, f.Project())
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := checkFolderPermission(op, f.Project(), folders, f.ID().Ref()); err != nil {
+		return nil, nil, err
+	}
+	return f, folders, nil
+}
+
+// checkFolderPermission checks the permission of the folder, which is inherited from its ancestors.
+// Nothing is checked for the root of the project.
+func checkFolderPermission(op *usecase.Operator, pid id.ProjectID, folders asset.FolderList, fid *id.AssetFolderID) error {
+	if fid == nil {
+		return nil
+	}
+	if !canChangeFolder(op, pid, folders.Permission(*fid)) {
+		return interfaces.ErrFolderPermissionDenied
+	}
+	return nil
+}
+
+func canChangeFolder(op *usecase.Operator, pid id.ProjectID, p asset.FolderPermission) bool {
+	if op.Machine {
+		return true
+	}
+	switch p {
+	case asset.FolderPermissionOwner:
+		return op.IsOwningProject(pid)
+	case asset.FolderPermissionMaintainer:
+		return op.IsMaintainingProject(pid)
+	default:
+		return op.IsWritableProject(pid)
+	}
+}
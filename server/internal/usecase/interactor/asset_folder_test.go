@@ -0,0 +1,111 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/fs"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountdomain/workspace"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssetFolder(t *testing.T) {
+	ctx := context.Background()
+	g := gateway.Container{
+		File: lo.Must(fs.NewFile(afero.NewMemMapFs(), "", false)),
+	}
+	uid := accountdomain.NewUserID()
+	ws := workspace.New().NewID().MustBuild()
+	pid := id.NewProjectID()
+	p := project.New().ID(pid).Workspace(ws.ID()).MustBuild()
+
+	owner := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			User:             &uid,
+			OwningWorkspaces: []accountdomain.WorkspaceID{ws.ID()},
+		},
+		OwningProjects: []id.ProjectID{pid},
+	}
+	writer := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			User:               &uid,
+			WritableWorkspaces: []accountdomain.WorkspaceID{ws.ID()},
+		},
+		WritableProjects: []id.ProjectID{pid},
+	}
+
+	db := memory.New()
+	assert.NoError(t, db.Project.Save(ctx, p))
+	a1 := asset.New().NewID().Project(pid).NewUUID().CreatedByUser(uid).Size(1).Thread(id.NewThreadID().Ref()).MustBuild()
+	a2 := asset.New().NewID().Project(pid).NewUUID().CreatedByUser(uid).Size(1).Thread(id.NewThreadID().Ref()).MustBuild()
+	assert.NoError(t, db.Asset.Save(ctx, a1))
+	assert.NoError(t, db.Asset.Save(ctx, a2))
+
+	folderUC := NewAssetFolder(db, &g)
+	assetUC := NewAsset(db, &g)
+
+	// create
+	images, err := folderUC.Create(ctx, interfaces.CreateAssetFolderParam{ProjectID: pid, Name: "images"}, writer)
+	assert.NoError(t, err)
+	icons, err := folderUC.Create(ctx, interfaces.CreateAssetFolderParam{ProjectID: pid, Parent: images.ID().Ref(), Name: "icons"}, writer)
+	assert.NoError(t, err)
+	_, err = folderUC.Create(ctx, interfaces.CreateAssetFolderParam{ProjectID: pid, Name: "Images"}, writer)
+	assert.ErrorIs(t, err, asset.ErrDuplicatedFolderName)
+	_, err = folderUC.Create(ctx, interfaces.CreateAssetFolderParam{ProjectID: pid, Parent: id.NewAssetFolderID().Ref(), Name: "x"}, writer)
+	assert.Error(t, err)
+
+	// move
+	_, err = folderUC.Move(ctx, images.ID(), icons.ID().Ref(), writer)
+	assert.ErrorIs(t, err, asset.ErrInvalidFolderParent)
+
+	// permission is inherited from the parent
+	_, err = folderUC.Update(ctx, interfaces.UpdateAssetFolderParam{FolderID: images.ID(), Permission: new(asset.FolderPermissionOwner)}, writer)
+	assert.ErrorIs(t, err, interfaces.ErrFolderPermissionDenied)
+	images, err = folderUC.Update(ctx, interfaces.UpdateAssetFolderParam{FolderID: images.ID(), Name: new("pictures"), Permission: new(asset.FolderPermissionOwner)}, owner)
+	assert.NoError(t, err)
+	assert.Equal(t, "pictures", images.Name())
+	_, err = folderUC.Update(ctx, interfaces.UpdateAssetFolderParam{FolderID: icons.ID(), Name: new("logos")}, writer)
+	assert.ErrorIs(t, err, interfaces.ErrFolderPermissionDenied)
+
+	// assets
+	_, err = assetUC.Move(ctx, interfaces.MoveAssetsParam{AssetIDs: id.AssetIDList{a1.ID()}, Folder: icons.ID().Ref()}, writer)
+	assert.ErrorIs(t, err, interfaces.ErrFolderPermissionDenied)
+	moved, err := assetUC.Move(ctx, interfaces.MoveAssetsParam{AssetIDs: id.AssetIDList{a1.ID()}, Folder: icons.ID().Ref()}, owner)
+	assert.NoError(t, err)
+	assert.Equal(t, icons.ID().Ref(), moved[0].Folder())
+
+	got, _, err := assetUC.Search(ctx, pid, interfaces.AssetFilter{Folder: images.ID().Ref()}, writer)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+	got, _, err = assetUC.Search(ctx, pid, interfaces.AssetFilter{Folder: images.ID().Ref(), Recursive: true}, writer)
+	assert.NoError(t, err)
+	assert.Equal(t, id.AssetIDList{a1.ID()}, got.IDs())
+	got, _, err = assetUC.Search(ctx, pid, interfaces.AssetFilter{RootFolder: true}, writer)
+	assert.NoError(t, err)
+	assert.Equal(t, id.AssetIDList{a2.ID()}, got.IDs())
+
+	// delete
+	_, err = folderUC.Delete(ctx, icons.ID(), owner)
+	assert.ErrorIs(t, err, asset.ErrFolderNotEmpty)
+	_, err = assetUC.Move(ctx, interfaces.MoveAssetsParam{AssetIDs: id.AssetIDList{a1.ID()}}, owner)
+	assert.NoError(t, err)
+	_, err = folderUC.Delete(ctx, images.ID(), owner)
+	assert.ErrorIs(t, err, asset.ErrFolderNotEmpty)
+	_, err = folderUC.Delete(ctx, icons.ID(), owner)
+	assert.NoError(t, err)
+
+	folders, err := folderUC.FindByProject(ctx, pid, writer)
+	assert.NoError(t, err)
+	assert.Equal(t, asset.FolderIDList{images.ID()}, folders.IDs())
+}
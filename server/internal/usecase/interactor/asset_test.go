@@ -20,6 +20,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/file"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/task"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/account/accountdomain/user"
@@ -1115,6 +1116,158 @@ func TestAsset_Update(t *testing.T) {
 	}
 }
 
+func TestAsset_UpdateMetadata(t *testing.T) {
+	ctx := context.Background()
+	g := gateway.Container{
+		File: lo.Must(fs.NewFile(afero.NewMemMapFs(), "", false)),
+	}
+	uid := accountdomain.NewUserID()
+	ws := workspace.New().NewID().MustBuild()
+	op := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			User:               &uid,
+			WritableWorkspaces: []accountdomain.WorkspaceID{ws.ID()},
+		},
+		WritableProjects: []id.ProjectID{},
+	}
+
+	sfAlt := schema.NewField(schema.NewText(new(20)).TypeProperty()).NewID().Key(id.NewKey("alt")).MustBuild()
+	sfTags := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("tags")).Multiple(true).MustBuild()
+	pid := id.NewProjectID()
+	s := schema.New().NewID().Workspace(ws.ID()).Project(pid).Fields(schema.FieldList{sfAlt, sfTags}).MustBuild()
+	sid := s.ID()
+	p := project.New().ID(pid).Workspace(ws.ID()).AssetMetadataSchema(&sid).MustBuild()
+	op.WritableProjects = append(op.WritableProjects, pid)
+
+	a1 := asset.New().NewID().Project(pid).NewUUID().CreatedByUser(uid).Size(1).Thread(id.NewThreadID().Ref()).MustBuild()
+	a2 := asset.New().NewID().Project(pid).NewUUID().CreatedByUser(uid).Size(1).Thread(id.NewThreadID().Ref()).MustBuild()
+
+	db := memory.New()
+	assert.NoError(t, db.Project.Save(ctx, p))
+	assert.NoError(t, db.Schema.Save(ctx, s))
+	assert.NoError(t, db.Asset.Save(ctx, a1))
+	assert.NoError(t, db.Asset.Save(ctx, a2))
+	uc := NewAsset(db, &g)
+
+	got, err := uc.Update(ctx, interfaces.UpdateAssetParam{
+		AssetID: a1.ID(),
+		Metadata: []interfaces.AssetMetadataFieldParam{
+			{Key: new(id.NewKey("alt")), Value: "a cat"},
+			{Field: new(sfTags.ID()), Value: []any{"animal", "cat"}},
+		},
+	}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"alt": "a cat", "tags": []any{"animal", "cat"}}, got.Metadata().Map(s))
+
+	// the other fields are kept
+	got, err = uc.Update(ctx, interfaces.UpdateAssetParam{
+		AssetID:  a1.ID(),
+		Metadata: []interfaces.AssetMetadataFieldParam{{Key: new(id.NewKey("alt")), Value: nil}},
+	}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"tags": []any{"animal", "cat"}}, got.Metadata().Map(s))
+
+	_, err = uc.Update(ctx, interfaces.UpdateAssetParam{
+		AssetID:  a1.ID(),
+		Metadata: []interfaces.AssetMetadataFieldParam{{Key: new(id.NewKey("alt")), Value: "this text is too long for the field"}},
+	}, op)
+	assert.Error(t, err)
+	_, err = uc.Update(ctx, interfaces.UpdateAssetParam{
+		AssetID:  a1.ID(),
+		Metadata: []interfaces.AssetMetadataFieldParam{{Key: new(id.NewKey("license")), Value: "MIT"}},
+	}, op)
+	assert.ErrorIs(t, err, interfaces.ErrInvalidField)
+
+	res, _, err := uc.Search(ctx, pid, interfaces.AssetFilter{
+		Metadata: []interfaces.AssetMetadataFieldParam{{Key: new(id.NewKey("tags")), Value: "cat"}},
+	}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, id.AssetIDList{a1.ID()}, res.IDs())
+
+	ms, err := uc.FindMetadataSchema(ctx, pid, op)
+	assert.NoError(t, err)
+	assert.Equal(t, s.ID(), ms.ID())
+}
+
+func TestAsset_ReplaceFile(t *testing.T) {
+	ctx := context.Background()
+	uid := accountdomain.NewUserID()
+	ws := workspace.New().NewID().MustBuild()
+	pid := id.NewProjectID()
+	p := project.New().ID(pid).Workspace(ws.ID()).MustBuild()
+	op := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			User:               &uid,
+			WritableWorkspaces: []accountdomain.WorkspaceID{ws.ID()},
+		},
+		WritableProjects: []id.ProjectID{pid},
+	}
+
+	db := memory.New()
+	assert.NoError(t, db.Project.Save(ctx, p))
+	uc := Asset{
+		repos: db,
+		gateways: &gateway.Container{
+			File: lo.Must(fs.NewFile(afero.NewMemMapFs(), "", false)),
+		},
+		ignoreEvent: true,
+	}
+
+	a, _, err := uc.Create(ctx, interfaces.CreateAssetParam{
+		ProjectID: pid,
+		File:      &file.File{Name: "a.txt", Content: io.NopCloser(bytes.NewBufferString("Hello")), Size: 5},
+	}, op)
+	assert.NoError(t, err)
+	uuid1 := a.UUID()
+
+	_, _, err = uc.ReplaceFile(ctx, interfaces.ReplaceAssetFileParam{AssetID: a.ID()}, op)
+	assert.Equal(t, interfaces.ErrFileNotIncluded, err)
+
+	got, gotFile, err := uc.ReplaceFile(ctx, interfaces.ReplaceAssetFileParam{
+		AssetID: a.ID(),
+		File:    &file.File{Name: "b.txt", Content: io.NopCloser(bytes.NewBufferString("Hello, world")), Size: 12},
+	}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, a.ID(), got.ID())
+	assert.NotEqual(t, uuid1, got.UUID())
+	assert.Equal(t, "b.txt", got.FileName())
+	assert.Equal(t, uint64(12), got.Size())
+	assert.Equal(t, "b.txt", gotFile.Name())
+	assert.Equal(t, &uid, got.UpdatedByUser())
+	assert.Equal(t, []string{uuid1, got.UUID()}, got.UUIDs())
+
+	dbGot, err := db.Asset.FindByID(ctx, a.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, got.UUID(), dbGot.UUID())
+	assert.Len(t, dbGot.Versions(), 2)
+
+	// restore
+	_, _, err = uc.RestoreVersion(ctx, a.ID(), got.UUID(), op)
+	assert.Equal(t, asset.ErrCurrentVersion, err)
+	_, _, err = uc.RestoreVersion(ctx, a.ID(), "xxx", op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	got, gotFile, err = uc.RestoreVersion(ctx, a.ID(), uuid1, op)
+	assert.NoError(t, err)
+	assert.Equal(t, uuid1, got.UUID())
+	assert.Equal(t, "a.txt", got.FileName())
+	assert.Equal(t, uint64(5), got.Size())
+	assert.Equal(t, "a.txt", gotFile.Name())
+	assert.Len(t, got.Versions(), 3)
+
+	// operator without permission
+	uid2 := accountdomain.NewUserID()
+	op2 := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			User:               &uid2,
+			ReadableWorkspaces: []accountdomain.WorkspaceID{ws.ID()},
+		},
+		ReadableProjects: []id.ProjectID{pid},
+	}
+	_, _, err = uc.RestoreVersion(ctx, a.ID(), uuid1, op2)
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+}
+
 func TestAsset_UpdateFiles(t *testing.T) {
 	uid := accountdomain.NewUserID()
 	assetID1, uuid1 := asset.NewID(), "5130c89f-8f67-4766-b127-49ee6796d464"
@@ -2,14 +2,21 @@ package interactor
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
 	"github.com/reearth/reearth-cms/server/pkg/operator"
 	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/task"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/account/accountdomain/workspace"
@@ -23,6 +30,7 @@ import (
 type ContainerConfig struct {
 	SignupSecret    string
 	AuthSrvUIDomain string
+	TrashRetention  time.Duration
 }
 
 func New(r *repo.Container, g *gateway.Container,
@@ -30,10 +38,11 @@ func New(r *repo.Container, g *gateway.Container,
 	config ContainerConfig) interfaces.Container {
 	return interfaces.Container{
 		Asset:             NewAsset(r, g),
+		AssetFolder:       NewAssetFolder(r, g),
 		Workspace:         accountinteractor.NewWorkspace(ar, nil),
 		User:              accountinteractor.NewMultiUser(ar, ag, config.SignupSecret, config.AuthSrvUIDomain, ar.Users),
 		Project:           NewProject(r, g),
-		Item:              NewItem(r, g),
+		Item:              NewItem(r, g).WithTrashRetention(config.TrashRetention),
 		View:              NewView(r, g),
 		Request:           NewRequest(r, g),
 		Model:             NewModel(r, g),
@@ -43,6 +52,8 @@ func New(r *repo.Container, g *gateway.Container,
 		Group:             NewGroup(r, g),
 		WorkspaceSettings: NewWorkspaceSettings(r, g),
 		Job:               NewJob(r, g),
+		Schedule:          NewSchedule(r, g),
+		Notification:      NewNotification(r, g),
 	}
 }
 
@@ -53,6 +64,10 @@ type Event struct {
 	Operator      operator.Operator
 	Object        any
 	WebhookObject any
+	// Models and Groups are the models and groups which the event is about.
+	// When both are empty, they are taken from the webhook object.
+	Models id.ModelIDList
+	Groups id.GroupIDList
 }
 
 func (e *Event) EventProject() *event.Project {
@@ -65,6 +80,37 @@ func (e *Event) EventProject() *event.Project {
 	}
 }
 
+// WebhookScope returns the models and groups which the webhooks of the event are filtered by.
+func (e *Event) WebhookScope() integration.WebhookScope {
+	if len(e.Models) > 0 || len(e.Groups) > 0 {
+		return integration.WebhookScope{Models: e.Models, Groups: e.Groups}
+	}
+
+	obj := e.WebhookObject
+	if obj == nil {
+		obj = e.Object
+	}
+
+	var models id.ModelIDList
+	switch o := obj.(type) {
+	case item.ItemModelSchema:
+		if o.Item != nil {
+			models = id.ModelIDList{o.Item.Model()}
+		}
+	case *item.Item:
+		models = id.ModelIDList{o.Model()}
+	case item.Versioned:
+		models = id.ModelIDList{o.Value().Model()}
+	case *model.Model:
+		models = id.ModelIDList{o.ID()}
+	case schema.FieldModelSchema:
+		if o.Model != nil {
+			models = id.ModelIDList{o.Model.ID()}
+		}
+	}
+	return integration.WebhookScope{Models: models}
+}
+
 func createEvent(ctx context.Context, r *repo.Container, g *gateway.Container, e Event) (*event.Event[any], error) {
 	evs, err := createEvents(ctx, r, g, []Event{e})
 	if err != nil {
@@ -125,16 +171,47 @@ func webhooks(ctx context.Context, r *repo.Container, g *gateway.Container, el [
 
 	for i, ev := range evl {
 		e := el[i]
-		for _, w := range integrations.ActiveWebhooks(ev.Type()) {
-			if err := g.TaskRunner.Run(ctx, task.WebhookPayload{
-				Webhook:  w,
-				Event:    ev,
-				Override: e.WebhookObject,
-			}.Payload()); err != nil {
-				return err
+		scope := e.WebhookScope()
+		// payloads are rendered once per shape so that retries and redeliveries send the same data
+		payloads := map[string][]byte{}
+		for _, in := range integrations {
+			for _, w := range in.MatchedWebhooks(ev.Type(), scope) {
+				shape := w.Payload()
+				payload, ok := payloads[shape.Key()]
+				if !ok {
+					if payload, err = webhookPayload(ev, e.WebhookObject, shape); err != nil {
+						return err
+					}
+					payloads[shape.Key()] = payload
+				}
+
+				d := integration.NewWebhookDelivery(in.ID(), w, ev.ID(), ev.Type(), payload)
+				if err := r.WebhookDelivery.Save(ctx, d); err != nil {
+					return err
+				}
+
+				if err := g.TaskRunner.Run(ctx, task.WebhookPayload{
+					Webhook:  w,
+					Event:    ev,
+					Override: json.RawMessage(payload),
+					Delivery: d.ID().Ref(),
+				}.Payload()); err != nil {
+					return err
+				}
 			}
 		}
 	}
 
 	return nil
 }
+
+func webhookPayload(ev *event.Event[any], obj any, shape integration.WebhookPayloadShape) ([]byte, error) {
+	if obj == nil {
+		obj = ev.Object()
+	}
+	d, err := integrationapi.NewWebhookData(obj, shape)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(d)
+}
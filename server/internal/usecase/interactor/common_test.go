@@ -2,6 +2,7 @@ package interactor
 
 import (
 	"context"
+	"encoding/json"
 	"net/url"
 	"testing"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/integration"
 	"github.com/reearth/reearth-cms/server/pkg/operator"
 	"github.com/reearth/reearth-cms/server/pkg/project"
@@ -116,12 +118,83 @@ func TestCommon_webhook(t *testing.T) {
 	assert.NoError(t, err)
 
 	lo.Must0(db.Integration.Save(ctx, integration))
-	mRunner.EXPECT().Run(ctx, task.WebhookPayload{
-		Webhook: wh,
-		Event:   ev,
-	}.Payload()).Times(1).Return(nil)
+	var got task.Payload
+	mRunner.EXPECT().Run(ctx, gomock.Any()).Times(1).DoAndReturn(func(_ context.Context, p task.Payload) error {
+		got = p
+		return nil
+	})
 	err = webhook(ctx, db, gw, Event{Workspace: ws.ID()}, ev)
 	assert.NoError(t, err)
+	assert.Equal(t, wh, got.Webhook.Webhook)
+	assert.Equal(t, ev, got.Webhook.Event)
+
+	// the delivery is logged with the rendered payload
+	d, err := db.WebhookDelivery.FindByID(ctx, *got.Webhook.Delivery)
+	assert.NoError(t, err)
+	assert.Equal(t, integration.ID(), d.Integration())
+	assert.Equal(t, wh.ID(), d.Webhook())
+	assert.Equal(t, ev.ID(), d.Event())
+	assert.Equal(t, "pending", d.Status().String())
+	assert.Equal(t, json.RawMessage(d.Payload()), got.Webhook.Override)
+}
+
+func TestCommon_webhookFilterAndPayload(t *testing.T) {
+	uID := user.NewID()
+	pID := project.NewID()
+	m1, m2 := id.NewModelID(), id.NewModelID()
+	a := asset.New().NewID().Thread(asset.NewThreadID().Ref()).NewUUID().
+		Project(pID).Size(100).CreatedByUser(uID).
+		MustBuild()
+	ws := workspace.New().NewID().MustBuild()
+	wh1 := integration.NewWebhookBuilder().NewID().Name("aaa").
+		Url(lo.Must(url.Parse("https://example.com"))).Active(true).
+		Trigger(integration.WebhookTrigger{event.AssetCreate: true}).
+		Filter(integration.NewWebhookFilter(id.ModelIDList{m1}, nil)).
+		Payload(integration.NewWebhookPayloadShape(integration.WebhookPayloadTypeMinimal, nil)).
+		MustBuild()
+	wh2 := integration.NewWebhookBuilder().NewID().Name("bbb").
+		Url(lo.Must(url.Parse("https://example.com"))).Active(true).
+		Trigger(integration.WebhookTrigger{event.AssetCreate: true}).
+		Filter(integration.NewWebhookFilter(id.ModelIDList{m2}, nil)).
+		MustBuild()
+	in := integration.New().NewID().Developer(uID).Name("xxx").
+		Webhook([]*integration.Webhook{wh1, wh2}).MustBuild()
+	iid, err := accountdomain.IntegrationIDFrom(in.ID().String())
+	assert.NoError(t, err)
+	lo.Must0(ws.Members().AddIntegration(iid, workspace.RoleOwner, uID))
+	ev := event.New[any]().NewID().Timestamp(time.Now()).Type(event.AssetCreate).
+		Operator(operator.OperatorFromUser(uID)).Object(a).MustBuild()
+
+	db := memory.New()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mRunner := gatewaymock.NewMockTaskRunner(mockCtrl)
+	gw := &gateway.Container{
+		TaskRunner: mRunner,
+	}
+
+	ctx := context.Background()
+	lo.Must0(db.Workspace.Save(ctx, ws))
+	lo.Must0(db.Integration.Save(ctx, in))
+
+	// only the webhook of the model is sent
+	var got task.Payload
+	mRunner.EXPECT().Run(ctx, gomock.Any()).Times(1).DoAndReturn(func(_ context.Context, p task.Payload) error {
+		got = p
+		return nil
+	})
+	err = webhook(ctx, db, gw, Event{Workspace: ws.ID(), Models: id.ModelIDList{m1}}, ev)
+	assert.NoError(t, err)
+	assert.Equal(t, wh1, got.Webhook.Webhook)
+
+	// the payload is rendered in the shape of the webhook
+	var payload map[string]any
+	lo.Must0(json.Unmarshal(got.Webhook.Override.(json.RawMessage), &payload))
+	assert.Equal(t, map[string]any{
+		"id":        a.ID().String(),
+		"projectId": pID.String(),
+		"threadId":  a.Thread().String(),
+	}, payload)
 }
 
 func TestNew(t *testing.T) {
@@ -129,6 +202,7 @@ func TestNew(t *testing.T) {
 	assert.NotNil(t, uc)
 	assert.Equal(t, interfaces.Container{
 		Asset:             NewAsset(nil, nil),
+		AssetFolder:       NewAssetFolder(nil, nil),
 		Workspace:         accountinteractor.NewWorkspace(&accountrepo.Container{}, nil),
 		User:              accountinteractor.NewUser(&accountrepo.Container{}, nil, "", ""),
 		Item:              NewItem(nil, nil),
@@ -142,5 +216,7 @@ func TestNew(t *testing.T) {
 		Group:             NewGroup(nil, nil),
 		WorkspaceSettings: NewWorkspaceSettings(nil, nil),
 		Job:               NewJob(nil, nil),
+		Schedule:          NewSchedule(nil, nil),
+		Notification:      NewNotification(nil, nil),
 	}, uc)
 }
@@ -2,6 +2,7 @@ package interactor
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -12,12 +13,23 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/integration"
 	"github.com/reearth/reearth-cms/server/pkg/rbac"
+	"github.com/reearth/reearth-cms/server/pkg/task"
 	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/log"
 	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
 	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 )
 
+// webhookDeactivationWindow is how long dead deliveries are considered when webhooks are deactivated
+const webhookDeactivationWindow = 24 * time.Hour
+
+const webhookRetryLockName = "webhook_retry"
+
+var ErrWebhookTaskRunnerNotConfigured = rerror.NewE(i18n.T("webhooks can not be sent because task runner is not configured"))
+
 type Integration struct {
 	repos    *repo.Container
 	gateways *gateway.Container
@@ -282,14 +294,20 @@ func (i Integration) CreateWebhook(ctx context.Context, iId id.IntegrationID, pa
 				return nil, interfaces.ErrOperationDenied
 			}
 
-			w, err := integration.NewWebhookBuilder().
+			b := integration.NewWebhookBuilder().
 				NewID().
 				Name(param.Name).
 				Url(&param.URL).
 				Active(param.Active).
 				Secret(param.Secret).
-				Trigger(integration.WebhookTrigger(*param.Trigger)).
-				Build()
+				Trigger(integration.WebhookTrigger(*param.Trigger))
+			if param.Filter != nil {
+				b = b.Filter(integration.NewWebhookFilter(param.Filter.Models, param.Filter.Groups))
+			}
+			if param.Payload != nil {
+				b = b.Payload(integration.NewWebhookPayloadShape(param.Payload.Type, param.Payload.Fields))
+			}
+			w, err := b.Build()
 
 			if err != nil {
 				return nil, err
@@ -349,6 +367,18 @@ func (i Integration) UpdateWebhook(ctx context.Context, iId id.IntegrationID, wI
 				w.SetSecret(*param.Secret)
 			}
 
+			if param.Filter != nil {
+				w.SetFilter(integration.NewWebhookFilter(param.Filter.Models, param.Filter.Groups))
+			}
+
+			if param.Payload != nil {
+				p := integration.NewWebhookPayloadShape(param.Payload.Type, param.Payload.Fields)
+				if err := p.Validate(); err != nil {
+					return nil, err
+				}
+				w.SetPayload(p)
+			}
+
 			w.SetUpdatedAt(time.Now())
 
 			in.UpdateWebhook(wId, w)
@@ -393,3 +423,181 @@ func (i Integration) DeleteWebhook(ctx context.Context, iId id.IntegrationID, wI
 			return nil
 		})
 }
+
+func (i Integration) FindWebhookDeliveries(ctx context.Context, iId id.IntegrationID, wId id.WebhookID, p *usecasex.Pagination, operator *usecase.Operator) (integration.WebhookDeliveryList, *usecasex.PageInfo, error) {
+	if operator.AcOperator.User == nil && operator.Integration == nil {
+		return nil, nil, interfaces.ErrInvalidOperator
+	}
+	if err := i.checkPermissions(ctx, rbac.ActionRead); err != nil {
+		return nil, nil, err
+	}
+
+	in, err := i.repos.Integration.FindByID(ctx, iId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !canManageWebhooks(in, operator) {
+		return nil, nil, interfaces.ErrOperationDenied
+	}
+	if _, ok := in.Webhook(wId); !ok {
+		return nil, nil, rerror.ErrNotFound
+	}
+
+	return i.repos.WebhookDelivery.FindByWebhook(ctx, wId, p)
+}
+
+func (i Integration) RedeliverWebhook(ctx context.Context, wId id.WebhookID, dId id.WebhookDeliveryID, operator *usecase.Operator) (*integration.WebhookDelivery, error) {
+	if operator.AcOperator.User == nil && operator.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+	if err := i.checkPermissions(ctx, rbac.ActionUpdate); err != nil {
+		return nil, err
+	}
+	if i.gateways == nil || i.gateways.TaskRunner == nil {
+		return nil, ErrWebhookTaskRunnerNotConfigured
+	}
+	return Run1(ctx, operator, i.repos, Usecase().Transaction(),
+		func(ctx context.Context) (*integration.WebhookDelivery, error) {
+			d, err := i.repos.WebhookDelivery.FindByID(ctx, dId)
+			if err != nil {
+				return nil, err
+			}
+			if d.Webhook() != wId {
+				return nil, rerror.ErrNotFound
+			}
+
+			in, err := i.repos.Integration.FindByID(ctx, d.Integration())
+			if err != nil {
+				return nil, err
+			}
+			if !canManageWebhooks(in, operator) {
+				return nil, interfaces.ErrOperationDenied
+			}
+
+			w, ok := in.Webhook(d.Webhook())
+			if !ok {
+				return nil, rerror.ErrNotFound
+			}
+
+			// a redelivery is sent even if the webhook is inactive
+			nd := d.Redeliver(w)
+			if err := i.repos.WebhookDelivery.Save(ctx, nd); err != nil {
+				return nil, err
+			}
+			if err := i.sendWebhookDelivery(ctx, w, nd); err != nil {
+				return nil, err
+			}
+			return nd, nil
+		})
+}
+
+func (i Integration) RetryWebhookDeliveries(ctx context.Context, now time.Time) (integration.WebhookDeliveryList, error) {
+	if i.gateways == nil || i.gateways.TaskRunner == nil {
+		return nil, nil
+	}
+
+	// only one server instance should retry the same deliveries
+	if err := i.repos.Lock.Lock(ctx, webhookRetryLockName); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := i.repos.Lock.Unlock(ctx, webhookRetryLockName); err != nil {
+			log.Errorf("webhook: failed to unlock: %v", err)
+		}
+	}()
+
+	due, err := i.repos.WebhookDelivery.FindDue(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	dead, err := i.repos.WebhookDelivery.FindDeadSince(ctx, now.Add(-webhookDeactivationWindow))
+	if err != nil {
+		return nil, err
+	}
+	if len(due) == 0 && len(dead) == 0 {
+		return nil, nil
+	}
+
+	integrations, err := i.repos.Integration.FindByIDs(ctx, lo.Uniq(lo.Map(append(due, dead...), func(d *integration.WebhookDelivery, _ int) id.IntegrationID {
+		return d.Integration()
+	})))
+	if err != nil {
+		return nil, err
+	}
+	webhookOf := func(d *integration.WebhookDelivery) (*integration.Integration, *integration.Webhook) {
+		in, ok := lo.Find(integrations, func(in *integration.Integration) bool { return in.ID() == d.Integration() })
+		if !ok {
+			return nil, nil
+		}
+		w, _ := in.Webhook(d.Webhook())
+		return in, w
+	}
+
+	retried := integration.WebhookDeliveryList{}
+	for _, d := range due {
+		_, w := webhookOf(d)
+		if w == nil || !w.Active() {
+			// the delivery stays as it is until the webhook is activated again
+			continue
+		}
+
+		d.Retry()
+		if err := i.repos.WebhookDelivery.Save(ctx, d); err != nil {
+			return nil, err
+		}
+		if err := i.sendWebhookDelivery(ctx, w, d); err != nil {
+			log.Errorf("webhook: failed to retry delivery %s: %v", d.ID(), err)
+			continue
+		}
+		retried = append(retried, d)
+	}
+
+	for _, d := range dead {
+		in, w := webhookOf(d)
+		if w == nil || !w.Active() {
+			continue
+		}
+
+		latest, err := i.repos.WebhookDelivery.FindLatestByWebhook(ctx, w.ID(), integration.MaxWebhookConsecutiveFailures)
+		if err != nil {
+			return nil, err
+		}
+		// the deliveries made before the webhook was updated do not count
+		latest = lo.Filter(latest, func(d *integration.WebhookDelivery, _ int) bool { return d.CreatedAt().After(w.UpdatedAt()) })
+		if len(latest) < integration.MaxWebhookConsecutiveFailures || !latest.AllDead() {
+			continue
+		}
+
+		w.SetActive(false)
+		w.SetUpdatedAt(now)
+		in.UpdateWebhook(w.ID(), w)
+		in.SetUpdatedAt(now)
+		if err := i.repos.Integration.Save(ctx, in); err != nil {
+			return nil, err
+		}
+		log.Warnf("webhook: webhook %s was deactivated after %d failed deliveries", w.ID(), len(latest))
+	}
+
+	return retried, nil
+}
+
+func (i Integration) sendWebhookDelivery(ctx context.Context, w *integration.Webhook, d *integration.WebhookDelivery) error {
+	ev, err := i.repos.Event.FindByID(ctx, d.Event())
+	if err != nil {
+		return err
+	}
+	return i.gateways.TaskRunner.Run(ctx, task.WebhookPayload{
+		Webhook:  w,
+		Event:    ev,
+		Override: json.RawMessage(d.Payload()),
+		Delivery: d.ID().Ref(),
+	}.Payload())
+}
+
+// canManageWebhooks returns true when the operator is the developer of the integration or the integration itself.
+func canManageWebhooks(in *integration.Integration, operator *usecase.Operator) bool {
+	if operator.AcOperator.User != nil {
+		return in.Developer() == *operator.AcOperator.User
+	}
+	return operator.Integration != nil && *operator.Integration == in.ID()
+}
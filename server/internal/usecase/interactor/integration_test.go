@@ -2,16 +2,23 @@ package interactor
 
 import (
 	"context"
+	"encoding/json"
 	"net/url"
 	"regexp"
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
 	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway/gatewaymock"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearth-cms/server/pkg/operator"
+	"github.com/reearth/reearth-cms/server/pkg/task"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/account/accountdomain/user"
 	"github.com/reearth/reearthx/account/accountusecase"
@@ -1000,6 +1007,95 @@ func TestIntegration_DeleteWebhook(t *testing.T) {
 	}
 }
 
+func testWebhookDeliverySuite(t *testing.T) (testData, *integration.Webhook, *event.Event[any]) {
+	t.Helper()
+	ts := testSuite()
+	w := integration.NewWebhookBuilder().NewID().Name("w").Url(ts.Uri).Active(true).
+		Trigger(integration.WebhookTrigger{event.ItemCreate: true}).UpdatedAt(ts.Now.Add(-time.Hour)).MustBuild()
+	ts.I1.SetWebhook([]*integration.Webhook{w})
+	ev := event.New[any]().NewID().Timestamp(ts.Now).Type(event.ItemCreate).
+		Operator(operator.OperatorFromUser(ts.UId)).Object(nil).MustBuild()
+	return ts, w, ev
+}
+
+func TestIntegration_RedeliverWebhook(t *testing.T) {
+	ts, w, ev := testWebhookDeliverySuite(t)
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Integration.Save(ctx, ts.I1))
+	lo.Must0(db.Event.Save(ctx, ev))
+	d := integration.NewWebhookDelivery(ts.IId1, w, ev.ID(), ev.Type(), []byte(`{"a":1}`))
+	lo.Must0(db.WebhookDelivery.Save(ctx, d))
+
+	mockCtrl := gomock.NewController(t)
+	mRunner := gatewaymock.NewMockTaskRunner(mockCtrl)
+	var got task.Payload
+	mRunner.EXPECT().Run(ctx, gomock.Any()).Times(1).DoAndReturn(func(_ context.Context, p task.Payload) error {
+		got = p
+		return nil
+	})
+	i := NewIntegration(db, &gateway.Container{TaskRunner: mRunner})
+
+	// other integrations can not redeliver
+	_, err := i.RedeliverWebhook(ctx, w.ID(), d.ID(), &usecase.Operator{Integration: new(ts.IId2), AcOperator: &accountusecase.Operator{}})
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+
+	_, err = i.RedeliverWebhook(ctx, w.ID(), id.NewWebhookDeliveryID(), ts.Op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+	_, err = i.RedeliverWebhook(ctx, id.NewWebhookID(), d.ID(), ts.Op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	nd, err := i.RedeliverWebhook(ctx, w.ID(), d.ID(), ts.Op)
+	assert.NoError(t, err)
+	assert.NotEqual(t, d.ID(), nd.ID())
+	assert.Equal(t, d.Payload(), nd.Payload())
+	assert.Equal(t, nd.ID().Ref(), got.Webhook.Delivery)
+	assert.Equal(t, ev.ID(), got.Webhook.Event.ID())
+	assert.Equal(t, json.RawMessage(`{"a":1}`), got.Webhook.Override)
+
+	res, _, err := i.FindWebhookDeliveries(ctx, ts.IId1, w.ID(), nil, ts.Op)
+	assert.NoError(t, err)
+	assert.Equal(t, integration.WebhookDeliveryList{nd, d}, res)
+}
+
+func TestIntegration_RetryWebhookDeliveries(t *testing.T) {
+	ts, w, ev := testWebhookDeliverySuite(t)
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Integration.Save(ctx, ts.I1))
+	lo.Must0(db.Event.Save(ctx, ev))
+
+	failed := integration.WebhookDeliveryAttempt{AttemptedAt: ts.Now.Add(-time.Minute), StatusCode: 500}
+	due := integration.NewWebhookDelivery(ts.IId1, w, ev.ID(), ev.Type(), []byte(`{}`))
+	due.RecordAttempt(failed)
+	lo.Must0(db.WebhookDelivery.Save(ctx, due))
+
+	mockCtrl := gomock.NewController(t)
+	mRunner := gatewaymock.NewMockTaskRunner(mockCtrl)
+	mRunner.EXPECT().Run(ctx, gomock.Any()).Times(1).Return(nil)
+	i := NewIntegration(db, &gateway.Container{TaskRunner: mRunner})
+
+	res, err := i.RetryWebhookDeliveries(ctx, ts.Now)
+	assert.NoError(t, err)
+	assert.Equal(t, []id.WebhookDeliveryID{due.ID()}, lo.Map(res, func(d *integration.WebhookDelivery, _ int) id.WebhookDeliveryID { return d.ID() }))
+	got := lo.Must(db.WebhookDelivery.FindByID(ctx, due.ID()))
+	assert.Equal(t, integration.WebhookDeliveryStatusPending, got.Status())
+
+	// the webhook is deactivated after the consecutive failures
+	for range integration.MaxWebhookConsecutiveFailures {
+		d := integration.NewWebhookDelivery(ts.IId1, w, ev.ID(), ev.Type(), []byte(`{}`))
+		for range integration.MaxWebhookDeliveryAttempts {
+			d.RecordAttempt(integration.WebhookDeliveryAttempt{AttemptedAt: ts.Now, StatusCode: 500})
+		}
+		lo.Must0(db.WebhookDelivery.Save(ctx, d))
+	}
+	res, err = i.RetryWebhookDeliveries(ctx, ts.Now)
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+	in := lo.Must(db.Integration.FindByID(ctx, ts.IId1))
+	assert.False(t, in.Webhooks()[0].Active())
+}
+
 func TestNewIntegration(t *testing.T) {
 	r := memory.New()
 	assert.Equal(t, &Integration{repos: r}, NewIntegration(r, nil))
@@ -15,9 +15,11 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
 	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/rbac"
 	"github.com/reearth/reearth-cms/server/pkg/request"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/rerror"
@@ -27,9 +29,10 @@ import (
 )
 
 type Item struct {
-	repos       *repo.Container
-	gateways    *gateway.Container
-	ignoreEvent bool
+	repos          *repo.Container
+	gateways       *gateway.Container
+	ignoreEvent    bool
+	trashRetention time.Duration
 }
 
 func NewItem(r *repo.Container, g *gateway.Container) *Item {
@@ -199,6 +202,11 @@ func (i Item) FindAllVersionsByID(ctx context.Context, itemID id.ItemID, _ *usec
 }
 
 func (i Item) Search(ctx context.Context, sp schema.Package, q *item.Query, p *usecasex.Pagination, _ *usecase.Operator) (item.VersionedList, *usecasex.PageInfo, error) {
+	if q.Filter() != nil {
+		if err := q.Filter().Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
 	items, pi, err := i.repos.Item.Search(ctx, sp, q, p)
 	if err != nil {
 		return nil, nil, err
@@ -329,6 +337,10 @@ func (i Item) Create(ctx context.Context, param interfaces.CreateItemParam, oper
 				return nil, err
 			}
 
+			if err := validateRules(s, it); err != nil {
+				return nil, err
+			}
+
 			if err = i.handleReferenceFields(ctx, *s, it, item.Fields{}); err != nil {
 				return nil, err
 			}
@@ -465,6 +477,10 @@ func (i Item) Update(ctx context.Context, param interfaces.UpdateItemParam, oper
 			}
 			itv.UpdateFields(groupFields)
 
+			if err := validateRules(s, itv); err != nil {
+				return nil, err
+			}
+
 			if operator.AcOperator.User != nil {
 				itv.SetUpdatedByUser(*operator.AcOperator.User)
 			} else if operator.Integration != nil {
@@ -933,6 +949,17 @@ func filterFieldParamsBySchema(params []interfaces.ItemFieldParam, s *schema.Sch
 	return
 }
 
+// validateRules evaluates the rules spanning the fields of the schema against the fields of the item.
+func validateRules(s *schema.Schema, it *item.Item) error {
+	errs := s.ValidateRules(func(fid id.FieldID) *value.Multiple {
+		return it.Field(fid).Value()
+	})
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
 func itemFieldsFromParams(fields []interfaces.ItemFieldParam, s *schema.Schema) (item.Fields, error) {
 	return util.TryMap(fields, func(f interfaces.ItemFieldParam) (*item.Field, error) {
 		sf := s.FieldByIDOrKey(f.Field, f.Key)
@@ -951,10 +978,49 @@ func itemFieldsFromParams(fields []interfaces.ItemFieldParam, s *schema.Schema)
 		}
 		m := value.NewMultiple(sf.Type(), as)
 		if err := sf.Validate(m); err != nil {
+			if fe := schema.NewFieldValidationError(sf, err); fe != nil {
+				return nil, fe
+			}
 			return nil, fmt.Errorf("%w: id=%s key=%s", err, sf.ID(), sf.Name())
 		}
 
-		return item.NewField(sf.ID(), m, f.Group), nil
+		field := item.NewField(sf.ID(), m, f.Group)
+		if f.Locales == nil {
+			return field, nil
+		}
+		if len(f.Locales) == 0 {
+			field.ClearLocalizedValues()
+			return field, nil
+		}
+		if !sf.Localizable() {
+			return nil, fmt.Errorf("%w: id=%s key=%s", schema.ErrNotLocalizable, sf.ID(), sf.Name())
+		}
+
+		for l, lv := range f.Locales {
+			locale, ok := project.NormalizeLocale(l)
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", project.ErrInvalidLocale, l)
+			}
+			if !sf.Multiple() {
+				lv = []any{lv}
+			}
+			las, ok := lv.([]any)
+			if !ok {
+				return nil, fmt.Errorf("%w: id=%s key=%s locale=%s", interfaces.ErrInvalidValue, f.Field, f.Key, locale)
+			}
+			lm := value.NewMultiple(sf.Type(), las)
+			// translations are optional even if the field is required
+			if err := sf.ValidateValue(lm); err != nil {
+				if fe := schema.NewFieldValidationError(sf, err); fe != nil {
+					fe.Detail = fmt.Sprintf("%s (locale=%s)", fe.Detail, locale)
+					return nil, fe
+				}
+				return nil, fmt.Errorf("%w: id=%s key=%s locale=%s", err, sf.ID(), sf.Name(), locale)
+			}
+			field.SetLocalizedValue(locale, lm)
+		}
+
+		return field, nil
 	})
 }
 
@@ -1011,16 +1077,19 @@ func refFields(s schema.Schema, refSchemaID schema.ID) item.FieldIDList {
 	})
 }
 
-func (i Item) handleRelatedReferenceFields(ctx context.Context, itemIDs id.ItemIDList, sp schema.Package) error {
+// handleRelatedReferenceFields clears the references to the items from the other items of the project,
+// and returns the cleared reference fields grouped by the referenced item.
+func (i Item) handleRelatedReferenceFields(ctx context.Context, itemIDs id.ItemIDList, sp schema.Package) (map[id.ItemID][]trash.Reference, error) {
 	if len(itemIDs) == 0 {
-		return nil
+		return nil, nil
 	}
 
+	cleared := map[id.ItemID][]trash.Reference{}
 	p := usecasex.CursorPagination{First: new(int64(100))}.Wrap()
 	for {
 		models, pageInfo, err := i.repos.Model.FindByProject(ctx, sp.Schema().Project(), p)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		sIDs := lo.Map(models, func(m *model.Model, _ int) id.SchemaID {
@@ -1029,7 +1098,7 @@ func (i Item) handleRelatedReferenceFields(ctx context.Context, itemIDs id.ItemI
 
 		schemas, err := i.repos.Schema.FindByIDs(ctx, sIDs)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		for _, s := range schemas {
@@ -1040,12 +1109,14 @@ func (i Item) handleRelatedReferenceFields(ctx context.Context, itemIDs id.ItemI
 				return m.Schema() == s.ID()
 			})
 			if !ok {
-				return rerror.ErrInternalBy(fmt.Errorf("model not found for schema %s", s.ID()))
+				return nil, rerror.ErrInternalBy(fmt.Errorf("model not found for schema %s", s.ID()))
 			}
-			refFields(*s, sp.Schema().ID())
-			err = i.clearRelatedReferenceFields(ctx, m.ID(), s, refFields(*s, sp.Schema().ID()), itemIDs)
+			refs, err := i.clearRelatedReferenceFields(ctx, m.ID(), s, refFields(*s, sp.Schema().ID()), itemIDs)
 			if err != nil {
-				return err
+				return nil, err
+			}
+			for iid, r := range refs {
+				cleared[iid] = append(cleared[iid], r...)
 			}
 		}
 
@@ -1055,14 +1126,16 @@ func (i Item) handleRelatedReferenceFields(ctx context.Context, itemIDs id.ItemI
 		p = usecasex.CursorPagination{First: new(int64(100)), After: pageInfo.EndCursor}.Wrap()
 	}
 
-	return nil
+	return cleared, nil
 }
 
-func (i Item) clearRelatedReferenceFields(ctx context.Context, modelID id.ModelID, _ *schema.Schema, refFieldIDs item.FieldIDList, itemIDs id.ItemIDList) error {
+func (i Item) clearRelatedReferenceFields(ctx context.Context, modelID id.ModelID, _ *schema.Schema, refFieldIDs item.FieldIDList, itemIDs id.ItemIDList) (map[id.ItemID][]trash.Reference, error) {
 	if len(itemIDs) == 0 || len(refFieldIDs) == 0 {
-		return nil
+		return nil, nil
 	}
 
+	cleared := map[id.ItemID][]trash.Reference{}
+
 	// loop through itemIDs in batches to avoid large queries
 	batchSize := 100
 	for start := 0; start < len(itemIDs); start += batchSize {
@@ -1080,7 +1153,7 @@ func (i Item) clearRelatedReferenceFields(ctx context.Context, modelID id.ModelI
 
 		ivl, err := i.repos.Item.FindByModelAndValue(ctx, modelID, filter, nil)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		updates := lo.FilterMap(ivl.Unwrap(), func(itm *item.Item, _ int) (*item.Item, bool) {
@@ -1097,6 +1170,7 @@ func (i Item) clearRelatedReferenceFields(ctx context.Context, modelID id.ModelI
 				for _, val := range field.Value().Values() {
 					if refID, ok := val.ValueReference(); ok && itemIDs.Has(refID) {
 						hasDeletedRef = true
+						cleared[refID] = append(cleared[refID], trash.NewReference(itm.ID(), refFieldID))
 					} else {
 						newValues = append(newValues, val.Value())
 					}
@@ -1113,11 +1187,11 @@ func (i Item) clearRelatedReferenceFields(ctx context.Context, modelID id.ModelI
 		})
 
 		if err := i.repos.Item.SaveAll(ctx, updates); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	return nil
+	return cleared, nil
 }
 
 func (i Item) BatchDelete(ctx context.Context, iIDs id.ItemIDList, sp schema.Package, operator *usecase.Operator) (result id.ItemIDList, err error) {
@@ -1161,17 +1235,12 @@ func (i Item) BatchDelete(ctx context.Context, iIDs id.ItemIDList, sp schema.Pac
 			}
 		}
 
-		if err := i.handleRelatedReferenceFields(ctx, iList.IDs(), sp); err != nil {
-			return nil, err
-		}
-
-		err = i.repos.Item.BatchRemove(ctx, iList.MetadataIDs())
+		refs, err := i.handleRelatedReferenceFields(ctx, iList.IDs(), sp)
 		if err != nil {
 			return nil, err
 		}
 
-		err = i.repos.Item.BatchRemove(ctx, iIDs)
-		if err != nil {
+		if err := i.trash(ctx, iList, refs, operator); err != nil {
 			return nil, err
 		}
 
@@ -0,0 +1,272 @@
+package interactor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/job"
+	"github.com/reearth/reearth-cms/server/pkg/rbac"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+)
+
+func (i Item) BulkUpdateAsync(ctx context.Context, param interfaces.BulkUpdateItemsParam, operator *usecase.Operator) (id.JobID, error) {
+	if !operator.IsUserOrIntegration() {
+		return id.JobID{}, interfaces.ErrInvalidOperator
+	}
+	if len(param.Fields) == 0 {
+		return id.JobID{}, interfaces.ErrItemFieldRequired
+	}
+
+	s := param.SchemaPackage.Schema()
+	if !operator.IsWritableWorkspace(s.Workspace()) {
+		return id.JobID{}, interfaces.ErrOperationDenied
+	}
+	if err := doCheckPermission(ctx, i.gateways, rbac.ResourceItem, rbac.ActionUpdate, s.Workspace()); err != nil {
+		return id.JobID{}, err
+	}
+
+	// validate the values before the job starts, group fields can not be assigned since their item groups differ by item
+	modelSchemaFields, otherFields := filterFieldParamsBySchema(param.Fields, s)
+	if len(otherFields) > 0 {
+		return id.JobID{}, fmt.Errorf("%w: id=%s key=%s", interfaces.ErrInvalidField, otherFields[0].Field, otherFields[0].Key)
+	}
+	if _, err := itemFieldsFromParams(modelSchemaFields, s); err != nil {
+		return id.JobID{}, err
+	}
+	for _, f := range param.Fields {
+		if s.FieldByIDOrKey(f.Field, f.Key).Unique() {
+			return id.JobID{}, interfaces.ErrBulkUpdateUniqueField
+		}
+	}
+
+	payload := &job.BulkUpdatePayload{
+		ModelID: param.ModelID.String(),
+		Fields: lo.Map(param.Fields, func(f interfaces.ItemFieldParam, _ int) job.BulkUpdateField {
+			return job.BulkUpdateField{
+				Field: s.FieldByIDOrKey(f.Field, f.Key).ID().String(),
+				Value: f.Value,
+			}
+		}),
+	}
+	if param.Filter != nil {
+		filterJSON, err := json.Marshal(param.Filter)
+		if err != nil {
+			return id.JobID{}, fmt.Errorf("failed to serialize bulk update filter: %w", err)
+		}
+		payload.Filter = filterJSON
+	}
+	payloadJSON, err := payload.ToJSON()
+	if err != nil {
+		return id.JobID{}, fmt.Errorf("failed to serialize bulk update payload: %w", err)
+	}
+
+	jb := job.New().
+		NewID().
+		Type(job.TypeBulkUpdate).
+		Project(s.Project()).
+		Payload(payloadJSON)
+
+	if operator.AcOperator != nil && operator.AcOperator.User != nil {
+		jb = jb.User(*operator.AcOperator.User)
+	}
+	if operator.Integration != nil {
+		jb = jb.Integration(*operator.Integration)
+	}
+
+	j, err := jb.Build()
+	if err != nil {
+		return id.JobID{}, fmt.Errorf("failed to create job: %w", err)
+	}
+
+	if err := i.repos.Job.Save(ctx, j); err != nil {
+		return id.JobID{}, fmt.Errorf("failed to save job: %w", err)
+	}
+
+	go i.runBulkUpdateJob(j.ID(), param, operator)
+
+	log.Infof("item: bulk update job %s created", j.ID())
+	return j.ID(), nil
+}
+
+func (i Item) runBulkUpdateJob(jobID id.JobID, param interfaces.BulkUpdateItemsParam, operator *usecase.Operator) {
+	ctx := context.Background()
+
+	j, err := i.repos.Job.FindByID(ctx, jobID)
+	if err != nil {
+		log.Errorf("item: bulk update job %s failed to load: %v", jobID, err)
+		return
+	}
+
+	j.Start()
+	if err := i.repos.Job.Save(ctx, j); err != nil {
+		log.Errorf("item: bulk update job %s failed to update status: %v", jobID, err)
+		return
+	}
+
+	if i.gateways.JobPubSub != nil {
+		_ = i.gateways.JobPubSub.Publish(ctx, jobID, j.State())
+	}
+
+	res, err := i.bulkUpdateWithProgress(ctx, j, param, operator)
+	if err != nil {
+		j, _ = i.repos.Job.FindByID(ctx, jobID)
+		if j != nil && j.IsCancelled() {
+			if i.gateways.JobPubSub != nil {
+				_ = i.gateways.JobPubSub.Publish(ctx, jobID, j.State())
+			}
+			log.Infof("item: bulk update job %s was cancelled", jobID)
+			return
+		}
+
+		if j != nil {
+			j.Fail(err.Error())
+			if saveErr := i.repos.Job.Save(ctx, j); saveErr != nil {
+				log.Errorf("item: bulk update job %s failed to save error: %v", jobID, saveErr)
+			}
+			if i.gateways.JobPubSub != nil {
+				_ = i.gateways.JobPubSub.Publish(ctx, jobID, j.State())
+			}
+		}
+		log.Errorf("item: bulk update job %s failed: %v", jobID, err)
+		return
+	}
+
+	resultJSON, _ := res.ToJSON()
+
+	j, _ = i.repos.Job.FindByID(ctx, jobID)
+	if j != nil {
+		j.Complete(resultJSON)
+		if err := i.repos.Job.Save(ctx, j); err != nil {
+			log.Errorf("item: bulk update job %s failed to save completion: %v", jobID, err)
+		}
+		if i.gateways.JobPubSub != nil {
+			_ = i.gateways.JobPubSub.Publish(ctx, jobID, j.State())
+		}
+	}
+
+	if i.gateways.JobPubSub != nil {
+		i.gateways.JobPubSub.Unsubscribe(jobID)
+	}
+
+	log.Infof("item: bulk update job %s completed: total=%d updated=%d skipped=%d failed=%d",
+		jobID, res.Total, res.Updated, res.Skipped, res.Failed)
+}
+
+// bulkUpdateWithProgress updates the matched items one by one so that each of them gets a new version and an event.
+// The items which can not be updated are counted as failed and do not stop the job.
+func (i Item) bulkUpdateWithProgress(ctx context.Context, j *job.Job, param interfaces.BulkUpdateItemsParam, operator *usecase.Operator) (*job.BulkUpdateResult, error) {
+	res := &job.BulkUpdateResult{}
+
+	fields, err := itemFieldsFromParams(param.Fields, param.SchemaPackage.Schema())
+	if err != nil {
+		return res, err
+	}
+
+	// the matched items are collected first since updating them may change the result of the filter
+	ids, err := i.bulkUpdateTargets(ctx, param)
+	if err != nil {
+		return res, err
+	}
+	res.Total = len(ids)
+
+	// the permission was checked when the job was created
+	itemUC := i
+	itemUC.gateways = gatewaysWithoutAuthorization(i.gateways)
+
+	processed := 0
+	for _, chunk := range lo.Chunk(ids, chunkSize) {
+		currentJob, _ := i.repos.Job.FindByID(ctx, j.ID())
+		if currentJob != nil && currentJob.IsCancelled() {
+			return res, fmt.Errorf("job cancelled")
+		}
+
+		items, err := i.repos.Item.FindByIDs(ctx, chunk, nil)
+		if err != nil {
+			return res, err
+		}
+
+		for _, itm := range items {
+			if hasFieldValues(itm.Value(), fields) {
+				res.Skipped++
+				continue
+			}
+
+			if _, err := itemUC.Update(ctx, interfaces.UpdateItemParam{
+				ItemID: itm.Value().ID(),
+				Fields: param.Fields,
+			}, operator); err != nil {
+				log.Warnf("item: bulk update job %s failed to update item %s: %v", j.ID(), itm.Value().ID(), err)
+				res.Failed++
+				continue
+			}
+			res.Updated++
+		}
+		// the items deleted after they were matched are neither updated nor skipped
+		res.Failed += len(chunk) - len(items)
+
+		processed += len(chunk)
+
+		progress := job.NewProgress(processed, res.Total)
+		state := job.NewState(job.StatusInProgress, &progress, "")
+		if i.gateways.JobPubSub != nil {
+			if err := i.gateways.JobPubSub.Publish(ctx, j.ID(), state); err != nil {
+				log.Warnf("item: failed to publish job %s progress: %v", j.ID(), err)
+			}
+		}
+
+		j.SetProgress(progress)
+		if err := i.repos.Job.Save(ctx, j); err != nil {
+			log.Errorf("item: bulk update job %s failed to update progress: %v", j.ID(), err)
+		}
+	}
+
+	return res, nil
+}
+
+func (i Item) bulkUpdateTargets(ctx context.Context, param interfaces.BulkUpdateItemsParam) (id.ItemIDList, error) {
+	var query *item.Query
+	if param.Filter != nil {
+		query = item.NewQuery(param.SchemaPackage.Schema().Project(), param.ModelID, nil, "", nil).
+			WithFilter(param.Filter)
+	}
+
+	batchSize := defaultBatchConfig().BatchSize
+	pagination := usecasex.CursorPagination{First: &batchSize}.Wrap()
+
+	var ids id.ItemIDList
+	for {
+		var items item.VersionedList
+		var pi *usecasex.PageInfo
+		var err error
+		if query != nil {
+			items, pi, err = i.repos.Item.Search(ctx, param.SchemaPackage, query, pagination)
+		} else {
+			items, pi, err = i.repos.Item.FindByModel(ctx, param.ModelID, nil, nil, pagination)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, items.Unwrap().IDs()...)
+		if pi == nil || !pi.HasNextPage || len(items) == 0 {
+			break
+		}
+		pagination.Cursor.After = pi.EndCursor
+	}
+	return ids, nil
+}
+
+// hasFieldValues reports whether the item already has all the given values, localized values are always assigned.
+func hasFieldValues(itm *item.Item, fields item.Fields) bool {
+	return lo.EveryBy(fields, func(f *item.Field) bool {
+		old := itm.Field(f.FieldID())
+		return len(f.Locales()) == 0 && old != nil && old.Value().Equal(f.Value())
+	})
+}
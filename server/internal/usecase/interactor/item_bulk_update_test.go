@@ -0,0 +1,149 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway/gatewaymock"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/job"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/rbac"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItem_BulkUpdateAsync(t *testing.T) {
+	ctx := context.Background()
+	db := memory.New()
+
+	wid := accountdomain.NewWorkspaceID()
+	uid := accountdomain.NewUserID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	sf1 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("status")).MustBuild()
+	sf2 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("code")).Unique(true).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{sf1, sf2}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(id.RandomKey()).Project(prj.ID()).MustBuild()
+	newItem := func(status string) *item.Item {
+		return item.New().NewID().User(uid).Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID().Ref()).
+			Fields([]*item.Field{item.NewField(sf1.ID(), value.TypeText.Value(status).AsMultiple(), nil)}).MustBuild()
+	}
+	i1, i2, i3 := newItem("todo"), newItem("todo"), newItem("done")
+
+	require.NoError(t, db.Project.Save(ctx, prj))
+	require.NoError(t, db.Schema.Save(ctx, s))
+	require.NoError(t, db.Model.Save(ctx, m))
+	for _, i := range []*item.Item{i1, i2, i3} {
+		require.NoError(t, db.Item.Save(ctx, i))
+	}
+
+	op := &usecase.Operator{
+		AcOperator:       &accountusecase.Operator{User: new(uid), WritableWorkspaces: accountdomain.WorkspaceIDList{wid}},
+		ReadableProjects: id.ProjectIDList{prj.ID()},
+		WritableProjects: id.ProjectIDList{prj.ID()},
+	}
+	itemUC := NewItem(db, &gateway.Container{})
+	itemUC.ignoreEvent = true
+	sp := *schema.NewPackage(s, nil, nil, nil)
+	fields := []interfaces.ItemFieldParam{{Key: new(id.NewKey("status")), Value: "done"}}
+
+	_, err := itemUC.BulkUpdateAsync(ctx, interfaces.BulkUpdateItemsParam{ModelID: m.ID(), SchemaPackage: sp, Fields: fields}, &usecase.Operator{AcOperator: &accountusecase.Operator{}})
+	assert.Equal(t, interfaces.ErrInvalidOperator, err)
+	_, err = itemUC.BulkUpdateAsync(ctx, interfaces.BulkUpdateItemsParam{ModelID: m.ID(), SchemaPackage: sp}, op)
+	assert.Equal(t, interfaces.ErrItemFieldRequired, err)
+	_, err = itemUC.BulkUpdateAsync(ctx, interfaces.BulkUpdateItemsParam{ModelID: m.ID(), SchemaPackage: sp, Fields: []interfaces.ItemFieldParam{{Field: sf2.ID().Ref(), Value: "a"}}}, op)
+	assert.Equal(t, interfaces.ErrBulkUpdateUniqueField, err)
+	_, err = itemUC.BulkUpdateAsync(ctx, interfaces.BulkUpdateItemsParam{ModelID: m.ID(), SchemaPackage: sp, Fields: []interfaces.ItemFieldParam{{Key: new(id.NewKey("unknown")), Value: "a"}}}, op)
+	assert.ErrorIs(t, err, interfaces.ErrInvalidField)
+
+	j := job.New().NewID().Type(job.TypeBulkUpdate).Project(prj.ID()).User(uid).MustBuild()
+	require.NoError(t, db.Job.Save(ctx, j))
+
+	res, err := itemUC.bulkUpdateWithProgress(ctx, j, interfaces.BulkUpdateItemsParam{ModelID: m.ID(), SchemaPackage: sp, Fields: fields}, op)
+	require.NoError(t, err)
+	assert.Equal(t, &job.BulkUpdateResult{Total: 3, Updated: 2, Skipped: 1}, res)
+	assert.Equal(t, job.NewProgress(3, 3), j.Progress())
+
+	// the updated items get a new version and the skipped one does not
+	for _, i := range []*item.Item{i1, i2, i3} {
+		vi, err := itemUC.FindByID(ctx, i.ID(), op)
+		require.NoError(t, err)
+		assert.Equal(t, "done", vi.Value().Field(sf1.ID()).Value().First().Interface())
+	}
+	versions, err := itemUC.FindAllVersionsByID(ctx, i1.ID(), op)
+	require.NoError(t, err)
+	assert.Len(t, versions, 2)
+	versions, err = itemUC.FindAllVersionsByID(ctx, i3.ID(), op)
+	require.NoError(t, err)
+	assert.Len(t, versions, 1)
+
+	// cancelled
+	j.Cancel()
+	require.NoError(t, db.Job.Save(ctx, j))
+	_, err = itemUC.bulkUpdateWithProgress(ctx, j, interfaces.BulkUpdateItemsParam{ModelID: m.ID(), SchemaPackage: sp, Fields: fields}, op)
+	assert.Error(t, err)
+}
+
+func TestItem_BulkUpdateAsync_Authorization(t *testing.T) {
+	ctx := context.Background()
+	db := memory.New()
+
+	wid := accountdomain.NewWorkspaceID()
+	uid := accountdomain.NewUserID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	sf := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("status")).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{sf}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(id.RandomKey()).Project(prj.ID()).MustBuild()
+	i1 := item.New().NewID().User(uid).Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID().Ref()).
+		Fields([]*item.Field{item.NewField(sf.ID(), value.TypeText.Value("todo").AsMultiple(), nil)}).MustBuild()
+
+	require.NoError(t, db.Project.Save(ctx, prj))
+	require.NoError(t, db.Schema.Save(ctx, s))
+	require.NoError(t, db.Model.Save(ctx, m))
+	require.NoError(t, db.Item.Save(ctx, i1))
+
+	op := &usecase.Operator{
+		AcOperator:       &accountusecase.Operator{User: new(uid), WritableWorkspaces: accountdomain.WorkspaceIDList{wid}},
+		ReadableProjects: id.ProjectIDList{prj.ID()},
+		WritableProjects: id.ProjectIDList{prj.ID()},
+	}
+	sp := *schema.NewPackage(s, nil, nil, nil)
+	param := interfaces.BulkUpdateItemsParam{ModelID: m.ID(), SchemaPackage: sp, Fields: []interfaces.ItemFieldParam{{Key: new(id.NewKey("status")), Value: "done"}}}
+
+	// the permission is checked once when the job is created
+	ctrl := gomock.NewController(t)
+	mockAuth := gatewaymock.NewMockAuthorization(ctrl)
+	mockAuth.EXPECT().CheckPermission(gomock.Any(), rbac.ResourceItem, rbac.ActionUpdate, wid).Return(false, nil)
+	itemUC := NewItem(db, &gateway.Container{Authorization: mockAuth})
+	itemUC.ignoreEvent = true
+	_, err := itemUC.BulkUpdateAsync(ctx, param, op)
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+
+	// the job runs without the request credentials, so the items must be updated without checking the permission again
+	mockAuth = gatewaymock.NewMockAuthorization(ctrl)
+	mockAuth.EXPECT().CheckPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, errors.New("no credentials")).AnyTimes()
+	itemUC = NewItem(db, &gateway.Container{Authorization: mockAuth})
+	itemUC.ignoreEvent = true
+	j := job.New().NewID().Type(job.TypeBulkUpdate).Project(prj.ID()).User(uid).MustBuild()
+	require.NoError(t, db.Job.Save(ctx, j))
+
+	res, err := itemUC.bulkUpdateWithProgress(ctx, j, param, op)
+	require.NoError(t, err)
+	assert.Equal(t, &job.BulkUpdateResult{Total: 1, Updated: 1}, res)
+
+	got, err := db.Item.FindByID(ctx, i1.ID(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "done", got.Value().Field(sf.ID()).Value().First().Interface())
+}
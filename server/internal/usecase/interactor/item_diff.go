@@ -0,0 +1,42 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/rerror"
+)
+
+// Diff returns the changes of the fields of the item from a version or ref to another.
+// When the item has no version at the ref to compare from, all the fields are reported as added.
+func (i Item) Diff(ctx context.Context, itemID id.ItemID, from, to version.VersionOrRef, operator *usecase.Operator) (*interfaces.ItemDiff, error) {
+	toItem, err := i.FindVersionByID(ctx, itemID, to, operator)
+	if err != nil {
+		return nil, err
+	}
+
+	fromItem, err := i.repos.Item.FindVersionByID(ctx, itemID, from)
+	if err != nil {
+		isRef := version.MatchVersionOrRef(from, func(version.Version) bool { return false }, func(version.Ref) bool { return true })
+		if !isRef || !errors.Is(err, rerror.ErrNotFound) {
+			return nil, err
+		}
+		fromItem = nil
+	}
+
+	var fromFields item.Fields
+	if fromItem != nil {
+		fromFields = fromItem.Value().Fields()
+	}
+
+	return &interfaces.ItemDiff{
+		From:    fromItem,
+		To:      toItem,
+		Changes: item.CompareFields(toItem.Value().Fields(), fromFields),
+	}, nil
+}
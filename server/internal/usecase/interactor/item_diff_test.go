@@ -0,0 +1,84 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItem_Diff(t *testing.T) {
+	ctx := context.Background()
+	db := memory.New()
+
+	pid := id.NewProjectID()
+	uid := accountdomain.NewUserID()
+	fid1, fid2 := id.NewFieldID(), id.NewFieldID()
+	iid := id.NewItemID()
+	newItem := func(fields ...*item.Field) *item.Item {
+		return item.New().ID(iid).User(uid).Schema(id.NewSchemaID()).Model(id.NewModelID()).Project(pid).
+			Thread(id.NewThreadID().Ref()).Fields(fields).MustBuild()
+	}
+
+	require.NoError(t, db.Item.Save(ctx, newItem(
+		item.NewField(fid1, value.TypeText.Value("a").AsMultiple(), nil),
+	)))
+	versions, err := db.Item.FindAllVersionsByID(ctx, iid)
+	require.NoError(t, err)
+	v1 := versions[0].Version()
+
+	require.NoError(t, db.Item.Save(ctx, newItem(
+		item.NewField(fid1, value.TypeText.Value("b").AsMultiple(), nil),
+		item.NewField(fid2, value.NewMultiple(value.TypeText, []any{"c", "d"}), nil),
+	)))
+
+	op := &usecase.Operator{
+		AcOperator:       &accountusecase.Operator{User: new(uid)},
+		ReadableProjects: id.ProjectIDList{pid},
+	}
+	itemUC := NewItem(db, nil)
+
+	res, err := itemUC.Diff(ctx, iid, v1.OrRef(), version.Latest.OrVersion(), op)
+	require.NoError(t, err)
+	assert.Equal(t, v1, res.From.Version())
+	assert.ElementsMatch(t, item.FieldChanges{
+		{
+			ID:            fid1,
+			Type:          item.FieldChangeTypeUpdate,
+			PreviousValue: value.TypeText.Value("a").AsMultiple(),
+			CurrentValue:  value.TypeText.Value("b").AsMultiple(),
+		},
+		{
+			ID:           fid2,
+			Type:         item.FieldChangeTypeAdd,
+			CurrentValue: value.NewMultiple(value.TypeText, []any{"c", "d"}),
+		},
+	}, res.Changes)
+
+	// the item has never been published
+	res, err = itemUC.Diff(ctx, iid, version.Public.OrVersion(), v1.OrRef(), op)
+	require.NoError(t, err)
+	assert.Nil(t, res.From)
+	assert.Equal(t, item.FieldChanges{
+		{
+			ID:           fid1,
+			Type:         item.FieldChangeTypeAdd,
+			CurrentValue: value.TypeText.Value("a").AsMultiple(),
+		},
+	}, res.Changes)
+
+	_, err = itemUC.Diff(ctx, iid, version.New().OrRef(), version.Latest.OrVersion(), op)
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+	_, err = itemUC.Diff(ctx, id.NewItemID(), v1.OrRef(), version.Latest.OrVersion(), op)
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+}
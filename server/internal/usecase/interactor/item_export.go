@@ -29,13 +29,19 @@ func defaultBatchConfig() *BatchConfig {
 	}
 }
 
+// exportBatchFunc is called after each batch of the export is written with the number of the processed items.
+type exportBatchFunc func(ctx context.Context, processed int, total int64, assets asset.List) error
+
 func (i Item) Export(ctx context.Context, params interfaces.ExportItemParams, w io.Writer, op *usecase.Operator) error {
 	if s := params.SchemaPackage.Schema(); s != nil {
 		if err := doCheckPermission(ctx, i.gateways, rbac.ResourceItem, rbac.ActionExport, s.Workspace()); err != nil {
 			return err
 		}
 	}
+	return i.export(ctx, params, w, nil)
+}
 
+func (i Item) export(ctx context.Context, params interfaces.ExportItemParams, w io.Writer, onBatch exportBatchFunc) error {
 	// Create the exporter based on format
 	var exporter exporters.Exporter
 	switch params.Format {
@@ -45,6 +51,8 @@ func (i Item) Export(ctx context.Context, params interfaces.ExportItemParams, w
 		exporter = exporters.NewCSVExporter(w)
 	case exporters.FormatGeoJSON:
 		exporter = exporters.NewGeoJSONExporter(w)
+	case exporters.FormatMVT:
+		exporter = exporters.NewMVTExporter(w)
 	default:
 		return rerror.NewE(i18n.T("unsupported export format"))
 	}
@@ -56,7 +64,7 @@ func (i Item) Export(ctx context.Context, params interfaces.ExportItemParams, w
 		Options: params.Options,
 	}
 
-	if params.Format == exporters.FormatGeoJSON && params.Options.GeometryField == nil {
+	if (params.Format == exporters.FormatGeoJSON || params.Format == exporters.FormatMVT) && params.Options.GeometryField == nil {
 		geoField := params.SchemaPackage.Schema().FirstGeometryField()
 		if geoField == nil {
 			return exporters.ErrNoGeometryField
@@ -64,6 +72,15 @@ func (i Item) Export(ctx context.Context, params interfaces.ExportItemParams, w
 		req.Options.GeometryField = geoField.ID().Ref()
 	}
 
+	// narrow the schema to the requested fields, keeping the geometry field of GeoJSON and MVT features
+	if len(params.Options.Fields) > 0 {
+		fields := params.Options.Fields.Clone()
+		if req.Options.GeometryField != nil {
+			fields = fields.AddUniq(*req.Options.GeometryField)
+		}
+		req.Schema = *params.SchemaPackage.Select(fields)
+	}
+
 	if err := exporter.ValidateRequest(req); err != nil {
 		return err
 	}
@@ -84,7 +101,15 @@ func (i Item) Export(ctx context.Context, params interfaces.ExportItemParams, w
 		ver = nil
 	}
 
+	var query *item.Query
+	if params.Filter != nil || params.Sort != nil {
+		query = item.NewQuery(params.SchemaPackage.Schema().Project(), params.ModelID, nil, "", ver).
+			WithFilter(params.Filter).
+			WithSort(params.Sort)
+	}
+
 	pageInfo := &usecasex.PageInfo{}
+	processed := 0
 
 	for {
 		// Check if context has been cancelled (client disconnect, timeout, etc.)
@@ -95,10 +120,20 @@ func (i Item) Export(ctx context.Context, params interfaces.ExportItemParams, w
 			// Continue processing
 		}
 
-		versionedItems, pi, err := i.repos.Item.FindByModel(ctx, params.ModelID, ver, nil, pagination)
+		var versionedItems item.VersionedList
+		var pi *usecasex.PageInfo
+		var err error
+		if query != nil {
+			versionedItems, pi, err = i.repos.Item.Search(ctx, params.SchemaPackage, query, pagination)
+		} else {
+			versionedItems, pi, err = i.repos.Item.FindByModel(ctx, params.ModelID, ver, nil, pagination)
+		}
 		if err != nil {
 			return rerror.ErrInternalBy(err)
 		}
+		if pi == nil {
+			pi = &usecasex.PageInfo{}
+		}
 		pageInfo = pi
 
 		items := versionedItems.Unwrap()
@@ -159,6 +194,13 @@ func (i Item) Export(ctx context.Context, params interfaces.ExportItemParams, w
 			return err
 		}
 
+		processed += len(items)
+		if onBatch != nil {
+			if err := onBatch(ctx, processed, pageInfo.TotalCount, assets); err != nil {
+				return err
+			}
+		}
+
 		// Check if we have more pages
 		if pageInfo == nil || !pageInfo.HasNextPage {
 			break
@@ -0,0 +1,360 @@
+package interactor
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/exporters"
+	"github.com/reearth/reearth-cms/server/pkg/file"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/job"
+	"github.com/reearth/reearth-cms/server/pkg/rbac"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+)
+
+const (
+	exportDir             = "exports"
+	exportDownloadLinkTTL = 24 * time.Hour
+	exportLockName        = "item_export"
+)
+
+var exportContentTypes = map[string]string{
+	".json":    "application/json",
+	".csv":     "text/csv",
+	".geojson": "application/geo+json",
+	".zip":     "application/zip",
+}
+
+func (i Item) ExportAsync(ctx context.Context, param interfaces.ExportItemsAsyncParam, operator *usecase.Operator) (id.JobID, error) {
+	if !operator.IsUserOrIntegration() {
+		return id.JobID{}, interfaces.ErrInvalidOperator
+	}
+
+	s := param.SchemaPackage.Schema()
+	if !operator.IsReadableWorkspace(s.Workspace()) {
+		return id.JobID{}, interfaces.ErrOperationDenied
+	}
+	if err := doCheckPermission(ctx, i.gateways, rbac.ResourceItem, rbac.ActionExport, s.Workspace()); err != nil {
+		return id.JobID{}, err
+	}
+
+	switch param.Format {
+	case exporters.FormatJSON, exporters.FormatCSV:
+	case exporters.FormatGeoJSON:
+		if s.FirstGeometryField() == nil {
+			return id.JobID{}, exporters.ErrNoGeometryField
+		}
+	default:
+		return id.JobID{}, exporters.ErrUnsupportedFormat
+	}
+
+	payload := &job.ExportPayload{
+		ModelID:       param.ModelID.String(),
+		Format:        string(param.Format),
+		PublicOnly:    param.PublicOnly,
+		IncludeAssets: param.IncludeAssets,
+	}
+	payloadJSON, err := payload.ToJSON()
+	if err != nil {
+		return id.JobID{}, fmt.Errorf("failed to serialize export payload: %w", err)
+	}
+
+	jb := job.New().
+		NewID().
+		Type(job.TypeExport).
+		Project(s.Project()).
+		Payload(payloadJSON)
+
+	if operator.AcOperator != nil && operator.AcOperator.User != nil {
+		jb = jb.User(*operator.AcOperator.User)
+	}
+	if operator.Integration != nil {
+		jb = jb.Integration(*operator.Integration)
+	}
+
+	j, err := jb.Build()
+	if err != nil {
+		return id.JobID{}, fmt.Errorf("failed to create job: %w", err)
+	}
+
+	if err := i.repos.Job.Save(ctx, j); err != nil {
+		return id.JobID{}, fmt.Errorf("failed to save job: %w", err)
+	}
+
+	go i.runExportJob(j.ID(), param)
+
+	log.Infof("item: export job %s created", j.ID())
+	return j.ID(), nil
+}
+
+func (i Item) runExportJob(jobID id.JobID, param interfaces.ExportItemsAsyncParam) {
+	ctx := context.Background()
+
+	j, err := i.repos.Job.FindByID(ctx, jobID)
+	if err != nil {
+		log.Errorf("item: export job %s failed to load: %v", jobID, err)
+		return
+	}
+
+	j.Start()
+	if err := i.repos.Job.Save(ctx, j); err != nil {
+		log.Errorf("item: export job %s failed to update status: %v", jobID, err)
+		return
+	}
+
+	if i.gateways.JobPubSub != nil {
+		_ = i.gateways.JobPubSub.Publish(ctx, jobID, j.State())
+	}
+
+	res, err := i.exportToFile(ctx, j, param)
+	if err != nil {
+		j, _ = i.repos.Job.FindByID(ctx, jobID)
+		if j != nil && j.IsCancelled() {
+			if i.gateways.JobPubSub != nil {
+				_ = i.gateways.JobPubSub.Publish(ctx, jobID, j.State())
+			}
+			log.Infof("item: export job %s was cancelled", jobID)
+			return
+		}
+
+		if j != nil {
+			j.Fail(err.Error())
+			if saveErr := i.repos.Job.Save(ctx, j); saveErr != nil {
+				log.Errorf("item: export job %s failed to save error: %v", jobID, saveErr)
+			}
+			if i.gateways.JobPubSub != nil {
+				_ = i.gateways.JobPubSub.Publish(ctx, jobID, j.State())
+			}
+		}
+		log.Errorf("item: export job %s failed: %v", jobID, err)
+		return
+	}
+
+	resultJSON, _ := res.ToJSON()
+
+	j, _ = i.repos.Job.FindByID(ctx, jobID)
+	if j != nil {
+		j.Complete(resultJSON)
+		if err := i.repos.Job.Save(ctx, j); err != nil {
+			log.Errorf("item: export job %s failed to save completion: %v", jobID, err)
+		}
+		if i.gateways.JobPubSub != nil {
+			_ = i.gateways.JobPubSub.Publish(ctx, jobID, j.State())
+		}
+	}
+
+	if i.gateways.JobPubSub != nil {
+		i.gateways.JobPubSub.Unsubscribe(jobID)
+	}
+
+	log.Infof("item: export job %s completed: total=%d path=%s", jobID, res.Total, res.Path)
+}
+
+// exportToFile writes the items to a temporary file, uploads it to the storage and issues its download link.
+func (i Item) exportToFile(ctx context.Context, j *job.Job, param interfaces.ExportItemsAsyncParam) (*job.ExportResult, error) {
+	m, err := i.repos.Model.FindByID(ctx, param.ModelID)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := os.CreateTemp("", "export-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create export file: %w", err)
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+
+	ext := "." + string(param.Format)
+	filename := m.Key().String() + ext
+
+	var w io.Writer = tmp
+	var zw *zip.Writer
+	if param.IncludeAssets {
+		zw = zip.NewWriter(tmp)
+		if w, err = zw.Create(filename); err != nil {
+			return nil, fmt.Errorf("failed to create export file: %w", err)
+		}
+		ext = ".zip"
+	}
+
+	total := 0
+	var assets asset.List
+	onBatch := func(ctx context.Context, processed int, count int64, batch asset.List) error {
+		currentJob, _ := i.repos.Job.FindByID(ctx, j.ID())
+		if currentJob != nil && currentJob.IsCancelled() {
+			return fmt.Errorf("job cancelled")
+		}
+
+		total = processed
+		for _, a := range batch {
+			if assets.FindByID(a.ID()) == nil {
+				assets = append(assets, a)
+			}
+		}
+
+		progress := job.NewProgress(processed, max(int(count), processed))
+		state := job.NewState(job.StatusInProgress, &progress, "")
+		if i.gateways.JobPubSub != nil {
+			if err := i.gateways.JobPubSub.Publish(ctx, j.ID(), state); err != nil {
+				log.Warnf("item: failed to publish job %s progress: %v", j.ID(), err)
+			}
+		}
+
+		j.SetProgress(progress)
+		if err := i.repos.Job.Save(ctx, j); err != nil {
+			log.Errorf("item: export job %s failed to update progress: %v", j.ID(), err)
+		}
+		return nil
+	}
+
+	if err := i.export(ctx, interfaces.ExportItemParams{
+		ModelID:       param.ModelID,
+		Format:        param.Format,
+		SchemaPackage: param.SchemaPackage,
+		Options: exporters.ExportOptions{
+			PublicOnly:    param.PublicOnly,
+			IncludeAssets: param.IncludeAssets,
+		},
+	}, w, onBatch); err != nil {
+		return nil, err
+	}
+
+	if zw != nil {
+		for _, a := range assets {
+			if err := i.writeAssetToZip(ctx, zw, a); err != nil {
+				return nil, err
+			}
+		}
+		if err := zw.Close(); err != nil {
+			return nil, fmt.Errorf("failed to write export file: %w", err)
+		}
+	}
+
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	p := path.Join(exportDir, fmt.Sprintf("%s-%s%s", m.Key(), j.ID(), ext))
+	if _, err := i.gateways.File.Upload(ctx, &file.File{
+		Content:     tmp,
+		Name:        path.Base(p),
+		Size:        size,
+		ContentType: exportContentTypes[ext],
+	}, p); err != nil {
+		return nil, err
+	}
+
+	expiresAt := util.Now().Add(exportDownloadLinkTTL)
+	url, err := i.gateways.File.IssueDownloadLink(ctx, p, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &job.ExportResult{
+		Total:     total,
+		Path:      p,
+		URL:       url,
+		ExpiresAt: &expiresAt,
+	}, nil
+}
+
+func (i Item) writeAssetToZip(ctx context.Context, zw *zip.Writer, a *asset.Asset) error {
+	if a.UUID() == "" || a.FileName() == "" {
+		return nil
+	}
+
+	r, _, err := i.gateways.File.ReadAsset(ctx, a.UUID(), a.FileName(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to read asset %s: %w", a.ID(), err)
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+
+	w, err := zw.Create(path.Join("assets", a.UUID(), a.FileName()))
+	if err != nil {
+		return fmt.Errorf("failed to write export file: %w", err)
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		return fmt.Errorf("failed to write export file: %w", err)
+	}
+	return nil
+}
+
+// PurgeExpiredExports deletes the exported files whose download links expired by the given time. It is run by the export sweeper.
+// The files of the jobs which no longer exist are deleted as well.
+func (i Item) PurgeExpiredExports(ctx context.Context, now time.Time) ([]string, error) {
+	// only one server instance should delete the same files
+	if err := i.repos.Lock.Lock(ctx, exportLockName); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := i.repos.Lock.Unlock(ctx, exportLockName); err != nil {
+			log.Errorf("export: failed to unlock: %v", err)
+		}
+	}()
+
+	files, err := i.gateways.File.ListByPrefix(ctx, exportDir+"/")
+	if err != nil {
+		return nil, err
+	}
+
+	var purged []string
+	for _, p := range files {
+		expired, err := i.isExpiredExport(ctx, p, now)
+		if err != nil {
+			return purged, err
+		}
+		if !expired {
+			continue
+		}
+		if err := i.gateways.File.Delete(ctx, p); err != nil {
+			return purged, err
+		}
+		purged = append(purged, p)
+	}
+	return purged, nil
+}
+
+// isExpiredExport reports whether the exported file at the path, which is named after its job, has expired.
+func (i Item) isExpiredExport(ctx context.Context, p string, now time.Time) (bool, error) {
+	name := strings.TrimSuffix(path.Base(p), path.Ext(p))
+	jid, err := id.JobIDFrom(name[strings.LastIndex(name, "-")+1:])
+	if err != nil {
+		// not a file written by an export job
+		return false, nil
+	}
+
+	j, err := i.repos.Job.FindByID(ctx, jid)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	res, err := j.ExportResult()
+	if err != nil || res == nil || res.Path != p {
+		// the job is still running, or it did not finish writing the file
+		return j.IsFinished() && j.Status() != job.StatusCompleted, nil
+	}
+	return res.ExpiresAt == nil || !res.ExpiresAt.After(now), nil
+}
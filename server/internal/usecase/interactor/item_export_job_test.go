@@ -0,0 +1,161 @@
+package interactor
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/fs"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/exporters"
+	"github.com/reearth/reearth-cms/server/pkg/file"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/job"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItem_ExportAsync(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	defer util.MockNow(now)()
+
+	ctx := context.Background()
+	db := memory.New()
+	f := lo.Must(fs.NewFile(afero.NewMemMapFs(), "https://example.com", false))
+
+	wid := accountdomain.NewWorkspaceID()
+	uid := accountdomain.NewUserID()
+	pid := id.NewProjectID()
+	sf1 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("name")).MustBuild()
+	sf2 := schema.NewField(schema.NewAsset().TypeProperty()).NewID().Key(id.NewKey("image")).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(pid).Fields(schema.FieldList{sf1, sf2}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(id.NewKey("cities")).Project(pid).MustBuild()
+
+	uuid, size, err := f.UploadAsset(ctx, &file.File{Content: io.NopCloser(strings.NewReader("png")), Name: "tokyo.png", Size: 3})
+	require.NoError(t, err)
+	a := asset.New().NewID().Project(pid).CreatedByUser(uid).Size(uint64(size)).FileName("tokyo.png").UUID(uuid).Thread(id.NewThreadID().Ref()).MustBuild()
+	i1 := item.New().NewID().Schema(s.ID()).Model(m.ID()).Project(pid).Thread(id.NewThreadID().Ref()).User(uid).
+		Fields([]*item.Field{
+			item.NewField(sf1.ID(), value.TypeText.Value("Tokyo").AsMultiple(), nil),
+			item.NewField(sf2.ID(), value.TypeAsset.Value(a.ID()).AsMultiple(), nil),
+		}).MustBuild()
+
+	require.NoError(t, db.Schema.Save(ctx, s))
+	require.NoError(t, db.Model.Save(ctx, m))
+	require.NoError(t, db.Asset.Save(ctx, a))
+	require.NoError(t, db.Item.Save(ctx, i1))
+
+	op := &usecase.Operator{AcOperator: &accountusecase.Operator{User: new(uid), ReadableWorkspaces: accountdomain.WorkspaceIDList{wid}}}
+	itemUC := NewItem(db, &gateway.Container{File: f})
+	sp := *schema.NewPackage(s, nil, nil, nil)
+
+	_, err = itemUC.ExportAsync(ctx, interfaces.ExportItemsAsyncParam{ModelID: m.ID(), SchemaPackage: sp, Format: exporters.FormatCSV}, &usecase.Operator{AcOperator: &accountusecase.Operator{}})
+	assert.Equal(t, interfaces.ErrInvalidOperator, err)
+	_, err = itemUC.ExportAsync(ctx, interfaces.ExportItemsAsyncParam{ModelID: m.ID(), SchemaPackage: sp, Format: exporters.FormatMVT}, op)
+	assert.Equal(t, exporters.ErrUnsupportedFormat, err)
+	_, err = itemUC.ExportAsync(ctx, interfaces.ExportItemsAsyncParam{ModelID: m.ID(), SchemaPackage: sp, Format: exporters.FormatGeoJSON}, op)
+	assert.Equal(t, exporters.ErrNoGeometryField, err)
+
+	newJob := func() *job.Job {
+		j := job.New().NewID().Type(job.TypeExport).Project(pid).User(uid).MustBuild()
+		require.NoError(t, db.Job.Save(ctx, j))
+		return j
+	}
+
+	// csv
+	param := interfaces.ExportItemsAsyncParam{ModelID: m.ID(), SchemaPackage: sp, Format: exporters.FormatCSV}
+	j := newJob()
+	res, err := itemUC.exportToFile(ctx, j, param)
+	require.NoError(t, err)
+	assert.Equal(t, &job.ExportResult{
+		Total:     1,
+		Path:      "exports/cities-" + j.ID().String() + ".csv",
+		URL:       "https://example.com/exports/cities-" + j.ID().String() + ".csv",
+		ExpiresAt: new(now.Add(24 * time.Hour)),
+	}, res)
+	assert.Equal(t, job.NewProgress(1, 1), j.Progress())
+
+	r, _, err := f.Read(ctx, res.Path, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "id,name\n"+i1.ID().String()+",Tokyo\n", string(lo.Must(io.ReadAll(r))))
+
+	// json zipped with the asset files
+	param = interfaces.ExportItemsAsyncParam{ModelID: m.ID(), SchemaPackage: sp, Format: exporters.FormatJSON, IncludeAssets: true}
+	j = newJob()
+	res, err = itemUC.exportToFile(ctx, j, param)
+	require.NoError(t, err)
+	assert.Equal(t, "exports/cities-"+j.ID().String()+".zip", res.Path)
+
+	r, _, err = f.Read(ctx, res.Path, nil)
+	require.NoError(t, err)
+	data := lo.Must(io.ReadAll(r))
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cities.json", "assets/" + uuid + "/tokyo.png"}, lo.Map(zr.File, func(f *zip.File, _ int) string { return f.Name }))
+
+	// cancelled
+	j = newJob()
+	j.Cancel()
+	require.NoError(t, db.Job.Save(ctx, j))
+	_, err = itemUC.exportToFile(ctx, j, param)
+	assert.Error(t, err)
+}
+
+func TestItem_PurgeExpiredExports(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	db := memory.New()
+	f := lo.Must(fs.NewFile(afero.NewMemMapFs(), "https://example.com", false))
+	itemUC := NewItem(db, &gateway.Container{File: f})
+	pid := id.NewProjectID()
+
+	upload := func(p string) {
+		_, err := f.Upload(ctx, &file.File{Content: io.NopCloser(strings.NewReader("x")), Name: path.Base(p), Size: 1}, p)
+		require.NoError(t, err)
+	}
+	newJob := func(expiresAt *time.Time) string {
+		j := job.New().NewID().Type(job.TypeExport).Project(pid).MustBuild()
+		p := "exports/cities-" + j.ID().String() + ".csv"
+		j.Start()
+		if expiresAt != nil {
+			j.Complete(lo.Must((&job.ExportResult{Total: 1, Path: p, ExpiresAt: expiresAt}).ToJSON()))
+		}
+		require.NoError(t, db.Job.Save(ctx, j))
+		upload(p)
+		return p
+	}
+
+	expired := newJob(new(now.Add(-time.Minute)))
+	valid := newJob(new(now.Add(time.Hour)))
+	running := newJob(nil)
+	orphan := "exports/cities-" + id.NewJobID().String() + ".csv"
+	upload(orphan)
+	other := "exports/readme.txt"
+	upload(other)
+
+	res, err := itemUC.PurgeExpiredExports(ctx, now)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{expired, orphan}, res)
+
+	files, err := f.ListByPrefix(ctx, "exports/")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{valid, running, other}, files)
+}
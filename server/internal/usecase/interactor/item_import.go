@@ -672,6 +672,10 @@ func (i Item) saveChunk(ctx context.Context, prj *project.Project, m *model.Mode
 
 			it.UpdateFields(groupFields)
 
+			if err := validateRules(s, it); err != nil {
+				return nil, nil, err
+			}
+
 			if err = i.handleReferenceFields(ctx, *s, it, oldFields); err != nil {
 				return nil, nil, err
 			}
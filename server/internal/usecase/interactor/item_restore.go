@@ -0,0 +1,287 @@
+package interactor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/rbac"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/samber/lo"
+)
+
+// Restore creates a new version of the item whose fields equal the fields of the given past version.
+// The fields which are not valid for the current schema are dropped and reported, and the restored fields are validated
+// as Update does. The metadata item is restored to its version at the time of the past version in the same way.
+func (i Item) Restore(ctx context.Context, itemID id.ItemID, ver version.VersionOrRef, operator *usecase.Operator) (item.Versioned, []item.DroppedField, error) {
+	if !operator.IsUserOrIntegration() {
+		return nil, nil, interfaces.ErrInvalidOperator
+	}
+
+	target, err := i.repos.Item.FindByID(ctx, itemID, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	wid, err := workspaceIDForProject(ctx, i.repos, target.Value().Project())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return Run2(ctx, operator, i.repos,
+		Usecase().
+			WithPermission(i.authz(), rbac.ResourceItem, rbac.ActionUpdate, wid).
+			Transaction(),
+		func(ctx context.Context) (item.Versioned, []item.DroppedField, error) {
+			itm, err := i.repos.Item.FindByID(ctx, itemID, nil)
+			if err != nil {
+				return nil, nil, err
+			}
+			itv := itm.Value()
+			if !operator.CanUpdate(itv) {
+				return nil, nil, interfaces.ErrOperationDenied
+			}
+
+			past, err := i.repos.Item.FindVersionByID(ctx, itemID, ver)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			m, err := i.repos.Model.FindByID(ctx, itv.Model())
+			if err != nil {
+				return nil, nil, err
+			}
+
+			sp, err := NewSchema(i.repos, i.gateways).FindByModel(ctx, m.ID(), operator)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			fields, dropped := item.RestorableFields(past.Value().Fields(), sp)
+			fields, droppedRefs, err := i.dropMissingReferences(ctx, fields)
+			if err != nil {
+				return nil, nil, err
+			}
+			dropped = append(dropped, droppedRefs...)
+
+			if err := validateRequiredFields(fields, sp.Schema(), sp.GroupSchemas()); err != nil {
+				return nil, nil, err
+			}
+			if err := i.checkUnique(ctx, lo.Filter(fields, func(f *item.Field, _ int) bool {
+				return sp.Schema().Field(f.FieldID()) != nil
+			}), sp.Schema(), m.ID(), itv); err != nil {
+				return nil, nil, err
+			}
+			for _, gs := range sp.GroupSchemas() {
+				if err := i.checkUnique(ctx, lo.Filter(fields, func(f *item.Field, _ int) bool {
+					return gs.Field(f.FieldID()) != nil
+				}), gs, m.ID(), itv); err != nil {
+					return nil, nil, err
+				}
+			}
+
+			oldFields := itv.Fields()
+			itv.ReplaceFields(fields)
+
+			if err := validateRules(sp.Schema(), itv); err != nil {
+				return nil, nil, err
+			}
+
+			if operator.AcOperator != nil && operator.AcOperator.User != nil {
+				itv.SetUpdatedByUser(*operator.AcOperator.User)
+			} else if operator.Integration != nil {
+				itv.SetUpdatedByIntegration(*operator.Integration)
+			}
+
+			if mid := itv.MetadataItem(); mid != nil && sp.MetaSchema() != nil {
+				droppedMeta, err := i.restoreMetadata(ctx, *mid, past.Time(), sp.MetaSchema(), operator)
+				if err != nil {
+					return nil, nil, err
+				}
+				dropped = append(dropped, droppedMeta...)
+			}
+
+			if err := i.repos.Item.Save(ctx, itv); err != nil {
+				return nil, nil, err
+			}
+
+			// re-fetch item so the new version is returned
+			itm, err = i.repos.Item.FindByID(ctx, itemID, nil)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if err = i.handleReferenceFields(ctx, *sp.Schema(), itm.Value(), oldFields); err != nil {
+				return nil, nil, err
+			}
+
+			refItems, err := i.getReferencedItems(ctx, itm.Value(), *sp)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			prj, err := i.repos.Project.FindByID(ctx, m.Project())
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if err := i.event(ctx, Event{
+				Project:   prj,
+				Workspace: sp.Schema().Workspace(),
+				Type:      event.ItemUpdate,
+				Object:    itm,
+				WebhookObject: item.ItemModelSchema{
+					Item:            itv,
+					Model:           m,
+					Schema:          sp.Schema(),
+					GroupSchemas:    sp.GroupSchemas(),
+					ReferencedItems: refItems,
+					Changes:         item.CompareFields(itv.Fields(), oldFields),
+					RestoredFrom:    past.Version().Ref(),
+				},
+				Operator: operator.Operator(),
+			}); err != nil {
+				return nil, nil, err
+			}
+
+			return itm, dropped, nil
+		})
+}
+
+// dropMissingReferences drops the reference fields which refer to the items which no longer exist.
+func (i Item) dropMissingReferences(ctx context.Context, fields item.Fields) (item.Fields, []item.DroppedField, error) {
+	refs := fields.FieldsByType(value.TypeReference)
+	ids := lo.Uniq(lo.FlatMap(refs, func(f *item.Field, _ int) []id.ItemID {
+		return lo.FilterMap(f.Value().Values(), func(v *value.Value, _ int) (id.ItemID, bool) {
+			return v.ValueReference()
+		})
+	}))
+	if len(ids) == 0 {
+		return fields, nil, nil
+	}
+
+	found, err := i.repos.Item.FindByIDs(ctx, ids, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	existing := found.ToMap()
+
+	var dropped []item.DroppedField
+	res := lo.Filter(fields, func(f *item.Field, _ int) bool {
+		if f.Type() != value.TypeReference {
+			return true
+		}
+		for _, v := range f.Value().Values() {
+			if rid, ok := v.ValueReference(); ok && existing[rid] == nil {
+				dropped = append(dropped, item.NewDroppedField(f, item.DroppedFieldReasonReferenceNotFound))
+				return false
+			}
+		}
+		return true
+	})
+	return res, dropped, nil
+}
+
+// restoreMetadata restores the metadata item to the latest version at the given time, validating it as the item.
+func (i Item) restoreMetadata(ctx context.Context, metaID id.ItemID, at time.Time, ms *schema.Schema, operator *usecase.Operator) ([]item.DroppedField, error) {
+	versions, err := i.repos.Item.FindAllVersionsByID(ctx, metaID)
+	if err != nil {
+		return nil, err
+	}
+	var past item.Versioned
+	for _, v := range versions {
+		if !v.Time().After(at) && (past == nil || v.Time().After(past.Time())) {
+			past = v
+		}
+	}
+
+	mi, err := i.repos.Item.FindByID(ctx, metaID, nil)
+	if err != nil {
+		return nil, err
+	}
+	// the metadata did not exist at the time, or it has not been changed since then
+	if past == nil || past.Version() == mi.Version() {
+		return nil, nil
+	}
+
+	fields, dropped := item.RestorableFields(past.Value().Fields(), schema.NewPackage(ms, nil, nil, nil))
+	fields, droppedRefs, err := i.dropMissingReferences(ctx, fields)
+	if err != nil {
+		return nil, err
+	}
+	dropped = append(dropped, droppedRefs...)
+
+	miv := mi.Value()
+	if err := validateRequiredFields(fields, ms, nil); err != nil {
+		return nil, err
+	}
+	if err := i.checkUnique(ctx, fields, ms, miv.Model(), miv); err != nil {
+		return nil, err
+	}
+
+	miv.ReplaceFields(fields)
+	if err := validateRules(ms, miv); err != nil {
+		return nil, err
+	}
+
+	if operator.AcOperator != nil && operator.AcOperator.User != nil {
+		miv.SetUpdatedByUser(*operator.AcOperator.User)
+	} else if operator.Integration != nil {
+		miv.SetUpdatedByIntegration(*operator.Integration)
+	}
+	if err := i.repos.Item.Save(ctx, miv); err != nil {
+		return nil, err
+	}
+	return dropped, nil
+}
+
+// validateRequiredFields checks that the required fields have values, as the restored fields replace all the fields of the item.
+// The required fields of the group schemas are checked for each item group.
+func validateRequiredFields(fields item.Fields, s *schema.Schema, groupSchemas schema.List) error {
+	for _, sf := range s.Fields() {
+		if err := validateRequiredField(sf, fields.Field(sf.ID())); err != nil {
+			return err
+		}
+	}
+
+	for _, gs := range groupSchemas {
+		itemGroups := lo.Uniq(lo.FilterMap(fields, func(f *item.Field, _ int) (id.ItemGroupID, bool) {
+			if f.ItemGroup() == nil || gs.Field(f.FieldID()) == nil {
+				return id.ItemGroupID{}, false
+			}
+			return *f.ItemGroup(), true
+		}))
+		for _, ig := range itemGroups {
+			groupFields := item.Fields(fields.FieldsByGroup(ig))
+			for _, sf := range gs.Fields() {
+				if err := validateRequiredField(sf, groupFields.Field(sf.ID())); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func validateRequiredField(sf *schema.Field, f *item.Field) error {
+	if !sf.Required() {
+		return nil
+	}
+	var v *value.Multiple
+	if f != nil {
+		v = f.Value()
+	}
+	if err := sf.Validate(v); err != nil {
+		if fe := schema.NewFieldValidationError(sf, err); fe != nil {
+			return fe
+		}
+		return fmt.Errorf("%w: id=%s key=%s", err, sf.ID(), sf.Name())
+	}
+	return nil
+}
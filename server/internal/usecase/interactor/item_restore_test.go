@@ -0,0 +1,171 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItem_Restore(t *testing.T) {
+	ctx := context.Background()
+	db := memory.New()
+
+	wid := accountdomain.NewWorkspaceID()
+	uid := accountdomain.NewUserID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	sf1 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	sf2 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{sf1, sf2}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(id.RandomKey()).Project(prj.ID()).MustBuild()
+	i := item.New().NewID().User(uid).Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID().Ref()).
+		Fields([]*item.Field{
+			item.NewField(sf1.ID(), value.TypeText.Value("a").AsMultiple(), nil),
+			item.NewField(sf2.ID(), value.TypeText.Value("b").AsMultiple(), nil),
+		}).MustBuild()
+
+	require.NoError(t, db.Project.Save(ctx, prj))
+	require.NoError(t, db.Schema.Save(ctx, s))
+	require.NoError(t, db.Model.Save(ctx, m))
+	require.NoError(t, db.Item.Save(ctx, i))
+
+	op := &usecase.Operator{
+		AcOperator:       &accountusecase.Operator{User: new(uid)},
+		ReadableProjects: id.ProjectIDList{prj.ID()},
+		WritableProjects: id.ProjectIDList{prj.ID()},
+	}
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+
+	versions, err := itemUC.FindAllVersionsByID(ctx, i.ID(), op)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	first := versions[0].Version()
+
+	i2 := item.New().ID(i.ID()).User(uid).Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(i.Thread()).
+		Fields([]*item.Field{item.NewField(sf1.ID(), value.TypeText.Value("c").AsMultiple(), nil)}).MustBuild()
+	require.NoError(t, db.Item.Save(ctx, i2))
+
+	// the field removed from the schema can not be restored
+	s.RemoveField(sf2.ID())
+	require.NoError(t, db.Schema.Save(ctx, s))
+
+	res, dropped, err := itemUC.Restore(ctx, i.ID(), first.OrRef(), op)
+	require.NoError(t, err)
+	assert.Equal(t, "a", res.Value().Field(sf1.ID()).Value().First().Interface())
+	assert.Nil(t, res.Value().Field(sf2.ID()))
+	assert.Equal(t, []item.DroppedField{{Field: sf2.ID(), Reason: item.DroppedFieldReasonRemoved}}, dropped)
+	assert.NotEqual(t, first, res.Version())
+
+	versions, err = itemUC.FindAllVersionsByID(ctx, i.ID(), op)
+	require.NoError(t, err)
+	assert.Len(t, versions, 3)
+
+	_, _, err = itemUC.Restore(ctx, i.ID(), version.New().OrRef(), op)
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+	_, _, err = itemUC.Restore(ctx, i.ID(), first.OrRef(), &usecase.Operator{AcOperator: &accountusecase.Operator{}})
+	assert.Equal(t, interfaces.ErrInvalidOperator, err)
+	_, _, err = itemUC.Restore(ctx, i.ID(), first.OrRef(), &usecase.Operator{AcOperator: &accountusecase.Operator{User: new(accountdomain.NewUserID())}})
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+}
+
+func TestItem_Restore_Validation(t *testing.T) {
+	ctx := context.Background()
+	db := memory.New()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	wid := accountdomain.NewWorkspaceID()
+	uid := accountdomain.NewUserID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	sf1 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	sf2 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{sf1, sf2}).MustBuild()
+	mf1 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	mf2 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	ms := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{mf1, mf2}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Metadata(ms.ID().Ref()).Key(id.RandomKey()).Project(prj.ID()).MustBuild()
+
+	mi := item.New().NewID().User(uid).Schema(ms.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID().Ref()).
+		Fields([]*item.Field{item.NewField(mf1.ID(), value.TypeText.Value("m1").AsMultiple(), nil)}).MustBuild()
+	i := item.New().NewID().User(uid).Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID().Ref()).
+		MetadataItem(mi.ID().Ref()).
+		Fields([]*item.Field{item.NewField(sf1.ID(), value.TypeText.Value("a").AsMultiple(), nil)}).MustBuild()
+
+	require.NoError(t, db.Project.Save(ctx, prj))
+	require.NoError(t, db.Schema.Save(ctx, s))
+	require.NoError(t, db.Schema.Save(ctx, ms))
+	require.NoError(t, db.Model.Save(ctx, m))
+	restoreNow := util.MockNow(now)
+	require.NoError(t, db.Item.Save(ctx, mi))
+	require.NoError(t, db.Item.Save(ctx, i))
+	restoreNow()
+
+	op := &usecase.Operator{
+		AcOperator:       &accountusecase.Operator{User: new(uid)},
+		ReadableProjects: id.ProjectIDList{prj.ID()},
+		WritableProjects: id.ProjectIDList{prj.ID()},
+	}
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+
+	versions, err := itemUC.FindAllVersionsByID(ctx, i.ID(), op)
+	require.NoError(t, err)
+	first := versions[0].Version()
+
+	// the item and its metadata are updated later
+	restoreNow = util.MockNow(now.Add(time.Hour))
+	i.UpdateFields([]*item.Field{item.NewField(sf2.ID(), value.TypeText.Value("b").AsMultiple(), nil)})
+	require.NoError(t, db.Item.Save(ctx, i))
+	mi.UpdateFields([]*item.Field{
+		item.NewField(mf1.ID(), value.TypeText.Value("m2").AsMultiple(), nil),
+		item.NewField(mf2.ID(), value.TypeText.Value("n").AsMultiple(), nil),
+	})
+	require.NoError(t, db.Item.Save(ctx, mi))
+	restoreNow()
+
+	// the field which has become required since the version can not be empty
+	sf2.SetRequired(true)
+	require.NoError(t, db.Schema.Save(ctx, s))
+	_, _, err = itemUC.Restore(ctx, i.ID(), first.OrRef(), op)
+	assert.ErrorIs(t, err, schema.ErrValueRequired)
+	sf2.SetRequired(false)
+	require.NoError(t, db.Schema.Save(ctx, s))
+
+	// so can not the field of the metadata
+	mf2.SetRequired(true)
+	require.NoError(t, db.Schema.Save(ctx, ms))
+	_, _, err = itemUC.Restore(ctx, i.ID(), first.OrRef(), op)
+	assert.ErrorIs(t, err, schema.ErrValueRequired)
+	mf2.SetRequired(false)
+	require.NoError(t, db.Schema.Save(ctx, ms))
+
+	versions, err = itemUC.FindAllVersionsByID(ctx, i.ID(), op)
+	require.NoError(t, err)
+	assert.Len(t, versions, 2)
+
+	// the metadata is restored to the version at the time
+	res, dropped, err := itemUC.Restore(ctx, i.ID(), first.OrRef(), op)
+	require.NoError(t, err)
+	assert.Empty(t, dropped)
+	assert.Nil(t, res.Value().Field(sf2.ID()))
+	gotMeta, err := db.Item.FindByID(ctx, mi.ID(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "m1", gotMeta.Value().Field(mf1.ID()).Value().First().Interface())
+	assert.Nil(t, gotMeta.Value().Field(mf2.ID()))
+}
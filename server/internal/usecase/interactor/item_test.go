@@ -1,18 +1,23 @@
 package interactor
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"testing"
 	"time"
 
+	geojson "github.com/paulmach/go.geojson"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/exporters"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/item/view"
 	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/mvt"
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/request"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
@@ -1641,6 +1646,194 @@ func TestItem_PublishUnpublishBatch(t *testing.T) {
 	}
 }
 
+func Test_itemFieldsFromParams_Locales(t *testing.T) {
+	sf1 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).Localizable(true).Required(true).MustBuild()
+	sf2 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	s := schema.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).Fields(schema.FieldList{sf1, sf2}).MustBuild()
+
+	fields, err := itemFieldsFromParams([]interfaces.ItemFieldParam{
+		{
+			Field:   sf1.ID().Ref(),
+			Value:   "こんにちは",
+			Locales: map[string]any{"en": "hello", "en_us": "howdy", "fr": nil},
+		},
+	}, s)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"en", "en-US", "fr"}, fields[0].Locales())
+	assert.Equal(t, value.TypeText.Value("hello").AsMultiple(), fields[0].LocalizedValue("en"))
+	assert.Equal(t, value.TypeText.Value("howdy").AsMultiple(), fields[0].LocalizedValue("en-US"))
+	assert.True(t, fields[0].LocalizedValue("fr").IsEmpty())
+	assert.Equal(t, value.TypeText.Value("こんにちは").AsMultiple(), fields[0].Value())
+
+	_, err = itemFieldsFromParams([]interfaces.ItemFieldParam{
+		{
+			Field:   sf2.ID().Ref(),
+			Value:   "a",
+			Locales: map[string]any{"en": "b"},
+		},
+	}, s)
+	assert.ErrorIs(t, err, schema.ErrNotLocalizable)
+
+	_, err = itemFieldsFromParams([]interfaces.ItemFieldParam{
+		{
+			Field:   sf1.ID().Ref(),
+			Value:   "a",
+			Locales: map[string]any{"!!": "b"},
+		},
+	}, s)
+	assert.ErrorIs(t, err, project.ErrInvalidLocale)
+}
+
+func TestItem_Update_Locales(t *testing.T) {
+	uId := accountdomain.NewUserID().Ref()
+	prj := project.New().NewID().MustBuild()
+	sf := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).Localizable(true).MustBuild()
+	s := schema.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(prj.ID()).Fields(schema.FieldList{sf}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(id.RandomKey()).Project(s.Project()).MustBuild()
+	f := item.NewField(sf.ID(), value.TypeText.Value("こんにちは").AsMultiple(), nil)
+	f.SetLocalizedValue("en", value.TypeText.Value("hello").AsMultiple())
+	i := item.New().NewID().User(*uId).Model(m.ID()).Project(s.Project()).Schema(s.ID()).Thread(id.NewThreadID().Ref()).Fields(item.Fields{f}).MustBuild()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	lo.Must0(db.Item.Save(ctx, i))
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+	op := &usecase.Operator{
+		AcOperator:       &accountusecase.Operator{User: uId},
+		ReadableProjects: []id.ProjectID{s.Project()},
+		WritableProjects: []id.ProjectID{s.Project()},
+	}
+
+	// the translations are kept when the update has no locales
+	res, err := itemUC.Update(ctx, interfaces.UpdateItemParam{
+		ItemID: i.ID(),
+		Fields: []interfaces.ItemFieldParam{{Field: sf.ID().Ref(), Value: "やあ"}},
+	}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, value.TypeText.Value("やあ").AsMultiple(), res.Value().Field(sf.ID()).Value())
+	assert.Equal(t, value.TypeText.Value("hello").AsMultiple(), res.Value().Field(sf.ID()).LocalizedValue("en"))
+
+	// the translations are cleared by empty locales
+	res, err = itemUC.Update(ctx, interfaces.UpdateItemParam{
+		ItemID: i.ID(),
+		Fields: []interfaces.ItemFieldParam{{Field: sf.ID().Ref(), Value: "やあ", Locales: map[string]any{}}},
+	}, op)
+	assert.NoError(t, err)
+	assert.Nil(t, res.Value().Field(sf.ID()).Locales())
+}
+
+func Test_itemFieldsFromParams_TextValidation(t *testing.T) {
+	tp := schema.NewText(nil)
+	assert.NoError(t, tp.SetValidation(lo.Must(schema.NewTextValidation(nil, "^[0-9]+$", "digits only", nil, ""))))
+	sf := schema.NewField(tp.TypeProperty()).NewID().Key(id.NewKey("code")).Localizable(true).MustBuild()
+	s := schema.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).Fields(schema.FieldList{sf}).MustBuild()
+
+	_, err := itemFieldsFromParams([]interfaces.ItemFieldParam{
+		{Field: sf.ID().Ref(), Value: "123"},
+	}, s)
+	assert.NoError(t, err)
+
+	_, err = itemFieldsFromParams([]interfaces.ItemFieldParam{
+		{Field: sf.ID().Ref(), Value: "abc"},
+	}, s)
+	assert.Equal(t, &schema.FieldValidationError{
+		Field:  "code",
+		Code:   schema.FieldValidationCodePatternMismatch,
+		Detail: "digits only",
+	}, err)
+
+	_, err = itemFieldsFromParams([]interfaces.ItemFieldParam{
+		{Field: sf.ID().Ref(), Value: "123", Locales: map[string]any{"en": "abc"}},
+	}, s)
+	assert.Equal(t, &schema.FieldValidationError{
+		Field:  "code",
+		Code:   schema.FieldValidationCodePatternMismatch,
+		Detail: "digits only (locale=en)",
+	}, err)
+}
+
+func TestItem_Create_Rules(t *testing.T) {
+	prj := project.New().NewID().MustBuild()
+	status := schema.NewField(schema.NewSelect([]string{"draft", "published"}).TypeProperty()).NewID().Key(id.NewKey("status")).MustBuild()
+	startAt := schema.NewField(schema.NewDateTime().TypeProperty()).NewID().Key(id.NewKey("startAt")).MustBuild()
+	endAt := schema.NewField(schema.NewDateTime().TypeProperty()).NewID().Key(id.NewKey("endAt")).MustBuild()
+	published := lo.Must(schema.NewCondition(status.ID(), schema.ConditionOperatorEquals, value.TypeSelect.Value("published")))
+	s := schema.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(prj.ID()).
+		Fields(schema.FieldList{status, startAt, endAt}).
+		Rules(schema.Rules{
+			schema.NewRequiredIfRule(startAt.ID(), published, ""),
+			schema.NewCompareRule(endAt.ID(), schema.CompareOperatorGreaterThan, startAt.ID(), ""),
+		}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(id.RandomKey()).Project(s.Project()).MustBuild()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+
+	op := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			User:               accountdomain.NewUserID().Ref(),
+			ReadableWorkspaces: []accountdomain.WorkspaceID{s.Workspace()},
+			WritableWorkspaces: []accountdomain.WorkspaceID{s.Workspace()},
+		},
+		ReadableProjects: []id.ProjectID{s.Project()},
+		WritableProjects: []id.ProjectID{s.Project()},
+	}
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	item, err := itemUC.Create(ctx, interfaces.CreateItemParam{
+		SchemaID: s.ID(),
+		ModelID:  m.ID(),
+		Fields: []interfaces.ItemFieldParam{
+			{Field: status.ID().Ref(), Value: "published"},
+			{Field: endAt.ID().Ref(), Value: now},
+		},
+	}, op)
+	assert.Equal(t, schema.FieldValidationErrors{
+		{Field: "startAt", Code: schema.FieldValidationCodeRequired, Detail: "value is required when status is published"},
+	}, err)
+	assert.Nil(t, item)
+
+	item, err = itemUC.Create(ctx, interfaces.CreateItemParam{
+		SchemaID: s.ID(),
+		ModelID:  m.ID(),
+		Fields: []interfaces.ItemFieldParam{
+			{Field: status.ID().Ref(), Value: "draft"},
+			{Field: endAt.ID().Ref(), Value: now},
+		},
+	}, op)
+	assert.NoError(t, err)
+
+	// the rules are evaluated against the merged fields of the item
+	_, err = itemUC.Update(ctx, interfaces.UpdateItemParam{
+		ItemID: item.Value().ID(),
+		Fields: []interfaces.ItemFieldParam{
+			{Field: startAt.ID().Ref(), Value: now.Add(time.Hour)},
+		},
+	}, op)
+	assert.Equal(t, schema.FieldValidationErrors{
+		{Field: "endAt", Code: schema.FieldValidationCodeComparisonFailed, Detail: "value must be greater than startAt"},
+	}, err)
+
+	_, err = itemUC.Update(ctx, interfaces.UpdateItemParam{
+		ItemID: item.Value().ID(),
+		Fields: []interfaces.ItemFieldParam{
+			{Field: status.ID().Ref(), Value: "published"},
+			{Field: startAt.ID().Ref(), Value: now.Add(-time.Hour)},
+		},
+	}, op)
+	assert.NoError(t, err)
+}
+
 func TestItem_PublishUnpublishEmpty(t *testing.T) {
 	t.Parallel()
 
@@ -2027,3 +2220,90 @@ func TestItem_PublishUnpublishEmpty(t *testing.T) {
 //		})
 //	}
 //}
+
+func TestItem_Export(t *testing.T) {
+	ctx := context.Background()
+	pid := id.NewProjectID()
+	sf1 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("name")).MustBuild()
+	sf2 := schema.NewField(schema.MustNewInteger(nil, nil).TypeProperty()).NewID().Key(id.NewKey("population")).MustBuild()
+	s := schema.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(pid).Fields(schema.FieldList{sf1, sf2}).MustBuild()
+	mid := id.NewModelID()
+	i1 := item.New().NewID().Schema(s.ID()).Model(mid).Project(pid).Thread(id.NewThreadID().Ref()).Anonymous(true).
+		Fields([]*item.Field{
+			item.NewField(sf1.ID(), value.TypeText.Value("Tokyo").AsMultiple(), nil),
+			item.NewField(sf2.ID(), value.TypeInteger.Value(14000000).AsMultiple(), nil),
+		}).MustBuild()
+
+	db := memory.New()
+	lo.Must0(db.Item.Save(ctx, i1))
+	itemUC := NewItem(db, nil)
+
+	buf := &bytes.Buffer{}
+	err := itemUC.Export(ctx, interfaces.ExportItemParams{
+		ModelID:       mid,
+		Format:        exporters.FormatCSV,
+		SchemaPackage: *schema.NewPackage(s, nil, nil, nil),
+	}, buf, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "id,name,population\n"+i1.ID().String()+",Tokyo,14000000\n", buf.String())
+
+	// projection and sort
+	buf.Reset()
+	err = itemUC.Export(ctx, interfaces.ExportItemParams{
+		ModelID:       mid,
+		Format:        exporters.FormatCSV,
+		SchemaPackage: *schema.NewPackage(s, nil, nil, nil),
+		Options:       exporters.ExportOptions{Fields: id.FieldIDList{sf2.ID()}},
+		Sort:          &view.Sort{Field: view.FieldSelector{Type: view.FieldTypeField, ID: sf2.ID().Ref()}, Direction: view.DirectionAsc},
+	}, buf, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "id,population\n"+i1.ID().String()+",14000000\n", buf.String())
+}
+
+func TestItem_Export_MVT(t *testing.T) {
+	ctx := context.Background()
+	pid := id.NewProjectID()
+	gst := schema.GeometryObjectSupportedTypeList{schema.GeometryObjectSupportedTypePoint}
+	sf1 := schema.NewField(schema.NewGeometryObject(gst).TypeProperty()).NewID().Key(id.NewKey("location")).MustBuild()
+	sf2 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("name")).MustBuild()
+	s := schema.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(pid).Fields(schema.FieldList{sf1, sf2}).MustBuild()
+	mid := id.NewModelID()
+	newItem := func(geo, name string) *item.Item {
+		return item.New().NewID().Schema(s.ID()).Model(mid).Project(pid).Thread(id.NewThreadID().Ref()).Anonymous(true).
+			Fields([]*item.Field{
+				item.NewField(sf1.ID(), value.TypeGeometryObject.Value(geo).AsMultiple(), nil),
+				item.NewField(sf2.ID(), value.TypeText.Value(name).AsMultiple(), nil),
+			}).MustBuild()
+	}
+	i1 := newItem(`{"type":"Point","coordinates":[139.767,35.681]}`, "Tokyo")
+	i2 := newItem(`{"type":"Point","coordinates":[-74.006,40.713]}`, "New York")
+
+	db := memory.New()
+	lo.Must0(db.Item.Save(ctx, i1))
+	lo.Must0(db.Item.Save(ctx, i2))
+	itemUC := NewItem(db, nil)
+
+	tile := mvt.TileID{Z: 1, X: 1, Y: 0}
+	expected := mvt.NewLayer("places")
+	expected.Add(geojson.NewPointGeometry([]float64{139.767, 35.681}), map[string]any{"id": i1.ID().String(), "name": "Tokyo"})
+	want := lo.Must(mvt.Marshal(tile, expected))
+
+	// the geometry field defaults to the first one and items are narrowed to the tile
+	buf := &bytes.Buffer{}
+	err := itemUC.Export(ctx, interfaces.ExportItemParams{
+		ModelID:       mid,
+		Format:        exporters.FormatMVT,
+		SchemaPackage: *schema.NewPackage(s, nil, nil, nil),
+		Options:       exporters.ExportOptions{Tile: &exporters.TileOptions{ID: tile, Layer: "places"}},
+		Filter: &view.Condition{
+			ConditionType: view.ConditionTypeSpatial,
+			SpatialCondition: &view.SpatialCondition{
+				Field: view.FieldSelector{Type: view.FieldTypeField, ID: sf1.ID().Ref()},
+				Op:    view.SpatialOperatorBBox,
+				BBox:  tile.Bounds(),
+			},
+		},
+	}, buf, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, want, buf.Bytes())
+}
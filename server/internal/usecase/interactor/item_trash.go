@@ -0,0 +1,296 @@
+package interactor
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/rbac"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+const trashLockName = "item_trash"
+
+// WithTrashRetention sets how long trashed items are kept, which is reported as the expiry of the trashed items.
+func (i *Item) WithTrashRetention(retention time.Duration) *Item {
+	i.trashRetention = retention
+	return i
+}
+
+func (i Item) FindTrashed(ctx context.Context, modelID id.ModelID, p *usecasex.Pagination, _ *usecase.Operator) ([]interfaces.TrashedItem, *usecasex.PageInfo, error) {
+	entries, pi, err := i.repos.Trash.FindByModel(ctx, modelID, p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items, err := i.repos.Item.FindByIDs(ctx, entries.Items(), version.Trash.Ref())
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := i.checkPermissions(ctx, rbac.ActionList, items.Projects()); err != nil {
+		return nil, nil, err
+	}
+
+	byID := items.ToMap()
+	res := lo.FilterMap(entries, func(e *trash.Entry, _ int) (interfaces.TrashedItem, bool) {
+		itm, ok := byID[e.Item()]
+		if !ok {
+			return interfaces.TrashedItem{}, false
+		}
+		return interfaces.TrashedItem{
+			Item:      itm,
+			Entry:     e,
+			ExpiresAt: e.ExpiresAt(i.trashRetention),
+		}, true
+	})
+	return res, pi, nil
+}
+
+// RestoreTrashed brings the items back from the trash as unpublished items, and restores the references to them
+// which were cleared when they were trashed.
+func (i Item) RestoreTrashed(ctx context.Context, itemIDs id.ItemIDList, operator *usecase.Operator) (item.VersionedList, error) {
+	if !operator.IsUserOrIntegration() {
+		return nil, interfaces.ErrInvalidOperator
+	}
+	if len(itemIDs) == 0 {
+		return nil, interfaces.ErrEmptyIDsList
+	}
+
+	return Run1(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) (item.VersionedList, error) {
+		entries, err := i.findTrashedEntries(ctx, itemIDs, operator)
+		if err != nil {
+			return nil, err
+		}
+
+		for pid, l := range lo.GroupBy(entries, func(e *trash.Entry) id.ProjectID { return e.Project() }) {
+			if err := i.repos.Item.Trash(ctx, trash.List(l).MetadataItems(), pid, false); err != nil {
+				return nil, err
+			}
+			if err := i.repos.Item.Trash(ctx, trash.List(l).Items(), pid, false); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := i.restoreReferences(ctx, entries); err != nil {
+			return nil, err
+		}
+
+		if err := i.repos.Trash.RemoveAll(ctx, entries.Items()); err != nil {
+			return nil, err
+		}
+
+		return i.repos.Item.FindByIDs(ctx, entries.Items(), nil)
+	})
+}
+
+// PurgeTrashed permanently deletes the items in the trash with their metadata items.
+func (i Item) PurgeTrashed(ctx context.Context, itemIDs id.ItemIDList, operator *usecase.Operator) (id.ItemIDList, error) {
+	if !operator.IsUserOrIntegration() {
+		return nil, interfaces.ErrInvalidOperator
+	}
+	if len(itemIDs) == 0 {
+		return nil, interfaces.ErrEmptyIDsList
+	}
+
+	return Run1(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) (id.ItemIDList, error) {
+		entries, err := i.findTrashedEntries(ctx, itemIDs, operator)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := i.purge(ctx, entries); err != nil {
+			return nil, err
+		}
+		return entries.Items(), nil
+	})
+}
+
+// PurgeTrashedBefore permanently deletes the items trashed before the given time. It is run by the trash sweeper.
+func (i Item) PurgeTrashedBefore(ctx context.Context, before time.Time) (id.ItemIDList, error) {
+	// only one server instance should purge the same items
+	if err := i.repos.Lock.Lock(ctx, trashLockName); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := i.repos.Lock.Unlock(ctx, trashLockName); err != nil {
+			log.Errorf("trash: failed to unlock: %v", err)
+		}
+	}()
+
+	entries, err := i.repos.Trash.FindTrashedBefore(ctx, before)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.purge(ctx, entries); err != nil {
+		return nil, err
+	}
+	return entries.Items(), nil
+}
+
+// trash moves the items and their metadata items to the trash, recording the references to them cleared from the other items.
+// The items should belong to the same model.
+func (i Item) trash(ctx context.Context, items item.List, refs map[id.ItemID][]trash.Reference, operator *usecase.Operator) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	now := util.Now()
+	entries := make(trash.List, 0, len(items))
+	for _, itm := range items {
+		b := trash.New().
+			Item(itm.ID()).
+			MetadataItem(itm.MetadataItem()).
+			Project(itm.Project()).
+			Model(itm.Model()).
+			References(refs[itm.ID()]).
+			TrashedAt(now)
+
+		if operator.AcOperator != nil && operator.AcOperator.User != nil {
+			b = b.User(*operator.AcOperator.User)
+		} else if operator.Integration != nil {
+			b = b.Integration(*operator.Integration)
+		}
+
+		e, err := b.Build()
+		if err != nil {
+			return err
+		}
+		entries = append(entries, e)
+	}
+
+	pid := items[0].Project()
+	if err := i.repos.Item.Trash(ctx, items.MetadataIDs(), pid, true); err != nil {
+		return err
+	}
+	if err := i.repos.Item.Trash(ctx, items.IDs(), pid, true); err != nil {
+		return err
+	}
+	return i.repos.Trash.SaveAll(ctx, entries)
+}
+
+func (i Item) purge(ctx context.Context, entries trash.List) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	if err := i.repos.Item.BatchRemove(ctx, entries.MetadataItems()); err != nil {
+		return err
+	}
+	if err := i.repos.Item.BatchRemove(ctx, entries.Items()); err != nil {
+		return err
+	}
+	return i.repos.Trash.RemoveAll(ctx, entries.Items())
+}
+
+// findTrashedEntries returns the trash entries of the items after checking the operator can delete them.
+func (i Item) findTrashedEntries(ctx context.Context, itemIDs id.ItemIDList, operator *usecase.Operator) (trash.List, error) {
+	entries, err := i.repos.Trash.FindByItems(ctx, itemIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	vList, err := i.repos.Item.FindByIDs(ctx, entries.Items(), version.Trash.Ref())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(itemIDs) != len(vList) {
+		if len(vList) == 0 {
+			return nil, rerror.ErrNotFound
+		}
+		return nil, interfaces.ErrPartialNotFound
+	}
+
+	iList := vList.Unwrap()
+	if err := i.checkPermissions(ctx, rbac.ActionDelete, iList.Projects()); err != nil {
+		return nil, err
+	}
+	for _, itm := range iList {
+		if !operator.CanUpdate(itm) {
+			return nil, interfaces.ErrOperationDenied
+		}
+	}
+
+	return entries, nil
+}
+
+// restoreReferences sets the references cleared when the items were trashed back to the referencing items.
+// A reference is skipped when the referencing item or its field no longer exists,
+// or when the field holds a single value that now refers to another item.
+func (i Item) restoreReferences(ctx context.Context, entries trash.List) error {
+	type restoredRef struct {
+		field  id.FieldID
+		target id.ItemID
+	}
+
+	byItem := map[id.ItemID][]restoredRef{}
+	for _, e := range entries {
+		for _, r := range e.References() {
+			byItem[r.Item()] = append(byItem[r.Item()], restoredRef{field: r.Field(), target: e.Item()})
+		}
+	}
+	if len(byItem) == 0 {
+		return nil
+	}
+
+	vList, err := i.repos.Item.FindByIDs(ctx, lo.Keys(byItem), nil)
+	if err != nil {
+		return err
+	}
+	items := vList.Unwrap()
+
+	schemas, err := i.repos.Schema.FindByIDs(ctx, lo.Uniq(lo.Map(items, func(itm *item.Item, _ int) id.SchemaID { return itm.Schema() })))
+	if err != nil {
+		return err
+	}
+
+	updates := lo.Filter(items, func(itm *item.Item, _ int) bool {
+		s := schemas.Schema(itm.Schema().Ref())
+		if s == nil {
+			return false
+		}
+
+		updated := false
+		for _, r := range byItem[itm.ID()] {
+			sf := s.Field(r.field)
+			if sf == nil {
+				continue
+			}
+
+			f := itm.Field(r.field)
+			values := []any{}
+			var group *id.ItemGroupID
+			if f != nil {
+				if lo.ContainsBy(f.Value().Values(), func(v *value.Value) bool {
+					ref, ok := v.ValueReference()
+					return ok && ref == r.target
+				}) {
+					continue
+				}
+				if !sf.Multiple() && !f.Value().IsEmpty() {
+					continue
+				}
+				values = lo.Map(f.Value().Values(), func(v *value.Value, _ int) any { return v.Value() })
+				group = f.ItemGroup()
+			}
+
+			values = append(values, r.target)
+			itm.UpdateFields([]*item.Field{item.NewField(r.field, value.NewMultiple(value.TypeReference, values), group)})
+			updated = true
+		}
+		return updated
+	})
+
+	return i.repos.Item.SaveAll(ctx, updates)
+}
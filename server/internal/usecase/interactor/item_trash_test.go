@@ -0,0 +1,171 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountdomain/user"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItem_Trash(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	defer util.MockNow(now)()
+
+	ctx := context.Background()
+	db := memory.New()
+
+	wid := accountdomain.NewWorkspaceID()
+	pid := id.NewProjectID()
+	u := user.New().NewID().Name("test").Email("test@example.com").Workspace(wid).MustBuild()
+
+	sid1, sid2 := id.NewSchemaID(), id.NewSchemaID()
+	mid1, mid2 := id.NewModelID(), id.NewModelID()
+	refField1ID, refField2ID := id.NewFieldID(), id.NewFieldID()
+	refField1 := schema.NewField(schema.NewReference(mid2, sid2, new(refField2ID), nil).TypeProperty()).
+		ID(refField1ID).Key(id.RandomKey()).MustBuild()
+	refField2 := schema.NewField(schema.NewReference(mid1, sid1, new(refField1ID), nil).TypeProperty()).
+		ID(refField2ID).Key(id.RandomKey()).MustBuild()
+	s1 := schema.New().ID(sid1).Workspace(wid).Project(pid).Fields(schema.FieldList{refField1}).MustBuild()
+	s2 := schema.New().ID(sid2).Workspace(wid).Project(pid).Fields(schema.FieldList{refField2}).MustBuild()
+	m1 := model.New().ID(mid1).Project(pid).Schema(sid1).RandomKey().MustBuild()
+	m2 := model.New().ID(mid2).Project(pid).Schema(sid2).RandomKey().MustBuild()
+
+	iid1, iid2 := id.NewItemID(), id.NewItemID()
+	i1 := item.New().ID(iid1).User(u.ID()).Schema(sid1).Model(mid1).Project(pid).Thread(id.NewThreadID().Ref()).
+		Fields([]*item.Field{item.NewField(refField1ID, value.TypeReference.Value(iid2).AsMultiple(), nil)}).MustBuild()
+	i2 := item.New().ID(iid2).User(u.ID()).Schema(sid2).Model(mid2).Project(pid).Thread(id.NewThreadID().Ref()).
+		Fields([]*item.Field{item.NewField(refField2ID, value.TypeReference.Value(iid1).AsMultiple(), nil)}).MustBuild()
+	i3 := item.New().NewID().User(u.ID()).Schema(sid1).Model(mid1).Project(pid).Thread(id.NewThreadID().Ref()).MustBuild()
+
+	require.NoError(t, db.Schema.SaveAll(ctx, schema.List{s1, s2}))
+	require.NoError(t, db.Model.Save(ctx, m1))
+	require.NoError(t, db.Model.Save(ctx, m2))
+	for _, i := range []*item.Item{i1, i2, i3} {
+		require.NoError(t, db.Item.Save(ctx, i))
+	}
+	require.NoError(t, db.Item.UpdateRef(ctx, iid1, version.Public, version.Latest.OrVersion().Ref()))
+
+	op := &usecase.Operator{
+		AcOperator:       &accountusecase.Operator{User: new(u.ID())},
+		WritableProjects: id.ProjectIDList{pid},
+	}
+	itemUC := NewItem(db, nil).WithTrashRetention(24 * time.Hour)
+	itemUC.ignoreEvent = true
+
+	res, err := itemUC.BatchDelete(ctx, id.ItemIDList{iid1, i3.ID()}, *schema.NewPackage(s1, nil, nil, nil), op)
+	require.NoError(t, err)
+	assert.Equal(t, id.ItemIDList{iid1, i3.ID()}, res)
+
+	// the items are hidden and the reference to the trashed item is cleared
+	_, err = itemUC.FindByID(ctx, iid1, op)
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+	vi2, err := itemUC.FindByID(ctx, iid2, op)
+	require.NoError(t, err)
+	assert.True(t, vi2.Value().Field(refField2ID).Value().IsEmpty())
+
+	trashed, _, err := itemUC.FindTrashed(ctx, mid1, nil, op)
+	require.NoError(t, err)
+	require.Len(t, trashed, 2)
+	assert.ElementsMatch(t, id.ItemIDList{iid1, i3.ID()}, []id.ItemID{trashed[0].Item.Value().ID(), trashed[1].Item.Value().ID()})
+	assert.Equal(t, new(now.Add(24*time.Hour)), trashed[0].ExpiresAt)
+	assert.Equal(t, u.ID().Ref(), trashed[0].Entry.User())
+
+	// restore brings the item back unpublished and re-links the reference
+	restored, err := itemUC.RestoreTrashed(ctx, id.ItemIDList{iid1}, op)
+	require.NoError(t, err)
+	require.Len(t, restored, 1)
+	assert.Equal(t, iid1, restored[0].Value().ID())
+	assert.False(t, restored[0].Refs().Has(version.Public))
+
+	vi2, err = itemUC.FindByID(ctx, iid2, op)
+	require.NoError(t, err)
+	ref, ok := vi2.Value().Field(refField2ID).Value().First().ValueReference()
+	assert.True(t, ok)
+	assert.Equal(t, iid1, ref)
+
+	trashed, _, err = itemUC.FindTrashed(ctx, mid1, nil, op)
+	require.NoError(t, err)
+	require.Len(t, trashed, 1)
+	assert.Equal(t, i3.ID(), trashed[0].Item.Value().ID())
+
+	// items not in the trash can not be restored
+	_, err = itemUC.RestoreTrashed(ctx, id.ItemIDList{iid1}, op)
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+	_, err = itemUC.RestoreTrashed(ctx, nil, op)
+	assert.Equal(t, interfaces.ErrEmptyIDsList, err)
+	_, err = itemUC.RestoreTrashed(ctx, id.ItemIDList{i3.ID()}, &usecase.Operator{AcOperator: &accountusecase.Operator{}})
+	assert.Equal(t, interfaces.ErrInvalidOperator, err)
+	_, err = itemUC.RestoreTrashed(ctx, id.ItemIDList{i3.ID()}, &usecase.Operator{AcOperator: &accountusecase.Operator{User: new(accountdomain.NewUserID())}})
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+}
+
+func TestItem_PurgeTrashed(t *testing.T) {
+	ctx := context.Background()
+	db := memory.New()
+
+	wid := accountdomain.NewWorkspaceID()
+	pid := id.NewProjectID()
+	uid := accountdomain.NewUserID()
+	s := schema.New().NewID().Workspace(wid).Project(pid).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(id.RandomKey()).Project(pid).MustBuild()
+	i1 := item.New().NewID().User(uid).Schema(s.ID()).Model(m.ID()).Project(pid).Thread(id.NewThreadID().Ref()).MustBuild()
+	i2 := item.New().NewID().User(uid).Schema(s.ID()).Model(m.ID()).Project(pid).Thread(id.NewThreadID().Ref()).MustBuild()
+
+	require.NoError(t, db.Schema.Save(ctx, s))
+	require.NoError(t, db.Model.Save(ctx, m))
+	require.NoError(t, db.Item.Save(ctx, i1))
+	require.NoError(t, db.Item.Save(ctx, i2))
+
+	op := &usecase.Operator{
+		AcOperator:       &accountusecase.Operator{User: new(uid)},
+		WritableProjects: id.ProjectIDList{pid},
+	}
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+
+	_, err := itemUC.PurgeTrashed(ctx, id.ItemIDList{i1.ID()}, op)
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+
+	_, err = itemUC.BatchDelete(ctx, id.ItemIDList{i1.ID(), i2.ID()}, *schema.NewPackage(s, nil, nil, nil), op)
+	require.NoError(t, err)
+
+	res, err := itemUC.PurgeTrashed(ctx, id.ItemIDList{i1.ID()}, op)
+	require.NoError(t, err)
+	assert.Equal(t, id.ItemIDList{i1.ID()}, res)
+
+	_, err = db.Item.FindByID(ctx, i1.ID(), version.Trash.Ref())
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+	_, err = db.Item.FindByID(ctx, i2.ID(), version.Trash.Ref())
+	assert.NoError(t, err)
+
+	// the trash of the past 24 hours is kept by the sweeper
+	res, err = itemUC.PurgeTrashedBefore(ctx, util.Now().Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, res)
+
+	res, err = itemUC.PurgeTrashedBefore(ctx, util.Now().Add(time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, id.ItemIDList{i2.ID()}, res)
+
+	trashed, _, err := itemUC.FindTrashed(ctx, m.ID(), nil, op)
+	require.NoError(t, err)
+	assert.Empty(t, trashed)
+	_, err = db.Item.FindByID(ctx, i2.ID(), version.Trash.Ref())
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+}
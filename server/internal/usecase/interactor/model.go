@@ -162,7 +162,14 @@ func (i Model) Create(ctx context.Context, param interfaces.CreateModelParam, op
 			if !operator.IsWritableProject(param.ProjectId) {
 				return nil, interfaces.ErrOperationDenied
 			}
-			return i.create(ctx, param)
+			m, err := i.create(ctx, param)
+			if err != nil {
+				return nil, err
+			}
+			if err := i.event(ctx, m, event.ModelCreate, operator); err != nil {
+				return nil, err
+			}
+			return m, nil
 		})
 }
 
@@ -290,6 +297,9 @@ func (i Model) Update(ctx context.Context, param interfaces.UpdateModelParam, op
 			if err := i.repos.Model.Save(ctx, m); err != nil {
 				return nil, err
 			}
+			if err := i.event(ctx, m, event.ModelUpdate, operator); err != nil {
+				return nil, err
+			}
 			return m, nil
 		})
 }
@@ -369,10 +379,29 @@ func (i Model) Delete(ctx context.Context, modelID id.ModelID, sp schema.Package
 			if err := i.repos.Model.Remove(ctx, modelID); err != nil {
 				return err
 			}
-			return i.repos.Model.SaveAll(ctx, res)
+			if err := i.repos.Model.SaveAll(ctx, res); err != nil {
+				return err
+			}
+			return i.event(ctx, m, event.ModelDelete, operator)
 		})
 }
 
+func (i Model) event(ctx context.Context, m *model.Model, t event.Type, operator *usecase.Operator) error {
+	prj, err := i.repos.Project.FindByID(ctx, m.Project())
+	if err != nil {
+		return err
+	}
+
+	_, err = createEvent(ctx, i.repos, i.gateways, Event{
+		Project:   prj,
+		Workspace: prj.Workspace(),
+		Type:      t,
+		Object:    m,
+		Operator:  operator.Operator(),
+	})
+	return err
+}
+
 func (i Model) removeReferenceFieldsPointingToSchema(ctx context.Context, m *model.Model) error {
 	var models model.List
 	p := usecasex.CursorPagination{First: new(int64(1000))}.Wrap()
@@ -458,7 +487,7 @@ func (i Model) deleteItemsByModel(ctx context.Context, prj *project.Project, m *
 				})
 			}
 
-			if err := itemInteractor.handleRelatedReferenceFields(ctx, items.IDs(), sp); err != nil {
+			if _, err := itemInteractor.handleRelatedReferenceFields(ctx, items.IDs(), sp); err != nil {
 				return err
 			}
 		}
@@ -473,6 +502,9 @@ func (i Model) deleteItemsByModel(ctx context.Context, prj *project.Project, m *
 	if err := i.repos.Item.RemoveByModel(ctx, m.ID()); err != nil {
 		return err
 	}
+	if err := i.repos.Trash.RemoveByModel(ctx, m.ID()); err != nil {
+		return err
+	}
 
 	// delete threads that belonged to the deleted items
 	if len(allThreadIDs) > 0 {
@@ -505,6 +537,8 @@ func (i Model) FindOrCreateSchema(ctx context.Context, param interfaces.FindOrCr
 			return nil, err
 		}
 		projectID = g.Project()
+	} else if param.ProjectID != nil {
+		projectID = *param.ProjectID
 	} else {
 		return nil, interfaces.ErrEitherModelOrGroup
 	}
@@ -564,6 +598,8 @@ func (i Model) FindOrCreateSchema(ctx context.Context, param interfaces.FindOrCr
 					return nil, err
 				}
 				sid = g.Schema()
+			} else if param.ProjectID != nil {
+				return i.findOrCreateAssetMetadataSchema(ctx, *param.ProjectID, param.Create, operator)
 			} else {
 				return nil, interfaces.ErrEitherModelOrGroup
 			}
@@ -573,6 +609,36 @@ func (i Model) FindOrCreateSchema(ctx context.Context, param interfaces.FindOrCr
 		})
 }
 
+func (i Model) findOrCreateAssetMetadataSchema(ctx context.Context, pid id.ProjectID, create bool, operator *usecase.Operator) (*schema.Schema, error) {
+	p, err := i.repos.Project.FindByID(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	if sid := p.AssetMetadataSchema(); sid != nil {
+		return i.repos.Schema.FindByID(ctx, *sid)
+	}
+	if !create {
+		return nil, interfaces.ErrAssetMetadataSchemaNotFound
+	}
+	if !operator.IsWritableProject(p.ID()) {
+		return nil, interfaces.ErrOperationDenied
+	}
+
+	s, err := schema.New().NewID().Workspace(p.Workspace()).Project(p.ID()).TitleField(nil).Build()
+	if err != nil {
+		return nil, err
+	}
+	p.SetAssetMetadataSchema(s.ID())
+
+	if err := i.repos.Schema.Save(ctx, s); err != nil {
+		return nil, err
+	}
+	if err := i.repos.Project.Save(ctx, p); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
 func (i Model) UpdateOrder(ctx context.Context, ids id.ModelIDList, operator *usecase.Operator) (model.List, error) {
 	if len(ids) == 0 {
 		return nil, nil
@@ -697,6 +763,10 @@ func (i Model) Copy(ctx context.Context, params interfaces.CopyModelParam, opera
 				}
 			}
 
+			if err := i.event(ctx, newModel, event.ModelCreate, operator); err != nil {
+				return nil, err
+			}
+
 			return newModel, nil
 		})
 }
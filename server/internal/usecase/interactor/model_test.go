@@ -24,6 +24,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/account/accountdomain/user"
+	"github.com/reearth/reearthx/account/accountdomain/workspace"
 	"github.com/reearth/reearthx/account/accountusecase"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/stretchr/testify/assert"
@@ -1152,7 +1153,8 @@ func TestNewModel(t *testing.T) {
 }
 
 func TestModel_Copy(t *testing.T) {
-	wid := accountdomain.NewWorkspaceID()
+	ws := workspace.New().NewID().MustBuild()
+	wid := ws.ID()
 	p := project.New().NewID().Workspace(wid).MustBuild()
 	op := &usecase.Operator{
 		OwningProjects: []id.ProjectID{p.ID()},
@@ -1174,7 +1176,9 @@ func TestModel_Copy(t *testing.T) {
 	ctx := context.Background()
 	db := memory.New()
 
-	err := db.Project.Save(ctx, p.Clone())
+	err := db.Workspace.Save(ctx, ws)
+	assert.NoError(t, err)
+	err = db.Project.Save(ctx, p.Clone())
 	assert.NoError(t, err)
 	err = db.Model.Save(ctx, m.Clone())
 	assert.NoError(t, err)
@@ -0,0 +1,306 @@
+package interactor
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"fmt"
+	htmltmpl "html/template"
+	texttmpl "text/template"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountdomain/user"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+)
+
+var (
+	//go:embed emails/notification_text.tmpl emails/notification_html.tmpl
+	notificationEmails embed.FS
+
+	notificationTextTemplate = texttmpl.Must(texttmpl.ParseFS(notificationEmails, "emails/notification_text.tmpl"))
+	notificationHTMLTemplate = htmltmpl.Must(htmltmpl.ParseFS(notificationEmails, "emails/notification_html.tmpl"))
+)
+
+const notificationDigestLockName = "notification_digest"
+
+type Notification struct {
+	repos    *repo.Container
+	gateways *gateway.Container
+}
+
+func NewNotification(r *repo.Container, g *gateway.Container) interfaces.Notification {
+	return &Notification{
+		repos:    r,
+		gateways: g,
+	}
+}
+
+func (i *Notification) FindByUser(ctx context.Context, unreadOnly bool, pagination *usecasex.Pagination, operator *usecase.Operator) (notification.List, *usecasex.PageInfo, error) {
+	if operator.AcOperator.User == nil {
+		return nil, nil, interfaces.ErrInvalidOperator
+	}
+	return i.repos.Notification.FindByUser(ctx, *operator.AcOperator.User, unreadOnly, pagination)
+}
+
+func (i *Notification) CountUnread(ctx context.Context, operator *usecase.Operator) (int64, error) {
+	if operator.AcOperator.User == nil {
+		return 0, interfaces.ErrInvalidOperator
+	}
+	return i.repos.Notification.CountUnreadByUser(ctx, *operator.AcOperator.User)
+}
+
+func (i *Notification) MarkAsRead(ctx context.Context, ids id.NotificationIDList, operator *usecase.Operator) (notification.List, error) {
+	if operator.AcOperator.User == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) (notification.List, error) {
+		// only the unread notifications of the operator can be marked
+		unread, err := i.repos.Notification.FindUnreadByUser(ctx, *operator.AcOperator.User)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) > 0 {
+			unread = lo.Filter(unread, func(n *notification.Notification, _ int) bool { return ids.Has(n.ID()) })
+		}
+
+		for _, n := range unread {
+			n.MarkAsRead()
+		}
+		if err := i.repos.Notification.SaveAll(ctx, unread); err != nil {
+			return nil, err
+		}
+		return unread, nil
+	})
+}
+
+func (i *Notification) FindPreference(ctx context.Context, operator *usecase.Operator) (*notification.Preference, error) {
+	if operator.AcOperator.User == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	prefs, err := i.repos.NotificationPreference.FindByUsers(ctx, accountdomain.UserIDList{*operator.AcOperator.User})
+	if err != nil {
+		return nil, err
+	}
+	return prefs.For(*operator.AcOperator.User), nil
+}
+
+func (i *Notification) UpdatePreference(ctx context.Context, param interfaces.UpdateNotificationPreferenceParam, operator *usecase.Operator) (*notification.Preference, error) {
+	if operator.AcOperator.User == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) (*notification.Preference, error) {
+		current, err := i.FindPreference(ctx, operator)
+		if err != nil {
+			return nil, err
+		}
+
+		frequency := current.EmailFrequency()
+		if param.EmailFrequency != nil {
+			f, ok := notification.EmailFrequencyFrom(param.EmailFrequency.String())
+			if !ok {
+				return nil, interfaces.ErrInvalidEmailFrequency
+			}
+			frequency = f
+		}
+		muted := current.MutedTypes()
+		if param.MutedTypes != nil {
+			muted = param.MutedTypes
+		}
+
+		p := notification.NewPreference(*operator.AcOperator.User, frequency, muted)
+		if err := i.repos.NotificationPreference.Save(ctx, p); err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
+func (i *Notification) SendDigests(ctx context.Context) (notification.List, error) {
+	// only one server instance should send the same digests
+	if err := i.repos.Lock.Lock(ctx, notificationDigestLockName); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := i.repos.Lock.Unlock(ctx, notificationDigestLockName); err != nil {
+			log.Errorf("notification: failed to unlock: %v", err)
+		}
+	}()
+
+	pending, err := i.repos.Notification.FindEmailPending(ctx)
+	if err != nil || len(pending) == 0 {
+		return nil, err
+	}
+
+	users, err := i.repos.User.FindByIDs(ctx, pending.Users())
+	if err != nil {
+		return nil, err
+	}
+
+	sent := notification.List{}
+	for uid, l := range pending.GroupByUser() {
+		u, _ := lo.Find(users, func(u *user.User) bool { return u.ID() == uid })
+		if u != nil {
+			if err := sendNotificationEmail(i.gateways, u, l); err != nil {
+				// the notifications stay pending and are sent with the next digest
+				log.Warnf("notification: failed to send digest to user %s: %v", uid, err)
+				continue
+			}
+		}
+		for _, n := range l {
+			n.SetEmailPending(false)
+		}
+		sent = append(sent, l...)
+	}
+
+	if err := i.repos.Notification.SaveAll(ctx, sent); err != nil {
+		return nil, err
+	}
+	return sent, nil
+}
+
+type notificationParam struct {
+	Workspace accountdomain.WorkspaceID
+	Type      notification.Type
+	Actor     *accountdomain.UserID
+	Request   *id.RequestID
+	Thread    *id.ThreadID
+	Comment   *id.CommentID
+	Title     string
+	Content   string
+}
+
+// notify creates the notifications of the recipients except the actor and the ones who muted the type.
+// The notifications are emailed once the transaction commits or left for the digest depending on the preference of each recipient.
+func notify(ctx context.Context, r *repo.Container, g *gateway.Container, param notificationParam, recipients accountdomain.UserIDList) (notification.List, error) {
+	recipients = lo.Filter(lo.Uniq(recipients), func(u accountdomain.UserID, _ int) bool {
+		return param.Actor == nil || u != *param.Actor
+	})
+	if len(recipients) == 0 {
+		return nil, nil
+	}
+
+	prefs, err := r.NotificationPreference.FindByUsers(ctx, recipients)
+	if err != nil {
+		return nil, err
+	}
+
+	res := notification.List{}
+	instant := notification.List{}
+	for _, u := range recipients {
+		p := prefs.For(u)
+		if p.IsMuted(param.Type) {
+			continue
+		}
+
+		n, err := notification.New().
+			NewID().
+			Workspace(param.Workspace).
+			User(u).
+			Type(param.Type).
+			Actor(param.Actor).
+			Request(param.Request).
+			Thread(param.Thread).
+			Comment(param.Comment).
+			Title(param.Title).
+			Content(param.Content).
+			EmailPending(p.EmailFrequency() == notification.EmailFrequencyDigest).
+			Build()
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, n)
+		if p.EmailFrequency() == notification.EmailFrequencyInstant {
+			instant = append(instant, n)
+		}
+	}
+
+	if err := r.Notification.SaveAll(ctx, res); err != nil {
+		return nil, err
+	}
+
+	if len(instant) > 0 {
+		users, err := r.User.FindByIDs(ctx, instant.Users())
+		if err != nil {
+			return nil, err
+		}
+		// emails are sent on a best-effort basis once the notifications are saved, they are still shown in the feed
+		afterCommit(ctx, func() {
+			for _, u := range users {
+				if err := sendNotificationEmail(g, u, instant.GroupByUser()[u.ID()]); err != nil {
+					log.Warnf("notification: failed to send email to user %s: %v", u.ID(), err)
+				}
+			}
+		})
+	}
+
+	return res, nil
+}
+
+type notificationEmail struct {
+	UserName      string
+	Notifications []notificationEmailItem
+}
+
+type notificationEmailItem struct {
+	Message string
+	Content string
+}
+
+func sendNotificationEmail(g *gateway.Container, u *user.User, l notification.List) error {
+	if g == nil || g.Mailer == nil || len(l) == 0 {
+		return nil
+	}
+
+	data := notificationEmail{
+		UserName: u.Name(),
+		Notifications: lo.Map(l, func(n *notification.Notification, _ int) notificationEmailItem {
+			return notificationEmailItem{Message: notificationMessage(n), Content: n.Content()}
+		}),
+	}
+
+	var text, html bytes.Buffer
+	if err := notificationTextTemplate.Execute(&text, data); err != nil {
+		return err
+	}
+	if err := notificationHTMLTemplate.Execute(&html, data); err != nil {
+		return err
+	}
+
+	subject := data.Notifications[0].Message
+	if len(l) > 1 {
+		subject = fmt.Sprintf("You have %d new notifications", len(l))
+	}
+
+	return g.Mailer.SendMail([]gateway.Contact{{Email: u.Email(), Name: u.Name()}}, subject, text.String(), html.String())
+}
+
+func notificationMessage(n *notification.Notification) string {
+	switch n.Type() {
+	case notification.TypeRequestAssigned:
+		return fmt.Sprintf("You were assigned as a reviewer of the request %q", n.Title())
+	case notification.TypeRequestApproved:
+		return fmt.Sprintf("Your request %q was approved", n.Title())
+	case notification.TypeRequestChangesRequested:
+		return fmt.Sprintf("Changes were requested on your request %q", n.Title())
+	case notification.TypeRequestClosed:
+		return fmt.Sprintf("Your request %q was closed", n.Title())
+	case notification.TypeComment:
+		return "There is a new comment on a thread you participate in"
+	case notification.TypeMention:
+		return "You were mentioned in a comment"
+	default:
+		return "You have a new notification"
+	}
+}
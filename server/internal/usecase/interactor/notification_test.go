@@ -0,0 +1,163 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountdomain/user"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mailerMock struct {
+	sent []gateway.Contact
+}
+
+func (m *mailerMock) SendMail(to []gateway.Contact, _, _, _ string) error {
+	m.sent = append(m.sent, to...)
+	return nil
+}
+
+func TestNotify(t *testing.T) {
+	ctx := context.Background()
+	db := memory.New()
+	mailer := &mailerMock{}
+	g := &gateway.Container{Mailer: mailer}
+
+	wid := accountdomain.NewWorkspaceID()
+	actor := user.New().NewID().Name("actor").Email("actor@example.com").Workspace(wid).MustBuild()
+	u1 := user.New().NewID().Name("u1").Email("u1@example.com").Workspace(wid).MustBuild()
+	u2 := user.New().NewID().Name("u2").Email("u2@example.com").Workspace(wid).MustBuild()
+	u3 := user.New().NewID().Name("u3").Email("u3@example.com").Workspace(wid).MustBuild()
+	for _, u := range []*user.User{actor, u1, u2, u3} {
+		require.NoError(t, db.User.Save(ctx, u))
+	}
+	require.NoError(t, db.NotificationPreference.Save(ctx, notification.NewPreference(u2.ID(), notification.EmailFrequencyDigest, nil)))
+	require.NoError(t, db.NotificationPreference.Save(ctx, notification.NewPreference(u3.ID(), notification.EmailFrequencyInstant, []notification.Type{notification.TypeRequestAssigned})))
+
+	res, err := notify(ctx, db, g, notificationParam{
+		Workspace: wid,
+		Type:      notification.TypeRequestAssigned,
+		Actor:     actor.ID().Ref(),
+		Request:   id.NewRequestID().Ref(),
+		Title:     "request",
+	}, accountdomain.UserIDList{actor.ID(), u1.ID(), u2.ID(), u3.ID()})
+	require.NoError(t, err)
+
+	// the actor and the user who muted the type are not notified
+	assert.Equal(t, accountdomain.UserIDList{u1.ID(), u2.ID()}, res.Users())
+	// only the users who want instant emails are emailed
+	assert.Equal(t, []gateway.Contact{{Email: "u1@example.com", Name: "u1"}}, mailer.sent)
+	pending, err := db.Notification.FindEmailPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, accountdomain.UserIDList{u2.ID()}, pending.Users())
+
+	uc := NewNotification(db, g)
+	sent, err := uc.SendDigests(ctx)
+	require.NoError(t, err)
+	assert.Len(t, sent, 1)
+	assert.Equal(t, []gateway.Contact{{Email: "u1@example.com", Name: "u1"}, {Email: "u2@example.com", Name: "u2"}}, mailer.sent)
+	pending, err = db.Notification.FindEmailPending(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestNotify_AfterCommit(t *testing.T) {
+	ctx := context.Background()
+	db := memory.New()
+	mailer := &mailerMock{}
+	g := &gateway.Container{Mailer: mailer}
+
+	wid := accountdomain.NewWorkspaceID()
+	u := user.New().NewID().Name("u").Email("u@example.com").Workspace(wid).MustBuild()
+	require.NoError(t, db.User.Save(ctx, u))
+	param := notificationParam{Workspace: wid, Type: notification.TypeComment, Thread: id.NewThreadID().Ref()}
+
+	// no email is sent when the transaction fails
+	err := Run0(ctx, nil, db, Usecase().Transaction(), func(ctx context.Context) error {
+		if _, err := notify(ctx, db, g, param, accountdomain.UserIDList{u.ID()}); err != nil {
+			return err
+		}
+		return errors.New("failed")
+	})
+	assert.EqualError(t, err, "failed")
+	assert.Empty(t, mailer.sent)
+
+	// the email is sent once the transaction commits
+	err = Run0(ctx, nil, db, Usecase().Transaction(), func(ctx context.Context) error {
+		if _, err := notify(ctx, db, g, param, accountdomain.UserIDList{u.ID()}); err != nil {
+			return err
+		}
+		assert.Empty(t, mailer.sent)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []gateway.Contact{{Email: "u@example.com", Name: "u"}}, mailer.sent)
+}
+
+func TestNotification_MarkAsRead(t *testing.T) {
+	ctx := context.Background()
+	db := memory.New()
+
+	wid := accountdomain.NewWorkspaceID()
+	uid := accountdomain.NewUserID()
+	n1 := notification.New().NewID().Workspace(wid).User(uid).Type(notification.TypeComment).MustBuild()
+	n2 := notification.New().NewID().Workspace(wid).User(uid).Type(notification.TypeComment).MustBuild()
+	n3 := notification.New().NewID().Workspace(wid).User(accountdomain.NewUserID()).Type(notification.TypeComment).MustBuild()
+	require.NoError(t, db.Notification.SaveAll(ctx, notification.List{n1, n2, n3}))
+
+	op := &usecase.Operator{AcOperator: &accountusecase.Operator{User: &uid}}
+	uc := NewNotification(db, nil)
+
+	_, err := uc.MarkAsRead(ctx, nil, &usecase.Operator{AcOperator: &accountusecase.Operator{}})
+	assert.Equal(t, interfaces.ErrInvalidOperator, err)
+
+	// the notifications of other users are not marked
+	res, err := uc.MarkAsRead(ctx, id.NotificationIDList{n1.ID(), n3.ID()}, op)
+	require.NoError(t, err)
+	assert.Equal(t, id.NotificationIDList{n1.ID()}, id.NotificationIDList{res[0].ID()})
+	count, err := uc.CountUnread(ctx, op)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	res, err = uc.MarkAsRead(ctx, nil, op)
+	require.NoError(t, err)
+	assert.Len(t, res, 1)
+	count, err = uc.CountUnread(ctx, op)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestNotification_UpdatePreference(t *testing.T) {
+	ctx := context.Background()
+	db := memory.New()
+	uid := accountdomain.NewUserID()
+	op := &usecase.Operator{AcOperator: &accountusecase.Operator{User: &uid}}
+	uc := NewNotification(db, nil)
+
+	p, err := uc.FindPreference(ctx, op)
+	require.NoError(t, err)
+	assert.Equal(t, notification.DefaultPreference(uid), p)
+
+	_, err = uc.UpdatePreference(ctx, interfaces.UpdateNotificationPreferenceParam{EmailFrequency: new(notification.EmailFrequency("weekly"))}, op)
+	assert.Equal(t, interfaces.ErrInvalidEmailFrequency, err)
+
+	_, err = uc.UpdatePreference(ctx, interfaces.UpdateNotificationPreferenceParam{EmailFrequency: new(notification.EmailFrequencyDigest)}, op)
+	require.NoError(t, err)
+	p, err = uc.UpdatePreference(ctx, interfaces.UpdateNotificationPreferenceParam{MutedTypes: []notification.Type{notification.TypeComment}}, op)
+	require.NoError(t, err)
+	assert.Equal(t, notification.NewPreference(uid, notification.EmailFrequencyDigest, []notification.Type{notification.TypeComment}), p)
+
+	got, err := uc.FindPreference(ctx, op)
+	require.NoError(t, err)
+	assert.Equal(t, p, got)
+}
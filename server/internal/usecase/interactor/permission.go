@@ -34,3 +34,15 @@ func workspaceIDForProject(ctx context.Context, r *repo.Container, projectID id.
 	}
 	return p.Workspace(), nil
 }
+
+// gatewaysWithoutAuthorization returns a copy of the gateways without the authorization gateway.
+// The permission checks of the authorization gateway need the credentials of a request, which background jobs
+// do not have, so a job has to check the permissions of the operator it runs for by itself.
+func gatewaysWithoutAuthorization(gateways *gateway.Container) *gateway.Container {
+	var g gateway.Container
+	if gateways != nil {
+		g = *gateways
+	}
+	g.Authorization = nil
+	return &g
+}
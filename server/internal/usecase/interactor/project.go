@@ -313,6 +313,18 @@ func (i *Project) Update(ctx context.Context, param interfaces.UpdateProjectPara
 				p.SetTopics(*param.Topics)
 			}
 
+			if param.Localization != nil {
+				if len(param.Localization.Locales) == 0 {
+					p.SetLocalization(nil)
+				} else {
+					l, err := project.NewLocalization(param.Localization.DefaultLocale, param.Localization.Locales, param.Localization.Fallbacks)
+					if err != nil {
+						return nil, err
+					}
+					p.SetLocalization(l)
+				}
+			}
+
 			p.SetUpdatedAt(util.Now())
 			if err := i.repos.Project.Save(ctx, p); err != nil {
 				return nil, err
@@ -1168,6 +1168,53 @@ func TestProject_UpdatePostingSettings_RoleEnforcement(t *testing.T) {
 	}
 }
 
+func TestProject_UpdateLocalization(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	wid := accountdomain.NewWorkspaceID()
+	pid := id.NewProjectID()
+	p := project.New().ID(pid).Workspace(wid).MustBuild()
+	op := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			User:             new(accountdomain.NewUserID()),
+			OwningWorkspaces: []accountdomain.WorkspaceID{wid},
+		},
+	}
+
+	db := memory.New()
+	assert.NoError(t, db.Project.Save(ctx, p))
+	projectUC := NewProject(db, nil)
+
+	got, err := projectUC.Update(ctx, interfaces.UpdateProjectParam{
+		ID: pid,
+		Localization: &interfaces.LocalizationParam{
+			DefaultLocale: "ja",
+			Locales:       []string{"ja", "en"},
+			Fallbacks:     map[string][]string{"en": {"ja"}},
+		},
+	}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, "ja", got.Localization().DefaultLocale())
+	assert.Equal(t, []string{"ja", "en"}, got.Localization().Locales())
+
+	_, err = projectUC.Update(ctx, interfaces.UpdateProjectParam{
+		ID: pid,
+		Localization: &interfaces.LocalizationParam{
+			DefaultLocale: "fr",
+			Locales:       []string{"ja", "en"},
+		},
+	}, op)
+	assert.Equal(t, project.ErrDefaultLocaleMissing, err)
+
+	got, err = projectUC.Update(ctx, interfaces.UpdateProjectParam{
+		ID:           pid,
+		Localization: &interfaces.LocalizationParam{},
+	}, op)
+	assert.NoError(t, err)
+	assert.Nil(t, got.Localization())
+}
+
 func TestProject_CheckAlias(t *testing.T) {
 	wid1 := accountdomain.NewWorkspaceID()
 	wid2 := accountdomain.NewWorkspaceID()
@@ -12,6 +12,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
 	"github.com/reearth/reearth-cms/server/pkg/rbac"
 	"github.com/reearth/reearth-cms/server/pkg/request"
 	"github.com/reearth/reearth-cms/server/pkg/version"
@@ -128,6 +129,11 @@ func (r Request) Create(ctx context.Context, param interfaces.CreateRequestParam
 			return nil, err
 		}
 
+		wf, err := r.workflowForItems(ctx, param.ProjectID, *items)
+		if err != nil {
+			return nil, err
+		}
+
 		builder := request.New().
 			NewID().
 			Workspace(ws.ID()).
@@ -137,7 +143,7 @@ func (r Request) Create(ctx context.Context, param interfaces.CreateRequestParam
 			Title(param.Title)
 
 		if param.State != nil {
-			if *param.State == request.StateApproved || *param.State == request.StateClosed {
+			if *param.State == request.StateApproved || *param.State == request.StateClosed || *param.State == request.StateChangesRequested {
 				return nil, fmt.Errorf("can't create request with state %s", param.State.String())
 			}
 			builder.State(*param.State)
@@ -145,7 +151,10 @@ func (r Request) Create(ctx context.Context, param interfaces.CreateRequestParam
 		if param.Description != nil {
 			builder.Description(*param.Description)
 		}
-		if param.Reviewers != nil && param.Reviewers.Len() > 0 {
+		// the reviewers of the request which has a workflow are the ones of its stages
+		if wf != nil {
+			builder.Stages(wf.Stages()).Reviewers(wf.Stages().Reviewers())
+		} else if param.Reviewers != nil && param.Reviewers.Len() > 0 {
 			for _, rev := range param.Reviewers {
 				if !ws.Members().IsOwnerOrMaintainer(rev) {
 					return nil, rerror.NewE(i18n.T("reviewer should be owner or maintainer"))
@@ -163,6 +172,16 @@ func (r Request) Create(ctx context.Context, param interfaces.CreateRequestParam
 			return nil, err
 		}
 
+		if req.State() == request.StateWaiting {
+			if err := r.notify(ctx, req, notification.TypeRequestAssigned, operator, req.Reviewers()); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := r.requestEvent(ctx, req, event.RequestCreate, operator); err != nil {
+			return nil, err
+		}
+
 		return req, nil
 	})
 }
@@ -193,10 +212,15 @@ func (r Request) Update(ctx context.Context, param interfaces.UpdateRequestParam
 			return nil, interfaces.ErrOperationDenied
 		}
 
+		prevState, prevReviewers := req.State(), req.Reviewers()
+
 		if param.State != nil {
 			if *param.State == request.StateApproved {
 				return nil, rerror.NewE(i18n.T("can't update by approve"))
 			}
+			if *param.State == request.StateChangesRequested {
+				return nil, rerror.NewE(i18n.T("can't update by requesting changes"))
+			}
 			req.SetState(*param.State)
 		}
 
@@ -212,6 +236,9 @@ func (r Request) Update(ctx context.Context, param interfaces.UpdateRequestParam
 		}
 
 		if param.Reviewers != nil && param.Reviewers.Len() > 0 {
+			if len(req.Stages()) > 0 {
+				return nil, interfaces.ErrReviewersDefinedByWorkflow
+			}
 			for _, rev := range param.Reviewers {
 				if !ws.Members().IsOwnerOrMaintainer(rev) {
 					return nil, rerror.NewE(i18n.T("reviewer should be owner or maintainer"))
@@ -234,6 +261,30 @@ func (r Request) Update(ctx context.Context, param interfaces.UpdateRequestParam
 			return nil, err
 		}
 
+		switch {
+		case req.State() == request.StateWaiting:
+			// the reviewers are notified when the request starts waiting for them or when they are added to it
+			assigned := req.Reviewers()
+			if prevState == request.StateWaiting {
+				assigned = lo.Without(assigned, prevReviewers...)
+			}
+			if err := r.notify(ctx, req, notification.TypeRequestAssigned, operator, assigned); err != nil {
+				return nil, err
+			}
+		case req.State() == request.StateClosed && prevState != request.StateClosed:
+			if err := r.notify(ctx, req, notification.TypeRequestClosed, operator, accountdomain.UserIDList{req.CreatedBy()}); err != nil {
+				return nil, err
+			}
+		}
+
+		et := event.Type(event.RequestUpdate)
+		if req.State() == request.StateClosed && prevState != request.StateClosed {
+			et = event.RequestClose
+		}
+		if err := r.requestEvent(ctx, req, et, operator); err != nil {
+			return nil, err
+		}
+
 		return req, nil
 	})
 }
@@ -286,8 +337,21 @@ func (r Request) CloseAll(ctx context.Context, pid id.ProjectID, ids id.RequestI
 		return err
 	}
 
+	closed := lo.Filter(reqs, func(req *request.Request, _ int) bool { return req.State() != request.StateClosed })
 	reqs.UpdateStatus(request.StateClosed)
-	return r.repos.Request.SaveAll(ctx, pid, reqs)
+	if err := r.repos.Request.SaveAll(ctx, pid, reqs); err != nil {
+		return err
+	}
+
+	for _, req := range closed {
+		if err := r.notify(ctx, req, notification.TypeRequestClosed, operator, accountdomain.UserIDList{req.CreatedBy()}); err != nil {
+			return err
+		}
+		if err := r.requestEvent(ctx, req, event.RequestClose, operator); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (r Request) Approve(ctx context.Context, requestID id.RequestID, operator *usecase.Operator) (*request.Request, error) {
@@ -319,12 +383,43 @@ func (r Request) Approve(ctx context.Context, requestID id.RequestID, operator *
 		if req.State() != request.StateWaiting {
 			return nil, rerror.NewE(i18n.T("only requests with status waiting can be approved"))
 		}
-		req.SetState(request.StateApproved)
+		approved, err := req.Approve(*operator.AcOperator.User)
+		if err != nil {
+			return nil, err
+		}
 
 		if err := r.repos.Request.Save(ctx, req); err != nil {
 			return nil, err
 		}
 
+		// the items are published once the request is approved in its last stage
+		if !approved {
+			if err := r.requestEvent(ctx, req, event.RequestUpdate, operator); err != nil {
+				return nil, err
+			}
+			return req, nil
+		}
+
+		if err := r.notify(ctx, req, notification.TypeRequestApproved, operator, accountdomain.UserIDList{req.CreatedBy()}); err != nil {
+			return nil, err
+		}
+
+		models, err := r.models(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := r.event(ctx, Event{
+			Project:   prj,
+			Workspace: req.Workspace(),
+			Type:      event.RequestApprove,
+			Object:    req,
+			Operator:  operator.Operator(),
+			Models:    models,
+		}); err != nil {
+			return nil, err
+		}
+
 		// apply changes to items (publish items)
 		for _, itm := range req.Items() {
 			// publish the approved version
@@ -374,6 +469,226 @@ func (r Request) Approve(ctx context.Context, requestID id.RequestID, operator *
 	})
 }
 
+func (r Request) RequestChanges(ctx context.Context, requestID id.RequestID, comment string, operator *usecase.Operator) (*request.Request, error) {
+	if operator.AcOperator.User == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, operator, r.repos, Usecase().Transaction(), func(ctx context.Context) (*request.Request, error) {
+		req, err := r.repos.Request.FindByID(ctx, requestID)
+		if err != nil {
+			return nil, err
+		}
+		if !operator.IsOwningWorkspace(req.Workspace()) && !operator.IsMaintainingWorkspace(req.Workspace()) {
+			return nil, interfaces.ErrInvalidOperator
+		}
+		if err := r.checkPermissions(ctx, rbac.ActionApprove, req.Workspace()); err != nil {
+			return nil, err
+		}
+		if !req.Reviewers().Has(*operator.AcOperator.User) {
+			return nil, rerror.NewE(i18n.T("only reviewers can request changes"))
+		}
+
+		if err := req.RequestChanges(*operator.AcOperator.User, comment); err != nil {
+			return nil, err
+		}
+		req.SetUpdatedAt(util.Now())
+
+		if err := r.repos.Request.Save(ctx, req); err != nil {
+			return nil, err
+		}
+
+		if err := r.notify(ctx, req, notification.TypeRequestChangesRequested, operator, accountdomain.UserIDList{req.CreatedBy()}); err != nil {
+			return nil, err
+		}
+		if err := r.requestEvent(ctx, req, event.RequestUpdate, operator); err != nil {
+			return nil, err
+		}
+		return req, nil
+	})
+}
+
+func (r Request) FindWorkflows(ctx context.Context, pid id.ProjectID, _ *usecase.Operator) (request.WorkflowList, error) {
+	wid, err := workspaceIDForProject(ctx, r.repos, pid)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.checkPermissions(ctx, rbac.ActionList, wid); err != nil {
+		return nil, err
+	}
+	return r.repos.RequestWorkflow.FindByProject(ctx, pid)
+}
+
+// SaveWorkflow creates the workflow of the project or the model, or replaces the stages of the existing one.
+// The requests which have already been created keep the stages they were created with.
+func (r Request) SaveWorkflow(ctx context.Context, param interfaces.SaveRequestWorkflowParam, operator *usecase.Operator) (*request.Workflow, error) {
+	if operator.AcOperator.User == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, operator, r.repos, Usecase().Transaction(), func(ctx context.Context) (*request.Workflow, error) {
+		p, err := r.repos.Project.FindByID(ctx, param.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		if !operator.IsOwningWorkspace(p.Workspace()) && !operator.IsMaintainingWorkspace(p.Workspace()) {
+			return nil, interfaces.ErrOperationDenied
+		}
+		if err := doCheckPermission(ctx, r.gateways, rbac.ResourceProject, rbac.ActionUpdate, p.Workspace()); err != nil {
+			return nil, err
+		}
+		if param.ModelID != nil {
+			m, err := r.repos.Model.FindByID(ctx, *param.ModelID)
+			if err != nil {
+				return nil, err
+			}
+			if m.Project() != p.ID() {
+				return nil, interfaces.ErrOperationDenied
+			}
+		}
+
+		ws, err := r.repos.Workspace.FindByID(ctx, p.Workspace())
+		if err != nil {
+			return nil, err
+		}
+		stages, err := util.TryMap(param.Stages, func(sp interfaces.RequestStageParam) (*request.Stage, error) {
+			for _, rev := range sp.Reviewers {
+				if !ws.Members().IsOwnerOrMaintainer(rev) {
+					return nil, rerror.NewE(i18n.T("reviewer should be owner or maintainer"))
+				}
+			}
+			return request.NewStage(sp.Name, sp.Reviewers, sp.RequiredApprovals)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		workflows, err := r.repos.RequestWorkflow.FindByProject(ctx, p.ID())
+		if err != nil {
+			return nil, err
+		}
+		wf, found := lo.Find(workflows, func(w *request.Workflow) bool {
+			return lo.FromPtr(w.Model()) == lo.FromPtr(param.ModelID)
+		})
+		if found {
+			if err := wf.SetStages(stages); err != nil {
+				return nil, err
+			}
+			wf.SetUpdatedAt(util.Now())
+		} else {
+			wf, err = request.NewWorkflow().
+				NewID().
+				Workspace(p.Workspace()).
+				Project(p.ID()).
+				Model(param.ModelID).
+				Stages(stages).
+				Build()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if err := r.repos.RequestWorkflow.Save(ctx, wf); err != nil {
+			return nil, err
+		}
+		return wf, nil
+	})
+}
+
+func (r Request) DeleteWorkflow(ctx context.Context, wid id.WorkflowID, operator *usecase.Operator) error {
+	if operator.AcOperator.User == nil {
+		return interfaces.ErrInvalidOperator
+	}
+
+	return Run0(ctx, operator, r.repos, Usecase().Transaction(), func(ctx context.Context) error {
+		wf, err := r.repos.RequestWorkflow.FindByID(ctx, wid)
+		if err != nil {
+			return err
+		}
+		if !operator.IsOwningWorkspace(wf.Workspace()) && !operator.IsMaintainingWorkspace(wf.Workspace()) {
+			return interfaces.ErrOperationDenied
+		}
+		if err := doCheckPermission(ctx, r.gateways, rbac.ResourceProject, rbac.ActionUpdate, wf.Workspace()); err != nil {
+			return err
+		}
+		return r.repos.RequestWorkflow.Remove(ctx, wid)
+	})
+}
+
+// workflowForItems returns the workflow which applies to the items of the request, or nil when the project has no workflow.
+func (r Request) workflowForItems(ctx context.Context, pid id.ProjectID, items request.ItemList) (*request.Workflow, error) {
+	workflows, err := r.repos.RequestWorkflow.FindByProject(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	if len(workflows) == 0 {
+		return nil, nil
+	}
+
+	itms, err := r.repos.Item.FindByIDs(ctx, items.IDs(), nil)
+	if err != nil {
+		return nil, err
+	}
+	res := lo.Uniq(lo.Map(itms, func(itm item.Versioned, _ int) *request.Workflow {
+		return workflows.ForModel(itm.Value().Model())
+	}))
+	if len(res) > 1 {
+		return nil, interfaces.ErrItemsWithDifferentWorkflows
+	}
+	if len(res) == 0 {
+		return nil, nil
+	}
+	return res[0], nil
+}
+
+func (r Request) notify(ctx context.Context, req *request.Request, t notification.Type, operator *usecase.Operator, recipients accountdomain.UserIDList) error {
+	_, err := notify(ctx, r.repos, r.gateways, notificationParam{
+		Workspace: req.Workspace(),
+		Type:      t,
+		Actor:     operator.AcOperator.User,
+		Request:   req.ID().Ref(),
+		Thread:    req.Thread(),
+		Title:     req.Title(),
+	}, recipients)
+	return err
+}
+
+func (r Request) requestEvent(ctx context.Context, req *request.Request, t event.Type, operator *usecase.Operator) error {
+	if r.ignoreEvent {
+		return nil
+	}
+
+	prj, err := r.repos.Project.FindByID(ctx, req.Project())
+	if err != nil {
+		return err
+	}
+
+	models, err := r.models(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return r.event(ctx, Event{
+		Project:   prj,
+		Workspace: req.Workspace(),
+		Type:      t,
+		Object:    req,
+		Operator:  operator.Operator(),
+		Models:    models,
+	})
+}
+
+// models returns the models of the items in the request so that its webhooks can be filtered by them.
+func (r Request) models(ctx context.Context, req *request.Request) (id.ModelIDList, error) {
+	items, err := r.repos.Item.FindByIDs(ctx, req.Items().IDs(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Uniq(lo.Map(items, func(i item.Versioned, _ int) id.ModelID {
+		return i.Value().Model()
+	})), nil
+}
+
 func (r Request) event(ctx context.Context, e Event) error {
 	if r.ignoreEvent {
 		return nil
@@ -11,15 +11,18 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
 	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/request"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/account/accountdomain/user"
+	"github.com/reearth/reearthx/account/accountdomain/workspace"
 	"github.com/reearth/reearthx/account/accountusecase"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -413,3 +416,133 @@ func TestRequest_Approve(t *testing.T) {
 	expected := version.MustBeValue(itm.Version(), nil, version.NewRefs(version.Public, version.Latest), now, i)
 	assert.Equal(t, expected, itm)
 }
+
+func TestRequest_Workflow(t *testing.T) {
+	ctx := context.Background()
+	db := memory.New()
+
+	u1, u2, u3, u4 := accountdomain.NewUserID(), accountdomain.NewUserID(), accountdomain.NewUserID(), accountdomain.NewUserID()
+	ws := workspace.New().NewID().Members(map[accountdomain.UserID]workspace.Member{
+		u1: {Role: workspace.RoleMaintainer},
+		u2: {Role: workspace.RoleMaintainer},
+		u3: {Role: workspace.RoleOwner},
+		u4: {Role: workspace.RoleWriter},
+	}).MustBuild()
+	prj := project.New().NewID().Workspace(ws.ID()).MustBuild()
+	s := schema.New().NewID().Workspace(ws.ID()).Project(prj.ID()).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Project(prj.ID()).RandomKey().MustBuild()
+	i := item.New().NewID().Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID().Ref()).User(u4).MustBuild()
+
+	assert.NoError(t, db.Workspace.Save(ctx, ws))
+	assert.NoError(t, db.Project.Save(ctx, prj))
+	assert.NoError(t, db.Schema.Save(ctx, s))
+	assert.NoError(t, db.Model.Save(ctx, m))
+	assert.NoError(t, db.Item.Save(ctx, i))
+
+	op := func(u accountdomain.UserID) *usecase.Operator {
+		return &usecase.Operator{
+			AcOperator: &accountusecase.Operator{
+				User:                   new(u),
+				WritableWorkspaces:     accountdomain.WorkspaceIDList{ws.ID()},
+				MaintainableWorkspaces: accountdomain.WorkspaceIDList{ws.ID()},
+				OwningWorkspaces:       accountdomain.WorkspaceIDList{ws.ID()},
+			},
+		}
+	}
+	requestUC := NewRequest(db, nil)
+	requestUC.ignoreEvent = true
+
+	// workflow
+	_, err := requestUC.SaveWorkflow(ctx, interfaces.SaveRequestWorkflowParam{
+		ProjectID: prj.ID(),
+		Stages:    []interfaces.RequestStageParam{{Name: "editor", Reviewers: accountdomain.UserIDList{u4}, RequiredApprovals: 1}},
+	}, op(u3))
+	assert.ErrorContains(t, err, "reviewer should be owner or maintainer")
+
+	wf, err := requestUC.SaveWorkflow(ctx, interfaces.SaveRequestWorkflowParam{
+		ProjectID: prj.ID(),
+		Stages:    []interfaces.RequestStageParam{{Name: "editor", Reviewers: accountdomain.UserIDList{u1}, RequiredApprovals: 1}},
+	}, op(u3))
+	assert.NoError(t, err)
+
+	wf2, err := requestUC.SaveWorkflow(ctx, interfaces.SaveRequestWorkflowParam{
+		ProjectID: prj.ID(),
+		Stages: []interfaces.RequestStageParam{
+			{Name: "editor", Reviewers: accountdomain.UserIDList{u1, u2}, RequiredApprovals: 2},
+			{Name: "legal", Reviewers: accountdomain.UserIDList{u3}, RequiredApprovals: 1},
+		},
+	}, op(u3))
+	assert.NoError(t, err)
+	assert.Equal(t, wf.ID(), wf2.ID())
+	assert.Len(t, wf2.Stages(), 2)
+
+	workflows, err := requestUC.FindWorkflows(ctx, prj.ID(), op(u3))
+	assert.NoError(t, err)
+	assert.Len(t, workflows, 1)
+
+	// the request gets the stages of the workflow
+	vi, err := db.Item.FindByID(ctx, i.ID(), nil)
+	assert.NoError(t, err)
+	ri, _ := request.NewItem(i.ID(), new(vi.Version().String()))
+	req, err := requestUC.Create(ctx, interfaces.CreateRequestParam{
+		ProjectID: prj.ID(),
+		Title:     "foo",
+		Reviewers: accountdomain.UserIDList{u1},
+		Items:     request.ItemList{ri},
+	}, op(u4))
+	assert.NoError(t, err)
+	assert.Equal(t, wf2.Stages(), req.Stages())
+	assert.Equal(t, accountdomain.UserIDList{u1, u2, u3}, req.Reviewers())
+
+	isPublic := func() bool {
+		itm, err := db.Item.FindByID(ctx, i.ID(), version.Public.Ref())
+		return err == nil && itm != nil
+	}
+
+	_, err = requestUC.Approve(ctx, req.ID(), op(u3))
+	assert.Equal(t, request.ErrNotStageReviewer, err)
+	req, err = requestUC.Approve(ctx, req.ID(), op(u1))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, req.CurrentStageIndex())
+	req, err = requestUC.Approve(ctx, req.ID(), op(u2))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, req.CurrentStageIndex())
+	assert.Equal(t, request.StateWaiting, req.State())
+	assert.False(t, isPublic())
+
+	// changes requested in the last stage
+	req, err = requestUC.RequestChanges(ctx, req.ID(), "fix the title", op(u3))
+	assert.NoError(t, err)
+	assert.Equal(t, request.StateChangesRequested, req.State())
+	_, err = requestUC.Approve(ctx, req.ID(), op(u3))
+	assert.ErrorContains(t, err, "only requests with status waiting can be approved")
+
+	_, err = requestUC.Update(ctx, interfaces.UpdateRequestParam{RequestID: req.ID(), Reviewers: accountdomain.UserIDList{u1}}, op(u4))
+	assert.Equal(t, interfaces.ErrReviewersDefinedByWorkflow, err)
+	req, err = requestUC.Update(ctx, interfaces.UpdateRequestParam{RequestID: req.ID(), State: new(request.StateWaiting)}, op(u4))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, req.CurrentStageIndex())
+
+	// the items are published when the last stage approves
+	for _, u := range []accountdomain.UserID{u1, u2, u3} {
+		req, err = requestUC.Approve(ctx, req.ID(), op(u))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, request.StateApproved, req.State())
+	assert.Len(t, req.Approvals(), 6)
+	assert.True(t, isPublic())
+
+	// the reviewers are notified of the assignment and the author of the reviews
+	notifications := func(u accountdomain.UserID) []notification.Type {
+		l, err := db.Notification.FindUnreadByUser(ctx, u)
+		assert.NoError(t, err)
+		return lo.Map(l, func(n *notification.Notification, _ int) notification.Type { return n.Type() })
+	}
+	assert.Equal(t, []notification.Type{notification.TypeRequestAssigned, notification.TypeRequestAssigned}, notifications(u1))
+	assert.Equal(t, []notification.Type{notification.TypeRequestApproved, notification.TypeRequestChangesRequested}, notifications(u4))
+
+	assert.NoError(t, requestUC.DeleteWorkflow(ctx, wf.ID(), op(u3)))
+	workflows, err = requestUC.FindWorkflows(ctx, prj.ID(), op(u3))
+	assert.NoError(t, err)
+	assert.Empty(t, workflows)
+}
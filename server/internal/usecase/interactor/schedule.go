@@ -0,0 +1,290 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/rbac"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountdomain/workspace"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+const scheduleLockName = "item_schedule"
+
+type Schedule struct {
+	repos    *repo.Container
+	gateways *gateway.Container
+}
+
+func NewSchedule(r *repo.Container, g *gateway.Container) interfaces.Schedule {
+	return &Schedule{
+		repos:    r,
+		gateways: g,
+	}
+}
+
+func (i *Schedule) authz() gateway.Authorization {
+	if i.gateways == nil {
+		return nil
+	}
+	return i.gateways.Authorization
+}
+
+func (i *Schedule) FindByID(ctx context.Context, scheduleID id.ScheduleID, operator *usecase.Operator) (*schedule.Schedule, error) {
+	s, err := i.repos.Schedule.FindByID(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	wid, err := workspaceIDForProject(ctx, i.repos, s.Project())
+	if err != nil {
+		return nil, err
+	}
+
+	return Run1(ctx, operator, i.repos,
+		Usecase().WithPermission(i.authz(), rbac.ResourceItem, rbac.ActionRead, wid),
+		func(ctx context.Context) (*schedule.Schedule, error) {
+			return s, nil
+		})
+}
+
+func (i *Schedule) FindByProject(ctx context.Context, projectID id.ProjectID, status *schedule.Status, operator *usecase.Operator) (schedule.List, error) {
+	wid, err := workspaceIDForProject(ctx, i.repos, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return Run1(ctx, operator, i.repos,
+		Usecase().WithPermission(i.authz(), rbac.ResourceItem, rbac.ActionList, wid),
+		func(ctx context.Context) (schedule.List, error) {
+			return i.repos.Schedule.FindByProject(ctx, projectID, status)
+		})
+}
+
+func (i *Schedule) Create(ctx context.Context, param interfaces.CreateScheduleParam, operator *usecase.Operator) (*schedule.Schedule, error) {
+	if operator.AcOperator.User == nil && operator.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+	if len(param.ItemIDs) == 0 {
+		return nil, interfaces.ErrItemMissing
+	}
+	if !param.ScheduledAt.After(util.Now()) {
+		return nil, interfaces.ErrScheduleInPast
+	}
+
+	items, err := i.repos.Item.FindByIDs(ctx, param.ItemIDs, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// check all items were found
+	if len(items) != len(param.ItemIDs) {
+		return nil, interfaces.ErrItemMissing
+	}
+
+	// check all items on the same models
+	s := lo.CountBy(items, func(itm item.Versioned) bool {
+		return itm.Value().Model() == items[0].Value().Model()
+	})
+	if s != len(items) {
+		return nil, interfaces.ErrItemsShouldBeOnSameModel
+	}
+
+	m, err := i.repos.Model.FindByID(ctx, items[0].Value().Model())
+	if err != nil {
+		return nil, err
+	}
+
+	wid, err := workspaceIDForProject(ctx, i.repos, m.Project())
+	if err != nil {
+		return nil, err
+	}
+
+	action := rbac.ActionPublish
+	if param.Action == schedule.ActionUnpublish {
+		action = rbac.ActionUnpublish
+	}
+
+	return Run1(ctx, operator, i.repos,
+		Usecase().
+			WithWritableWorkspaces(wid).
+			WithPermission(i.authz(), rbac.ResourceItem, action, wid).
+			Transaction(),
+		func(ctx context.Context) (*schedule.Schedule, error) {
+			b := schedule.New().
+				NewID().
+				Project(m.Project()).
+				Model(m.ID()).
+				Items(param.ItemIDs).
+				Action(param.Action).
+				ScheduledAt(param.ScheduledAt)
+
+			if operator.AcOperator.User != nil {
+				b = b.User(*operator.AcOperator.User)
+			} else if operator.Integration != nil {
+				b = b.Integration(*operator.Integration)
+			}
+
+			sch, err := b.Build()
+			if err != nil {
+				return nil, err
+			}
+
+			if err := i.repos.Schedule.Save(ctx, sch); err != nil {
+				return nil, err
+			}
+
+			return sch, nil
+		})
+}
+
+func (i *Schedule) Cancel(ctx context.Context, scheduleID id.ScheduleID, operator *usecase.Operator) (*schedule.Schedule, error) {
+	if operator.AcOperator.User == nil && operator.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	s, err := i.repos.Schedule.FindByID(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	wid, err := workspaceIDForProject(ctx, i.repos, s.Project())
+	if err != nil {
+		return nil, err
+	}
+
+	action := rbac.ActionPublish
+	if s.Action() == schedule.ActionUnpublish {
+		action = rbac.ActionUnpublish
+	}
+
+	return Run1(ctx, operator, i.repos,
+		Usecase().
+			WithWritableWorkspaces(wid).
+			WithPermission(i.authz(), rbac.ResourceItem, action, wid).
+			Transaction(),
+		func(ctx context.Context) (*schedule.Schedule, error) {
+			if err := s.Cancel(); err != nil {
+				if errors.Is(err, schedule.ErrNotPending) {
+					return nil, interfaces.ErrScheduleNotPending
+				}
+				return nil, err
+			}
+
+			if err := i.repos.Schedule.Save(ctx, s); err != nil {
+				return nil, err
+			}
+
+			return s, nil
+		})
+}
+
+func (i *Schedule) RunDue(ctx context.Context, now time.Time) (schedule.List, error) {
+	// only one server instance should fire the same schedules
+	if err := i.repos.Lock.Lock(ctx, scheduleLockName); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := i.repos.Lock.Unlock(ctx, scheduleLockName); err != nil {
+			log.Errorf("schedule: failed to unlock: %v", err)
+		}
+	}()
+
+	due, err := i.repos.Schedule.FindDue(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(schedule.List, 0, len(due))
+	for _, s := range due {
+		if err := i.run(ctx, s); err != nil {
+			log.Errorf("schedule: %s %s failed: %v", s.Action(), s.ID(), err)
+			s.Fail(err.Error())
+		} else {
+			log.Infof("schedule: %s %s completed", s.Action(), s.ID())
+			s.Complete()
+		}
+
+		if err := i.repos.Schedule.Save(ctx, s); err != nil {
+			return res, err
+		}
+		res = append(res, s)
+	}
+
+	return res, nil
+}
+
+func (i *Schedule) run(ctx context.Context, s *schedule.Schedule) error {
+	wid, err := workspaceIDForProject(ctx, i.repos, s.Project())
+	if err != nil {
+		return err
+	}
+
+	op, err := i.creatorOperator(ctx, s, wid)
+	if err != nil {
+		return err
+	}
+	itemUC := NewItem(i.repos, gatewaysWithoutAuthorization(i.gateways))
+
+	switch s.Action() {
+	case schedule.ActionPublish:
+		_, err = itemUC.Publish(ctx, s.Items(), op)
+	case schedule.ActionUnpublish:
+		_, err = itemUC.Unpublish(ctx, s.Items(), op)
+	default:
+		err = schedule.ErrInvalidAction
+	}
+	return err
+}
+
+// creatorOperator returns the operator which acts on behalf of the user or integration that created the schedule.
+// The creator may have left the workspace or lost the role since the schedule was created,
+// so the permission is checked again against the current members of the workspace.
+func (i *Schedule) creatorOperator(ctx context.Context, s *schedule.Schedule, wid accountdomain.WorkspaceID) (*usecase.Operator, error) {
+	ws, err := i.repos.Workspace.FindByID(ctx, wid)
+	if err != nil {
+		return nil, err
+	}
+	w := workspace.List{ws}
+
+	var ww, mw, ow accountdomain.WorkspaceIDList
+	if uid := s.User(); uid != nil {
+		ww = w.FilterByUserRole(*uid, workspace.RoleWriter).IDs()
+		mw = w.FilterByUserRole(*uid, workspace.RoleMaintainer).IDs()
+		ow = w.FilterByUserRole(*uid, workspace.RoleOwner).IDs()
+	} else if iid := s.Integration(); iid != nil {
+		aid, err := accountdomain.IntegrationIDFrom(iid.String())
+		if err != nil {
+			return nil, err
+		}
+		ww = w.FilterByIntegrationRole(aid, workspace.RoleWriter).IDs()
+		mw = w.FilterByIntegrationRole(aid, workspace.RoleMaintainer).IDs()
+		ow = w.FilterByIntegrationRole(aid, workspace.RoleOwner).IDs()
+	}
+
+	op := &usecase.Operator{
+		Integration: s.Integration(),
+		AcOperator: &accountusecase.Operator{
+			User:                   s.User(),
+			WritableWorkspaces:     ww,
+			MaintainableWorkspaces: mw,
+			OwningWorkspaces:       ow,
+		},
+	}
+	if !op.IsWritableWorkspace(wid) {
+		return nil, interfaces.ErrOperationDenied
+	}
+	return op, nil
+}
@@ -0,0 +1,285 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountdomain/workspace"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+type scheduleFixture struct {
+	db  *repo.Container
+	op  *usecase.Operator
+	m   *model.Model
+	ids id.ItemIDList
+}
+
+func newScheduleFixture(t *testing.T, n int) scheduleFixture {
+	t.Helper()
+
+	ctx := context.Background()
+	uid := accountdomain.NewUserID()
+	ws := workspace.New().NewID().Members(map[accountdomain.UserID]workspace.Member{
+		uid: {Role: workspace.RoleOwner},
+	}).MustBuild()
+	wid := ws.ID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).MustBuild()
+	m := model.New().NewID().Project(prj.ID()).Schema(s.ID()).RandomKey().MustBuild()
+
+	db := memory.New()
+	assert.NoError(t, db.Workspace.Save(ctx, ws))
+	assert.NoError(t, db.Project.Save(ctx, prj))
+	assert.NoError(t, db.Schema.Save(ctx, s))
+	assert.NoError(t, db.Model.Save(ctx, m))
+
+	ids := make(id.ItemIDList, 0, n)
+	for j := 0; j < n; j++ {
+		it := item.New().NewID().Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID().Ref()).Anonymous(true).MustBuild()
+		assert.NoError(t, db.Item.Save(ctx, it))
+		ids = append(ids, it.ID())
+	}
+
+	op := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			User:             lo.ToPtr(uid),
+			OwningWorkspaces: accountdomain.WorkspaceIDList{wid},
+		},
+	}
+
+	return scheduleFixture{db: db, op: op, m: m, ids: ids}
+}
+
+func TestSchedule_Create(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	at := time.Now().Add(time.Hour)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		f := newScheduleFixture(t, 2)
+		uc := NewSchedule(f.db, nil)
+
+		s, err := uc.Create(ctx, interfaces.CreateScheduleParam{
+			ItemIDs:     f.ids,
+			Action:      schedule.ActionPublish,
+			ScheduledAt: at,
+		}, f.op)
+		assert.NoError(t, err)
+		assert.Equal(t, f.m.ID(), s.Model())
+		assert.Equal(t, f.m.Project(), s.Project())
+		assert.Equal(t, f.ids, s.Items())
+		assert.Equal(t, schedule.StatusPending, s.Status())
+		assert.Equal(t, f.op.AcOperator.User, s.User())
+
+		got, err := uc.FindByProject(ctx, f.m.Project(), nil, f.op)
+		assert.NoError(t, err)
+		assert.Equal(t, schedule.List{s}, got)
+	})
+
+	t.Run("scheduled in the past", func(t *testing.T) {
+		t.Parallel()
+		f := newScheduleFixture(t, 1)
+
+		_, err := NewSchedule(f.db, nil).Create(ctx, interfaces.CreateScheduleParam{
+			ItemIDs:     f.ids,
+			Action:      schedule.ActionPublish,
+			ScheduledAt: time.Now().Add(-time.Minute),
+		}, f.op)
+		assert.Equal(t, interfaces.ErrScheduleInPast, err)
+	})
+
+	t.Run("missing items", func(t *testing.T) {
+		t.Parallel()
+		f := newScheduleFixture(t, 1)
+
+		_, err := NewSchedule(f.db, nil).Create(ctx, interfaces.CreateScheduleParam{
+			ItemIDs:     append(f.ids, id.NewItemID()),
+			Action:      schedule.ActionPublish,
+			ScheduledAt: at,
+		}, f.op)
+		assert.Equal(t, interfaces.ErrItemMissing, err)
+
+		_, err = NewSchedule(f.db, nil).Create(ctx, interfaces.CreateScheduleParam{
+			Action:      schedule.ActionPublish,
+			ScheduledAt: at,
+		}, f.op)
+		assert.Equal(t, interfaces.ErrItemMissing, err)
+	})
+
+	t.Run("operator without write access", func(t *testing.T) {
+		t.Parallel()
+		f := newScheduleFixture(t, 1)
+		op := &usecase.Operator{
+			AcOperator: &accountusecase.Operator{
+				User: lo.ToPtr(accountdomain.NewUserID()),
+			},
+		}
+
+		_, err := NewSchedule(f.db, nil).Create(ctx, interfaces.CreateScheduleParam{
+			ItemIDs:     f.ids,
+			Action:      schedule.ActionPublish,
+			ScheduledAt: at,
+		}, op)
+		assert.Equal(t, interfaces.ErrOperationDenied, err)
+	})
+
+	t.Run("invalid operator", func(t *testing.T) {
+		t.Parallel()
+		f := newScheduleFixture(t, 1)
+
+		_, err := NewSchedule(f.db, nil).Create(ctx, interfaces.CreateScheduleParam{
+			ItemIDs:     f.ids,
+			Action:      schedule.ActionPublish,
+			ScheduledAt: at,
+		}, &usecase.Operator{AcOperator: &accountusecase.Operator{}})
+		assert.Equal(t, interfaces.ErrInvalidOperator, err)
+	})
+}
+
+func TestSchedule_Cancel(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	f := newScheduleFixture(t, 1)
+	uc := NewSchedule(f.db, nil)
+
+	s, err := uc.Create(ctx, interfaces.CreateScheduleParam{
+		ItemIDs:     f.ids,
+		Action:      schedule.ActionUnpublish,
+		ScheduledAt: time.Now().Add(time.Hour),
+	}, f.op)
+	assert.NoError(t, err)
+
+	cancelled, err := uc.Cancel(ctx, s.ID(), f.op)
+	assert.NoError(t, err)
+	assert.Equal(t, schedule.StatusCancelled, cancelled.Status())
+
+	got, err := uc.FindByID(ctx, s.ID(), f.op)
+	assert.NoError(t, err)
+	assert.Equal(t, schedule.StatusCancelled, got.Status())
+
+	_, err = uc.Cancel(ctx, s.ID(), f.op)
+	assert.Equal(t, interfaces.ErrScheduleNotPending, err)
+}
+
+func TestSchedule_RunDue(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	f := newScheduleFixture(t, 3)
+	uc := NewSchedule(f.db, nil)
+	itemUC := NewItem(f.db, nil)
+	now := time.Now()
+
+	publish, err := uc.Create(ctx, interfaces.CreateScheduleParam{
+		ItemIDs:     f.ids[:2],
+		Action:      schedule.ActionPublish,
+		ScheduledAt: now.Add(time.Minute),
+	}, f.op)
+	assert.NoError(t, err)
+
+	later, err := uc.Create(ctx, interfaces.CreateScheduleParam{
+		ItemIDs:     f.ids[2:],
+		Action:      schedule.ActionPublish,
+		ScheduledAt: now.Add(time.Hour),
+	}, f.op)
+	assert.NoError(t, err)
+
+	// nothing is due yet
+	res, err := uc.RunDue(ctx, now)
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+
+	res, err = uc.RunDue(ctx, now.Add(2*time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, schedule.IDList{publish.ID()}, res.IDs())
+	assert.Equal(t, schedule.StatusCompleted, res[0].Status())
+	assert.NotNil(t, res[0].ExecutedAt())
+
+	status, err := itemUC.ItemStatus(ctx, f.ids, f.op)
+	assert.NoError(t, err)
+	assert.Equal(t, item.StatusPublic, status[f.ids[0]])
+	assert.Equal(t, item.StatusPublic, status[f.ids[1]])
+	assert.Equal(t, item.StatusDraft, status[f.ids[2]])
+
+	// the schedule does not fire twice
+	res, err = uc.RunDue(ctx, now.Add(2*time.Minute))
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+
+	pending := schedule.StatusPending
+	got, err := uc.FindByProject(ctx, f.m.Project(), &pending, f.op)
+	assert.NoError(t, err)
+	assert.Equal(t, schedule.IDList{later.ID()}, got.IDs())
+}
+
+func TestSchedule_RunDue_Failure(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	f := newScheduleFixture(t, 1)
+	uc := NewSchedule(f.db, nil)
+	now := time.Now()
+
+	// the item is gone by the time the schedule fires
+	s := schedule.New().
+		NewID().
+		Project(f.m.Project()).
+		Model(f.m.ID()).
+		Items(id.ItemIDList{id.NewItemID()}).
+		Action(schedule.ActionUnpublish).
+		ScheduledAt(now).
+		User(*f.op.AcOperator.User).
+		MustBuild()
+	assert.NoError(t, f.db.Schedule.Save(ctx, s))
+
+	res, err := uc.RunDue(ctx, now)
+	assert.NoError(t, err)
+	assert.Len(t, res, 1)
+	assert.Equal(t, schedule.StatusFailed, res[0].Status())
+	assert.Equal(t, interfaces.ErrItemMissing.Error(), res[0].Error())
+}
+
+func TestSchedule_RunDue_CreatorRemoved(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	f := newScheduleFixture(t, 1)
+	uc := NewSchedule(f.db, nil)
+	itemUC := NewItem(f.db, nil)
+	now := time.Now()
+
+	_, err := uc.Create(ctx, interfaces.CreateScheduleParam{
+		ItemIDs:     f.ids,
+		Action:      schedule.ActionPublish,
+		ScheduledAt: now.Add(time.Minute),
+	}, f.op)
+	assert.NoError(t, err)
+
+	// the creator leaves the workspace before the schedule fires
+	ws, err := f.db.Workspace.FindByID(ctx, f.op.AcOperator.OwningWorkspaces[0])
+	assert.NoError(t, err)
+	assert.NoError(t, f.db.Workspace.Save(ctx, workspace.New().ID(ws.ID()).MustBuild()))
+
+	res, err := uc.RunDue(ctx, now.Add(2*time.Minute))
+	assert.NoError(t, err)
+	assert.Len(t, res, 1)
+	assert.Equal(t, schedule.StatusFailed, res[0].Status())
+	assert.Equal(t, interfaces.ErrOperationDenied.Error(), res[0].Error())
+
+	status, err := itemUC.ItemStatus(ctx, f.ids, f.op)
+	assert.NoError(t, err)
+	assert.Equal(t, item.StatusDraft, status[f.ids[0]])
+}
@@ -2,12 +2,14 @@ package interactor
 
 import (
 	"context"
+	"errors"
 
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/exporters"
 	"github.com/reearth/reearth-cms/server/pkg/group"
 	"github.com/reearth/reearth-cms/server/pkg/id"
@@ -16,6 +18,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/types"
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/rerror"
 	"github.com/samber/lo"
 )
 
@@ -181,6 +184,7 @@ func (i Schema) CreateField(ctx context.Context, param interfaces.CreateFieldPar
 			Unique(param.Unique).
 			Multiple(param.Multiple).
 			Required(param.Required).
+			Localizable(param.Localizable).
 			Name(param.Name).
 			Description(lo.FromPtr(param.Description)).
 			Key(id.NewKey(param.Key)).
@@ -220,6 +224,10 @@ func (i Schema) CreateField(ctx context.Context, param interfaces.CreateFieldPar
 			return nil, err
 		}
 
+		if err := i.fieldEvents(ctx, s, event.FieldCreate, op, f); err != nil {
+			return nil, err
+		}
+
 		return f, nil
 	})
 }
@@ -311,6 +319,10 @@ func (i Schema) UpdateField(ctx context.Context, param interfaces.UpdateFieldPar
 			return nil, err
 		}
 
+		if err := i.fieldEvents(ctx, s, event.FieldUpdate, op, f); err != nil {
+			return nil, err
+		}
+
 		return f, nil
 	})
 }
@@ -412,10 +424,87 @@ func (i Schema) DeleteField(ctx context.Context, schemaId id.SchemaID, fieldID i
 			}
 
 			s.RemoveField(fieldID)
-			return i.repos.Schema.Save(ctx, s)
+			if err := i.repos.Schema.Save(ctx, s); err != nil {
+				return err
+			}
+
+			return i.fieldEvents(ctx, s, event.FieldDelete, operator, f)
 		})
 }
 
+func (i Schema) UpdateRules(ctx context.Context, sid id.SchemaID, params []interfaces.SchemaRuleParam, operator *usecase.Operator) (*schema.Schema, error) {
+	return Run1(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) (*schema.Schema, error) {
+		s, err := i.repos.Schema.FindByID(ctx, sid)
+		if err != nil {
+			return nil, err
+		}
+		if !operator.IsWritableProject(s.Project()) {
+			return nil, interfaces.ErrOperationDenied
+		}
+
+		if err := i.checkPermissions(ctx, rbac.ActionUpdate, s.Workspace()); err != nil {
+			return nil, err
+		}
+
+		rules := make(schema.Rules, 0, len(params))
+		for _, param := range params {
+			r, err := newRule(s, param)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, r)
+		}
+		if err := s.SetRules(rules); err != nil {
+			return nil, err
+		}
+
+		if err := i.repos.Schema.Save(ctx, s); err != nil {
+			return nil, err
+		}
+		return s, nil
+	})
+}
+
+func newRule(s *schema.Schema, param interfaces.SchemaRuleParam) (*schema.Rule, error) {
+	if s.Field(param.FieldID) == nil {
+		return nil, interfaces.ErrFieldNotFound
+	}
+
+	switch param.Type {
+	case schema.RuleTypeRequiredIf, schema.RuleTypeVisibleIf:
+		if param.Condition == nil {
+			return nil, schema.ErrInvalidRule
+		}
+		cf := s.Field(param.Condition.FieldID)
+		if cf == nil {
+			return nil, interfaces.ErrFieldNotFound
+		}
+		var v *value.Value
+		if param.Condition.Value != nil {
+			if v = cf.Type().Value(param.Condition.Value); v == nil {
+				return nil, interfaces.ErrInvalidValue
+			}
+		}
+		c, err := schema.NewCondition(cf.ID(), param.Condition.Operator, v)
+		if err != nil {
+			return nil, err
+		}
+		if param.Type == schema.RuleTypeRequiredIf {
+			return schema.NewRequiredIfRule(param.FieldID, c, param.Message), nil
+		}
+		return schema.NewVisibleIfRule(param.FieldID, c, param.Message), nil
+	case schema.RuleTypeCompare:
+		if param.OtherID == nil {
+			return nil, schema.ErrInvalidRule
+		}
+		if s.Field(*param.OtherID) == nil {
+			return nil, interfaces.ErrFieldNotFound
+		}
+		return schema.NewCompareRule(param.FieldID, param.Operator, *param.OtherID, param.Message), nil
+	}
+	return nil, schema.ErrInvalidRule
+}
+
 func (i Schema) deleteCorrespondingField(ctx context.Context, s *schema.Schema, f *schema.Field) error {
 	fr, _ := schema.FieldReferenceFromTypeProperty(f.TypeProperty())
 	if fr.CorrespondingFieldID() == nil {
@@ -453,6 +542,7 @@ func (i Schema) UpdateFields(ctx context.Context, sid id.SchemaID, params []inte
 			return nil, err
 		}
 
+		updated := make(schema.FieldList, 0, len(params))
 		for _, param := range params {
 			f := s.Field(param.FieldID)
 			if f == nil {
@@ -462,11 +552,16 @@ func (i Schema) UpdateFields(ctx context.Context, sid id.SchemaID, params []inte
 			if err != nil {
 				return nil, err
 			}
+			updated = append(updated, f)
 		}
 		if err := i.repos.Schema.Save(ctx, s); err != nil {
 			return nil, err
 		}
 
+		if err := i.fieldEvents(ctx, s, event.FieldUpdate, operator, updated...); err != nil {
+			return nil, err
+		}
+
 		return s.Fields(), nil
 	})
 }
@@ -513,6 +608,12 @@ func updateField(param interfaces.UpdateFieldParam, f *schema.Field) error {
 		f.SetRequired(*param.Required)
 	}
 
+	if param.Localizable != nil {
+		if err := f.SetLocalizable(*param.Localizable); err != nil {
+			return err
+		}
+	}
+
 	if param.Unique != nil {
 		f.SetUnique(*param.Unique)
 	}
@@ -576,7 +677,8 @@ func (i Schema) CreateFields(ctx context.Context, sId id.SchemaID, createFieldsP
 			}
 
 			// delete current fields if any
-			for _, field := range s.Fields() {
+			deleted := s.Fields()
+			for _, field := range deleted {
 				if field.Type() == value.TypeReference {
 					if err := i.deleteCorrespondingField(ctx, s, field); err != nil {
 						return nil, err
@@ -596,6 +698,7 @@ func (i Schema) CreateFields(ctx context.Context, sId id.SchemaID, createFieldsP
 					Unique(createFieldParam.Unique).
 					Multiple(createFieldParam.Multiple).
 					Required(createFieldParam.Required).
+					Localizable(createFieldParam.Localizable).
 					Name(createFieldParam.Name).
 					Description(lo.FromPtr(createFieldParam.Description)).
 					Key(id.NewKey(createFieldParam.Key)).
@@ -636,10 +739,65 @@ func (i Schema) CreateFields(ctx context.Context, sId id.SchemaID, createFieldsP
 				return nil, err
 			}
 
+			if err := i.fieldEvents(ctx, s, event.FieldDelete, op, deleted...); err != nil {
+				return nil, err
+			}
+			if err := i.fieldEvents(ctx, s, event.FieldCreate, op, s.Fields()...); err != nil {
+				return nil, err
+			}
+
 			return s.Fields(), nil
 		})
 }
 
+// fieldEvents emits an event of the given type for each of the fields of the schema.
+func (i Schema) fieldEvents(ctx context.Context, s *schema.Schema, t event.Type, op *usecase.Operator, fields ...*schema.Field) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	prj, err := i.repos.Project.FindByID(ctx, s.Project())
+	if err != nil {
+		return err
+	}
+
+	// group schemas and metadata schemas do not have a model
+	m, err := i.repos.Model.FindBySchema(ctx, s.ID())
+	if err != nil && !errors.Is(err, rerror.ErrNotFound) {
+		return err
+	}
+
+	// the fields of a group schema are scoped to the group so that webhooks can be filtered by it
+	var groups id.GroupIDList
+	if m == nil {
+		gl, err := i.repos.Group.FindByProject(ctx, s.Project())
+		if err != nil {
+			return err
+		}
+		if g, ok := lo.Find(gl, func(g *group.Group) bool { return g.Schema() == s.ID() }); ok {
+			groups = id.GroupIDList{g.ID()}
+		}
+	}
+
+	events := lo.Map(fields, func(f *schema.Field, _ int) Event {
+		return Event{
+			Project:   prj,
+			Workspace: s.Workspace(),
+			Type:      t,
+			Operator:  op.Operator(),
+			Object:    s,
+			WebhookObject: schema.FieldModelSchema{
+				Field:  f,
+				Schema: s,
+				Model:  m,
+			},
+			Groups: groups,
+		}
+	})
+	_, err = createEvents(ctx, i.repos, i.gateways, events)
+	return err
+}
+
 func (i Schema) GuessSchemaFieldsByAsset(ctx context.Context, assetID id.AssetID, modelID id.ModelID, operator *usecase.Operator) (*interfaces.GuessSchemaFieldsData, error) {
 	if operator.AcOperator.User == nil && operator.Integration == nil {
 		return &interfaces.GuessSchemaFieldsData{}, interfaces.ErrInvalidOperator
@@ -2,15 +2,23 @@ package interactor
 
 import (
 	"context"
+	"strings"
 
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearth-cms/server/pkg/operator"
 	"github.com/reearth/reearth-cms/server/pkg/rbac"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
 	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountdomain/user"
+	"github.com/reearth/reearthx/account/accountdomain/workspace"
+	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 )
 
@@ -82,7 +90,7 @@ func (i *Thread) CreateThreadWithComment(ctx context.Context, input interfaces.C
 			if err := i.linkThreadToResource(ctx, th.ID(), input.ResourceType, input.ResourceID); err != nil {
 				return nil, nil, err
 			}
-			_, c, err := i.addComment(ctx, th.ID(), input.Content, op)
+			_, c, err := i.addComment(ctx, th.ID(), input.Content, nil, op)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -147,12 +155,25 @@ func (i *Thread) AddComment(ctx context.Context, thid id.ThreadID, content strin
 		ctx, op, i.repos,
 		Usecase().Transaction(),
 		func(ctx context.Context) (*thread.Thread, *thread.Comment, error) {
-			return i.addComment(ctx, thid, content, op)
+			return i.addComment(ctx, thid, content, nil, op)
 		},
 	)
 }
 
-func (i *Thread) addComment(ctx context.Context, thid id.ThreadID, content string, op *usecase.Operator) (*thread.Thread, *thread.Comment, error) {
+func (i *Thread) ReplyComment(ctx context.Context, thid id.ThreadID, replyTo id.CommentID, content string, op *usecase.Operator) (*thread.Thread, *thread.Comment, error) {
+	if op.AcOperator.User == nil && op.Integration == nil {
+		return nil, nil, interfaces.ErrInvalidOperator
+	}
+	return Run2(
+		ctx, op, i.repos,
+		Usecase().Transaction(),
+		func(ctx context.Context) (*thread.Thread, *thread.Comment, error) {
+			return i.addComment(ctx, thid, content, &replyTo, op)
+		},
+	)
+}
+
+func (i *Thread) addComment(ctx context.Context, thid id.ThreadID, content string, replyTo *id.CommentID, op *usecase.Operator) (*thread.Thread, *thread.Comment, error) {
 	th, err := i.repos.Thread.FindByID(ctx, thid)
 	if err != nil {
 		return nil, nil, err
@@ -166,7 +187,20 @@ func (i *Thread) addComment(ctx context.Context, thid id.ThreadID, content strin
 		return nil, nil, err
 	}
 
+	// the participants are the users who have commented on the thread so far
+	participants := lo.FilterMap(th.Comments(), func(c *thread.Comment, _ int) (accountdomain.UserID, bool) {
+		u := c.Author().User()
+		return lo.FromPtr(u), u != nil
+	})
+
+	mentions, err := i.resolveMentions(ctx, th.Workspace(), content)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	comment := thread.NewComment(thread.NewCommentID(), op.Operator(), content)
+	comment.SetReplyTo(replyTo)
+	comment.SetMentions(mentions)
 	if err := th.AddComment(comment); err != nil {
 		return nil, nil, err
 	}
@@ -175,9 +209,87 @@ func (i *Thread) addComment(ctx context.Context, thid id.ThreadID, content strin
 		return nil, nil, err
 	}
 
+	// the mentioned users get a mention instead of the usual notification of a new comment
+	if err := i.notify(ctx, th, comment, notification.TypeMention, op, mentions.Users()); err != nil {
+		return nil, nil, err
+	}
+	if err := i.notify(ctx, th, comment, notification.TypeComment, op, lo.Without(participants, mentions.Users()...)); err != nil {
+		return nil, nil, err
+	}
+
+	if err := i.event(ctx, th, comment, event.CommentCreate, op); err != nil {
+		return nil, nil, err
+	}
+
 	return th, comment, nil
 }
 
+// resolveMentions resolves the names mentioned in the content to the users and the integrations of the workspace.
+// Names which match nobody are left as plain text.
+func (i *Thread) resolveMentions(ctx context.Context, wid accountdomain.WorkspaceID, content string) (thread.MentionList, error) {
+	names := thread.ParseMentions(content)
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	ws, err := i.repos.Workspace.FindByID(ctx, wid)
+	if err != nil {
+		return nil, err
+	}
+	users, err := i.repos.User.FindByIDs(ctx, ws.Members().UserIDs())
+	if err != nil {
+		return nil, err
+	}
+	iids, err := util.TryMap(ws.Members().IntegrationIDs(), func(iid workspace.IntegrationID) (id.IntegrationID, error) {
+		return id.IntegrationIDFrom(iid.String())
+	})
+	if err != nil {
+		return nil, err
+	}
+	integrations, err := i.repos.Integration.FindByIDs(ctx, iids)
+	if err != nil {
+		return nil, err
+	}
+
+	var res thread.MentionList
+	for _, name := range names {
+		if u, ok := lo.Find(users, func(u *user.User) bool { return strings.EqualFold(u.Name(), name) }); ok {
+			res = append(res, thread.NewMention(name, operator.OperatorFromUser(u.ID())))
+			continue
+		}
+		if in, ok := lo.Find(integrations, func(in *integration.Integration) bool { return strings.EqualFold(in.Name(), name) }); ok {
+			res = append(res, thread.NewMention(name, operator.OperatorFromIntegration(in.ID())))
+		}
+	}
+	return res, nil
+}
+
+func (i *Thread) notify(ctx context.Context, th *thread.Thread, c *thread.Comment, t notification.Type, op *usecase.Operator, recipients accountdomain.UserIDList) error {
+	_, err := notify(ctx, i.repos, i.gateways, notificationParam{
+		Workspace: th.Workspace(),
+		Type:      t,
+		Actor:     op.AcOperator.User,
+		Thread:    th.ID().Ref(),
+		Comment:   c.ID().Ref(),
+		Content:   c.Content(),
+	}, recipients)
+	return err
+}
+
+func (i *Thread) event(ctx context.Context, th *thread.Thread, c *thread.Comment, t event.Type, op *usecase.Operator) error {
+	_, err := createEvent(ctx, i.repos, i.gateways, Event{
+		Workspace: th.Workspace(),
+		Type:      t,
+		Operator:  op.Operator(),
+		Object:    th,
+		WebhookObject: thread.CommentThread{
+			Thread:  th,
+			Comment: c,
+		},
+	})
+	return err
+}
+
 func (i *Thread) UpdateComment(ctx context.Context, thid id.ThreadID, cid id.CommentID, content string, op *usecase.Operator) (*thread.Thread, *thread.Comment, error) {
 	if op.AcOperator.User == nil && op.Integration == nil {
 		return nil, nil, interfaces.ErrInvalidOperator
@@ -203,11 +315,28 @@ func (i *Thread) UpdateComment(ctx context.Context, thid id.ThreadID, cid id.Com
 				return nil, nil, err
 			}
 
+			c := th.Comment(cid)
+			mentioned := c.Mentions().Users()
+			mentions, err := i.resolveMentions(ctx, th.Workspace(), content)
+			if err != nil {
+				return nil, nil, err
+			}
+			c.SetMentions(mentions)
+
 			if err := i.repos.Thread.Save(ctx, th); err != nil {
 				return nil, nil, err
 			}
 
-			return th, th.Comment(cid), nil
+			// only the users newly mentioned by the edit are notified
+			if err := i.notify(ctx, th, c, notification.TypeMention, op, lo.Without(mentions.Users(), mentioned...)); err != nil {
+				return nil, nil, err
+			}
+
+			if err := i.event(ctx, th, c, event.CommentUpdate, op); err != nil {
+				return nil, nil, err
+			}
+
+			return th, c, nil
 		},
 	)
 }
@@ -233,6 +362,7 @@ func (i *Thread) DeleteComment(ctx context.Context, thid id.ThreadID, cid id.Com
 				return nil, err
 			}
 
+			c := th.Comment(cid)
 			if err := th.DeleteComment(cid); err != nil {
 				return nil, err
 			}
@@ -241,6 +371,107 @@ func (i *Thread) DeleteComment(ctx context.Context, thid id.ThreadID, cid id.Com
 				return nil, err
 			}
 
+			if err := i.event(ctx, th, c, event.CommentDelete, op); err != nil {
+				return nil, err
+			}
+
+			return th, nil
+		},
+	)
+}
+
+func (i *Thread) AddReaction(ctx context.Context, thid id.ThreadID, cid id.CommentID, emoji string, op *usecase.Operator) (*thread.Thread, *thread.Comment, error) {
+	return i.updateComment(ctx, thid, cid, op, func(c *thread.Comment) error {
+		r, err := thread.NewReaction(emoji, op.Operator())
+		if err != nil {
+			return err
+		}
+		return c.AddReaction(r)
+	})
+}
+
+func (i *Thread) RemoveReaction(ctx context.Context, thid id.ThreadID, cid id.CommentID, emoji string, op *usecase.Operator) (*thread.Thread, *thread.Comment, error) {
+	return i.updateComment(ctx, thid, cid, op, func(c *thread.Comment) error {
+		return c.RemoveReaction(emoji, op.Operator())
+	})
+}
+
+func (i *Thread) updateComment(ctx context.Context, thid id.ThreadID, cid id.CommentID, op *usecase.Operator, f func(*thread.Comment) error) (*thread.Thread, *thread.Comment, error) {
+	if op.AcOperator.User == nil && op.Integration == nil {
+		return nil, nil, interfaces.ErrInvalidOperator
+	}
+	return Run2(
+		ctx, op, i.repos,
+		Usecase().Transaction(),
+		func(ctx context.Context) (*thread.Thread, *thread.Comment, error) {
+			th, err := i.repos.Thread.FindByID(ctx, thid)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if !op.IsWritableWorkspace(th.Workspace()) {
+				return nil, nil, interfaces.ErrOperationDenied
+			}
+
+			if err := i.checkPermissions(ctx, rbac.ActionComment, th.Workspace()); err != nil {
+				return nil, nil, err
+			}
+
+			c := th.Comment(cid)
+			if c == nil {
+				return nil, nil, interfaces.ErrCommentDoesNotExist
+			}
+			if err := f(c); err != nil {
+				return nil, nil, err
+			}
+
+			if err := i.repos.Thread.Save(ctx, th); err != nil {
+				return nil, nil, err
+			}
+
+			return th, c, nil
+		},
+	)
+}
+
+func (i *Thread) Resolve(ctx context.Context, thid id.ThreadID, op *usecase.Operator) (*thread.Thread, error) {
+	return i.updateThread(ctx, thid, op, func(th *thread.Thread) {
+		th.Resolve(op.Operator())
+	})
+}
+
+func (i *Thread) Reopen(ctx context.Context, thid id.ThreadID, op *usecase.Operator) (*thread.Thread, error) {
+	return i.updateThread(ctx, thid, op, func(th *thread.Thread) {
+		th.Reopen()
+	})
+}
+
+func (i *Thread) updateThread(ctx context.Context, thid id.ThreadID, op *usecase.Operator, f func(*thread.Thread)) (*thread.Thread, error) {
+	if op.AcOperator.User == nil && op.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+	return Run1(
+		ctx, op, i.repos,
+		Usecase().Transaction(),
+		func(ctx context.Context) (*thread.Thread, error) {
+			th, err := i.repos.Thread.FindByID(ctx, thid)
+			if err != nil {
+				return nil, err
+			}
+
+			if !op.IsWritableWorkspace(th.Workspace()) {
+				return nil, interfaces.ErrOperationDenied
+			}
+
+			if err := i.checkPermissions(ctx, rbac.ActionComment, th.Workspace()); err != nil {
+				return nil, err
+			}
+
+			f(th)
+			if err := i.repos.Thread.Save(ctx, th); err != nil {
+				return nil, err
+			}
+
 			return th, nil
 		},
 	)
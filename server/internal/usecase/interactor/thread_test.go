@@ -8,10 +8,14 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
 	"github.com/reearth/reearth-cms/server/pkg/operator"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
 	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountdomain/user"
+	"github.com/reearth/reearthx/account/accountdomain/workspace"
 	"github.com/reearth/reearthx/account/accountusecase"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/samber/lo"
@@ -590,3 +594,79 @@ func TestThread_DeleteComment(t *testing.T) {
 		})
 	}
 }
+
+func TestThread_MentionsRepliesAndReactions(t *testing.T) {
+	ctx := context.Background()
+	db := memory.New()
+
+	alice := user.New().NewID().Name("alice").Email("alice@example.com").MustBuild()
+	bob := user.New().NewID().Name("bob").Email("bob@example.com").MustBuild()
+	carol := user.New().NewID().Name("carol").Email("carol@example.com").MustBuild()
+	in := integration.New().NewID().Developer(alice.ID()).Name("bot").MustBuild()
+	iid := lo.Must(accountdomain.IntegrationIDFrom(in.ID().String()))
+	ws := workspace.New().NewID().Members(map[accountdomain.UserID]workspace.Member{
+		alice.ID(): {Role: workspace.RoleOwner},
+		bob.ID():   {Role: workspace.RoleWriter},
+		carol.ID(): {Role: workspace.RoleWriter},
+	}).MustBuild()
+	lo.Must0(ws.Members().AddIntegration(iid, workspace.RoleWriter, alice.ID()))
+	for _, u := range []*user.User{alice, bob, carol} {
+		lo.Must0(db.User.Save(ctx, u))
+	}
+	lo.Must0(db.Workspace.Save(ctx, ws))
+	lo.Must0(db.Integration.Save(ctx, in))
+	th := thread.New().NewID().Workspace(ws.ID()).MustBuild()
+	lo.Must0(db.Thread.Save(ctx, th))
+
+	op := func(u *user.User) *usecase.Operator {
+		return &usecase.Operator{AcOperator: &accountusecase.Operator{User: new(u.ID()), WritableWorkspaces: accountdomain.WorkspaceIDList{ws.ID()}}}
+	}
+	notifications := func(u *user.User) []notification.Type {
+		l := lo.Must(db.Notification.FindUnreadByUser(ctx, u.ID()))
+		return lo.Map(l, func(n *notification.Notification, _ int) notification.Type { return n.Type() })
+	}
+	threadUC := NewThread(db, nil)
+
+	_, c1, err := threadUC.AddComment(ctx, th.ID(), "hi @Bob and @bot, mail me at carol@example.com", op(carol))
+	assert.NoError(t, err)
+	assert.Equal(t, thread.MentionList{
+		thread.NewMention("Bob", operator.OperatorFromUser(bob.ID())),
+		thread.NewMention("bot", operator.OperatorFromIntegration(in.ID())),
+	}, c1.Mentions())
+
+	// the participant is notified of the reply and the mentioned user of the mention
+	_, c2, err := threadUC.ReplyComment(ctx, th.ID(), c1.ID(), "@alice please check", op(bob))
+	assert.NoError(t, err)
+	assert.Equal(t, c1.ID().Ref(), c2.ReplyTo())
+	_, _, err = threadUC.ReplyComment(ctx, th.ID(), id.NewCommentID(), "xxx", op(bob))
+	assert.Equal(t, thread.ErrCommentDoesNotExist, err)
+	assert.Equal(t, []notification.Type{notification.TypeMention}, notifications(bob))
+	assert.Equal(t, []notification.Type{notification.TypeMention}, notifications(alice))
+	assert.Equal(t, []notification.Type{notification.TypeComment}, notifications(carol))
+
+	// only the newly mentioned users are notified on edit
+	_, c2, err = threadUC.UpdateComment(ctx, th.ID(), c2.ID(), "@alice @carol please check", op(bob))
+	assert.NoError(t, err)
+	assert.Len(t, c2.Mentions(), 2)
+	assert.Len(t, notifications(alice), 1)
+	assert.Equal(t, []notification.Type{notification.TypeMention, notification.TypeComment}, notifications(carol))
+
+	_, c1, err = threadUC.AddReaction(ctx, th.ID(), c1.ID(), "+1", op(alice))
+	assert.NoError(t, err)
+	assert.Len(t, c1.Reactions(), 1)
+	_, _, err = threadUC.AddReaction(ctx, th.ID(), c1.ID(), "+1", op(alice))
+	assert.Equal(t, thread.ErrAlreadyReacted, err)
+	_, _, err = threadUC.AddReaction(ctx, th.ID(), id.NewCommentID(), "+1", op(alice))
+	assert.Equal(t, interfaces.ErrCommentDoesNotExist, err)
+	_, c1, err = threadUC.RemoveReaction(ctx, th.ID(), c1.ID(), "+1", op(alice))
+	assert.NoError(t, err)
+	assert.Empty(t, c1.Reactions())
+
+	res, err := threadUC.Resolve(ctx, th.ID(), op(alice))
+	assert.NoError(t, err)
+	assert.True(t, res.IsResolved())
+	assert.Equal(t, alice.ID().Ref(), res.ResolvedBy().User())
+	res, err = threadUC.Reopen(ctx, th.ID(), op(alice))
+	assert.NoError(t, err)
+	assert.False(t, res.IsResolved())
+}
@@ -110,14 +110,43 @@ func Run3[A, B, C any](ctx context.Context, op *usecase.Operator, r *repo.Contai
 		tr = r.Transaction
 	}
 
+	// the callbacks registered by a nested use case run after the outermost transaction commits
+	hooks, nested := ctx.Value(afterCommitKey{}).(*[]func())
+	if !nested {
+		hooks = &[]func(){}
+	}
+
 	err = usecasex.DoTransaction(ctx, tr, transactionRetry, func(ctx context.Context) error {
+		if !nested {
+			// the callbacks of an aborted attempt are discarded when the transaction is retried
+			*hooks = nil
+			ctx = context.WithValue(ctx, afterCommitKey{}, hooks)
+		}
 		a, b, c, err = f(ctx)
 		return err
 	})
 
+	if err == nil && !nested {
+		for _, h := range *hooks {
+			h()
+		}
+	}
 	return
 }
 
+type afterCommitKey struct{}
+
+// afterCommit defers f until the transaction of the running use case commits, so that side effects which can not be
+// rolled back, such as sending emails, are not performed for changes which are not saved.
+// f is called immediately when no use case is running.
+func afterCommit(ctx context.Context, f func()) {
+	if hooks, ok := ctx.Value(afterCommitKey{}).(*[]func()); ok {
+		*hooks = append(*hooks, f)
+		return
+	}
+	f()
+}
+
 func (u *uc) checkPermissions2(ctx context.Context) error {
 	if u.authz == nil || len(u.authzChecks) == 0 {
 		return nil
@@ -8,6 +8,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/file"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/idx"
 	"github.com/reearth/reearthx/rerror"
@@ -21,11 +22,35 @@ type CreateAssetParam struct {
 	File              *file.File
 	Token             string
 	SkipDecompression bool
+	Folder            *id.AssetFolderID
+}
+
+// ReplaceAssetFileParam uploads a new file for the asset. Either File or Token is required as well as CreateAssetParam.
+type ReplaceAssetFileParam struct {
+	AssetID           id.AssetID
+	File              *file.File
+	Token             string
+	SkipDecompression bool
 }
 
 type UpdateAssetParam struct {
 	AssetID     idx.ID[id.Asset]
 	PreviewType *asset.PreviewType
+	// Metadata updates the given metadata fields and keeps the others. A nil value clears the field.
+	Metadata []AssetMetadataFieldParam
+}
+
+// AssetMetadataFieldParam is a value of a field of the asset metadata schema, which is specified by either the ID or the key.
+type AssetMetadataFieldParam struct {
+	Field *id.FieldID
+	Key   *id.Key
+	Value any
+}
+
+type MoveAssetsParam struct {
+	AssetIDs id.AssetIDList
+	// Folder is the destination folder. A nil folder moves the assets to the root of the project.
+	Folder *id.AssetFolderID
 }
 
 type CreateAssetUploadParam struct {
@@ -44,6 +69,7 @@ var (
 	ErrFileNotIncluded                     error = rerror.NewE(i18n.T("file not included"))
 	ErrDataTransferUploadSizeLimitExceeded error = rerror.NewE(i18n.T("data transfer upload size limit exceeded"))
 	ErrAssetUploadSizeLimitExceeded        error = rerror.NewE(i18n.T("asset upload size limit exceeded"))
+	ErrAssetMetadataSchemaNotFound         error = rerror.NewE(i18n.T("asset metadata schema not found"))
 )
 
 type AssetFilter struct {
@@ -51,6 +77,13 @@ type AssetFilter struct {
 	Keyword      *string
 	Pagination   *usecasex.Pagination
 	ContentTypes []string
+	// Folder limits the assets to the ones in the folder, or the ones at the root of the project when RootFolder is true.
+	// Recursive includes the assets in the sub folders as well.
+	Folder     *id.AssetFolderID
+	RootFolder bool
+	Recursive  bool
+	// Metadata limits the assets to the ones which have all the values in their metadata fields.
+	Metadata []AssetMetadataFieldParam
 }
 
 type AssetUpload struct {
@@ -76,9 +109,13 @@ type Asset interface {
 	Export(context.Context, ExportAssetsParams, io.Writer, *usecase.Operator) error
 	FindFileByID(context.Context, id.AssetID, *usecase.Operator) (*asset.File, error)
 	FindFilesByIDs(context.Context, id.AssetIDList, *usecase.Operator) (map[id.AssetID]*asset.File, error)
+	FindMetadataSchema(context.Context, id.ProjectID, *usecase.Operator) (*schema.Schema, error)
 	DownloadByID(context.Context, id.AssetID, map[string]string, *usecase.Operator) (io.ReadCloser, map[string]string, error)
 	Create(context.Context, CreateAssetParam, *usecase.Operator) (*asset.Asset, *asset.File, error)
 	Update(context.Context, UpdateAssetParam, *usecase.Operator) (*asset.Asset, error)
+	ReplaceFile(context.Context, ReplaceAssetFileParam, *usecase.Operator) (*asset.Asset, *asset.File, error)
+	RestoreVersion(context.Context, id.AssetID, string, *usecase.Operator) (*asset.Asset, *asset.File, error)
+	Move(context.Context, MoveAssetsParam, *usecase.Operator) (asset.List, error)
 	UpdateFiles(context.Context, id.AssetID, *asset.ArchiveExtractionStatus, *usecase.Operator) (*asset.Asset, error)
 	Delete(context.Context, id.AssetID, *usecase.Operator) (id.AssetID, error)
 	BatchDelete(context.Context, id.AssetIDList, *usecase.Operator) ([]id.AssetID, error)
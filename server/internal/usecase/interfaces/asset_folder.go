@@ -0,0 +1,38 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+)
+
+var ErrFolderPermissionDenied error = rerror.NewE(i18n.T("operation denied by the folder permission"))
+
+type CreateAssetFolderParam struct {
+	ProjectID  id.ProjectID
+	Parent     *id.AssetFolderID
+	Name       string
+	Permission *asset.FolderPermission
+}
+
+type UpdateAssetFolderParam struct {
+	FolderID   id.AssetFolderID
+	Name       *string
+	Permission *asset.FolderPermission
+	// InheritPermission clears the permission of the folder so that the one of the parent is used.
+	InheritPermission bool
+}
+
+type AssetFolder interface {
+	FindByID(context.Context, id.AssetFolderID, *usecase.Operator) (*asset.Folder, error)
+	FindByIDs(context.Context, id.AssetFolderIDList, *usecase.Operator) (asset.FolderList, error)
+	FindByProject(context.Context, id.ProjectID, *usecase.Operator) (asset.FolderList, error)
+	Create(context.Context, CreateAssetFolderParam, *usecase.Operator) (*asset.Folder, error)
+	Update(context.Context, UpdateAssetFolderParam, *usecase.Operator) (*asset.Folder, error)
+	Move(context.Context, id.AssetFolderID, *id.AssetFolderID, *usecase.Operator) (*asset.Folder, error)
+	Delete(context.Context, id.AssetFolderID, *usecase.Operator) (id.AssetFolderID, error)
+}
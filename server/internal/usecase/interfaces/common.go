@@ -17,6 +17,7 @@ var (
 
 type Container struct {
 	Asset             Asset
+	AssetFolder       AssetFolder
 	Workspace         accountinterfaces.Workspace
 	WorkspaceSettings WorkspaceSettings
 	User              accountinterfaces.User
@@ -30,4 +31,6 @@ type Container struct {
 	Thread            Thread
 	Group             Group
 	Job               Job
+	Schedule          Schedule
+	Notification      Notification
 }
@@ -3,11 +3,13 @@ package interfaces
 import (
 	"context"
 	"net/url"
+	"time"
 
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearthx/usecasex"
 )
 
 type CreateIntegrationParam struct {
@@ -29,6 +31,8 @@ type CreateWebhookParam struct {
 	Secret  string
 	Active  bool
 	Trigger *WebhookTriggerParam
+	Filter  *WebhookFilterParam
+	Payload *WebhookPayloadParam
 }
 
 type UpdateWebhookParam struct {
@@ -37,10 +41,23 @@ type UpdateWebhookParam struct {
 	Active  *bool
 	Trigger *WebhookTriggerParam
 	Secret  *string
+	Filter  *WebhookFilterParam
+	Payload *WebhookPayloadParam
 }
 
 type WebhookTriggerParam map[event.Type]bool
 
+type WebhookFilterParam struct {
+	Models id.ModelIDList
+	Groups id.GroupIDList
+}
+
+type WebhookPayloadParam struct {
+	Type integration.WebhookPayloadType
+	// Fields are the keys of the item fields to be sent when the type is projection
+	Fields []string
+}
+
 type Integration interface {
 	FindByIDs(context.Context, id.IntegrationIDList, *usecase.Operator) (integration.List, error)
 	FindByMe(context.Context, *usecase.Operator) (integration.List, error)
@@ -53,4 +70,9 @@ type Integration interface {
 	CreateWebhook(context.Context, id.IntegrationID, CreateWebhookParam, *usecase.Operator) (*integration.Webhook, error)
 	UpdateWebhook(context.Context, id.IntegrationID, id.WebhookID, UpdateWebhookParam, *usecase.Operator) (*integration.Webhook, error)
 	DeleteWebhook(context.Context, id.IntegrationID, id.WebhookID, *usecase.Operator) error
+
+	FindWebhookDeliveries(context.Context, id.IntegrationID, id.WebhookID, *usecasex.Pagination, *usecase.Operator) (integration.WebhookDeliveryList, *usecasex.PageInfo, error)
+	RedeliverWebhook(context.Context, id.WebhookID, id.WebhookDeliveryID, *usecase.Operator) (*integration.WebhookDelivery, error)
+	// RetryWebhookDeliveries sends the failed deliveries which are due again and deactivates the webhooks which keep failing.
+	RetryWebhookDeliveries(context.Context, time.Time) (integration.WebhookDeliveryList, error)
 }
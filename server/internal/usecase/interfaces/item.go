@@ -10,8 +10,10 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/exporters"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/item/view"
 	"github.com/reearth/reearth-cms/server/pkg/model"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
@@ -34,6 +36,7 @@ var (
 	ErrMetadataMismatch         = rerror.NewE(i18n.T("metadata item and schema mismatch"))
 	ErrImportFileTooLarge       = rerror.NewE(i18n.T("import file is too large (max 100MB)"))
 	ErrImportTooManyRecords     = rerror.NewE(i18n.T("import file contains too many records (max 50,000)"))
+	ErrBulkUpdateUniqueField    = rerror.NewE(i18n.T("unique fields can not be updated in bulk"))
 )
 
 type ItemFieldParam struct {
@@ -41,6 +44,9 @@ type ItemFieldParam struct {
 	Key   *id.Key
 	Value any
 	Group *id.ItemGroupID
+	// Locales holds translated values keyed by locale; Value is the value in the default locale.
+	// A nil map keeps the current translations and an empty map clears them.
+	Locales map[string]any
 }
 
 type CreateItemParam struct {
@@ -133,11 +139,45 @@ type ImportItemsAsyncParam struct {
 	GeoField     *string
 }
 
+type BulkUpdateItemsParam struct {
+	ModelID       id.ModelID
+	SchemaPackage schema.Package
+	// Filter narrows down the items to update. All the items of the model are updated when it is nil.
+	Filter *view.Condition
+	Fields []ItemFieldParam
+}
+
+type ExportItemsAsyncParam struct {
+	ModelID       id.ModelID
+	SchemaPackage schema.Package
+	Format        exporters.ExportFormat
+	PublicOnly    bool
+	// IncludeAssets archives the exported file with the files of the referenced assets as a zip
+	IncludeAssets bool
+}
+
 type ExportItemParams struct {
 	ModelID       id.ModelID
 	Format        exporters.ExportFormat
 	Options       exporters.ExportOptions
 	SchemaPackage schema.Package
+	Filter        *view.Condition
+	Sort          *view.Sort
+}
+
+// TrashedItem is an item in the trash. ExpiresAt is when the item is purged, or nil when the trash is kept forever.
+type TrashedItem struct {
+	Item      item.Versioned
+	Entry     *trash.Entry
+	ExpiresAt *time.Time
+}
+
+// ItemDiff is the changes of the fields of an item from a version to another.
+// From is nil when the item has no version at the ref, such as an item which has never been published.
+type ItemDiff struct {
+	From    item.Versioned
+	To      item.Versioned
+	Changes item.FieldChanges
 }
 
 type Item interface {
@@ -149,18 +189,27 @@ type Item interface {
 	FindPublicByModel(context.Context, id.ModelID, *usecasex.Pagination, *usecase.Operator) (item.List, *usecasex.PageInfo, error)
 	FindVersionByID(context.Context, id.ItemID, version.VersionOrRef, *usecase.Operator) (item.Versioned, error)
 	FindAllVersionsByID(context.Context, id.ItemID, *usecase.Operator) (item.VersionedList, error)
+	Diff(context.Context, id.ItemID, version.VersionOrRef, version.VersionOrRef, *usecase.Operator) (*ItemDiff, error)
 	Search(context.Context, schema.Package, *item.Query, *usecasex.Pagination, *usecase.Operator) (item.VersionedList, *usecasex.PageInfo, error)
 	Export(context.Context, ExportItemParams, io.Writer, *usecase.Operator) error
+	ExportAsync(context.Context, ExportItemsAsyncParam, *usecase.Operator) (id.JobID, error)
+	PurgeExpiredExports(context.Context, time.Time) ([]string, error)
 	ItemStatus(context.Context, id.ItemIDList, *usecase.Operator) (map[id.ItemID]item.Status, error)
 	LastModifiedByModel(context.Context, id.ModelID, *usecase.Operator) (time.Time, error)
 	IsItemReferenced(context.Context, id.ItemID, id.FieldID, *usecase.Operator) (bool, error)
 	Create(context.Context, CreateItemParam, *usecase.Operator) (item.Versioned, error)
 	Update(context.Context, UpdateItemParam, *usecase.Operator) (item.Versioned, error)
+	Restore(context.Context, id.ItemID, version.VersionOrRef, *usecase.Operator) (item.Versioned, []item.DroppedField, error)
 	Delete(context.Context, id.ItemID, schema.Package, *usecase.Operator) error
 	BatchDelete(context.Context, id.ItemIDList, schema.Package, *usecase.Operator) (id.ItemIDList, error)
+	FindTrashed(context.Context, id.ModelID, *usecasex.Pagination, *usecase.Operator) ([]TrashedItem, *usecasex.PageInfo, error)
+	RestoreTrashed(context.Context, id.ItemIDList, *usecase.Operator) (item.VersionedList, error)
+	PurgeTrashed(context.Context, id.ItemIDList, *usecase.Operator) (id.ItemIDList, error)
+	PurgeTrashedBefore(context.Context, time.Time) (id.ItemIDList, error)
 	Publish(context.Context, id.ItemIDList, *usecase.Operator) (item.VersionedList, error)
 	Unpublish(context.Context, id.ItemIDList, *usecase.Operator) (item.VersionedList, error)
 	Import(context.Context, ImportItemsParam, *usecase.Operator) (ImportItemsResponse, error)
 	ImportAsync(context.Context, ImportItemsAsyncParam, *usecase.Operator) (id.JobID, error)
+	BulkUpdateAsync(context.Context, BulkUpdateItemsParam, *usecase.Operator) (id.JobID, error)
 	TriggerImportJob(context.Context, id.AssetID, id.ModelID, string, string, string, bool, *usecase.Operator) error
 }
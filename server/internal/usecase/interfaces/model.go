@@ -35,6 +35,8 @@ type FindByProjectAndKeywordParam struct {
 type FindOrCreateSchemaParam struct {
 	ModelID *id.ModelID
 	GroupID *id.GroupID
+	// ProjectID specifies the asset metadata schema of the project when neither a model nor a group is given
+	ProjectID *id.ProjectID
 	// boolean that identify if it is a metadata
 	Metadata *bool
 	// boolean to identify if we want to create a metadata schema or just return an error if metadata schema is nil
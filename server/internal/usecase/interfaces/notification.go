@@ -0,0 +1,30 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+var ErrInvalidEmailFrequency = rerror.NewE(i18n.T("invalid email frequency"))
+
+type UpdateNotificationPreferenceParam struct {
+	EmailFrequency *notification.EmailFrequency
+	MutedTypes     []notification.Type
+}
+
+type Notification interface {
+	FindByUser(context.Context, bool, *usecasex.Pagination, *usecase.Operator) (notification.List, *usecasex.PageInfo, error)
+	CountUnread(context.Context, *usecase.Operator) (int64, error)
+	// MarkAsRead marks the notifications of the operator as read, or all of them when no ids are given.
+	MarkAsRead(context.Context, id.NotificationIDList, *usecase.Operator) (notification.List, error)
+	FindPreference(context.Context, *usecase.Operator) (*notification.Preference, error)
+	UpdatePreference(context.Context, UpdateNotificationPreferenceParam, *usecase.Operator) (*notification.Preference, error)
+	// SendDigests emails the notifications waiting for a digest, one email per user.
+	SendDigests(context.Context) (notification.List, error)
+}
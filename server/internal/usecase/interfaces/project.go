@@ -43,6 +43,7 @@ type UpdateProjectParam struct {
 	Topics        *[]string
 	RequestRoles  []workspace.Role
 	Accessibility *AccessibilityParam
+	Localization  *LocalizationParam
 }
 
 type AccessibilityParam struct {
@@ -60,6 +61,14 @@ type PublicationSettingsParam struct {
 	PublicAssets bool
 }
 
+// LocalizationParam replaces the locale configuration of the project.
+// Localization is turned off when no locale is given.
+type LocalizationParam struct {
+	DefaultLocale string
+	Locales       []string
+	Fallbacks     map[string][]string
+}
+
 type RegenerateKeyParam struct {
 	ProjectId id.ProjectID
 	KeyId     id.APIKeyID
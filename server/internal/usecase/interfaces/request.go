@@ -13,7 +13,9 @@ import (
 )
 
 var (
-	ErrAlreadyPublished = rerror.NewE(i18n.T("already published"))
+	ErrAlreadyPublished            = rerror.NewE(i18n.T("already published"))
+	ErrReviewersDefinedByWorkflow  = rerror.NewE(i18n.T("reviewers of the request are defined by its workflow"))
+	ErrItemsWithDifferentWorkflows = rerror.NewE(i18n.T("items of the request should have the same workflow"))
 )
 
 type CreateRequestParam struct {
@@ -34,6 +36,18 @@ type UpdateRequestParam struct {
 	Items       request.ItemList
 }
 
+type RequestStageParam struct {
+	Name              string
+	Reviewers         accountdomain.UserIDList
+	RequiredApprovals int
+}
+
+type SaveRequestWorkflowParam struct {
+	ProjectID id.ProjectID
+	ModelID   *id.ModelID
+	Stages    []RequestStageParam
+}
+
 type RequestFilter struct {
 	Keyword   *string
 	State     []request.State
@@ -49,5 +63,9 @@ type Request interface {
 	Create(context.Context, CreateRequestParam, *usecase.Operator) (*request.Request, error)
 	Update(context.Context, UpdateRequestParam, *usecase.Operator) (*request.Request, error)
 	Approve(context.Context, id.RequestID, *usecase.Operator) (*request.Request, error)
+	RequestChanges(context.Context, id.RequestID, string, *usecase.Operator) (*request.Request, error)
 	CloseAll(context.Context, id.ProjectID, id.RequestIDList, *usecase.Operator) error
+	FindWorkflows(context.Context, id.ProjectID, *usecase.Operator) (request.WorkflowList, error)
+	SaveWorkflow(context.Context, SaveRequestWorkflowParam, *usecase.Operator) (*request.Workflow, error)
+	DeleteWorkflow(context.Context, id.WorkflowID, *usecase.Operator) error
 }
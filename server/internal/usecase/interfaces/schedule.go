@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+)
+
+var (
+	ErrScheduleInPast     = rerror.NewE(i18n.T("scheduled time must be in the future"))
+	ErrScheduleNotPending = rerror.NewE(i18n.T("schedule is not pending"))
+)
+
+type CreateScheduleParam struct {
+	ItemIDs     id.ItemIDList
+	Action      schedule.Action
+	ScheduledAt time.Time
+}
+
+type Schedule interface {
+	FindByID(context.Context, id.ScheduleID, *usecase.Operator) (*schedule.Schedule, error)
+	FindByProject(context.Context, id.ProjectID, *schedule.Status, *usecase.Operator) (schedule.List, error)
+	Create(context.Context, CreateScheduleParam, *usecase.Operator) (*schedule.Schedule, error)
+	Cancel(context.Context, id.ScheduleID, *usecase.Operator) (*schedule.Schedule, error)
+	// RunDue publishes or unpublishes the items of every pending schedule whose time has come.
+	RunDue(context.Context, time.Time) (schedule.List, error)
+}
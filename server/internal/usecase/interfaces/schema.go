@@ -22,6 +22,7 @@ type CreateFieldParam struct {
 	Multiple     bool
 	Unique       bool
 	Required     bool
+	Localizable  bool
 	IsTitle      bool
 	TypeProperty *schema.TypeProperty
 	DefaultValue *value.Multiple
@@ -38,11 +39,28 @@ type UpdateFieldParam struct {
 	Multiple     *bool
 	Unique       *bool
 	Required     *bool
+	Localizable  *bool
 	IsTitle      *bool
 	TypeProperty *schema.TypeProperty
 	DefaultValue *value.Multiple
 }
 
+type SchemaRuleParam struct {
+	Type      schema.RuleType
+	FieldID   id.FieldID
+	Condition *SchemaRuleConditionParam
+	Operator  schema.CompareOperator
+	OtherID   *id.FieldID
+	Message   string
+}
+
+type SchemaRuleConditionParam struct {
+	FieldID  id.FieldID
+	Operator schema.ConditionOperator
+	// Value is converted to the type of the field.
+	Value any
+}
+
 type ModelData struct {
 	ModelID   *id.ModelID
 	SchemaID  id.SchemaID
@@ -96,6 +114,7 @@ type Schema interface {
 	UpdateField(context.Context, UpdateFieldParam, *usecase.Operator) (*schema.Field, error)
 	UpdateFields(context.Context, id.SchemaID, []UpdateFieldParam, *usecase.Operator) (schema.FieldList, error)
 	DeleteField(context.Context, id.SchemaID, id.FieldID, *usecase.Operator) error
+	UpdateRules(context.Context, id.SchemaID, []SchemaRuleParam, *usecase.Operator) (*schema.Schema, error)
 	GetSchemasAndGroupSchemasByIDs(context.Context, id.SchemaIDList, *usecase.Operator) (schema.List, schema.List, error)
 	GuessSchemaFieldsByAsset(context.Context, id.AssetID, id.ModelID, *usecase.Operator) (*GuessSchemaFieldsData, error)
 }
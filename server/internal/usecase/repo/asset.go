@@ -5,6 +5,7 @@ import (
 
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/usecasex"
 )
 
@@ -13,6 +14,23 @@ type AssetFilter struct {
 	Keyword      *string
 	Pagination   *usecasex.Pagination
 	ContentTypes []string
+	// Folders limits the assets to the ones in the folders, and RootFolder includes the ones which are not in any folder.
+	// The folders are not filtered when both are empty.
+	Folders    id.AssetFolderIDList
+	RootFolder bool
+	// Metadata limits the assets to the ones which have all the values in their metadata fields.
+	Metadata []AssetMetadataFilter
+}
+
+// AssetMetadataFilter matches the assets which have the value in the metadata field.
+// A field with multiple values matches when any of them is equal to the value.
+type AssetMetadataFilter struct {
+	Field id.FieldID
+	Value *value.Value
+}
+
+func (f AssetFilter) HasFolderFilter() bool {
+	return len(f.Folders) > 0 || f.RootFolder
 }
 
 type Asset interface {
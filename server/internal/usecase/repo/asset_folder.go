@@ -0,0 +1,17 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+)
+
+type AssetFolder interface {
+	Filtered(ProjectFilter) AssetFolder
+	FindByID(context.Context, id.AssetFolderID) (*asset.Folder, error)
+	FindByIDs(context.Context, id.AssetFolderIDList) (asset.FolderList, error)
+	FindByProject(context.Context, id.ProjectID) (asset.FolderList, error)
+	Save(context.Context, *asset.Folder) error
+	Remove(context.Context, id.AssetFolderID) error
+}
@@ -12,25 +12,34 @@ import (
 )
 
 type Container struct {
-	Asset             Asset
-	AssetFile         AssetFile
-	AssetUpload       AssetUpload
-	Lock              Lock
-	User              accountrepo.User
-	Workspace         accountrepo.Workspace
-	Project           Project
-	Model             Model
-	Schema            Schema
-	Item              Item
-	View              View
-	Integration       Integration
-	Thread            Thread
-	Event             Event
-	Request           Request
-	Group             Group
-	WorkspaceSettings WorkspaceSettings
-	Job               Job
-	Transaction       usecasex.Transaction
+	Asset                  Asset
+	AssetFile              AssetFile
+	AssetUpload            AssetUpload
+	AssetFolder            AssetFolder
+	Lock                   Lock
+	User                   accountrepo.User
+	Workspace              accountrepo.Workspace
+	Project                Project
+	Model                  Model
+	Schema                 Schema
+	Item                   Item
+	View                   View
+	Integration            Integration
+	Thread                 Thread
+	Event                  Event
+	Request                Request
+	RequestWorkflow        RequestWorkflow
+	Group                  Group
+	WorkspaceSettings      WorkspaceSettings
+	Job                    Job
+	Notification           Notification
+	NotificationPreference NotificationPreference
+	Schedule               Schedule
+	Trash                  Trash
+	WebhookDelivery        WebhookDelivery
+	Task                   Task
+	Copier                 Copier
+	Transaction            usecasex.Transaction
 }
 
 var (
@@ -42,25 +51,34 @@ func (c *Container) Filtered(workspace WorkspaceFilter, project ProjectFilter) *
 		return c
 	}
 	return &Container{
-		Asset:             c.Asset.Filtered(project),
-		AssetFile:         c.AssetFile,
-		AssetUpload:       c.AssetUpload,
-		Lock:              c.Lock,
-		Transaction:       c.Transaction,
-		Workspace:         c.Workspace,
-		User:              c.User,
-		Request:           c.Request,
-		Group:             c.Group.Filtered(project),
-		Item:              c.Item.Filtered(project),
-		View:              c.View.Filtered(project),
-		Project:           c.Project.Filtered(workspace, project),
-		Model:             c.Model.Filtered(project),
-		Schema:            c.Schema.Filtered(workspace),
-		Thread:            c.Thread.Filtered(workspace),
-		Integration:       c.Integration,
-		WorkspaceSettings: c.WorkspaceSettings,
-		Event:             c.Event,
-		Job:               c.Job,
+		Asset:                  c.Asset.Filtered(project),
+		AssetFile:              c.AssetFile,
+		AssetUpload:            c.AssetUpload,
+		AssetFolder:            c.AssetFolder.Filtered(project),
+		Lock:                   c.Lock,
+		Transaction:            c.Transaction,
+		Workspace:              c.Workspace,
+		User:                   c.User,
+		Request:                c.Request,
+		RequestWorkflow:        c.RequestWorkflow,
+		Group:                  c.Group.Filtered(project),
+		Item:                   c.Item.Filtered(project),
+		View:                   c.View.Filtered(project),
+		Project:                c.Project.Filtered(workspace, project),
+		Model:                  c.Model.Filtered(project),
+		Schema:                 c.Schema.Filtered(workspace),
+		Thread:                 c.Thread.Filtered(workspace),
+		Integration:            c.Integration,
+		WorkspaceSettings:      c.WorkspaceSettings,
+		Event:                  c.Event,
+		Job:                    c.Job,
+		Notification:           c.Notification,
+		NotificationPreference: c.NotificationPreference,
+		Schedule:               c.Schedule,
+		Trash:                  c.Trash,
+		WebhookDelivery:        c.WebhookDelivery,
+		Task:                   c.Task,
+		Copier:                 c.Copier,
 	}
 }
 
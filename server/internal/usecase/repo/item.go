@@ -50,5 +50,6 @@ type Item interface {
 	BatchRemove(context.Context, id.ItemIDList) error
 	RemoveByModel(context.Context, id.ModelID) error
 	Archive(context.Context, id.ItemID, id.ProjectID, bool) error
+	Trash(context.Context, id.ItemIDList, id.ProjectID, bool) error
 	Copy(context.Context, CopyParams) (*string, *string, error)
 }
@@ -0,0 +1,24 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+type Notification interface {
+	FindByID(context.Context, id.NotificationID) (*notification.Notification, error)
+	FindByUser(context.Context, accountdomain.UserID, bool, *usecasex.Pagination) (notification.List, *usecasex.PageInfo, error)
+	FindUnreadByUser(context.Context, accountdomain.UserID) (notification.List, error)
+	CountUnreadByUser(context.Context, accountdomain.UserID) (int64, error)
+	FindEmailPending(context.Context) (notification.List, error)
+	SaveAll(context.Context, notification.List) error
+}
+
+type NotificationPreference interface {
+	FindByUsers(context.Context, accountdomain.UserIDList) (notification.PreferenceList, error)
+	Save(context.Context, *notification.Preference) error
+}
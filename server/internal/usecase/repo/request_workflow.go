@@ -0,0 +1,15 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+)
+
+type RequestWorkflow interface {
+	FindByID(context.Context, id.WorkflowID) (*request.Workflow, error)
+	FindByProject(context.Context, id.ProjectID) (request.WorkflowList, error)
+	Save(context.Context, *request.Workflow) error
+	Remove(context.Context, id.WorkflowID) error
+}
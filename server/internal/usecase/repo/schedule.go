@@ -0,0 +1,16 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+)
+
+type Schedule interface {
+	FindByID(context.Context, id.ScheduleID) (*schedule.Schedule, error)
+	FindByProject(context.Context, id.ProjectID, *schedule.Status) (schedule.List, error)
+	FindDue(context.Context, time.Time) (schedule.List, error)
+	Save(context.Context, *schedule.Schedule) error
+}
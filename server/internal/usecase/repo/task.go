@@ -0,0 +1,22 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/task"
+)
+
+type Task interface {
+	FindByID(context.Context, id.TaskID) (*task.Task, error)
+	// Acquire starts the task which is due first and locks it for the lease so that other runners do not run it.
+	// It returns rerror.ErrNotFound when there are no due tasks.
+	Acquire(context.Context, time.Time, time.Duration) (*task.Task, error)
+	Save(context.Context, *task.Task) error
+}
+
+type Copier interface {
+	// Copy duplicates the documents of the collection which match the filter applying the changes to them.
+	Copy(context.Context, task.CopyPayload) error
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/item/view"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearth-cms/server/pkg/version"
@@ -40,6 +41,7 @@ func TestItemRepo(t *testing.T, newRepo itemFactory) {
 	t.Run("Remove", func(t *testing.T) { testItemRemove(t, newRepo) })
 	t.Run("BatchRemove", func(t *testing.T) { testItemBatchRemove(t, newRepo) })
 	t.Run("Archive", func(t *testing.T) { testItemArchive(t, newRepo) })
+	t.Run("Trash", func(t *testing.T) { testItemTrash(t, newRepo) })
 	t.Run("Search", func(t *testing.T) { testItemSearch(t, newRepo) })
 	t.Run("FindByModelAndValue", func(t *testing.T) { testItemFindByModelAndValue(t, newRepo) })
 	t.Run("UpdateRef", func(t *testing.T) { testItemUpdateRef(t, newRepo) })
@@ -714,6 +716,89 @@ func testItemArchive(t *testing.T, newRepo itemFactory) {
 	}
 }
 
+func testItemTrash(t *testing.T, newRepo itemFactory) {
+	ctx := context.Background()
+
+	t.Run("must move items to the trash and back", func(t *testing.T) {
+		t.Parallel()
+
+		pid, mid := id.NewProjectID(), id.NewModelID()
+		i1 := newItem(pid, id.NewSchemaID(), mid)
+		i2 := newItem(pid, id.NewSchemaID(), mid)
+		r := newRepo(t).Filtered(*rwFilter(pid))
+		require.NoError(t, r.SaveAll(ctx, item.List{i1, i2}))
+		require.NoError(t, r.UpdateRef(ctx, i1.ID(), version.Public, version.Latest.OrVersion().Ref()))
+
+		require.NoError(t, r.Trash(ctx, id.ItemIDList{i1.ID()}, pid, true))
+
+		// trashed items are hidden from the latest and public refs
+		_, err := r.FindByID(ctx, i1.ID(), nil)
+		assert.Equal(t, rerror.ErrNotFound, err)
+		_, err = r.FindByID(ctx, i1.ID(), version.Public.Ref())
+		assert.Equal(t, rerror.ErrNotFound, err)
+		got, _, err := r.FindByModel(ctx, mid, nil, nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, id.ItemIDList{i2.ID()}, got.Unwrap().IDs())
+
+		trashed, err := r.FindByID(ctx, i1.ID(), version.Trash.Ref())
+		assert.NoError(t, err)
+		assert.Equal(t, i1.ID(), trashed.Value().ID())
+		archived, err := r.IsArchived(ctx, i1.ID())
+		assert.NoError(t, err)
+		assert.True(t, archived)
+
+		require.NoError(t, r.Trash(ctx, id.ItemIDList{i1.ID()}, pid, false))
+
+		restored, err := r.FindByID(ctx, i1.ID(), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, i1.ID(), restored.Value().ID())
+		_, err = r.FindByID(ctx, i1.ID(), version.Trash.Ref())
+		assert.Equal(t, rerror.ErrNotFound, err)
+		archived, err = r.IsArchived(ctx, i1.ID())
+		assert.NoError(t, err)
+		assert.False(t, archived)
+
+		// restored items stay unpublished
+		_, err = r.FindByID(ctx, i1.ID(), version.Public.Ref())
+		assert.Equal(t, rerror.ErrNotFound, err)
+	})
+
+	t.Run("must purge trashed items", func(t *testing.T) {
+		t.Parallel()
+
+		pid := id.NewProjectID()
+		i1 := newItem(pid, id.NewSchemaID(), id.NewModelID())
+		r := newRepo(t).Filtered(*rwFilter(pid))
+		require.NoError(t, r.Save(ctx, i1))
+		require.NoError(t, r.Trash(ctx, id.ItemIDList{i1.ID()}, pid, true))
+
+		require.NoError(t, r.BatchRemove(ctx, id.ItemIDList{i1.ID()}))
+
+		_, err := r.FindByID(ctx, i1.ID(), version.Trash.Ref())
+		assert.Equal(t, rerror.ErrNotFound, err)
+	})
+
+	t.Run("must not trash items of other projects", func(t *testing.T) {
+		t.Parallel()
+
+		pid, pid2 := id.NewProjectID(), id.NewProjectID()
+		i1 := newItem(pid, id.NewSchemaID(), id.NewModelID())
+		base := newRepo(t)
+		require.NoError(t, base.Save(ctx, i1))
+
+		r := base.Filtered(*rwFilter(pid))
+		assert.Same(t, repo.ErrOperationDenied, r.Trash(ctx, id.ItemIDList{i1.ID()}, pid2, true))
+
+		// the item belongs to pid, not pid2
+		r = base.Filtered(*rwFilter(pid, pid2))
+		assert.NoError(t, r.Trash(ctx, id.ItemIDList{i1.ID()}, pid2, true))
+
+		got, err := base.FindByID(ctx, i1.ID(), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, i1.ID(), got.Value().ID())
+	})
+}
+
 func testItemSearch(t *testing.T, newRepo itemFactory) {
 	ctx := context.Background()
 	pid := id.NewProjectID()
@@ -730,6 +815,17 @@ func testItemSearch(t *testing.T, newRepo itemFactory) {
 	i4 := item.New().NewID().Schema(s2.ID()).Model(mid).Fields([]*item.Field{f1}).Project(pid).Thread(id.NewThreadID().Ref()).Anonymous(true).MustBuild()
 	sp := schema.NewPackage(s1, nil, nil, nil)
 
+	sf3 := schema.NewField(schema.NewGeometryObject(schema.GeometryObjectSupportedTypeList{schema.GeometryObjectSupportedTypePoint}).TypeProperty()).NewID().RandomKey().MustBuild()
+	s3 := schema.New().NewID().Project(pid).Workspace(accountdomain.NewWorkspaceID()).Fields([]*schema.Field{sf1, sf3}).MustBuild()
+	tokyo := item.NewField(sf3.ID(), value.TypeGeometryObject.Value(`{"type":"Point","coordinates":[139.767,35.681]}`).AsMultiple(), nil)
+	osaka := item.NewField(sf3.ID(), value.TypeGeometryObject.Value(`{"type":"Point","coordinates":[135.495,34.702]}`).AsMultiple(), nil)
+	i5 := item.New().NewID().Schema(s3.ID()).Model(mid).Fields([]*item.Field{f1, tokyo}).Project(pid).Thread(id.NewThreadID().Ref()).Anonymous(true).MustBuild()
+	i6 := item.New().NewID().Schema(s3.ID()).Model(mid).Fields([]*item.Field{osaka}).Project(pid).Thread(id.NewThreadID().Ref()).Anonymous(true).MustBuild()
+	spatial := func(c view.SpatialCondition) *view.Condition {
+		c.Field = view.FieldSelector{Type: view.FieldTypeField, ID: sf3.ID().Ref()}
+		return &view.Condition{ConditionType: view.ConditionTypeSpatial, SpatialCondition: &c}
+	}
+
 	tests := []struct {
 		name    string
 		seeds   item.List
@@ -756,6 +852,35 @@ func testItemSearch(t *testing.T, newRepo itemFactory) {
 			args:  item.NewQuery(pid, mid, s2.ID().Ref(), "foo", nil),
 			want:  1,
 		},
+		{
+			name:  "must find items in the bbox",
+			seeds: item.List{i1, i5, i6},
+			args:  item.NewQuery(pid, mid, nil, "", nil).WithFilter(spatial(view.SpatialCondition{Op: view.SpatialOperatorBBox, BBox: []float64{139, 35, 140, 36}})),
+			want:  1,
+		},
+		{
+			name:  "must find items intersecting the polygon",
+			seeds: item.List{i1, i5, i6},
+			args:  item.NewQuery(pid, mid, nil, "", nil).WithFilter(spatial(view.SpatialCondition{Op: view.SpatialOperatorIntersects, Polygon: [][]float64{{135, 34}, {140, 34}, {140, 36}, {135, 36}}})),
+			want:  2,
+		},
+		{
+			name:  "must find items near the point",
+			seeds: item.List{i1, i5, i6},
+			args:  item.NewQuery(pid, mid, nil, "", nil).WithFilter(spatial(view.SpatialCondition{Op: view.SpatialOperatorNear, Point: []float64{135.5, 34.7}, Radius: 1000})),
+			want:  1,
+		},
+		{
+			name:  "must find items matching the keyword and the bbox",
+			seeds: item.List{i1, i5, i6},
+			args: item.NewQuery(pid, mid, nil, "foo", nil).WithFilter(&view.Condition{
+				ConditionType: view.ConditionTypeAnd,
+				AndCondition: &view.AndCondition{Conditions: []view.Condition{
+					*spatial(view.SpatialCondition{Op: view.SpatialOperatorBBox, BBox: []float64{130, 30, 140, 40}}),
+				}},
+			}),
+			want: 1,
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,19 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+type Trash interface {
+	FindByItems(context.Context, id.ItemIDList) (trash.List, error)
+	FindByModel(context.Context, id.ModelID, *usecasex.Pagination) (trash.List, *usecasex.PageInfo, error)
+	FindTrashedBefore(context.Context, time.Time) (trash.List, error)
+	SaveAll(context.Context, trash.List) error
+	RemoveAll(context.Context, id.ItemIDList) error
+	RemoveByModel(context.Context, id.ModelID) error
+}
@@ -0,0 +1,19 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+type WebhookDelivery interface {
+	FindByID(context.Context, id.WebhookDeliveryID) (*integration.WebhookDelivery, error)
+	FindByWebhook(context.Context, id.WebhookID, *usecasex.Pagination) (integration.WebhookDeliveryList, *usecasex.PageInfo, error)
+	FindLatestByWebhook(context.Context, id.WebhookID, int) (integration.WebhookDeliveryList, error)
+	FindDue(context.Context, time.Time) (integration.WebhookDeliveryList, error)
+	FindDeadSince(context.Context, time.Time) (integration.WebhookDeliveryList, error)
+	Save(context.Context, *integration.WebhookDelivery) error
+}
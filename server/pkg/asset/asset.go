@@ -6,6 +6,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
 )
 
 type Asset struct {
@@ -25,6 +26,9 @@ type Asset struct {
 	archiveExtractionStatus *ArchiveExtractionStatus
 	flatFiles               bool
 	public                  bool
+	folder                  *FolderID
+	metadata                Metadata
+	versions                []*Version
 	accessInfoResolver      *AccessInfoResolver
 }
 
@@ -124,6 +128,56 @@ func (a *Asset) Public() bool {
 	return a.public
 }
 
+// Folder returns the folder which the asset is in, or nil when it is at the root of the project.
+func (a *Asset) Folder() *FolderID {
+	return a.folder
+}
+
+// Metadata returns the values of the custom metadata fields of the asset.
+func (a *Asset) Metadata() Metadata {
+	return a.metadata
+}
+
+// Versions returns the files which the asset has had, oldest first, and the last one is the current file.
+// It is empty when the file of the asset has never been replaced.
+func (a *Asset) Versions() []*Version {
+	return a.versions
+}
+
+// Version returns the file of the asset which has the UUID.
+func (a *Asset) Version(uuid string) *Version {
+	if uuid == a.uuid {
+		return a.currentVersion()
+	}
+	v, _ := lo.Find(a.versions, func(v *Version) bool { return v.UUID() == uuid })
+	return v
+}
+
+// UUIDs returns the UUIDs of all the files of the asset including the replaced ones.
+func (a *Asset) UUIDs() []string {
+	return lo.Uniq(append([]string{a.uuid}, lo.Map(a.versions, func(v *Version, _ int) string { return v.UUID() })...))
+}
+
+func (a *Asset) currentVersion() *Version {
+	if len(a.versions) > 0 {
+		if v := a.versions[len(a.versions)-1]; v.UUID() == a.uuid {
+			return v
+		}
+	}
+	b := NewVersion().
+		UUID(a.uuid).
+		FileName(a.fileName).
+		Size(a.size).
+		PreviewType(a.previewType).
+		UploadedAt(a.createdAt)
+	if a.integration != nil {
+		b = b.UploadedByIntegration(a.integration)
+	} else {
+		b = b.UploadedByUser(a.user)
+	}
+	return b.Build()
+}
+
 func (a *Asset) AccessInfo() AccessInfo {
 	defaultAccessInfo := AccessInfo{
 		Url:    "",
@@ -170,6 +224,31 @@ func (a *Asset) UpdatePublic(public bool) {
 	a.public = public
 }
 
+func (a *Asset) MoveToFolder(f *FolderID) {
+	a.folder = f.CloneRef()
+}
+
+func (a *Asset) SetMetadata(m Metadata) {
+	a.metadata = m.Clone()
+}
+
+// ReplaceFile replaces the file of the asset with the new one while keeping the current one in the versions.
+func (a *Asset) ReplaceFile(v *Version) {
+	if v == nil {
+		return
+	}
+	if len(a.versions) == 0 {
+		a.versions = []*Version{a.currentVersion()}
+	}
+	a.versions = append(a.versions, v)
+	a.fileName = v.FileName()
+	a.size = v.Size()
+	a.uuid = v.UUID()
+	a.previewType = v.PreviewType()
+	// the files extracted from the previous file are no longer the files of the asset
+	a.flatFiles = false
+}
+
 func (a *Asset) SetAccessInfoResolver(resolver AccessInfoResolver) {
 	if resolver == nil {
 		a.accessInfoResolver = nil
@@ -202,5 +281,8 @@ func (a *Asset) Clone() *Asset {
 		archiveExtractionStatus: a.archiveExtractionStatus,
 		flatFiles:               a.flatFiles,
 		public:                  a.public,
+		folder:                  a.folder.CloneRef(),
+		metadata:                a.metadata.Clone(),
+		versions:                cloneVersions(a.versions),
 	}
 }
@@ -150,3 +150,38 @@ func TestAsset_Clone(t *testing.T) {
 	assert.NotSame(t, a, got)
 	assert.Nil(t, (*Asset)(nil).Clone())
 }
+
+func TestAsset_ReplaceFile(t *testing.T) {
+	uid := accountdomain.NewUserID()
+	iid := NewIntegrationID()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	a := New().NewID().Project(NewProjectID()).CreatedByUser(uid).FileName("a.pdf").Size(10).UUID("uuid1").MustBuild()
+
+	assert.Empty(t, a.Versions())
+	assert.Equal(t, []string{"uuid1"}, a.UUIDs())
+	assert.Equal(t, "a.pdf", a.Version("uuid1").FileName())
+	assert.Equal(t, &uid, a.Version("uuid1").UploadedByUser())
+	assert.Nil(t, a.Version("uuid2"))
+
+	v := NewVersion().UUID("uuid2").FileName("b.pdf").Size(20).UploadedAt(now).UploadedByIntegration(&iid).Build()
+	a.ReplaceFile(v)
+
+	assert.Equal(t, "uuid2", a.UUID())
+	assert.Equal(t, "b.pdf", a.FileName())
+	assert.Equal(t, uint64(20), a.Size())
+	assert.Equal(t, []string{"uuid1", "uuid2"}, a.UUIDs())
+	assert.Len(t, a.Versions(), 2)
+	assert.Equal(t, "a.pdf", a.Versions()[0].FileName())
+	assert.Equal(t, a.CreatedAt(), a.Versions()[0].UploadedAt())
+	assert.Same(t, v, a.Version("uuid2"))
+	assert.Equal(t, &iid, a.Version("uuid2").UploadedByIntegration())
+
+	// restoring a file adds a new version with the same uuid
+	a.ReplaceFile(NewVersion().UUID("uuid1").FileName("a.pdf").Size(10).UploadedAt(now).UploadedByUser(&uid).Build())
+	assert.Equal(t, "uuid1", a.UUID())
+	assert.Len(t, a.Versions(), 3)
+	assert.Equal(t, []string{"uuid1", "uuid2"}, a.UUIDs())
+
+	a.ReplaceFile(nil)
+	assert.Len(t, a.Versions(), 3)
+}
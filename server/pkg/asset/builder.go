@@ -140,3 +140,18 @@ func (b *Builder) Public(public bool) *Builder {
 	b.a.public = public
 	return b
 }
+
+func (b *Builder) Folder(f *FolderID) *Builder {
+	b.a.folder = f
+	return b
+}
+
+func (b *Builder) Metadata(m Metadata) *Builder {
+	b.a.metadata = m
+	return b
+}
+
+func (b *Builder) Versions(v []*Version) *Builder {
+	b.a.versions = v
+	return b
+}
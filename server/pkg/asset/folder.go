@@ -0,0 +1,141 @@
+package asset
+
+import (
+	"strings"
+	"time"
+
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+)
+
+var (
+	ErrInvalidFolderName    = rerror.NewE(i18n.T("invalid folder name"))
+	ErrDuplicatedFolderName = rerror.NewE(i18n.T("a folder with the same name already exists"))
+	ErrInvalidFolderParent  = rerror.NewE(i18n.T("a folder can not be moved into itself or its sub folders"))
+	ErrFolderNotEmpty       = rerror.NewE(i18n.T("folder is not empty"))
+)
+
+// FolderPermission is the least role in the project which is required to change a folder and the assets in it.
+type FolderPermission string
+
+const (
+	FolderPermissionWriter     FolderPermission = "writer"
+	FolderPermissionMaintainer FolderPermission = "maintainer"
+	FolderPermissionOwner      FolderPermission = "owner"
+)
+
+func FolderPermissionFrom(s string) (FolderPermission, bool) {
+	switch p := FolderPermission(strings.ToLower(s)); p {
+	case FolderPermissionWriter, FolderPermissionMaintainer, FolderPermissionOwner:
+		return p, true
+	}
+	return "", false
+}
+
+func (p FolderPermission) String() string {
+	return string(p)
+}
+
+// Folder organizes the assets of a project into a hierarchy.
+type Folder struct {
+	id          FolderID
+	project     ProjectID
+	parent      *FolderID
+	name        string
+	permission  *FolderPermission
+	user        *accountdomain.UserID
+	integration *IntegrationID
+	updatedAt   time.Time
+}
+
+func (f *Folder) ID() FolderID {
+	return f.id
+}
+
+func (f *Folder) Project() ProjectID {
+	return f.project
+}
+
+// Parent returns the parent folder, or nil when the folder is at the root of the project.
+func (f *Folder) Parent() *FolderID {
+	return f.parent.CloneRef()
+}
+
+func (f *Folder) Name() string {
+	return f.name
+}
+
+// Permission returns the permission set to the folder, or nil when it is inherited from the parent.
+func (f *Folder) Permission() *FolderPermission {
+	return util.CloneRef(f.permission)
+}
+
+func (f *Folder) User() *accountdomain.UserID {
+	return f.user
+}
+
+func (f *Folder) Integration() *IntegrationID {
+	return f.integration
+}
+
+func (f *Folder) CreatedAt() time.Time {
+	return f.id.Timestamp()
+}
+
+func (f *Folder) UpdatedAt() time.Time {
+	if f.updatedAt.IsZero() {
+		return f.CreatedAt()
+	}
+	return f.updatedAt
+}
+
+func (f *Folder) Rename(name string) error {
+	name, err := validateFolderName(name)
+	if err != nil {
+		return err
+	}
+	f.name = name
+	f.updatedAt = util.Now()
+	return nil
+}
+
+// Move changes the parent of the folder. Cycles through sub folders are checked by FolderList.CanMove.
+func (f *Folder) Move(parent *FolderID) error {
+	if parent != nil && *parent == f.id {
+		return ErrInvalidFolderParent
+	}
+	f.parent = parent.CloneRef()
+	f.updatedAt = util.Now()
+	return nil
+}
+
+func (f *Folder) SetPermission(p *FolderPermission) {
+	f.permission = util.CloneRef(p)
+	f.updatedAt = util.Now()
+}
+
+func (f *Folder) Clone() *Folder {
+	if f == nil {
+		return nil
+	}
+	return &Folder{
+		id:          f.id.Clone(),
+		project:     f.project.Clone(),
+		parent:      f.parent.CloneRef(),
+		name:        f.name,
+		permission:  util.CloneRef(f.permission),
+		user:        f.user.CloneRef(),
+		integration: f.integration.CloneRef(),
+		updatedAt:   f.updatedAt,
+	}
+}
+
+func validateFolderName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || strings.Contains(name, FolderPathSeparator) {
+		return "", ErrInvalidFolderName
+	}
+	return name, nil
+}
@@ -0,0 +1,91 @@
+package asset
+
+import (
+	"time"
+
+	"github.com/reearth/reearthx/account/accountdomain"
+)
+
+type FolderBuilder struct {
+	f *Folder
+}
+
+func NewFolder() *FolderBuilder {
+	return &FolderBuilder{f: &Folder{}}
+}
+
+func (b *FolderBuilder) Build() (*Folder, error) {
+	if b.f.id.IsNil() {
+		return nil, ErrInvalidID
+	}
+	if b.f.project.IsNil() {
+		return nil, ErrNoProjectID
+	}
+	if b.f.user.IsNil() && b.f.integration.IsNil() {
+		return nil, ErrNoUser
+	}
+	if b.f.parent != nil && *b.f.parent == b.f.id {
+		return nil, ErrInvalidFolderParent
+	}
+	name, err := validateFolderName(b.f.name)
+	if err != nil {
+		return nil, err
+	}
+	b.f.name = name
+	return b.f, nil
+}
+
+func (b *FolderBuilder) MustBuild() *Folder {
+	r, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func (b *FolderBuilder) ID(id FolderID) *FolderBuilder {
+	b.f.id = id
+	return b
+}
+
+func (b *FolderBuilder) NewID() *FolderBuilder {
+	b.f.id = NewFolderID()
+	return b
+}
+
+func (b *FolderBuilder) Project(pid ProjectID) *FolderBuilder {
+	b.f.project = pid
+	return b
+}
+
+func (b *FolderBuilder) Parent(parent *FolderID) *FolderBuilder {
+	b.f.parent = parent.CloneRef()
+	return b
+}
+
+func (b *FolderBuilder) Name(name string) *FolderBuilder {
+	b.f.name = name
+	return b
+}
+
+func (b *FolderBuilder) Permission(p *FolderPermission) *FolderBuilder {
+	b.f.permission = p
+	return b
+}
+
+func (b *FolderBuilder) CreatedByUser(u accountdomain.UserID) *FolderBuilder {
+	b.f.user = &u
+	b.f.integration = nil
+	return b
+}
+
+func (b *FolderBuilder) CreatedByIntegration(i IntegrationID) *FolderBuilder {
+	b.f.integration = &i
+	b.f.user = nil
+	return b
+}
+
+func (b *FolderBuilder) UpdatedAt(t time.Time) *FolderBuilder {
+	b.f.updatedAt = t
+	return b
+}
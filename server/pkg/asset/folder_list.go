@@ -0,0 +1,106 @@
+package asset
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+const FolderPathSeparator = "/"
+
+type FolderList []*Folder
+
+func (l FolderList) IDs() FolderIDList {
+	return lo.Map(l, func(f *Folder, _ int) FolderID { return f.ID() })
+}
+
+func (l FolderList) SortByID() FolderList {
+	m := slices.Clone(l)
+	slices.SortFunc(m, func(a, b *Folder) int {
+		return a.ID().Compare(b.ID())
+	})
+	return m
+}
+
+func (l FolderList) Folder(fid FolderID) *Folder {
+	f, _ := lo.Find(l, func(f *Folder) bool { return f.ID() == fid })
+	return f
+}
+
+// Children returns the folders directly under the parent. A nil parent returns the folders at the root.
+func (l FolderList) Children(parent *FolderID) FolderList {
+	return lo.Filter(l, func(f *Folder, _ int) bool {
+		p := f.Parent()
+		if parent == nil || p == nil {
+			return parent == nil && p == nil
+		}
+		return *p == *parent
+	})
+}
+
+// Ancestors returns the folders from the root to the folder, including the folder itself.
+// It returns nil when the folder or one of its ancestors is not in the list.
+func (l FolderList) Ancestors(fid FolderID) FolderList {
+	m := lo.SliceToMap(l, func(f *Folder) (FolderID, *Folder) { return f.ID(), f })
+
+	var res FolderList
+	visited := map[FolderID]struct{}{}
+	for cur := &fid; cur != nil; {
+		f, ok := m[*cur]
+		if !ok {
+			return nil
+		}
+		if _, ok := visited[*cur]; ok {
+			// a broken hierarchy should not hang callers
+			return nil
+		}
+		visited[*cur] = struct{}{}
+		res = append(FolderList{f}, res...)
+		cur = f.Parent()
+	}
+	return res
+}
+
+// Path returns the names of the folders from the root joined by the separator, such as "a/b/c".
+func (l FolderList) Path(fid FolderID) string {
+	return strings.Join(lo.Map(l.Ancestors(fid), func(f *Folder, _ int) string { return f.Name() }), FolderPathSeparator)
+}
+
+// Descendants returns the ids of the folder and all of its sub folders.
+func (l FolderList) Descendants(fid FolderID) FolderIDList {
+	res := FolderIDList{fid}
+	for i := 0; i < len(res); i++ {
+		cur := res[i]
+		for _, c := range l.Children(&cur) {
+			if !res.Has(c.ID()) {
+				res = append(res, c.ID())
+			}
+		}
+	}
+	return res
+}
+
+// CanMove reports whether the folder can be moved under the parent without creating a cycle.
+func (l FolderList) CanMove(fid FolderID, parent *FolderID) bool {
+	return parent == nil || !l.Descendants(fid).Has(*parent)
+}
+
+// HasName reports whether a folder with the name exists under the parent, except the folder of the id.
+func (l FolderList) HasName(parent *FolderID, name string, except *FolderID) bool {
+	return lo.ContainsBy(l.Children(parent), func(f *Folder) bool {
+		return strings.EqualFold(f.Name(), strings.TrimSpace(name)) && (except == nil || f.ID() != *except)
+	})
+}
+
+// Permission returns the permission of the folder, which is inherited from the nearest ancestor that has one.
+// Folders without any permission in their path can be changed by writers.
+func (l FolderList) Permission(fid FolderID) FolderPermission {
+	anc := l.Ancestors(fid)
+	for i := len(anc) - 1; i >= 0; i-- {
+		if p := anc[i].Permission(); p != nil {
+			return *p
+		}
+	}
+	return FolderPermissionWriter
+}
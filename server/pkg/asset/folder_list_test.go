@@ -0,0 +1,47 @@
+package asset
+
+import (
+	"testing"
+
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFolderList(t *testing.T) {
+	pid := NewProjectID()
+	uid := accountdomain.NewUserID()
+	owner := FolderPermissionOwner
+	a := NewFolder().NewID().Project(pid).Name("a").CreatedByUser(uid).MustBuild()
+	b := NewFolder().NewID().Project(pid).Name("b").Parent(a.ID().Ref()).Permission(&owner).CreatedByUser(uid).MustBuild()
+	c := NewFolder().NewID().Project(pid).Name("c").Parent(b.ID().Ref()).CreatedByUser(uid).MustBuild()
+	d := NewFolder().NewID().Project(pid).Name("d").CreatedByUser(uid).MustBuild()
+	l := FolderList{a, b, c, d}
+
+	assert.Equal(t, FolderIDList{a.ID(), b.ID(), c.ID(), d.ID()}, l.IDs())
+	assert.Equal(t, b, l.Folder(b.ID()))
+	assert.Nil(t, l.Folder(NewFolderID()))
+
+	assert.Equal(t, FolderList{a, d}, l.Children(nil))
+	assert.Equal(t, FolderList{b}, l.Children(a.ID().Ref()))
+
+	assert.Equal(t, FolderList{a, b, c}, l.Ancestors(c.ID()))
+	assert.Nil(t, FolderList{b, c}.Ancestors(c.ID()))
+	assert.Equal(t, "a/b/c", l.Path(c.ID()))
+	assert.Equal(t, "d", l.Path(d.ID()))
+	assert.Equal(t, "", l.Path(NewFolderID()))
+
+	assert.Equal(t, FolderIDList{a.ID(), b.ID(), c.ID()}, l.Descendants(a.ID()))
+	assert.True(t, l.CanMove(a.ID(), d.ID().Ref()))
+	assert.True(t, l.CanMove(c.ID(), nil))
+	assert.False(t, l.CanMove(a.ID(), c.ID().Ref()))
+	assert.False(t, l.CanMove(a.ID(), a.ID().Ref()))
+
+	assert.True(t, l.HasName(nil, " A ", nil))
+	assert.False(t, l.HasName(nil, "a", a.ID().Ref()))
+	assert.False(t, l.HasName(a.ID().Ref(), "c", nil))
+
+	assert.Equal(t, FolderPermissionWriter, l.Permission(a.ID()))
+	assert.Equal(t, FolderPermissionOwner, l.Permission(b.ID()))
+	assert.Equal(t, FolderPermissionOwner, l.Permission(c.ID()))
+	assert.Equal(t, FolderPermissionWriter, l.Permission(d.ID()))
+}
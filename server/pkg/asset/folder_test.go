@@ -0,0 +1,75 @@
+package asset
+
+import (
+	"testing"
+
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFolderBuilder_Build(t *testing.T) {
+	pid := NewProjectID()
+	uid := accountdomain.NewUserID()
+	fid := NewFolderID()
+
+	f, err := NewFolder().ID(fid).Project(pid).Name(" images ").CreatedByUser(uid).Build()
+	assert.NoError(t, err)
+	assert.Equal(t, fid, f.ID())
+	assert.Equal(t, pid, f.Project())
+	assert.Equal(t, "images", f.Name())
+	assert.Equal(t, &uid, f.User())
+	assert.Nil(t, f.Parent())
+	assert.Nil(t, f.Permission())
+	assert.Equal(t, fid.Timestamp(), f.UpdatedAt())
+
+	_, err = NewFolder().Project(pid).Name("a").CreatedByUser(uid).Build()
+	assert.ErrorIs(t, err, ErrInvalidID)
+	_, err = NewFolder().NewID().Name("a").CreatedByUser(uid).Build()
+	assert.ErrorIs(t, err, ErrNoProjectID)
+	_, err = NewFolder().NewID().Project(pid).Name("a").Build()
+	assert.ErrorIs(t, err, ErrNoUser)
+	_, err = NewFolder().NewID().Project(pid).Name("a/b").CreatedByUser(uid).Build()
+	assert.ErrorIs(t, err, ErrInvalidFolderName)
+	_, err = NewFolder().ID(fid).Project(pid).Name("a").Parent(fid.Ref()).CreatedByUser(uid).Build()
+	assert.ErrorIs(t, err, ErrInvalidFolderParent)
+}
+
+func TestFolder_Rename(t *testing.T) {
+	f := NewFolder().NewID().Project(NewProjectID()).Name("a").CreatedByUser(accountdomain.NewUserID()).MustBuild()
+
+	assert.NoError(t, f.Rename("b"))
+	assert.Equal(t, "b", f.Name())
+	assert.ErrorIs(t, f.Rename(" "), ErrInvalidFolderName)
+	assert.Equal(t, "b", f.Name())
+}
+
+func TestFolder_Move(t *testing.T) {
+	f := NewFolder().NewID().Project(NewProjectID()).Name("a").CreatedByUser(accountdomain.NewUserID()).MustBuild()
+	parent := NewFolderID()
+
+	assert.NoError(t, f.Move(&parent))
+	assert.Equal(t, &parent, f.Parent())
+	assert.NoError(t, f.Move(nil))
+	assert.Nil(t, f.Parent())
+	assert.ErrorIs(t, f.Move(f.ID().Ref()), ErrInvalidFolderParent)
+}
+
+func TestFolder_Clone(t *testing.T) {
+	p := FolderPermissionMaintainer
+	f := NewFolder().NewID().Project(NewProjectID()).Name("a").Parent(NewFolderID().Ref()).
+		Permission(&p).CreatedByUser(accountdomain.NewUserID()).MustBuild()
+
+	got := f.Clone()
+	assert.Equal(t, f, got)
+	assert.NotSame(t, f, got)
+	assert.Nil(t, (*Folder)(nil).Clone())
+}
+
+func TestFolderPermissionFrom(t *testing.T) {
+	p, ok := FolderPermissionFrom("Maintainer")
+	assert.True(t, ok)
+	assert.Equal(t, FolderPermissionMaintainer, p)
+
+	_, ok = FolderPermissionFrom("reader")
+	assert.False(t, ok)
+}
@@ -11,26 +11,34 @@ type ProjectID = id.ProjectID
 type UserID = accountdomain.UserID
 type ThreadID = id.ThreadID
 type IntegrationID = id.IntegrationID
+type FolderID = id.AssetFolderID
+type FolderIDList = id.AssetFolderIDList
+type FieldID = id.FieldID
+type FieldIDList = id.FieldIDList
 
 var NewID = id.NewAssetID
 var NewProjectID = id.NewProjectID
 var NewUserID = accountdomain.NewUserID
 var NewThreadID = id.NewThreadID
 var NewIntegrationID = id.NewIntegrationID
+var NewFolderID = id.NewAssetFolderID
 
 var MustID = id.MustAssetID
 var MustProjectID = id.MustProjectID
 var MustUserID = id.MustUserID
 var MustThreadID = id.MustThreadID
+var MustFolderID = id.MustAssetFolderID
 
 var IDFrom = id.AssetIDFrom
 var ProjectIDFrom = id.ProjectIDFrom
 var UserIDFrom = accountdomain.UserIDFrom
 var ThreadIDFrom = id.ThreadIDFrom
+var FolderIDFrom = id.AssetFolderIDFrom
 
 var IDFromRef = id.AssetIDFromRef
 var ProjectIDFromRef = id.ProjectIDFromRef
 var UserIDFromRef = accountdomain.UserIDFromRef
 var ThreadIDFromRef = id.ThreadIDFromRef
+var FolderIDFromRef = id.AssetFolderIDFromRef
 
 var ErrInvalidID = id.ErrInvalidID
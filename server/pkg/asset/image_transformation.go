@@ -0,0 +1,224 @@
+package asset
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+)
+
+// MaxImageSize is the max width and height of a transformed image.
+const MaxImageSize = 4096
+
+const (
+	ImageTransformationWidthKey     = "w"
+	ImageTransformationHeightKey    = "h"
+	ImageTransformationFitKey       = "fit"
+	ImageTransformationFormatKey    = "f"
+	ImageTransformationQualityKey   = "q"
+	ImageTransformationSignatureKey = "s"
+)
+
+var ErrInvalidImageTransformation = rerror.NewE(i18n.T("invalid image transformation"))
+
+type ImageFormat string
+
+const (
+	ImageFormatWebP ImageFormat = "webp"
+	ImageFormatPNG  ImageFormat = "png"
+	ImageFormatJPEG ImageFormat = "jpeg"
+)
+
+func ImageFormatFrom(s string) (ImageFormat, bool) {
+	switch ImageFormat(strings.ToLower(s)) {
+	case ImageFormatWebP:
+		return ImageFormatWebP, true
+	case ImageFormatPNG:
+		return ImageFormatPNG, true
+	case ImageFormatJPEG, "jpg":
+		return ImageFormatJPEG, true
+	}
+	return "", false
+}
+
+// imageFormatFromFileName returns the format which the file is encoded in, or PNG when it is unknown.
+func imageFormatFromFileName(name string) ImageFormat {
+	if f, ok := ImageFormatFrom(strings.TrimPrefix(path.Ext(name), ".")); ok {
+		return f
+	}
+	return ImageFormatPNG
+}
+
+func (f ImageFormat) ContentType() string {
+	return "image/" + string(f)
+}
+
+func (f ImageFormat) Ext() string {
+	if f == ImageFormatJPEG {
+		return ".jpg"
+	}
+	return "." + string(f)
+}
+
+type ImageFit string
+
+const (
+	// ImageFitContain resizes the image to fit in the box keeping its aspect ratio.
+	ImageFitContain ImageFit = "contain"
+	// ImageFitCover resizes the image to cover the box keeping its aspect ratio and crops the overflowing area.
+	ImageFitCover ImageFit = "cover"
+)
+
+// ImageTransformation describes how to transform an image asset. A zero width or height is calculated from the aspect ratio of the image.
+type ImageTransformation struct {
+	Width   int
+	Height  int
+	Fit     ImageFit
+	Format  ImageFormat
+	Quality int
+}
+
+// ParseImageTransformation parses the query of a URL. It returns nil when the query does not contain any transformation.
+func ParseImageTransformation(q url.Values) (*ImageTransformation, error) {
+	if !q.Has(ImageTransformationWidthKey) && !q.Has(ImageTransformationHeightKey) && !q.Has(ImageTransformationFitKey) &&
+		!q.Has(ImageTransformationFormatKey) && !q.Has(ImageTransformationQualityKey) {
+		return nil, nil
+	}
+
+	t := &ImageTransformation{}
+	var err error
+	if t.Width, err = parseImageTransformationInt(q, ImageTransformationWidthKey, MaxImageSize); err != nil {
+		return nil, err
+	}
+	if t.Height, err = parseImageTransformationInt(q, ImageTransformationHeightKey, MaxImageSize); err != nil {
+		return nil, err
+	}
+	if t.Quality, err = parseImageTransformationInt(q, ImageTransformationQualityKey, 100); err != nil {
+		return nil, err
+	}
+	if v := q.Get(ImageTransformationFormatKey); v != "" {
+		f, ok := ImageFormatFrom(v)
+		if !ok {
+			return nil, ErrInvalidImageTransformation
+		}
+		t.Format = f
+	}
+	switch ImageFit(q.Get(ImageTransformationFitKey)) {
+	case "", ImageFitContain:
+	case ImageFitCover:
+		t.Fit = ImageFitCover
+	default:
+		return nil, ErrInvalidImageTransformation
+	}
+	if t.Fit == ImageFitCover && (t.Width == 0 || t.Height == 0) {
+		return nil, ErrInvalidImageTransformation
+	}
+	return t, nil
+}
+
+func parseImageTransformationInt(q url.Values, key string, max int) (int, error) {
+	v := q.Get(key)
+	if v == "" {
+		return 0, nil
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil || i <= 0 || i > max {
+		return 0, ErrInvalidImageTransformation
+	}
+	return i, nil
+}
+
+// WithDefaults returns the transformation whose format is the same as the original file when it is not specified.
+func (t ImageTransformation) WithDefaults(fileName string) ImageTransformation {
+	if t.Format == "" {
+		t.Format = imageFormatFromFileName(fileName)
+	}
+	if t.Fit == "" {
+		t.Fit = ImageFitContain
+	}
+	return t
+}
+
+// Query returns the canonical query of the transformation, which does not contain the default values.
+func (t ImageTransformation) Query() url.Values {
+	q := url.Values{}
+	if t.Width > 0 {
+		q.Set(ImageTransformationWidthKey, strconv.Itoa(t.Width))
+	}
+	if t.Height > 0 {
+		q.Set(ImageTransformationHeightKey, strconv.Itoa(t.Height))
+	}
+	if t.Fit != "" && t.Fit != ImageFitContain {
+		q.Set(ImageTransformationFitKey, string(t.Fit))
+	}
+	if t.Format != "" {
+		q.Set(ImageTransformationFormatKey, string(t.Format))
+	}
+	if t.Quality > 0 {
+		q.Set(ImageTransformationQualityKey, strconv.Itoa(t.Quality))
+	}
+	return q
+}
+
+func (t ImageTransformation) String() string {
+	return t.Query().Encode()
+}
+
+// Sign returns the signature of the transformation of the file which has the UUID.
+func (t ImageTransformation) Sign(secret, uuid string) string {
+	m := hmac.New(sha256.New, []byte(secret))
+	_, _ = m.Write([]byte(uuid + "?" + t.String()))
+	return hex.EncodeToString(m.Sum(nil))
+}
+
+func (t ImageTransformation) Verify(secret, uuid, sig string) bool {
+	if secret == "" || sig == "" {
+		return false
+	}
+	return hmac.Equal([]byte(t.Sign(secret, uuid)), []byte(sig))
+}
+
+// CachePath returns the path in the storage where the transformed image of the file which has the UUID is cached.
+func (t ImageTransformation) CachePath(uuid, fileName string) string {
+	t = t.WithDefaults(fileName)
+	h := sha256.Sum256([]byte(t.String()))
+	return path.Join("transformed", uuid[:2], uuid[2:], hex.EncodeToString(h[:8])+t.Format.Ext())
+}
+
+// ImageTransformationPolicy limits the transformations which can be requested to prevent abuse.
+// A transformation is allowed when it has a valid signature, or its size and quality are in the whitelists.
+type ImageTransformationPolicy struct {
+	Secret    string
+	Sizes     []int
+	Qualities []int
+}
+
+func (p ImageTransformationPolicy) Allow(t ImageTransformation, uuid, sig string) bool {
+	if t.Verify(p.Secret, uuid, sig) {
+		return true
+	}
+	return (t.Width == 0 || slices.Contains(p.Sizes, t.Width)) &&
+		(t.Height == 0 || slices.Contains(p.Sizes, t.Height)) &&
+		(t.Quality == 0 || slices.Contains(p.Qualities, t.Quality))
+}
+
+// URL returns the URL of the transformed image, which is signed when the policy has a secret.
+func (p ImageTransformationPolicy) URL(base string, uuid string, t ImageTransformation) string {
+	u, err := url.Parse(base)
+	if err != nil {
+		return ""
+	}
+	q := t.Query()
+	if p.Secret != "" {
+		q.Set(ImageTransformationSignatureKey, t.Sign(p.Secret, uuid))
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
@@ -0,0 +1,111 @@
+package asset
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImageTransformation(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    *ImageTransformation
+		wantErr error
+	}{
+		{
+			name:  "no transformation",
+			query: "foo=bar",
+			want:  nil,
+		},
+		{
+			name:  "resize",
+			query: "w=100&f=jpg&q=80",
+			want:  &ImageTransformation{Width: 100, Format: ImageFormatJPEG, Quality: 80},
+		},
+		{
+			name:  "crop",
+			query: "w=100&h=50&fit=cover&f=webp",
+			want:  &ImageTransformation{Width: 100, Height: 50, Fit: ImageFitCover, Format: ImageFormatWebP},
+		},
+		{
+			name:    "crop without height",
+			query:   "w=100&fit=cover",
+			wantErr: ErrInvalidImageTransformation,
+		},
+		{
+			name:    "too large",
+			query:   "w=10000",
+			wantErr: ErrInvalidImageTransformation,
+		},
+		{
+			name:    "invalid quality",
+			query:   "q=0",
+			wantErr: ErrInvalidImageTransformation,
+		},
+		{
+			name:    "invalid format",
+			query:   "f=bmp",
+			wantErr: ErrInvalidImageTransformation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			q, _ := url.ParseQuery(tt.query)
+			got, err := ParseImageTransformation(q)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestImageTransformation_String(t *testing.T) {
+	assert.Equal(t, "f=png&h=20&w=10", ImageTransformation{Width: 10, Height: 20, Fit: ImageFitContain, Format: ImageFormatPNG}.String())
+	assert.Equal(t, "fit=cover&h=20&q=80&w=10", ImageTransformation{Width: 10, Height: 20, Fit: ImageFitCover, Quality: 80}.String())
+}
+
+func TestImageTransformation_WithDefaults(t *testing.T) {
+	assert.Equal(t, ImageTransformation{Width: 10, Fit: ImageFitContain, Format: ImageFormatJPEG}, ImageTransformation{Width: 10}.WithDefaults("a.JPG"))
+	assert.Equal(t, ImageTransformation{Width: 10, Fit: ImageFitContain, Format: ImageFormatPNG}, ImageTransformation{Width: 10}.WithDefaults("a.gif"))
+	assert.Equal(t, ImageTransformation{Width: 10, Fit: ImageFitContain, Format: ImageFormatWebP}, ImageTransformation{Width: 10, Format: ImageFormatWebP}.WithDefaults("a.png"))
+}
+
+func TestImageTransformation_Sign(t *testing.T) {
+	tr := ImageTransformation{Width: 10}
+	sig := tr.Sign("secret", "uuid")
+
+	assert.True(t, tr.Verify("secret", "uuid", sig))
+	assert.False(t, tr.Verify("secret", "uuid2", sig))
+	assert.False(t, tr.Verify("secret2", "uuid", sig))
+	assert.False(t, ImageTransformation{Width: 20}.Verify("secret", "uuid", sig))
+	assert.False(t, tr.Verify("", "uuid", tr.Sign("", "uuid")))
+}
+
+func TestImageTransformation_CachePath(t *testing.T) {
+	tr := ImageTransformation{Width: 10}
+	p := tr.CachePath("0123456789", "a.png")
+
+	assert.Regexp(t, `^transformed/01/23456789/[0-9a-f]{16}\.png$`, p)
+	assert.Equal(t, p, ImageTransformation{Width: 10, Format: ImageFormatPNG, Fit: ImageFitContain}.CachePath("0123456789", "b.png"))
+	assert.NotEqual(t, p, ImageTransformation{Width: 20}.CachePath("0123456789", "a.png"))
+}
+
+func TestImageTransformationPolicy(t *testing.T) {
+	p := ImageTransformationPolicy{Secret: "secret", Sizes: []int{100, 200}, Qualities: []int{80}}
+	tr := ImageTransformation{Width: 150}
+
+	assert.True(t, p.Allow(ImageTransformation{Width: 100, Height: 200, Quality: 80}, "uuid", ""))
+	assert.False(t, p.Allow(tr, "uuid", ""))
+	assert.False(t, p.Allow(ImageTransformation{Width: 100, Quality: 50}, "uuid", ""))
+	assert.True(t, p.Allow(tr, "uuid", tr.Sign("secret", "uuid")))
+
+	assert.Equal(t, "https://example.com/assets/a.png?s="+tr.Sign("secret", "uuid")+"&w=150", p.URL("https://example.com/assets/a.png", "uuid", tr))
+	assert.Equal(t, "https://example.com/assets/a.png?w=150", ImageTransformationPolicy{}.URL("https://example.com/assets/a.png", "uuid", tr))
+}
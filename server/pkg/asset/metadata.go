@@ -0,0 +1,113 @@
+package asset
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+)
+
+// MetadataField is a value of a custom metadata field defined by the asset metadata schema of the project.
+type MetadataField struct {
+	field FieldID
+	value *value.Multiple
+}
+
+func NewMetadataField(field FieldID, v *value.Multiple) *MetadataField {
+	if v == nil {
+		return nil
+	}
+	return &MetadataField{
+		field: field,
+		value: v,
+	}
+}
+
+func (f *MetadataField) FieldID() FieldID {
+	return f.field
+}
+
+func (f *MetadataField) Type() value.Type {
+	return f.value.Type()
+}
+
+func (f *MetadataField) Value() *value.Multiple {
+	if f == nil {
+		return nil
+	}
+	return f.value
+}
+
+func (f *MetadataField) Clone() *MetadataField {
+	if f == nil {
+		return nil
+	}
+	return &MetadataField{
+		field: f.field,
+		value: f.value.Clone(),
+	}
+}
+
+type Metadata []*MetadataField
+
+func (m Metadata) Field(fid FieldID) *MetadataField {
+	f, _ := lo.Find(m, func(f *MetadataField) bool {
+		return f != nil && f.field == fid
+	})
+	return f
+}
+
+func (m Metadata) FieldIDs() FieldIDList {
+	return lo.Map(m, func(f *MetadataField, _ int) FieldID {
+		return f.field
+	})
+}
+
+// Merge returns the metadata with the fields of the other metadata added or replaced.
+// Fields with an empty value are removed.
+func (m Metadata) Merge(other Metadata) Metadata {
+	res := m.Clone()
+	for _, f := range other {
+		if f == nil {
+			continue
+		}
+		res = lo.Filter(res, func(g *MetadataField, _ int) bool {
+			return g.field != f.field
+		})
+		if !f.value.IsEmpty() {
+			res = append(res, f.Clone())
+		}
+	}
+	return res
+}
+
+// Map returns the values keyed by the field keys of the schema. Fields which are not in the schema are omitted.
+func (m Metadata) Map(s *schema.Schema) map[string]any {
+	if len(m) == 0 || s == nil {
+		return nil
+	}
+	res := map[string]any{}
+	for _, f := range m {
+		sf := s.Field(f.field)
+		if sf == nil || f.value.IsEmpty() {
+			continue
+		}
+		if sf.Multiple() {
+			res[sf.Key().String()] = f.value.Interface()
+		} else {
+			res[sf.Key().String()] = f.value.First().Interface()
+		}
+	}
+	if len(res) == 0 {
+		return nil
+	}
+	return res
+}
+
+func (m Metadata) Clone() Metadata {
+	if m == nil {
+		return nil
+	}
+	return lo.Map(m, func(f *MetadataField, _ int) *MetadataField {
+		return f.Clone()
+	})
+}
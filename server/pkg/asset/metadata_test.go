@@ -0,0 +1,67 @@
+package asset
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMetadataField(t *testing.T) {
+	fid := id.NewFieldID()
+	v := value.NewMultiple(value.TypeText, []any{"alt"})
+
+	f := NewMetadataField(fid, v)
+	assert.Equal(t, fid, f.FieldID())
+	assert.Equal(t, value.TypeText, f.Type())
+	assert.Equal(t, v, f.Value())
+	assert.Nil(t, NewMetadataField(fid, nil))
+}
+
+func TestMetadata_Field(t *testing.T) {
+	f1 := NewMetadataField(id.NewFieldID(), value.NewMultiple(value.TypeText, []any{"a"}))
+	f2 := NewMetadataField(id.NewFieldID(), value.NewMultiple(value.TypeBool, []any{true}))
+	m := Metadata{f1, f2}
+
+	assert.Equal(t, f2, m.Field(f2.FieldID()))
+	assert.Nil(t, m.Field(id.NewFieldID()))
+	assert.Equal(t, FieldIDList{f1.FieldID(), f2.FieldID()}, m.FieldIDs())
+}
+
+func TestMetadata_Merge(t *testing.T) {
+	fid1, fid2, fid3 := id.NewFieldID(), id.NewFieldID(), id.NewFieldID()
+	m := Metadata{
+		NewMetadataField(fid1, value.NewMultiple(value.TypeText, []any{"a"})),
+		NewMetadataField(fid2, value.NewMultiple(value.TypeText, []any{"b"})),
+	}
+
+	got := m.Merge(Metadata{
+		NewMetadataField(fid1, value.NewMultiple(value.TypeText, nil)),
+		NewMetadataField(fid2, value.NewMultiple(value.TypeText, []any{"c"})),
+		NewMetadataField(fid3, value.NewMultiple(value.TypeInteger, []any{1})),
+	})
+
+	assert.Equal(t, FieldIDList{fid2, fid3}, got.FieldIDs())
+	assert.Equal(t, "c", got.Field(fid2).Value().First().Interface())
+	// the original metadata is not changed
+	assert.Equal(t, "b", m.Field(fid2).Value().First().Interface())
+}
+
+func TestMetadata_Map(t *testing.T) {
+	sf1 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("alt")).MustBuild()
+	sf2 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("tags")).Multiple(true).MustBuild()
+	s := schema.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).Fields(schema.FieldList{sf1, sf2}).MustBuild()
+
+	m := Metadata{
+		NewMetadataField(sf1.ID(), value.NewMultiple(value.TypeText, []any{"a cat"})),
+		NewMetadataField(sf2.ID(), value.NewMultiple(value.TypeText, []any{"x", "y"})),
+		NewMetadataField(id.NewFieldID(), value.NewMultiple(value.TypeText, []any{"removed"})),
+	}
+
+	assert.Equal(t, map[string]any{"alt": "a cat", "tags": []any{"x", "y"}}, m.Map(s))
+	assert.Nil(t, Metadata{}.Map(s))
+	assert.Nil(t, m.Map(nil))
+}
@@ -0,0 +1,144 @@
+package asset
+
+import (
+	"time"
+
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+var ErrCurrentVersion = rerror.NewE(i18n.T("the version is the current file of the asset"))
+
+// Version is a file which an asset has had. The files replaced by a new one are kept in the storage so that they can be restored.
+type Version struct {
+	uuid            string
+	fileName        string
+	size            uint64
+	contentType     string
+	contentEncoding string
+	previewType     *PreviewType
+	uploadedAt      time.Time
+	user            *accountdomain.UserID
+	integration     *IntegrationID
+}
+
+func (v *Version) UUID() string {
+	return v.uuid
+}
+
+func (v *Version) FileName() string {
+	return v.fileName
+}
+
+func (v *Version) Size() uint64 {
+	return v.size
+}
+
+func (v *Version) ContentType() string {
+	return v.contentType
+}
+
+func (v *Version) ContentEncoding() string {
+	return v.contentEncoding
+}
+
+func (v *Version) PreviewType() *PreviewType {
+	return v.previewType
+}
+
+func (v *Version) UploadedAt() time.Time {
+	return v.uploadedAt
+}
+
+func (v *Version) UploadedByUser() *accountdomain.UserID {
+	return v.user
+}
+
+func (v *Version) UploadedByIntegration() *IntegrationID {
+	return v.integration
+}
+
+func (v *Version) Clone() *Version {
+	if v == nil {
+		return nil
+	}
+	return &Version{
+		uuid:            v.uuid,
+		fileName:        v.fileName,
+		size:            v.size,
+		contentType:     v.contentType,
+		contentEncoding: v.contentEncoding,
+		previewType:     v.previewType,
+		uploadedAt:      v.uploadedAt,
+		user:            v.user.CloneRef(),
+		integration:     v.integration.CloneRef(),
+	}
+}
+
+func cloneVersions(versions []*Version) []*Version {
+	if versions == nil {
+		return nil
+	}
+	return lo.Map(versions, func(v *Version, _ int) *Version { return v.Clone() })
+}
+
+type VersionBuilder struct {
+	v *Version
+}
+
+func NewVersion() *VersionBuilder {
+	return &VersionBuilder{v: &Version{}}
+}
+
+func (b *VersionBuilder) Build() *Version {
+	return b.v
+}
+
+func (b *VersionBuilder) UUID(uuid string) *VersionBuilder {
+	b.v.uuid = uuid
+	return b
+}
+
+func (b *VersionBuilder) FileName(name string) *VersionBuilder {
+	b.v.fileName = name
+	return b
+}
+
+func (b *VersionBuilder) Size(size uint64) *VersionBuilder {
+	b.v.size = size
+	return b
+}
+
+func (b *VersionBuilder) ContentType(contentType string) *VersionBuilder {
+	b.v.contentType = contentType
+	return b
+}
+
+func (b *VersionBuilder) ContentEncoding(contentEncoding string) *VersionBuilder {
+	b.v.contentEncoding = contentEncoding
+	return b
+}
+
+func (b *VersionBuilder) PreviewType(t *PreviewType) *VersionBuilder {
+	b.v.previewType = t
+	return b
+}
+
+func (b *VersionBuilder) UploadedAt(t time.Time) *VersionBuilder {
+	b.v.uploadedAt = t
+	return b
+}
+
+func (b *VersionBuilder) UploadedByUser(u *accountdomain.UserID) *VersionBuilder {
+	b.v.user = u
+	b.v.integration = nil
+	return b
+}
+
+func (b *VersionBuilder) UploadedByIntegration(i *IntegrationID) *VersionBuilder {
+	b.v.integration = i
+	b.v.user = nil
+	return b
+}
@@ -15,9 +15,23 @@ const (
 	ItemPublish      = "item.publish"
 	ItemUnpublish    = "item.unpublish"
 	AssetCreate      = "asset.create"
+	AssetUpdate      = "asset.update"
 	AssetDecompress  = "asset.decompress"
 	AssetDelete      = "asset.delete"
 	AssetBatchDelete = "asset.batchdelete"
+	ModelCreate      = "model.create"
+	ModelUpdate      = "model.update"
+	ModelDelete      = "model.delete"
+	FieldCreate      = "field.create"
+	FieldUpdate      = "field.update"
+	FieldDelete      = "field.delete"
+	RequestCreate    = "request.create"
+	RequestUpdate    = "request.update"
+	RequestApprove   = "request.approve"
+	RequestClose     = "request.close"
+	CommentCreate    = "comment.create"
+	CommentUpdate    = "comment.update"
+	CommentDelete    = "comment.delete"
 )
 
 type Event[T any] struct {
@@ -17,6 +17,7 @@ type CSVExporter struct {
 	writer        io.Writer
 	csvWriter     *csv.Writer
 	schemaPackage *schema.Package
+	locales       []string
 }
 
 // NewCSVExporter creates a new CSV exporter
@@ -48,7 +49,7 @@ func (e *CSVExporter) Export(ctx context.Context, req *ExportRequest, il item.Li
 	}
 
 	// Write data rows
-	lo.ForEach(il, func(itm *item.Item, _ int) {
+	lo.ForEach(il.Localize(req.Options.Locales), func(itm *item.Item, _ int) {
 		row, ok := RowFromItem(itm, sp)
 		if !ok {
 			return
@@ -66,6 +67,7 @@ func (e *CSVExporter) StartExport(ctx context.Context, req *ExportRequest) error
 	e.isStreaming = true
 	e.csvWriter = csv.NewWriter(e.writer)
 	e.schemaPackage = &req.Schema
+	e.locales = req.Options.Locales
 
 	// Write headers
 	if err := e.csvWriter.Write(BuildCSVHeaders(e.schemaPackage)); err != nil {
@@ -82,7 +84,7 @@ func (e *CSVExporter) ProcessBatch(ctx context.Context, il item.List, assets ass
 	}
 
 	// Write data rows
-	lo.ForEach(il, func(itm *item.Item, _ int) {
+	lo.ForEach(il.Localize(e.locales), func(itm *item.Item, _ int) {
 		row, ok := RowFromItem(itm, e.schemaPackage)
 		if !ok {
 			return
@@ -109,6 +111,7 @@ func (e *CSVExporter) EndExport(ctx context.Context, extra map[string]any) error
 	e.isStreaming = false
 	e.csvWriter = nil
 	e.schemaPackage = nil
+	e.locales = nil
 
 	return err
 }
@@ -1,6 +1,8 @@
 package exporters
 
 import (
+	"bytes"
+	"context"
 	"net/url"
 	"testing"
 	"time"
@@ -97,6 +99,46 @@ func TestRowFromItem(t *testing.T) {
 	assert.Equal(t, []string{i1.ID().String(), "30", "true"}, row3)
 }
 
+func TestCSVExporter_Export_Locales(t *testing.T) {
+	iid := id.NewItemID()
+	sid := id.NewSchemaID()
+	pid := id.NewProjectID()
+	sf := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Name("name").Key(id.NewKey("name")).Localizable(true).MustBuild()
+	s := schema.New().ID(sid).Fields([]*schema.Field{sf}).Workspace(accountdomain.NewWorkspaceID()).Project(pid).MustBuild()
+	f := item.NewField(sf.ID(), value.TypeText.Value("東京").AsMultiple(), nil)
+	f.SetLocalizedValue("en", value.TypeText.Value("Tokyo").AsMultiple())
+	i := item.New().
+		ID(iid).
+		Schema(sid).
+		Project(pid).
+		Fields([]*item.Field{f}).
+		Model(id.NewModelID()).
+		Thread(id.NewThreadID().Ref()).
+		Anonymous(true).
+		MustBuild()
+
+	req := &ExportRequest{
+		Format: FormatCSV,
+		Schema: *schema.NewPackage(s, nil, nil, nil),
+	}
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, NewCSVExporter(buf).Export(context.Background(), req, item.List{i}, nil))
+	assert.Equal(t, "id,name\n"+iid.String()+",東京\n", buf.String())
+
+	req.Options.Locales = []string{"en", "ja"}
+	buf.Reset()
+	assert.NoError(t, NewCSVExporter(buf).Export(context.Background(), req, item.List{i}, nil))
+	assert.Equal(t, "id,name\n"+iid.String()+",Tokyo\n", buf.String())
+
+	buf.Reset()
+	e := NewCSVExporter(buf)
+	assert.NoError(t, e.StartExport(context.Background(), req))
+	assert.NoError(t, e.ProcessBatch(context.Background(), item.List{i}, nil))
+	assert.NoError(t, e.EndExport(context.Background(), nil))
+	assert.Equal(t, "id,name\n"+iid.String()+",Tokyo\n", buf.String())
+}
+
 func TestToCSVProp(t *testing.T) {
 	sf1 := schema.NewField(schema.NewText(new(10)).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
 	if1 := item.NewField(sf1.ID(), value.TypeText.Value("test").AsMultiple(), nil)
@@ -17,6 +17,7 @@ type GeoJSONExporter struct {
 	writer       io.Writer
 	schema       *schema.Package
 	geo          schema.FieldID
+	locales      []string
 }
 
 // NewGeoJSONExporter creates a new GeoJSON exporter
@@ -56,7 +57,7 @@ func (e *GeoJSONExporter) Export(ctx context.Context, req *ExportRequest, il ite
 	if err := e.ValidateRequest(req); err != nil {
 		return err
 	}
-	fc, err := FeatureCollectionFromItems(il, &req.Schema, *req.Options.GeometryField, al)
+	fc, err := FeatureCollectionFromItems(il.Localize(req.Options.Locales), &req.Schema, *req.Options.GeometryField, al)
 	if err != nil {
 		return err
 	}
@@ -73,6 +74,7 @@ func (e *GeoJSONExporter) StartExport(ctx context.Context, req *ExportRequest) e
 	e.featureCount = 0
 	e.schema = &req.Schema
 	e.geo = *req.Options.GeometryField
+	e.locales = req.Options.Locales
 
 	// Write opening GeoJSON FeatureCollection structure
 	_, err := e.writer.Write([]byte(`{"type":"FeatureCollection","features":[`))
@@ -85,7 +87,7 @@ func (e *GeoJSONExporter) ProcessBatch(ctx context.Context, items item.List, ass
 		return ErrInvalidRequest
 	}
 
-	for _, itm := range items {
+	for _, itm := range items.Localize(e.locales) {
 		feature, ok := featureFromItem(itm, e.schema, e.geo, assets)
 		if !ok {
 			continue
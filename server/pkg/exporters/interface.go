@@ -6,6 +6,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/mvt"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearthx/usecasex"
 )
@@ -16,6 +17,7 @@ const (
 	FormatJSON    ExportFormat = "json"
 	FormatGeoJSON ExportFormat = "geojson"
 	FormatCSV     ExportFormat = "csv"
+	FormatMVT     ExportFormat = "mvt"
 )
 
 type ItemLoader func(list id.ItemIDList) (item.List, error)
@@ -39,6 +41,17 @@ type ExportOptions struct {
 	GeometryField    *schema.FieldID
 	Pagination       *usecasex.Pagination
 	IncludeRefModels id.ModelIDList
+	// Locales is the fallback chain used to resolve values of localizable fields
+	Locales []string
+	// Fields limits the exported fields of the model schema; all fields are exported when empty
+	Fields id.FieldIDList
+	// Tile is the vector tile exported by the MVT format
+	Tile *TileOptions
+}
+
+type TileOptions struct {
+	ID    mvt.TileID
+	Layer string
 }
 
 // Exporter defines the interface for all export implementations
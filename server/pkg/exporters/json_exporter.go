@@ -46,7 +46,7 @@ func (e *JSONExporter) ValidateRequest(req *ExportRequest) error {
 func (e *JSONExporter) Export(ctx context.Context, req *ExportRequest, il item.List, al asset.List) error {
 	encoder := json.NewEncoder(e.writer)
 	encoder.SetIndent("", "  ")
-	return e.exportItems(encoder, il, &req.Schema, al)
+	return e.exportItems(encoder, il.Localize(req.Options.Locales), &req.Schema, al)
 }
 
 // StartExport initializes the streaming export
@@ -66,7 +66,7 @@ func (e *JSONExporter) ProcessBatch(ctx context.Context, items item.List, assets
 		return ErrInvalidRequest
 	}
 
-	for _, itm := range items {
+	for _, itm := range items.Localize(e.request.Options.Locales) {
 		// Add comma if not the first item
 		if e.itemCount > 0 {
 			if _, err := e.writer.Write([]byte(",")); err != nil {
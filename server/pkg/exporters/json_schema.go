@@ -87,21 +87,25 @@ func buildPropertiesMap(f schema.FieldList, gsMap map[id.GroupID]*schema.Schema)
 				if maxLength := f.MaxLength(); maxLength != nil {
 					fieldSchema.MaxLength = maxLength
 				}
+				setTextValidation(&fieldSchema, f.Validation())
 			},
 			TextArea: func(f *schema.FieldTextArea) {
 				if maxLength := f.MaxLength(); maxLength != nil {
 					fieldSchema.MaxLength = maxLength
 				}
+				setTextValidation(&fieldSchema, f.Validation())
 			},
 			RichText: func(f *schema.FieldRichText) {
 				if maxLength := f.MaxLength(); maxLength != nil {
 					fieldSchema.MaxLength = maxLength
 				}
+				setTextValidation(&fieldSchema, f.Validation())
 			},
 			Markdown: func(f *schema.FieldMarkdown) {
 				if maxLength := f.MaxLength(); maxLength != nil {
 					fieldSchema.MaxLength = maxLength
 				}
+				setTextValidation(&fieldSchema, f.Validation())
 			},
 			Select: func(f *schema.FieldSelect) {
 				fieldSchema.Options = new(f.Values())
@@ -147,6 +151,31 @@ func buildPropertiesMap(f schema.FieldList, gsMap map[id.GroupID]*schema.Schema)
 	return properties
 }
 
+func setTextValidation(fs *types.JSONSchema, v *schema.TextValidation) {
+	if v.IsEmpty() {
+		return
+	}
+	fs.MinLength = v.MinLength()
+	if p := v.Pattern(); p != "" {
+		fs.Pattern = new(p)
+		if m := v.PatternMessage(); m != "" {
+			fs.PatternMessage = new(m)
+		}
+	}
+	if w := v.ForbiddenWords(); len(w) > 0 {
+		fs.ForbiddenWords = new(w)
+	}
+	if p := v.Preset(); p != "" {
+		fs.Preset = new(p.String())
+		if p == schema.TextPresetEmail {
+			fs.Format = new("email")
+		} else if fs.Pattern == nil {
+			// JSON Schema has only one pattern, so the custom one takes precedence
+			fs.Pattern = new(p.Pattern())
+		}
+	}
+}
+
 func buildItems(f schema.FieldList) *types.JSONSchema {
 	return &types.JSONSchema{
 		Type:       "object",
@@ -189,6 +189,15 @@ func TestBuildPropertiesExtended(t *testing.T) {
 	t.Parallel()
 
 	intTypeProperty, _ := schema.NewInteger(nil, nil)
+	textValidation, _ := schema.NewTextValidation(new(2), "^[a-z]+$", "lowercase only", []string{"spam"}, "")
+	validatedText := schema.NewText(new(10))
+	_ = validatedText.SetValidation(textValidation)
+	emailValidation, _ := schema.NewTextValidation(nil, "", "", nil, schema.TextPresetEmail)
+	emailText := schema.NewText(nil)
+	_ = emailText.SetValidation(emailValidation)
+	phoneValidation, _ := schema.NewTextValidation(nil, "", "", nil, schema.TextPresetPhone)
+	phoneTextArea := schema.NewTextArea(nil)
+	_ = phoneTextArea.SetValidation(phoneValidation)
 
 	tests := []struct {
 		name     string
@@ -258,6 +267,42 @@ func TestBuildPropertiesExtended(t *testing.T) {
 				DefaultValue: "default text",
 			},
 		},
+		{
+			name: "text field with validation",
+			field: schema.NewField(validatedText.TypeProperty()).
+				ID(id.NewFieldID()).Key(id.NewKey("text-validation")).MustBuild(),
+			expected: types.JSONSchema{
+				Type:           "string",
+				FieldType:      "text",
+				MaxLength:      new(10),
+				MinLength:      new(2),
+				Pattern:        new("^[a-z]+$"),
+				PatternMessage: new("lowercase only"),
+				ForbiddenWords: new([]string{"spam"}),
+			},
+		},
+		{
+			name: "text field with email preset",
+			field: schema.NewField(emailText.TypeProperty()).
+				ID(id.NewFieldID()).Key(id.NewKey("email")).MustBuild(),
+			expected: types.JSONSchema{
+				Type:      "string",
+				FieldType: "text",
+				Format:    new("email"),
+				Preset:    new("email"),
+			},
+		},
+		{
+			name: "text area field with phone preset",
+			field: schema.NewField(phoneTextArea.TypeProperty()).
+				ID(id.NewFieldID()).Key(id.NewKey("phone")).MustBuild(),
+			expected: types.JSONSchema{
+				Type:      "string",
+				FieldType: "textArea",
+				Pattern:   new(schema.TextPresetPhone.Pattern()),
+				Preset:    new("phone"),
+			},
+		},
 		{
 			name: "bool field with default value",
 			field: schema.NewField(schema.NewBool().TypeProperty()).
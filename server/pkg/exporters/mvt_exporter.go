@@ -0,0 +1,150 @@
+package exporters
+
+import (
+	"context"
+	"io"
+
+	geojson "github.com/paulmach/go.geojson"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/mvt"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+)
+
+// MVTExporter handles Mapbox Vector Tile exports.
+// Features are collected through the batches and the tile is written at the end since a tile can not be streamed.
+type MVTExporter struct {
+	isStreaming bool
+	writer      io.Writer
+	schema      *schema.Package
+	geo         schema.FieldID
+	locales     []string
+	tile        mvt.TileID
+	layer       *mvt.Layer
+}
+
+// NewMVTExporter creates a new MVT exporter
+func NewMVTExporter(w io.Writer) Exporter {
+	return &MVTExporter{
+		writer: w,
+	}
+}
+
+// ValidateRequest validates the export request
+func (e *MVTExporter) ValidateRequest(req *ExportRequest) error {
+	if e.writer == nil {
+		return ErrWriterRequired
+	}
+
+	if req.Schema.Schema() == nil {
+		return ErrSchemaRequired
+	}
+
+	if !req.Schema.Schema().HasGeometryFields() {
+		return ErrNoGeometryField
+	}
+
+	if req.Options.GeometryField == nil || !req.Schema.Schema().HasField(*req.Options.GeometryField) {
+		return ErrInvalidGeometryField
+	}
+
+	if !req.Schema.Field(*req.Options.GeometryField).IsGeometryField() {
+		return ErrInvalidGeometryField
+	}
+
+	if req.Options.Tile == nil || req.Options.Tile.Layer == "" {
+		return ErrInvalidRequest
+	}
+
+	return req.Options.Tile.ID.Validate()
+}
+
+// Export performs the MVT export
+func (e *MVTExporter) Export(ctx context.Context, req *ExportRequest, il item.List, al asset.List) error {
+	if err := e.StartExport(ctx, req); err != nil {
+		return err
+	}
+	if err := e.ProcessBatch(ctx, il, al); err != nil {
+		return err
+	}
+	return e.EndExport(ctx, nil)
+}
+
+// StartExport initializes the MVT export
+func (e *MVTExporter) StartExport(ctx context.Context, req *ExportRequest) error {
+	if err := e.ValidateRequest(req); err != nil {
+		return err
+	}
+
+	e.isStreaming = true
+	e.schema = &req.Schema
+	e.geo = *req.Options.GeometryField
+	e.locales = req.Options.Locales
+	e.tile = req.Options.Tile.ID
+	e.layer = mvt.NewLayer(req.Options.Tile.Layer)
+	return nil
+}
+
+// ProcessBatch adds the features of a batch of items to the tile
+func (e *MVTExporter) ProcessBatch(ctx context.Context, items item.List, assets asset.List) error {
+	if !e.isStreaming {
+		return ErrInvalidRequest
+	}
+
+	for _, itm := range items.Localize(e.locales) {
+		g, ok := tileGeometryFromItem(itm, e.geo)
+		if !ok {
+			continue
+		}
+		e.layer.Add(g, tilePropertiesFromItem(itm, e.schema, assets))
+	}
+
+	return nil
+}
+
+// EndExport encodes and writes the tile
+func (e *MVTExporter) EndExport(ctx context.Context, extra map[string]any) error {
+	if !e.isStreaming {
+		return ErrInvalidRequest
+	}
+	e.isStreaming = false
+
+	b, err := mvt.Marshal(e.tile, e.layer)
+	if err != nil {
+		return err
+	}
+	_, err = e.writer.Write(b)
+	return err
+}
+
+func tileGeometryFromItem(itm *item.Item, geoFieldID schema.FieldID) (*geojson.Geometry, bool) {
+	f := itm.Field(geoFieldID)
+	if f == nil || f.Value() == nil || f.Value().IsEmpty() {
+		return nil, false
+	}
+	s, ok := f.Value().First().ValueString()
+	if !ok {
+		return nil, false
+	}
+	g, err := geojson.UnmarshalGeometry([]byte(s))
+	if err != nil {
+		return nil, false
+	}
+	return g, true
+}
+
+// tilePropertiesFromItem returns the same properties as GeoJSON features with the item ID as "id" unless a field uses the key.
+func tilePropertiesFromItem(itm *item.Item, sp *schema.Package, assets asset.List) map[string]any {
+	props := extractProperties(itm, sp, assets)
+	if props == nil {
+		return nil
+	}
+	res := make(map[string]any, len(props.Keys())+1)
+	for _, k := range props.Keys() {
+		res[k], _ = props.Get(k)
+	}
+	if _, ok := res["id"]; !ok {
+		res["id"] = itm.ID().String()
+	}
+	return res
+}
@@ -0,0 +1,119 @@
+package exporters
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	geojson "github.com/paulmach/go.geojson"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/mvt"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMVTExporter_Export(t *testing.T) {
+	sid := id.NewSchemaID()
+	pid := id.NewProjectID()
+	gst := schema.GeometryObjectSupportedTypeList{schema.GeometryObjectSupportedTypePoint}
+	sf1 := schema.NewField(schema.NewGeometryObject(gst).TypeProperty()).NewID().Name("location").Key(id.NewKey("location")).MustBuild()
+	sf2 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Name("name").Key(id.NewKey("name")).Localizable(true).MustBuild()
+	s := schema.New().ID(sid).Fields([]*schema.Field{sf1, sf2}).Workspace(accountdomain.NewWorkspaceID()).Project(pid).MustBuild()
+
+	newItem := func(geo string, name string) *item.Item {
+		f := item.NewField(sf2.ID(), value.TypeText.Value(name).AsMultiple(), nil)
+		f.SetLocalizedValue("en", value.TypeText.Value(name+" (en)").AsMultiple())
+		return item.New().
+			NewID().
+			Schema(sid).
+			Project(pid).
+			Fields([]*item.Field{
+				item.NewField(sf1.ID(), value.TypeGeometryObject.Value(geo).AsMultiple(), nil),
+				f,
+			}).
+			Model(id.NewModelID()).
+			Thread(id.NewThreadID().Ref()).
+			Anonymous(true).
+			MustBuild()
+	}
+	i1 := newItem(`{"type":"Point","coordinates":[139.767,35.681]}`, "東京")
+	i2 := newItem(`{"type":"Point","coordinates":[-74.006,40.713]}`, "ニューヨーク")
+	i3 := item.New().NewID().Schema(sid).Project(pid).Model(id.NewModelID()).Thread(id.NewThreadID().Ref()).Anonymous(true).MustBuild()
+
+	// the north east quarter of the world
+	tile := mvt.TileID{Z: 1, X: 1, Y: 0}
+	req := &ExportRequest{
+		Format: FormatMVT,
+		Schema: *schema.NewPackage(s, nil, nil, nil),
+		Options: ExportOptions{
+			GeometryField: sf1.ID().Ref(),
+			Locales:       []string{"en"},
+			Tile:          &TileOptions{ID: tile, Layer: "places"},
+		},
+	}
+
+	expected := mvt.NewLayer("places")
+	expected.Add(geojson.NewPointGeometry([]float64{139.767, 35.681}), map[string]any{"id": i1.ID().String(), "name": "東京 (en)"})
+	want, err := mvt.Marshal(tile, expected)
+	require.NoError(t, err)
+	require.NotEmpty(t, want)
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, NewMVTExporter(buf).Export(context.Background(), req, item.List{i1, i2, i3}, nil))
+	assert.Equal(t, want, buf.Bytes())
+
+	buf.Reset()
+	e := NewMVTExporter(buf)
+	assert.NoError(t, e.StartExport(context.Background(), req))
+	assert.NoError(t, e.ProcessBatch(context.Background(), item.List{i1}, nil))
+	assert.NoError(t, e.ProcessBatch(context.Background(), item.List{i2, i3}, nil))
+	assert.Empty(t, buf.Bytes())
+	assert.NoError(t, e.EndExport(context.Background(), nil))
+	assert.Equal(t, want, buf.Bytes())
+	assert.Equal(t, ErrInvalidRequest, e.EndExport(context.Background(), nil))
+}
+
+func TestMVTExporter_ValidateRequest(t *testing.T) {
+	gst := schema.GeometryObjectSupportedTypeList{schema.GeometryObjectSupportedTypePoint}
+	sf1 := schema.NewField(schema.NewGeometryObject(gst).TypeProperty()).NewID().Key(id.NewKey("location")).MustBuild()
+	sf2 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("name")).MustBuild()
+	s := schema.New().NewID().Fields([]*schema.Field{sf1, sf2}).Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).MustBuild()
+	sp := *schema.NewPackage(s, nil, nil, nil)
+
+	tests := []struct {
+		name    string
+		options ExportOptions
+		wantErr error
+	}{
+		{
+			name:    "valid",
+			options: ExportOptions{GeometryField: sf1.ID().Ref(), Tile: &TileOptions{ID: mvt.TileID{Z: 2, X: 3, Y: 1}, Layer: "l"}},
+		},
+		{
+			name:    "non geometry field",
+			options: ExportOptions{GeometryField: sf2.ID().Ref(), Tile: &TileOptions{ID: mvt.TileID{}, Layer: "l"}},
+			wantErr: ErrInvalidGeometryField,
+		},
+		{
+			name:    "without tile",
+			options: ExportOptions{GeometryField: sf1.ID().Ref()},
+			wantErr: ErrInvalidRequest,
+		},
+		{
+			name:    "invalid tile",
+			options: ExportOptions{GeometryField: sf1.ID().Ref(), Tile: &TileOptions{ID: mvt.TileID{Z: 1, X: 2, Y: 0}, Layer: "l"}},
+			wantErr: mvt.ErrInvalidTile,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := NewMVTExporter(&bytes.Buffer{}).ValidateRequest(&ExportRequest{Format: FormatMVT, Schema: sp, Options: tt.options})
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
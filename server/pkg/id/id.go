@@ -290,3 +290,81 @@ var MustJobID = idx.Must[Job]
 var JobIDFrom = idx.From[Job]
 var JobIDFromRef = idx.FromRef[Job]
 var JobIDListFrom = idx.ListFrom[Job]
+
+type Schedule struct{}
+
+func (Schedule) Type() string { return "schedule" }
+
+type ScheduleID = idx.ID[Schedule]
+type ScheduleIDList = idx.List[Schedule]
+
+var NewScheduleID = idx.New[Schedule]
+var MustScheduleID = idx.Must[Schedule]
+var ScheduleIDFrom = idx.From[Schedule]
+var ScheduleIDFromRef = idx.FromRef[Schedule]
+var ScheduleIDListFrom = idx.ListFrom[Schedule]
+
+type Workflow struct{}
+
+func (Workflow) Type() string { return "workflow" }
+
+type WorkflowID = idx.ID[Workflow]
+type WorkflowIDList = idx.List[Workflow]
+
+var NewWorkflowID = idx.New[Workflow]
+var MustWorkflowID = idx.Must[Workflow]
+var WorkflowIDFrom = idx.From[Workflow]
+var WorkflowIDFromRef = idx.FromRef[Workflow]
+var WorkflowIDListFrom = idx.ListFrom[Workflow]
+
+type Notification struct{}
+
+func (Notification) Type() string { return "notification" }
+
+type NotificationID = idx.ID[Notification]
+type NotificationIDList = idx.List[Notification]
+
+var NewNotificationID = idx.New[Notification]
+var MustNotificationID = idx.Must[Notification]
+var NotificationIDFrom = idx.From[Notification]
+var NotificationIDFromRef = idx.FromRef[Notification]
+var NotificationIDListFrom = idx.ListFrom[Notification]
+
+type WebhookDelivery struct{}
+
+func (WebhookDelivery) Type() string { return "webhookDelivery" }
+
+type WebhookDeliveryID = idx.ID[WebhookDelivery]
+type WebhookDeliveryIDList = idx.List[WebhookDelivery]
+
+var NewWebhookDeliveryID = idx.New[WebhookDelivery]
+var MustWebhookDeliveryID = idx.Must[WebhookDelivery]
+var WebhookDeliveryIDFrom = idx.From[WebhookDelivery]
+var WebhookDeliveryIDFromRef = idx.FromRef[WebhookDelivery]
+var WebhookDeliveryIDListFrom = idx.ListFrom[WebhookDelivery]
+
+type AssetFolder struct{}
+
+func (AssetFolder) Type() string { return "assetFolder" }
+
+type AssetFolderID = idx.ID[AssetFolder]
+type AssetFolderIDList = idx.List[AssetFolder]
+
+var NewAssetFolderID = idx.New[AssetFolder]
+var MustAssetFolderID = idx.Must[AssetFolder]
+var AssetFolderIDFrom = idx.From[AssetFolder]
+var AssetFolderIDFromRef = idx.FromRef[AssetFolder]
+var AssetFolderIDListFrom = idx.ListFrom[AssetFolder]
+
+type Task struct{}
+
+func (Task) Type() string { return "task" }
+
+type TaskID = idx.ID[Task]
+type TaskIDList = idx.List[Task]
+
+var NewTaskID = idx.New[Task]
+var MustTaskID = idx.Must[Task]
+var TaskIDFrom = idx.From[Task]
+var TaskIDFromRef = idx.FromRef[Task]
+var TaskIDListFrom = idx.ListFrom[Task]
@@ -7,11 +7,17 @@ import (
 
 type ID = id.IntegrationID
 type WebhookID = id.WebhookID
+type WebhookDeliveryID = id.WebhookDeliveryID
+type EventID = id.EventID
 type UserID = accountdomain.UserID
 type ModelID = id.ModelID
+type ModelIDList = id.ModelIDList
+type GroupID = id.GroupID
+type GroupIDList = id.GroupIDList
 
 var NewID = id.NewIntegrationID
 var NewWebhookID = id.NewWebhookID
+var NewWebhookDeliveryID = id.NewWebhookDeliveryID
 var MustID = id.MustIntegrationID
 var IDFrom = id.IntegrationIDFrom
 var IDFromRef = id.IntegrationIDFromRef
@@ -5,6 +5,7 @@ import (
 	"slices"
 	"time"
 
+	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 )
@@ -83,6 +84,20 @@ func (i *Integration) Webhooks() []*Webhook {
 	return i.webhooks
 }
 
+// ActiveWebhooks returns the active webhooks which are triggered by the event type.
+func (i *Integration) ActiveWebhooks(ty event.Type) []*Webhook {
+	return lo.Filter(i.webhooks, func(w *Webhook, _ int) bool {
+		return w.Trigger().IsActive(ty) && w.Active()
+	})
+}
+
+// MatchedWebhooks returns the active webhooks which are triggered by the event type and whose filter matches the scope.
+func (i *Integration) MatchedWebhooks(ty event.Type, s WebhookScope) []*Webhook {
+	return lo.Filter(i.webhooks, func(w *Webhook, _ int) bool {
+		return w.Match(ty, s)
+	})
+}
+
 func (i *Integration) Webhook(wId WebhookID) (*Webhook, bool) {
 	return lo.Find(i.webhooks, func(w *Webhook) bool { return w.id == wId })
 }
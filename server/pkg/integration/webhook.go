@@ -15,6 +15,8 @@ type Webhook struct {
 	trigger   WebhookTrigger
 	updatedAt time.Time
 	secret    string
+	filter    WebhookFilter
+	payload   WebhookPayloadShape
 }
 
 type WebhookTrigger map[event.Type]bool
@@ -76,6 +78,27 @@ func (w *Webhook) SetSecret(secret string) {
 	w.secret = secret
 }
 
+func (w *Webhook) Filter() WebhookFilter {
+	return w.filter
+}
+
+func (w *Webhook) SetFilter(filter WebhookFilter) {
+	w.filter = filter
+}
+
+func (w *Webhook) Payload() WebhookPayloadShape {
+	return w.payload
+}
+
+func (w *Webhook) SetPayload(payload WebhookPayloadShape) {
+	w.payload = payload
+}
+
+// Match reports whether the webhook should be sent for the event of the type and scope.
+func (w *Webhook) Match(ty event.Type, s WebhookScope) bool {
+	return w.Active() && w.Trigger().IsActive(ty) && w.filter.Match(s)
+}
+
 func (w *Webhook) Clone() *Webhook {
 	if w == nil {
 		return nil
@@ -93,6 +116,8 @@ func (w *Webhook) Clone() *Webhook {
 		trigger:   w.trigger,
 		updatedAt: w.updatedAt,
 		secret:    w.secret,
+		filter:    w.filter.Clone(),
+		payload:   w.payload.Clone(),
 	}
 }
 
@@ -19,6 +19,9 @@ func (b *WebhookBuilder) Build() (*Webhook, error) {
 	if b.w.id.IsNil() {
 		return nil, ErrInvalidID
 	}
+	if err := b.w.payload.Validate(); err != nil {
+		return nil, err
+	}
 	if b.w.updatedAt.IsZero() {
 		b.w.updatedAt = b.w.CreatedAt()
 	}
@@ -72,3 +75,13 @@ func (b *WebhookBuilder) Secret(secret string) *WebhookBuilder {
 	b.w.secret = secret
 	return b
 }
+
+func (b *WebhookBuilder) Filter(filter WebhookFilter) *WebhookBuilder {
+	b.w.filter = filter
+	return b
+}
+
+func (b *WebhookBuilder) Payload(payload WebhookPayloadShape) *WebhookBuilder {
+	b.w.payload = payload
+	return b
+}
@@ -0,0 +1,219 @@
+package integration
+
+import (
+	"slices"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearthx/util"
+)
+
+const (
+	// MaxWebhookDeliveryAttempts is the number of attempts after which a delivery is dead-lettered
+	MaxWebhookDeliveryAttempts = 5
+	// MaxWebhookConsecutiveFailures is the number of consecutive dead deliveries after which a webhook is deactivated
+	MaxWebhookConsecutiveFailures = 5
+	// MaxWebhookResponseBodyLength is the length of the excerpt of the response body kept in the delivery log
+	MaxWebhookResponseBodyLength = 1024
+
+	webhookRetryBaseInterval = time.Minute
+	webhookRetryMaxInterval  = time.Hour
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryStatusRetrying  WebhookDeliveryStatus = "retrying"
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "dead"
+)
+
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
+
+type WebhookDelivery struct {
+	id            WebhookDeliveryID
+	integration   ID
+	webhook       WebhookID
+	event         EventID
+	eventType     event.Type
+	url           string
+	payload       []byte
+	status        WebhookDeliveryStatus
+	attempts      []*WebhookDeliveryAttempt
+	nextAttemptAt *time.Time
+	updatedAt     time.Time
+}
+
+type WebhookDeliveryAttempt struct {
+	AttemptedAt  time.Time
+	StatusCode   int
+	Latency      time.Duration
+	ResponseBody string
+	Error        string
+}
+
+func (a *WebhookDeliveryAttempt) Succeeded() bool {
+	return a != nil && a.Error == "" && a.StatusCode >= 200 && a.StatusCode < 300
+}
+
+func NewWebhookDelivery(i ID, w *Webhook, eventID EventID, eventType event.Type, payload []byte) *WebhookDelivery {
+	now := util.Now()
+	return &WebhookDelivery{
+		id:          NewWebhookDeliveryID(),
+		integration: i,
+		webhook:     w.ID(),
+		event:       eventID,
+		eventType:   eventType,
+		url:         w.URL().String(),
+		payload:     payload,
+		status:      WebhookDeliveryStatusPending,
+		updatedAt:   now,
+	}
+}
+
+func (d *WebhookDelivery) ID() WebhookDeliveryID {
+	return d.id
+}
+
+func (d *WebhookDelivery) Integration() ID {
+	return d.integration
+}
+
+func (d *WebhookDelivery) Webhook() WebhookID {
+	return d.webhook
+}
+
+func (d *WebhookDelivery) Event() EventID {
+	return d.event
+}
+
+func (d *WebhookDelivery) EventType() event.Type {
+	return d.eventType
+}
+
+func (d *WebhookDelivery) URL() string {
+	return d.url
+}
+
+// Payload returns the data of the event which is sent to the webhook
+func (d *WebhookDelivery) Payload() []byte {
+	return slices.Clone(d.payload)
+}
+
+func (d *WebhookDelivery) Status() WebhookDeliveryStatus {
+	return d.status
+}
+
+func (d *WebhookDelivery) Attempts() []*WebhookDeliveryAttempt {
+	return slices.Clone(d.attempts)
+}
+
+func (d *WebhookDelivery) NextAttemptAt() *time.Time {
+	return util.CloneRef(d.nextAttemptAt)
+}
+
+func (d *WebhookDelivery) CreatedAt() time.Time {
+	return d.id.Timestamp()
+}
+
+func (d *WebhookDelivery) UpdatedAt() time.Time {
+	return d.updatedAt
+}
+
+// RecordAttempt appends the attempt to the log and moves the delivery to its next status.
+func (d *WebhookDelivery) RecordAttempt(a WebhookDeliveryAttempt) {
+	if len(a.ResponseBody) > MaxWebhookResponseBodyLength {
+		a.ResponseBody = a.ResponseBody[:MaxWebhookResponseBodyLength]
+	}
+	d.attempts = append(d.attempts, &a)
+	d.status, d.nextAttemptAt = NextWebhookDeliveryStatus(len(d.attempts), a.Succeeded(), a.AttemptedAt)
+	d.updatedAt = a.AttemptedAt
+}
+
+// Retry marks the delivery as being sent again.
+func (d *WebhookDelivery) Retry() {
+	d.status = WebhookDeliveryStatusPending
+	d.nextAttemptAt = nil
+	d.updatedAt = util.Now()
+}
+
+// Redeliver returns a new delivery which sends the same payload to the webhook again.
+func (d *WebhookDelivery) Redeliver(w *Webhook) *WebhookDelivery {
+	return NewWebhookDelivery(d.integration, w, d.event, d.eventType, d.payload)
+}
+
+func (d *WebhookDelivery) IsDue(now time.Time) bool {
+	return d.status == WebhookDeliveryStatusRetrying && d.nextAttemptAt != nil && !d.nextAttemptAt.After(now)
+}
+
+func (d *WebhookDelivery) Clone() *WebhookDelivery {
+	if d == nil {
+		return nil
+	}
+	var attempts []*WebhookDeliveryAttempt
+	for _, a := range d.attempts {
+		attempts = append(attempts, util.CloneRef(a))
+	}
+	return &WebhookDelivery{
+		id:            d.id,
+		integration:   d.integration,
+		webhook:       d.webhook,
+		event:         d.event,
+		eventType:     d.eventType,
+		url:           d.url,
+		payload:       slices.Clone(d.payload),
+		status:        d.status,
+		attempts:      attempts,
+		nextAttemptAt: util.CloneRef(d.nextAttemptAt),
+		updatedAt:     d.updatedAt,
+	}
+}
+
+// NextWebhookDeliveryStatus returns the status of a delivery after its n-th attempt.
+// Failed deliveries are retried with an exponential backoff until they are dead-lettered.
+func NextWebhookDeliveryStatus(attempts int, succeeded bool, at time.Time) (WebhookDeliveryStatus, *time.Time) {
+	if succeeded {
+		return WebhookDeliveryStatusSucceeded, nil
+	}
+	if attempts >= MaxWebhookDeliveryAttempts {
+		return WebhookDeliveryStatusDead, nil
+	}
+	return WebhookDeliveryStatusRetrying, new(at.Add(WebhookRetryInterval(attempts)))
+}
+
+// WebhookRetryInterval returns the interval before the retry following the n-th attempt.
+func WebhookRetryInterval(attempts int) time.Duration {
+	i := webhookRetryBaseInterval
+	for n := 1; n < attempts && i < webhookRetryMaxInterval; n++ {
+		i *= 2
+	}
+	return min(i, webhookRetryMaxInterval)
+}
+
+type WebhookDeliveryList []*WebhookDelivery
+
+func (l WebhookDeliveryList) Webhooks() []WebhookID {
+	res := make([]WebhookID, 0, len(l))
+	for _, d := range l {
+		if !slices.Contains(res, d.Webhook()) {
+			res = append(res, d.Webhook())
+		}
+	}
+	return res
+}
+
+// AllDead returns true when the list is not empty and all of its deliveries are dead.
+func (l WebhookDeliveryList) AllDead() bool {
+	if len(l) == 0 {
+		return false
+	}
+	for _, d := range l {
+		if d.Status() != WebhookDeliveryStatusDead {
+			return false
+		}
+	}
+	return true
+}
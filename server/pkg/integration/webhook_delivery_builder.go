@@ -0,0 +1,93 @@
+package integration
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/event"
+)
+
+type WebhookDeliveryBuilder struct {
+	d *WebhookDelivery
+}
+
+func NewWebhookDeliveryBuilder() *WebhookDeliveryBuilder {
+	return &WebhookDeliveryBuilder{d: &WebhookDelivery{status: WebhookDeliveryStatusPending}}
+}
+
+func (b *WebhookDeliveryBuilder) Build() (*WebhookDelivery, error) {
+	if b.d.id.IsNil() || b.d.integration.IsNil() || b.d.webhook.IsNil() {
+		return nil, ErrInvalidID
+	}
+	if b.d.updatedAt.IsZero() {
+		b.d.updatedAt = b.d.CreatedAt()
+	}
+	return b.d, nil
+}
+
+func (b *WebhookDeliveryBuilder) MustBuild() *WebhookDelivery {
+	r, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func (b *WebhookDeliveryBuilder) NewID() *WebhookDeliveryBuilder {
+	b.d.id = NewWebhookDeliveryID()
+	return b
+}
+
+func (b *WebhookDeliveryBuilder) ID(id WebhookDeliveryID) *WebhookDeliveryBuilder {
+	b.d.id = id
+	return b
+}
+
+func (b *WebhookDeliveryBuilder) Integration(i ID) *WebhookDeliveryBuilder {
+	b.d.integration = i
+	return b
+}
+
+func (b *WebhookDeliveryBuilder) Webhook(w WebhookID) *WebhookDeliveryBuilder {
+	b.d.webhook = w
+	return b
+}
+
+func (b *WebhookDeliveryBuilder) Event(e EventID) *WebhookDeliveryBuilder {
+	b.d.event = e
+	return b
+}
+
+func (b *WebhookDeliveryBuilder) EventType(t event.Type) *WebhookDeliveryBuilder {
+	b.d.eventType = t
+	return b
+}
+
+func (b *WebhookDeliveryBuilder) URL(url string) *WebhookDeliveryBuilder {
+	b.d.url = url
+	return b
+}
+
+func (b *WebhookDeliveryBuilder) Payload(payload []byte) *WebhookDeliveryBuilder {
+	b.d.payload = payload
+	return b
+}
+
+func (b *WebhookDeliveryBuilder) Status(status WebhookDeliveryStatus) *WebhookDeliveryBuilder {
+	b.d.status = status
+	return b
+}
+
+func (b *WebhookDeliveryBuilder) Attempts(attempts []*WebhookDeliveryAttempt) *WebhookDeliveryBuilder {
+	b.d.attempts = attempts
+	return b
+}
+
+func (b *WebhookDeliveryBuilder) NextAttemptAt(t *time.Time) *WebhookDeliveryBuilder {
+	b.d.nextAttemptAt = t
+	return b
+}
+
+func (b *WebhookDeliveryBuilder) UpdatedAt(t time.Time) *WebhookDeliveryBuilder {
+	b.d.updatedAt = t
+	return b
+}
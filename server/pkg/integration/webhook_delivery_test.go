@@ -0,0 +1,76 @@
+package integration
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookRetryInterval(t *testing.T) {
+	assert.Equal(t, time.Minute, WebhookRetryInterval(1))
+	assert.Equal(t, 2*time.Minute, WebhookRetryInterval(2))
+	assert.Equal(t, 4*time.Minute, WebhookRetryInterval(3))
+	assert.Equal(t, time.Hour, WebhookRetryInterval(10))
+}
+
+func TestNextWebhookDeliveryStatus(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	s, next := NextWebhookDeliveryStatus(1, true, now)
+	assert.Equal(t, WebhookDeliveryStatusSucceeded, s)
+	assert.Nil(t, next)
+
+	s, next = NextWebhookDeliveryStatus(2, false, now)
+	assert.Equal(t, WebhookDeliveryStatusRetrying, s)
+	assert.Equal(t, new(now.Add(2*time.Minute)), next)
+
+	s, next = NextWebhookDeliveryStatus(MaxWebhookDeliveryAttempts, false, now)
+	assert.Equal(t, WebhookDeliveryStatusDead, s)
+	assert.Nil(t, next)
+}
+
+func TestWebhookDelivery_RecordAttempt(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	w := NewWebhookBuilder().NewID().Url(lo.Must(url.Parse("https://example.com"))).MustBuild()
+	d := NewWebhookDelivery(NewID(), w, id.NewEventID(), event.ItemCreate, []byte(`{}`))
+	assert.Equal(t, WebhookDeliveryStatusPending, d.Status())
+	assert.Equal(t, "https://example.com", d.URL())
+
+	d.RecordAttempt(WebhookDeliveryAttempt{AttemptedAt: now, StatusCode: 500, ResponseBody: strings.Repeat("a", 2000)})
+	assert.Equal(t, WebhookDeliveryStatusRetrying, d.Status())
+	assert.Equal(t, new(now.Add(time.Minute)), d.NextAttemptAt())
+	assert.Len(t, d.Attempts()[0].ResponseBody, MaxWebhookResponseBodyLength)
+	assert.False(t, d.IsDue(now))
+	assert.True(t, d.IsDue(now.Add(time.Minute)))
+
+	d.Retry()
+	assert.Equal(t, WebhookDeliveryStatusPending, d.Status())
+	assert.Nil(t, d.NextAttemptAt())
+
+	d.RecordAttempt(WebhookDeliveryAttempt{AttemptedAt: now, StatusCode: 204})
+	assert.Equal(t, WebhookDeliveryStatusSucceeded, d.Status())
+	assert.Len(t, d.Attempts(), 2)
+
+	r := d.Redeliver(w)
+	assert.NotEqual(t, d.ID(), r.ID())
+	assert.Equal(t, d.Event(), r.Event())
+	assert.Equal(t, d.Payload(), r.Payload())
+	assert.Empty(t, r.Attempts())
+}
+
+func TestWebhookDeliveryList_AllDead(t *testing.T) {
+	w := NewWebhookBuilder().NewID().Url(lo.Must(url.Parse("https://example.com"))).MustBuild()
+	dead := NewWebhookDeliveryBuilder().NewID().Integration(NewID()).Webhook(w.ID()).Status(WebhookDeliveryStatusDead).MustBuild()
+	ok := NewWebhookDeliveryBuilder().NewID().Integration(NewID()).Webhook(w.ID()).Status(WebhookDeliveryStatusSucceeded).MustBuild()
+
+	assert.False(t, WebhookDeliveryList{}.AllDead())
+	assert.True(t, WebhookDeliveryList{dead, dead}.AllDead())
+	assert.False(t, WebhookDeliveryList{dead, ok}.AllDead())
+	assert.Equal(t, []WebhookID{w.ID()}, WebhookDeliveryList{dead, ok}.Webhooks())
+}
@@ -0,0 +1,131 @@
+package integration
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+)
+
+var ErrWebhookProjectionFieldsRequired = rerror.NewE(i18n.T("fields are required for the projection payload of a webhook"))
+
+// WebhookScope is the models and groups which an event is about.
+type WebhookScope struct {
+	Models ModelIDList
+	Groups GroupIDList
+}
+
+// WebhookFilter limits a webhook to the events of specific models and groups.
+// An empty filter lets every event through.
+type WebhookFilter struct {
+	models ModelIDList
+	groups GroupIDList
+}
+
+func NewWebhookFilter(models ModelIDList, groups GroupIDList) WebhookFilter {
+	return WebhookFilter{
+		models: models.Clone(),
+		groups: groups.Clone(),
+	}
+}
+
+func (f WebhookFilter) Models() ModelIDList {
+	return f.models.Clone()
+}
+
+func (f WebhookFilter) Groups() GroupIDList {
+	return f.groups.Clone()
+}
+
+func (f WebhookFilter) IsEmpty() bool {
+	return len(f.models) == 0 && len(f.groups) == 0
+}
+
+// Match reports whether the event with the scope should be sent.
+// Events which are not about a model or a group, such as asset events, always match.
+func (f WebhookFilter) Match(s WebhookScope) bool {
+	if f.IsEmpty() || (len(s.Models) == 0 && len(s.Groups) == 0) {
+		return true
+	}
+	return f.models.Intersect(s.Models).Len() > 0 || f.groups.Intersect(s.Groups).Len() > 0
+}
+
+func (f WebhookFilter) Clone() WebhookFilter {
+	if f.IsEmpty() {
+		return WebhookFilter{}
+	}
+	return NewWebhookFilter(f.models, f.groups)
+}
+
+type WebhookPayloadType string
+
+const (
+	// WebhookPayloadTypeFull sends the whole entity with its model and schema.
+	WebhookPayloadTypeFull WebhookPayloadType = "full"
+	// WebhookPayloadTypeMinimal sends only the ids of the entity and its parents.
+	WebhookPayloadTypeMinimal WebhookPayloadType = "minimal"
+	// WebhookPayloadTypeProjection sends the entity with only the selected item fields.
+	WebhookPayloadTypeProjection WebhookPayloadType = "projection"
+)
+
+func WebhookPayloadTypeFrom(s string) WebhookPayloadType {
+	switch WebhookPayloadType(strings.ToLower(s)) {
+	case WebhookPayloadTypeMinimal:
+		return WebhookPayloadTypeMinimal
+	case WebhookPayloadTypeProjection:
+		return WebhookPayloadTypeProjection
+	}
+	return WebhookPayloadTypeFull
+}
+
+// WebhookPayloadShape is the variant of the payload which a webhook receives.
+// The zero value is the full payload.
+type WebhookPayloadShape struct {
+	typ    WebhookPayloadType
+	fields []string
+}
+
+// NewWebhookPayloadShape returns the shape of the type. The field keys are only kept for projections.
+func NewWebhookPayloadShape(t WebhookPayloadType, fields []string) WebhookPayloadShape {
+	if t != WebhookPayloadTypeProjection {
+		return WebhookPayloadShape{typ: t}
+	}
+	return WebhookPayloadShape{typ: t, fields: slices.Clone(fields)}
+}
+
+func (s WebhookPayloadShape) Type() WebhookPayloadType {
+	if s.typ == "" {
+		return WebhookPayloadTypeFull
+	}
+	return s.typ
+}
+
+// Fields returns the keys of the item fields to be sent when the type is projection.
+func (s WebhookPayloadShape) Fields() []string {
+	return slices.Clone(s.fields)
+}
+
+// HasField reports whether the item field of the key should be sent.
+func (s WebhookPayloadShape) HasField(key string) bool {
+	return s.Type() != WebhookPayloadTypeProjection || slices.Contains(s.fields, key)
+}
+
+func (s WebhookPayloadShape) Validate() error {
+	if s.Type() == WebhookPayloadTypeProjection && len(s.fields) == 0 {
+		return ErrWebhookProjectionFieldsRequired
+	}
+	return nil
+}
+
+func (s WebhookPayloadShape) Clone() WebhookPayloadShape {
+	return NewWebhookPayloadShape(s.typ, s.fields)
+}
+
+// Key identifies the shape so that payloads rendered for the same event can be shared between webhooks.
+func (s WebhookPayloadShape) Key() string {
+	if s.Type() != WebhookPayloadTypeProjection {
+		return string(s.Type())
+	}
+	return string(s.Type()) + ":" + strings.Join(s.fields, ",")
+}
@@ -0,0 +1,111 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookFilter_Match(t *testing.T) {
+	m1, m2 := id.NewModelID(), id.NewModelID()
+	g1 := id.NewGroupID()
+
+	tests := []struct {
+		name   string
+		filter WebhookFilter
+		scope  WebhookScope
+		want   bool
+	}{
+		{
+			name:   "empty filter",
+			filter: WebhookFilter{},
+			scope:  WebhookScope{Models: ModelIDList{m1}},
+			want:   true,
+		},
+		{
+			name:   "unscoped event",
+			filter: NewWebhookFilter(ModelIDList{m1}, nil),
+			scope:  WebhookScope{},
+			want:   true,
+		},
+		{
+			name:   "model matched",
+			filter: NewWebhookFilter(ModelIDList{m1}, nil),
+			scope:  WebhookScope{Models: ModelIDList{m2, m1}},
+			want:   true,
+		},
+		{
+			name:   "model not matched",
+			filter: NewWebhookFilter(ModelIDList{m1}, nil),
+			scope:  WebhookScope{Models: ModelIDList{m2}},
+			want:   false,
+		},
+		{
+			name:   "group matched",
+			filter: NewWebhookFilter(ModelIDList{m1}, GroupIDList{g1}),
+			scope:  WebhookScope{Groups: GroupIDList{g1}},
+			want:   true,
+		},
+		{
+			name:   "group not matched",
+			filter: NewWebhookFilter(nil, GroupIDList{g1}),
+			scope:  WebhookScope{Models: ModelIDList{m1}},
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.filter.Match(tt.scope))
+		})
+	}
+}
+
+func TestWebhook_Match(t *testing.T) {
+	m1, m2 := id.NewModelID(), id.NewModelID()
+	w := &Webhook{
+		active:  true,
+		trigger: WebhookTrigger{event.ItemCreate: true},
+		filter:  NewWebhookFilter(ModelIDList{m1}, nil),
+	}
+
+	assert.True(t, w.Match(event.ItemCreate, WebhookScope{Models: ModelIDList{m1}}))
+	assert.False(t, w.Match(event.ItemCreate, WebhookScope{Models: ModelIDList{m2}}))
+	assert.False(t, w.Match(event.ItemUpdate, WebhookScope{Models: ModelIDList{m1}}))
+
+	w.SetActive(false)
+	assert.False(t, w.Match(event.ItemCreate, WebhookScope{Models: ModelIDList{m1}}))
+}
+
+func TestWebhookPayloadShape(t *testing.T) {
+	s := WebhookPayloadShape{}
+	assert.Equal(t, WebhookPayloadTypeFull, s.Type())
+	assert.True(t, s.HasField("a"))
+	assert.Equal(t, "full", s.Key())
+
+	s = NewWebhookPayloadShape(WebhookPayloadTypeMinimal, []string{"a"})
+	assert.Equal(t, WebhookPayloadTypeMinimal, s.Type())
+	assert.Nil(t, s.Fields())
+	assert.Equal(t, "minimal", s.Key())
+
+	s = NewWebhookPayloadShape(WebhookPayloadTypeProjection, []string{"a", "b"})
+	assert.Equal(t, []string{"a", "b"}, s.Fields())
+	assert.True(t, s.HasField("a"))
+	assert.False(t, s.HasField("c"))
+	assert.Equal(t, "projection:a,b", s.Key())
+	assert.Equal(t, s, s.Clone())
+	assert.NoError(t, s.Validate())
+
+	s = NewWebhookPayloadShape(WebhookPayloadTypeProjection, nil)
+	assert.ErrorIs(t, s.Validate(), ErrWebhookProjectionFieldsRequired)
+}
+
+func TestWebhookPayloadTypeFrom(t *testing.T) {
+	assert.Equal(t, WebhookPayloadTypeMinimal, WebhookPayloadTypeFrom("MINIMAL"))
+	assert.Equal(t, WebhookPayloadTypeProjection, WebhookPayloadTypeFrom("projection"))
+	assert.Equal(t, WebhookPayloadTypeFull, WebhookPayloadTypeFrom("full"))
+	assert.Equal(t, WebhookPayloadTypeFull, WebhookPayloadTypeFrom(""))
+}
@@ -2,6 +2,7 @@ package integrationapi
 
 import (
 	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/samber/lo"
 )
 
@@ -32,6 +33,25 @@ func NewAsset(a *asset.Asset, f *asset.File, all bool) *Asset {
 		File:                    ToAssetFile(f, all),
 		ArchiveExtractionStatus: ToAssetArchiveExtractionStatus(a.ArchiveExtractionStatus()),
 		Public:                  ai.Public,
+		FolderId:                a.Folder(),
+	}
+}
+
+// SetThumbnailURL sets the URL of the thumbnail of the asset unless it is empty.
+func (a *Asset) SetThumbnailURL(u string) {
+	if a == nil || u == "" {
+		return
+	}
+	a.ThumbnailUrl = new(u)
+}
+
+// SetMetadata sets the metadata of the asset keyed by the field keys of the asset metadata schema.
+func (a *Asset) SetMetadata(m asset.Metadata, s *schema.Schema) {
+	if a == nil {
+		return
+	}
+	if mm := m.Map(s); len(mm) > 0 {
+		a.Metadata = &mm
 	}
 }
 
@@ -0,0 +1,29 @@
+package integrationapi
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+)
+
+func NewAssetFolder(f *asset.Folder, folders asset.FolderList) *AssetFolder {
+	if f == nil {
+		return nil
+	}
+
+	return &AssetFolder{
+		Id:         f.ID(),
+		ParentId:   f.Parent(),
+		Name:       f.Name(),
+		Path:       folders.Path(f.ID()),
+		Permission: new(AssetFolderPermission(folders.Permission(f.ID()))),
+		CreatedAt:  f.CreatedAt(),
+		UpdatedAt:  f.UpdatedAt(),
+	}
+}
+
+// SetFolderPath sets the path of the folder which the asset belongs to.
+func (a *Asset) SetFolderPath(folders asset.FolderList) {
+	if a == nil || a.FolderId == nil || folders.Folder(*a.FolderId) == nil {
+		return
+	}
+	a.FolderPath = new(folders.Path(*a.FolderId))
+}
@@ -1,9 +1,24 @@
 package integrationapi
 
 import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/operator"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
+	"github.com/samber/lo"
 )
 
+type CommentThread struct {
+	ThreadID id.ThreadID `json:"threadId"`
+	Comment  *Comment    `json:"comment"`
+}
+
+func NewCommentThread(c thread.CommentThread) CommentThread {
+	return CommentThread{
+		ThreadID: c.Thread.ID(),
+		Comment:  NewComment(c.Comment),
+	}
+}
+
 func NewComment(c *thread.Comment) *Comment {
 	if c == nil {
 		return nil
@@ -24,6 +39,33 @@ func NewComment(c *thread.Comment) *Comment {
 		AuthorId:   &authorID,
 		AuthorType: &authorType,
 		Content:    new(c.Content()),
-		CreatedAt:  new(c.CreatedAt()),
+		ReplyTo:    c.ReplyTo(),
+		Mentions: new(lo.Map(c.Mentions(), func(m *thread.Mention, _ int) CommentMention {
+			targetID, targetType := operatorIDAndType(m.Target())
+			return CommentMention{
+				Name:       new(m.Name()),
+				TargetId:   &targetID,
+				TargetType: &targetType,
+			}
+		})),
+		Reactions: new(lo.Map(c.Reactions(), func(r *thread.Reaction, _ int) CommentReaction {
+			authorID, authorType := operatorIDAndType(r.Author())
+			return CommentReaction{
+				Emoji:      new(r.Emoji()),
+				AuthorId:   &authorID,
+				AuthorType: &authorType,
+			}
+		})),
+		CreatedAt: new(c.CreatedAt()),
+	}
+}
+
+func operatorIDAndType(o operator.Operator) (any, string) {
+	if o.User() != nil {
+		return o.User().Ref(), "user"
+	}
+	if o.Integration() != nil {
+		return o.Integration().Ref(), "integration"
 	}
+	return nil, ""
 }
@@ -5,6 +5,8 @@ import (
 
 	"github.com/reearth/reearth-cms/server/pkg/operator"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,6 +19,10 @@ func TestNewComment(t *testing.T) {
 	cIntegration := thread.NewComment(thread.NewCommentID(), authorIntegration, "test")
 	authorID := c.Author().User().Ref()
 	authorIntegrationID := cIntegration.Author().Integration().Ref()
+	cReply := thread.NewComment(thread.NewCommentID(), authorIntegration, "@user hi")
+	cReply.SetReplyTo(c.ID().Ref())
+	cReply.SetMentions(thread.MentionList{thread.NewMention("user", authorUser)})
+	lo.Must0(cReply.AddReaction(lo.Must(thread.NewReaction("+1", authorUser))))
 	tests := []struct {
 		name     string
 		input    *thread.Comment
@@ -36,6 +42,8 @@ func TestNewComment(t *testing.T) {
 				Id:         c.ID().Ref(),
 				AuthorType: new(User),
 				AuthorId:   new(any(authorID)),
+				Mentions:   new([]CommentMention{}),
+				Reactions:  new([]CommentReaction{}),
 			},
 		},
 		{
@@ -47,6 +55,26 @@ func TestNewComment(t *testing.T) {
 				Id:         cIntegration.ID().Ref(),
 				AuthorType: new(Integrtaion),
 				AuthorId:   new(any(authorIntegrationID)),
+				Mentions:   new([]CommentMention{}),
+				Reactions:  new([]CommentReaction{}),
+			},
+		},
+		{
+			name:  "Reply with mentions and reactions",
+			input: cReply,
+			expected: &Comment{
+				Content:    new("@user hi"),
+				CreatedAt:  new(cReply.CreatedAt()),
+				Id:         cReply.ID().Ref(),
+				AuthorType: new(Integrtaion),
+				AuthorId:   new(any(cReply.Author().Integration().Ref())),
+				ReplyTo:    c.ID().Ref(),
+				Mentions: new([]CommentMention{
+					{Name: new("user"), TargetId: new(any(authorID)), TargetType: new("user")},
+				}),
+				Reactions: new([]CommentReaction{
+					{Emoji: new("+1"), AuthorId: new(any(authorID)), AuthorType: new("user")},
+				}),
 			},
 		},
 	}
@@ -59,3 +87,13 @@ func TestNewComment(t *testing.T) {
 		})
 	}
 }
+
+func TestNewCommentThread(t *testing.T) {
+	th := thread.New().NewID().Workspace(accountdomain.NewWorkspaceID()).MustBuild()
+	c := thread.NewComment(thread.NewCommentID(), operator.OperatorFromUser(thread.NewUserID()), "test")
+
+	assert.Equal(t, CommentThread{
+		ThreadID: th.ID(),
+		Comment:  NewComment(c),
+	}, NewCommentThread(thread.CommentThread{Thread: th, Comment: c}))
+}
@@ -108,6 +108,19 @@ func (i *Condition) Into() *view.Condition {
 			},
 		}
 	}
+	if i.Spatial != nil {
+		return &view.Condition{
+			ConditionType: view.ConditionTypeSpatial,
+			SpatialCondition: &view.SpatialCondition{
+				Field:   i.Spatial.FieldId.Into(),
+				Op:      i.Spatial.Operator.Into(),
+				BBox:    lo.FromPtr(i.Spatial.Bbox),
+				Polygon: lo.FromPtr(i.Spatial.Polygon),
+				Point:   lo.FromPtr(i.Spatial.Point),
+				Radius:  lo.FromPtr(i.Spatial.Radius),
+			},
+		}
+	}
 	if i.And != nil {
 		return &view.Condition{
 			ConditionType: view.ConditionTypeAnd,
@@ -209,6 +222,19 @@ func (e ConditionTimeOperator) Into() view.TimeOperator {
 	}
 }
 
+func (e ConditionSpatialOperator) Into() view.SpatialOperator {
+	switch e {
+	case Bbox:
+		return view.SpatialOperatorBBox
+	case Intersects:
+		return view.SpatialOperatorIntersects
+	case Near:
+		return view.SpatialOperatorNear
+	default:
+		return ""
+	}
+}
+
 func (e ConditionNullableOperator) Into() view.NullableOperator {
 	switch e {
 	case Empty:
@@ -328,6 +328,27 @@ func TestConditionMultipleOperator_Into(t *testing.T) {
 	}
 }
 
+func TestConditionSpatialOperator_Into(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    ConditionSpatialOperator
+		expected view.SpatialOperator
+	}{
+		{"success Bbox", Bbox, view.SpatialOperatorBBox},
+		{"success Intersects", Intersects, view.SpatialOperatorIntersects},
+		{"success Near", Near, view.SpatialOperatorNear},
+		{"success default case", ConditionSpatialOperator("99"), ""},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.name), func(t *testing.T) {
+			t.Parallel()
+			result := test.input.Into()
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
 func TestFieldSelector_Into(t *testing.T) {
 	fieldType := FieldSelector{
 		FieldId: id.NewFieldID().Ref(),
@@ -694,6 +715,37 @@ func TestConditionInto(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "success spatial",
+			condition: &Condition{
+				Spatial: &struct {
+					Bbox     *[]float64               "json:\"bbox,omitempty\""
+					FieldId  FieldSelector            "json:\"fieldId\""
+					Operator ConditionSpatialOperator "json:\"operator\""
+					Point    *[]float64               "json:\"point,omitempty\""
+					Polygon  *[][]float64             "json:\"polygon,omitempty\""
+					Radius   *float64                 "json:\"radius,omitempty\""
+				}{
+					FieldId: FieldSelector{
+						FieldId: fieldID,
+						Type:    new(FieldSelectorTypeField),
+					},
+					Operator: Bbox,
+					Bbox:     &[]float64{139, 35, 140, 36},
+				},
+			},
+			want: &view.Condition{
+				ConditionType: view.ConditionTypeSpatial,
+				SpatialCondition: &view.SpatialCondition{
+					Field: view.FieldSelector{
+						Type: view.FieldTypeField,
+						ID:   fieldID,
+					},
+					Op:   view.SpatialOperatorBBox,
+					BBox: []float64{139, 35, 140, 36},
+				},
+			},
+		},
 		{
 			name:      "success nil",
 			condition: nil,
@@ -3,9 +3,16 @@
 package integrationapi
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/thread"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
 )
@@ -17,6 +24,9 @@ var (
 func New(obj any, v string) (res any, err error) {
 	// note: version (v) is not used currently
 	switch o := obj.(type) {
+	case json.RawMessage:
+		// already rendered data such as the payload of a webhook delivery
+		res = o
 	case *event.Event[any]:
 		res, err = NewEvent(o, v)
 	case *asset.Asset:
@@ -29,10 +39,17 @@ func New(obj any, v string) (res any, err error) {
 		res = NewVersionedItem(o, nil, nil, nil, nil, nil, nil)
 	case item.ItemModelSchema:
 		res = NewItemModelSchema(o, nil)
+	case *model.Model:
+		res = NewModel(o, nil, time.Time{})
+	case schema.FieldModelSchema:
+		res = NewFieldModelSchema(o)
+	case *request.Request:
+		res = NewRequest(o)
+	case thread.CommentThread:
+		res = NewCommentThread(o)
 	// TODO: add later
 	// case *schema.Schema:
 	// case *project.Project:
-	// case *model.Model:
 	// case *thread.Thread:
 	// case *integration.Integration:
 	// case *user.Workspace:
@@ -4,6 +4,7 @@ import (
 	"github.com/oapi-codegen/runtime/types"
 	"github.com/reearth/reearth-cms/server/pkg/item"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
@@ -77,3 +78,55 @@ func fieldsFrom(iFields item.Fields, sFields schema.FieldList, assets *AssetCont
 	})
 	return fs
 }
+
+func NewItemDiff(from, to *VersionedItem, changes item.FieldChanges) ItemDiff {
+	return ItemDiff{
+		From: from,
+		To:   to,
+		Changes: new(lo.Map(changes, func(c item.FieldChange, _ int) ItemFieldChange {
+			return NewItemFieldChange(c)
+		})),
+	}
+}
+
+func NewItemFieldChange(c item.FieldChange) ItemFieldChange {
+	added, removed := c.ValueChanges()
+	res := ItemFieldChange{
+		Id:            new(c.ID),
+		Group:         c.ItemGroup,
+		Type:          new(ItemFieldChangeType(c.Type)),
+		AddedValues:   new(lo.Map(added, func(v *value.Value, _ int) any { return v.Interface() })),
+		RemovedValues: new(lo.Map(removed, func(v *value.Value, _ int) any { return v.Interface() })),
+	}
+	if c.PreviousValue != nil {
+		res.PreviousValue = c.PreviousValue.Interface()
+	}
+	if c.CurrentValue != nil {
+		res.CurrentValue = c.CurrentValue.Interface()
+	}
+	return res
+}
+
+func NewDroppedFields(dropped []item.DroppedField) []DroppedField {
+	return lo.Map(dropped, func(d item.DroppedField, _ int) DroppedField {
+		return DroppedField{
+			Id:     new(d.Field),
+			Group:  d.ItemGroup,
+			Reason: new(DroppedFieldReason(d.Reason)),
+		}
+	})
+}
+
+// Into returns the version or ref, which is the latest ref when neither is given.
+func (r *RefOrVersion) Into() version.VersionOrRef {
+	if r == nil {
+		return version.Latest.OrVersion()
+	}
+	if r.Version != nil {
+		return version.Version(*r.Version).OrRef()
+	}
+	if r.Ref != nil {
+		return version.Ref(*r.Ref).OrVersion()
+	}
+	return version.Latest.OrVersion()
+}
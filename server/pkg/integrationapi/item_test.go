@@ -0,0 +1,68 @@
+package integrationapi
+
+import (
+	"testing"
+
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewItemFieldChange(t *testing.T) {
+	fid := id.NewFieldID()
+	gid := id.NewItemGroupID()
+
+	assert.Equal(t, ItemFieldChange{
+		Id:            new(fid),
+		Group:         new(gid),
+		Type:          new(ItemFieldChangeTypeUpdate),
+		PreviousValue: []any{"a", "b"},
+		CurrentValue:  []any{"b", "c"},
+		AddedValues:   new([]any{"c"}),
+		RemovedValues: new([]any{"a"}),
+	}, NewItemFieldChange(item.FieldChange{
+		ID:            fid,
+		ItemGroup:     new(gid),
+		Type:          item.FieldChangeTypeUpdate,
+		PreviousValue: value.NewMultiple(value.TypeText, []any{"a", "b"}),
+		CurrentValue:  value.NewMultiple(value.TypeText, []any{"b", "c"}),
+	}))
+
+	assert.Equal(t, ItemFieldChange{
+		Id:            new(fid),
+		Type:          new(ItemFieldChangeTypeDelete),
+		PreviousValue: []any{"a"},
+		AddedValues:   new([]any{}),
+		RemovedValues: new([]any{"a"}),
+	}, NewItemFieldChange(item.FieldChange{
+		ID:            fid,
+		Type:          item.FieldChangeTypeDelete,
+		PreviousValue: value.TypeText.Value("a").AsMultiple(),
+	}))
+}
+
+func TestNewDroppedFields(t *testing.T) {
+	fid := id.NewFieldID()
+	gid := id.NewItemGroupID()
+
+	assert.Equal(t, []DroppedField{
+		{Id: new(fid), Reason: new(Removed)},
+		{Id: new(fid), Group: new(gid), Reason: new(Invalid)},
+	}, NewDroppedFields([]item.DroppedField{
+		{Field: fid, Reason: item.DroppedFieldReasonRemoved},
+		{Field: fid, ItemGroup: new(gid), Reason: item.DroppedFieldReasonInvalid},
+	}))
+	assert.Empty(t, NewDroppedFields(nil))
+}
+
+func TestRefOrVersion_Into(t *testing.T) {
+	v := version.New()
+
+	assert.Equal(t, version.Latest.OrVersion(), (*RefOrVersion)(nil).Into())
+	assert.Equal(t, version.Latest.OrVersion(), (&RefOrVersion{}).Into())
+	assert.Equal(t, version.Public.OrVersion(), (&RefOrVersion{Ref: new(RefOrVersionRefPublic)}).Into())
+	assert.Equal(t, v.OrRef(), (&RefOrVersion{Version: new(types.UUID(v))}).Into())
+}
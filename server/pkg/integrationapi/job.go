@@ -0,0 +1,40 @@
+package integrationapi
+
+import (
+	"encoding/json"
+
+	"github.com/reearth/reearth-cms/server/pkg/job"
+	"github.com/samber/lo"
+)
+
+func NewJob(j *job.Job) *Job {
+	if j == nil {
+		return nil
+	}
+
+	var result any
+	if r := j.Result(); len(r) > 0 {
+		_ = json.Unmarshal(r, &result)
+	}
+
+	p := j.Progress()
+	return &Job{
+		Id:        j.ID().Ref(),
+		ProjectId: j.ProjectID().Ref(),
+		Type:      new(JobType(j.Type())),
+		Status:    new(JobStatus(j.Status())),
+		Progress: &struct {
+			Processed *int `json:"processed,omitempty"`
+			Total     *int `json:"total,omitempty"`
+		}{
+			Processed: new(p.Processed()),
+			Total:     new(p.Total()),
+		},
+		Result:      result,
+		Error:       lo.EmptyableToPtr(j.Error()),
+		CreatedAt:   new(j.CreatedAt()),
+		UpdatedAt:   new(j.UpdatedAt()),
+		StartedAt:   j.StartedAt(),
+		CompletedAt: j.CompletedAt(),
+	}
+}
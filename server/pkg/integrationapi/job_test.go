@@ -0,0 +1,39 @@
+package integrationapi
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/job"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewJob(t *testing.T) {
+	assert.Nil(t, NewJob(nil))
+
+	j := job.New().NewID().Type(job.TypeBulkUpdate).Project(id.NewProjectID()).User(accountdomain.NewUserID()).MustBuild()
+	j.Start()
+	j.SetProgress(job.NewProgress(5, 10))
+
+	assert.Equal(t, &Job{
+		Id:        j.ID().Ref(),
+		ProjectId: j.ProjectID().Ref(),
+		Type:      new(JobTypeBulkUpdate),
+		Status:    new(JobStatusInProgress),
+		Progress: &struct {
+			Processed *int `json:"processed,omitempty"`
+			Total     *int `json:"total,omitempty"`
+		}{Processed: new(5), Total: new(10)},
+		CreatedAt: new(j.CreatedAt()),
+		UpdatedAt: new(j.UpdatedAt()),
+		StartedAt: j.StartedAt(),
+	}, NewJob(j))
+
+	j.Complete(lo.Must((&job.BulkUpdateResult{Total: 10, Updated: 10}).ToJSON()))
+	got := NewJob(j)
+	assert.Equal(t, new(JobStatusCompleted), got.Status)
+	assert.Equal(t, map[string]any{"total": float64(10), "updated": float64(10), "skipped": float64(0), "failed": float64(0)}, got.Result)
+	assert.Equal(t, j.CompletedAt(), got.CompletedAt)
+}
@@ -0,0 +1,45 @@
+package integrationapi
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/samber/lo"
+)
+
+type Request struct {
+	ID          id.RequestID `json:"id"`
+	ProjectID   id.ProjectID `json:"projectId"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	State       string       `json:"state"`
+	CreatedBy   string       `json:"createdBy"`
+	Reviewers   []string     `json:"reviewers"`
+	Items       []id.ItemID  `json:"items"`
+	ThreadID    *id.ThreadID `json:"threadId,omitempty"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	UpdatedAt   time.Time    `json:"updatedAt"`
+	ApprovedAt  *time.Time   `json:"approvedAt,omitempty"`
+	ClosedAt    *time.Time   `json:"closedAt,omitempty"`
+}
+
+func NewRequest(r *request.Request) Request {
+	return Request{
+		ID:          r.ID(),
+		ProjectID:   r.Project(),
+		Title:       r.Title(),
+		Description: r.Description(),
+		State:       r.State().String(),
+		CreatedBy:   r.CreatedBy().String(),
+		Reviewers:   r.Reviewers().Strings(),
+		Items: lo.Map(r.Items(), func(i *request.Item, _ int) id.ItemID {
+			return i.Item()
+		}),
+		ThreadID:   r.Thread(),
+		CreatedAt:  r.CreatedAt(),
+		UpdatedAt:  r.UpdatedAt(),
+		ApprovedAt: r.ApprovedAt(),
+		ClosedAt:   r.ClosedAt(),
+	}
+}
@@ -0,0 +1,42 @@
+package integrationapi
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRequest(t *testing.T) {
+	uid := accountdomain.NewUserID()
+	iid := id.NewItemID()
+	thid := id.NewThreadID()
+	r := request.New().
+		NewID().
+		Workspace(accountdomain.NewWorkspaceID()).
+		Project(id.NewProjectID()).
+		CreatedBy(uid).
+		Reviewers(accountdomain.UserIDList{uid}).
+		Items(request.ItemList{lo.Must(request.NewItem(iid, nil))}).
+		Title("title").
+		Description("desc").
+		Thread(thid.Ref()).
+		MustBuild()
+
+	assert.Equal(t, Request{
+		ID:          r.ID(),
+		ProjectID:   r.Project(),
+		Title:       "title",
+		Description: "desc",
+		State:       "waiting",
+		CreatedBy:   uid.String(),
+		Reviewers:   []string{uid.String()},
+		Items:       []id.ItemID{iid},
+		ThreadID:    thid.Ref(),
+		CreatedAt:   r.CreatedAt(),
+		UpdatedAt:   r.UpdatedAt(),
+	}, NewRequest(r))
+}
@@ -0,0 +1,30 @@
+package integrationapi
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/samber/lo"
+)
+
+func NewSchedule(s *schedule.Schedule) *Schedule {
+	if s == nil {
+		return nil
+	}
+
+	return &Schedule{
+		Id:          s.ID().Ref(),
+		ProjectId:   s.Project().Ref(),
+		ModelId:     s.Model().Ref(),
+		ItemIds:     new([]schedule.ItemID(s.Items())),
+		Action:      new(ScheduleAction(s.Action())),
+		Status:      new(ScheduleStatus(s.Status())),
+		ScheduledAt: new(s.ScheduledAt()),
+		Error:       lo.EmptyableToPtr(s.Error()),
+		CreatedAt:   new(s.CreatedAt()),
+		UpdatedAt:   new(s.UpdatedAt()),
+		ExecutedAt:  s.ExecutedAt(),
+	}
+}
+
+func ToScheduleStatus(s *ScheduleStatus) *schedule.Status {
+	return schedule.StatusFromRef((*string)(s))
+}
@@ -0,0 +1,87 @@
+package integrationapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSchedule(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond)
+	iid := id.NewItemID()
+	s := schedule.New().
+		NewID().
+		Project(id.NewProjectID()).
+		Model(id.NewModelID()).
+		Items(id.ItemIDList{iid}).
+		Action(schedule.ActionPublish).
+		ScheduledAt(now).
+		User(accountdomain.NewUserID()).
+		UpdatedAt(now).
+		MustBuild()
+
+	s2 := s.Clone()
+	s2.Fail("failed")
+
+	tests := []struct {
+		name  string
+		input *schedule.Schedule
+		want  *Schedule
+	}{
+		{
+			name:  "nil",
+			input: nil,
+			want:  nil,
+		},
+		{
+			name:  "pending",
+			input: s,
+			want: &Schedule{
+				Id:          s.ID().Ref(),
+				ProjectId:   s.Project().Ref(),
+				ModelId:     s.Model().Ref(),
+				ItemIds:     &[]id.ItemID{iid},
+				Action:      new(ScheduleActionPublish),
+				Status:      new(ScheduleStatusPending),
+				ScheduledAt: new(now),
+				CreatedAt:   new(s.CreatedAt()),
+				UpdatedAt:   new(now),
+			},
+		},
+		{
+			name:  "failed",
+			input: s2,
+			want: &Schedule{
+				Id:          s2.ID().Ref(),
+				ProjectId:   s2.Project().Ref(),
+				ModelId:     s2.Model().Ref(),
+				ItemIds:     &[]id.ItemID{iid},
+				Action:      new(ScheduleActionPublish),
+				Status:      new(ScheduleStatusFailed),
+				ScheduledAt: new(now),
+				Error:       new("failed"),
+				CreatedAt:   new(s2.CreatedAt()),
+				UpdatedAt:   new(s2.UpdatedAt()),
+				ExecutedAt:  s2.ExecutedAt(),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, NewSchedule(tt.input))
+		})
+	}
+}
+
+func TestToScheduleStatus(t *testing.T) {
+	assert.Nil(t, ToScheduleStatus(nil))
+	assert.Equal(t, new(schedule.StatusPending), ToScheduleStatus(new(ScheduleStatusPending)))
+	assert.Equal(t, new(schedule.StatusCancelled), ToScheduleStatus(new(ScheduleStatusCancelled)))
+	assert.Nil(t, ToScheduleStatus(new(ScheduleStatus("unknown"))))
+}
@@ -3,6 +3,7 @@ package integrationapi
 import (
 	"time"
 
+	"github.com/oapi-codegen/runtime/types"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
 	"github.com/reearth/reearth-cms/server/pkg/model"
@@ -18,10 +19,12 @@ type ItemModelSchema struct {
 	Model           Model            `json:"model"`
 	Schema          Schema           `json:"schema"`
 	Changes         []FieldChange    `json:"changes,omitempty"`
+	RestoredFrom    *types.UUID      `json:"restoredFrom,omitempty"`
 }
 
 type FieldChange struct {
 	ID            item.FieldID         `json:"id"`
+	Group         *item.ItemGroupID    `json:"group,omitempty"`
 	Type          item.FieldChangeType `json:"type"`
 	CurrentValue  any                  `json:"currentValue"`
 	PreviousValue any                  `json:"previousValue"`
@@ -34,15 +37,34 @@ type ItemModelSchemaItemChange struct {
 	Changes []FieldChange `json:"changes"`
 }
 
+type FieldModelSchema struct {
+	Field  SchemaField `json:"field"`
+	Schema Schema      `json:"schema"`
+	Model  *Model      `json:"model,omitempty"`
+}
+
+func NewFieldModelSchema(f schema.FieldModelSchema) FieldModelSchema {
+	var m *Model
+	if f.Model != nil {
+		m = new(NewModel(f.Model, nil, time.Time{}))
+	}
+	return FieldModelSchema{
+		Field:  NewSchemaField(f.Field),
+		Schema: NewSchema(f.Schema),
+		Model:  m,
+	}
+}
+
 func NewItemModelSchema(i item.ItemModelSchema, assets *AssetContext) ItemModelSchema {
 	return ItemModelSchema{
 		Item: NewItem(i.Item, append(i.GroupSchemas, i.Schema), assets),
 		ReferencedItems: lo.Map(i.ReferencedItems, func(itm *version.Value[*item.Item], _ int) *VersionedItem {
 			return new(NewVersionedItem(itm, nil, nil, nil, nil, nil, nil))
 		}),
-		Model:   NewModel(i.Model, nil, time.Time{}),
-		Schema:  NewSchema(i.Schema),
-		Changes: NewItemFieldChanges(i.Changes),
+		Model:        NewModel(i.Model, nil, time.Time{}),
+		Schema:       NewSchema(i.Schema),
+		Changes:      NewItemFieldChanges(i.Changes),
+		RestoredFrom: (*types.UUID)(i.RestoredFrom),
 	}
 }
 
@@ -105,6 +127,7 @@ func NewItemFieldChanges(changes item.FieldChanges) []FieldChange {
 	for _, change := range changes {
 		transformedChanges = append(transformedChanges, FieldChange{
 			ID:            change.ID,
+			Group:         change.ItemGroup,
 			CurrentValue:  change.CurrentValue.Interface(),
 			PreviousValue: change.PreviousValue.Interface(),
 			Type:          change.Type,
@@ -4,11 +4,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/oapi-codegen/runtime/types"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
 	"github.com/reearth/reearth-cms/server/pkg/model"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
@@ -91,6 +93,23 @@ func TestNewModel(t *testing.T) {
 	}
 }
 
+func TestNewItemModelSchema(t *testing.T) {
+	t.Parallel()
+
+	pID := id.NewProjectID()
+	s := schema.New().NewID().Project(pID).Workspace(accountdomain.NewWorkspaceID()).MustBuild()
+	m := model.New().NewID().Project(pID).Schema(s.ID()).Key(id.NewKey("mmm123")).MustBuild()
+	i := item.New().NewID().Project(pID).Model(m.ID()).Schema(s.ID()).User(accountdomain.NewUserID()).Thread(id.NewThreadID().Ref()).MustBuild()
+	v := version.New()
+
+	res := NewItemModelSchema(item.ItemModelSchema{Item: i, Model: m, Schema: s}, nil)
+	assert.Nil(t, res.RestoredFrom)
+
+	res = NewItemModelSchema(item.ItemModelSchema{Item: i, Model: m, Schema: s, RestoredFrom: &v}, nil)
+	assert.Equal(t, new(types.UUID(v)), res.RestoredFrom)
+	assert.Equal(t, i.ID().Ref(), res.Item.Id)
+}
+
 func TestNewItemFieldChanges(t *testing.T) {
 
 	fID := id.NewFieldID()
@@ -194,3 +213,19 @@ func TestNewSchemaField(t *testing.T) {
 		})
 	}
 }
+
+func TestNewFieldModelSchema(t *testing.T) {
+	pID := id.NewProjectID()
+	sf := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("title")).Name("Title").MustBuild()
+	s := schema.New().NewID().Project(pID).Workspace(accountdomain.NewWorkspaceID()).Fields([]*schema.Field{sf}).MustBuild()
+	m := model.New().NewID().Project(pID).Schema(s.ID()).Key(id.NewKey("model")).MustBuild()
+
+	got := NewFieldModelSchema(schema.FieldModelSchema{Field: sf, Schema: s, Model: m})
+	assert.Equal(t, NewSchemaField(sf), got.Field)
+	assert.Equal(t, NewSchema(s), got.Schema)
+	assert.Equal(t, new(NewModel(m, nil, time.Time{})), got.Model)
+
+	// the schema of a group does not have a model
+	got = NewFieldModelSchema(schema.FieldModelSchema{Field: sf, Schema: s})
+	assert.Nil(t, got.Model)
+}
@@ -0,0 +1,19 @@
+package integrationapi
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+)
+
+func NewTrashedItem(e *trash.Entry, expiresAt *time.Time, i VersionedItem) *TrashedItem {
+	if e == nil {
+		return nil
+	}
+
+	return &TrashedItem{
+		Item:      &i,
+		TrashedAt: new(e.TrashedAt()),
+		ExpiresAt: expiresAt,
+	}
+}
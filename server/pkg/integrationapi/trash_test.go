@@ -0,0 +1,38 @@
+package integrationapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTrashedItem(t *testing.T) {
+	t.Parallel()
+
+	iid := id.NewItemID()
+	at := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+	expiresAt := at.Add(24 * time.Hour)
+	e := trash.New().
+		Item(iid).
+		Project(id.NewProjectID()).
+		Model(id.NewModelID()).
+		User(accountdomain.NewUserID()).
+		TrashedAt(at).
+		MustBuild()
+	vi := VersionedItem{Id: iid.Ref()}
+
+	assert.Nil(t, NewTrashedItem(nil, nil, vi))
+	assert.Equal(t, &TrashedItem{
+		Item:      &vi,
+		TrashedAt: &at,
+		ExpiresAt: &expiresAt,
+	}, NewTrashedItem(e, &expiresAt, vi))
+	assert.Equal(t, &TrashedItem{
+		Item:      &vi,
+		TrashedAt: &at,
+	}, NewTrashedItem(e, nil, vi))
+}
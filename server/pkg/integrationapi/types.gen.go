@@ -46,6 +46,13 @@ const (
 	Unknown    AssetPreviewType = "unknown"
 )
 
+// Defines values for AssetFolderPermission.
+const (
+	AssetFolderPermissionMaintainer AssetFolderPermission = "maintainer"
+	AssetFolderPermissionOwner      AssetFolderPermission = "owner"
+	AssetFolderPermissionWriter     AssetFolderPermission = "writer"
+)
+
 // Defines values for AssetEmbedding.
 const (
 	All   AssetEmbedding = "all"
@@ -93,6 +100,13 @@ const (
 	LessThanOrEqualTo    ConditionNumberOperator = "lessThanOrEqualTo"
 )
 
+// Defines values for ConditionSpatialOperator.
+const (
+	Bbox       ConditionSpatialOperator = "bbox"
+	Intersects ConditionSpatialOperator = "intersects"
+	Near       ConditionSpatialOperator = "near"
+)
+
 // Defines values for ConditionStringOperator.
 const (
 	Contains      ConditionStringOperator = "contains"
@@ -114,6 +128,14 @@ const (
 	OfThisYear  ConditionTimeOperator = "ofThisYear"
 )
 
+// Defines values for DroppedFieldReason.
+const (
+	Invalid           DroppedFieldReason = "invalid"
+	ReferenceNotFound DroppedFieldReason = "reference_not_found"
+	Removed           DroppedFieldReason = "removed"
+	TypeChanged       DroppedFieldReason = "type_changed"
+)
+
 // Defines values for FieldSelectorType.
 const (
 	FieldSelectorTypeCreationDate     FieldSelectorType = "creationDate"
@@ -126,6 +148,29 @@ const (
 	FieldSelectorTypeStatus           FieldSelectorType = "status"
 )
 
+// Defines values for ItemFieldChangeType.
+const (
+	ItemFieldChangeTypeAdd    ItemFieldChangeType = "add"
+	ItemFieldChangeTypeDelete ItemFieldChangeType = "delete"
+	ItemFieldChangeTypeUpdate ItemFieldChangeType = "update"
+)
+
+// Defines values for JobStatus.
+const (
+	JobStatusCancelled  JobStatus = "cancelled"
+	JobStatusCompleted  JobStatus = "completed"
+	JobStatusFailed     JobStatus = "failed"
+	JobStatusInProgress JobStatus = "in_progress"
+	JobStatusPending    JobStatus = "pending"
+)
+
+// Defines values for JobType.
+const (
+	JobTypeBulkUpdate JobType = "bulk_update"
+	JobTypeExport     JobType = "export"
+	JobTypeImport     JobType = "import"
+)
+
 // Defines values for ProjectRequestRole.
 const (
 	MAINTAINER ProjectRequestRole = "MAINTAINER"
@@ -140,6 +185,20 @@ const (
 	RefOrVersionRefPublic RefOrVersionRef = "public"
 )
 
+// Defines values for ScheduleAction.
+const (
+	ScheduleActionPublish   ScheduleAction = "publish"
+	ScheduleActionUnpublish ScheduleAction = "unpublish"
+)
+
+// Defines values for ScheduleStatus.
+const (
+	ScheduleStatusCancelled ScheduleStatus = "cancelled"
+	ScheduleStatusCompleted ScheduleStatus = "completed"
+	ScheduleStatusFailed    ScheduleStatus = "failed"
+	ScheduleStatusPending   ScheduleStatus = "pending"
+)
+
 // Defines values for ValueType.
 const (
 	ValueTypeAsset          ValueType = "asset"
@@ -161,6 +220,14 @@ const (
 	ValueTypeUrl            ValueType = "url"
 )
 
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "dead"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusRetrying  WebhookDeliveryStatus = "retrying"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+)
+
 // Defines values for RefParam.
 const (
 	RefParamLatest RefParam = "latest"
@@ -302,11 +369,15 @@ type Asset struct {
 	ContentType             *string                       `json:"contentType,omitempty"`
 	CreatedAt               time.Time                     `json:"createdAt"`
 	File                    *File                         `json:"file,omitempty"`
+	FolderId                *id.AssetFolderID             `json:"folderId,omitempty"`
+	FolderPath              *string                       `json:"folderPath,omitempty"`
 	Id                      id.AssetID                    `json:"id"`
+	Metadata                *map[string]interface{}       `json:"metadata,omitempty"`
 	Name                    *string                       `json:"name,omitempty"`
 	PreviewType             *AssetPreviewType             `json:"previewType,omitempty"`
 	ProjectId               id.ProjectID                  `json:"projectId"`
 	Public                  bool                          `json:"public"`
+	ThumbnailUrl            *string                       `json:"thumbnailUrl,omitempty"`
 	TotalSize               *float32                      `json:"totalSize,omitempty"`
 	UpdatedAt               time.Time                     `json:"updatedAt"`
 	Url                     string                        `json:"url"`
@@ -318,6 +389,20 @@ type AssetArchiveExtractionStatus string
 // AssetPreviewType defines model for Asset.PreviewType.
 type AssetPreviewType string
 
+// AssetFolder defines model for assetFolder.
+type AssetFolder struct {
+	CreatedAt  time.Time              `json:"createdAt"`
+	Id         id.AssetFolderID       `json:"id"`
+	Name       string                 `json:"name"`
+	ParentId   *id.AssetFolderID      `json:"parentId,omitempty"`
+	Path       string                 `json:"path"`
+	Permission *AssetFolderPermission `json:"permission,omitempty"`
+	UpdatedAt  time.Time              `json:"updatedAt"`
+}
+
+// AssetFolderPermission defines model for AssetFolder.Permission.
+type AssetFolderPermission string
+
 // AssetEmbedding defines model for assetEmbedding.
 type AssetEmbedding string
 
@@ -328,11 +413,28 @@ type Comment struct {
 	Content    *string            `json:"content,omitempty"`
 	CreatedAt  *time.Time         `json:"createdAt,omitempty"`
 	Id         *id.CommentID      `json:"id,omitempty"`
+	Mentions   *[]CommentMention  `json:"mentions,omitempty"`
+	Reactions  *[]CommentReaction `json:"reactions,omitempty"`
+	ReplyTo    *id.CommentID      `json:"replyTo,omitempty"`
 }
 
 // CommentAuthorType defines model for Comment.AuthorType.
 type CommentAuthorType string
 
+// CommentMention defines model for commentMention.
+type CommentMention struct {
+	Name       *string `json:"name,omitempty"`
+	TargetId   *any    `json:"targetId,omitempty"`
+	TargetType *string `json:"targetType,omitempty"`
+}
+
+// CommentReaction defines model for commentReaction.
+type CommentReaction struct {
+	AuthorId   *any    `json:"authorId,omitempty"`
+	AuthorType *string `json:"authorType,omitempty"`
+	Emoji      *string `json:"emoji,omitempty"`
+}
+
 // Condition defines model for condition.
 type Condition struct {
 	And   *[]Condition `json:"and,omitempty"`
@@ -360,7 +462,24 @@ type Condition struct {
 		Operator ConditionNumberOperator `json:"operator"`
 		Value    float32                 `json:"value"`
 	} `json:"number,omitempty"`
-	Or     *[]Condition `json:"or,omitempty"`
+	Or *[]Condition `json:"or,omitempty"`
+
+	// Spatial coordinates are [longitude, latitude] in WGS84
+	Spatial *struct {
+		// Bbox [west, south, east, north], used by bbox
+		Bbox     *[]float64               `json:"bbox,omitempty"`
+		FieldId  FieldSelector            `json:"fieldId"`
+		Operator ConditionSpatialOperator `json:"operator"`
+
+		// Point [longitude, latitude], used by near
+		Point *[]float64 `json:"point,omitempty"`
+
+		// Polygon exterior ring of the polygon, used by intersects
+		Polygon *[][]float64 `json:"polygon,omitempty"`
+
+		// Radius radius in meters, used by near
+		Radius *float64 `json:"radius,omitempty"`
+	} `json:"spatial,omitempty"`
 	String *struct {
 		FieldId  FieldSelector           `json:"fieldId"`
 		Operator ConditionStringOperator `json:"operator"`
@@ -388,12 +507,25 @@ type ConditionNullableOperator string
 // ConditionNumberOperator defines model for Condition.Number.Operator.
 type ConditionNumberOperator string
 
+// ConditionSpatialOperator defines model for Condition.Spatial.Operator.
+type ConditionSpatialOperator string
+
 // ConditionStringOperator defines model for Condition.String.Operator.
 type ConditionStringOperator string
 
 // ConditionTimeOperator defines model for Condition.Time.Operator.
 type ConditionTimeOperator string
 
+// DroppedField defines model for droppedField.
+type DroppedField struct {
+	Group  *id.ItemGroupID     `json:"group,omitempty"`
+	Id     *id.FieldID         `json:"id,omitempty"`
+	Reason *DroppedFieldReason `json:"reason,omitempty"`
+}
+
+// DroppedFieldReason defines model for DroppedField.Reason.
+type DroppedFieldReason string
+
 // Field defines model for field.
 type Field struct {
 	Group *id.ItemGroupID `json:"group,omitempty"`
@@ -447,6 +579,51 @@ type Item struct {
 	UpdatedAt       *time.Time `json:"updatedAt,omitempty"`
 }
 
+// ItemDiff defines model for itemDiff.
+type ItemDiff struct {
+	Changes *[]ItemFieldChange `json:"changes,omitempty"`
+	From    *VersionedItem     `json:"from,omitempty"`
+	To      *VersionedItem     `json:"to,omitempty"`
+}
+
+// ItemFieldChange defines model for itemFieldChange.
+type ItemFieldChange struct {
+	AddedValues   *[]interface{}       `json:"addedValues,omitempty"`
+	CurrentValue  interface{}          `json:"currentValue,omitempty"`
+	Group         *id.ItemGroupID      `json:"group,omitempty"`
+	Id            *id.FieldID          `json:"id,omitempty"`
+	PreviousValue interface{}          `json:"previousValue,omitempty"`
+	RemovedValues *[]interface{}       `json:"removedValues,omitempty"`
+	Type          *ItemFieldChangeType `json:"type,omitempty"`
+}
+
+// ItemFieldChangeType defines model for ItemFieldChange.Type.
+type ItemFieldChangeType string
+
+// Job defines model for job.
+type Job struct {
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+	Error       *string    `json:"error,omitempty"`
+	Id          *id.JobID  `json:"id,omitempty"`
+	Progress    *struct {
+		Processed *int `json:"processed,omitempty"`
+		Total     *int `json:"total,omitempty"`
+	} `json:"progress,omitempty"`
+	ProjectId *id.ProjectID `json:"projectId,omitempty"`
+	Result    interface{}   `json:"result,omitempty"`
+	StartedAt *time.Time    `json:"startedAt,omitempty"`
+	Status    *JobStatus    `json:"status,omitempty"`
+	Type      *JobType      `json:"type,omitempty"`
+	UpdatedAt *time.Time    `json:"updatedAt,omitempty"`
+}
+
+// JobStatus defines model for jobStatus.
+type JobStatus string
+
+// JobType defines model for jobType.
+type JobType string
+
 // Model defines model for model.
 type Model struct {
 	CreatedAt        *time.Time    `json:"createdAt,omitempty"`
@@ -496,6 +673,27 @@ type RefOrVersion struct {
 // RefOrVersionRef defines model for RefOrVersion.Ref.
 type RefOrVersionRef string
 
+// Schedule defines model for schedule.
+type Schedule struct {
+	Action      *ScheduleAction `json:"action,omitempty"`
+	CreatedAt   *time.Time      `json:"createdAt,omitempty"`
+	Error       *string         `json:"error,omitempty"`
+	ExecutedAt  *time.Time      `json:"executedAt,omitempty"`
+	Id          *id.ScheduleID  `json:"id,omitempty"`
+	ItemIds     *[]id.ItemID    `json:"itemIds,omitempty"`
+	ModelId     *id.ModelID     `json:"modelId,omitempty"`
+	ProjectId   *id.ProjectID   `json:"projectId,omitempty"`
+	ScheduledAt *time.Time      `json:"scheduledAt,omitempty"`
+	Status      *ScheduleStatus `json:"status,omitempty"`
+	UpdatedAt   *time.Time      `json:"updatedAt,omitempty"`
+}
+
+// ScheduleAction defines model for scheduleAction.
+type ScheduleAction string
+
+// ScheduleStatus defines model for scheduleStatus.
+type ScheduleStatus string
+
 // Schema defines model for schema.
 type Schema struct {
 	CreatedAt  *time.Time     `json:"createdAt,omitempty"`
@@ -522,6 +720,13 @@ type TagResponse struct {
 	Name  *string   `json:"name,omitempty"`
 }
 
+// TrashedItem defines model for trashedItem.
+type TrashedItem struct {
+	ExpiresAt *time.Time     `json:"expiresAt,omitempty"`
+	Item      *VersionedItem `json:"item,omitempty"`
+	TrashedAt *time.Time     `json:"trashedAt,omitempty"`
+}
+
 // ValueType defines model for valueType.
 type ValueType string
 
@@ -549,6 +754,37 @@ type VersionedItem struct {
 	Version         *openapi_types.UUID   `json:"version,omitempty"`
 }
 
+// WebhookDelivery defines model for webhookDelivery.
+type WebhookDelivery struct {
+	Attempts      *[]WebhookDeliveryAttempt `json:"attempts,omitempty"`
+	CreatedAt     *time.Time                `json:"createdAt,omitempty"`
+	EventId       *string                   `json:"eventId,omitempty"`
+	EventType     *string                   `json:"eventType,omitempty"`
+	Id            *id.WebhookDeliveryID     `json:"id,omitempty"`
+	NextAttemptAt *time.Time                `json:"nextAttemptAt,omitempty"`
+	Payload       interface{}               `json:"payload,omitempty"`
+	Status        *WebhookDeliveryStatus    `json:"status,omitempty"`
+	UpdatedAt     *time.Time                `json:"updatedAt,omitempty"`
+	Url           *string                   `json:"url,omitempty"`
+	WebhookId     *id.WebhookID             `json:"webhookId,omitempty"`
+}
+
+// WebhookDeliveryAttempt defines model for webhookDeliveryAttempt.
+type WebhookDeliveryAttempt struct {
+	AttemptedAt *time.Time `json:"attemptedAt,omitempty"`
+	Error       *string    `json:"error,omitempty"`
+
+	// Latency Latency in milliseconds
+	Latency *int `json:"latency,omitempty"`
+
+	// ResponseBody The excerpt of the response body
+	ResponseBody *string `json:"responseBody,omitempty"`
+	StatusCode   *int    `json:"statusCode,omitempty"`
+}
+
+// WebhookDeliveryStatus defines model for webhookDeliveryStatus.
+type WebhookDeliveryStatus string
+
 // AssetIdParam defines model for assetIdParam.
 type AssetIdParam = id.AssetID
 
@@ -567,6 +803,9 @@ type GroupIdOrKeyParam = group.IDOrKey
 // ItemIdParam defines model for itemIdParam.
 type ItemIdParam = id.ItemID
 
+// JobIdParam defines model for jobIdParam.
+type JobIdParam = id.JobID
+
 // KeywordParam defines model for keywordParam.
 type KeywordParam = string
 
@@ -585,6 +824,9 @@ type ProjectIdOrAliasParam = project.IDOrAlias
 // RefParam defines model for refParam.
 type RefParam string
 
+// ScheduleIdParam defines model for scheduleIdParam.
+type ScheduleIdParam = id.ScheduleID
+
 // SchemaIdParam defines model for schemaIdParam.
 type SchemaIdParam = id.SchemaID
 
@@ -594,9 +836,24 @@ type SortDirParam string
 // SortParam defines model for sortParam.
 type SortParam string
 
+// WebhookDeliveryIdParam defines model for webhookDeliveryIdParam.
+type WebhookDeliveryIdParam = id.WebhookDeliveryID
+
+// WebhookIdParam defines model for webhookIdParam.
+type WebhookIdParam = id.WebhookID
+
 // WorkspaceIdOrAliasParam defines model for workspaceIdOrAliasParam.
 type WorkspaceIdOrAliasParam = accountdomain.WorkspaceIDOrAlias
 
+// WebhookDeliveryListParams defines parameters for WebhookDeliveryList.
+type WebhookDeliveryListParams struct {
+	// Page Used to select the page
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage Used to select the page
+	PerPage *PerPageParam `form:"perPage,omitempty" json:"perPage,omitempty"`
+}
+
 // ProjectFilterParams defines parameters for ProjectFilter.
 type ProjectFilterParams struct {
 	// Page Used to select the page
@@ -675,6 +932,15 @@ type AssetFilterParams struct {
 
 	// Keyword keyword string
 	Keyword *KeywordParam `form:"keyword,omitempty" json:"keyword,omitempty"`
+
+	// FolderId Limits the assets to the ones in the folder
+	FolderId *id.AssetFolderID `form:"folderId,omitempty" json:"folderId,omitempty"`
+
+	// Recursive Includes the assets in the sub folders of the folder
+	Recursive *bool `form:"recursive,omitempty" json:"recursive,omitempty"`
+
+	// Metadata Limits the assets to the ones whose metadata matches, e.g. metadata[key]=value
+	Metadata *map[string]string `json:"metadata,omitempty"`
 }
 
 // AssetFilterParamsSort defines parameters for AssetFilter.
@@ -685,10 +951,11 @@ type AssetFilterParamsDir string
 
 // AssetCreateJSONBody defines parameters for AssetCreate.
 type AssetCreateJSONBody struct {
-	ContentEncoding   *string `json:"contentEncoding,omitempty"`
-	SkipDecompression *bool   `json:"skipDecompression"`
-	Token             *string `json:"token,omitempty"`
-	Url               *string `json:"url,omitempty"`
+	ContentEncoding   *string           `json:"contentEncoding,omitempty"`
+	FolderId          *id.AssetFolderID `json:"folderId,omitempty"`
+	SkipDecompression *bool             `json:"skipDecompression"`
+	Token             *string           `json:"token,omitempty"`
+	Url               *string           `json:"url,omitempty"`
 }
 
 // AssetCreateMultipartBody defines parameters for AssetCreate.
@@ -696,9 +963,16 @@ type AssetCreateMultipartBody struct {
 	ContentEncoding   *string             `json:"contentEncoding,omitempty"`
 	ContentType       *string             `json:"contentType,omitempty"`
 	File              *openapi_types.File `json:"file,omitempty"`
+	FolderId          *id.AssetFolderID   `json:"folderId,omitempty"`
 	SkipDecompression *bool               `json:"skipDecompression,omitempty"`
 }
 
+// AssetFolderCreateJSONBody defines parameters for AssetFolderCreate.
+type AssetFolderCreateJSONBody struct {
+	Name     string            `json:"name"`
+	ParentId *id.AssetFolderID `json:"parentId,omitempty"`
+}
+
 // AssetUploadCreateJSONBody defines parameters for AssetUploadCreate.
 type AssetUploadCreateJSONBody struct {
 	ContentEncoding *string `json:"contentEncoding,omitempty"`
@@ -710,7 +984,8 @@ type AssetUploadCreateJSONBody struct {
 
 // AssetCommentCreateJSONBody defines parameters for AssetCommentCreate.
 type AssetCommentCreateJSONBody struct {
-	Content *string `json:"content,omitempty"`
+	Content *string       `json:"content,omitempty"`
+	ReplyTo *id.CommentID `json:"replyTo,omitempty"`
 }
 
 // AssetCommentUpdateJSONBody defines parameters for AssetCommentUpdate.
@@ -918,6 +1193,12 @@ type ItemsAsGeoJSONParams struct {
 // ItemsAsGeoJSONParamsRef defines parameters for ItemsAsGeoJSON.
 type ItemsAsGeoJSONParamsRef string
 
+// ItemBulkUpdateJSONBody defines parameters for ItemBulkUpdate.
+type ItemBulkUpdateJSONBody struct {
+	Fields []Field    `json:"fields"`
+	Filter *Condition `json:"filter,omitempty"`
+}
+
 // ItemFilterPostJSONBody defines parameters for ItemFilterPost.
 type ItemFilterPostJSONBody struct {
 	Filter *Condition `json:"filter,omitempty"`
@@ -956,6 +1237,25 @@ type ItemFilterPostParamsDir string
 // ItemFilterPostParamsRef defines parameters for ItemFilterPost.
 type ItemFilterPostParamsRef string
 
+// ItemTrashListParams defines parameters for ItemTrashList.
+type ItemTrashListParams struct {
+	// Page Used to select the page
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage Used to select the page
+	PerPage *PerPageParam `form:"perPage,omitempty" json:"perPage,omitempty"`
+}
+
+// ItemTrashPurgeJSONBody defines parameters for ItemTrashPurge.
+type ItemTrashPurgeJSONBody struct {
+	ItemIds []id.ItemID `json:"itemIds"`
+}
+
+// ItemTrashRestoreJSONBody defines parameters for ItemTrashRestore.
+type ItemTrashRestoreJSONBody struct {
+	ItemIds []id.ItemID `json:"itemIds"`
+}
+
 // ItemGetParams defines parameters for ItemGet.
 type ItemGetParams struct {
 	// Ref Used to select a ref or ver
@@ -977,7 +1277,8 @@ type ItemUpdateJSONBody struct {
 
 // ItemCommentCreateJSONBody defines parameters for ItemCommentCreate.
 type ItemCommentCreateJSONBody struct {
-	Content *string `json:"content,omitempty"`
+	Content *string       `json:"content,omitempty"`
+	ReplyTo *id.CommentID `json:"replyTo,omitempty"`
 }
 
 // ItemCommentUpdateJSONBody defines parameters for ItemCommentUpdate.
@@ -985,12 +1286,33 @@ type ItemCommentUpdateJSONBody struct {
 	Content *string `json:"content,omitempty"`
 }
 
+// ItemDiffParams defines parameters for ItemDiff.
+type ItemDiffParams struct {
+	// From version id or ref (latest, public) to compare from
+	From string `form:"from" json:"from"`
+
+	// To version id or ref (latest, public) to compare to, latest by default
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // ItemPublishParams defines parameters for ItemPublish.
 type ItemPublishParams struct {
 	// Asset Specifies whether asset data are embedded in the results
 	Asset *AssetParam `form:"asset,omitempty" json:"asset,omitempty"`
 }
 
+// ScheduleListParams defines parameters for ScheduleList.
+type ScheduleListParams struct {
+	Status *ScheduleStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ScheduleCreateJSONBody defines parameters for ScheduleCreate.
+type ScheduleCreateJSONBody struct {
+	Action      ScheduleAction `json:"action"`
+	ItemIds     []id.ItemID    `json:"itemIds"`
+	ScheduledAt time.Time      `json:"scheduledAt"`
+}
+
 // FieldCreateJSONBody defines parameters for FieldCreate.
 type FieldCreateJSONBody struct {
 	Key      *string    `json:"key,omitempty"`
@@ -1022,6 +1344,9 @@ type AssetCreateJSONRequestBody AssetCreateJSONBody
 // AssetCreateMultipartRequestBody defines body for AssetCreate for multipart/form-data ContentType.
 type AssetCreateMultipartRequestBody AssetCreateMultipartBody
 
+// AssetFolderCreateJSONRequestBody defines body for AssetFolderCreate for application/json ContentType.
+type AssetFolderCreateJSONRequestBody AssetFolderCreateJSONBody
+
 // AssetUploadCreateJSONRequestBody defines body for AssetUploadCreate for application/json ContentType.
 type AssetUploadCreateJSONRequestBody AssetUploadCreateJSONBody
 
@@ -1055,9 +1380,18 @@ type ModelImportMultipartRequestBody ModelImportMultipartBody
 // ItemCreateJSONRequestBody defines body for ItemCreate for application/json ContentType.
 type ItemCreateJSONRequestBody ItemCreateJSONBody
 
+// ItemBulkUpdateJSONRequestBody defines body for ItemBulkUpdate for application/json ContentType.
+type ItemBulkUpdateJSONRequestBody ItemBulkUpdateJSONBody
+
 // ItemFilterPostJSONRequestBody defines body for ItemFilterPost for application/json ContentType.
 type ItemFilterPostJSONRequestBody ItemFilterPostJSONBody
 
+// ItemTrashPurgeJSONRequestBody defines body for ItemTrashPurge for application/json ContentType.
+type ItemTrashPurgeJSONRequestBody ItemTrashPurgeJSONBody
+
+// ItemTrashRestoreJSONRequestBody defines body for ItemTrashRestore for application/json ContentType.
+type ItemTrashRestoreJSONRequestBody ItemTrashRestoreJSONBody
+
 // ItemUpdateJSONRequestBody defines body for ItemUpdate for application/json ContentType.
 type ItemUpdateJSONRequestBody ItemUpdateJSONBody
 
@@ -1067,6 +1401,12 @@ type ItemCommentCreateJSONRequestBody ItemCommentCreateJSONBody
 // ItemCommentUpdateJSONRequestBody defines body for ItemCommentUpdate for application/json ContentType.
 type ItemCommentUpdateJSONRequestBody ItemCommentUpdateJSONBody
 
+// ItemRestoreJSONRequestBody defines body for ItemRestore for application/json ContentType.
+type ItemRestoreJSONRequestBody = RefOrVersion
+
+// ScheduleCreateJSONRequestBody defines body for ScheduleCreate for application/json ContentType.
+type ScheduleCreateJSONRequestBody ScheduleCreateJSONBody
+
 // FieldCreateJSONRequestBody defines body for FieldCreate for application/json ContentType.
 type FieldCreateJSONRequestBody FieldCreateJSONBody
 
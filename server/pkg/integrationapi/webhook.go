@@ -0,0 +1,117 @@
+package integrationapi
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/thread"
+	"github.com/samber/lo"
+)
+
+// WebhookMinimalData is the data of the webhooks which only request the ids of the changed entity.
+type WebhookMinimalData struct {
+	ID        string  `json:"id"`
+	ProjectID *string `json:"projectId,omitempty"`
+	ModelID   *string `json:"modelId,omitempty"`
+	SchemaID  *string `json:"schemaId,omitempty"`
+	ThreadID  *string `json:"threadId,omitempty"`
+}
+
+// NewWebhookData renders the object of an event in the shape which the webhook requests.
+// Objects which have no minimal or projected variant are rendered in full.
+func NewWebhookData(obj any, shape integration.WebhookPayloadShape) (any, error) {
+	switch shape.Type() {
+	case integration.WebhookPayloadTypeMinimal:
+		if d, ok := newWebhookMinimalData(obj); ok {
+			return d, nil
+		}
+	case integration.WebhookPayloadTypeProjection:
+		if o, ok := obj.(item.ItemModelSchema); ok {
+			return projectItemModelSchema(o, shape), nil
+		}
+	}
+	return New(obj, "")
+}
+
+func newWebhookMinimalData(obj any) (WebhookMinimalData, bool) {
+	switch o := obj.(type) {
+	case item.ItemModelSchema:
+		return newWebhookMinimalData(o.Item)
+	case *item.Item:
+		return WebhookMinimalData{
+			ID:        o.ID().String(),
+			ProjectID: new(o.Project().String()),
+			ModelID:   new(o.Model().String()),
+			SchemaID:  new(o.Schema().String()),
+			ThreadID:  o.Thread().StringRef(),
+		}, true
+	case *model.Model:
+		return WebhookMinimalData{
+			ID:        o.ID().String(),
+			ProjectID: new(o.Project().String()),
+			SchemaID:  new(o.Schema().String()),
+		}, true
+	case schema.FieldModelSchema:
+		d := WebhookMinimalData{
+			ID:        o.Field.ID().String(),
+			ProjectID: new(o.Schema.Project().String()),
+			SchemaID:  new(o.Schema.ID().String()),
+		}
+		if o.Model != nil {
+			d.ModelID = new(o.Model.ID().String())
+		}
+		return d, true
+	case *request.Request:
+		return WebhookMinimalData{
+			ID:        o.ID().String(),
+			ProjectID: new(o.Project().String()),
+			ThreadID:  o.Thread().StringRef(),
+		}, true
+	case thread.CommentThread:
+		if o.Comment == nil {
+			return WebhookMinimalData{}, false
+		}
+		return WebhookMinimalData{
+			ID:       o.Comment.ID().String(),
+			ThreadID: new(o.Thread.ID().String()),
+		}, true
+	case *asset.Asset:
+		return WebhookMinimalData{
+			ID:        o.ID().String(),
+			ProjectID: new(o.Project().String()),
+			ThreadID:  o.Thread().StringRef(),
+		}, true
+	}
+	return WebhookMinimalData{}, false
+}
+
+func projectItemModelSchema(i item.ItemModelSchema, shape integration.WebhookPayloadShape) ItemModelSchema {
+	res := NewItemModelSchema(i, nil)
+
+	hasField := func(f Field) bool {
+		return f.Key != nil && shape.HasField(*f.Key)
+	}
+	if res.Item.Fields != nil {
+		res.Item.Fields = new(lo.Filter(*res.Item.Fields, func(f Field, _ int) bool { return hasField(f) }))
+	}
+	if res.Item.MetadataFields != nil {
+		res.Item.MetadataFields = new(lo.Filter(*res.Item.MetadataFields, func(f Field, _ int) bool { return hasField(f) }))
+	}
+
+	schemas := append(schema.List{i.Schema}, i.GroupSchemas...)
+	res.Changes = lo.Filter(res.Changes, func(c FieldChange, _ int) bool {
+		for _, s := range schemas {
+			if s == nil {
+				continue
+			}
+			if f := s.Field(c.ID); f != nil {
+				return shape.HasField(f.Key().String())
+			}
+		}
+		return false
+	})
+	return res
+}
@@ -0,0 +1,43 @@
+package integrationapi
+
+import (
+	"encoding/json"
+
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/samber/lo"
+)
+
+func NewWebhookDelivery(d *integration.WebhookDelivery) *WebhookDelivery {
+	if d == nil {
+		return nil
+	}
+
+	var payload any
+	if p := d.Payload(); len(p) > 0 {
+		_ = json.Unmarshal(p, &payload)
+	}
+
+	attempts := lo.Map(d.Attempts(), func(a *integration.WebhookDeliveryAttempt, _ int) WebhookDeliveryAttempt {
+		return WebhookDeliveryAttempt{
+			AttemptedAt:  new(a.AttemptedAt),
+			StatusCode:   lo.EmptyableToPtr(a.StatusCode),
+			Latency:      new(int(a.Latency.Milliseconds())),
+			ResponseBody: lo.EmptyableToPtr(a.ResponseBody),
+			Error:        lo.EmptyableToPtr(a.Error),
+		}
+	})
+
+	return &WebhookDelivery{
+		Id:            d.ID().Ref(),
+		WebhookId:     d.Webhook().Ref(),
+		EventId:       new(d.Event().String()),
+		EventType:     new(string(d.EventType())),
+		Url:           new(d.URL()),
+		Payload:       payload,
+		Status:        new(WebhookDeliveryStatus(d.Status())),
+		Attempts:      &attempts,
+		NextAttemptAt: d.NextAttemptAt(),
+		CreatedAt:     new(d.CreatedAt()),
+		UpdatedAt:     new(d.UpdatedAt()),
+	}
+}
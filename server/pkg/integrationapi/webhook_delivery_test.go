@@ -0,0 +1,37 @@
+package integrationapi
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWebhookDelivery(t *testing.T) {
+	now := time.Now()
+	w := integration.NewWebhookBuilder().NewID().Url(lo.Must(url.Parse("https://example.com"))).MustBuild()
+	d := integration.NewWebhookDelivery(id.NewIntegrationID(), w, id.NewEventID(), event.ItemCreate, []byte(`{"a":1}`))
+	d.RecordAttempt(integration.WebhookDeliveryAttempt{AttemptedAt: now, Latency: 5 * time.Millisecond, Error: "timeout"})
+
+	assert.Nil(t, NewWebhookDelivery(nil))
+	assert.Equal(t, &WebhookDelivery{
+		Id:        d.ID().Ref(),
+		WebhookId: w.ID().Ref(),
+		EventId:   new(d.Event().String()),
+		EventType: new("item.create"),
+		Url:       new("https://example.com"),
+		Payload:   map[string]any{"a": float64(1)},
+		Status:    new(WebhookDeliveryStatusRetrying),
+		Attempts: &[]WebhookDeliveryAttempt{
+			{AttemptedAt: new(now), Latency: new(5), Error: new("timeout")},
+		},
+		NextAttemptAt: new(now.Add(time.Minute)),
+		CreatedAt:     new(d.CreatedAt()),
+		UpdatedAt:     new(now),
+	}, NewWebhookDelivery(d))
+}
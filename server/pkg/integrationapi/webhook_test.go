@@ -0,0 +1,73 @@
+package integrationapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWebhookData(t *testing.T) {
+	pID := id.NewProjectID()
+	f1 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("title")).MustBuild()
+	f2 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("body")).MustBuild()
+	s := schema.New().NewID().Project(pID).Workspace(accountdomain.NewWorkspaceID()).Fields([]*schema.Field{f1, f2}).MustBuild()
+	m := model.New().NewID().Project(pID).Schema(s.ID()).Key(id.NewKey("mmm123")).MustBuild()
+	i := item.New().NewID().Project(pID).Model(m.ID()).Schema(s.ID()).User(accountdomain.NewUserID()).Fields([]*item.Field{
+		item.NewField(f1.ID(), value.TypeText.Value("a").AsMultiple(), nil),
+		item.NewField(f2.ID(), value.TypeText.Value("b").AsMultiple(), nil),
+	}).MustBuild()
+	ims := item.ItemModelSchema{
+		Item:   i,
+		Model:  m,
+		Schema: s,
+		Changes: item.FieldChanges{
+			{ID: f1.ID(), Type: item.FieldChangeTypeUpdate},
+			{ID: f2.ID(), Type: item.FieldChangeTypeUpdate},
+		},
+	}
+
+	// full
+	res, err := NewWebhookData(ims, integration.WebhookPayloadShape{})
+	assert.NoError(t, err)
+	assert.Equal(t, NewItemModelSchema(ims, nil), res)
+
+	// minimal
+	res, err = NewWebhookData(ims, integration.NewWebhookPayloadShape(integration.WebhookPayloadTypeMinimal, nil))
+	assert.NoError(t, err)
+	assert.Equal(t, WebhookMinimalData{
+		ID:        i.ID().String(),
+		ProjectID: new(pID.String()),
+		ModelID:   new(m.ID().String()),
+		SchemaID:  new(s.ID().String()),
+	}, res)
+
+	res, err = NewWebhookData(m, integration.NewWebhookPayloadShape(integration.WebhookPayloadTypeMinimal, nil))
+	assert.NoError(t, err)
+	assert.Equal(t, WebhookMinimalData{
+		ID:        m.ID().String(),
+		ProjectID: new(pID.String()),
+		SchemaID:  new(s.ID().String()),
+	}, res)
+
+	// projection
+	res, err = NewWebhookData(ims, integration.NewWebhookPayloadShape(integration.WebhookPayloadTypeProjection, []string{"title"}))
+	assert.NoError(t, err)
+	p := res.(ItemModelSchema)
+	assert.Len(t, *p.Item.Fields, 1)
+	assert.Equal(t, "title", *(*p.Item.Fields)[0].Key)
+	assert.Len(t, p.Changes, 1)
+	assert.Equal(t, f1.ID(), p.Changes[0].ID)
+
+	// objects without a projection are rendered in full
+	res, err = NewWebhookData(m, integration.NewWebhookPayloadShape(integration.WebhookPayloadTypeProjection, []string{"title"}))
+	assert.NoError(t, err)
+	assert.Equal(t, NewModel(m, nil, time.Time{}), res)
+}
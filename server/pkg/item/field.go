@@ -1,15 +1,22 @@
 package item
 
 import (
+	"slices"
+
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
 )
 
+// LocalizedValues holds the values of a localizable field keyed by locale.
+type LocalizedValues map[string]*value.Multiple
+
 type Field struct {
-	field FieldID
-	group *ItemGroupID
-	value *value.Multiple
+	field   FieldID
+	group   *ItemGroupID
+	value   *value.Multiple
+	locales LocalizedValues
 }
 
 func NewField(field FieldID, v *value.Multiple, ig *ItemGroupID) *Field {
@@ -31,6 +38,7 @@ func (f *Field) Type() value.Type {
 	return f.value.Type()
 }
 
+// Value returns the value in the default locale of the project.
 func (f *Field) Value() *value.Multiple {
 	if f == nil {
 		return nil
@@ -45,6 +53,82 @@ func (f *Field) ItemGroup() *ItemGroupID {
 	return util.CloneRef(f.group)
 }
 
+// Locales returns the locales the field has a translated value for, sorted.
+func (f *Field) Locales() []string {
+	if f == nil || len(f.locales) == 0 {
+		return nil
+	}
+	keys := lo.Keys(f.locales)
+	slices.Sort(keys)
+	return keys
+}
+
+func (f *Field) LocalizedValues() LocalizedValues {
+	if f == nil || len(f.locales) == 0 {
+		return nil
+	}
+	return f.locales.Clone()
+}
+
+func (f *Field) LocalizedValue(locale string) *value.Multiple {
+	if f == nil {
+		return nil
+	}
+	return f.locales[locale]
+}
+
+// SetLocalizedValue sets the value of the locale. A nil value removes the translation.
+func (f *Field) SetLocalizedValue(locale string, v *value.Multiple) {
+	if f == nil || locale == "" {
+		return
+	}
+	if v == nil {
+		delete(f.locales, locale)
+		return
+	}
+	if v.Type() != f.Type() {
+		return
+	}
+	if f.locales == nil {
+		f.locales = LocalizedValues{}
+	}
+	f.locales[locale] = v
+}
+
+// ClearLocalizedValues removes all the translations, and they are not carried over when the field replaces another one.
+func (f *Field) ClearLocalizedValues() {
+	if f == nil {
+		return
+	}
+	f.locales = LocalizedValues{}
+}
+
+// ValueIn returns the first non-empty value along the locale chain,
+// falling back to the value in the default locale.
+func (f *Field) ValueIn(locales []string) *value.Multiple {
+	if f == nil {
+		return nil
+	}
+	for _, l := range locales {
+		if v := f.locales[l]; !v.IsEmpty() {
+			return v
+		}
+	}
+	return f.value
+}
+
+// Localize returns a copy of the field that has the value resolved along the locale chain as its value.
+func (f *Field) Localize(locales []string) *Field {
+	if f == nil {
+		return nil
+	}
+	return &Field{
+		field: f.field,
+		value: f.ValueIn(locales).Clone(),
+		group: util.CloneRef(f.group),
+	}
+}
+
 func (f *Field) IsGeometryField() bool {
 	return f.Type() == value.TypeGeometryObject || f.Type() == value.TypeGeometryEditor
 }
@@ -54,8 +138,20 @@ func (f *Field) Clone() *Field {
 		return nil
 	}
 	return &Field{
-		field: f.field,
-		value: f.value.Clone(),
-		group: util.CloneRef(f.group),
+		field:   f.field,
+		value:   f.value.Clone(),
+		group:   util.CloneRef(f.group),
+		locales: f.locales.Clone(),
+	}
+}
+
+func (l LocalizedValues) Clone() LocalizedValues {
+	if l == nil {
+		return nil
+	}
+	res := make(LocalizedValues, len(l))
+	for k, v := range l {
+		res[k] = v.Clone()
 	}
+	return res
 }
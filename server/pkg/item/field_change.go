@@ -2,8 +2,10 @@ package item
 
 import (
 	"slices"
+	"strings"
 
 	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
 )
 
 type FieldChangeType string
@@ -18,23 +20,32 @@ type FieldChanges []FieldChange
 
 type FieldChange struct {
 	ID            FieldID
+	ItemGroup     *ItemGroupID
 	Type          FieldChangeType
 	CurrentValue  *value.Multiple
 	PreviousValue *value.Multiple
 }
 
+type fieldChangeKey struct {
+	field FieldID
+	group ItemGroupID
+}
+
+// CompareFields returns the changes from the old fields to the new fields.
+// The fields in groups are compared for each item group.
 func CompareFields(n, o Fields) FieldChanges {
-	nFields, oFields := n.Map(), o.Map()
+	nFields, oFields := fieldsByGroup(n), fieldsByGroup(o)
 
 	changes := make([]FieldChange, 0, len(nFields)+len(oFields))
 
-	for fieldID, newField := range nFields {
-		oldField, exists := oFields[fieldID]
+	for key, newField := range nFields {
+		oldField, exists := oFields[key]
 
 		if !exists {
 			// add
 			change := FieldChange{
-				ID:            fieldID,
+				ID:            key.field,
+				ItemGroup:     newField.ItemGroup(),
 				Type:          FieldChangeTypeAdd,
 				PreviousValue: nil,
 				CurrentValue:  newField.Value(),
@@ -50,7 +61,8 @@ func CompareFields(n, o Fields) FieldChanges {
 
 		// update
 		change := FieldChange{
-			ID:            fieldID,
+			ID:            key.field,
+			ItemGroup:     newField.ItemGroup(),
 			Type:          FieldChangeTypeUpdate,
 			PreviousValue: oldField.Value(),
 			CurrentValue:  newField.Value(),
@@ -59,14 +71,15 @@ func CompareFields(n, o Fields) FieldChanges {
 		changes = append(changes, change)
 	}
 
-	for fieldID, oldField := range oFields {
-		if _, exists := nFields[fieldID]; exists {
+	for key, oldField := range oFields {
+		if _, exists := nFields[key]; exists {
 			continue
 		}
 
 		// delete
 		change := FieldChange{
-			ID:            fieldID,
+			ID:            key.field,
+			ItemGroup:     oldField.ItemGroup(),
 			Type:          FieldChangeTypeDelete,
 			PreviousValue: oldField.Value(),
 			CurrentValue:  nil,
@@ -76,8 +89,34 @@ func CompareFields(n, o Fields) FieldChanges {
 	}
 
 	slices.SortFunc(changes, func(a, b FieldChange) int {
-		return a.ID.Timestamp().Compare(b.ID.Timestamp())
+		if c := a.ID.Timestamp().Compare(b.ID.Timestamp()); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.ID.String(), b.ID.String()); c != 0 {
+			return c
+		}
+		return strings.Compare(lo.FromPtr(a.ItemGroup.StringRef()), lo.FromPtr(b.ItemGroup.StringRef()))
 	})
 
 	return changes
 }
+
+// ValueChanges returns the values added to and removed from the field by the change.
+// A value which appears several times is counted for each occurrence.
+func (c FieldChange) ValueChanges() (added, removed []*value.Value) {
+	prev := c.PreviousValue.Values()
+	for _, v := range c.CurrentValue.Values() {
+		if i := slices.IndexFunc(prev, v.Equal); i >= 0 {
+			prev = slices.Delete(prev, i, i+1)
+			continue
+		}
+		added = append(added, v)
+	}
+	return added, prev
+}
+
+func fieldsByGroup(fields Fields) map[fieldChangeKey]*Field {
+	return lo.SliceToMap(lo.Compact(fields), func(f *Field) (fieldChangeKey, *Field) {
+		return fieldChangeKey{field: f.FieldID(), group: lo.FromPtr(f.ItemGroup())}, f
+	})
+}
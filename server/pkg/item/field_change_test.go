@@ -143,3 +143,59 @@ func TestCompareFields(t *testing.T) {
 		})
 	}
 }
+
+func TestCompareFields_Group(t *testing.T) {
+	fId := id.NewFieldID()
+	ig1, ig2 := id.NewItemGroupID(), id.NewItemGroupID()
+
+	res := CompareFields(
+		Fields{
+			NewField(fId, value.TypeText.Value("a").AsMultiple(), ig1.Ref()),
+			NewField(fId, value.TypeText.Value("c").AsMultiple(), ig2.Ref()),
+		},
+		Fields{
+			NewField(fId, value.TypeText.Value("b").AsMultiple(), ig1.Ref()),
+		},
+	)
+	assert.ElementsMatch(t, FieldChanges{
+		{
+			ID:            fId,
+			ItemGroup:     ig1.Ref(),
+			Type:          FieldChangeTypeUpdate,
+			PreviousValue: value.TypeText.Value("b").AsMultiple(),
+			CurrentValue:  value.TypeText.Value("a").AsMultiple(),
+		},
+		{
+			ID:           fId,
+			ItemGroup:    ig2.Ref(),
+			Type:         FieldChangeTypeAdd,
+			CurrentValue: value.TypeText.Value("c").AsMultiple(),
+		},
+	}, res)
+}
+
+func TestFieldChange_ValueChanges(t *testing.T) {
+	a, b, c := value.TypeText.Value("a"), value.TypeText.Value("b"), value.TypeText.Value("c")
+
+	added, removed := FieldChange{
+		Type:          FieldChangeTypeUpdate,
+		PreviousValue: value.NewMultiple(value.TypeText, []any{"a", "b", "b"}),
+		CurrentValue:  value.NewMultiple(value.TypeText, []any{"b", "c", "a"}),
+	}.ValueChanges()
+	assert.Equal(t, []*value.Value{c}, added)
+	assert.Equal(t, []*value.Value{b}, removed)
+
+	added, removed = FieldChange{
+		Type:         FieldChangeTypeAdd,
+		CurrentValue: value.NewMultiple(value.TypeText, []any{"a"}),
+	}.ValueChanges()
+	assert.Equal(t, []*value.Value{a}, added)
+	assert.Empty(t, removed)
+
+	added, removed = FieldChange{
+		Type:          FieldChangeTypeDelete,
+		PreviousValue: value.NewMultiple(value.TypeText, []any{"b"}),
+	}.ValueChanges()
+	assert.Empty(t, added)
+	assert.Equal(t, []*value.Value{b}, removed)
+}
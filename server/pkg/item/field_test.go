@@ -41,6 +41,15 @@ func TestField_Clone(t *testing.T) {
 				group: &ig,
 			},
 		},
+		{
+			name: "localized field",
+			field: &Field{
+				field:   fid,
+				value:   val,
+				group:   &ig,
+				locales: LocalizedValues{"en": value.NewMultiple(value.TypeText, []any{"en"})},
+			},
+		},
 		{
 			name: "field with nil group",
 			field: &Field{
@@ -72,3 +81,64 @@ func TestField_Clone(t *testing.T) {
 		})
 	}
 }
+
+func TestField_LocalizedValue(t *testing.T) {
+	f := NewField(id.NewFieldID(), value.TypeText.Value("こんにちは").AsMultiple(), nil)
+	assert.Nil(t, f.Locales())
+	assert.Nil(t, f.LocalizedValue("en"))
+
+	f.SetLocalizedValue("en", value.TypeText.Value("hello").AsMultiple())
+	f.SetLocalizedValue("fr", value.TypeText.Value("bonjour").AsMultiple())
+	// type mismatch is ignored
+	f.SetLocalizedValue("de", value.TypeBool.Value(true).AsMultiple())
+	// empty locale is ignored
+	f.SetLocalizedValue("", value.TypeText.Value("x").AsMultiple())
+
+	assert.Equal(t, []string{"en", "fr"}, f.Locales())
+	assert.Equal(t, value.TypeText.Value("hello").AsMultiple(), f.LocalizedValue("en"))
+	assert.Equal(t, LocalizedValues{
+		"en": value.TypeText.Value("hello").AsMultiple(),
+		"fr": value.TypeText.Value("bonjour").AsMultiple(),
+	}, f.LocalizedValues())
+
+	f.SetLocalizedValue("fr", nil)
+	assert.Equal(t, []string{"en"}, f.Locales())
+
+	var nilF *Field
+	assert.Nil(t, nilF.Locales())
+	assert.Nil(t, nilF.LocalizedValue("en"))
+}
+
+func TestField_ValueIn(t *testing.T) {
+	f := NewField(id.NewFieldID(), value.TypeText.Value("こんにちは").AsMultiple(), nil)
+	f.SetLocalizedValue("en", value.TypeText.Value("hello").AsMultiple())
+	f.SetLocalizedValue("en-US", value.NewMultiple(value.TypeText, nil))
+
+	assert.Equal(t, value.TypeText.Value("hello").AsMultiple(), f.ValueIn([]string{"en-US", "en", "ja"}))
+	assert.Equal(t, value.TypeText.Value("こんにちは").AsMultiple(), f.ValueIn([]string{"fr"}))
+	assert.Equal(t, value.TypeText.Value("こんにちは").AsMultiple(), f.ValueIn(nil))
+
+	var nilF *Field
+	assert.Nil(t, nilF.ValueIn([]string{"en"}))
+}
+
+func TestField_Localize(t *testing.T) {
+	fid := id.NewFieldID()
+	ig := id.NewItemGroupID()
+	f := NewField(fid, value.TypeText.Value("こんにちは").AsMultiple(), &ig)
+	f.SetLocalizedValue("en", value.TypeText.Value("hello").AsMultiple())
+
+	assert.Equal(t, &Field{
+		field: fid,
+		value: value.TypeText.Value("hello").AsMultiple(),
+		group: &ig,
+	}, f.Localize([]string{"en"}))
+	assert.Equal(t, &Field{
+		field: fid,
+		value: value.TypeText.Value("こんにちは").AsMultiple(),
+		group: &ig,
+	}, f.Localize([]string{"fr"}))
+
+	var nilF *Field
+	assert.Nil(t, nilF.Localize([]string{"en"}))
+}
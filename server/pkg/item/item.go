@@ -154,6 +154,11 @@ func (i *Item) UpdateFields(fields []*Field) {
 			return f, true
 		}
 
+		// the translations are kept unless the new field has them or they are cleared explicitly
+		if ff.locales == nil && len(f.locales) > 0 && ff.Type() == f.Type() {
+			ff = ff.Clone()
+			ff.locales = f.locales.Clone()
+		}
 		return ff, true
 	}), newFields...)
 
@@ -162,6 +167,12 @@ func (i *Item) UpdateFields(fields []*Field) {
 	i.timestamp = util.Now()
 }
 
+// ReplaceFields replaces all the fields of the item with the given fields.
+func (i *Item) ReplaceFields(fields Fields) {
+	i.fields = lo.Filter(fields, func(f *Field, _ int) bool { return f != nil })
+	i.cleanGroups()
+}
+
 func (i *Item) cleanGroups() {
 	i.fields = lo.Filter(i.fields, func(f *Field, _ int) bool {
 		if f.ItemGroup() == nil {
@@ -209,6 +220,20 @@ func (i *Item) FilterFields(list FieldIDList) *Item {
 	return i
 }
 
+// Localize returns a copy of the item whose field values are resolved along the locale chain.
+// The item itself is returned when no locale is given.
+func (i *Item) Localize(locales []string) *Item {
+	if i == nil || len(locales) == 0 {
+		return i
+	}
+
+	res := i.Clone()
+	res.fields = lo.Map(i.fields, func(f *Field, _ int) *Field {
+		return f.Localize(locales)
+	})
+	return res
+}
+
 func (i *Item) HasField(fid FieldID, value any) bool {
 	for _, field := range i.fields {
 		if field.field == fid && field.value == value {
@@ -324,6 +349,8 @@ type ItemModelSchema struct {
 	Schema          *schema.Schema
 	GroupSchemas    schema.List
 	Changes         FieldChanges
+	// RestoredFrom is the version the item was restored from, when the update is a restore
+	RestoredFrom *version.Version
 }
 
 func (i *Item) SetMetadataItem(iid id.ItemID) {
@@ -113,6 +113,23 @@ func TestItem_ClearField(t *testing.T) {
 	assert.Equal(t, []*Field{f1, f3}, i.fields)
 }
 
+func TestItem_ReplaceFields(t *testing.T) {
+	now := time.Now()
+	defer util.MockNow(now)()
+
+	fid1, fid2, gfid := id.NewFieldID(), id.NewFieldID(), id.NewFieldID()
+	ig := id.NewItemGroupID()
+	f1 := NewField(fid1, value.TypeText.Value("test").AsMultiple(), nil)
+	f2 := NewField(fid2, value.TypeText.Value("test").AsMultiple(), nil)
+	orphan := NewField(gfid, value.TypeText.Value("test").AsMultiple(), ig.Ref())
+
+	i := &Item{fields: []*Field{f1}}
+
+	i.ReplaceFields(Fields{f2, nil, orphan})
+	assert.Equal(t, []*Field{f2}, i.fields)
+	assert.Equal(t, now, i.timestamp)
+}
+
 func TestItem_ClearReferenceFields(t *testing.T) {
 	now := time.Now()
 	defer util.MockNow(now)()
@@ -192,6 +209,57 @@ func TestItem_IsAnonymous(t *testing.T) {
 	assert.False(t, user.Clone().IsAnonymous())
 }
 
+func TestItem_UpdateFields_Locales(t *testing.T) {
+	fid := id.NewFieldID()
+	f := NewField(fid, value.TypeText.Value("こんにちは").AsMultiple(), nil)
+	f.SetLocalizedValue("en", value.TypeText.Value("hello").AsMultiple())
+	i := New().NewID().Schema(id.NewSchemaID()).Model(id.NewModelID()).Project(id.NewProjectID()).Thread(id.NewThreadID().Ref()).Anonymous(true).Fields([]*Field{f}).MustBuild()
+
+	// an update without locales keeps the translations
+	i.UpdateFields([]*Field{NewField(fid, value.TypeText.Value("やあ").AsMultiple(), nil)})
+	assert.Equal(t, value.TypeText.Value("やあ").AsMultiple(), i.Field(fid).Value())
+	assert.Equal(t, value.TypeText.Value("hello").AsMultiple(), i.Field(fid).LocalizedValue("en"))
+
+	// an update with locales replaces the translations
+	f2 := NewField(fid, value.TypeText.Value("やあ").AsMultiple(), nil)
+	f2.SetLocalizedValue("fr", value.TypeText.Value("salut").AsMultiple())
+	i.UpdateFields([]*Field{f2})
+	assert.Equal(t, []string{"fr"}, i.Field(fid).Locales())
+
+	// the translations are dropped when the type of the value changes
+	i.UpdateFields([]*Field{NewField(fid, value.TypeInteger.Value(1).AsMultiple(), nil)})
+	assert.Nil(t, i.Field(fid).Locales())
+
+	// cleared explicitly
+	i.UpdateFields([]*Field{f2})
+	f3 := NewField(fid, value.TypeText.Value("やあ").AsMultiple(), nil)
+	f3.ClearLocalizedValues()
+	i.UpdateFields([]*Field{f3})
+	assert.Nil(t, i.Field(fid).Locales())
+}
+
+func TestItem_Localize(t *testing.T) {
+	fid, fid2 := id.NewFieldID(), id.NewFieldID()
+	f := NewField(fid, value.TypeText.Value("こんにちは").AsMultiple(), nil)
+	f.SetLocalizedValue("en", value.TypeText.Value("hello").AsMultiple())
+	f2 := NewField(fid2, value.TypeInteger.Value(1).AsMultiple(), nil)
+	i := New().NewID().Schema(id.NewSchemaID()).Model(id.NewModelID()).Project(id.NewProjectID()).Thread(id.NewThreadID().Ref()).Anonymous(true).Fields([]*Field{f, f2}).MustBuild()
+
+	assert.Same(t, i, i.Localize(nil))
+
+	got := i.Localize([]string{"en", "ja"})
+	assert.NotSame(t, i, got)
+	assert.Equal(t, value.TypeText.Value("hello").AsMultiple(), got.Field(fid).Value())
+	assert.Nil(t, got.Field(fid).Locales())
+	assert.Equal(t, value.TypeInteger.Value(1).AsMultiple(), got.Field(fid2).Value())
+	// the original item is not modified
+	assert.Equal(t, value.TypeText.Value("こんにちは").AsMultiple(), i.Field(fid).Value())
+	assert.Equal(t, []string{"en"}, i.Field(fid).Locales())
+
+	var nilI *Item
+	assert.Nil(t, nilI.Localize([]string{"en"}))
+}
+
 func TestItem_HasField(t *testing.T) {
 	f1 := NewField(id.NewFieldID(), value.TypeText.Value("foo").AsMultiple(), nil)
 	f2 := NewField(id.NewFieldID(), value.TypeText.Value("hoge").AsMultiple(), nil)
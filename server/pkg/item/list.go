@@ -21,6 +21,15 @@ func (l List) FilterFields(lids FieldIDList) List {
 	})
 }
 
+func (l List) Localize(locales []string) List {
+	if len(locales) == 0 {
+		return l
+	}
+	return lo.Map(l, func(i *Item, _ int) *Item {
+		return i.Localize(locales)
+	})
+}
+
 func (l List) Item(iID ID) (*Item, bool) {
 	return lo.Find(l, func(i *Item) bool {
 		return i.ID() == iID
@@ -35,6 +35,21 @@ func TestList_Filtered(t *testing.T) {
 	assert.Equal(t, want, got)
 }
 
+func TestList_Localize(t *testing.T) {
+	fid := id.NewFieldID()
+	f := NewField(fid, value.TypeText.Value("こんにちは").AsMultiple(), nil)
+	f.SetLocalizedValue("en", value.TypeText.Value("hello").AsMultiple())
+	i := New().NewID().Schema(id.NewSchemaID()).Model(id.NewModelID()).Project(id.NewProjectID()).Thread(id.NewThreadID().Ref()).Anonymous(true).Fields([]*Field{f}).MustBuild()
+	l := List{i}
+
+	assert.Equal(t, l, l.Localize(nil))
+
+	got := l.Localize([]string{"en"})
+	assert.Len(t, got, 1)
+	assert.Equal(t, value.TypeText.Value("hello").AsMultiple(), got[0].Field(fid).Value())
+	assert.Equal(t, value.TypeText.Value("こんにちは").AsMultiple(), l[0].Field(fid).Value())
+}
+
 func TestList_Item(t *testing.T) {
 	sfid1 := id.NewFieldID()
 	sfid2 := id.NewFieldID()
@@ -0,0 +1,79 @@
+package item
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+)
+
+// DroppedFieldReason tells why a field of a past version can not be restored.
+type DroppedFieldReason string
+
+const (
+	// DroppedFieldReasonRemoved is for the fields removed from the schema.
+	DroppedFieldReasonRemoved DroppedFieldReason = "removed"
+	// DroppedFieldReasonTypeChanged is for the fields whose type has changed since the version.
+	DroppedFieldReasonTypeChanged DroppedFieldReason = "type_changed"
+	// DroppedFieldReasonInvalid is for the values which are not valid for the current field any more.
+	DroppedFieldReasonInvalid DroppedFieldReason = "invalid"
+	// DroppedFieldReasonReferenceNotFound is for the references to the items which no longer exist.
+	DroppedFieldReasonReferenceNotFound DroppedFieldReason = "reference_not_found"
+)
+
+type DroppedField struct {
+	Field     FieldID
+	ItemGroup *ItemGroupID
+	Reason    DroppedFieldReason
+}
+
+func NewDroppedField(f *Field, reason DroppedFieldReason) DroppedField {
+	return DroppedField{
+		Field:     f.FieldID(),
+		ItemGroup: f.ItemGroup().CloneRef(),
+		Reason:    reason,
+	}
+}
+
+// RestorableFields returns the fields of a past version which are still valid for the current schema package,
+// and reports the fields which are dropped. Translations are dropped silently if the field is no longer localizable.
+func RestorableFields(fields Fields, sp *schema.Package) (Fields, []DroppedField) {
+	var res Fields
+	var dropped []DroppedField
+	for _, f := range fields {
+		if f == nil {
+			continue
+		}
+
+		sf := restorableSchemaField(sp, f.FieldID())
+		if sf == nil {
+			dropped = append(dropped, NewDroppedField(f, DroppedFieldReasonRemoved))
+			continue
+		}
+		if sf.Type() != f.Type() {
+			dropped = append(dropped, NewDroppedField(f, DroppedFieldReasonTypeChanged))
+			continue
+		}
+		if err := sf.ValidateValue(f.Value()); err != nil {
+			dropped = append(dropped, NewDroppedField(f, DroppedFieldReasonInvalid))
+			continue
+		}
+
+		if !sf.Localizable() {
+			res = append(res, NewField(f.FieldID(), f.Value().Clone(), f.ItemGroup().CloneRef()))
+			continue
+		}
+		res = append(res, f.Clone())
+	}
+	return res, dropped
+}
+
+// restorableSchemaField finds the field of the item in the schema or its group schemas.
+func restorableSchemaField(sp *schema.Package, fid FieldID) *schema.Field {
+	if sf := sp.Schema().Field(fid); sf != nil {
+		return sf
+	}
+	for _, gs := range sp.GroupSchemas() {
+		if sf := gs.Field(fid); sf != nil {
+			return sf
+		}
+	}
+	return nil
+}
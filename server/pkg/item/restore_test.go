@@ -0,0 +1,48 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestorableFields(t *testing.T) {
+	t.Parallel()
+
+	sf1 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	sf2 := schema.NewField(schema.NewText(new(3)).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	sf3 := schema.NewField(schema.NewBool().TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	sf4 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).Localizable(true).MustBuild()
+	gsf := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	s := schema.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).
+		Fields(schema.FieldList{sf1, sf2, sf3, sf4}).MustBuild()
+	gs := schema.New().NewID().Workspace(s.Workspace()).Project(s.Project()).Fields(schema.FieldList{gsf}).MustBuild()
+	sp := schema.NewPackage(s, nil, map[id.GroupID]*schema.Schema{id.NewGroupID(): gs}, nil)
+
+	ig := id.NewItemGroupID()
+	removed := NewField(id.NewFieldID(), value.TypeText.Value("a").AsMultiple(), nil)
+	typeChanged := NewField(sf3.ID(), value.TypeText.Value("true").AsMultiple(), nil)
+	invalid := NewField(sf2.ID(), value.TypeText.Value("abcd").AsMultiple(), nil)
+	valid := NewField(sf1.ID(), value.TypeText.Value("a").AsMultiple(), nil)
+	group := NewField(gsf.ID(), value.TypeText.Value("g").AsMultiple(), ig.Ref())
+	localized := NewField(sf4.ID(), value.TypeText.Value("en").AsMultiple(), nil)
+	localized.SetLocalizedValue("ja", value.TypeText.Value("ja").AsMultiple())
+	notLocalizable := NewField(sf1.ID(), value.TypeText.Value("a").AsMultiple(), nil)
+	notLocalizable.SetLocalizedValue("ja", value.TypeText.Value("ja").AsMultiple())
+
+	res, dropped := RestorableFields(Fields{valid, removed, typeChanged, invalid, group, localized, nil}, sp)
+	assert.Equal(t, Fields{valid, group, localized}, res)
+	assert.Equal(t, []DroppedField{
+		{Field: removed.FieldID(), Reason: DroppedFieldReasonRemoved},
+		{Field: sf3.ID(), Reason: DroppedFieldReasonTypeChanged},
+		{Field: sf2.ID(), Reason: DroppedFieldReasonInvalid},
+	}, dropped)
+
+	res, dropped = RestorableFields(Fields{notLocalizable}, sp)
+	assert.Equal(t, Fields{valid}, res)
+	assert.Empty(t, dropped)
+}
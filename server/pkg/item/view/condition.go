@@ -14,6 +14,7 @@ const (
 	ConditionTypeString   ConditionType = "STRING"
 	ConditionTypeNumber   ConditionType = "NUMBER"
 	ConditionTypeTime     ConditionType = "TIME"
+	ConditionTypeSpatial  ConditionType = "SPATIAL"
 )
 
 type Condition struct {
@@ -27,6 +28,7 @@ type Condition struct {
 	StringCondition   *StringCondition
 	NumberCondition   *NumberCondition
 	TimeCondition     *TimeCondition
+	SpatialCondition  *SpatialCondition
 }
 
 func (c Condition) MetaFields() FieldSelectorList {
@@ -77,6 +79,45 @@ func (c Condition) FieldsByType(t FieldType) FieldSelectorList {
 		if c.TimeCondition.Field.Type == t {
 			res = append(res, c.TimeCondition.Field)
 		}
+	case ConditionTypeSpatial:
+		if c.SpatialCondition.Field.Type == t {
+			res = append(res, c.SpatialCondition.Field)
+		}
 	}
 	return res
 }
+
+// RequiredSpatialConditions returns the spatial conditions that every matched item must satisfy,
+// i.e. the condition itself or the spatial conditions directly combined with AND.
+func (c Condition) RequiredSpatialConditions() []SpatialCondition {
+	switch c.ConditionType {
+	case ConditionTypeSpatial:
+		return []SpatialCondition{*c.SpatialCondition}
+	case ConditionTypeAnd:
+		return lo.FlatMap(c.AndCondition.Conditions, func(c Condition, _ int) []SpatialCondition {
+			return c.RequiredSpatialConditions()
+		})
+	}
+	return nil
+}
+
+// Validate checks the parameters of the condition and its nested conditions.
+func (c Condition) Validate() error {
+	switch c.ConditionType {
+	case ConditionTypeAnd:
+		for _, c := range c.AndCondition.Conditions {
+			if err := c.Validate(); err != nil {
+				return err
+			}
+		}
+	case ConditionTypeOr:
+		for _, c := range c.OrCondition.Conditions {
+			if err := c.Validate(); err != nil {
+				return err
+			}
+		}
+	case ConditionTypeSpatial:
+		return c.SpatialCondition.Validate()
+	}
+	return nil
+}
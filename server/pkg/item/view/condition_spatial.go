@@ -0,0 +1,261 @@
+package view
+
+import (
+	"math"
+
+	geojson "github.com/paulmach/go.geojson"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+type SpatialOperator string
+
+const (
+	SpatialOperatorBBox       SpatialOperator = "BBOX"
+	SpatialOperatorIntersects SpatialOperator = "INTERSECTS"
+	SpatialOperatorNear       SpatialOperator = "NEAR"
+)
+
+// EarthRadius is the radius of the earth in meters used to convert distances on the sphere
+const EarthRadius = 6378100.0
+
+var ErrInvalidSpatialCondition = rerror.NewE(i18n.T("invalid spatial condition"))
+
+// SpatialCondition matches items by the geometry stored in a geometry field.
+// All coordinates are [longitude, latitude] pairs in WGS84.
+type SpatialCondition struct {
+	Field FieldSelector
+	Op    SpatialOperator
+	// BBox is [west, south, east, north] and is used by BBOX
+	BBox []float64
+	// Polygon is the exterior ring of the polygon used by INTERSECTS
+	Polygon [][]float64
+	// Point and Radius (in meters) are used by NEAR
+	Point  []float64
+	Radius float64
+}
+
+func (c SpatialCondition) Validate() error {
+	switch c.Op {
+	case SpatialOperatorBBox:
+		if len(c.BBox) != 4 || !IsValidPosition(c.BBox[0:2]) || !IsValidPosition(c.BBox[2:4]) ||
+			c.BBox[0] > c.BBox[2] || c.BBox[1] > c.BBox[3] {
+			return ErrInvalidSpatialCondition
+		}
+	case SpatialOperatorIntersects:
+		ring := c.Area()
+		if len(ring) < 4 || !lo.EveryBy(ring, IsValidPosition) {
+			return ErrInvalidSpatialCondition
+		}
+	case SpatialOperatorNear:
+		if !IsValidPosition(c.Point) || c.Radius <= 0 {
+			return ErrInvalidSpatialCondition
+		}
+	default:
+		return ErrInvalidSpatialCondition
+	}
+	return nil
+}
+
+// Area returns the closed ring of the area used by BBOX and INTERSECTS.
+func (c SpatialCondition) Area() [][]float64 {
+	switch c.Op {
+	case SpatialOperatorBBox:
+		if len(c.BBox) != 4 {
+			return nil
+		}
+		w, s, e, n := c.BBox[0], c.BBox[1], c.BBox[2], c.BBox[3]
+		return [][]float64{{w, s}, {e, s}, {e, n}, {w, n}, {w, s}}
+	case SpatialOperatorIntersects:
+		if len(c.Polygon) == 0 {
+			return nil
+		}
+		ring := c.Polygon
+		first, last := ring[0], ring[len(ring)-1]
+		if len(first) < 2 || len(last) < 2 || first[0] != last[0] || first[1] != last[1] {
+			ring = append(ring[:len(ring):len(ring)], first)
+		}
+		return ring
+	}
+	return nil
+}
+
+// Match reports whether any of the GeoJSON geometries satisfies the condition.
+// Areas are evaluated on a plane of longitude and latitude, and distances on a sphere.
+// MongoDB evaluates areas on a sphere instead, connecting positions with great circle arcs,
+// so for a large area the results may differ near its edges. Distances are the same as $centerSphere.
+func (c SpatialCondition) Match(geometries []string) bool {
+	return lo.SomeBy(geometries, func(g string) bool {
+		geo, err := geojson.UnmarshalGeometry([]byte(g))
+		if err != nil {
+			return false
+		}
+		switch c.Op {
+		case SpatialOperatorBBox, SpatialOperatorIntersects:
+			return intersectsArea(geo, c.Area())
+		case SpatialOperatorNear:
+			return isWithinRadius(geo, c.Point, c.Radius)
+		}
+		return false
+	})
+}
+
+// IsValidPosition reports whether the position has a longitude and a latitude in range.
+// An altitude following them is allowed as in GeoJSON.
+func IsValidPosition(p []float64) bool {
+	return len(p) >= 2 && p[0] >= -180 && p[0] <= 180 && p[1] >= -90 && p[1] <= 90
+}
+
+func intersectsArea(g *geojson.Geometry, area [][]float64) bool {
+	if g == nil || len(area) < 4 {
+		return false
+	}
+	switch g.Type {
+	case geojson.GeometryPoint:
+		return isInRing(g.Point, area)
+	case geojson.GeometryMultiPoint:
+		return lo.SomeBy(g.MultiPoint, func(p []float64) bool { return isInRing(p, area) })
+	case geojson.GeometryLineString:
+		return lineIntersectsArea(g.LineString, area)
+	case geojson.GeometryMultiLineString:
+		return lo.SomeBy(g.MultiLineString, func(l [][]float64) bool { return lineIntersectsArea(l, area) })
+	case geojson.GeometryPolygon:
+		return polygonIntersectsArea(g.Polygon, area)
+	case geojson.GeometryMultiPolygon:
+		return lo.SomeBy(g.MultiPolygon, func(p [][][]float64) bool { return polygonIntersectsArea(p, area) })
+	case geojson.GeometryCollection:
+		return lo.SomeBy(g.Geometries, func(g *geojson.Geometry) bool { return intersectsArea(g, area) })
+	}
+	return false
+}
+
+func lineIntersectsArea(line [][]float64, area [][]float64) bool {
+	if lo.SomeBy(line, func(p []float64) bool { return isInRing(p, area) }) {
+		return true
+	}
+	return crossesRing(line, area)
+}
+
+func polygonIntersectsArea(polygon [][][]float64, area [][]float64) bool {
+	if len(polygon) == 0 {
+		return false
+	}
+	if lineIntersectsArea(polygon[0], area) {
+		return true
+	}
+	// the area may be entirely inside the polygon
+	return isInPolygon(area[0], polygon)
+}
+
+func isInPolygon(p []float64, polygon [][][]float64) bool {
+	if len(polygon) == 0 || !isInRing(p, polygon[0]) {
+		return false
+	}
+	return !lo.SomeBy(polygon[1:], func(hole [][]float64) bool { return isInRing(p, hole) })
+}
+
+// isInRing reports whether the point is inside or on the boundary of the closed ring
+func isInRing(p []float64, ring [][]float64) bool {
+	if len(p) < 2 {
+		return false
+	}
+	in := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if len(a) < 2 || len(b) < 2 {
+			continue
+		}
+		if isOnSegment(p, a, b) {
+			return true
+		}
+		if (a[1] > p[1]) != (b[1] > p[1]) && p[0] < (b[0]-a[0])*(p[1]-a[1])/(b[1]-a[1])+a[0] {
+			in = !in
+		}
+	}
+	return in
+}
+
+func crossesRing(line [][]float64, ring [][]float64) bool {
+	for i := 1; i < len(line); i++ {
+		for j := 1; j < len(ring); j++ {
+			if SegmentsIntersect(line[i-1], line[i], ring[j-1], ring[j]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SegmentsIntersect reports whether the segment p1-p2 and the segment q1-q2 cross or touch each other on the plane.
+func SegmentsIntersect(p1, p2, q1, q2 []float64) bool {
+	if len(p1) < 2 || len(p2) < 2 || len(q1) < 2 || len(q2) < 2 {
+		return false
+	}
+	d1 := orientation(q1, q2, p1)
+	d2 := orientation(q1, q2, p2)
+	d3 := orientation(p1, p2, q1)
+	d4 := orientation(p1, p2, q2)
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+	return isOnSegment(p1, q1, q2) || isOnSegment(p2, q1, q2) || isOnSegment(q1, p1, p2) || isOnSegment(q2, p1, p2)
+}
+
+func orientation(a, b, c []float64) float64 {
+	return (b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0])
+}
+
+func isOnSegment(p, a, b []float64) bool {
+	return orientation(a, b, p) == 0 &&
+		math.Min(a[0], b[0]) <= p[0] && p[0] <= math.Max(a[0], b[0]) &&
+		math.Min(a[1], b[1]) <= p[1] && p[1] <= math.Max(a[1], b[1])
+}
+
+// isWithinRadius reports whether every position of the geometry is within the radius of the point
+func isWithinRadius(g *geojson.Geometry, center []float64, radius float64) bool {
+	if g == nil || len(center) < 2 {
+		return false
+	}
+	positions := geometryPositions(g)
+	if len(positions) == 0 {
+		return false
+	}
+	return lo.EveryBy(positions, func(p []float64) bool {
+		return len(p) >= 2 && distance(center, p) <= radius
+	})
+}
+
+func geometryPositions(g *geojson.Geometry) [][]float64 {
+	switch g.Type {
+	case geojson.GeometryPoint:
+		return [][]float64{g.Point}
+	case geojson.GeometryMultiPoint:
+		return g.MultiPoint
+	case geojson.GeometryLineString:
+		return g.LineString
+	case geojson.GeometryMultiLineString:
+		return lo.Flatten(g.MultiLineString)
+	case geojson.GeometryPolygon:
+		return lo.Flatten(g.Polygon)
+	case geojson.GeometryMultiPolygon:
+		return lo.Flatten(lo.Flatten(g.MultiPolygon))
+	case geojson.GeometryCollection:
+		return lo.FlatMap(g.Geometries, func(g *geojson.Geometry, _ int) [][]float64 {
+			if g == nil {
+				return nil
+			}
+			return geometryPositions(g)
+		})
+	}
+	return nil
+}
+
+// distance returns the great-circle distance in meters between two positions
+func distance(a, b []float64) float64 {
+	lat1, lat2 := a[1]*math.Pi/180, b[1]*math.Pi/180
+	dLat := lat2 - lat1
+	dLng := (b[0] - a[0]) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
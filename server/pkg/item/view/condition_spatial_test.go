@@ -0,0 +1,90 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpatialCondition_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       SpatialCondition
+		wantErr error
+	}{
+		{name: "bbox", c: SpatialCondition{Op: SpatialOperatorBBox, BBox: []float64{139, 35, 140, 36}}},
+		{name: "bbox with invalid length", c: SpatialCondition{Op: SpatialOperatorBBox, BBox: []float64{139, 35, 140}}, wantErr: ErrInvalidSpatialCondition},
+		{name: "bbox with swapped corners", c: SpatialCondition{Op: SpatialOperatorBBox, BBox: []float64{140, 35, 139, 36}}, wantErr: ErrInvalidSpatialCondition},
+		{name: "bbox out of range", c: SpatialCondition{Op: SpatialOperatorBBox, BBox: []float64{139, 35, 140, 91}}, wantErr: ErrInvalidSpatialCondition},
+		{name: "intersects", c: SpatialCondition{Op: SpatialOperatorIntersects, Polygon: [][]float64{{0, 0}, {1, 0}, {1, 1}}}},
+		{name: "intersects with too few positions", c: SpatialCondition{Op: SpatialOperatorIntersects, Polygon: [][]float64{{0, 0}, {1, 0}}}, wantErr: ErrInvalidSpatialCondition},
+		{name: "near", c: SpatialCondition{Op: SpatialOperatorNear, Point: []float64{139, 35}, Radius: 100}},
+		{name: "near without radius", c: SpatialCondition{Op: SpatialOperatorNear, Point: []float64{139, 35}}, wantErr: ErrInvalidSpatialCondition},
+		{name: "unknown operator", c: SpatialCondition{Op: "WITHIN"}, wantErr: ErrInvalidSpatialCondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.wantErr, tt.c.Validate())
+		})
+	}
+}
+
+func TestSpatialCondition_Area(t *testing.T) {
+	assert.Equal(t, [][]float64{{0, 1}, {2, 1}, {2, 3}, {0, 3}, {0, 1}}, SpatialCondition{Op: SpatialOperatorBBox, BBox: []float64{0, 1, 2, 3}}.Area())
+	assert.Equal(t, [][]float64{{0, 0}, {1, 0}, {1, 1}, {0, 0}}, SpatialCondition{Op: SpatialOperatorIntersects, Polygon: [][]float64{{0, 0}, {1, 0}, {1, 1}}}.Area())
+	assert.Equal(t, [][]float64{{0, 0}, {1, 0}, {1, 1}, {0, 0}}, SpatialCondition{Op: SpatialOperatorIntersects, Polygon: [][]float64{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}.Area())
+	assert.Nil(t, SpatialCondition{Op: SpatialOperatorNear, Point: []float64{0, 0}, Radius: 1}.Area())
+}
+
+func TestSpatialCondition_Match(t *testing.T) {
+	bbox := SpatialCondition{Op: SpatialOperatorBBox, BBox: []float64{0, 0, 10, 10}}
+	triangle := SpatialCondition{Op: SpatialOperatorIntersects, Polygon: [][]float64{{0, 0}, {10, 0}, {0, 10}}}
+	near := SpatialCondition{Op: SpatialOperatorNear, Point: []float64{139.767, 35.681}, Radius: 1000}
+
+	tests := []struct {
+		name       string
+		c          SpatialCondition
+		geometries []string
+		want       bool
+	}{
+		{name: "point inside bbox", c: bbox, geometries: []string{`{"type":"Point","coordinates":[5,5]}`}, want: true},
+		{name: "point on bbox edge", c: bbox, geometries: []string{`{"type":"Point","coordinates":[10,5]}`}, want: true},
+		{name: "point outside bbox", c: bbox, geometries: []string{`{"type":"Point","coordinates":[11,5]}`}, want: false},
+		{name: "line crossing bbox", c: bbox, geometries: []string{`{"type":"LineString","coordinates":[[-5,5],[15,5]]}`}, want: true},
+		{name: "polygon containing bbox", c: bbox, geometries: []string{`{"type":"Polygon","coordinates":[[[-20,-20],[20,-20],[20,20],[-20,20],[-20,-20]]]}`}, want: true},
+		{name: "polygon with bbox in its hole", c: bbox, geometries: []string{`{"type":"Polygon","coordinates":[[[-20,-20],[20,-20],[20,20],[-20,20],[-20,-20]],[[-15,-15],[15,-15],[15,15],[-15,15],[-15,-15]]]}`}, want: false},
+		{name: "any of multiple values", c: bbox, geometries: []string{`{"type":"Point","coordinates":[50,50]}`, `{"type":"Point","coordinates":[1,1]}`}, want: true},
+		{name: "geometry collection", c: bbox, geometries: []string{`{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[50,50]},{"type":"Point","coordinates":[1,1]}]}`}, want: true},
+		{name: "invalid geometry", c: bbox, geometries: []string{`{"type":"Point"`}, want: false},
+		{name: "point inside polygon", c: triangle, geometries: []string{`{"type":"Point","coordinates":[2,2]}`}, want: true},
+		{name: "point inside bbox but outside polygon", c: triangle, geometries: []string{`{"type":"Point","coordinates":[8,8]}`}, want: false},
+		{name: "multi point inside polygon", c: triangle, geometries: []string{`{"type":"MultiPoint","coordinates":[[8,8],[1,1]]}`}, want: true},
+		{name: "point near", c: near, geometries: []string{`{"type":"Point","coordinates":[139.770,35.684]}`}, want: true},
+		{name: "point far", c: near, geometries: []string{`{"type":"Point","coordinates":[139.700,35.690]}`}, want: false},
+		{name: "line partly out of radius", c: near, geometries: []string{`{"type":"LineString","coordinates":[[139.767,35.681],[139.700,35.690]]}`}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.c.Match(tt.geometries))
+		})
+	}
+}
+
+func TestIsValidPosition(t *testing.T) {
+	assert.True(t, IsValidPosition([]float64{139, 35}))
+	assert.True(t, IsValidPosition([]float64{139, 35, 10}))
+	assert.False(t, IsValidPosition([]float64{139}))
+	assert.False(t, IsValidPosition([]float64{181, 35}))
+	assert.False(t, IsValidPosition([]float64{139, -91}))
+}
+
+func TestSegmentsIntersect(t *testing.T) {
+	assert.True(t, SegmentsIntersect([]float64{0, 0}, []float64{2, 2}, []float64{0, 2}, []float64{2, 0}))
+	assert.True(t, SegmentsIntersect([]float64{0, 0}, []float64{2, 0}, []float64{1, 0}, []float64{1, 1}))
+	assert.True(t, SegmentsIntersect([]float64{0, 0}, []float64{2, 0}, []float64{1, 0}, []float64{3, 0}))
+	assert.False(t, SegmentsIntersect([]float64{0, 0}, []float64{1, 0}, []float64{2, 0}, []float64{3, 0}))
+	assert.False(t, SegmentsIntersect([]float64{0, 0}, []float64{2, 0}, []float64{0, 1}, []float64{2, 1}))
+	assert.False(t, SegmentsIntersect([]float64{0, 0}, []float64{2, 0}, []float64{0}, []float64{2, 1}))
+}
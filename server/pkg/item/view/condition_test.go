@@ -0,0 +1,30 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCondition_RequiredSpatialConditions(t *testing.T) {
+	fid := id.NewFieldID()
+	s := SpatialCondition{Field: FieldSelector{Type: FieldTypeField, ID: fid.Ref()}, Op: SpatialOperatorBBox, BBox: []float64{0, 0, 1, 1}}
+	sc := Condition{ConditionType: ConditionTypeSpatial, SpatialCondition: &s}
+	bc := Condition{ConditionType: ConditionTypeBool, BoolCondition: &BoolCondition{Field: FieldSelector{Type: FieldTypeField, ID: fid.Ref()}, Op: BoolOperatorEquals, Value: true}}
+
+	assert.Equal(t, []SpatialCondition{s}, sc.RequiredSpatialConditions())
+	assert.Equal(t, []SpatialCondition{s}, Condition{ConditionType: ConditionTypeAnd, AndCondition: &AndCondition{Conditions: []Condition{bc, sc}}}.RequiredSpatialConditions())
+	assert.Nil(t, Condition{ConditionType: ConditionTypeOr, OrCondition: &OrCondition{Conditions: []Condition{bc, sc}}}.RequiredSpatialConditions())
+	assert.Equal(t, FieldSelectorList{s.Field}, sc.ItemFields())
+}
+
+func TestCondition_Validate(t *testing.T) {
+	valid := Condition{ConditionType: ConditionTypeSpatial, SpatialCondition: &SpatialCondition{Op: SpatialOperatorBBox, BBox: []float64{0, 0, 1, 1}}}
+	invalid := Condition{ConditionType: ConditionTypeSpatial, SpatialCondition: &SpatialCondition{Op: SpatialOperatorNear, Point: []float64{0, 0}}}
+
+	assert.NoError(t, valid.Validate())
+	assert.NoError(t, Condition{ConditionType: ConditionTypeAnd, AndCondition: &AndCondition{Conditions: []Condition{valid}}}.Validate())
+	assert.Equal(t, ErrInvalidSpatialCondition, invalid.Validate())
+	assert.Equal(t, ErrInvalidSpatialCondition, Condition{ConditionType: ConditionTypeOr, OrCondition: &OrCondition{Conditions: []Condition{valid, invalid}}}.Validate())
+}
@@ -0,0 +1,69 @@
+package job
+
+import (
+	"encoding/json"
+)
+
+// BulkUpdateField is a value assigned to a field of every matched item. Field is the ID or the key of the field.
+type BulkUpdateField struct {
+	Field string `json:"field"`
+	Value any    `json:"value"`
+}
+
+type BulkUpdatePayload struct {
+	ModelID string            `json:"modelId"`
+	Filter  json.RawMessage   `json:"filter,omitempty"`
+	Fields  []BulkUpdateField `json:"fields"`
+}
+
+func (p *BulkUpdatePayload) ToJSON() (json.RawMessage, error) {
+	return json.Marshal(p)
+}
+
+func BulkUpdatePayloadFromJSON(data json.RawMessage) (*BulkUpdatePayload, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var p BulkUpdatePayload
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// BulkUpdateResult counts the matched items. Skipped items already had the assigned values, and failed items could not be updated.
+type BulkUpdateResult struct {
+	Total   int `json:"total"`
+	Updated int `json:"updated"`
+	Skipped int `json:"skipped"`
+	Failed  int `json:"failed"`
+}
+
+func (r *BulkUpdateResult) ToJSON() (json.RawMessage, error) {
+	return json.Marshal(r)
+}
+
+func BulkUpdateResultFromJSON(data json.RawMessage) (*BulkUpdateResult, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var r BulkUpdateResult
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (j *Job) BulkUpdatePayload() (*BulkUpdatePayload, error) {
+	if j.jobType != TypeBulkUpdate {
+		return nil, nil
+	}
+	return BulkUpdatePayloadFromJSON(j.payload)
+}
+
+func (j *Job) BulkUpdateResult() (*BulkUpdateResult, error) {
+	if j.jobType != TypeBulkUpdate {
+		return nil, nil
+	}
+	return BulkUpdateResultFromJSON(j.result)
+}
@@ -0,0 +1,78 @@
+package job
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkUpdatePayload_ToJSON(t *testing.T) {
+	payload := &BulkUpdatePayload{
+		ModelID: "model-123",
+		Filter:  json.RawMessage(`{"ConditionType":"BASIC"}`),
+		Fields:  []BulkUpdateField{{Field: "status", Value: "done"}},
+	}
+
+	data, err := payload.ToJSON()
+	assert.NoError(t, err)
+
+	restored, err := BulkUpdatePayloadFromJSON(data)
+	assert.NoError(t, err)
+	assert.Equal(t, payload, restored)
+}
+
+func TestBulkUpdatePayloadFromJSON(t *testing.T) {
+	got, err := BulkUpdatePayloadFromJSON(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	_, err = BulkUpdatePayloadFromJSON(json.RawMessage(`{invalid`))
+	assert.Error(t, err)
+}
+
+func TestBulkUpdateResult_ToJSON(t *testing.T) {
+	result := &BulkUpdateResult{Total: 10, Updated: 7, Skipped: 2, Failed: 1}
+
+	data, err := result.ToJSON()
+	assert.NoError(t, err)
+
+	restored, err := BulkUpdateResultFromJSON(data)
+	assert.NoError(t, err)
+	assert.Equal(t, result, restored)
+}
+
+func TestBulkUpdateResultFromJSON(t *testing.T) {
+	got, err := BulkUpdateResultFromJSON(json.RawMessage{})
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	_, err = BulkUpdateResultFromJSON(json.RawMessage(`{invalid`))
+	assert.Error(t, err)
+}
+
+func TestJob_BulkUpdatePayload(t *testing.T) {
+	payload := &BulkUpdatePayload{ModelID: "m1", Fields: []BulkUpdateField{{Field: "a", Value: "b"}}}
+	payloadJSON, _ := payload.ToJSON()
+
+	got, err := (&Job{jobType: TypeBulkUpdate, payload: payloadJSON}).BulkUpdatePayload()
+	assert.NoError(t, err)
+	assert.Equal(t, payload, got)
+
+	got, err = (&Job{jobType: TypeImport, payload: payloadJSON}).BulkUpdatePayload()
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestJob_BulkUpdateResult(t *testing.T) {
+	result := &BulkUpdateResult{Total: 1, Updated: 1}
+	resultJSON, _ := result.ToJSON()
+
+	got, err := (&Job{jobType: TypeBulkUpdate, result: resultJSON}).BulkUpdateResult()
+	assert.NoError(t, err)
+	assert.Equal(t, result, got)
+
+	got, err = (&Job{jobType: TypeImport, result: resultJSON}).BulkUpdateResult()
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
@@ -0,0 +1,65 @@
+package job
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type ExportPayload struct {
+	ModelID       string `json:"modelId"`
+	Format        string `json:"format"`
+	PublicOnly    bool   `json:"publicOnly"`
+	IncludeAssets bool   `json:"includeAssets"`
+}
+
+func (p *ExportPayload) ToJSON() (json.RawMessage, error) {
+	return json.Marshal(p)
+}
+
+func ExportPayloadFromJSON(data json.RawMessage) (*ExportPayload, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var p ExportPayload
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// ExportResult is the exported file. URL is a signed download link which expires at ExpiresAt.
+type ExportResult struct {
+	Total     int        `json:"total"`
+	Path      string     `json:"path"`
+	URL       string     `json:"url"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+func (r *ExportResult) ToJSON() (json.RawMessage, error) {
+	return json.Marshal(r)
+}
+
+func ExportResultFromJSON(data json.RawMessage) (*ExportResult, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var r ExportResult
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (j *Job) ExportPayload() (*ExportPayload, error) {
+	if j.jobType != TypeExport {
+		return nil, nil
+	}
+	return ExportPayloadFromJSON(j.payload)
+}
+
+func (j *Job) ExportResult() (*ExportResult, error) {
+	if j.jobType != TypeExport {
+		return nil, nil
+	}
+	return ExportResultFromJSON(j.result)
+}
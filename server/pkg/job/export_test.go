@@ -0,0 +1,85 @@
+package job
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportPayload_ToJSON(t *testing.T) {
+	payload := &ExportPayload{
+		ModelID:       "model-123",
+		Format:        "csv",
+		PublicOnly:    true,
+		IncludeAssets: true,
+	}
+
+	data, err := payload.ToJSON()
+	assert.NoError(t, err)
+
+	restored, err := ExportPayloadFromJSON(data)
+	assert.NoError(t, err)
+	assert.Equal(t, payload, restored)
+}
+
+func TestExportPayloadFromJSON(t *testing.T) {
+	got, err := ExportPayloadFromJSON(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	_, err = ExportPayloadFromJSON(json.RawMessage(`{invalid`))
+	assert.Error(t, err)
+}
+
+func TestExportResult_ToJSON(t *testing.T) {
+	result := &ExportResult{
+		Total:     10,
+		Path:      "model-job.csv",
+		URL:       "https://example.com/model-job.csv",
+		ExpiresAt: new(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	data, err := result.ToJSON()
+	assert.NoError(t, err)
+
+	restored, err := ExportResultFromJSON(data)
+	assert.NoError(t, err)
+	assert.Equal(t, result, restored)
+}
+
+func TestExportResultFromJSON(t *testing.T) {
+	got, err := ExportResultFromJSON(json.RawMessage{})
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	_, err = ExportResultFromJSON(json.RawMessage(`{invalid`))
+	assert.Error(t, err)
+}
+
+func TestJob_ExportPayload(t *testing.T) {
+	payload := &ExportPayload{ModelID: "m1", Format: "json"}
+	payloadJSON, _ := payload.ToJSON()
+
+	got, err := (&Job{jobType: TypeExport, payload: payloadJSON}).ExportPayload()
+	assert.NoError(t, err)
+	assert.Equal(t, payload, got)
+
+	got, err = (&Job{jobType: TypeImport, payload: payloadJSON}).ExportPayload()
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestJob_ExportResult(t *testing.T) {
+	result := &ExportResult{Total: 1, Path: "a.json", URL: "https://example.com/a.json"}
+	resultJSON, _ := result.ToJSON()
+
+	got, err := (&Job{jobType: TypeExport, result: resultJSON}).ExportResult()
+	assert.NoError(t, err)
+	assert.Equal(t, result, got)
+
+	got, err = (&Job{jobType: TypeImport, result: resultJSON}).ExportResult()
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
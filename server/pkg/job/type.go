@@ -5,10 +5,9 @@ import "strings"
 type Type string
 
 const (
-	TypeImport Type = "import"
-	// Future types can be added here:
-	// TypeExport     Type = "export"
-	// TypeBulkUpdate Type = "bulk_update"
+	TypeImport     Type = "import"
+	TypeExport     Type = "export"
+	TypeBulkUpdate Type = "bulk_update"
 )
 
 func TypeFrom(s string) (Type, bool) {
@@ -16,6 +15,10 @@ func TypeFrom(s string) (Type, bool) {
 	switch Type(ss) {
 	case TypeImport:
 		return TypeImport, true
+	case TypeExport:
+		return TypeExport, true
+	case TypeBulkUpdate:
+		return TypeBulkUpdate, true
 	default:
 		return Type(""), false
 	}
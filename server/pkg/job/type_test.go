@@ -31,6 +31,18 @@ func TestTypeFrom(t *testing.T) {
 			want:   TypeImport,
 			wantOk: true,
 		},
+		{
+			name:   "export",
+			input:  "export",
+			want:   TypeExport,
+			wantOk: true,
+		},
+		{
+			name:   "bulk update",
+			input:  "bulk_update",
+			want:   TypeBulkUpdate,
+			wantOk: true,
+		},
 		{
 			name:   "invalid type",
 			input:  "unknown",
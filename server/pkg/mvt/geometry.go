@@ -0,0 +1,327 @@
+package mvt
+
+import (
+	"math"
+
+	geojson "github.com/paulmach/go.geojson"
+	"github.com/samber/lo"
+)
+
+type geomType uint32
+
+const (
+	geomTypePoint      geomType = 1
+	geomTypeLineString geomType = 2
+	geomTypePolygon    geomType = 3
+)
+
+const (
+	commandMoveTo    = 1
+	commandLineTo    = 2
+	commandClosePath = 7
+)
+
+type point [2]int32
+
+type encodedGeometry struct {
+	Type     geomType
+	Commands []uint32
+}
+
+// projector converts geometries into the coordinates of a tile, clipping them at the buffer around the tile.
+type projector struct {
+	tile   TileID
+	extent uint32
+	low    float64
+	high   float64
+}
+
+func newProjector(t TileID, extent, buffer uint32) projector {
+	return projector{
+		tile:   t,
+		extent: extent,
+		low:    -float64(buffer),
+		high:   float64(extent + buffer),
+	}
+}
+
+// encode returns the encoded geometries, splitting geometry collections into one geometry per member.
+// Geometries entirely outside of the tile are dropped.
+func (p projector) encode(g *geojson.Geometry) []encodedGeometry {
+	if g == nil {
+		return nil
+	}
+	var e encodedGeometry
+	switch g.Type {
+	case geojson.GeometryPoint:
+		e = p.points([][]float64{g.Point})
+	case geojson.GeometryMultiPoint:
+		e = p.points(g.MultiPoint)
+	case geojson.GeometryLineString:
+		e = p.lines([][][]float64{g.LineString})
+	case geojson.GeometryMultiLineString:
+		e = p.lines(g.MultiLineString)
+	case geojson.GeometryPolygon:
+		e = p.polygons([][][][]float64{g.Polygon})
+	case geojson.GeometryMultiPolygon:
+		e = p.polygons(g.MultiPolygon)
+	case geojson.GeometryCollection:
+		return lo.FlatMap(g.Geometries, func(g *geojson.Geometry, _ int) []encodedGeometry {
+			return p.encode(g)
+		})
+	}
+	if len(e.Commands) == 0 {
+		return nil
+	}
+	return []encodedGeometry{e}
+}
+
+func (p projector) points(positions [][]float64) encodedGeometry {
+	points := lo.FilterMap(positions, func(pos []float64, _ int) (point, bool) {
+		if len(pos) < 2 {
+			return point{}, false
+		}
+		c := p.tile.project(pos, p.extent)
+		if c[0] < p.low || c[0] > p.high || c[1] < p.low || c[1] > p.high {
+			return point{}, false
+		}
+		return round(c), true
+	})
+
+	e := encodedGeometry{Type: geomTypePoint}
+	if len(points) == 0 {
+		return e
+	}
+	w := &commandWriter{}
+	w.moveTo(points...)
+	e.Commands = w.commands
+	return e
+}
+
+func (p projector) lines(lines [][][]float64) encodedGeometry {
+	w := &commandWriter{}
+	for _, l := range lines {
+		for _, clipped := range clipLine(p.positions(l), p.low, p.high) {
+			points := roundPoints(clipped)
+			if len(points) < 2 {
+				continue
+			}
+			w.moveTo(points[0])
+			w.lineTo(points[1:]...)
+		}
+	}
+	return encodedGeometry{Type: geomTypeLineString, Commands: w.commands}
+}
+
+func (p projector) polygons(polygons [][][][]float64) encodedGeometry {
+	w := &commandWriter{}
+	for _, polygon := range polygons {
+		for i, r := range polygon {
+			ring := roundPoints(clipRing(p.positions(openRing(r)), p.low, p.high))
+			if len(ring) > 1 && ring[0] == ring[len(ring)-1] {
+				ring = ring[:len(ring)-1]
+			}
+			a := ringArea(ring)
+			if len(ring) < 3 || a == 0 {
+				if i == 0 {
+					// holes are meaningless without the exterior ring
+					break
+				}
+				continue
+			}
+			// exterior rings have a positive area in the tile coordinates where y points down, and holes a negative one
+			if (i == 0) != (a > 0) {
+				ring = lo.Reverse(ring)
+			}
+			w.moveTo(ring[0])
+			w.lineTo(ring[1:]...)
+			w.closePath()
+		}
+	}
+	return encodedGeometry{Type: geomTypePolygon, Commands: w.commands}
+}
+
+func (p projector) positions(positions [][]float64) [][2]float64 {
+	return lo.FilterMap(positions, func(pos []float64, _ int) ([2]float64, bool) {
+		if len(pos) < 2 {
+			return [2]float64{}, false
+		}
+		return p.tile.project(pos, p.extent), true
+	})
+}
+
+// commandWriter writes geometry commands with parameters relative to the cursor.
+type commandWriter struct {
+	commands []uint32
+	cursor   point
+}
+
+func (w *commandWriter) moveTo(points ...point) {
+	w.write(commandMoveTo, points)
+}
+
+func (w *commandWriter) lineTo(points ...point) {
+	w.write(commandLineTo, points)
+}
+
+func (w *commandWriter) closePath() {
+	w.commands = append(w.commands, command(commandClosePath, 1))
+}
+
+func (w *commandWriter) write(id uint32, points []point) {
+	if len(points) == 0 {
+		return
+	}
+	w.commands = append(w.commands, command(id, len(points)))
+	for _, p := range points {
+		w.commands = append(w.commands, zigzag(p[0]-w.cursor[0]), zigzag(p[1]-w.cursor[1]))
+		w.cursor = p
+	}
+}
+
+func command(id uint32, count int) uint32 {
+	return (id & 0x7) | uint32(count)<<3
+}
+
+func zigzag(n int32) uint32 {
+	return uint32((n << 1) ^ (n >> 31))
+}
+
+func round(c [2]float64) point {
+	return point{int32(math.Round(c[0])), int32(math.Round(c[1]))}
+}
+
+// roundPoints rounds the coordinates to integers and drops consecutive duplicated points.
+func roundPoints(coords [][2]float64) []point {
+	res := make([]point, 0, len(coords))
+	for _, c := range coords {
+		p := round(c)
+		if len(res) > 0 && res[len(res)-1] == p {
+			continue
+		}
+		res = append(res, p)
+	}
+	return res
+}
+
+// ringArea returns the doubled signed area of the ring by the surveyor's formula.
+func ringArea(ring []point) int64 {
+	var a int64
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a += int64(ring[j][0])*int64(ring[i][1]) - int64(ring[i][0])*int64(ring[j][1])
+	}
+	return a
+}
+
+func openRing(ring [][]float64) [][]float64 {
+	if len(ring) < 2 {
+		return ring
+	}
+	first, last := ring[0], ring[len(ring)-1]
+	if len(first) >= 2 && len(last) >= 2 && first[0] == last[0] && first[1] == last[1] {
+		return ring[:len(ring)-1]
+	}
+	return ring
+}
+
+// clipLine clips the line to the square of [low, high], splitting it where it leaves the square.
+func clipLine(line [][2]float64, low, high float64) [][][2]float64 {
+	var res [][][2]float64
+	var current [][2]float64
+	for i := 1; i < len(line); i++ {
+		a, b, exited, ok := clipSegment(line[i-1], line[i], low, high)
+		if !ok {
+			if len(current) > 0 {
+				res = append(res, current)
+				current = nil
+			}
+			continue
+		}
+		if len(current) == 0 {
+			current = append(current, a)
+		}
+		current = append(current, b)
+		if exited {
+			res = append(res, current)
+			current = nil
+		}
+	}
+	if len(current) > 0 {
+		res = append(res, current)
+	}
+	return res
+}
+
+// clipSegment clips the segment to the square of [low, high] by the Liang-Barsky algorithm.
+// exited reports whether the segment leaves the square before reaching b.
+func clipSegment(a, b [2]float64, low, high float64) (_, _ [2]float64, exited, ok bool) {
+	t0, t1 := 0.0, 1.0
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	for _, e := range [4][2]float64{{-dx, a[0] - low}, {dx, high - a[0]}, {-dy, a[1] - low}, {dy, high - a[1]}} {
+		p, q := e[0], e[1]
+		if p == 0 {
+			if q < 0 {
+				return a, b, false, false
+			}
+			continue
+		}
+		r := q / p
+		if p < 0 {
+			if r > t1 {
+				return a, b, false, false
+			}
+			t0 = math.Max(t0, r)
+		} else {
+			if r < t0 {
+				return a, b, false, false
+			}
+			t1 = math.Min(t1, r)
+		}
+	}
+	ca := a
+	if t0 > 0 {
+		ca = [2]float64{a[0] + t0*dx, a[1] + t0*dy}
+	}
+	cb := b
+	if t1 < 1 {
+		cb = [2]float64{a[0] + t1*dx, a[1] + t1*dy}
+	}
+	return ca, cb, t1 < 1, true
+}
+
+// clipRing clips the open ring to the square of [low, high] by the Sutherland-Hodgman algorithm.
+func clipRing(ring [][2]float64, low, high float64) [][2]float64 {
+	for edge := 0; edge < 4 && len(ring) > 0; edge++ {
+		axis, bound, lower := edge/2, low, edge%2 == 0
+		if !lower {
+			bound = high
+		}
+		inside := func(c [2]float64) bool {
+			if lower {
+				return c[axis] >= bound
+			}
+			return c[axis] <= bound
+		}
+		intersect := func(a, b [2]float64) [2]float64 {
+			t := (bound - a[axis]) / (b[axis] - a[axis])
+			c := [2]float64{a[0] + t*(b[0]-a[0]), a[1] + t*(b[1]-a[1])}
+			c[axis] = bound
+			return c
+		}
+
+		res := make([][2]float64, 0, len(ring)+4)
+		for i, cur := range ring {
+			prev := ring[(i+len(ring)-1)%len(ring)]
+			if inside(cur) {
+				if !inside(prev) {
+					res = append(res, intersect(prev, cur))
+				}
+				res = append(res, cur)
+			} else if inside(prev) {
+				res = append(res, intersect(prev, cur))
+			}
+		}
+		ring = res
+	}
+	return ring
+}
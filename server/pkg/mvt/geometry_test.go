@@ -0,0 +1,141 @@
+package mvt
+
+import (
+	"testing"
+
+	geojson "github.com/paulmach/go.geojson"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandWriter(t *testing.T) {
+	// examples from the vector tile specification
+	w := &commandWriter{}
+	w.moveTo(point{25, 17})
+	assert.Equal(t, []uint32{9, 50, 34}, w.commands)
+
+	w = &commandWriter{}
+	w.moveTo(point{5, 7}, point{3, 2})
+	assert.Equal(t, []uint32{17, 10, 14, 3, 9}, w.commands)
+
+	w = &commandWriter{}
+	w.moveTo(point{2, 2})
+	w.lineTo(point{2, 10}, point{10, 10})
+	assert.Equal(t, []uint32{9, 4, 4, 18, 0, 16, 16, 0}, w.commands)
+
+	w = &commandWriter{}
+	w.moveTo(point{3, 6})
+	w.lineTo(point{8, 12}, point{20, 34})
+	w.closePath()
+	assert.Equal(t, []uint32{9, 6, 12, 18, 10, 12, 24, 44, 15}, w.commands)
+}
+
+func TestZigzag(t *testing.T) {
+	assert.Equal(t, uint32(0), zigzag(0))
+	assert.Equal(t, uint32(1), zigzag(-1))
+	assert.Equal(t, uint32(2), zigzag(1))
+	assert.Equal(t, uint32(3), zigzag(-2))
+	assert.Equal(t, uint32(4294967295), zigzag(-2147483648))
+}
+
+func TestProjector_encode(t *testing.T) {
+	// the tile covers the north east quarter of the world, where 90 degrees of longitude is 2048 units
+	p := newProjector(TileID{Z: 1, X: 1, Y: 0}, 4096, 64)
+
+	tests := []struct {
+		name string
+		g    *geojson.Geometry
+		want []encodedGeometry
+	}{
+		{
+			name: "point",
+			g:    geojson.NewPointGeometry([]float64{90, 0}),
+			want: []encodedGeometry{{Type: geomTypePoint, Commands: []uint32{9, 4096, 8192}}},
+		},
+		{
+			name: "point outside of the tile",
+			g:    geojson.NewPointGeometry([]float64{-90, 0}),
+			want: nil,
+		},
+		{
+			name: "multi point partly outside of the tile",
+			g:    geojson.NewMultiPointGeometry([]float64{-90, 0}, []float64{90, 0}, []float64{180, 0}),
+			want: []encodedGeometry{{Type: geomTypePoint, Commands: []uint32{17, 4096, 8192, 4096, 0}}},
+		},
+		{
+			name: "line clipped at the buffer",
+			g:    geojson.NewLineStringGeometry([][]float64{{-90, 0}, {90, 0}}),
+			want: []encodedGeometry{{Type: geomTypeLineString, Commands: []uint32{9, 127, 8192, 10, 4224, 0}}},
+		},
+		{
+			name: "line leaving and entering the tile",
+			g:    geojson.NewLineStringGeometry([][]float64{{90, 0}, {-90, 0}, {90, 0}}),
+			want: []encodedGeometry{{Type: geomTypeLineString, Commands: []uint32{9, 4096, 8192, 10, 4223, 0, 9, 0, 0, 10, 4224, 0}}},
+		},
+		{
+			name: "polygon with exterior ring reversed to be clockwise in the tile",
+			g: geojson.NewPolygonGeometry([][][]float64{
+				{{0, 0}, {90, 0}, {90, 66.51326044311186}, {0, 66.51326044311186}, {0, 0}},
+			}),
+			want: []encodedGeometry{{Type: geomTypePolygon, Commands: []uint32{9, 0, 4096, 26, 4096, 0, 0, 4096, 4095, 0, 15}}},
+		},
+		{
+			name: "polygon with hole",
+			g: geojson.NewPolygonGeometry([][][]float64{
+				{{0, 0}, {90, 0}, {90, 66.51326044311186}, {0, 66.51326044311186}, {0, 0}},
+				{{22.5, 40.97989806962013}, {22.5, 21.943045533438177}, {67.5, 21.943045533438177}, {67.5, 40.97989806962013}, {22.5, 40.97989806962013}},
+			}),
+			want: []encodedGeometry{{Type: geomTypePolygon, Commands: []uint32{
+				9, 0, 4096, 26, 4096, 0, 0, 4096, 4095, 0, 15,
+				9, 1024, 2047, 26, 0, 1024, 2048, 0, 0, 1023, 15,
+			}}},
+		},
+		{
+			name: "polygon clipped at the buffer",
+			g: geojson.NewPolygonGeometry([][][]float64{
+				{{-90, 0}, {90, 0}, {90, 66.51326044311186}, {-90, 66.51326044311186}, {-90, 0}},
+			}),
+			want: []encodedGeometry{{Type: geomTypePolygon, Commands: []uint32{9, 127, 4096, 26, 4224, 0, 0, 4096, 4223, 0, 15}}},
+		},
+		{
+			name: "polygon outside of the tile",
+			g: geojson.NewPolygonGeometry([][][]float64{
+				{{-90, 0}, {-10, 0}, {-10, 10}, {-90, 0}},
+			}),
+			want: nil,
+		},
+		{
+			name: "geometry collection",
+			g: geojson.NewCollectionGeometry(
+				geojson.NewPointGeometry([]float64{90, 0}),
+				geojson.NewLineStringGeometry([][]float64{{0, 0}, {90, 0}}),
+			),
+			want: []encodedGeometry{
+				{Type: geomTypePoint, Commands: []uint32{9, 4096, 8192}},
+				{Type: geomTypeLineString, Commands: []uint32{9, 0, 8192, 10, 4096, 0}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, p.encode(tt.g))
+		})
+	}
+}
+
+func TestClipLine(t *testing.T) {
+	assert.Equal(t, [][][2]float64{{{0, 5}, {10, 5}}}, clipLine([][2]float64{{-5, 5}, {15, 5}}, 0, 10))
+	assert.Equal(t, [][][2]float64{{{5, 5}, {10, 5}}, {{10, 6}, {5, 6}}}, clipLine([][2]float64{{5, 5}, {15, 5}, {15, 6}, {5, 6}}, 0, 10))
+	assert.Nil(t, clipLine([][2]float64{{-5, -5}, {-1, -1}}, 0, 10))
+}
+
+func TestClipRing(t *testing.T) {
+	assert.Equal(t, [][2]float64{{0, 0}, {5, 0}, {5, 5}, {0, 5}}, clipRing([][2]float64{{0, 0}, {5, 0}, {5, 5}, {0, 5}}, 0, 10))
+	assert.Equal(t, [][2]float64{{5, 0}, {10, 0}, {10, 5}, {5, 5}}, clipRing([][2]float64{{5, -5}, {15, -5}, {15, 5}, {5, 5}}, 0, 10))
+	assert.Empty(t, clipRing([][2]float64{{20, 20}, {30, 20}, {30, 30}}, 0, 10))
+}
+
+func TestRingArea(t *testing.T) {
+	assert.Equal(t, int64(200), ringArea([]point{{0, 0}, {10, 0}, {10, 10}, {0, 10}}))
+	assert.Equal(t, int64(-200), ringArea([]point{{0, 0}, {0, 10}, {10, 10}, {10, 0}}))
+}
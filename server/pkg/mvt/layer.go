@@ -0,0 +1,192 @@
+package mvt
+
+import (
+	"encoding/json"
+	"sort"
+
+	geojson "github.com/paulmach/go.geojson"
+)
+
+// DefaultExtent is the number of units along each side of a tile
+const DefaultExtent = 4096
+
+// DefaultBuffer is the number of units around a tile where geometries are kept to avoid seams at tile edges
+const DefaultBuffer = 64
+
+const version = 2
+
+type Layer struct {
+	Name     string
+	Extent   uint32
+	Buffer   uint32
+	features []feature
+}
+
+type feature struct {
+	geometry   *geojson.Geometry
+	properties map[string]any
+}
+
+func NewLayer(name string) *Layer {
+	return &Layer{
+		Name:   name,
+		Extent: DefaultExtent,
+		Buffer: DefaultBuffer,
+	}
+}
+
+// Add adds a feature whose geometry is in longitude and latitude.
+// Properties of strings, numbers and booleans are kept as they are, and others are encoded as JSON strings.
+func (l *Layer) Add(g *geojson.Geometry, properties map[string]any) {
+	if g == nil {
+		return
+	}
+	l.features = append(l.features, feature{geometry: g, properties: properties})
+}
+
+func (l *Layer) Len() int {
+	return len(l.features)
+}
+
+// Marshal encodes the layers clipped to the tile in the Mapbox Vector Tile 2.1 format.
+// Layers without any feature in the tile are omitted.
+func Marshal(t TileID, layers ...*Layer) ([]byte, error) {
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+
+	var b []byte
+	for _, l := range layers {
+		if l == nil {
+			continue
+		}
+		if lb := l.marshal(t); lb != nil {
+			b = appendBytesField(b, 3, lb)
+		}
+	}
+	return b, nil
+}
+
+func (l *Layer) marshal(t TileID) []byte {
+	p := newProjector(t, l.Extent, l.Buffer)
+	keys := map[string]uint32{}
+	values := map[any]uint32{}
+	var keyList []string
+	var valueList []any
+
+	var features []byte
+	var id uint64
+	for _, f := range l.features {
+		geometries := p.encode(f.geometry)
+		if len(geometries) == 0 {
+			continue
+		}
+
+		tags := make([]uint32, 0, len(f.properties)*2)
+		for _, k := range sortedKeys(f.properties) {
+			v, ok := propertyValue(f.properties[k])
+			if !ok {
+				continue
+			}
+			ki, ok := keys[k]
+			if !ok {
+				ki = uint32(len(keyList))
+				keys[k] = ki
+				keyList = append(keyList, k)
+			}
+			vi, ok := values[v]
+			if !ok {
+				vi = uint32(len(valueList))
+				values[v] = vi
+				valueList = append(valueList, v)
+			}
+			tags = append(tags, ki, vi)
+		}
+
+		for _, g := range geometries {
+			id++
+			var fb []byte
+			fb = appendVarintField(fb, 1, id)
+			fb = appendPackedField(fb, 2, tags)
+			fb = appendVarintField(fb, 3, uint64(g.Type))
+			fb = appendPackedField(fb, 4, g.Commands)
+			features = appendBytesField(features, 2, fb)
+		}
+	}
+
+	if id == 0 {
+		return nil
+	}
+
+	var b []byte
+	b = appendVarintField(b, 15, version)
+	b = appendBytesField(b, 1, []byte(l.Name))
+	b = append(b, features...)
+	for _, k := range keyList {
+		b = appendBytesField(b, 3, []byte(k))
+	}
+	for _, v := range valueList {
+		b = appendBytesField(b, 4, marshalValue(v))
+	}
+	b = appendVarintField(b, 5, uint64(l.Extent))
+	return b
+}
+
+// propertyValue normalizes the property into a string, float64, int64, uint64 or bool.
+func propertyValue(v any) (any, bool) {
+	switch v := v.(type) {
+	case nil:
+		return nil, false
+	case string, float64, int64, uint64, bool:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case uint:
+		return uint64(v), true
+	case uint32:
+		return uint64(v), true
+	}
+	j, err := json.Marshal(v)
+	if err != nil {
+		return nil, false
+	}
+	return string(j), true
+}
+
+func marshalValue(v any) []byte {
+	var b []byte
+	switch v := v.(type) {
+	case string:
+		b = appendBytesField(b, 1, []byte(v))
+	case float64:
+		b = appendDoubleField(b, 3, v)
+	case int64:
+		if v < 0 {
+			b = appendVarintField(b, 6, uint64((v<<1)^(v>>63)))
+		} else {
+			b = appendVarintField(b, 5, uint64(v))
+		}
+	case uint64:
+		b = appendVarintField(b, 5, v)
+	case bool:
+		var u uint64
+		if v {
+			u = 1
+		}
+		b = appendVarintField(b, 7, u)
+	}
+	return b
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
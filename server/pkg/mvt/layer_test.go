@@ -0,0 +1,130 @@
+package mvt
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	geojson "github.com/paulmach/go.geojson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshal(t *testing.T) {
+	tile := TileID{Z: 1, X: 1, Y: 0}
+
+	l := NewLayer("places")
+	l.Add(geojson.NewPointGeometry([]float64{90, 0}), map[string]any{
+		"name":  "a",
+		"count": 1,
+		"score": 1.5,
+		"open":  true,
+		"tags":  []string{"x", "y"},
+		"empty": nil,
+	})
+	l.Add(geojson.NewPointGeometry([]float64{45, 0}), map[string]any{
+		"name":  "b",
+		"count": -1,
+	})
+	// outside of the tile
+	l.Add(geojson.NewPointGeometry([]float64{-90, 0}), map[string]any{"name": "c"})
+	l.Add(nil, nil)
+	assert.Equal(t, 3, l.Len())
+
+	empty := NewLayer("empty")
+	empty.Add(geojson.NewPointGeometry([]float64{-90, 0}), nil)
+
+	b, err := Marshal(tile, l, empty, nil)
+	require.NoError(t, err)
+
+	layers := decodeMessage(t, b)[3]
+	require.Len(t, layers, 1)
+	layer := decodeMessage(t, layers[0].([]byte))
+
+	assert.Equal(t, []any{uint64(2)}, layer[15])
+	assert.Equal(t, []any{[]byte("places")}, layer[1])
+	assert.Equal(t, []any{uint64(4096)}, layer[5])
+	assert.Equal(t, []any{[]byte("count"), []byte("name"), []byte("open"), []byte("score"), []byte("tags")}, layer[3])
+
+	values := make([]map[int][]any, 0, len(layer[4]))
+	for _, v := range layer[4] {
+		values = append(values, decodeMessage(t, v.([]byte)))
+	}
+	assert.Equal(t, []map[int][]any{
+		{5: {uint64(1)}},
+		{1: {[]byte("a")}},
+		{7: {uint64(1)}},
+		{3: {1.5}},
+		{1: {[]byte(`["x","y"]`)}},
+		{6: {uint64(1)}},
+		{1: {[]byte("b")}},
+	}, values)
+
+	require.Len(t, layer[2], 2)
+	f1 := decodeMessage(t, layer[2][0].([]byte))
+	assert.Equal(t, []any{uint64(1)}, f1[1])
+	assert.Equal(t, []any{[]byte{0, 0, 1, 1, 2, 2, 3, 3, 4, 4}}, f1[2])
+	assert.Equal(t, []any{uint64(geomTypePoint)}, f1[3])
+	assert.Equal(t, []any{[]byte{9, 0x80, 0x20, 0x80, 0x40}}, f1[4])
+
+	f2 := decodeMessage(t, layer[2][1].([]byte))
+	assert.Equal(t, []any{uint64(2)}, f2[1])
+	assert.Equal(t, []any{[]byte{0, 5, 1, 6}}, f2[2])
+}
+
+func TestMarshal_Empty(t *testing.T) {
+	b, err := Marshal(TileID{Z: 0, X: 0, Y: 0}, NewLayer("empty"))
+	assert.NoError(t, err)
+	assert.Empty(t, b)
+
+	b, err = Marshal(TileID{Z: 0, X: 1, Y: 0}, NewLayer("empty"))
+	assert.Equal(t, ErrInvalidTile, err)
+	assert.Nil(t, b)
+}
+
+func TestMarshal_Deterministic(t *testing.T) {
+	props := map[string]any{"a": 1, "b": 2, "c": 3, "d": 4, "e": 5}
+	l := NewLayer("l")
+	l.Add(geojson.NewPointGeometry([]float64{0, 0}), props)
+
+	first, err := Marshal(TileID{}, l)
+	require.NoError(t, err)
+	for range 10 {
+		b, err := Marshal(TileID{}, l)
+		require.NoError(t, err)
+		assert.Equal(t, first, b)
+	}
+}
+
+// decodeMessage decodes the fields of a protobuf message into varints, doubles and raw bytes.
+func decodeMessage(t *testing.T, b []byte) map[int][]any {
+	t.Helper()
+	res := map[int][]any{}
+	for len(b) > 0 {
+		tag, n := binary.Uvarint(b)
+		require.Positive(t, n)
+		b = b[n:]
+		field := int(tag >> 3)
+		switch tag & 0x7 {
+		case wireVarint:
+			v, n := binary.Uvarint(b)
+			require.Positive(t, n)
+			b = b[n:]
+			res[field] = append(res[field], v)
+		case wireFixed64:
+			require.GreaterOrEqual(t, len(b), 8)
+			res[field] = append(res[field], math.Float64frombits(binary.LittleEndian.Uint64(b)))
+			b = b[8:]
+		case wireBytes:
+			l, n := binary.Uvarint(b)
+			require.Positive(t, n)
+			b = b[n:]
+			require.GreaterOrEqual(t, uint64(len(b)), l)
+			res[field] = append(res[field], b[:l])
+			b = b[l:]
+		default:
+			t.Fatalf("unexpected wire type %d", tag&0x7)
+		}
+	}
+	return res
+}
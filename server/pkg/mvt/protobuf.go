@@ -0,0 +1,44 @@
+package mvt
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// protobuf wire types used by the vector tile messages
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+)
+
+func appendTag(b []byte, field int, wire int) []byte {
+	return binary.AppendUvarint(b, uint64(field)<<3|uint64(wire))
+}
+
+func appendVarintField(b []byte, field int, v uint64) []byte {
+	b = appendTag(b, field, wireVarint)
+	return binary.AppendUvarint(b, v)
+}
+
+func appendDoubleField(b []byte, field int, v float64) []byte {
+	b = appendTag(b, field, wireFixed64)
+	return binary.LittleEndian.AppendUint64(b, math.Float64bits(v))
+}
+
+func appendBytesField(b []byte, field int, v []byte) []byte {
+	b = appendTag(b, field, wireBytes)
+	b = binary.AppendUvarint(b, uint64(len(v)))
+	return append(b, v...)
+}
+
+func appendPackedField(b []byte, field int, v []uint32) []byte {
+	if len(v) == 0 {
+		return b
+	}
+	var p []byte
+	for _, u := range v {
+		p = binary.AppendUvarint(p, uint64(u))
+	}
+	return appendBytesField(b, field, p)
+}
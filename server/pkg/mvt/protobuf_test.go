@@ -0,0 +1,15 @@
+package mvt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendField(t *testing.T) {
+	assert.Equal(t, []byte{0x08, 0x96, 0x01}, appendVarintField(nil, 1, 150))
+	assert.Equal(t, []byte{0x12, 0x07, 't', 'e', 's', 't', 'i', 'n', 'g'}, appendBytesField(nil, 2, []byte("testing")))
+	assert.Equal(t, []byte{0x19, 0, 0, 0, 0, 0, 0, 0xf0, 0x3f}, appendDoubleField(nil, 3, 1))
+	assert.Equal(t, []byte{0x22, 0x06, 0x03, 0x8e, 0x02, 0x9e, 0xa7, 0x05}, appendPackedField(nil, 4, []uint32{3, 270, 86942}))
+	assert.Nil(t, appendPackedField(nil, 4, nil))
+}
@@ -0,0 +1,70 @@
+package mvt
+
+import (
+	"math"
+
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+)
+
+// MaxZoom is the deepest zoom level of the tile pyramid
+const MaxZoom = 24
+
+// maxLatitude is the latitude where the web mercator projection becomes square
+const maxLatitude = 85.0511287798066
+
+var ErrInvalidTile = rerror.NewE(i18n.T("invalid tile"))
+
+// TileID identifies a tile of the web mercator (EPSG:3857) tile pyramid in the XYZ scheme.
+type TileID struct {
+	Z uint32
+	X uint32
+	Y uint32
+}
+
+func (t TileID) Validate() error {
+	if t.Z > MaxZoom {
+		return ErrInvalidTile
+	}
+	n := uint32(1) << t.Z
+	if t.X >= n || t.Y >= n {
+		return ErrInvalidTile
+	}
+	return nil
+}
+
+// Bounds returns the tile area as [west, south, east, north] in longitude and latitude.
+func (t TileID) Bounds() []float64 {
+	return t.bounds(0)
+}
+
+// BufferedBounds returns the area of the tile and the default buffer around it where geometries are kept.
+func (t TileID) BufferedBounds() []float64 {
+	return t.bounds(float64(DefaultBuffer) / DefaultExtent)
+}
+
+func (t TileID) bounds(buffer float64) []float64 {
+	n := math.Exp2(float64(t.Z))
+	return []float64{
+		math.Max(-180, (float64(t.X)-buffer)/n*360-180),
+		tileLatitude(float64(t.Y)+1+buffer, n),
+		math.Min(180, (float64(t.X)+1+buffer)/n*360-180),
+		tileLatitude(float64(t.Y)-buffer, n),
+	}
+}
+
+// project converts a longitude and latitude into the tile coordinates of the given extent.
+func (t TileID) project(p []float64, extent uint32) [2]float64 {
+	n := math.Exp2(float64(t.Z))
+	lat := math.Max(-maxLatitude, math.Min(maxLatitude, p[1])) * math.Pi / 180
+	x := (p[0] + 180) / 360 * n
+	y := (1 - math.Log(math.Tan(lat)+1/math.Cos(lat))/math.Pi) / 2 * n
+	return [2]float64{
+		(x - float64(t.X)) * float64(extent),
+		(y - float64(t.Y)) * float64(extent),
+	}
+}
+
+func tileLatitude(y, n float64) float64 {
+	return math.Atan(math.Sinh(math.Pi*(1-2*y/n))) * 180 / math.Pi
+}
@@ -0,0 +1,51 @@
+package mvt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTileID_Validate(t *testing.T) {
+	assert.NoError(t, TileID{Z: 0, X: 0, Y: 0}.Validate())
+	assert.NoError(t, TileID{Z: 2, X: 3, Y: 3}.Validate())
+	assert.NoError(t, TileID{Z: MaxZoom, X: 1 << MaxZoom >> 1, Y: 0}.Validate())
+	assert.Equal(t, ErrInvalidTile, TileID{Z: 2, X: 4, Y: 0}.Validate())
+	assert.Equal(t, ErrInvalidTile, TileID{Z: 2, X: 0, Y: 4}.Validate())
+	assert.Equal(t, ErrInvalidTile, TileID{Z: MaxZoom + 1}.Validate())
+}
+
+func TestTileID_Bounds(t *testing.T) {
+	b := TileID{Z: 0, X: 0, Y: 0}.Bounds()
+	assert.InDeltaSlice(t, []float64{-180, -maxLatitude, 180, maxLatitude}, b, 1e-9)
+
+	b = TileID{Z: 1, X: 1, Y: 0}.Bounds()
+	assert.InDeltaSlice(t, []float64{0, 0, 180, maxLatitude}, b, 1e-9)
+
+	// the tile containing Tokyo Station
+	b = TileID{Z: 14, X: 14552, Y: 6451}.Bounds()
+	assert.InDeltaSlice(t, []float64{139.7461, 35.6751, 139.7681, 35.6930}, b, 1e-4)
+}
+
+func TestTileID_BufferedBounds(t *testing.T) {
+	b := TileID{Z: 0, X: 0, Y: 0}.BufferedBounds()
+	assert.InDeltaSlice(t, []float64{-180, -85.5134, 180, 85.5134}, b, 1e-3)
+
+	b = TileID{Z: 1, X: 1, Y: 0}.BufferedBounds()
+	assert.InDeltaSlice(t, []float64{-2.8125, -2.8117, 180, 85.2879}, b, 1e-3)
+}
+
+func TestTileID_project(t *testing.T) {
+	tile := TileID{Z: 1, X: 1, Y: 0}
+	assert.InDeltaSlice(t, []float64{0, 4096}, project(tile, []float64{0, 0}), 1e-9)
+	assert.InDeltaSlice(t, []float64{4096, 0}, project(tile, []float64{180, maxLatitude}), 1e-6)
+	// latitudes beyond the projection are clamped
+	assert.InDeltaSlice(t, []float64{2048, 0}, project(tile, []float64{90, 89}), 1e-6)
+	// positions outside of the tile are projected into negative or exceeding coordinates
+	assert.InDeltaSlice(t, []float64{-2048, 4096}, project(tile, []float64{-90, 0}), 1e-9)
+}
+
+func project(t TileID, p []float64) []float64 {
+	c := t.project(p, 4096)
+	return c[:]
+}
@@ -0,0 +1,110 @@
+package notification
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidID   = errors.New("invalid notification id")
+	ErrNoWorkspace = errors.New("workspace is required")
+	ErrNoUser      = errors.New("user is required")
+	ErrInvalidType = errors.New("invalid notification type")
+)
+
+type Builder struct {
+	n *Notification
+}
+
+func New() *Builder {
+	return &Builder{n: &Notification{}}
+}
+
+func (b *Builder) Build() (*Notification, error) {
+	if b.n.id.IsNil() {
+		return nil, ErrInvalidID
+	}
+	if b.n.workspace.IsNil() {
+		return nil, ErrNoWorkspace
+	}
+	if b.n.user.IsNil() {
+		return nil, ErrNoUser
+	}
+	if _, ok := TypeFrom(b.n.typ.String()); !ok {
+		return nil, ErrInvalidType
+	}
+	return b.n, nil
+}
+
+func (b *Builder) MustBuild() *Notification {
+	n, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (b *Builder) NewID() *Builder {
+	b.n.id = NewID()
+	return b
+}
+
+func (b *Builder) ID(id ID) *Builder {
+	b.n.id = id
+	return b
+}
+
+func (b *Builder) Workspace(w WorkspaceID) *Builder {
+	b.n.workspace = w
+	return b
+}
+
+func (b *Builder) User(u UserID) *Builder {
+	b.n.user = u
+	return b
+}
+
+func (b *Builder) Type(t Type) *Builder {
+	b.n.typ = t
+	return b
+}
+
+func (b *Builder) Actor(u *UserID) *Builder {
+	b.n.actor = u.CloneRef()
+	return b
+}
+
+func (b *Builder) Request(r *RequestID) *Builder {
+	b.n.request = r.CloneRef()
+	return b
+}
+
+func (b *Builder) Thread(t *ThreadID) *Builder {
+	b.n.thread = t.CloneRef()
+	return b
+}
+
+func (b *Builder) Comment(c *CommentID) *Builder {
+	b.n.comment = c.CloneRef()
+	return b
+}
+
+func (b *Builder) Title(t string) *Builder {
+	b.n.title = t
+	return b
+}
+
+func (b *Builder) Content(c string) *Builder {
+	b.n.content = c
+	return b
+}
+
+func (b *Builder) ReadAt(t *time.Time) *Builder {
+	b.n.readAt = t
+	return b
+}
+
+func (b *Builder) EmailPending(p bool) *Builder {
+	b.n.emailPending = p
+	return b
+}
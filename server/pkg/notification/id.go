@@ -0,0 +1,22 @@
+package notification
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+)
+
+type ID = id.NotificationID
+type IDList = id.NotificationIDList
+type WorkspaceID = accountdomain.WorkspaceID
+type UserID = accountdomain.UserID
+type UserIDList = accountdomain.UserIDList
+type ProjectID = id.ProjectID
+type RequestID = id.RequestID
+type ThreadID = id.ThreadID
+type CommentID = id.CommentID
+
+var NewID = id.NewNotificationID
+var MustID = id.MustNotificationID
+var IDFrom = id.NotificationIDFrom
+var IDFromRef = id.NotificationIDFromRef
+var IDListFrom = id.NotificationIDListFrom
@@ -0,0 +1,124 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+// Notification tells a user about an event on a request or a thread, it is shown in the feed of the user and may also be emailed.
+type Notification struct {
+	id           ID
+	workspace    WorkspaceID
+	user         UserID
+	typ          Type
+	actor        *UserID
+	request      *RequestID
+	thread       *ThreadID
+	comment      *CommentID
+	title        string
+	content      string
+	readAt       *time.Time
+	emailPending bool
+}
+
+type List []*Notification
+
+func (n *Notification) ID() ID {
+	return n.id
+}
+
+func (n *Notification) Workspace() WorkspaceID {
+	return n.workspace
+}
+
+func (n *Notification) User() UserID {
+	return n.user
+}
+
+func (n *Notification) Type() Type {
+	return n.typ
+}
+
+func (n *Notification) Actor() *UserID {
+	return n.actor.CloneRef()
+}
+
+func (n *Notification) Request() *RequestID {
+	return n.request.CloneRef()
+}
+
+func (n *Notification) Thread() *ThreadID {
+	return n.thread.CloneRef()
+}
+
+func (n *Notification) Comment() *CommentID {
+	return n.comment.CloneRef()
+}
+
+// Title is the title of the request the notification is about.
+func (n *Notification) Title() string {
+	return n.title
+}
+
+// Content is the content of the comment the notification is about.
+func (n *Notification) Content() string {
+	return n.content
+}
+
+func (n *Notification) CreatedAt() time.Time {
+	return n.id.Timestamp()
+}
+
+func (n *Notification) ReadAt() *time.Time {
+	return util.CloneRef(n.readAt)
+}
+
+func (n *Notification) IsRead() bool {
+	return n.readAt != nil
+}
+
+// EmailPending reports whether the notification waits for the next digest email.
+func (n *Notification) EmailPending() bool {
+	return n.emailPending
+}
+
+func (n *Notification) MarkAsRead() {
+	if n.readAt == nil {
+		n.readAt = new(util.Now())
+	}
+}
+
+func (n *Notification) SetEmailPending(p bool) {
+	n.emailPending = p
+}
+
+func (n *Notification) Clone() *Notification {
+	if n == nil {
+		return nil
+	}
+	return &Notification{
+		id:           n.id.Clone(),
+		workspace:    n.workspace.Clone(),
+		user:         n.user.Clone(),
+		typ:          n.typ,
+		actor:        n.actor.CloneRef(),
+		request:      n.request.CloneRef(),
+		thread:       n.thread.CloneRef(),
+		comment:      n.comment.CloneRef(),
+		title:        n.title,
+		content:      n.content,
+		readAt:       util.CloneRef(n.readAt),
+		emailPending: n.emailPending,
+	}
+}
+
+// GroupByUser groups the notifications by their recipients keeping the order of the list.
+func (l List) GroupByUser() map[UserID]List {
+	return lo.GroupBy(l, func(n *Notification) UserID { return n.user })
+}
+
+func (l List) Users() UserIDList {
+	return lo.Uniq(lo.Map(l, func(n *Notification, _ int) UserID { return n.user }))
+}
@@ -0,0 +1,74 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_Build(t *testing.T) {
+	wid, uid, actor, rid := accountdomain.NewWorkspaceID(), accountdomain.NewUserID(), accountdomain.NewUserID(), id.NewRequestID()
+
+	n, err := New().NewID().Workspace(wid).User(uid).Type(TypeRequestAssigned).Actor(&actor).Request(&rid).
+		Title("foo").EmailPending(true).Build()
+	assert.NoError(t, err)
+	assert.Equal(t, wid, n.Workspace())
+	assert.Equal(t, uid, n.User())
+	assert.Equal(t, TypeRequestAssigned, n.Type())
+	assert.Equal(t, &actor, n.Actor())
+	assert.Equal(t, &rid, n.Request())
+	assert.Nil(t, n.Thread())
+	assert.Nil(t, n.Comment())
+	assert.Equal(t, "foo", n.Title())
+	assert.Equal(t, n.ID().Timestamp(), n.CreatedAt())
+	assert.True(t, n.EmailPending())
+	assert.False(t, n.IsRead())
+
+	_, err = New().Workspace(wid).User(uid).Type(TypeComment).Build()
+	assert.Equal(t, ErrInvalidID, err)
+	_, err = New().NewID().User(uid).Type(TypeComment).Build()
+	assert.Equal(t, ErrNoWorkspace, err)
+	_, err = New().NewID().Workspace(wid).Type(TypeComment).Build()
+	assert.Equal(t, ErrNoUser, err)
+	_, err = New().NewID().Workspace(wid).User(uid).Type("xxx").Build()
+	assert.Equal(t, ErrInvalidType, err)
+}
+
+func TestNotification_MarkAsRead(t *testing.T) {
+	now := util.Now()
+	defer util.MockNow(now)()
+
+	n := New().NewID().Workspace(accountdomain.NewWorkspaceID()).User(accountdomain.NewUserID()).Type(TypeComment).MustBuild()
+	n.MarkAsRead()
+	assert.True(t, n.IsRead())
+	assert.Equal(t, &now, n.ReadAt())
+
+	// the first read time is kept
+	defer util.MockNow(now.Add(1))()
+	n.MarkAsRead()
+	assert.Equal(t, &now, n.ReadAt())
+}
+
+func TestNotification_Clone(t *testing.T) {
+	n := New().NewID().Workspace(accountdomain.NewWorkspaceID()).User(accountdomain.NewUserID()).Type(TypeComment).
+		Thread(id.NewThreadID().Ref()).Comment(id.NewCommentID().Ref()).Content("hi").MustBuild()
+	c := n.Clone()
+	assert.Equal(t, n, c)
+	assert.NotSame(t, n, c)
+	assert.Nil(t, (*Notification)(nil).Clone())
+}
+
+func TestList(t *testing.T) {
+	u1, u2 := accountdomain.NewUserID(), accountdomain.NewUserID()
+	wid := accountdomain.NewWorkspaceID()
+	n1 := New().NewID().Workspace(wid).User(u1).Type(TypeComment).MustBuild()
+	n2 := New().NewID().Workspace(wid).User(u2).Type(TypeComment).MustBuild()
+	n3 := New().NewID().Workspace(wid).User(u1).Type(TypeRequestClosed).MustBuild()
+	l := List{n1, n2, n3}
+
+	assert.Equal(t, UserIDList{u1, u2}, l.Users())
+	assert.Equal(t, map[UserID]List{u1: {n1, n3}, u2: {n2}}, l.GroupByUser())
+}
@@ -0,0 +1,67 @@
+package notification
+
+import (
+	"slices"
+
+	"github.com/samber/lo"
+)
+
+// Preference is how a user wants to be notified, users without a preference get the default one.
+type Preference struct {
+	user           UserID
+	emailFrequency EmailFrequency
+	mutedTypes     []Type
+}
+
+type PreferenceList []*Preference
+
+func NewPreference(user UserID, emailFrequency EmailFrequency, mutedTypes []Type) *Preference {
+	if _, ok := EmailFrequencyFrom(emailFrequency.String()); !ok {
+		emailFrequency = EmailFrequencyInstant
+	}
+	return &Preference{
+		user:           user,
+		emailFrequency: emailFrequency,
+		mutedTypes:     lo.Uniq(mutedTypes),
+	}
+}
+
+func DefaultPreference(user UserID) *Preference {
+	return NewPreference(user, EmailFrequencyInstant, nil)
+}
+
+func (p *Preference) User() UserID {
+	return p.user
+}
+
+func (p *Preference) EmailFrequency() EmailFrequency {
+	return p.emailFrequency
+}
+
+func (p *Preference) MutedTypes() []Type {
+	return slices.Clone(p.mutedTypes)
+}
+
+// IsMuted reports whether the user does not want to be notified of the type at all.
+func (p *Preference) IsMuted(t Type) bool {
+	return slices.Contains(p.mutedTypes, t)
+}
+
+func (p *Preference) Clone() *Preference {
+	if p == nil {
+		return nil
+	}
+	return &Preference{
+		user:           p.user,
+		emailFrequency: p.emailFrequency,
+		mutedTypes:     slices.Clone(p.mutedTypes),
+	}
+}
+
+// For returns the preference of the user, or the default one when the user has not set it.
+func (l PreferenceList) For(u UserID) *Preference {
+	if p, ok := lo.Find(l, func(p *Preference) bool { return p.user == u }); ok {
+		return p
+	}
+	return DefaultPreference(u)
+}
@@ -0,0 +1,44 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreference(t *testing.T) {
+	uid := accountdomain.NewUserID()
+
+	p := NewPreference(uid, EmailFrequencyDigest, []Type{TypeComment, TypeComment})
+	assert.Equal(t, uid, p.User())
+	assert.Equal(t, EmailFrequencyDigest, p.EmailFrequency())
+	assert.Equal(t, []Type{TypeComment}, p.MutedTypes())
+	assert.True(t, p.IsMuted(TypeComment))
+	assert.False(t, p.IsMuted(TypeRequestAssigned))
+	assert.Equal(t, p, p.Clone())
+
+	assert.Equal(t, EmailFrequencyInstant, NewPreference(uid, "xxx", nil).EmailFrequency())
+}
+
+func TestPreferenceList_For(t *testing.T) {
+	u1, u2 := accountdomain.NewUserID(), accountdomain.NewUserID()
+	p := NewPreference(u1, EmailFrequencyNever, nil)
+
+	assert.Same(t, p, PreferenceList{p}.For(u1))
+	assert.Equal(t, DefaultPreference(u2), PreferenceList{p}.For(u2))
+}
+
+func TestTypeFrom(t *testing.T) {
+	ty, ok := TypeFrom("COMMENT")
+	assert.True(t, ok)
+	assert.Equal(t, TypeComment, ty)
+	_, ok = TypeFrom("xxx")
+	assert.False(t, ok)
+
+	f, ok := EmailFrequencyFrom("digest")
+	assert.True(t, ok)
+	assert.Equal(t, EmailFrequencyDigest, f)
+	_, ok = EmailFrequencyFrom("xxx")
+	assert.False(t, ok)
+}
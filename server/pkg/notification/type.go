@@ -0,0 +1,50 @@
+package notification
+
+import "strings"
+
+type Type string
+
+const (
+	TypeRequestAssigned         Type = "request_assigned"
+	TypeRequestApproved         Type = "request_approved"
+	TypeRequestChangesRequested Type = "request_changes_requested"
+	TypeRequestClosed           Type = "request_closed"
+	TypeComment                 Type = "comment"
+	TypeMention                 Type = "mention"
+)
+
+func TypeFrom(s string) (Type, bool) {
+	switch t := Type(strings.ToLower(s)); t {
+	case TypeRequestAssigned, TypeRequestApproved, TypeRequestChangesRequested, TypeRequestClosed, TypeComment, TypeMention:
+		return t, true
+	default:
+		return Type(""), false
+	}
+}
+
+func (t Type) String() string {
+	return string(t)
+}
+
+type EmailFrequency string
+
+const (
+	// EmailFrequencyInstant sends an email for each notification as soon as it is created.
+	EmailFrequencyInstant EmailFrequency = "instant"
+	// EmailFrequencyDigest batches the notifications into one email sent periodically.
+	EmailFrequencyDigest EmailFrequency = "digest"
+	EmailFrequencyNever  EmailFrequency = "never"
+)
+
+func EmailFrequencyFrom(s string) (EmailFrequency, bool) {
+	switch f := EmailFrequency(strings.ToLower(s)); f {
+	case EmailFrequencyInstant, EmailFrequencyDigest, EmailFrequencyNever:
+		return f, true
+	default:
+		return EmailFrequency(""), false
+	}
+}
+
+func (f EmailFrequency) String() string {
+	return string(f)
+}
@@ -112,6 +112,16 @@ func (b *Builder) RequestRoles(requestRoles []workspace.Role) *Builder {
 	return b
 }
 
+func (b *Builder) Localization(localization *Localization) *Builder {
+	b.p.localization = localization.Clone()
+	return b
+}
+
+func (b *Builder) AssetMetadataSchema(sid *SchemaID) *Builder {
+	b.p.assetMetadata = sid.CloneRef()
+	return b
+}
+
 func (b *Builder) StarCount(starCount int64) *Builder {
 	b.p.starCount = starCount
 	return b
@@ -110,6 +110,15 @@ func TestBuilder_RequestRoles(t *testing.T) {
 	}, res)
 }
 
+func TestBuilder_Localization(t *testing.T) {
+	var tb = New().NewID()
+	l, _ := NewLocalization("ja", []string{"ja", "en"}, nil)
+	res := tb.Localization(l)
+	assert.Equal(t, &Builder{
+		p: &Project{id: tb.p.id, localization: l},
+	}, res)
+}
+
 func TestBuilder_Topics(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -9,6 +9,7 @@ type ID = id.ProjectID
 type WorkspaceID = id.WorkspaceID
 type APIKeyID = id.APIKeyID
 type ModelID = id.ModelID
+type SchemaID = id.SchemaID
 
 type IDList = id.ProjectIDList
 type ModelIDList = id.ModelIDList
@@ -0,0 +1,150 @@
+package project
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"golang.org/x/text/language"
+)
+
+var (
+	ErrInvalidLocale        = rerror.NewE(i18n.T("invalid locale"))
+	ErrDefaultLocaleMissing = rerror.NewE(i18n.T("default locale must be one of the project locales"))
+)
+
+// Localization holds the locales in which the project content is published.
+// Values of localizable fields that are not translated fall back along the
+// chain of the requested locale, ending with the default locale.
+type Localization struct {
+	defaultLocale string
+	locales       []string
+	fallbacks     map[string][]string
+}
+
+func NewLocalization(defaultLocale string, locales []string, fallbacks map[string][]string) (*Localization, error) {
+	dl, ok := NormalizeLocale(defaultLocale)
+	if !ok {
+		return nil, ErrInvalidLocale
+	}
+
+	ll := make([]string, 0, len(locales))
+	for _, l := range locales {
+		nl, ok := NormalizeLocale(l)
+		if !ok {
+			return nil, ErrInvalidLocale
+		}
+		ll = append(ll, nl)
+	}
+	ll = lo.Uniq(ll)
+	if !slices.Contains(ll, dl) {
+		return nil, ErrDefaultLocaleMissing
+	}
+
+	fb := make(map[string][]string, len(fallbacks))
+	for l, chain := range fallbacks {
+		nl, ok := NormalizeLocale(l)
+		if !ok || !slices.Contains(ll, nl) {
+			return nil, ErrInvalidLocale
+		}
+		nc := make([]string, 0, len(chain))
+		for _, c := range chain {
+			ncl, ok := NormalizeLocale(c)
+			if !ok || !slices.Contains(ll, ncl) {
+				return nil, ErrInvalidLocale
+			}
+			if ncl != nl {
+				nc = append(nc, ncl)
+			}
+		}
+		if len(nc) > 0 {
+			fb[nl] = lo.Uniq(nc)
+		}
+	}
+
+	return &Localization{
+		defaultLocale: dl,
+		locales:       ll,
+		fallbacks:     fb,
+	}, nil
+}
+
+// NormalizeLocale returns the canonical form of a BCP 47 language tag.
+func NormalizeLocale(l string) (string, bool) {
+	if l == "" {
+		return "", false
+	}
+	t, err := language.Parse(l)
+	if err != nil {
+		return "", false
+	}
+	return t.String(), true
+}
+
+func (l *Localization) DefaultLocale() string {
+	if l == nil {
+		return ""
+	}
+	return l.defaultLocale
+}
+
+func (l *Localization) Locales() []string {
+	if l == nil {
+		return nil
+	}
+	return slices.Clone(l.locales)
+}
+
+func (l *Localization) Fallbacks() map[string][]string {
+	if l == nil {
+		return nil
+	}
+	return maps.Clone(l.fallbacks)
+}
+
+func (l *Localization) Has(locale string) bool {
+	if l == nil {
+		return false
+	}
+	nl, ok := NormalizeLocale(locale)
+	return ok && slices.Contains(l.locales, nl)
+}
+
+// FallbackChain returns the locales to look up, in order, when resolving a value for the locale.
+// The requested locale comes first and the default locale last.
+func (l *Localization) FallbackChain(locale string) []string {
+	nl, ok := NormalizeLocale(locale)
+	if !ok {
+		if l == nil {
+			return nil
+		}
+		return []string{l.defaultLocale}
+	}
+	if l == nil {
+		return []string{nl}
+	}
+
+	res := []string{nl}
+	res = append(res, l.fallbacks[nl]...)
+	res = append(res, l.defaultLocale)
+	return lo.Uniq(res)
+}
+
+func (l *Localization) Clone() *Localization {
+	if l == nil {
+		return nil
+	}
+
+	fb := make(map[string][]string, len(l.fallbacks))
+	for k, v := range l.fallbacks {
+		fb[k] = slices.Clone(v)
+	}
+
+	return &Localization{
+		defaultLocale: l.defaultLocale,
+		locales:       slices.Clone(l.locales),
+		fallbacks:     fb,
+	}
+}
@@ -0,0 +1,88 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLocalization(t *testing.T) {
+	l, err := NewLocalization("ja", []string{"ja", "en", "en-us", "ja"}, map[string][]string{"en-US": {"en", "en-US"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "ja", l.DefaultLocale())
+	assert.Equal(t, []string{"ja", "en", "en-US"}, l.Locales())
+	assert.Equal(t, map[string][]string{"en-US": {"en"}}, l.Fallbacks())
+
+	_, err = NewLocalization("", []string{"ja"}, nil)
+	assert.Equal(t, ErrInvalidLocale, err)
+
+	_, err = NewLocalization("ja", []string{"ja", "!!"}, nil)
+	assert.Equal(t, ErrInvalidLocale, err)
+
+	_, err = NewLocalization("fr", []string{"ja", "en"}, nil)
+	assert.Equal(t, ErrDefaultLocaleMissing, err)
+
+	_, err = NewLocalization("ja", []string{"ja", "en"}, map[string][]string{"en": {"fr"}})
+	assert.Equal(t, ErrInvalidLocale, err)
+
+	_, err = NewLocalization("ja", []string{"ja", "en"}, map[string][]string{"fr": {"en"}})
+	assert.Equal(t, ErrInvalidLocale, err)
+}
+
+func TestNormalizeLocale(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+		ok    bool
+	}{
+		{input: "ja", want: "ja", ok: true},
+		{input: "en-us", want: "en-US", ok: true},
+		{input: "EN_gb", want: "en-GB", ok: true},
+		{input: "", want: "", ok: false},
+		{input: "!!", want: "", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			got, ok := NormalizeLocale(tt.input)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+}
+
+func TestLocalization_Has(t *testing.T) {
+	l, _ := NewLocalization("ja", []string{"ja", "en"}, nil)
+	assert.True(t, l.Has("ja"))
+	assert.True(t, l.Has("EN"))
+	assert.False(t, l.Has("fr"))
+	assert.False(t, l.Has(""))
+
+	var nilL *Localization
+	assert.False(t, nilL.Has("ja"))
+}
+
+func TestLocalization_FallbackChain(t *testing.T) {
+	l, _ := NewLocalization("ja", []string{"ja", "en", "en-US"}, map[string][]string{"en-US": {"en"}})
+
+	assert.Equal(t, []string{"en-US", "en", "ja"}, l.FallbackChain("en-us"))
+	assert.Equal(t, []string{"en", "ja"}, l.FallbackChain("en"))
+	assert.Equal(t, []string{"ja"}, l.FallbackChain("ja"))
+	assert.Equal(t, []string{"fr", "ja"}, l.FallbackChain("fr"))
+	assert.Equal(t, []string{"ja"}, l.FallbackChain(""))
+
+	var nilL *Localization
+	assert.Equal(t, []string{"en"}, nilL.FallbackChain("en"))
+	assert.Nil(t, nilL.FallbackChain(""))
+}
+
+func TestLocalization_Clone(t *testing.T) {
+	l, _ := NewLocalization("ja", []string{"ja", "en"}, map[string][]string{"en": {"ja"}})
+	c := l.Clone()
+	assert.Equal(t, l, c)
+	assert.NotSame(t, l, c)
+
+	var nilL *Localization
+	assert.Nil(t, nilL.Clone())
+}
@@ -35,6 +35,8 @@ type Project struct {
 	updatedAt     time.Time
 	accessibility *Accessibility
 	requestRoles  []workspace.Role
+	localization  *Localization
+	assetMetadata *SchemaID
 }
 
 func (p *Project) ID() ID {
@@ -102,6 +104,15 @@ func (p *Project) RequestRoles() []workspace.Role {
 	return p.requestRoles
 }
 
+func (p *Project) Localization() *Localization {
+	return p.localization
+}
+
+// AssetMetadataSchema returns the schema which defines the custom metadata fields of the assets of the project.
+func (p *Project) AssetMetadataSchema() *SchemaID {
+	return p.assetMetadata.CloneRef()
+}
+
 func (p *Project) SetUpdatedAt(updatedAt time.Time) {
 	p.updatedAt = updatedAt
 }
@@ -120,6 +131,14 @@ func (p *Project) SetAccessibility(accessibility Accessibility) {
 	p.accessibility = accessibility.Clone()
 }
 
+func (p *Project) SetLocalization(l *Localization) {
+	p.localization = l.Clone()
+}
+
+func (p *Project) SetAssetMetadataSchema(sid SchemaID) {
+	p.assetMetadata = sid.Ref()
+}
+
 func (p *Project) UpdateName(name string) {
 	p.name = name
 }
@@ -206,6 +225,8 @@ func (p *Project) Clone() *Project {
 		updatedAt:     p.updatedAt,
 		accessibility: p.accessibility.Clone(),
 		requestRoles:  p.requestRoles,
+		localization:  p.localization.Clone(),
+		assetMetadata: p.assetMetadata.CloneRef(),
 	}
 }
 
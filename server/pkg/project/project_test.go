@@ -103,6 +103,17 @@ func TestProject_SetRequestRoles(t *testing.T) {
 	assert.Equal(t, p.RequestRoles(), r)
 }
 
+func TestProject_SetLocalization(t *testing.T) {
+	p := &Project{}
+	l, _ := NewLocalization("ja", []string{"ja", "en"}, nil)
+	p.SetLocalization(l)
+	assert.Equal(t, l, p.Localization())
+	assert.NotSame(t, l, p.Localization())
+
+	p.SetLocalization(nil)
+	assert.Nil(t, p.Localization())
+}
+
 func TestProject_SetTopics(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -193,7 +204,8 @@ func TestProject_UpdateAlias(t *testing.T) {
 func TestProject_Clone(t *testing.T) {
 	pub := &Accessibility{}
 	r := []workspace.Role{workspace.RoleOwner, workspace.RoleMaintainer}
-	p := New().NewID().Name("a").Accessibility(pub).RequestRoles(r).MustBuild()
+	l, _ := NewLocalization("ja", []string{"ja", "en"}, nil)
+	p := New().NewID().Name("a").Accessibility(pub).RequestRoles(r).Localization(l).MustBuild()
 
 	got := p.Clone()
 	assert.Equal(t, p, got)
@@ -0,0 +1,81 @@
+package request
+
+import (
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+type Decision string
+
+var DecisionApproved Decision = "approved"
+var DecisionChangesRequested Decision = "changes_requested"
+
+func (d Decision) String() string {
+	return string(d)
+}
+
+func DecisionFrom(s string) Decision {
+	switch Decision(strings.ToLower(s)) {
+	case DecisionApproved:
+		return DecisionApproved
+	case DecisionChangesRequested:
+		return DecisionChangesRequested
+	default:
+		return Decision("")
+	}
+}
+
+// Approval records the decision made by a reviewer in a stage of the request.
+type Approval struct {
+	user      UserID
+	stage     int
+	decision  Decision
+	comment   string
+	createdAt time.Time
+}
+
+func NewApproval(user UserID, stage int, decision Decision, comment string, createdAt time.Time) *Approval {
+	return &Approval{
+		user:      user,
+		stage:     stage,
+		decision:  decision,
+		comment:   comment,
+		createdAt: createdAt,
+	}
+}
+
+func (a *Approval) User() UserID {
+	return a.user
+}
+
+func (a *Approval) Stage() int {
+	return a.stage
+}
+
+func (a *Approval) Decision() Decision {
+	return a.decision
+}
+
+func (a *Approval) Comment() string {
+	return a.comment
+}
+
+func (a *Approval) CreatedAt() time.Time {
+	return a.createdAt
+}
+
+type ApprovalList []*Approval
+
+func (l ApprovalList) ByStage(stage int) ApprovalList {
+	return lo.Filter(l, func(a *Approval, _ int) bool { return a.stage == stage })
+}
+
+func (l ApprovalList) Approved() ApprovalList {
+	return lo.Filter(l, func(a *Approval, _ int) bool { return a.decision == DecisionApproved })
+}
+
+func (l ApprovalList) HasUser(u UserID) bool {
+	return lo.ContainsBy(l, func(a *Approval) bool { return a.user == u })
+}
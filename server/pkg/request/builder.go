@@ -37,6 +37,9 @@ func (b *Builder) Build() (*Request, error) {
 	if b.r.title == "" {
 		return nil, ErrEmptyTitle
 	}
+	if b.r.currentStage < 0 || (b.r.currentStage > 0 && b.r.currentStage >= len(b.r.stages)) {
+		return nil, ErrInvalidStage
+	}
 	if b.r.state == "" {
 		b.r.state = StateWaiting
 	}
@@ -119,3 +122,18 @@ func (b *Builder) ClosedAt(c *time.Time) *Builder {
 	b.r.closedAt = c
 	return b
 }
+
+func (b *Builder) Stages(s StageList) *Builder {
+	b.r.stages = s.Clone()
+	return b
+}
+
+func (b *Builder) CurrentStage(i int) *Builder {
+	b.r.currentStage = i
+	return b
+}
+
+func (b *Builder) Approvals(a ApprovalList) *Builder {
+	b.r.approvals = a
+	return b
+}
@@ -275,3 +275,22 @@ func TestNew(t *testing.T) {
 	b := New()
 	assert.NotNil(t, b.r)
 }
+
+func TestBuilder_Stages(t *testing.T) {
+	itm, _ := NewItem(NewItemID(), nil)
+	s, _ := NewStage("editor", UserIDList{NewUserID()}, 1)
+	b := func() *Builder {
+		return New().NewID().Workspace(NewWorkspaceID()).Project(NewProjectID()).CreatedBy(NewUserID()).
+			Items(ItemList{itm}).Title("foo")
+	}
+
+	r, err := b().Stages(StageList{s}).Build()
+	assert.NoError(t, err)
+	assert.Equal(t, StageList{s}, r.Stages())
+	assert.Equal(t, s, r.CurrentStage())
+
+	_, err = b().CurrentStage(1).Build()
+	assert.Equal(t, ErrInvalidStage, err)
+	_, err = b().Stages(StageList{s}).CurrentStage(1).Build()
+	assert.Equal(t, ErrInvalidStage, err)
+}
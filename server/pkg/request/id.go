@@ -12,6 +12,8 @@ type ItemID = id.ItemID
 type UserID = accountdomain.UserID
 type UserIDList = accountdomain.UserIDList
 type ThreadID = id.ThreadID
+type ModelID = id.ModelID
+type WorkflowID = id.WorkflowID
 
 var NewID = id.NewRequestID
 var NewWorkspaceID = accountdomain.NewWorkspaceID
@@ -19,6 +21,7 @@ var NewProjectID = id.NewProjectID
 var NewThreadID = id.NewThreadID
 var NewUserID = accountdomain.NewUserID
 var NewItemID = id.NewItemID
+var NewWorkflowID = id.NewWorkflowID
 var MustID = id.MustRequestID
 var IDFrom = id.RequestIDFrom
 var IDFromRef = id.RequestIDFromRef
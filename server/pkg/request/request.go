@@ -9,6 +9,7 @@ import (
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
 )
 
 var (
@@ -18,19 +19,22 @@ var (
 )
 
 type Request struct {
-	id          ID
-	workspace   accountdomain.WorkspaceID
-	project     ProjectID
-	items       ItemList
-	title       string
-	description string
-	createdBy   UserID
-	reviewers   UserIDList
-	state       State
-	updatedAt   time.Time
-	approvedAt  *time.Time
-	closedAt    *time.Time
-	thread      *ThreadID
+	id           ID
+	workspace    accountdomain.WorkspaceID
+	project      ProjectID
+	items        ItemList
+	title        string
+	description  string
+	createdBy    UserID
+	reviewers    UserIDList
+	state        State
+	updatedAt    time.Time
+	approvedAt   *time.Time
+	closedAt     *time.Time
+	thread       *ThreadID
+	stages       StageList
+	currentStage int
+	approvals    ApprovalList
 }
 
 func (r *Request) ID() ID {
@@ -89,6 +93,84 @@ func (r *Request) Thread() *ThreadID {
 	return r.thread
 }
 
+// Stages returns the stages of the approval workflow, requests without stages are approved by any one of the reviewers.
+func (r *Request) Stages() StageList {
+	return r.stages.Clone()
+}
+
+func (r *Request) CurrentStageIndex() int {
+	return r.currentStage
+}
+
+// CurrentStage returns the stage which the request is waiting for, or nil if the index of the stage is out of range.
+func (r *Request) CurrentStage() *Stage {
+	if len(r.stages) == 0 {
+		return &Stage{reviewers: r.reviewers, requiredApprovals: 1}
+	}
+	if r.currentStage < 0 || r.currentStage >= len(r.stages) {
+		return nil
+	}
+	return r.stages[r.currentStage]
+}
+
+func (r *Request) Approvals() ApprovalList {
+	return slices.Clone(r.approvals)
+}
+
+// Approve records the approval of the reviewer and moves the request to the next stage when the current stage has
+// enough approvals. It reports whether the request has been approved in all the stages.
+func (r *Request) Approve(u UserID) (bool, error) {
+	if err := r.checkReviewer(u); err != nil {
+		return false, err
+	}
+	r.approvals = append(r.approvals, NewApproval(u, r.currentStage, DecisionApproved, "", util.Now()))
+
+	if len(r.currentApprovals().ByStage(r.currentStage).Approved()) < r.CurrentStage().RequiredApprovals() {
+		return false, nil
+	}
+	if r.currentStage < len(r.stages)-1 {
+		r.currentStage++
+		return false, nil
+	}
+	r.SetState(StateApproved)
+	return true, nil
+}
+
+// RequestChanges records the decision of the reviewer and sends the request back to its creator.
+func (r *Request) RequestChanges(u UserID, comment string) error {
+	if err := r.checkReviewer(u); err != nil {
+		return err
+	}
+	r.approvals = append(r.approvals, NewApproval(u, r.currentStage, DecisionChangesRequested, comment, util.Now()))
+	r.SetState(StateChangesRequested)
+	return nil
+}
+
+func (r *Request) checkReviewer(u UserID) error {
+	if r.state != StateWaiting {
+		return ErrNotWaiting
+	}
+	if s := r.CurrentStage(); s == nil || !s.Reviewers().Has(u) {
+		return ErrNotStageReviewer
+	}
+	if r.currentApprovals().ByStage(r.currentStage).HasUser(u) {
+		return ErrAlreadyReviewedInTheStage
+	}
+	return nil
+}
+
+// currentApprovals returns the approvals made after the last time changes were requested,
+// the older ones are kept as the history of the review.
+func (r *Request) currentApprovals() ApprovalList {
+	_, i, ok := lo.FindLastIndexOf(r.approvals, func(a *Approval) bool {
+		return a.Decision() == DecisionChangesRequested
+	})
+	if !ok {
+		return r.approvals
+	}
+	return r.approvals[i+1:]
+}
+
 func (r *Request) SetTitle(title string) error {
 	if title == "" {
 		return ErrEmptyTitle
@@ -114,6 +196,10 @@ func (r *Request) SetItems(items ItemList) error {
 }
 
 func (r *Request) SetState(state State) {
+	// the request which is submitted again after changes were requested is reviewed from the first stage
+	if r.state == StateChangesRequested && state == StateWaiting {
+		r.currentStage = 0
+	}
 	r.state = state
 	switch state {
 	case StateClosed:
@@ -108,3 +108,127 @@ func TestRequest_UpdatedAt(t *testing.T) {
 
 	assert.Equal(t, time, r.UpdatedAt())
 }
+
+func TestRequest_Approve(t *testing.T) {
+	u1, u2, u3 := accountdomain.NewUserID(), accountdomain.NewUserID(), accountdomain.NewUserID()
+	editor, _ := NewStage("editor", UserIDList{u1, u2}, 2)
+	legal, _ := NewStage("legal", UserIDList{u3}, 1)
+	req := &Request{state: StateWaiting, stages: StageList{editor, legal}, reviewers: UserIDList{u1, u2, u3}}
+
+	_, err := req.Approve(u3)
+	assert.Equal(t, ErrNotStageReviewer, err)
+
+	approved, err := req.Approve(u1)
+	assert.NoError(t, err)
+	assert.False(t, approved)
+	assert.Equal(t, 0, req.CurrentStageIndex())
+
+	_, err = req.Approve(u1)
+	assert.Equal(t, ErrAlreadyReviewedInTheStage, err)
+
+	approved, err = req.Approve(u2)
+	assert.NoError(t, err)
+	assert.False(t, approved)
+	assert.Equal(t, 1, req.CurrentStageIndex())
+	assert.Equal(t, "legal", req.CurrentStage().Name())
+
+	approved, err = req.Approve(u3)
+	assert.NoError(t, err)
+	assert.True(t, approved)
+	assert.Equal(t, StateApproved, req.State())
+	assert.NotNil(t, req.ApprovedAt())
+	assert.Len(t, req.Approvals(), 3)
+
+	_, err = req.Approve(u3)
+	assert.Equal(t, ErrNotWaiting, err)
+
+	// requests without stages are approved by any one of the reviewers
+	req = &Request{state: StateWaiting, reviewers: UserIDList{u1, u2}}
+	approved, err = req.Approve(u2)
+	assert.NoError(t, err)
+	assert.True(t, approved)
+	assert.Equal(t, StateApproved, req.State())
+}
+
+func TestRequest_CurrentStage(t *testing.T) {
+	u1, u2 := accountdomain.NewUserID(), accountdomain.NewUserID()
+	editor, _ := NewStage("editor", UserIDList{u1}, 1)
+
+	assert.Equal(t, editor, (&Request{stages: StageList{editor}}).CurrentStage())
+	assert.Equal(t, &Stage{reviewers: UserIDList{u1, u2}, requiredApprovals: 1}, (&Request{reviewers: UserIDList{u1, u2}}).CurrentStage())
+
+	// the stages may have been changed after the request moved to a later stage
+	req := &Request{state: StateWaiting, stages: StageList{editor}, currentStage: 1}
+	assert.Nil(t, req.CurrentStage())
+	_, err := req.Approve(u1)
+	assert.Equal(t, ErrNotStageReviewer, err)
+	assert.Nil(t, (&Request{stages: StageList{editor}, currentStage: -1}).CurrentStage())
+}
+
+func TestRequest_RequestChanges(t *testing.T) {
+	u1, u2 := accountdomain.NewUserID(), accountdomain.NewUserID()
+	editor, _ := NewStage("editor", UserIDList{u1}, 1)
+	legal, _ := NewStage("legal", UserIDList{u2}, 1)
+	req := &Request{state: StateWaiting, stages: StageList{editor, legal}, reviewers: UserIDList{u1, u2}}
+
+	_, _ = req.Approve(u1)
+	assert.Equal(t, 1, req.CurrentStageIndex())
+
+	assert.Equal(t, ErrNotStageReviewer, req.RequestChanges(u1, "x"))
+	assert.NoError(t, req.RequestChanges(u2, "fix the title"))
+	assert.Equal(t, StateChangesRequested, req.State())
+	a := req.Approvals()[1]
+	assert.Equal(t, u2, a.User())
+	assert.Equal(t, 1, a.Stage())
+	assert.Equal(t, DecisionChangesRequested, a.Decision())
+	assert.Equal(t, "fix the title", a.Comment())
+
+	_, err := req.Approve(u2)
+	assert.Equal(t, ErrNotWaiting, err)
+
+	// the request submitted again is reviewed from the first stage and the previous approvals are not counted
+	req.SetState(StateWaiting)
+	assert.Equal(t, 0, req.CurrentStageIndex())
+	approved, err := req.Approve(u1)
+	assert.NoError(t, err)
+	assert.False(t, approved)
+	approved, err = req.Approve(u2)
+	assert.NoError(t, err)
+	assert.True(t, approved)
+	assert.Len(t, req.Approvals(), 4)
+}
+
+func TestRequest_SetState_Resubmit(t *testing.T) {
+	u1, u2, u3 := accountdomain.NewUserID(), accountdomain.NewUserID(), accountdomain.NewUserID()
+	editor, _ := NewStage("editor", UserIDList{u1, u2}, 2)
+	legal, _ := NewStage("legal", UserIDList{u3}, 1)
+	req := &Request{state: StateWaiting, stages: StageList{editor, legal}, reviewers: UserIDList{u1, u2, u3}}
+
+	_, _ = req.Approve(u1)
+	_, _ = req.Approve(u2)
+	assert.Equal(t, 1, req.CurrentStageIndex())
+	assert.NoError(t, req.RequestChanges(u3, "x"))
+
+	// the stage is kept until the request is submitted again
+	assert.Equal(t, 1, req.CurrentStageIndex())
+	req.SetState(StateWaiting)
+	assert.Equal(t, 0, req.CurrentStageIndex())
+	assert.Equal(t, "editor", req.CurrentStage().Name())
+	assert.Empty(t, req.currentApprovals())
+
+	// the approvals made before the changes were requested are not counted
+	approved, err := req.Approve(u1)
+	assert.NoError(t, err)
+	assert.False(t, approved)
+	assert.Equal(t, 0, req.CurrentStageIndex())
+	approved, err = req.Approve(u2)
+	assert.NoError(t, err)
+	assert.False(t, approved)
+	assert.Equal(t, 1, req.CurrentStageIndex())
+	assert.Len(t, req.currentApprovals(), 2)
+	assert.Len(t, req.Approvals(), 5)
+
+	// setting the same state does not reset the stage
+	req.SetState(StateWaiting)
+	assert.Equal(t, 1, req.CurrentStageIndex())
+}
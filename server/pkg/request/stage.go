@@ -0,0 +1,94 @@
+package request
+
+import (
+	"slices"
+
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+var (
+	ErrEmptyStageName            = rerror.NewE(i18n.T("stage name cannot be empty"))
+	ErrEmptyStageReviewers       = rerror.NewE(i18n.T("stage reviewers cannot be empty"))
+	ErrInvalidRequiredApprovals  = rerror.NewE(i18n.T("required approvals must be between 1 and the number of reviewers"))
+	ErrDuplicatedStageName       = rerror.NewE(i18n.T("duplicated stage name"))
+	ErrEmptyStages               = rerror.NewE(i18n.T("stages cannot be empty"))
+	ErrInvalidStage              = rerror.NewE(i18n.T("invalid stage"))
+	ErrNotWaiting                = rerror.NewE(i18n.T("only requests with status waiting can be reviewed"))
+	ErrNotStageReviewer          = rerror.NewE(i18n.T("only reviewers of the current stage can review"))
+	ErrAlreadyReviewedInTheStage = rerror.NewE(i18n.T("already reviewed in the current stage"))
+)
+
+// Stage is a step of the approval workflow which is completed when the required number of its reviewers approve.
+type Stage struct {
+	name              string
+	reviewers         UserIDList
+	requiredApprovals int
+}
+
+func NewStage(name string, reviewers UserIDList, requiredApprovals int) (*Stage, error) {
+	if name == "" {
+		return nil, ErrEmptyStageName
+	}
+	reviewers = lo.Uniq(reviewers)
+	if len(reviewers) == 0 {
+		return nil, ErrEmptyStageReviewers
+	}
+	if requiredApprovals < 1 || requiredApprovals > len(reviewers) {
+		return nil, ErrInvalidRequiredApprovals
+	}
+	return &Stage{
+		name:              name,
+		reviewers:         reviewers,
+		requiredApprovals: requiredApprovals,
+	}, nil
+}
+
+func (s *Stage) Name() string {
+	return s.name
+}
+
+func (s *Stage) Reviewers() UserIDList {
+	return slices.Clone(s.reviewers)
+}
+
+func (s *Stage) RequiredApprovals() int {
+	return s.requiredApprovals
+}
+
+func (s *Stage) Clone() *Stage {
+	if s == nil {
+		return nil
+	}
+	return &Stage{
+		name:              s.name,
+		reviewers:         slices.Clone(s.reviewers),
+		requiredApprovals: s.requiredApprovals,
+	}
+}
+
+type StageList []*Stage
+
+func (l StageList) Validate() error {
+	if len(l) == 0 {
+		return ErrEmptyStages
+	}
+	names := lo.Map(l, func(s *Stage, _ int) string { return s.Name() })
+	if len(lo.Uniq(names)) != len(names) {
+		return ErrDuplicatedStageName
+	}
+	return nil
+}
+
+// Reviewers returns the reviewers of all the stages without duplication.
+func (l StageList) Reviewers() UserIDList {
+	return lo.Uniq(lo.FlatMap(l, func(s *Stage, _ int) []UserID { return s.reviewers }))
+}
+
+func (l StageList) Clone() StageList {
+	if l == nil {
+		return nil
+	}
+	return lo.Map(l, func(s *Stage, _ int) *Stage { return s.Clone() })
+}
@@ -0,0 +1,42 @@
+package request
+
+import (
+	"testing"
+
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStage(t *testing.T) {
+	u1, u2 := accountdomain.NewUserID(), accountdomain.NewUserID()
+
+	s, err := NewStage("legal", UserIDList{u1, u2, u1}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "legal", s.Name())
+	assert.Equal(t, UserIDList{u1, u2}, s.Reviewers())
+	assert.Equal(t, 2, s.RequiredApprovals())
+
+	_, err = NewStage("", UserIDList{u1}, 1)
+	assert.Equal(t, ErrEmptyStageName, err)
+	_, err = NewStage("legal", nil, 1)
+	assert.Equal(t, ErrEmptyStageReviewers, err)
+	_, err = NewStage("legal", UserIDList{u1}, 0)
+	assert.Equal(t, ErrInvalidRequiredApprovals, err)
+	_, err = NewStage("legal", UserIDList{u1}, 2)
+	assert.Equal(t, ErrInvalidRequiredApprovals, err)
+}
+
+func TestStageList(t *testing.T) {
+	u1, u2 := accountdomain.NewUserID(), accountdomain.NewUserID()
+	s1, _ := NewStage("editor", UserIDList{u1}, 1)
+	s2, _ := NewStage("legal", UserIDList{u1, u2}, 1)
+
+	assert.NoError(t, StageList{s1, s2}.Validate())
+	assert.Equal(t, ErrEmptyStages, StageList{}.Validate())
+	assert.Equal(t, ErrDuplicatedStageName, StageList{s1, s1}.Validate())
+	assert.Equal(t, UserIDList{u1, u2}, StageList{s1, s2}.Reviewers())
+
+	c := StageList{s1, s2}.Clone()
+	assert.Equal(t, StageList{s1, s2}, c)
+	assert.NotSame(t, s1, c[0])
+}
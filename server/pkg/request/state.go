@@ -8,6 +8,7 @@ var StateApproved State = "approved"
 var StateClosed State = "closed"
 var StateWaiting State = "waiting"
 var StateDraft State = "draft"
+var StateChangesRequested State = "changes_requested"
 
 func (s State) String() string {
 	return string(s)
@@ -24,6 +25,8 @@ func StateFrom(s string) State {
 		return StateApproved
 	case StateClosed:
 		return StateClosed
+	case StateChangesRequested:
+		return StateChangesRequested
 	default:
 		return State("")
 	}
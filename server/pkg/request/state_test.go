@@ -17,6 +17,8 @@ func TestStateFrom(t *testing.T) {
 	assert.Equal(t, StateDraft, s)
 	s = StateFrom("closed")
 	assert.Equal(t, StateClosed, s)
+	s = StateFrom("changes_requested")
+	assert.Equal(t, StateChangesRequested, s)
 }
 
 func TestState_String(t *testing.T) {
@@ -0,0 +1,146 @@
+package request
+
+import (
+	"time"
+
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
+)
+
+// Workflow defines the approval stages of the requests created in a project.
+// A workflow with a model applies to the requests for the items of the model and takes precedence over the one of the project.
+type Workflow struct {
+	id        WorkflowID
+	workspace accountdomain.WorkspaceID
+	project   ProjectID
+	model     *ModelID
+	stages    StageList
+	updatedAt time.Time
+}
+
+func (w *Workflow) ID() WorkflowID {
+	return w.id
+}
+
+func (w *Workflow) Workspace() accountdomain.WorkspaceID {
+	return w.workspace
+}
+
+func (w *Workflow) Project() ProjectID {
+	return w.project
+}
+
+func (w *Workflow) Model() *ModelID {
+	return w.model.CloneRef()
+}
+
+func (w *Workflow) Stages() StageList {
+	return w.stages.Clone()
+}
+
+func (w *Workflow) UpdatedAt() time.Time {
+	return w.updatedAt
+}
+
+func (w *Workflow) SetStages(stages StageList) error {
+	if err := stages.Validate(); err != nil {
+		return err
+	}
+	w.stages = stages.Clone()
+	return nil
+}
+
+func (w *Workflow) SetUpdatedAt(t time.Time) {
+	w.updatedAt = t
+}
+
+func (w *Workflow) Clone() *Workflow {
+	if w == nil {
+		return nil
+	}
+	return &Workflow{
+		id:        w.id.Clone(),
+		workspace: w.workspace.Clone(),
+		project:   w.project.Clone(),
+		model:     w.model.CloneRef(),
+		stages:    w.stages.Clone(),
+		updatedAt: w.updatedAt,
+	}
+}
+
+type WorkflowList []*Workflow
+
+// ForModel returns the workflow of the model, or the one of the project when the model has no workflow.
+func (l WorkflowList) ForModel(m ModelID) *Workflow {
+	if w, ok := lo.Find(l, func(w *Workflow) bool { return w.model != nil && *w.model == m }); ok {
+		return w
+	}
+	w, _ := lo.Find(l, func(w *Workflow) bool { return w.model == nil })
+	return w
+}
+
+type WorkflowBuilder struct {
+	w *Workflow
+}
+
+func NewWorkflow() *WorkflowBuilder {
+	return &WorkflowBuilder{w: &Workflow{}}
+}
+
+func (b *WorkflowBuilder) Build() (*Workflow, error) {
+	if b.w.id.IsNil() {
+		return nil, ErrInvalidID
+	}
+	if b.w.workspace.IsNil() {
+		return nil, ErrInvalidID
+	}
+	if b.w.project.IsNil() {
+		return nil, ErrInvalidID
+	}
+	if err := b.w.stages.Validate(); err != nil {
+		return nil, err
+	}
+	if b.w.updatedAt.IsZero() {
+		b.w.updatedAt = b.w.id.Timestamp()
+	}
+	return b.w, nil
+}
+
+func (b *WorkflowBuilder) MustBuild() *Workflow {
+	return lo.Must(b.Build())
+}
+
+func (b *WorkflowBuilder) NewID() *WorkflowBuilder {
+	b.w.id = NewWorkflowID()
+	return b
+}
+
+func (b *WorkflowBuilder) ID(id WorkflowID) *WorkflowBuilder {
+	b.w.id = id
+	return b
+}
+
+func (b *WorkflowBuilder) Workspace(w accountdomain.WorkspaceID) *WorkflowBuilder {
+	b.w.workspace = w
+	return b
+}
+
+func (b *WorkflowBuilder) Project(p ProjectID) *WorkflowBuilder {
+	b.w.project = p
+	return b
+}
+
+func (b *WorkflowBuilder) Model(m *ModelID) *WorkflowBuilder {
+	b.w.model = m.CloneRef()
+	return b
+}
+
+func (b *WorkflowBuilder) Stages(s StageList) *WorkflowBuilder {
+	b.w.stages = s.Clone()
+	return b
+}
+
+func (b *WorkflowBuilder) UpdatedAt(t time.Time) *WorkflowBuilder {
+	b.w.updatedAt = t
+	return b
+}
@@ -0,0 +1,40 @@
+package request
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflowBuilder_Build(t *testing.T) {
+	wid, pid, mid := accountdomain.NewWorkspaceID(), id.NewProjectID(), id.NewModelID()
+	s, _ := NewStage("editor", UserIDList{accountdomain.NewUserID()}, 1)
+
+	w, err := NewWorkflow().NewID().Workspace(wid).Project(pid).Model(&mid).Stages(StageList{s}).Build()
+	assert.NoError(t, err)
+	assert.Equal(t, wid, w.Workspace())
+	assert.Equal(t, pid, w.Project())
+	assert.Equal(t, &mid, w.Model())
+	assert.Equal(t, StageList{s}, w.Stages())
+	assert.Equal(t, w.ID().Timestamp(), w.UpdatedAt())
+
+	_, err = NewWorkflow().Workspace(wid).Project(pid).Stages(StageList{s}).Build()
+	assert.Equal(t, ErrInvalidID, err)
+	_, err = NewWorkflow().NewID().Workspace(wid).Stages(StageList{s}).Build()
+	assert.Equal(t, ErrInvalidID, err)
+	_, err = NewWorkflow().NewID().Workspace(wid).Project(pid).Build()
+	assert.Equal(t, ErrEmptyStages, err)
+}
+
+func TestWorkflowList_ForModel(t *testing.T) {
+	wid, pid, m1, m2 := accountdomain.NewWorkspaceID(), id.NewProjectID(), id.NewModelID(), id.NewModelID()
+	s, _ := NewStage("editor", UserIDList{accountdomain.NewUserID()}, 1)
+	pw := NewWorkflow().NewID().Workspace(wid).Project(pid).Stages(StageList{s}).MustBuild()
+	mw := NewWorkflow().NewID().Workspace(wid).Project(pid).Model(&m1).Stages(StageList{s}).MustBuild()
+
+	assert.Same(t, mw, WorkflowList{pw, mw}.ForModel(m1))
+	assert.Same(t, pw, WorkflowList{pw, mw}.ForModel(m2))
+	assert.Nil(t, WorkflowList{mw}.ForModel(m2))
+}
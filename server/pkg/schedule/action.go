@@ -0,0 +1,37 @@
+package schedule
+
+import "strings"
+
+type Action string
+
+const (
+	ActionPublish   Action = "publish"
+	ActionUnpublish Action = "unpublish"
+)
+
+func ActionFrom(s string) (Action, bool) {
+	ss := strings.ToLower(s)
+	switch Action(ss) {
+	case ActionPublish:
+		return ActionPublish, true
+	case ActionUnpublish:
+		return ActionUnpublish, true
+	default:
+		return Action(""), false
+	}
+}
+
+func ActionFromRef(s *string) *Action {
+	if s == nil {
+		return nil
+	}
+	a, ok := ActionFrom(*s)
+	if !ok {
+		return nil
+	}
+	return &a
+}
+
+func (a Action) String() string {
+	return string(a)
+}
@@ -0,0 +1,68 @@
+package schedule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActionFrom(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		want   Action
+		wantOk bool
+	}{
+		{
+			name:   "publish",
+			input:  "publish",
+			want:   ActionPublish,
+			wantOk: true,
+		},
+		{
+			name:   "unpublish",
+			input:  "unpublish",
+			want:   ActionUnpublish,
+			wantOk: true,
+		},
+		{
+			name:   "uppercase PUBLISH",
+			input:  "PUBLISH",
+			want:   ActionPublish,
+			wantOk: true,
+		},
+		{
+			name:   "invalid action",
+			input:  "delete",
+			want:   Action(""),
+			wantOk: false,
+		},
+		{
+			name:   "empty",
+			input:  "",
+			want:   Action(""),
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := ActionFrom(tt.input)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantOk, ok)
+		})
+	}
+}
+
+func TestActionFromRef(t *testing.T) {
+	assert.Nil(t, ActionFromRef(nil))
+	assert.Nil(t, ActionFromRef(new("invalid")))
+	assert.Equal(t, ActionUnpublish, *ActionFromRef(new("unpublish")))
+}
+
+func TestAction_String(t *testing.T) {
+	assert.Equal(t, "publish", ActionPublish.String())
+	assert.Equal(t, "unpublish", ActionUnpublish.String())
+}
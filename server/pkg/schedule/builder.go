@@ -0,0 +1,132 @@
+package schedule
+
+import (
+	"errors"
+	"time"
+
+	"github.com/reearth/reearthx/account/accountdomain"
+)
+
+var (
+	ErrInvalidID       = errors.New("invalid schedule id")
+	ErrNoProjectID     = errors.New("project id is required")
+	ErrNoModelID       = errors.New("model id is required")
+	ErrNoItems         = errors.New("at least one item is required")
+	ErrNoUser          = errors.New("user or integration is required")
+	ErrInvalidAction   = errors.New("invalid schedule action")
+	ErrInvalidStatus   = errors.New("invalid schedule status")
+	ErrNoScheduledTime = errors.New("scheduled time is required")
+)
+
+type Builder struct {
+	s *Schedule
+}
+
+func New() *Builder {
+	return &Builder{s: &Schedule{
+		status: StatusPending,
+	}}
+}
+
+func (b *Builder) Build() (*Schedule, error) {
+	if b.s.id.IsNil() {
+		return nil, ErrInvalidID
+	}
+	if b.s.project.IsNil() {
+		return nil, ErrNoProjectID
+	}
+	if b.s.model.IsNil() {
+		return nil, ErrNoModelID
+	}
+	if len(b.s.items) == 0 {
+		return nil, ErrNoItems
+	}
+	if b.s.user == nil && b.s.integration == nil {
+		return nil, ErrNoUser
+	}
+	if _, ok := ActionFrom(b.s.action.String()); !ok {
+		return nil, ErrInvalidAction
+	}
+	if b.s.scheduledAt.IsZero() {
+		return nil, ErrNoScheduledTime
+	}
+	if b.s.updatedAt.IsZero() {
+		b.s.updatedAt = b.s.id.Timestamp()
+	}
+	return b.s, nil
+}
+
+func (b *Builder) MustBuild() *Schedule {
+	s, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func (b *Builder) ID(id ID) *Builder {
+	b.s.id = id
+	return b
+}
+
+func (b *Builder) NewID() *Builder {
+	b.s.id = NewID()
+	return b
+}
+
+func (b *Builder) Project(pid ProjectID) *Builder {
+	b.s.project = pid
+	return b
+}
+
+func (b *Builder) Model(mid ModelID) *Builder {
+	b.s.model = mid
+	return b
+}
+
+func (b *Builder) Items(ids ItemIDList) *Builder {
+	b.s.items = ids.Clone()
+	return b
+}
+
+func (b *Builder) Action(a Action) *Builder {
+	b.s.action = a
+	return b
+}
+
+func (b *Builder) Status(s Status) *Builder {
+	b.s.status = s
+	return b
+}
+
+func (b *Builder) ScheduledAt(t time.Time) *Builder {
+	b.s.scheduledAt = t
+	return b
+}
+
+func (b *Builder) User(uid accountdomain.UserID) *Builder {
+	b.s.user = &uid
+	b.s.integration = nil
+	return b
+}
+
+func (b *Builder) Integration(iid IntegrationID) *Builder {
+	b.s.integration = &iid
+	b.s.user = nil
+	return b
+}
+
+func (b *Builder) Error(err string) *Builder {
+	b.s.errorMsg = err
+	return b
+}
+
+func (b *Builder) UpdatedAt(t time.Time) *Builder {
+	b.s.updatedAt = t
+	return b
+}
+
+func (b *Builder) ExecutedAt(t *time.Time) *Builder {
+	b.s.executedAt = t
+	return b
+}
@@ -0,0 +1,158 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_Build(t *testing.T) {
+	sid := NewID()
+	pid := id.NewProjectID()
+	mid := id.NewModelID()
+	items := id.ItemIDList{id.NewItemID(), id.NewItemID()}
+	uid := accountdomain.NewUserID()
+	at := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	base := func() *Builder {
+		return New().
+			ID(sid).
+			Project(pid).
+			Model(mid).
+			Items(items).
+			Action(ActionPublish).
+			ScheduledAt(at).
+			User(uid)
+	}
+
+	t.Run("success with user", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := base().Build()
+
+		assert.NoError(t, err)
+		assert.Equal(t, sid, s.ID())
+		assert.Equal(t, pid, s.Project())
+		assert.Equal(t, mid, s.Model())
+		assert.Equal(t, items, s.Items())
+		assert.Equal(t, ActionPublish, s.Action())
+		assert.Equal(t, StatusPending, s.Status())
+		assert.Equal(t, at, s.ScheduledAt())
+		assert.Equal(t, &uid, s.User())
+		assert.Nil(t, s.Integration())
+		assert.Equal(t, sid.Timestamp(), s.UpdatedAt())
+		assert.Equal(t, sid.Timestamp(), s.CreatedAt())
+	})
+
+	t.Run("success with integration", func(t *testing.T) {
+		t.Parallel()
+
+		iid := id.NewIntegrationID()
+		s, err := base().Integration(iid).Build()
+
+		assert.NoError(t, err)
+		assert.Nil(t, s.User())
+		assert.Equal(t, &iid, s.Integration())
+	})
+
+	tests := []struct {
+		name    string
+		builder func() *Builder
+		wantErr error
+	}{
+		{
+			name:    "nil id",
+			builder: func() *Builder { return base().ID(ID{}) },
+			wantErr: ErrInvalidID,
+		},
+		{
+			name:    "nil project",
+			builder: func() *Builder { return base().Project(id.ProjectID{}) },
+			wantErr: ErrNoProjectID,
+		},
+		{
+			name:    "nil model",
+			builder: func() *Builder { return base().Model(id.ModelID{}) },
+			wantErr: ErrNoModelID,
+		},
+		{
+			name:    "no items",
+			builder: func() *Builder { return base().Items(nil) },
+			wantErr: ErrNoItems,
+		},
+		{
+			name: "no operator",
+			builder: func() *Builder {
+				b := base()
+				b.s.user = nil
+				return b
+			},
+			wantErr: ErrNoUser,
+		},
+		{
+			name:    "invalid action",
+			builder: func() *Builder { return base().Action("delete") },
+			wantErr: ErrInvalidAction,
+		},
+		{
+			name:    "no scheduled time",
+			builder: func() *Builder { return base().ScheduledAt(time.Time{}) },
+			wantErr: ErrNoScheduledTime,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := tt.builder().Build()
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, s)
+		})
+	}
+}
+
+func TestBuilder_MustBuild(t *testing.T) {
+	assert.Panics(t, func() {
+		New().MustBuild()
+	})
+
+	assert.NotPanics(t, func() {
+		New().
+			NewID().
+			Project(id.NewProjectID()).
+			Model(id.NewModelID()).
+			Items(id.ItemIDList{id.NewItemID()}).
+			Action(ActionUnpublish).
+			ScheduledAt(time.Now()).
+			Integration(id.NewIntegrationID()).
+			MustBuild()
+	})
+}
+
+func TestBuilder_Optional(t *testing.T) {
+	executed := time.Date(2030, 1, 1, 9, 0, 1, 0, time.UTC)
+	updated := time.Date(2030, 1, 1, 9, 0, 2, 0, time.UTC)
+
+	s := New().
+		NewID().
+		Project(id.NewProjectID()).
+		Model(id.NewModelID()).
+		Items(id.ItemIDList{id.NewItemID()}).
+		Action(ActionPublish).
+		Status(StatusFailed).
+		ScheduledAt(time.Now()).
+		User(accountdomain.NewUserID()).
+		Error("boom").
+		UpdatedAt(updated).
+		ExecutedAt(&executed).
+		MustBuild()
+
+	assert.Equal(t, StatusFailed, s.Status())
+	assert.Equal(t, "boom", s.Error())
+	assert.Equal(t, updated, s.UpdatedAt())
+	assert.Equal(t, &executed, s.ExecutedAt())
+}
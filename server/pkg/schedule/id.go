@@ -0,0 +1,17 @@
+package schedule
+
+import "github.com/reearth/reearth-cms/server/pkg/id"
+
+type ID = id.ScheduleID
+type IDList = id.ScheduleIDList
+type ProjectID = id.ProjectID
+type ModelID = id.ModelID
+type ItemID = id.ItemID
+type ItemIDList = id.ItemIDList
+type IntegrationID = id.IntegrationID
+
+var NewID = id.NewScheduleID
+var MustID = id.MustScheduleID
+var IDFrom = id.ScheduleIDFrom
+var IDFromRef = id.ScheduleIDFromRef
+var IDListFrom = id.ScheduleIDListFrom
@@ -0,0 +1,138 @@
+package schedule
+
+import (
+	"errors"
+	"time"
+
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/util"
+)
+
+var ErrNotPending = errors.New("schedule is not pending")
+
+// Schedule is a deferred publish or unpublish of a set of items of a model.
+type Schedule struct {
+	id          ID
+	project     ProjectID
+	model       ModelID
+	items       ItemIDList
+	action      Action
+	status      Status
+	scheduledAt time.Time
+	user        *accountdomain.UserID
+	integration *IntegrationID
+	errorMsg    string
+	updatedAt   time.Time
+	executedAt  *time.Time
+}
+
+type List []*Schedule
+
+func (s *Schedule) ID() ID {
+	return s.id
+}
+
+func (s *Schedule) Project() ProjectID {
+	return s.project
+}
+
+func (s *Schedule) Model() ModelID {
+	return s.model
+}
+
+func (s *Schedule) Items() ItemIDList {
+	return s.items.Clone()
+}
+
+func (s *Schedule) Action() Action {
+	return s.action
+}
+
+func (s *Schedule) Status() Status {
+	return s.status
+}
+
+func (s *Schedule) ScheduledAt() time.Time {
+	return s.scheduledAt
+}
+
+func (s *Schedule) User() *accountdomain.UserID {
+	return s.user
+}
+
+func (s *Schedule) Integration() *IntegrationID {
+	return s.integration
+}
+
+func (s *Schedule) Error() string {
+	return s.errorMsg
+}
+
+func (s *Schedule) CreatedAt() time.Time {
+	return s.id.Timestamp()
+}
+
+func (s *Schedule) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+func (s *Schedule) ExecutedAt() *time.Time {
+	return s.executedAt
+}
+
+// IsDue reports whether the schedule is still pending and its time has come.
+func (s *Schedule) IsDue(now time.Time) bool {
+	return s.status == StatusPending && !s.scheduledAt.After(now)
+}
+
+func (s *Schedule) Complete() {
+	now := util.Now()
+	s.status = StatusCompleted
+	s.executedAt = &now
+	s.updatedAt = now
+}
+
+func (s *Schedule) Fail(errMsg string) {
+	now := util.Now()
+	s.status = StatusFailed
+	s.errorMsg = errMsg
+	s.executedAt = &now
+	s.updatedAt = now
+}
+
+func (s *Schedule) Cancel() error {
+	if s.status != StatusPending {
+		return ErrNotPending
+	}
+	s.status = StatusCancelled
+	s.updatedAt = util.Now()
+	return nil
+}
+
+func (s *Schedule) Clone() *Schedule {
+	if s == nil {
+		return nil
+	}
+	return &Schedule{
+		id:          s.id.Clone(),
+		project:     s.project.Clone(),
+		model:       s.model.Clone(),
+		items:       s.items.Clone(),
+		action:      s.action,
+		status:      s.status,
+		scheduledAt: s.scheduledAt,
+		user:        s.user.CloneRef(),
+		integration: s.integration.CloneRef(),
+		errorMsg:    s.errorMsg,
+		updatedAt:   s.updatedAt,
+		executedAt:  util.CloneRef(s.executedAt),
+	}
+}
+
+func (l List) IDs() IDList {
+	ids := make(IDList, 0, len(l))
+	for _, s := range l {
+		ids = append(ids, s.ID())
+	}
+	return ids
+}
@@ -0,0 +1,98 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSchedule(at time.Time) *Schedule {
+	return New().
+		NewID().
+		Project(id.NewProjectID()).
+		Model(id.NewModelID()).
+		Items(id.ItemIDList{id.NewItemID()}).
+		Action(ActionPublish).
+		ScheduledAt(at).
+		User(accountdomain.NewUserID()).
+		MustBuild()
+}
+
+func TestSchedule_IsDue(t *testing.T) {
+	now := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	assert.True(t, newSchedule(now).IsDue(now))
+	assert.True(t, newSchedule(now.Add(-time.Minute)).IsDue(now))
+	assert.False(t, newSchedule(now.Add(time.Minute)).IsDue(now))
+
+	s := newSchedule(now.Add(-time.Minute))
+	assert.NoError(t, s.Cancel())
+	assert.False(t, s.IsDue(now))
+}
+
+func TestSchedule_Complete(t *testing.T) {
+	now := time.Now()
+	defer util.MockNow(now)()
+
+	s := newSchedule(now)
+	s.Complete()
+
+	assert.Equal(t, StatusCompleted, s.Status())
+	assert.Equal(t, &now, s.ExecutedAt())
+	assert.Equal(t, now, s.UpdatedAt())
+}
+
+func TestSchedule_Fail(t *testing.T) {
+	now := time.Now()
+	defer util.MockNow(now)()
+
+	s := newSchedule(now)
+	s.Fail("item not found")
+
+	assert.Equal(t, StatusFailed, s.Status())
+	assert.Equal(t, "item not found", s.Error())
+	assert.Equal(t, &now, s.ExecutedAt())
+	assert.Equal(t, now, s.UpdatedAt())
+}
+
+func TestSchedule_Cancel(t *testing.T) {
+	now := time.Now()
+	defer util.MockNow(now)()
+
+	s := newSchedule(now)
+	assert.NoError(t, s.Cancel())
+	assert.Equal(t, StatusCancelled, s.Status())
+	assert.Equal(t, now, s.UpdatedAt())
+	assert.Nil(t, s.ExecutedAt())
+
+	assert.ErrorIs(t, s.Cancel(), ErrNotPending)
+
+	s2 := newSchedule(now)
+	s2.Complete()
+	assert.ErrorIs(t, s2.Cancel(), ErrNotPending)
+}
+
+func TestSchedule_Clone(t *testing.T) {
+	var nilSchedule *Schedule
+	assert.Nil(t, nilSchedule.Clone())
+
+	s := newSchedule(time.Now())
+	s.Fail("error")
+
+	c := s.Clone()
+	assert.Equal(t, s, c)
+	assert.NotSame(t, s, c)
+	assert.NotSame(t, s.executedAt, c.executedAt)
+}
+
+func TestList_IDs(t *testing.T) {
+	s1 := newSchedule(time.Now())
+	s2 := newSchedule(time.Now())
+
+	assert.Equal(t, IDList{s1.ID(), s2.ID()}, List{s1, s2}.IDs())
+	assert.Equal(t, IDList{}, List{}.IDs())
+}
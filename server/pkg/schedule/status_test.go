@@ -0,0 +1,76 @@
+package schedule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusFrom(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		want   Status
+		wantOk bool
+	}{
+		{
+			name:   "pending",
+			input:  "pending",
+			want:   StatusPending,
+			wantOk: true,
+		},
+		{
+			name:   "completed",
+			input:  "completed",
+			want:   StatusCompleted,
+			wantOk: true,
+		},
+		{
+			name:   "failed",
+			input:  "failed",
+			want:   StatusFailed,
+			wantOk: true,
+		},
+		{
+			name:   "cancelled",
+			input:  "Cancelled",
+			want:   StatusCancelled,
+			wantOk: true,
+		},
+		{
+			name:   "invalid status",
+			input:  "in_progress",
+			want:   Status(""),
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := StatusFrom(tt.input)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantOk, ok)
+		})
+	}
+}
+
+func TestStatusFromRef(t *testing.T) {
+	assert.Nil(t, StatusFromRef(nil))
+	assert.Nil(t, StatusFromRef(new("unknown")))
+	assert.Equal(t, StatusPending, *StatusFromRef(new("pending")))
+}
+
+func TestStatus_StringRef(t *testing.T) {
+	var s *Status
+	assert.Nil(t, s.StringRef())
+	assert.Equal(t, new("failed"), new(StatusFailed).StringRef())
+}
+
+func TestStatus_IsFinished(t *testing.T) {
+	assert.False(t, StatusPending.IsFinished())
+	assert.True(t, StatusCompleted.IsFinished())
+	assert.True(t, StatusFailed.IsFinished())
+	assert.True(t, StatusCancelled.IsFinished())
+}
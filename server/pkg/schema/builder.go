@@ -26,6 +26,9 @@ func (b *Builder) Build() (*Schema, error) {
 	if b.s.titleField != nil && (len(b.s.fields) == 0 || b.s.fields == nil) {
 		return nil, ErrInvalidTitleField
 	}
+	if err := b.s.rules.validate(b.s); err != nil {
+		return nil, err
+	}
 	return b.s, nil
 }
 
@@ -66,3 +69,12 @@ func (b *Builder) TitleField(fid *FieldID) *Builder {
 	b.s.titleField = fid.CloneRef()
 	return b
 }
+
+func (b *Builder) Rules(rules Rules) *Builder {
+	if len(rules) == 0 {
+		b.s.rules = nil
+		return b
+	}
+	b.s.rules = rules.Clone()
+	return b
+}
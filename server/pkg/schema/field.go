@@ -10,7 +10,10 @@ import (
 	"github.com/reearth/reearthx/rerror"
 )
 
-var ErrValueRequired = rerror.NewE(i18n.T("value is required"))
+var (
+	ErrValueRequired  = rerror.NewE(i18n.T("value is required"))
+	ErrNotLocalizable = rerror.NewE(i18n.T("field type cannot be localized"))
+)
 
 type Field struct {
 	id           FieldID
@@ -20,6 +23,7 @@ type Field struct {
 	unique       bool
 	multiple     bool
 	required     bool
+	localizable  bool
 	updatedAt    time.Time
 	defaultValue *value.Multiple
 	typeProperty *TypeProperty
@@ -101,6 +105,27 @@ func (f *Field) Required() bool {
 	return f.required
 }
 
+// Localizable reports whether the field holds a separate value for each project locale.
+func (f *Field) Localizable() bool {
+	return f.localizable
+}
+
+func (f *Field) SetLocalizable(l bool) error {
+	if l && !f.canBeLocalized() {
+		return ErrNotLocalizable
+	}
+	f.localizable = l
+	return nil
+}
+
+func (f *Field) canBeLocalized() bool {
+	if f.typeProperty == nil {
+		return true
+	}
+	t := f.Type()
+	return t != value.TypeReference && t != value.TypeGroup
+}
+
 func (f *Field) SetRequired(req bool) {
 	f.required = req
 }
@@ -161,6 +186,7 @@ func (f *Field) Clone() *Field {
 		unique:       f.unique,
 		multiple:     f.multiple,
 		required:     f.required,
+		localizable:  f.localizable,
 		updatedAt:    f.updatedAt,
 		typeProperty: f.typeProperty.Clone(),
 		defaultValue: f.defaultValue.Clone(),
@@ -81,6 +81,9 @@ func (b *FieldBuilder) Build() (*Field, error) {
 			Err:   fmt.Errorf("%s", b.f.key.String()),
 		}
 	}
+	if b.f.localizable && !b.f.canBeLocalized() {
+		return nil, ErrNotLocalizable
+	}
 	if err := b.f.SetDefaultValue(b.dv); err != nil {
 		return nil, err
 	}
@@ -125,6 +128,11 @@ func (b *FieldBuilder) Required(required bool) *FieldBuilder {
 	return b
 }
 
+func (b *FieldBuilder) Localizable(localizable bool) *FieldBuilder {
+	b.f.localizable = localizable
+	return b
+}
+
 func (b *FieldBuilder) Order(o int) *FieldBuilder {
 	b.f.order = o
 	return b
@@ -28,6 +28,7 @@ func TestNewField(t *testing.T) {
 			unique:       true,
 			multiple:     true,
 			required:     true,
+			localizable:  true,
 			typeProperty: tp,
 			order:        3,
 			updatedAt:    now,
@@ -41,6 +42,7 @@ func TestNewField(t *testing.T) {
 			Multiple(true).
 			Unique(true).
 			Required(true).
+			Localizable(true).
 			DefaultValue(dv.AsMultiple()).
 			Order(3).
 			UpdatedAt(now).
@@ -70,6 +72,14 @@ func TestNewField(t *testing.T) {
 		Err:   fmt.Errorf("%s", ""),
 	}, err)
 
+	// error: not localizable
+	_, err = NewField(NewReference(id.NewModelID(), id.NewSchemaID(), nil, nil).TypeProperty()).
+		NewID().
+		Key(k).
+		Localizable(true).
+		Build()
+	assert.Equal(t, ErrNotLocalizable, err)
+
 	// error: invalid default value
 	_, err = NewField(NewText(nil).TypeProperty()).
 		NewID().
@@ -23,6 +23,14 @@ func (f *FieldMarkdown) MaxLength() *int {
 	return f.s.MaxLength()
 }
 
+func (f *FieldMarkdown) Validation() *TextValidation {
+	return f.s.Validation()
+}
+
+func (f *FieldMarkdown) SetValidation(v *TextValidation) error {
+	return f.s.SetValidation(v)
+}
+
 func (f *FieldMarkdown) Type() value.Type {
 	return value.TypeMarkdown
 }
@@ -23,6 +23,14 @@ func (f *FieldRichText) MaxLength() *int {
 	return f.s.MaxLength()
 }
 
+func (f *FieldRichText) Validation() *TextValidation {
+	return f.s.Validation()
+}
+
+func (f *FieldRichText) SetValidation(v *TextValidation) error {
+	return f.s.SetValidation(v)
+}
+
 func (f *FieldRichText) Type() value.Type {
 	return value.TypeRichText
 }
@@ -9,8 +9,9 @@ import (
 )
 
 type FieldString struct {
-	t         value.Type
-	maxLength *int
+	t          value.Type
+	maxLength  *int
+	validation *TextValidation
 }
 
 func NewString(t value.Type, maxLength *int) *FieldString {
@@ -24,6 +25,21 @@ func (f *FieldString) MaxLength() *int {
 	return util.CloneRef(f.maxLength)
 }
 
+func (f *FieldString) Validation() *TextValidation {
+	return f.validation.Clone()
+}
+
+func (f *FieldString) SetValidation(v *TextValidation) error {
+	if min := v.MinLength(); min != nil && f.maxLength != nil && *min > *f.maxLength {
+		return ErrInvalidMinMax
+	}
+	if v.IsEmpty() {
+		v = nil
+	}
+	f.validation = v.Clone()
+	return nil
+}
+
 func (f *FieldString) Type() value.Type {
 	return f.t
 }
@@ -33,8 +49,9 @@ func (f *FieldString) Clone() *FieldString {
 		return nil
 	}
 	return &FieldString{
-		t:         f.t,
-		maxLength: util.CloneRef(f.maxLength),
+		t:          f.t,
+		maxLength:  util.CloneRef(f.maxLength),
+		validation: f.validation.Clone(),
 	}
 }
 
@@ -55,5 +72,5 @@ func (f *FieldString) Validate(v *value.Value) error {
 		}
 	}
 
-	return nil
+	return f.validation.Validate(s)
 }
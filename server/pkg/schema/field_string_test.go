@@ -31,3 +31,23 @@ func TestFieldString_Validate(t *testing.T) {
 	assert.NoError(t, (&FieldString{t: value.TypeText}).Validate(value.TypeText.Value("aaa")))
 	assert.Equal(t, ErrInvalidValue, (&FieldString{t: value.TypeText}).Validate(value.TypeNumber.Value(1)))
 }
+
+func TestFieldString_SetValidation(t *testing.T) {
+	v, _ := NewTextValidation(new(2), "", "", []string{"foo"}, "")
+
+	f := NewString(value.TypeText, new(10))
+	assert.NoError(t, f.SetValidation(v))
+	assert.Equal(t, v, f.Validation())
+	assert.Equal(t, &ValueError{
+		Code:    FieldValidationCodeMinLengthNotMet,
+		Message: "value has 1 characters, but it should be at least 2 characters",
+	}, f.Validate(value.TypeText.Value("a")))
+	assert.Equal(t, FieldValidationCodeForbiddenWord, f.Validate(value.TypeText.Value("food")).(*ValueError).Code)
+	assert.NoError(t, f.Validate(value.TypeText.Value("bar")))
+
+	assert.Equal(t, ErrInvalidMinMax, NewString(value.TypeText, new(1)).SetValidation(v))
+
+	empty, _ := NewTextValidation(nil, "", "", nil, "")
+	assert.NoError(t, f.SetValidation(empty))
+	assert.Nil(t, f.Validation())
+}
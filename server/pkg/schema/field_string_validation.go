@@ -0,0 +1,214 @@
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+var (
+	ErrInvalidMinLength  = rerror.NewE(i18n.T("invalid min length"))
+	ErrInvalidPattern    = rerror.NewE(i18n.T("invalid pattern"))
+	ErrInvalidTextPreset = rerror.NewE(i18n.T("invalid text preset"))
+)
+
+type TextPreset string
+
+const (
+	TextPresetEmail      TextPreset = "email"
+	TextPresetPhone      TextPreset = "phone"
+	TextPresetPostalCode TextPreset = "postal_code"
+)
+
+var textPresetPatterns = map[TextPreset]*regexp.Regexp{
+	TextPresetEmail: regexp.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`),
+	// international numbers such as +81 3-1234-5678 or (03) 1234 5678
+	TextPresetPhone: regexp.MustCompile(`^\+?[0-9()][0-9()\- ]{5,18}[0-9]$`),
+	// alphanumeric codes such as 100-0001, SW1A 1AA or 94103
+	TextPresetPostalCode: regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9\- ]{1,8}[A-Za-z0-9]$`),
+}
+
+var textPresetNames = map[TextPreset]string{
+	TextPresetEmail:      "email address",
+	TextPresetPhone:      "phone number",
+	TextPresetPostalCode: "postal code",
+}
+
+func TextPresetFrom(s string) (TextPreset, bool) {
+	p := TextPreset(s)
+	if _, ok := textPresetPatterns[p]; ok {
+		return p, true
+	}
+	return "", false
+}
+
+func (p TextPreset) String() string {
+	return string(p)
+}
+
+// Pattern returns the regular expression which the values of the preset match.
+func (p TextPreset) Pattern() string {
+	if r := textPresetPatterns[p]; r != nil {
+		return r.String()
+	}
+	return ""
+}
+
+// TextValidation is the validation rules of the string based fields in addition to the max length.
+type TextValidation struct {
+	minLength      *int
+	pattern        *regexp.Regexp
+	patternMessage string
+	forbiddenWords []string
+	preset         TextPreset
+}
+
+// NewTextValidation returns the rules. The pattern matches any part of the value unless it is anchored with ^ and $.
+// The forbidden words are matched case-insensitively.
+func NewTextValidation(minLength *int, pattern, patternMessage string, forbiddenWords []string, preset TextPreset) (*TextValidation, error) {
+	if minLength != nil && *minLength < 0 {
+		return nil, ErrInvalidMinLength
+	}
+
+	var r *regexp.Regexp
+	if pattern != "" {
+		var err error
+		if r, err = regexp.Compile(pattern); err != nil {
+			return nil, ErrInvalidPattern
+		}
+	}
+
+	if preset != "" {
+		if _, ok := TextPresetFrom(string(preset)); !ok {
+			return nil, ErrInvalidTextPreset
+		}
+	}
+
+	words := lo.Uniq(lo.FilterMap(forbiddenWords, func(w string, _ int) (string, bool) {
+		w = strings.TrimSpace(w)
+		return w, w != ""
+	}))
+
+	return &TextValidation{
+		minLength:      util.CloneRef(minLength),
+		pattern:        r,
+		patternMessage: patternMessage,
+		forbiddenWords: words,
+		preset:         preset,
+	}, nil
+}
+
+func (v *TextValidation) MinLength() *int {
+	if v == nil {
+		return nil
+	}
+	return util.CloneRef(v.minLength)
+}
+
+func (v *TextValidation) Pattern() string {
+	if v == nil || v.pattern == nil {
+		return ""
+	}
+	return v.pattern.String()
+}
+
+// PatternMessage is the error message shown when the value does not match the pattern.
+func (v *TextValidation) PatternMessage() string {
+	if v == nil {
+		return ""
+	}
+	return v.patternMessage
+}
+
+func (v *TextValidation) ForbiddenWords() []string {
+	if v == nil {
+		return nil
+	}
+	return slices.Clone(v.forbiddenWords)
+}
+
+func (v *TextValidation) Preset() TextPreset {
+	if v == nil {
+		return ""
+	}
+	return v.preset
+}
+
+func (v *TextValidation) IsEmpty() bool {
+	return v == nil || v.minLength == nil && v.pattern == nil && len(v.forbiddenWords) == 0 && v.preset == ""
+}
+
+func (v *TextValidation) Clone() *TextValidation {
+	if v == nil {
+		return nil
+	}
+	return &TextValidation{
+		minLength:      util.CloneRef(v.minLength),
+		pattern:        v.pattern,
+		patternMessage: v.patternMessage,
+		forbiddenWords: slices.Clone(v.forbiddenWords),
+		preset:         v.preset,
+	}
+}
+
+func (v *TextValidation) Validate(s string) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.minLength != nil {
+		if l := utf8.RuneCountInString(s); l < *v.minLength {
+			return &ValueError{
+				Code:    FieldValidationCodeMinLengthNotMet,
+				Message: fmt.Sprintf("value has %d characters, but it should be at least %d characters", l, *v.minLength),
+			}
+		}
+	}
+
+	if v.preset != "" && !textPresetPatterns[v.preset].MatchString(s) {
+		return &ValueError{
+			Code:    FieldValidationCodeInvalidFormat,
+			Message: fmt.Sprintf("value is not a valid %s", textPresetNames[v.preset]),
+		}
+	}
+
+	if v.pattern != nil && !v.pattern.MatchString(s) {
+		msg := v.patternMessage
+		if msg == "" {
+			msg = fmt.Sprintf("value does not match the pattern %s", v.pattern)
+		}
+		return &ValueError{
+			Code:    FieldValidationCodePatternMismatch,
+			Message: msg,
+		}
+	}
+
+	ls := strings.ToLower(s)
+	for _, w := range v.forbiddenWords {
+		if strings.Contains(ls, strings.ToLower(w)) {
+			return &ValueError{
+				Code:    FieldValidationCodeForbiddenWord,
+				Message: fmt.Sprintf("value contains the forbidden word %q", w),
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValueError is a violation of a constraint of a field by a value.
+type ValueError struct {
+	Code    FieldValidationCode
+	Message string
+}
+
+func (e *ValueError) Error() string {
+	return e.Message
+}
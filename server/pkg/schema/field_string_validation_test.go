@@ -0,0 +1,167 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTextPresetFrom(t *testing.T) {
+	p, ok := TextPresetFrom("email")
+	assert.True(t, ok)
+	assert.Equal(t, TextPresetEmail, p)
+
+	p, ok = TextPresetFrom("postal_code")
+	assert.True(t, ok)
+	assert.Equal(t, TextPresetPostalCode, p)
+
+	p, ok = TextPresetFrom("zip")
+	assert.False(t, ok)
+	assert.Equal(t, TextPreset(""), p)
+}
+
+func TestNewTextValidation(t *testing.T) {
+	v, err := NewTextValidation(new(1), "^a", "starts with a", []string{" foo ", "", "bar", "foo"}, TextPresetEmail)
+	assert.NoError(t, err)
+	assert.Equal(t, new(1), v.MinLength())
+	assert.Equal(t, "^a", v.Pattern())
+	assert.Equal(t, "starts with a", v.PatternMessage())
+	assert.Equal(t, []string{"foo", "bar"}, v.ForbiddenWords())
+	assert.Equal(t, TextPresetEmail, v.Preset())
+	assert.False(t, v.IsEmpty())
+
+	_, err = NewTextValidation(new(-1), "", "", nil, "")
+	assert.Equal(t, ErrInvalidMinLength, err)
+
+	_, err = NewTextValidation(nil, "(", "", nil, "")
+	assert.Equal(t, ErrInvalidPattern, err)
+
+	_, err = NewTextValidation(nil, "", "", nil, "zip")
+	assert.Equal(t, ErrInvalidTextPreset, err)
+
+	v, err = NewTextValidation(nil, "", "", []string{" "}, "")
+	assert.NoError(t, err)
+	assert.True(t, v.IsEmpty())
+	assert.True(t, (*TextValidation)(nil).IsEmpty())
+}
+
+func TestTextValidation_Clone(t *testing.T) {
+	assert.Nil(t, (*TextValidation)(nil).Clone())
+
+	v, _ := NewTextValidation(new(1), "a", "", []string{"foo"}, TextPresetPhone)
+	got := v.Clone()
+	assert.Equal(t, v, got)
+	assert.NotSame(t, v, got)
+}
+
+func TestTextValidation_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		minLen   *int
+		pattern  string
+		message  string
+		words    []string
+		preset   TextPreset
+		value    string
+		wantCode FieldValidationCode
+		wantMsg  string
+	}{
+		{
+			name:     "min length",
+			minLen:   new(3),
+			value:    "あい",
+			wantCode: FieldValidationCodeMinLengthNotMet,
+			wantMsg:  "value has 2 characters, but it should be at least 3 characters",
+		},
+		{
+			name:   "min length ok",
+			minLen: new(2),
+			value:  "あい",
+		},
+		{
+			name:     "pattern",
+			pattern:  "^[0-9]+$",
+			value:    "12a",
+			wantCode: FieldValidationCodePatternMismatch,
+			wantMsg:  "value does not match the pattern ^[0-9]+$",
+		},
+		{
+			name:     "pattern with message",
+			pattern:  "^[0-9]+$",
+			message:  "digits only",
+			value:    "12a",
+			wantCode: FieldValidationCodePatternMismatch,
+			wantMsg:  "digits only",
+		},
+		{
+			name:    "pattern ok",
+			pattern: "[0-9]",
+			value:   "a1",
+		},
+		{
+			name:     "forbidden word",
+			words:    []string{"spam"},
+			value:    "This is SPAM",
+			wantCode: FieldValidationCodeForbiddenWord,
+			wantMsg:  `value contains the forbidden word "spam"`,
+		},
+		{
+			name:  "forbidden word ok",
+			words: []string{"spam"},
+			value: "ham",
+		},
+		{
+			name:   "email",
+			preset: TextPresetEmail,
+			value:  "user@example.com",
+		},
+		{
+			name:     "invalid email",
+			preset:   TextPresetEmail,
+			value:    "user@example",
+			wantCode: FieldValidationCodeInvalidFormat,
+			wantMsg:  "value is not a valid email address",
+		},
+		{
+			name:   "phone",
+			preset: TextPresetPhone,
+			value:  "+81 3-1234-5678",
+		},
+		{
+			name:     "invalid phone",
+			preset:   TextPresetPhone,
+			value:    "call me",
+			wantCode: FieldValidationCodeInvalidFormat,
+			wantMsg:  "value is not a valid phone number",
+		},
+		{
+			name:   "postal code",
+			preset: TextPresetPostalCode,
+			value:  "100-0001",
+		},
+		{
+			name:     "invalid postal code",
+			preset:   TextPresetPostalCode,
+			value:    "1",
+			wantCode: FieldValidationCodeInvalidFormat,
+			wantMsg:  "value is not a valid postal code",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			v, err := NewTextValidation(tt.minLen, tt.pattern, tt.message, tt.words, tt.preset)
+			assert.NoError(t, err)
+
+			err = v.Validate(tt.value)
+			if tt.wantCode == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, &ValueError{Code: tt.wantCode, Message: tt.wantMsg}, err)
+		})
+	}
+
+	assert.NoError(t, (*TextValidation)(nil).Validate("a"))
+}
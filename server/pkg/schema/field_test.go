@@ -71,6 +71,7 @@ func TestField_Clone(t *testing.T) {
 		unique:       true,
 		multiple:     true,
 		required:     true,
+		localizable:  true,
 		typeProperty: NewText(nil).TypeProperty(),
 		defaultValue: value.TypeText.Value("aa").AsMultiple(),
 		updatedAt:    time.Now(),
@@ -105,6 +106,19 @@ func TestField_SetMultiple(t *testing.T) {
 	assert.Equal(t, true, f.Multiple())
 }
 
+func TestField_SetLocalizable(t *testing.T) {
+	f := &Field{typeProperty: NewText(nil).TypeProperty()}
+	assert.NoError(t, f.SetLocalizable(true))
+	assert.True(t, f.Localizable())
+	assert.NoError(t, f.SetLocalizable(false))
+	assert.False(t, f.Localizable())
+
+	f = &Field{typeProperty: NewReference(id.NewModelID(), id.NewSchemaID(), nil, nil).TypeProperty()}
+	assert.Equal(t, ErrNotLocalizable, f.SetLocalizable(true))
+	assert.False(t, f.Localizable())
+	assert.NoError(t, f.SetLocalizable(false))
+}
+
 func TestField_SetName(t *testing.T) {
 	f := &Field{name: ""}
 	f.SetName("a")
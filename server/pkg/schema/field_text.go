@@ -23,6 +23,14 @@ func (f *FieldText) MaxLength() *int {
 	return f.s.MaxLength()
 }
 
+func (f *FieldText) Validation() *TextValidation {
+	return f.s.Validation()
+}
+
+func (f *FieldText) SetValidation(v *TextValidation) error {
+	return f.s.SetValidation(v)
+}
+
 func (f *FieldText) Type() value.Type {
 	return value.TypeText
 }
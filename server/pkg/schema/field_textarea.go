@@ -23,6 +23,14 @@ func (f *FieldTextArea) MaxLength() *int {
 	return f.s.MaxLength()
 }
 
+func (f *FieldTextArea) Validation() *TextValidation {
+	return f.s.Validation()
+}
+
+func (f *FieldTextArea) SetValidation(v *TextValidation) error {
+	return f.s.SetValidation(v)
+}
+
 func (f *FieldTextArea) Type() value.Type {
 	return value.TypeTextArea
 }
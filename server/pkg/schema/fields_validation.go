@@ -2,10 +2,14 @@ package schema
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"slices"
+	"strings"
 	"unicode/utf8"
 
 	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
 )
 
 type FieldValidationCode string
@@ -17,6 +21,12 @@ const (
 	FieldValidationCodeMaxLengthExceeded   FieldValidationCode = "MAX_LENGTH_EXCEEDED"
 	FieldValidationCodeMaxSizeExceeded     FieldValidationCode = "MAX_SIZE_EXCEEDED"
 	FieldValidationCodeInvalidGeoStructure FieldValidationCode = "INVALID_GEO_STRUCTURE"
+	FieldValidationCodeMinLengthNotMet     FieldValidationCode = "MIN_LENGTH_NOT_MET"
+	FieldValidationCodePatternMismatch     FieldValidationCode = "PATTERN_MISMATCH"
+	FieldValidationCodeForbiddenWord       FieldValidationCode = "FORBIDDEN_WORD"
+	FieldValidationCodeInvalidFormat       FieldValidationCode = "INVALID_FORMAT"
+	FieldValidationCodeComparisonFailed    FieldValidationCode = "COMPARISON_FAILED"
+	FieldValidationCodeHidden              FieldValidationCode = "FIELD_HIDDEN"
 )
 
 // Global hard limits enforced on every posting request.
@@ -33,6 +43,38 @@ type FieldValidationError struct {
 	Detail string              `json:"detail,omitempty"`
 }
 
+func (e *FieldValidationError) Error() string {
+	if e.Detail == "" {
+		return fmt.Sprintf("%s: %s", e.Field, e.Code)
+	}
+	return fmt.Sprintf("%s: %s", e.Field, e.Detail)
+}
+
+// FieldValidationErrors is the list of the violations of the constraints of the fields of an item.
+type FieldValidationErrors []*FieldValidationError
+
+func (e FieldValidationErrors) Error() string {
+	return strings.Join(lo.Map(e, func(e *FieldValidationError, _ int) string { return e.Error() }), "; ")
+}
+
+func (e FieldValidationErrors) Unwrap() []error {
+	return lo.Map(e, func(e *FieldValidationError, _ int) error { return e })
+}
+
+// NewFieldValidationError reports the violation of the constraint of the field by the value.
+// It returns nil if err is not a constraint violation.
+func NewFieldValidationError(f *Field, err error) *FieldValidationError {
+	ve, ok := errors.AsType[*ValueError](err)
+	if !ok {
+		return nil
+	}
+	return &FieldValidationError{
+		Field:  f.Key().String(),
+		Code:   ve.Code,
+		Detail: ve.Message,
+	}
+}
+
 // ValidateFields validates a raw key→value map against the schema.
 func (s *Schema) ValidateFields(fields map[string]any, skip []value.Type) []FieldValidationError {
 	if s == nil {
@@ -186,6 +228,9 @@ func validateFieldEntry(f *Field, raw any) *FieldValidationError {
 	}
 
 	if err := f.ValidateValue(m); err != nil {
+		if fe := NewFieldValidationError(f, err); fe != nil {
+			return fe
+		}
 		return &FieldValidationError{
 			Field:  key,
 			Code:   FieldValidationCodeConstraint,
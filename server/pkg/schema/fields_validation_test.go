@@ -48,6 +48,8 @@ func TestSchema_ValidateFields(t *testing.T) {
 	intField, _ := NewInteger(new(int64(1)), new(int64(100)))
 	numField, _ := NewNumber(new(0.0), new(1.0))
 	tagA, tagB := NewTag("a", TagColorBlue), NewTag("b", TagColorRed)
+	emailField := NewText(nil)
+	_ = emailField.SetValidation(lo.Must(NewTextValidation(nil, "", "", nil, TextPresetEmail)))
 
 	s := buildTestSchema(
 		buildTestField("title", NewText(nil).TypeProperty(), true),
@@ -55,6 +57,7 @@ func TestSchema_ValidateFields(t *testing.T) {
 		buildTestField("summary", NewTextArea(new(10)).TypeProperty(), false),
 		buildTestField("content", NewRichText(new(10)).TypeProperty(), false),
 		buildTestField("notes", NewMarkdown(new(10)).TypeProperty(), false),
+		buildTestField("email", emailField.TypeProperty(), false),
 		buildTestField("count", intField.TypeProperty(), false),
 		buildTestField("score", numField.TypeProperty(), false),
 		buildTestField("status", NewSelect([]string{"open", "closed"}).TypeProperty(), false),
@@ -131,6 +134,18 @@ func TestSchema_ValidateFields(t *testing.T) {
 			schema: s,
 			body:   map[string]any{"title": "hello", "unknown": "ignored"},
 		},
+		// text validation rules
+		{
+			name:      "text violates email preset",
+			schema:    s,
+			body:      map[string]any{"title": "hello", "email": "not an email"},
+			wantCodes: map[string]FieldValidationCode{"email": FieldValidationCodeInvalidFormat},
+		},
+		{
+			name:   "text satisfies email preset",
+			schema: s,
+			body:   map[string]any{"title": "hello", "email": "user@example.com"},
+		},
 		// maxLength constraints
 		{
 			name:      "text exceeds maxLength",
@@ -196,9 +211,9 @@ func TestSchema_ValidateFields(t *testing.T) {
 			wantCodes: map[string]FieldValidationCode{"tag": FieldValidationCodeConstraint},
 		},
 		{
-			name: "tag non existing value",
-			schema: s,
-			body: map[string]any{"title": "hello", "tag": id.NewTagID().String()},
+			name:      "tag non existing value",
+			schema:    s,
+			body:      map[string]any{"title": "hello", "tag": id.NewTagID().String()},
 			wantCodes: map[string]FieldValidationCode{"tag": FieldValidationCodeConstraint},
 		},
 		// type mismatches
@@ -59,6 +59,30 @@ func (p *Package) GroupSchema(gid id.GroupID) *Schema {
 	return s
 }
 
+// Select returns a copy of the package whose schema only contains the given fields.
+// The meta, group and referenced schemas are kept as they are.
+func (p *Package) Select(fields id.FieldIDList) *Package {
+	if p == nil {
+		return nil
+	}
+
+	s := p.schema.Clone()
+	if s != nil {
+		for _, f := range p.schema.Fields() {
+			if !fields.Has(f.ID()) {
+				s.RemoveField(f.ID())
+			}
+		}
+	}
+
+	return &Package{
+		schema:            s,
+		metaSchema:        p.metaSchema,
+		groupSchemas:      p.groupSchemas,
+		referencedSchemas: p.referencedSchemas,
+	}
+}
+
 func (p *Package) ReferencedSchemas() List {
 	if p == nil {
 		return nil
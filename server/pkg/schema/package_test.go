@@ -49,6 +49,31 @@ func TestPackage_Schema(t *testing.T) {
 	assert.Equal(t, s, p.Schema())
 }
 
+func TestPackage_Select(t *testing.T) {
+	sID := id.NewSchemaID()
+	msID := id.NewSchemaID()
+	f1 := NewField(NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	f2 := NewField(NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	f3 := NewField(NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	s := &Schema{id: sID, fields: FieldList{f1, f2}, titleField: f2.ID().Ref()}
+	meta := &Schema{id: msID, fields: FieldList{f3}}
+	p := NewPackage(s, meta, nil, nil)
+
+	got := p.Select(id.FieldIDList{f1.ID()})
+	assert.Equal(t, FieldList{f1}, got.Schema().Fields())
+	assert.Nil(t, got.Schema().TitleField())
+	assert.Equal(t, meta, got.MetaSchema())
+	// the original package is not modified
+	assert.Equal(t, FieldList{f1, f2}, p.Schema().Fields())
+	assert.Equal(t, f2.ID().Ref(), p.Schema().TitleField())
+
+	assert.Equal(t, FieldList{f1, f2}, p.Select(id.FieldIDList{f2.ID(), f1.ID()}).Schema().Fields())
+	assert.Empty(t, p.Select(nil).Schema().Fields())
+
+	var np *Package
+	assert.Nil(t, np.Select(id.FieldIDList{f1.ID()}))
+}
+
 func TestPackage_MetaSchema(t *testing.T) {
 	sID := id.NewSchemaID()
 	msID := id.NewSchemaID()
@@ -0,0 +1,407 @@
+package schema
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+var ErrInvalidRule = rerror.NewE(i18n.T("invalid rule"))
+
+type RuleType string
+
+const (
+	// RuleTypeRequiredIf requires the field when the condition holds.
+	RuleTypeRequiredIf RuleType = "required_if"
+	// RuleTypeVisibleIf shows the field only when the condition holds. The hidden field must be empty.
+	RuleTypeVisibleIf RuleType = "visible_if"
+	// RuleTypeCompare requires the value of the field to be in the relation with the value of the other field.
+	RuleTypeCompare RuleType = "compare"
+)
+
+func RuleTypeFrom(s string) (RuleType, bool) {
+	switch t := RuleType(s); t {
+	case RuleTypeRequiredIf, RuleTypeVisibleIf, RuleTypeCompare:
+		return t, true
+	}
+	return "", false
+}
+
+type ConditionOperator string
+
+const (
+	ConditionOperatorEquals    ConditionOperator = "eq"
+	ConditionOperatorNotEquals ConditionOperator = "neq"
+	ConditionOperatorEmpty     ConditionOperator = "empty"
+	ConditionOperatorNotEmpty  ConditionOperator = "not_empty"
+)
+
+func ConditionOperatorFrom(s string) (ConditionOperator, bool) {
+	switch o := ConditionOperator(s); o {
+	case ConditionOperatorEquals, ConditionOperatorNotEquals, ConditionOperatorEmpty, ConditionOperatorNotEmpty:
+		return o, true
+	}
+	return "", false
+}
+
+type CompareOperator string
+
+const (
+	CompareOperatorLessThan           CompareOperator = "lt"
+	CompareOperatorLessThanOrEqual    CompareOperator = "lte"
+	CompareOperatorGreaterThan        CompareOperator = "gt"
+	CompareOperatorGreaterThanOrEqual CompareOperator = "gte"
+	CompareOperatorEquals             CompareOperator = "eq"
+	CompareOperatorNotEquals          CompareOperator = "neq"
+)
+
+var compareOperatorNames = map[CompareOperator]string{
+	CompareOperatorLessThan:           "less than",
+	CompareOperatorLessThanOrEqual:    "less than or equal to",
+	CompareOperatorGreaterThan:        "greater than",
+	CompareOperatorGreaterThanOrEqual: "greater than or equal to",
+	CompareOperatorEquals:             "equal to",
+	CompareOperatorNotEquals:          "different from",
+}
+
+func CompareOperatorFrom(s string) (CompareOperator, bool) {
+	o := CompareOperator(s)
+	if _, ok := compareOperatorNames[o]; ok {
+		return o, true
+	}
+	return "", false
+}
+
+// Condition is a condition on the value of a field.
+type Condition struct {
+	field    FieldID
+	operator ConditionOperator
+	value    *value.Value
+}
+
+// NewCondition returns a condition. The value is required only for the equality operators.
+func NewCondition(field FieldID, operator ConditionOperator, v *value.Value) (*Condition, error) {
+	if _, ok := ConditionOperatorFrom(string(operator)); !ok {
+		return nil, ErrInvalidRule
+	}
+	isEquality := operator == ConditionOperatorEquals || operator == ConditionOperatorNotEquals
+	if isEquality == (v == nil || v.IsEmpty()) {
+		return nil, ErrInvalidRule
+	}
+	return &Condition{
+		field:    field,
+		operator: operator,
+		value:    v.Clone(),
+	}, nil
+}
+
+func (c *Condition) Field() FieldID {
+	return c.field
+}
+
+func (c *Condition) Operator() ConditionOperator {
+	return c.operator
+}
+
+func (c *Condition) Value() *value.Value {
+	return c.value.Clone()
+}
+
+func (c *Condition) Clone() *Condition {
+	if c == nil {
+		return nil
+	}
+	return &Condition{
+		field:    c.field,
+		operator: c.operator,
+		value:    c.value.Clone(),
+	}
+}
+
+// Match returns true when the value of the field satisfies the condition.
+// Multiple values satisfy eq when any of them is equal.
+func (c *Condition) Match(m *value.Multiple) bool {
+	switch c.operator {
+	case ConditionOperatorEmpty:
+		return m.IsEmpty()
+	case ConditionOperatorNotEmpty:
+		return !m.IsEmpty()
+	case ConditionOperatorEquals:
+		return lo.SomeBy(m.Values(), c.value.Equal)
+	case ConditionOperatorNotEquals:
+		return !lo.SomeBy(m.Values(), c.value.Equal)
+	}
+	return false
+}
+
+// Rule is a validation rule which spans the fields of a schema.
+type Rule struct {
+	t         RuleType
+	field     FieldID
+	condition *Condition
+	operator  CompareOperator
+	other     *FieldID
+	message   string
+}
+
+func NewRequiredIfRule(field FieldID, c *Condition, message string) *Rule {
+	return &Rule{t: RuleTypeRequiredIf, field: field, condition: c.Clone(), message: message}
+}
+
+func NewVisibleIfRule(field FieldID, c *Condition, message string) *Rule {
+	return &Rule{t: RuleTypeVisibleIf, field: field, condition: c.Clone(), message: message}
+}
+
+func NewCompareRule(field FieldID, operator CompareOperator, other FieldID, message string) *Rule {
+	return &Rule{t: RuleTypeCompare, field: field, operator: operator, other: other.Ref(), message: message}
+}
+
+func (r *Rule) Type() RuleType {
+	return r.t
+}
+
+func (r *Rule) Field() FieldID {
+	return r.field
+}
+
+// Condition is set for required_if and visible_if rules.
+func (r *Rule) Condition() *Condition {
+	return r.condition.Clone()
+}
+
+// Operator is set for compare rules.
+func (r *Rule) Operator() CompareOperator {
+	return r.operator
+}
+
+// Other is the field compared with the field, which is set for compare rules.
+func (r *Rule) Other() *FieldID {
+	return r.other.CloneRef()
+}
+
+// Message is the error message shown instead of the default one.
+func (r *Rule) Message() string {
+	return r.message
+}
+
+func (r *Rule) Clone() *Rule {
+	if r == nil {
+		return nil
+	}
+	return &Rule{
+		t:         r.t,
+		field:     r.field,
+		condition: r.condition.Clone(),
+		operator:  r.operator,
+		other:     r.other.CloneRef(),
+		message:   r.message,
+	}
+}
+
+// Fields returns the fields the rule refers to.
+func (r *Rule) Fields() id.FieldIDList {
+	res := id.FieldIDList{r.field}
+	if r.condition != nil {
+		res = append(res, r.condition.field)
+	}
+	if r.other != nil {
+		res = append(res, *r.other)
+	}
+	return res
+}
+
+type Rules []*Rule
+
+func (r Rules) Clone() Rules {
+	if r == nil {
+		return nil
+	}
+	return lo.Map(r, func(r *Rule, _ int) *Rule { return r.Clone() })
+}
+
+// validate checks that the rules refer to the fields of the schema in a meaningful way.
+func (r Rules) validate(s *Schema) error {
+	for _, rule := range r {
+		if rule == nil {
+			return ErrInvalidRule
+		}
+		f := s.Field(rule.field)
+		if f == nil || f.Type() == value.TypeGroup {
+			return ErrInvalidRule
+		}
+
+		switch rule.t {
+		case RuleTypeRequiredIf, RuleTypeVisibleIf:
+			c := rule.condition
+			if c == nil || c.field == rule.field {
+				return ErrInvalidRule
+			}
+			cf := s.Field(c.field)
+			if cf == nil || cf.Type() == value.TypeGroup {
+				return ErrInvalidRule
+			}
+			if c.value != nil && c.value.Type() != cf.Type() {
+				return ErrInvalidRule
+			}
+		case RuleTypeCompare:
+			if rule.other == nil || *rule.other == rule.field {
+				return ErrInvalidRule
+			}
+			if _, ok := compareOperatorNames[rule.operator]; !ok {
+				return ErrInvalidRule
+			}
+			of := s.Field(*rule.other)
+			if of == nil || of.Type() != f.Type() || f.Multiple() || of.Multiple() {
+				return ErrInvalidRule
+			}
+			if !isOrderedType(f.Type()) && rule.operator != CompareOperatorEquals && rule.operator != CompareOperatorNotEquals {
+				return ErrInvalidRule
+			}
+		default:
+			return ErrInvalidRule
+		}
+	}
+	return nil
+}
+
+// Validate evaluates the rules against the values of the fields of an item.
+// values returns the value of the field, which may be nil when the field is not set.
+func (r Rules) Validate(s *Schema, values func(FieldID) *value.Multiple) FieldValidationErrors {
+	var errs FieldValidationErrors
+	for _, rule := range r {
+		if e := rule.validate(s, values); e != nil {
+			errs = append(errs, e)
+		}
+	}
+	return errs
+}
+
+func (r *Rule) validate(s *Schema, values func(FieldID) *value.Multiple) *FieldValidationError {
+	f := s.Field(r.field)
+	if f == nil {
+		return nil
+	}
+	v := values(r.field)
+
+	var code FieldValidationCode
+	var detail string
+	switch r.t {
+	case RuleTypeRequiredIf:
+		if !v.IsEmpty() || !r.condition.Match(values(r.condition.field)) {
+			return nil
+		}
+		code = FieldValidationCodeRequired
+		detail = fmt.Sprintf("value is required when %s", r.condition.describe(s))
+	case RuleTypeVisibleIf:
+		if v.IsEmpty() || r.condition.Match(values(r.condition.field)) {
+			return nil
+		}
+		code = FieldValidationCodeHidden
+		detail = fmt.Sprintf("value must be empty unless %s", r.condition.describe(s))
+	case RuleTypeCompare:
+		of := s.Field(*r.other)
+		ov := values(*r.other)
+		// the comparison is skipped until both values are set, which is checked by the required rules
+		if of == nil || v.IsEmpty() || ov.IsEmpty() {
+			return nil
+		}
+		c, ok := compareValues(v.First(), ov.First())
+		if !ok || r.operator.match(c) {
+			return nil
+		}
+		code = FieldValidationCodeComparisonFailed
+		detail = fmt.Sprintf("value must be %s %s", compareOperatorNames[r.operator], of.Key())
+	default:
+		return nil
+	}
+
+	if r.message != "" {
+		detail = r.message
+	}
+	return &FieldValidationError{
+		Field:  f.Key().String(),
+		Code:   code,
+		Detail: detail,
+	}
+}
+
+func (c *Condition) describe(s *Schema) string {
+	key := c.field.String()
+	if f := s.Field(c.field); f != nil {
+		key = f.Key().String()
+	}
+	switch c.operator {
+	case ConditionOperatorEmpty:
+		return key + " is empty"
+	case ConditionOperatorNotEmpty:
+		return key + " is not empty"
+	case ConditionOperatorEquals:
+		return fmt.Sprintf("%s is %v", key, c.value.Interface())
+	case ConditionOperatorNotEquals:
+		return fmt.Sprintf("%s is not %v", key, c.value.Interface())
+	}
+	return key
+}
+
+func (o CompareOperator) match(c int) bool {
+	switch o {
+	case CompareOperatorLessThan:
+		return c < 0
+	case CompareOperatorLessThanOrEqual:
+		return c <= 0
+	case CompareOperatorGreaterThan:
+		return c > 0
+	case CompareOperatorGreaterThanOrEqual:
+		return c >= 0
+	case CompareOperatorEquals:
+		return c == 0
+	case CompareOperatorNotEquals:
+		return c != 0
+	}
+	return false
+}
+
+func isOrderedType(t value.Type) bool {
+	switch t {
+	case value.TypeInteger, value.TypeNumber, value.TypeDateTime,
+		value.TypeText, value.TypeTextArea, value.TypeRichText, value.TypeMarkdown, value.TypeSelect:
+		return true
+	}
+	return false
+}
+
+// compareValues returns the order of the values of the same type.
+// The values of the types without order are only compared for equality.
+func compareValues(a, b *value.Value) (int, bool) {
+	if a == nil || b == nil || a.Type() != b.Type() {
+		return 0, false
+	}
+	switch a.Type() {
+	case value.TypeInteger:
+		x, ok1 := a.ValueInteger()
+		y, ok2 := b.ValueInteger()
+		return cmp.Compare(x, y), ok1 && ok2
+	case value.TypeNumber:
+		x, ok1 := a.ValueNumber()
+		y, ok2 := b.ValueNumber()
+		return cmp.Compare(x, y), ok1 && ok2
+	case value.TypeDateTime:
+		x, ok1 := a.ValueDateTime()
+		y, ok2 := b.ValueDateTime()
+		return x.Compare(y), ok1 && ok2
+	case value.TypeText, value.TypeTextArea, value.TypeRichText, value.TypeMarkdown, value.TypeSelect:
+		x, ok1 := a.ValueString()
+		y, ok2 := b.ValueString()
+		return strings.Compare(x, y), ok1 && ok2
+	}
+	if a.Equal(b) {
+		return 0, true
+	}
+	return 1, true
+}
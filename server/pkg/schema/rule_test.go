@@ -0,0 +1,234 @@
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuleTypeFrom(t *testing.T) {
+	r, ok := RuleTypeFrom("visible_if")
+	assert.True(t, ok)
+	assert.Equal(t, RuleTypeVisibleIf, r)
+
+	r, ok = RuleTypeFrom("unique")
+	assert.False(t, ok)
+	assert.Equal(t, RuleType(""), r)
+}
+
+func TestCompareOperatorFrom(t *testing.T) {
+	o, ok := CompareOperatorFrom("gte")
+	assert.True(t, ok)
+	assert.Equal(t, CompareOperatorGreaterThanOrEqual, o)
+
+	o, ok = CompareOperatorFrom("in")
+	assert.False(t, ok)
+	assert.Equal(t, CompareOperator(""), o)
+}
+
+func TestNewCondition(t *testing.T) {
+	fid := NewFieldID()
+
+	c, err := NewCondition(fid, ConditionOperatorEquals, value.TypeText.Value("a"))
+	assert.NoError(t, err)
+	assert.Equal(t, fid, c.Field())
+	assert.Equal(t, ConditionOperatorEquals, c.Operator())
+	assert.Equal(t, value.TypeText.Value("a"), c.Value())
+
+	c, err = NewCondition(fid, ConditionOperatorNotEmpty, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, c.Value())
+
+	_, err = NewCondition(fid, ConditionOperatorEquals, nil)
+	assert.Equal(t, ErrInvalidRule, err)
+
+	_, err = NewCondition(fid, ConditionOperatorEmpty, value.TypeText.Value("a"))
+	assert.Equal(t, ErrInvalidRule, err)
+
+	_, err = NewCondition(fid, "in", nil)
+	assert.Equal(t, ErrInvalidRule, err)
+}
+
+func TestCondition_Match(t *testing.T) {
+	fid := NewFieldID()
+	eq, _ := NewCondition(fid, ConditionOperatorEquals, value.TypeText.Value("a"))
+	neq, _ := NewCondition(fid, ConditionOperatorNotEquals, value.TypeText.Value("a"))
+	empty, _ := NewCondition(fid, ConditionOperatorEmpty, nil)
+	notEmpty, _ := NewCondition(fid, ConditionOperatorNotEmpty, nil)
+
+	a := value.MultipleFrom(value.TypeText, []*value.Value{value.TypeText.Value("b"), value.TypeText.Value("a")})
+	b := value.TypeText.Value("b").AsMultiple()
+
+	assert.True(t, eq.Match(a))
+	assert.False(t, eq.Match(b))
+	assert.False(t, eq.Match(nil))
+	assert.False(t, neq.Match(a))
+	assert.True(t, neq.Match(b))
+	assert.True(t, neq.Match(nil))
+	assert.True(t, empty.Match(nil))
+	assert.False(t, empty.Match(b))
+	assert.False(t, notEmpty.Match(nil))
+	assert.True(t, notEmpty.Match(b))
+}
+
+func TestRule_Fields(t *testing.T) {
+	fid1, fid2 := NewFieldID(), NewFieldID()
+	c, _ := NewCondition(fid2, ConditionOperatorNotEmpty, nil)
+
+	assert.Equal(t, id.FieldIDList{fid1, fid2}, NewRequiredIfRule(fid1, c, "").Fields())
+	assert.Equal(t, id.FieldIDList{fid1, fid2}, NewCompareRule(fid1, CompareOperatorLessThan, fid2, "").Fields())
+}
+
+func TestRule_Clone(t *testing.T) {
+	assert.Nil(t, (*Rule)(nil).Clone())
+
+	c, _ := NewCondition(NewFieldID(), ConditionOperatorEquals, value.TypeText.Value("a"))
+	r := NewVisibleIfRule(NewFieldID(), c, "msg")
+	got := r.Clone()
+	assert.Equal(t, r, got)
+	assert.NotSame(t, r, got)
+}
+
+func TestSchema_SetRules(t *testing.T) {
+	text := NewField(NewText(nil).TypeProperty()).NewID().Key(id.NewKey("text")).MustBuild()
+	numType, _ := NewNumber(nil, nil)
+	num := NewField(numType.TypeProperty()).NewID().Key(id.NewKey("num")).MustBuild()
+	num2 := NewField(numType.TypeProperty()).NewID().Key(id.NewKey("num2")).MustBuild()
+	multi := NewField(numType.TypeProperty()).NewID().Key(id.NewKey("multi")).Multiple(true).MustBuild()
+	check := NewField(NewBool().TypeProperty()).NewID().Key(id.NewKey("check")).MustBuild()
+	check2 := NewField(NewBool().TypeProperty()).NewID().Key(id.NewKey("check2")).MustBuild()
+	group := NewField(NewGroup(id.NewGroupID()).TypeProperty()).NewID().Key(id.NewKey("group")).MustBuild()
+	s := New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).
+		Fields(FieldList{text, num, num2, multi, check, check2, group}).MustBuild()
+
+	textEq, _ := NewCondition(text.ID(), ConditionOperatorEquals, value.TypeText.Value("a"))
+	numEq, _ := NewCondition(num.ID(), ConditionOperatorEquals, value.TypeText.Value("a"))
+	groupEmpty, _ := NewCondition(group.ID(), ConditionOperatorEmpty, nil)
+	missing, _ := NewCondition(NewFieldID(), ConditionOperatorEmpty, nil)
+
+	tests := []struct {
+		name    string
+		rule    *Rule
+		wantErr error
+	}{
+		{name: "required if", rule: NewRequiredIfRule(num.ID(), textEq, "")},
+		{name: "compare", rule: NewCompareRule(num.ID(), CompareOperatorLessThan, num2.ID(), "")},
+		{name: "compare equality of unordered type", rule: NewCompareRule(check.ID(), CompareOperatorNotEquals, check2.ID(), "")},
+		{name: "nil", rule: nil, wantErr: ErrInvalidRule},
+		{name: "missing field", rule: NewRequiredIfRule(NewFieldID(), textEq, ""), wantErr: ErrInvalidRule},
+		{name: "missing condition field", rule: NewRequiredIfRule(num.ID(), missing, ""), wantErr: ErrInvalidRule},
+		{name: "group field", rule: NewRequiredIfRule(num.ID(), groupEmpty, ""), wantErr: ErrInvalidRule},
+		{name: "self condition", rule: NewVisibleIfRule(text.ID(), textEq, ""), wantErr: ErrInvalidRule},
+		{name: "type mismatch of condition", rule: NewVisibleIfRule(text.ID(), numEq, ""), wantErr: ErrInvalidRule},
+		{name: "no condition", rule: NewVisibleIfRule(text.ID(), nil, ""), wantErr: ErrInvalidRule},
+		{name: "compare with itself", rule: NewCompareRule(num.ID(), CompareOperatorLessThan, num.ID(), ""), wantErr: ErrInvalidRule},
+		{name: "compare different types", rule: NewCompareRule(num.ID(), CompareOperatorLessThan, text.ID(), ""), wantErr: ErrInvalidRule},
+		{name: "compare multiple", rule: NewCompareRule(num.ID(), CompareOperatorLessThan, multi.ID(), ""), wantErr: ErrInvalidRule},
+		{name: "compare order of unordered type", rule: NewCompareRule(check.ID(), CompareOperatorLessThan, check2.ID(), ""), wantErr: ErrInvalidRule},
+		{name: "invalid operator", rule: NewCompareRule(num.ID(), "in", num2.ID(), ""), wantErr: ErrInvalidRule},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := s.Clone()
+			err := s.SetRules(Rules{tt.rule})
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, s.Rules())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, Rules{tt.rule}, s.Rules())
+		})
+	}
+}
+
+func TestSchema_ValidateRules(t *testing.T) {
+	status := NewField(NewSelect([]string{"draft", "published"}).TypeProperty()).NewID().Key(id.NewKey("status")).MustBuild()
+	publishedAt := NewField(NewDateTime().TypeProperty()).NewID().Key(id.NewKey("publishedAt")).MustBuild()
+	expiredAt := NewField(NewDateTime().TypeProperty()).NewID().Key(id.NewKey("expiredAt")).MustBuild()
+	reason := NewField(NewTextArea(nil).TypeProperty()).NewID().Key(id.NewKey("reason")).MustBuild()
+
+	published, _ := NewCondition(status.ID(), ConditionOperatorEquals, value.TypeSelect.Value("published"))
+	draft, _ := NewCondition(status.ID(), ConditionOperatorEquals, value.TypeSelect.Value("draft"))
+	s := New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).
+		Fields(FieldList{status, publishedAt, expiredAt, reason}).
+		Rules(Rules{
+			NewRequiredIfRule(publishedAt.ID(), published, ""),
+			NewVisibleIfRule(reason.ID(), draft, ""),
+			NewCompareRule(expiredAt.ID(), CompareOperatorGreaterThan, publishedAt.ID(), "expiration must be after publication"),
+		}).MustBuild()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	values := func(m map[FieldID]*value.Value) func(FieldID) *value.Multiple {
+		return func(fid FieldID) *value.Multiple { return m[fid].AsMultiple() }
+	}
+
+	tests := []struct {
+		name   string
+		values map[FieldID]*value.Value
+		want   FieldValidationErrors
+	}{
+		{
+			name: "valid",
+			values: map[FieldID]*value.Value{
+				status.ID():      value.TypeSelect.Value("published"),
+				publishedAt.ID(): value.TypeDateTime.Value(now),
+				expiredAt.ID():   value.TypeDateTime.Value(now.Add(time.Hour)),
+			},
+		},
+		{
+			name: "draft",
+			values: map[FieldID]*value.Value{
+				status.ID(): value.TypeSelect.Value("draft"),
+				reason.ID(): value.TypeTextArea.Value("wip"),
+			},
+		},
+		{
+			name: "invalid",
+			values: map[FieldID]*value.Value{
+				status.ID():    value.TypeSelect.Value("published"),
+				expiredAt.ID(): value.TypeDateTime.Value(now),
+				reason.ID():    value.TypeTextArea.Value("wip"),
+			},
+			want: FieldValidationErrors{
+				{Field: "publishedAt", Code: FieldValidationCodeRequired, Detail: "value is required when status is published"},
+				{Field: "reason", Code: FieldValidationCodeHidden, Detail: "value must be empty unless status is draft"},
+			},
+		},
+		{
+			name: "comparison failed",
+			values: map[FieldID]*value.Value{
+				status.ID():      value.TypeSelect.Value("published"),
+				publishedAt.ID(): value.TypeDateTime.Value(now),
+				expiredAt.ID():   value.TypeDateTime.Value(now),
+			},
+			want: FieldValidationErrors{
+				{Field: "expiredAt", Code: FieldValidationCodeComparisonFailed, Detail: "expiration must be after publication"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, s.ValidateRules(values(tt.values)))
+		})
+	}
+
+	assert.Nil(t, (*Schema)(nil).ValidateRules(values(nil)))
+}
+
+func TestFieldValidationErrors_Error(t *testing.T) {
+	errs := FieldValidationErrors{
+		{Field: "a", Code: FieldValidationCodeRequired, Detail: "value is required"},
+		{Field: "b", Code: FieldValidationCodeComparisonFailed, Detail: "value must be less than a"},
+	}
+	assert.Equal(t, "a: value is required; b: value must be less than a", errs.Error())
+	assert.ErrorIs(t, errs, errs[1])
+}
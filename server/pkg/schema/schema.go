@@ -5,6 +5,7 @@ import (
 	"slices"
 
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/model"
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/samber/lo"
@@ -18,6 +19,7 @@ type Schema struct {
 	workspace  accountdomain.WorkspaceID
 	fields     FieldList
 	titleField *FieldID
+	rules      Rules
 }
 
 func (s *Schema) ID() ID {
@@ -129,6 +131,13 @@ func (s *Schema) RemoveField(fid FieldID) {
 			if lo.FromPtr(s.titleField) == fid {
 				s.titleField = nil
 			}
+			// the rules lose their meaning without the field
+			if len(s.rules) > 0 {
+				s.rules = lo.Reject(s.rules, func(r *Rule, _ int) bool { return r.Fields().Has(fid) })
+				if len(s.rules) == 0 {
+					s.rules = nil
+				}
+			}
 			return
 		}
 	}
@@ -155,6 +164,35 @@ func (s *Schema) SetTitleField(tf *FieldID) error {
 	return nil
 }
 
+// Rules returns the validation rules which span the fields.
+func (s *Schema) Rules() Rules {
+	if s == nil {
+		return nil
+	}
+	return s.rules.Clone()
+}
+
+func (s *Schema) SetRules(rules Rules) error {
+	if err := rules.validate(s); err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		s.rules = nil
+		return nil
+	}
+	s.rules = rules.Clone()
+	return nil
+}
+
+// ValidateRules evaluates the rules against the values of the fields.
+// values returns the value of the field, which may be nil when the field is not set.
+func (s *Schema) ValidateRules(values func(FieldID) *value.Multiple) FieldValidationErrors {
+	if s == nil {
+		return nil
+	}
+	return s.rules.Validate(s, values)
+}
+
 func (s *Schema) Clone() *Schema {
 	if s == nil {
 		return nil
@@ -166,6 +204,7 @@ func (s *Schema) Clone() *Schema {
 		workspace:  s.Workspace().Clone(),
 		fields:     slices.Clone(s.fields),
 		titleField: s.TitleField().CloneRef(),
+		rules:      s.rules.Clone(),
 	}
 }
 
@@ -194,4 +233,12 @@ func (s *Schema) CopyFrom(s2 *Schema) {
 	}
 	s.fields = slices.Clone(s2.fields)
 	s.titleField = s2.TitleField().CloneRef()
+	s.rules = s2.rules.Clone()
+}
+
+type FieldModelSchema struct {
+	Field  *Field
+	Schema *Schema
+	// Model is nil when the schema belongs to a group
+	Model *model.Model
 }
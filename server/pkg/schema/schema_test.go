@@ -132,6 +132,21 @@ func TestSchema_RemoveField(t *testing.T) {
 			fid:  fid3,
 			want: &Schema{fields: FieldList{{id: fid1, name: "f1"}, {id: fid2, name: "f2"}}},
 		},
+		{
+			name: "remove rules referring to the field",
+			s: &Schema{
+				fields: FieldList{{id: fid1, name: "f1"}, {id: fid2, name: "f2"}, {id: fid3, name: "f3"}},
+				rules: Rules{
+					NewCompareRule(fid1, CompareOperatorLessThan, fid2, ""),
+					NewCompareRule(fid2, CompareOperatorLessThan, fid3, ""),
+				},
+			},
+			fid: fid3,
+			want: &Schema{
+				fields: FieldList{{id: fid1, name: "f1"}, {id: fid2, name: "f2"}},
+				rules:  Rules{NewCompareRule(fid1, CompareOperatorLessThan, fid2, "")},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,102 @@
+package task
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidID     = errors.New("invalid task id")
+	ErrInvalidStatus = errors.New("invalid task status")
+)
+
+type Builder struct {
+	t *Task
+}
+
+func New() *Builder {
+	return &Builder{t: &Task{
+		status:      StatusPending,
+		maxAttempts: DefaultMaxAttempts,
+	}}
+}
+
+func (b *Builder) Build() (*Task, error) {
+	if b.t.id.IsNil() {
+		return nil, ErrInvalidID
+	}
+	if b.t.message.Kind() == "" {
+		return nil, ErrEmptyPayload
+	}
+	if _, ok := StatusFrom(b.t.status.String()); !ok {
+		return nil, ErrInvalidStatus
+	}
+	if b.t.maxAttempts <= 0 {
+		b.t.maxAttempts = DefaultMaxAttempts
+	}
+	if b.t.runAt.IsZero() {
+		b.t.runAt = b.t.id.Timestamp()
+	}
+	if b.t.updatedAt.IsZero() {
+		b.t.updatedAt = b.t.id.Timestamp()
+	}
+	return b.t, nil
+}
+
+func (b *Builder) MustBuild() *Task {
+	t, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func (b *Builder) ID(id ID) *Builder {
+	b.t.id = id
+	return b
+}
+
+func (b *Builder) NewID() *Builder {
+	b.t.id = NewID()
+	return b
+}
+
+func (b *Builder) Message(m Message) *Builder {
+	b.t.message = m
+	return b
+}
+
+func (b *Builder) Status(s Status) *Builder {
+	b.t.status = s
+	return b
+}
+
+func (b *Builder) Attempts(n int) *Builder {
+	b.t.attempts = n
+	return b
+}
+
+func (b *Builder) MaxAttempts(n int) *Builder {
+	b.t.maxAttempts = n
+	return b
+}
+
+func (b *Builder) RunAt(t time.Time) *Builder {
+	b.t.runAt = t
+	return b
+}
+
+func (b *Builder) LockedUntil(t *time.Time) *Builder {
+	b.t.lockedUntil = t
+	return b
+}
+
+func (b *Builder) Error(e string) *Builder {
+	b.t.errorMsg = e
+	return b
+}
+
+func (b *Builder) UpdatedAt(t time.Time) *Builder {
+	b.t.updatedAt = t
+	return b
+}
@@ -0,0 +1,12 @@
+package task
+
+import "github.com/reearth/reearth-cms/server/pkg/id"
+
+type ID = id.TaskID
+type IDList = id.TaskIDList
+
+var NewID = id.NewTaskID
+var MustID = id.MustTaskID
+var IDFrom = id.TaskIDFrom
+var IDFromRef = id.TaskIDFromRef
+var IDListFrom = id.TaskIDListFrom
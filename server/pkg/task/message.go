@@ -0,0 +1,124 @@
+package task
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
+)
+
+var ErrEmptyPayload = errors.New("task payload is empty")
+
+// Message is the serializable form of a payload which is kept in the task queue.
+// The webhook and the event of a webhook payload are rendered into the message sent to the endpoint
+// since they cannot be restored from the queue.
+type Message struct {
+	DecompressAsset *DecompressAssetPayload `json:"decompressAsset,omitempty"`
+	CompressAsset   *CompressAssetPayload   `json:"compressAsset,omitempty"`
+	Webhook         *WebhookMessage         `json:"webhook,omitempty"`
+	Copy            *CopyPayload            `json:"copy,omitempty"`
+	Import          *ImportPayload          `json:"import,omitempty"`
+}
+
+func NewMessage(p Payload) (Message, error) {
+	m := Message{
+		DecompressAsset: p.DecompressAsset,
+		CompressAsset:   p.CompressAsset,
+		Copy:            p.Copy,
+		Import:          p.Import,
+	}
+	if p.Webhook != nil {
+		w, err := NewWebhookMessage(p.Webhook)
+		if err != nil {
+			return Message{}, err
+		}
+		m.Webhook = w
+	}
+	if m.Kind() == "" {
+		return Message{}, ErrEmptyPayload
+	}
+	return m, nil
+}
+
+// Kind returns the name of the kind of the payload, which is used for logging.
+func (m Message) Kind() string {
+	switch {
+	case m.DecompressAsset != nil:
+		return "decompressAsset"
+	case m.CompressAsset != nil:
+		return "compressAsset"
+	case m.Webhook != nil:
+		return "webhook"
+	case m.Copy != nil:
+		return "copy"
+	case m.Import != nil:
+		return "import"
+	}
+	return ""
+}
+
+// WebhookMessage is an event to be sent to a webhook with the destination of the webhook.
+type WebhookMessage struct {
+	URL       string                  `json:"url"`
+	Secret    string                  `json:"secret"`
+	Timestamp time.Time               `json:"timestamp"`
+	WebhookID string                  `json:"webhookId"`
+	EventID   string                  `json:"eventId"`
+	EventType string                  `json:"type"`
+	EventData any                     `json:"data"`
+	Operator  integrationapi.Operator `json:"operator"`
+	// DeliveryID is the id of the delivery log which the result of the request is recorded to
+	DeliveryID string `json:"deliveryId,omitempty"`
+}
+
+func NewWebhookMessage(w *WebhookPayload) (*WebhookMessage, error) {
+	ed, err := integrationapi.NewEventWith(w.Event, w.Override, "")
+	if err != nil {
+		return nil, err
+	}
+
+	m := &WebhookMessage{
+		URL:       w.Webhook.URL().String(),
+		Secret:    w.Webhook.Secret(),
+		Timestamp: ed.Timestamp,
+		WebhookID: w.Webhook.ID().String(),
+		EventID:   ed.ID,
+		EventType: ed.Type,
+		EventData: ed.Data,
+		Operator:  ed.Operator,
+	}
+	if w.Delivery != nil {
+		m.DeliveryID = w.Delivery.String()
+	}
+	return m, nil
+}
+
+// Body returns the request body sent to the webhook.
+func (m *WebhookMessage) Body() ([]byte, error) {
+	return json.Marshal(struct {
+		ID        string    `json:"id"`
+		Timestamp time.Time `json:"timestamp"`
+		Type      string    `json:"type"`
+		Data      any       `json:"data"`
+		Operator  any       `json:"operator"`
+	}{
+		ID:        m.EventID,
+		Timestamp: m.Timestamp,
+		Type:      m.EventType,
+		Data:      m.EventData,
+		Operator:  m.Operator,
+	})
+}
+
+// Signature returns the value of the Reearth-Signature header of the request whose body is b and which is sent at t.
+func (m *WebhookMessage) Signature(b []byte, t time.Time) string {
+	mac := hmac.New(sha256.New, []byte(m.Secret))
+	_, _ = fmt.Fprintf(mac, "v1:%d:", t.Unix())
+	_, _ = mac.Write(b)
+	return fmt.Sprintf("v1,t=%d,%s", t.Unix(), hex.EncodeToString(mac.Sum(nil)))
+}
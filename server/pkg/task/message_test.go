@@ -0,0 +1,31 @@
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMessage(t *testing.T) {
+	_, err := NewMessage(Payload{})
+	assert.ErrorIs(t, err, ErrEmptyPayload)
+
+	m, err := NewMessage((&DecompressAssetPayload{AssetID: "a", Path: "b"}).Payload())
+	assert.NoError(t, err)
+	assert.Equal(t, Message{DecompressAsset: &DecompressAssetPayload{AssetID: "a", Path: "b"}}, m)
+	assert.Equal(t, "decompressAsset", m.Kind())
+}
+
+func TestWebhookMessage_Signature(t *testing.T) {
+	ts := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	m := &WebhookMessage{Secret: "secret", EventID: "e", EventType: "item.create", Timestamp: ts}
+
+	b, err := m.Body()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"id":"e","timestamp":"2026-01-01T00:00:00Z","type":"item.create","data":null,"operator":{}}`, string(b))
+
+	s := m.Signature(b, ts)
+	assert.Regexp(t, `^v1,t=1767225600,[0-9a-f]{64}$`, s)
+	assert.NotEqual(t, s, (&WebhookMessage{Secret: "other"}).Signature(b, ts))
+}
@@ -0,0 +1,152 @@
+package task
+
+import (
+	"errors"
+	"time"
+
+	"github.com/reearth/reearthx/util"
+)
+
+const (
+	DefaultMaxAttempts = 5
+
+	retryBaseInterval = 10 * time.Second
+	retryMaxInterval  = 10 * time.Minute
+)
+
+var ErrNotFinished = errors.New("task is not finished")
+
+// Task is a payload queued to be run by the built-in task runner.
+type Task struct {
+	id          ID
+	message     Message
+	status      Status
+	attempts    int
+	maxAttempts int
+	runAt       time.Time
+	lockedUntil *time.Time
+	errorMsg    string
+	updatedAt   time.Time
+}
+
+func (t *Task) ID() ID {
+	return t.id
+}
+
+func (t *Task) Message() Message {
+	return t.message
+}
+
+func (t *Task) Status() Status {
+	return t.status
+}
+
+func (t *Task) Attempts() int {
+	return t.attempts
+}
+
+func (t *Task) MaxAttempts() int {
+	return t.maxAttempts
+}
+
+// RunAt returns when the task is run or retried next.
+func (t *Task) RunAt() time.Time {
+	return t.runAt
+}
+
+// LockedUntil returns when the running task is regarded as lost and run again.
+func (t *Task) LockedUntil() *time.Time {
+	return util.CloneRef(t.lockedUntil)
+}
+
+func (t *Task) Error() string {
+	return t.errorMsg
+}
+
+func (t *Task) CreatedAt() time.Time {
+	return t.id.Timestamp()
+}
+
+func (t *Task) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+// IsDue returns true when the task is waiting to be run or its runner has been lost.
+func (t *Task) IsDue(now time.Time) bool {
+	switch t.status {
+	case StatusPending:
+		return !t.runAt.After(now)
+	case StatusRunning:
+		return t.lockedUntil != nil && t.lockedUntil.Before(now)
+	}
+	return false
+}
+
+// Start marks the task as being run by a runner until the lease expires.
+func (t *Task) Start(now time.Time, lease time.Duration) {
+	t.status = StatusRunning
+	t.attempts++
+	t.lockedUntil = new(now.Add(lease))
+	t.updatedAt = now
+}
+
+func (t *Task) Succeed(now time.Time) {
+	t.status = StatusSucceeded
+	t.lockedUntil = nil
+	t.errorMsg = ""
+	t.updatedAt = now
+}
+
+// Fail records the error and schedules a retry with an exponential backoff until the task runs out of its attempts.
+func (t *Task) Fail(now time.Time, err error) {
+	t.lockedUntil = nil
+	t.updatedAt = now
+	if err != nil {
+		t.errorMsg = err.Error()
+	}
+	if t.attempts >= t.maxAttempts {
+		t.status = StatusFailed
+		return
+	}
+	t.status = StatusPending
+	t.runAt = now.Add(RetryInterval(t.attempts))
+}
+
+// Retry runs the finished task again from its first attempt.
+func (t *Task) Retry(now time.Time) error {
+	if !t.status.IsFinished() {
+		return ErrNotFinished
+	}
+	t.status = StatusPending
+	t.attempts = 0
+	t.runAt = now
+	t.errorMsg = ""
+	t.updatedAt = now
+	return nil
+}
+
+func (t *Task) Clone() *Task {
+	if t == nil {
+		return nil
+	}
+	return &Task{
+		id:          t.id,
+		message:     t.message,
+		status:      t.status,
+		attempts:    t.attempts,
+		maxAttempts: t.maxAttempts,
+		runAt:       t.runAt,
+		lockedUntil: util.CloneRef(t.lockedUntil),
+		errorMsg:    t.errorMsg,
+		updatedAt:   t.updatedAt,
+	}
+}
+
+// RetryInterval returns the interval before the retry following the n-th attempt.
+func RetryInterval(attempts int) time.Duration {
+	i := retryBaseInterval
+	for n := 1; n < attempts && i < retryMaxInterval; n++ {
+		i *= 2
+	}
+	return min(i, retryMaxInterval)
+}
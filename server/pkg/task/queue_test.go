@@ -0,0 +1,71 @@
+package task
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTask_Lifecycle(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tk := New().NewID().Message(Message{Copy: &CopyPayload{Collection: "item"}}).MaxAttempts(2).RunAt(now).MustBuild()
+
+	assert.Equal(t, StatusPending, tk.Status())
+	assert.True(t, tk.IsDue(now))
+	assert.False(t, tk.IsDue(now.Add(-time.Second)))
+
+	tk.Start(now, time.Minute)
+	assert.Equal(t, StatusRunning, tk.Status())
+	assert.Equal(t, 1, tk.Attempts())
+	assert.Equal(t, new(now.Add(time.Minute)), tk.LockedUntil())
+	assert.False(t, tk.IsDue(now.Add(time.Minute)))
+	// the runner has been lost
+	assert.True(t, tk.IsDue(now.Add(time.Minute+time.Second)))
+
+	tk.Fail(now, errors.New("boom"))
+	assert.Equal(t, StatusPending, tk.Status())
+	assert.Equal(t, "boom", tk.Error())
+	assert.Nil(t, tk.LockedUntil())
+	assert.Equal(t, now.Add(retryBaseInterval), tk.RunAt())
+	assert.ErrorIs(t, tk.Retry(now), ErrNotFinished)
+
+	tk.Start(now, time.Minute)
+	tk.Fail(now, errors.New("boom"))
+	assert.Equal(t, StatusFailed, tk.Status())
+	assert.False(t, tk.IsDue(now.Add(time.Hour)))
+
+	assert.NoError(t, tk.Retry(now))
+	assert.Equal(t, StatusPending, tk.Status())
+	assert.Equal(t, 0, tk.Attempts())
+	assert.Equal(t, "", tk.Error())
+
+	tk.Start(now, time.Minute)
+	tk.Succeed(now)
+	assert.Equal(t, StatusSucceeded, tk.Status())
+	assert.Nil(t, tk.LockedUntil())
+}
+
+func TestBuilder_Build(t *testing.T) {
+	_, err := New().Message(Message{Copy: &CopyPayload{}}).Build()
+	assert.ErrorIs(t, err, ErrInvalidID)
+
+	_, err = New().NewID().Build()
+	assert.ErrorIs(t, err, ErrEmptyPayload)
+
+	_, err = New().NewID().Message(Message{Copy: &CopyPayload{}}).Status("x").Build()
+	assert.ErrorIs(t, err, ErrInvalidStatus)
+
+	tk, err := New().NewID().Message(Message{Copy: &CopyPayload{}}).MaxAttempts(0).Build()
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultMaxAttempts, tk.MaxAttempts())
+	assert.Equal(t, tk.CreatedAt(), tk.RunAt())
+}
+
+func TestRetryInterval(t *testing.T) {
+	assert.Equal(t, 10*time.Second, RetryInterval(1))
+	assert.Equal(t, 20*time.Second, RetryInterval(2))
+	assert.Equal(t, 80*time.Second, RetryInterval(4))
+	assert.Equal(t, 10*time.Minute, RetryInterval(10))
+}
@@ -0,0 +1,36 @@
+package task
+
+import "strings"
+
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+func StatusFrom(s string) (Status, bool) {
+	ss := strings.ToLower(s)
+	switch Status(ss) {
+	case StatusPending:
+		return StatusPending, true
+	case StatusRunning:
+		return StatusRunning, true
+	case StatusSucceeded:
+		return StatusSucceeded, true
+	case StatusFailed:
+		return StatusFailed, true
+	default:
+		return Status(""), false
+	}
+}
+
+func (s Status) String() string {
+	return string(s)
+}
+
+func (s Status) IsFinished() bool {
+	return s == StatusSucceeded || s == StatusFailed
+}
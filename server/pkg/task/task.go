@@ -37,9 +37,12 @@ func (t *CompressAssetPayload) Payload() Payload {
 }
 
 type WebhookPayload struct {
-	Webhook  *integration.Webhook
-	Event    *event.Event[any]
+	Webhook *integration.Webhook
+	Event   *event.Event[any]
+	// Override is sent as the data of the event instead of its object,
+	// such as the payload already rendered in the shape which the webhook requests.
 	Override any
+	Delivery *integration.WebhookDeliveryID
 }
 
 func (t WebhookPayload) Payload() Payload {
@@ -2,7 +2,9 @@ package thread
 
 import (
 	"slices"
+	"time"
 
+	"github.com/reearth/reearth-cms/server/pkg/operator"
 	"github.com/reearth/reearthx/account/accountdomain"
 )
 
@@ -53,3 +55,13 @@ func (b *Builder) Comments(c []*Comment) *Builder {
 	b.th.comments = slices.Clone(c)
 	return b
 }
+
+func (b *Builder) Resolved(by *operator.Operator, at *time.Time) *Builder {
+	if by == nil || at == nil {
+		b.th.resolvedBy, b.th.resolvedAt = nil, nil
+		return b
+	}
+	b.th.resolvedBy = new(*by)
+	b.th.resolvedAt = new(*at)
+	return b
+}
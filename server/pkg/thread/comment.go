@@ -1,15 +1,19 @@
 package thread
 
 import (
+	"slices"
 	"time"
 
 	"github.com/reearth/reearth-cms/server/pkg/operator"
 )
 
 type Comment struct {
-	id      CommentID
-	author  operator.Operator
-	content string
+	id        CommentID
+	author    operator.Operator
+	content   string
+	replyTo   *CommentID
+	mentions  MentionList
+	reactions ReactionList
 }
 
 func NewComment(id CommentID, author operator.Operator, content string) *Comment {
@@ -36,18 +40,68 @@ func (c *Comment) CreatedAt() time.Time {
 	return c.id.Timestamp()
 }
 
+// ReplyTo returns the id of the comment this comment replies to.
+func (c *Comment) ReplyTo() *CommentID {
+	return c.replyTo.CloneRef()
+}
+
+func (c *Comment) Mentions() MentionList {
+	return c.mentions.Clone()
+}
+
+func (c *Comment) Reactions() ReactionList {
+	return c.reactions.Clone()
+}
+
 func (c *Comment) SetContent(content string) {
 	c.content = content
 }
 
+func (c *Comment) SetReplyTo(replyTo *CommentID) {
+	c.replyTo = replyTo.CloneRef()
+}
+
+func (c *Comment) SetMentions(mentions MentionList) {
+	c.mentions = mentions.Clone()
+}
+
+func (c *Comment) SetReactions(reactions ReactionList) {
+	c.reactions = reactions.Clone()
+}
+
+func (c *Comment) AddReaction(r *Reaction) error {
+	if slices.ContainsFunc(c.reactions, func(r2 *Reaction) bool { return r2.is(r.emoji, r.author) }) {
+		return ErrAlreadyReacted
+	}
+	c.reactions = append(c.reactions, r.Clone())
+	return nil
+}
+
+func (c *Comment) RemoveReaction(emoji string, author operator.Operator) error {
+	i := slices.IndexFunc(c.reactions, func(r *Reaction) bool { return r.is(emoji, author) })
+	if i < 0 {
+		return ErrReactionNotFound
+	}
+	c.reactions = slices.Delete(slices.Clone(c.reactions), i, i+1)
+	return nil
+}
+
 func (c *Comment) Clone() *Comment {
 	if c == nil {
 		return nil
 	}
 
 	return &Comment{
-		id:      c.id,
-		author:  c.author,
-		content: c.content,
+		id:        c.id,
+		author:    c.author,
+		content:   c.content,
+		replyTo:   c.replyTo.CloneRef(),
+		mentions:  c.mentions.Clone(),
+		reactions: c.reactions.Clone(),
 	}
 }
+
+type CommentThread struct {
+	Thread  *Thread
+	Comment *Comment
+}
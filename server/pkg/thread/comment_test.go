@@ -32,11 +32,25 @@ func TestComment_SetContent(t *testing.T) {
 	assert.Equal(t, "xxx", comment.content)
 }
 
+func TestComment_SetReplyTo(t *testing.T) {
+	comment := Comment{}
+	cid := NewCommentID()
+	comment.SetReplyTo(&cid)
+	assert.Equal(t, &cid, comment.ReplyTo())
+	comment.SetReplyTo(nil)
+	assert.Nil(t, comment.ReplyTo())
+}
+
 func TestComment_Clone(t *testing.T) {
+	u := NewUserID()
+	r, _ := NewReaction("+1", operator.OperatorFromUser(u))
 	comment := &Comment{
-		id:      NewCommentID(),
-		author:  operator.OperatorFromUser(NewUserID()),
-		content: "test",
+		id:        NewCommentID(),
+		author:    operator.OperatorFromUser(NewUserID()),
+		content:   "test @alice",
+		replyTo:   NewCommentID().Ref(),
+		mentions:  MentionList{NewMention("alice", operator.OperatorFromUser(u))},
+		reactions: ReactionList{r},
 	}
 	assert.Nil(t, (*Comment)(nil).Clone())
 	assert.Equal(t, comment, comment.Clone())
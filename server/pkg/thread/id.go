@@ -8,6 +8,7 @@ import (
 type ID = id.ThreadID
 type CommentID = id.CommentID
 type UserID = accountdomain.UserID
+type UserIDList = accountdomain.UserIDList
 type WorkspaceID = id.WorkspaceID
 
 var NewID = id.NewThreadID
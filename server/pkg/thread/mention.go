@@ -0,0 +1,70 @@
+package thread
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/reearth/reearth-cms/server/pkg/operator"
+	"github.com/samber/lo"
+)
+
+// the mark must not follow a word character so that email addresses are not taken as mentions
+var mentionRe = regexp.MustCompile(`(?:^|[^\w@])@([\w][\w.\-]*)`)
+
+// Mention is a reference to a user or an integration written as @name in the content of a comment.
+type Mention struct {
+	name   string
+	target operator.Operator
+}
+
+func NewMention(name string, target operator.Operator) *Mention {
+	return &Mention{
+		name:   name,
+		target: target,
+	}
+}
+
+func (m *Mention) Name() string {
+	return m.name
+}
+
+func (m *Mention) Target() operator.Operator {
+	return m.target
+}
+
+func (m *Mention) Clone() *Mention {
+	if m == nil {
+		return nil
+	}
+	return &Mention{
+		name:   m.name,
+		target: m.target,
+	}
+}
+
+type MentionList []*Mention
+
+// Users returns the ids of the mentioned users.
+func (l MentionList) Users() UserIDList {
+	return lo.Uniq(lo.FilterMap(l, func(m *Mention, _ int) (UserID, bool) {
+		u := m.target.User()
+		return lo.FromPtr(u), u != nil
+	}))
+}
+
+func (l MentionList) Clone() MentionList {
+	if l == nil {
+		return nil
+	}
+	return lo.Map(l, func(m *Mention, _ int) *Mention { return m.Clone() })
+}
+
+// ParseMentions returns the names mentioned in the content in order of appearance without duplication.
+func ParseMentions(content string) []string {
+	matches := mentionRe.FindAllStringSubmatch(content, -1)
+	return lo.Uniq(lo.FilterMap(matches, func(m []string, _ int) (string, bool) {
+		// a trailing period ends the sentence rather than the name
+		name := strings.TrimRight(m[1], ".-")
+		return name, name != ""
+	}))
+}
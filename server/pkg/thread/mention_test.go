@@ -0,0 +1,41 @@
+package thread
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/operator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{name: "no mention", content: "hello world", want: []string{}},
+		{name: "mentions", content: "@alice could you and @bob.smith check this?", want: []string{"alice", "bob.smith"}},
+		{name: "end of sentence", content: "thanks @alice.", want: []string{"alice"}},
+		{name: "duplicated", content: "@alice @alice\n@my-integration", want: []string{"alice", "my-integration"}},
+		{name: "email", content: "mail to alice@example.com", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseMentions(tt.content))
+		})
+	}
+}
+
+func TestMentionList_Users(t *testing.T) {
+	u := NewUserID()
+	l := MentionList{
+		NewMention("alice", operator.OperatorFromUser(u)),
+		NewMention("integration", operator.OperatorFromIntegration(id.NewIntegrationID())),
+		NewMention("Alice", operator.OperatorFromUser(u)),
+	}
+	assert.Equal(t, UserIDList{u}, l.Users())
+	assert.Equal(t, l, l.Clone())
+	assert.Nil(t, MentionList(nil).Clone())
+}
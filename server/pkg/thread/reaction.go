@@ -0,0 +1,70 @@
+package thread
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/reearth/reearth-cms/server/pkg/operator"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+const maxReactionLength = 32
+
+var (
+	ErrInvalidReaction  = rerror.NewE(i18n.T("invalid reaction"))
+	ErrAlreadyReacted   = rerror.NewE(i18n.T("already reacted with the same emoji"))
+	ErrReactionNotFound = rerror.NewE(i18n.T("reaction not found"))
+)
+
+// Reaction is an emoji put on a comment by a user or an integration.
+type Reaction struct {
+	emoji  string
+	author operator.Operator
+}
+
+func NewReaction(emoji string, author operator.Operator) (*Reaction, error) {
+	if emoji == "" || utf8.RuneCountInString(emoji) > maxReactionLength || strings.ContainsFunc(emoji, func(r rune) bool { return r == ' ' || r == '\n' || r == '\t' }) {
+		return nil, ErrInvalidReaction
+	}
+	return &Reaction{
+		emoji:  emoji,
+		author: author,
+	}, nil
+}
+
+func (r *Reaction) Emoji() string {
+	return r.emoji
+}
+
+func (r *Reaction) Author() operator.Operator {
+	return r.author
+}
+
+func (r *Reaction) Clone() *Reaction {
+	if r == nil {
+		return nil
+	}
+	return &Reaction{
+		emoji:  r.emoji,
+		author: r.author,
+	}
+}
+
+func (r *Reaction) is(emoji string, author operator.Operator) bool {
+	return r.emoji == emoji && sameOperator(r.author, author)
+}
+
+type ReactionList []*Reaction
+
+func (l ReactionList) Clone() ReactionList {
+	if l == nil {
+		return nil
+	}
+	return lo.Map(l, func(r *Reaction, _ int) *Reaction { return r.Clone() })
+}
+
+func sameOperator(a, b operator.Operator) bool {
+	return lo.FromPtr(a.User()) == lo.FromPtr(b.User()) && lo.FromPtr(a.Integration()) == lo.FromPtr(b.Integration())
+}
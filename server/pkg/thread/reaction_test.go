@@ -0,0 +1,41 @@
+package thread
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/operator"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReaction(t *testing.T) {
+	author := operator.OperatorFromUser(NewUserID())
+
+	r, err := NewReaction("👍", author)
+	assert.NoError(t, err)
+	assert.Equal(t, "👍", r.Emoji())
+	assert.Equal(t, author, r.Author())
+
+	for _, emoji := range []string{"", "thumbs up", strings.Repeat("a", maxReactionLength+1)} {
+		_, err := NewReaction(emoji, author)
+		assert.Equal(t, ErrInvalidReaction, err)
+	}
+}
+
+func TestComment_Reactions(t *testing.T) {
+	u1, u2 := NewUserID(), NewUserID()
+	c := NewComment(NewCommentID(), operator.OperatorFromUser(u1), "test")
+	r1, _ := NewReaction("+1", operator.OperatorFromUser(u1))
+	r2, _ := NewReaction("+1", operator.OperatorFromUser(u2))
+
+	assert.NoError(t, c.AddReaction(r1))
+	assert.NoError(t, c.AddReaction(r2))
+	// the author is compared by value
+	assert.Equal(t, ErrAlreadyReacted, c.AddReaction(lo.Must(NewReaction("+1", operator.OperatorFromUser(u1)))))
+	assert.Equal(t, ReactionList{r1, r2}, c.Reactions())
+
+	assert.Equal(t, ErrReactionNotFound, c.RemoveReaction("heart", operator.OperatorFromUser(u1)))
+	assert.NoError(t, c.RemoveReaction("+1", operator.OperatorFromUser(u1)))
+	assert.Equal(t, ReactionList{r2}, c.Reactions())
+}
@@ -2,17 +2,21 @@ package thread
 
 import (
 	"slices"
+	"time"
 
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/operator"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 )
 
 type Thread struct {
-	id        ID
-	workspace accountdomain.WorkspaceID
-	comments  []*Comment
+	id         ID
+	workspace  accountdomain.WorkspaceID
+	comments   []*Comment
+	resolvedBy *operator.Operator
+	resolvedAt *time.Time
 }
 
 func (th *Thread) ID() ID {
@@ -30,6 +34,32 @@ func (th *Thread) Comments() []*Comment {
 	return slices.Clone(th.comments)
 }
 
+func (th *Thread) IsResolved() bool {
+	return th.resolvedAt != nil
+}
+
+func (th *Thread) ResolvedBy() *operator.Operator {
+	return util.CloneRef(th.resolvedBy)
+}
+
+func (th *Thread) ResolvedAt() *time.Time {
+	return util.CloneRef(th.resolvedAt)
+}
+
+// Resolve marks the thread as resolved, resolving a resolved thread keeps who resolved it first.
+func (th *Thread) Resolve(by operator.Operator) {
+	if th.IsResolved() {
+		return
+	}
+	th.resolvedBy = &by
+	th.resolvedAt = new(util.Now())
+}
+
+func (th *Thread) Reopen() {
+	th.resolvedBy = nil
+	th.resolvedAt = nil
+}
+
 func (th *Thread) HasComment(cid CommentID) bool {
 	if th == nil {
 		return false
@@ -44,6 +74,9 @@ func (th *Thread) AddComment(c *Comment) error {
 	if th.HasComment(c.ID()) {
 		return ErrCommentAlreadyExist
 	}
+	if c.ReplyTo() != nil && !th.HasComment(*c.ReplyTo()) {
+		return ErrCommentDoesNotExist
+	}
 
 	th.comments = append(th.comments, c)
 	return nil
@@ -88,8 +121,10 @@ func (th *Thread) Clone() *Thread {
 	})
 
 	return &Thread{
-		id:        th.id.Clone(),
-		workspace: th.workspace.Clone(),
-		comments:  comments,
+		id:         th.id.Clone(),
+		workspace:  th.workspace.Clone(),
+		comments:   comments,
+		resolvedBy: util.CloneRef(th.resolvedBy),
+		resolvedAt: util.CloneRef(th.resolvedAt),
 	}
 }
@@ -69,6 +69,30 @@ func TestThread_AddComment(t *testing.T) {
 
 	err = thread.AddComment(c)
 	assert.ErrorIs(t, err, ErrCommentAlreadyExist)
+
+	// replies must be to a comment of the thread
+	reply := NewComment(NewCommentID(), operator.OperatorFromUser(NewUserID()), "reply")
+	reply.SetReplyTo(NewCommentID().Ref())
+	assert.ErrorIs(t, thread.AddComment(reply), ErrCommentDoesNotExist)
+	reply.SetReplyTo(c.ID().Ref())
+	assert.NoError(t, thread.AddComment(reply))
+}
+
+func TestThread_Resolve(t *testing.T) {
+	thread := &Thread{id: NewID(), workspace: accountdomain.NewWorkspaceID()}
+	assert.False(t, thread.IsResolved())
+
+	u1, u2 := operator.OperatorFromUser(NewUserID()), operator.OperatorFromUser(NewUserID())
+	thread.Resolve(u1)
+	assert.True(t, thread.IsResolved())
+	assert.NotNil(t, thread.ResolvedAt())
+	thread.Resolve(u2)
+	assert.Equal(t, &u1, thread.ResolvedBy())
+
+	thread.Reopen()
+	assert.False(t, thread.IsResolved())
+	assert.Nil(t, thread.ResolvedBy())
+	assert.Nil(t, thread.ResolvedAt())
 }
 
 func TestThread_UpdateComment(t *testing.T) {
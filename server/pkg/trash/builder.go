@@ -0,0 +1,94 @@
+package trash
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/util"
+)
+
+var (
+	ErrInvalidID   = errors.New("invalid item id")
+	ErrNoProjectID = errors.New("project id is required")
+	ErrNoModelID   = errors.New("model id is required")
+	ErrNoUser      = errors.New("user or integration is required")
+)
+
+type Builder struct {
+	e *Entry
+}
+
+func New() *Builder {
+	return &Builder{e: &Entry{}}
+}
+
+func (b *Builder) Build() (*Entry, error) {
+	if b.e.item.IsNil() {
+		return nil, ErrInvalidID
+	}
+	if b.e.project.IsNil() {
+		return nil, ErrNoProjectID
+	}
+	if b.e.model.IsNil() {
+		return nil, ErrNoModelID
+	}
+	if b.e.user == nil && b.e.integration == nil {
+		return nil, ErrNoUser
+	}
+	if b.e.trashedAt.IsZero() {
+		b.e.trashedAt = util.Now()
+	}
+	return b.e, nil
+}
+
+func (b *Builder) MustBuild() *Entry {
+	e, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+func (b *Builder) Item(iid ItemID) *Builder {
+	b.e.item = iid
+	return b
+}
+
+func (b *Builder) MetadataItem(iid *ItemID) *Builder {
+	b.e.metadataItem = iid.CloneRef()
+	return b
+}
+
+func (b *Builder) Project(pid ProjectID) *Builder {
+	b.e.project = pid
+	return b
+}
+
+func (b *Builder) Model(mid ModelID) *Builder {
+	b.e.model = mid
+	return b
+}
+
+func (b *Builder) User(uid accountdomain.UserID) *Builder {
+	b.e.user = &uid
+	b.e.integration = nil
+	return b
+}
+
+func (b *Builder) Integration(iid IntegrationID) *Builder {
+	b.e.integration = &iid
+	b.e.user = nil
+	return b
+}
+
+func (b *Builder) References(refs []Reference) *Builder {
+	b.e.references = slices.Clone(refs)
+	return b
+}
+
+func (b *Builder) TrashedAt(t time.Time) *Builder {
+	b.e.trashedAt = t
+	return b
+}
@@ -0,0 +1,98 @@
+package trash
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_Build(t *testing.T) {
+	iid := id.NewItemID()
+	mdid := id.NewItemID()
+	pid := id.NewProjectID()
+	mid := id.NewModelID()
+	uid := accountdomain.NewUserID()
+	refs := []Reference{NewReference(id.NewItemID(), id.NewFieldID())}
+	at := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	base := func() *Builder {
+		return New().
+			Item(iid).
+			MetadataItem(&mdid).
+			Project(pid).
+			Model(mid).
+			User(uid).
+			References(refs).
+			TrashedAt(at)
+	}
+
+	t.Run("success with user", func(t *testing.T) {
+		t.Parallel()
+
+		e, err := base().Build()
+
+		assert.NoError(t, err)
+		assert.Equal(t, iid, e.Item())
+		assert.Equal(t, &mdid, e.MetadataItem())
+		assert.Equal(t, pid, e.Project())
+		assert.Equal(t, mid, e.Model())
+		assert.Equal(t, &uid, e.User())
+		assert.Nil(t, e.Integration())
+		assert.Equal(t, refs, e.References())
+		assert.Equal(t, at, e.TrashedAt())
+	})
+
+	t.Run("success with integration", func(t *testing.T) {
+		t.Parallel()
+
+		iid := id.NewIntegrationID()
+		e, err := base().Integration(iid).Build()
+
+		assert.NoError(t, err)
+		assert.Nil(t, e.User())
+		assert.Equal(t, &iid, e.Integration())
+	})
+
+	t.Run("trashedAt defaults to now", func(t *testing.T) {
+		now := time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)
+		defer util.MockNow(now)()
+
+		e, err := New().Item(iid).Project(pid).Model(mid).User(uid).Build()
+
+		assert.NoError(t, err)
+		assert.Equal(t, now, e.TrashedAt())
+		assert.Nil(t, e.MetadataItem())
+		assert.Nil(t, e.References())
+	})
+
+	tests := []struct {
+		name    string
+		builder *Builder
+		wantErr error
+	}{
+		{name: "no item", builder: base().Item(id.ItemID{}), wantErr: ErrInvalidID},
+		{name: "no project", builder: base().Project(id.ProjectID{}), wantErr: ErrNoProjectID},
+		{name: "no model", builder: base().Model(id.ModelID{}), wantErr: ErrNoModelID},
+		{name: "no user", builder: New().Item(iid).Project(pid).Model(mid), wantErr: ErrNoUser},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			e, err := tt.builder.Build()
+			assert.Equal(t, tt.wantErr, err)
+			assert.Nil(t, e)
+		})
+	}
+}
+
+func TestBuilder_MustBuild(t *testing.T) {
+	assert.Panics(t, func() { New().MustBuild() })
+	assert.NotPanics(t, func() {
+		New().Item(id.NewItemID()).Project(id.NewProjectID()).Model(id.NewModelID()).Integration(id.NewIntegrationID()).MustBuild()
+	})
+}
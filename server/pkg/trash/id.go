@@ -0,0 +1,10 @@
+package trash
+
+import "github.com/reearth/reearth-cms/server/pkg/id"
+
+type ProjectID = id.ProjectID
+type ModelID = id.ModelID
+type ItemID = id.ItemID
+type ItemIDList = id.ItemIDList
+type FieldID = id.FieldID
+type IntegrationID = id.IntegrationID
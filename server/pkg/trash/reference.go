@@ -0,0 +1,19 @@
+package trash
+
+// Reference is a reference field of an item that pointed to a trashed item.
+type Reference struct {
+	item  ItemID
+	field FieldID
+}
+
+func NewReference(item ItemID, field FieldID) Reference {
+	return Reference{item: item, field: field}
+}
+
+func (r Reference) Item() ItemID {
+	return r.item
+}
+
+func (r Reference) Field() FieldID {
+	return r.field
+}
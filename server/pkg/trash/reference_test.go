@@ -0,0 +1,17 @@
+package trash
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReference(t *testing.T) {
+	iid := id.NewItemID()
+	fid := id.NewFieldID()
+
+	r := NewReference(iid, fid)
+	assert.Equal(t, iid, r.Item())
+	assert.Equal(t, fid, r.Field())
+}
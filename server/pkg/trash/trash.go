@@ -0,0 +1,103 @@
+package trash
+
+import (
+	"slices"
+	"time"
+
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+// Entry records an item moved to the trash. The item can be restored until the entry is purged.
+type Entry struct {
+	item         ItemID
+	metadataItem *ItemID
+	project      ProjectID
+	model        ModelID
+	user         *accountdomain.UserID
+	integration  *IntegrationID
+	references   []Reference
+	trashedAt    time.Time
+}
+
+type List []*Entry
+
+func (e *Entry) Item() ItemID {
+	return e.item
+}
+
+func (e *Entry) MetadataItem() *ItemID {
+	return e.metadataItem
+}
+
+func (e *Entry) Project() ProjectID {
+	return e.project
+}
+
+func (e *Entry) Model() ModelID {
+	return e.model
+}
+
+func (e *Entry) User() *accountdomain.UserID {
+	return e.user
+}
+
+func (e *Entry) Integration() *IntegrationID {
+	return e.integration
+}
+
+// References returns the reference fields of other items that pointed to the item when it was trashed.
+func (e *Entry) References() []Reference {
+	return slices.Clone(e.references)
+}
+
+func (e *Entry) TrashedAt() time.Time {
+	return e.trashedAt
+}
+
+// ExpiresAt returns when the entry is purged under the retention period. Zero retention keeps the entry forever.
+func (e *Entry) ExpiresAt(retention time.Duration) *time.Time {
+	if retention <= 0 {
+		return nil
+	}
+	return lo.ToPtr(e.trashedAt.Add(retention))
+}
+
+func (e *Entry) IsExpired(retention time.Duration, now time.Time) bool {
+	exp := e.ExpiresAt(retention)
+	return exp != nil && !exp.After(now)
+}
+
+func (e *Entry) Clone() *Entry {
+	if e == nil {
+		return nil
+	}
+	return &Entry{
+		item:         e.item.Clone(),
+		metadataItem: e.metadataItem.CloneRef(),
+		project:      e.project.Clone(),
+		model:        e.model.Clone(),
+		user:         e.user.CloneRef(),
+		integration:  e.integration.CloneRef(),
+		references:   e.References(),
+		trashedAt:    e.trashedAt,
+	}
+}
+
+func (l List) Items() ItemIDList {
+	return lo.Map(l, func(e *Entry, _ int) ItemID { return e.Item() })
+}
+
+func (l List) MetadataItems() ItemIDList {
+	return lo.FilterMap(l, func(e *Entry, _ int) (ItemID, bool) {
+		if e.MetadataItem() == nil {
+			return ItemID{}, false
+		}
+		return *e.MetadataItem(), true
+	})
+}
+
+func (l List) Clone() List {
+	return util.Map(l, func(e *Entry) *Entry { return e.Clone() })
+}
@@ -0,0 +1,52 @@
+package trash
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntry_ExpiresAt(t *testing.T) {
+	at := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+	e := New().Item(id.NewItemID()).Project(id.NewProjectID()).Model(id.NewModelID()).User(accountdomain.NewUserID()).TrashedAt(at).MustBuild()
+
+	assert.Equal(t, new(at.Add(24*time.Hour)), e.ExpiresAt(24*time.Hour))
+	assert.Nil(t, e.ExpiresAt(0))
+
+	assert.False(t, e.IsExpired(24*time.Hour, at.Add(time.Hour)))
+	assert.True(t, e.IsExpired(24*time.Hour, at.Add(24*time.Hour)))
+	assert.False(t, e.IsExpired(0, at.Add(24*365*time.Hour)))
+}
+
+func TestEntry_Clone(t *testing.T) {
+	mdid := id.NewItemID()
+	e := New().
+		Item(id.NewItemID()).
+		MetadataItem(&mdid).
+		Project(id.NewProjectID()).
+		Model(id.NewModelID()).
+		Integration(id.NewIntegrationID()).
+		References([]Reference{NewReference(id.NewItemID(), id.NewFieldID())}).
+		MustBuild()
+
+	got := e.Clone()
+	assert.Equal(t, e, got)
+	assert.NotSame(t, e, got)
+	assert.Nil(t, (*Entry)(nil).Clone())
+}
+
+func TestList(t *testing.T) {
+	iid1, iid2, mdid := id.NewItemID(), id.NewItemID(), id.NewItemID()
+	pid, mid, uid := id.NewProjectID(), id.NewModelID(), accountdomain.NewUserID()
+	l := List{
+		New().Item(iid1).MetadataItem(&mdid).Project(pid).Model(mid).User(uid).MustBuild(),
+		New().Item(iid2).Project(pid).Model(mid).User(uid).MustBuild(),
+	}
+
+	assert.Equal(t, id.ItemIDList{iid1, iid2}, l.Items())
+	assert.Equal(t, id.ItemIDList{mdid}, l.MetadataItems())
+	assert.Equal(t, l, l.Clone())
+}
@@ -3,14 +3,16 @@ package types
 import "time"
 
 type Asset struct {
-	Type        string     `json:"type"`
-	ID          string     `json:"id,omitempty"`
-	URL         string     `json:"url,omitempty"`
-	ContentType string     `json:"contentType,omitempty"`
-	Size        int64      `json:"size,omitempty"`
-	Files       []string   `json:"files,omitempty"`
-	CreatedAt   *time.Time `json:"createdAt,omitempty"`
-	UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
-	CreatedBy   string     `json:"createdBy,omitempty"`
-	UpdatedBy   string     `json:"updatedBy,omitempty"`
+	Type        string         `json:"type"`
+	ID          string         `json:"id,omitempty"`
+	URL         string         `json:"url,omitempty"`
+	ContentType string         `json:"contentType,omitempty"`
+	Size        int64          `json:"size,omitempty"`
+	Files       []string       `json:"files,omitempty"`
+	FolderPath  string         `json:"folderPath,omitempty"`
+	Metadata    map[string]any `json:"metadata,omitempty"`
+	CreatedAt   *time.Time     `json:"createdAt,omitempty"`
+	UpdatedAt   *time.Time     `json:"updatedAt,omitempty"`
+	CreatedBy   string         `json:"createdBy,omitempty"`
+	UpdatedBy   string         `json:"updatedBy,omitempty"`
 }
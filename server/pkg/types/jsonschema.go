@@ -24,7 +24,12 @@ type JSONSchema struct {
 	Multiple  bool   `json:"x-multiple,omitempty"`
 
 	// for string based types
-	MaxLength *int `json:"maxLength,omitempty"`
+	MaxLength      *int      `json:"maxLength,omitempty"`
+	MinLength      *int      `json:"minLength,omitempty"`
+	Pattern        *string   `json:"pattern,omitempty"`
+	PatternMessage *string   `json:"x-patternMessage,omitempty"`
+	ForbiddenWords *[]string `json:"x-forbiddenWords,omitempty"`
+	Preset         *string   `json:"x-preset,omitempty"`
 
 	// for number types
 	Maximum *float64 `json:"maximum,omitempty"`
@@ -7,6 +7,9 @@ import (
 const Latest = Ref("latest")
 const Public = Ref("public")
 
+// Trash is the ref that replaces latest while the value is in the trash
+const Trash = Ref("trash")
+
 type Ref string
 
 func (r Ref) Ref() *Ref {
@@ -29,7 +32,7 @@ func (r *Ref) OrLatest() *Ref {
 }
 
 func (r Ref) IsSpecial() bool {
-	return r == Ref("") || r == Latest
+	return r == Ref("") || r == Latest || r == Trash
 }
 
 type Refs = set.Set[Ref]
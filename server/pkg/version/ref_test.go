@@ -29,6 +29,8 @@ func TestRef_IsSpecial(t *testing.T) {
 	assert.False(t, Ref("x").IsSpecial())
 	assert.True(t, Ref("").IsSpecial())
 	assert.True(t, Latest.IsSpecial())
+	assert.True(t, Trash.IsSpecial())
+	assert.False(t, Public.IsSpecial())
 }
 
 func TestRefsFrom(t *testing.T) {
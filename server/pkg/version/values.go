@@ -137,6 +137,23 @@ func (v *Values[V]) UpdateRef(r Ref, vr *VersionOrRef) {
 	}
 }
 
+// MoveRef renames the ref from to the ref to on the value it points. Unlike UpdateRef, special refs can be moved.
+func (v *Values[V]) MoveRef(from, to Ref) {
+	if v == nil || v.IsArchived() || from == to {
+		return
+	}
+
+	vv := v.get(from.OrVersion())
+	if vv == nil {
+		return
+	}
+	if v2 := v.get(to.OrVersion()); v2 != nil {
+		v2.DeleteRefs(to)
+	}
+	vv.DeleteRefs(from)
+	vv.AddRefs(to)
+}
+
 func cloneValues[V any](values []*Value[V]) []*Value[V] {
 	return util.Map(values, func(v *Value[V]) *Value[V] { return v.Clone() })
 }
@@ -324,6 +324,95 @@ func TestValues_UpdateRef(t *testing.T) {
 	}
 }
 
+func TestValues_MoveRef(t *testing.T) {
+	vx, vy := New(), New()
+
+	tests := []struct {
+		name     string
+		target   *Values[string]
+		from, to Ref
+		want     *Values[string]
+	}{
+		{
+			name: "latest should be moved",
+			target: &Values[string]{
+				inner: []*Value[string]{
+					NewValue(vx, nil, NewRefs(Public), time.Time{}, "a"),
+					NewValue(vy, NewVersions(vx), NewRefs(Latest), time.Time{}, "b"),
+				},
+			},
+			from: Latest,
+			to:   Trash,
+			want: &Values[string]{
+				inner: []*Value[string]{
+					NewValue(vx, nil, NewRefs(Public), time.Time{}, "a"),
+					NewValue(vy, NewVersions(vx), NewRefs(Trash), time.Time{}, "b"),
+				},
+			},
+		},
+		{
+			name: "existing ref should be replaced",
+			target: &Values[string]{
+				inner: []*Value[string]{
+					NewValue(vx, nil, NewRefs("A"), time.Time{}, "a"),
+					NewValue(vy, NewVersions(vx), NewRefs(Latest), time.Time{}, "b"),
+				},
+			},
+			from: Latest,
+			to:   "A",
+			want: &Values[string]{
+				inner: []*Value[string]{
+					NewValue(vx, nil, nil, time.Time{}, "a"),
+					NewValue(vy, NewVersions(vx), NewRefs("A"), time.Time{}, "b"),
+				},
+			},
+		},
+		{
+			name: "ref is not found",
+			target: &Values[string]{
+				inner: []*Value[string]{
+					NewValue(vx, nil, NewRefs(Latest), time.Time{}, "a"),
+				},
+			},
+			from: Trash,
+			to:   Latest,
+			want: &Values[string]{
+				inner: []*Value[string]{
+					NewValue(vx, nil, NewRefs(Latest), time.Time{}, "a"),
+				},
+			},
+		},
+		{
+			name: "archived should not be moved",
+			target: &Values[string]{
+				inner: []*Value[string]{
+					NewValue(vx, nil, NewRefs(Latest), time.Time{}, "a"),
+				},
+				archived: true,
+			},
+			from: Latest,
+			to:   Trash,
+			want: &Values[string]{
+				inner: []*Value[string]{
+					NewValue(vx, nil, NewRefs(Latest), time.Time{}, "a"),
+				},
+				archived: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			target := tt.target
+			target.MoveRef(tt.from, tt.to)
+			assert.Equal(t, tt.want, target)
+			assert.True(t, target.validate())
+		})
+	}
+}
+
 func TestUnwrapValues(t *testing.T) {
 	assert.Equal(t, []int{1, 2, 3}, UnwrapValues([]*Value[int]{{value: 1}, {value: 2}, {value: 3}}))
 	assert.Nil(t, UnwrapValues[int](nil))
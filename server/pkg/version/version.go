@@ -37,6 +37,14 @@ func ToVersionOrLatestRef(ver *string) VersionOrRef {
 	return v.OrRef()
 }
 
+// ParseVersionOrRef parses a version, or returns the ref of the name when it is not a version.
+func ParseVersionOrRef(ver string) VersionOrRef {
+	if v := ParseVersion(&ver); v != nil {
+		return v.OrRef()
+	}
+	return Ref(ver).OrVersion()
+}
+
 func ParseVersion(ver *string) *Version {
 	if ver == nil {
 		return nil
@@ -52,6 +52,13 @@ func TestToVersionOrLatestRef(t *testing.T) {
 	assert.Nil(t, v2)
 }
 
+func TestParseVersionOrRef(t *testing.T) {
+	ver := "b3a1e9e4-1c6e-4f56-8f93-1234567890ab"
+
+	assert.Equal(t, Version(uuid.MustParse(ver)).OrRef(), ParseVersionOrRef(ver))
+	assert.Equal(t, Public.OrVersion(), ParseVersionOrRef("public"))
+}
+
 func TestParseVersion(t *testing.T) {
 	ver1 := "b3a1e9e4-1c6e-4f56-8f93-1234567890ab"
 	ver2 := "invalid-uuid"
@@ -66,5 +66,5 @@ func initReposWithCollection(ctx context.Context, dbURI, collection string) (*re
 	db := client.Database("reearth_cms")
 	mongoCopier := rmongo.NewCopier(db)
 	mongoCopier.SetCollection(db.Collection(collection))
-	return rmongo.New(ctx, nil, mongoCopier)
+	return rmongo.New(ctx, nil, nil, mongoCopier)
 }
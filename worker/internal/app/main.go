@@ -46,7 +46,8 @@ func Start(debug bool, version string) {
 	}
 	mongoWebhook := rmongo.NewWebhook(client.Database("reearth_cms"))
 	lo.Must0(mongoWebhook.InitIndex(ctx))
-	repos, err := rmongo.New(ctx, mongoWebhook, nil)
+	mongoWebhookDelivery := rmongo.NewWebhookDelivery(client.Database("reearth_cms"))
+	repos, err := rmongo.New(ctx, mongoWebhook, mongoWebhookDelivery, nil)
 	if err != nil {
 		log.Fatalf("repo initialization error: %+v\n", err)
 	}
@@ -7,10 +7,11 @@ import (
 	"github.com/reearth/reearth-cms/worker/internal/usecase/repo"
 )
 
-func New(ctx context.Context, webhook *Webhook, copier *Copier) (*repo.Container, error) {
+func New(ctx context.Context, webhook *Webhook, webhookDelivery *WebhookDelivery, copier *Copier) (*repo.Container, error) {
 	r := &repo.Container{
-		Webhook: webhook,
-		Copier:  copier,
+		Webhook:         webhook,
+		WebhookDelivery: webhookDelivery,
+		Copier:          copier,
 	}
 
 	// init
@@ -0,0 +1,68 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth-cms/worker/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/worker/pkg/webhook"
+	"github.com/reearth/reearthx/rerror"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// webhookDeliveryCol is the collection of the delivery log which is managed by the server
+const webhookDeliveryCol = "webhook_delivery"
+
+var _ repo.WebhookDelivery = (*WebhookDelivery)(nil)
+
+type WebhookDelivery struct {
+	c *mongo.Collection
+}
+
+func NewWebhookDelivery(db *mongo.Database) *WebhookDelivery {
+	return &WebhookDelivery{
+		c: db.Collection(webhookDeliveryCol),
+	}
+}
+
+func (w *WebhookDelivery) RecordAttempt(ctx context.Context, deliveryID string, a webhook.Attempt) error {
+	res := w.c.FindOneAndUpdate(ctx, bson.M{
+		"id": deliveryID,
+	}, bson.M{
+		"$push": bson.M{
+			"attempts": bson.M{
+				"attemptedat":  a.AttemptedAt,
+				"statuscode":   a.StatusCode,
+				"latencyms":    a.Latency.Milliseconds(),
+				"responsebody": a.ResponseBody,
+				"error":        a.Error,
+			},
+		},
+	}, options.FindOneAndUpdate().SetReturnDocument(options.After).SetProjection(bson.M{"attempts": 1}))
+
+	var d struct {
+		Attempts []bson.M `bson:"attempts"`
+	}
+	if err := res.Decode(&d); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return rerror.ErrNotFound
+		}
+		return rerror.ErrInternalBy(err)
+	}
+
+	status, next := webhook.NextDeliveryStatus(len(d.Attempts), a.Succeeded(), a.AttemptedAt)
+	if _, err := w.c.UpdateOne(ctx, bson.M{
+		"id": deliveryID,
+	}, bson.M{
+		"$set": bson.M{
+			"status":        status,
+			"nextattemptat": next,
+			"updatedat":     a.AttemptedAt,
+		},
+	}); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	return nil
+}
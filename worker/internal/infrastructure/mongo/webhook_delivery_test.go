@@ -0,0 +1,43 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/worker/pkg/webhook"
+	"github.com/reearth/reearthx/mongox/mongotest"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestWebhookDelivery_RecordAttempt(t *testing.T) {
+	ctx := context.Background()
+	db := mongotest.Connect(t)(t)
+	w := NewWebhookDelivery(db)
+	now := time.Date(2022, 10, 10, 1, 1, 1, 0, time.UTC)
+
+	err := w.RecordAttempt(ctx, "xxx", webhook.Attempt{AttemptedAt: now, StatusCode: 200})
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	_, err = db.Collection(webhookDeliveryCol).InsertOne(ctx, bson.M{"id": "aaa", "status": "pending"})
+	assert.NoError(t, err)
+
+	assert.NoError(t, w.RecordAttempt(ctx, "aaa", webhook.Attempt{AttemptedAt: now, StatusCode: 500, ResponseBody: "error"}))
+	var d struct {
+		Status        string     `bson:"status"`
+		NextAttemptAt *time.Time `bson:"nextattemptat"`
+		Attempts      []bson.M   `bson:"attempts"`
+	}
+	assert.NoError(t, db.Collection(webhookDeliveryCol).FindOne(ctx, bson.M{"id": "aaa"}).Decode(&d))
+	assert.Equal(t, webhook.DeliveryStatusRetrying, d.Status)
+	assert.Equal(t, now.Add(time.Minute), d.NextAttemptAt.UTC())
+	assert.Len(t, d.Attempts, 1)
+
+	assert.NoError(t, w.RecordAttempt(ctx, "aaa", webhook.Attempt{AttemptedAt: now, StatusCode: 200}))
+	assert.NoError(t, db.Collection(webhookDeliveryCol).FindOne(ctx, bson.M{"id": "aaa"}).Decode(&d))
+	assert.Equal(t, webhook.DeliveryStatusSucceeded, d.Status)
+	assert.Nil(t, d.NextAttemptAt)
+	assert.Len(t, d.Attempts, 2)
+}
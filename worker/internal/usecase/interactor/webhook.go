@@ -6,10 +6,15 @@ import (
 
 	"github.com/reearth/reearth-cms/worker/pkg/webhook"
 	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/util"
 )
 
 func (u *Usecase) SendWebhook(ctx context.Context, w *webhook.Webhook) error {
+	// retries of a delivery share the delivery id, so they are deduplicated per delivery
 	eid := fmt.Sprintf("%s_%s", w.EventID, w.WebhookID)
+	if w.DeliveryID != "" {
+		eid = w.DeliveryID
+	}
 	found, err := u.repos.Webhook.GetAndSet(ctx, eid)
 	if err != nil {
 		log.Errorf("webhook usecase: failed to get webhook sent: %v", err)
@@ -20,13 +25,23 @@ func (u *Usecase) SendWebhook(ctx context.Context, w *webhook.Webhook) error {
 
 	log.Infof("webhook usecase: process: %+v", w)
 
-	if err := webhook.Send(ctx, w); err != nil {
+	now := util.Now()
+	res, err := webhook.Send(ctx, w)
+	if err != nil {
 		log.Errorf("webhook usecase: error response: %v", err)
 		if err2 := u.repos.Webhook.Delete(ctx, eid); err2 != nil {
 			log.Errorf("webhook usecase: failed to set webhook sent: %v", err2)
 		}
+	}
+
+	if w.DeliveryID == "" || u.repos.WebhookDelivery == nil {
 		return err
 	}
 
+	// the server retries the failed delivery with a backoff according to the recorded attempt
+	if err2 := u.repos.WebhookDelivery.RecordAttempt(ctx, w.DeliveryID, webhook.NewAttempt(now, res, err)); err2 != nil {
+		log.Errorf("webhook usecase: failed to record delivery attempt: %v", err2)
+		return err
+	}
 	return nil
 }
@@ -1,6 +1,7 @@
 package repo
 
 type Container struct {
-	Webhook Webhook
-	Copier  Copier
+	Webhook         Webhook
+	WebhookDelivery WebhookDelivery
+	Copier          Copier
 }
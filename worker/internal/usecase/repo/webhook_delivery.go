@@ -0,0 +1,12 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/worker/pkg/webhook"
+)
+
+type WebhookDelivery interface {
+	// RecordAttempt appends the attempt to the delivery log and updates the status of the delivery.
+	RecordAttempt(ctx context.Context, deliveryID string, a webhook.Attempt) error
+}
@@ -0,0 +1,57 @@
+package webhook
+
+import "time"
+
+// The delivery statuses and the retry policy follow the webhook delivery log of the server.
+const (
+	DeliveryStatusSucceeded = "succeeded"
+	DeliveryStatusRetrying  = "retrying"
+	DeliveryStatusDead      = "dead"
+
+	maxDeliveryAttempts       = 5
+	deliveryRetryBaseInterval = time.Minute
+	deliveryRetryMaxInterval  = time.Hour
+)
+
+// Attempt is an attempt to deliver a webhook which is recorded to the delivery log.
+type Attempt struct {
+	AttemptedAt  time.Time
+	StatusCode   int
+	Latency      time.Duration
+	ResponseBody string
+	Error        string
+}
+
+func NewAttempt(at time.Time, r *Result, err error) Attempt {
+	a := Attempt{AttemptedAt: at}
+	if r != nil {
+		a.StatusCode = r.StatusCode
+		a.Latency = r.Latency
+		a.ResponseBody = r.ResponseBody
+	}
+	if err != nil {
+		a.Error = err.Error()
+	}
+	return a
+}
+
+func (a Attempt) Succeeded() bool {
+	return a.Error == "" && a.StatusCode >= 200 && a.StatusCode < 300
+}
+
+// NextDeliveryStatus returns the status of a delivery after its n-th attempt and when it is retried.
+func NextDeliveryStatus(attempts int, succeeded bool, at time.Time) (string, *time.Time) {
+	if succeeded {
+		return DeliveryStatusSucceeded, nil
+	}
+	if attempts >= maxDeliveryAttempts {
+		return DeliveryStatusDead, nil
+	}
+
+	i := deliveryRetryBaseInterval
+	for n := 1; n < attempts && i < deliveryRetryMaxInterval; n++ {
+		i *= 2
+	}
+	next := at.Add(min(i, deliveryRetryMaxInterval))
+	return DeliveryStatusRetrying, &next
+}
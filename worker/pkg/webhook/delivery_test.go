@@ -0,0 +1,41 @@
+package webhook
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAttempt(t *testing.T) {
+	now := time.Date(2022, 10, 10, 1, 1, 1, 1, time.UTC)
+
+	a := NewAttempt(now, &Result{StatusCode: 200, Latency: time.Second, ResponseBody: "ok"}, nil)
+	assert.Equal(t, Attempt{AttemptedAt: now, StatusCode: 200, Latency: time.Second, ResponseBody: "ok"}, a)
+	assert.True(t, a.Succeeded())
+
+	a = NewAttempt(now, nil, errors.New("timeout"))
+	assert.Equal(t, Attempt{AttemptedAt: now, Error: "timeout"}, a)
+	assert.False(t, a.Succeeded())
+}
+
+func TestNextDeliveryStatus(t *testing.T) {
+	now := time.Date(2022, 10, 10, 1, 1, 1, 1, time.UTC)
+
+	s, next := NextDeliveryStatus(1, true, now)
+	assert.Equal(t, DeliveryStatusSucceeded, s)
+	assert.Nil(t, next)
+
+	s, next = NextDeliveryStatus(1, false, now)
+	assert.Equal(t, DeliveryStatusRetrying, s)
+	assert.Equal(t, now.Add(time.Minute), *next)
+
+	s, next = NextDeliveryStatus(3, false, now)
+	assert.Equal(t, DeliveryStatusRetrying, s)
+	assert.Equal(t, now.Add(4*time.Minute), *next)
+
+	s, next = NextDeliveryStatus(5, false, now)
+	assert.Equal(t, DeliveryStatusDead, s)
+	assert.Nil(t, next)
+}
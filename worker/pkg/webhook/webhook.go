@@ -15,6 +15,9 @@ import (
 	"github.com/reearth/reearthx/util"
 )
 
+// maxResponseBodyLength is the length of the excerpt of the response body kept in the delivery log
+const maxResponseBodyLength = 1024
+
 var httpClient = &http.Client{Timeout: 30 * time.Second}
 
 type Webhook struct {
@@ -26,6 +29,15 @@ type Webhook struct {
 	EventType string    `json:"type"`
 	EventData any       `json:"data"`
 	Operator  any       `json:"operator"`
+	// DeliveryID is the id of the delivery log which the result of the request is recorded to
+	DeliveryID string `json:"deliveryId,omitempty"`
+}
+
+// Result is the response of a webhook request.
+type Result struct {
+	StatusCode   int
+	Latency      time.Duration
+	ResponseBody string
 }
 
 type requestBody struct {
@@ -36,15 +48,15 @@ type requestBody struct {
 	Operator  any       `json:"operator"`
 }
 
-func Send(ctx context.Context, w *Webhook) error {
+func Send(ctx context.Context, w *Webhook) (*Result, error) {
 	b, err := w.requestBody()
 	if err != nil {
-		return fmt.Errorf("failed to marshal request body: %w", err)
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, "POST", w.URL, bytes.NewBuffer(b))
 	if err != nil {
-		return fmt.Errorf("failed to create a request: %w", err)
+		return nil, fmt.Errorf("failed to create a request: %w", err)
 	}
 
 	now := util.Now()
@@ -52,9 +64,10 @@ func Send(ctx context.Context, w *Webhook) error {
 
 	req.Header.Set("Reearth-Signature", signature)
 
+	start := time.Now()
 	res, err := httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to send a request: %w", err)
+		return &Result{Latency: time.Since(start)}, fmt.Errorf("failed to send a request: %w", err)
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
@@ -63,11 +76,18 @@ func Send(ctx context.Context, w *Webhook) error {
 		}
 	}(res.Body)
 
+	body, _ := io.ReadAll(io.LimitReader(res.Body, maxResponseBodyLength))
+	r := &Result{
+		StatusCode:   res.StatusCode,
+		Latency:      time.Since(start),
+		ResponseBody: string(body),
+	}
+
 	if res.StatusCode > 300 {
-		return fmt.Errorf("ERROR: id=%s, url=%s, status=%d", w.EventID, w.URL, res.StatusCode)
+		return r, fmt.Errorf("ERROR: id=%s, url=%s, status=%d", w.EventID, w.URL, res.StatusCode)
 	}
 
-	return nil
+	return r, nil
 }
 
 func (w Webhook) requestBody() ([]byte, error) {
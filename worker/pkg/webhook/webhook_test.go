@@ -57,17 +57,21 @@ func TestSend(t *testing.T) {
 	httpmock.RegisterResponder("POST", endpoint2, httpmock.NewStringResponder(500, `error`))
 
 	// success
-	err := Send(context.Background(), w)
+	res, err := Send(context.Background(), w)
 	info := httpmock.GetCallCountInfo()
 	assert.NoError(t, err)
 	assert.Equal(t, 1, info["POST "+endpoint1])
+	assert.Equal(t, 200, res.StatusCode)
+	assert.Equal(t, "hello", res.ResponseBody)
 
 	// should fail
 	w.URL = endpoint2
-	err = Send(context.Background(), w)
+	res, err = Send(context.Background(), w)
 	info = httpmock.GetCallCountInfo()
 	assert.Error(t, err)
 	assert.Equal(t, 1, info["POST "+endpoint2])
+	assert.Equal(t, 500, res.StatusCode)
+	assert.Equal(t, "error", res.ResponseBody)
 }
 
 func TestWebhook_requestBody(t *testing.T) {